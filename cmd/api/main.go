@@ -45,6 +45,9 @@ func main() {
 		// Domain Service module (pure domain logic)
 		modules.DomainServiceModule,
 
+		// Event module (domain event subscribers)
+		modules.EventModule,
+
 		// Use Case module (application logic)
 		modules.UseCaseModule,
 
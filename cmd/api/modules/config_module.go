@@ -20,6 +20,7 @@ var ConfigModule = fx.Module("config",
 		func(c *config.Config) *config.RedisConfig { return &c.Redis },
 		func(c *config.Config) *config.LoggerConfig { return &c.Logger },
 		func(c *config.Config) *config.SePayConfig { return &c.SePay },
+		func(c *config.Config) *config.SessionConfig { return &c.Session },
 	),
 	fx.Invoke(initLogger, initValidator),
 )
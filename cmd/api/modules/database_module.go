@@ -15,9 +15,14 @@ import (
 
 // DatabaseModule provides database and cache dependencies with lifecycle management
 var DatabaseModule = fx.Module("database",
-	fx.Provide(newDatabase, newRedisClient, provideRedisRawClient),
+	fx.Provide(newDatabase, newRedisClient, provideRedisRawClient, provideCache),
 )
 
+// provideCache exposes our Redis wrapper through the generic cache interface
+func provideCache(client *cache.RedisClient) cache.Cache {
+	return client
+}
+
 // provideRedisRawClient extracts the raw redis.Client from our wrapper
 func provideRedisRawClient(client *cache.RedisClient) *redis.Client {
 	return client.Client()
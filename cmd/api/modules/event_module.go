@@ -0,0 +1,69 @@
+package modules
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/infrastructure/config"
+	"github.com/aiagent/pkg/logger"
+	"go.uber.org/fx"
+)
+
+// EventModule wires domain event subscribers onto the event bus
+// and runs the outbox relay that delivers durable events to them
+var EventModule = fx.Module("event",
+	fx.Provide(newOutboxRelay),
+	fx.Invoke(registerEventSubscribers),
+	fx.Invoke(startOutboxRelay),
+)
+
+// registerEventSubscribers attaches all subscribers to the shared event bus
+func registerEventSubscribers(
+	bus service.EventBus,
+	notifications *service.NotificationEventSubscriber,
+	payments *service.PaymentEventSubscriber,
+) {
+	notifications.Register(bus)
+	payments.Register(bus)
+	logger.Info("Domain event subscribers registered")
+}
+
+// newOutboxRelay creates the outbox relay from configuration
+func newOutboxRelay(repo repository.OutboxRepository, bus service.EventBus, cfg *config.Config) *service.OutboxRelay {
+	return service.NewOutboxRelay(repo, bus, service.OutboxRelayConfig{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		Lease:        cfg.Outbox.Lease,
+		BaseBackoff:  cfg.Outbox.BaseBackoff,
+		MaxBackoff:   cfg.Outbox.MaxBackoff,
+	})
+}
+
+// startOutboxRelay starts the outbox relay with lifecycle hooks
+func startOutboxRelay(lc fx.Lifecycle, relay *service.OutboxRelay, cfg *config.Config) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if !cfg.Outbox.Enabled {
+				logger.Info("Outbox relay is disabled")
+				return nil
+			}
+
+			logger.Info("Starting outbox relay", map[string]interface{}{
+				"poll_interval": cfg.Outbox.PollInterval.String(),
+				"batch_size":    cfg.Outbox.BatchSize,
+			})
+			relay.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if !cfg.Outbox.Enabled {
+				return nil
+			}
+
+			logger.Info("Stopping outbox relay")
+			relay.Stop()
+			return nil
+		},
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aiagent/internal/interfaces/http/handler/comment"
 	"github.com/aiagent/internal/interfaces/http/handler/fraud"
 	"github.com/aiagent/internal/interfaces/http/handler/health"
+	"github.com/aiagent/internal/interfaces/http/handler/media"
 	"github.com/aiagent/internal/interfaces/http/handler/notification"
 	paymentH "github.com/aiagent/internal/interfaces/http/handler/payment"
 	"github.com/aiagent/internal/interfaces/http/handler/plan"
@@ -19,6 +20,7 @@ import (
 	"github.com/aiagent/internal/interfaces/http/handler/reading_history"
 	"github.com/aiagent/internal/interfaces/http/handler/recommendation"
 	"github.com/aiagent/internal/interfaces/http/handler/role"
+	"github.com/aiagent/internal/interfaces/http/handler/search"
 	"github.com/aiagent/internal/interfaces/http/handler/series"
 	"github.com/aiagent/internal/interfaces/http/handler/subscription"
 	"github.com/aiagent/internal/interfaces/http/handler/tag"
@@ -39,6 +41,7 @@ var HandlerModule = fx.Module("handler",
 		comment.NewCommentHandler,
 		subscription.NewSubscriptionHandler,
 		profile.NewProfileHandler,
+		media.NewMediaHandler,
 		role.NewRoleHandler,
 		series.NewSeriesHandler,
 		ranking.NewRankingHandler,
@@ -48,10 +51,20 @@ var HandlerModule = fx.Module("handler",
 		auth.NewAuthHandler,
 		notification.NewNotificationHandler,
 		version.NewVersionHandler,
-		paymentH.NewPaymentHandler,
+		search.NewSearchHandler,
+		func(uc payment.CreatePaymentUseCase, statusUC payment.PaymentStatusUseCase) paymentH.PaymentHandler {
+			return paymentH.NewPaymentHandler(uc, statusUC)
+		},
 		plan.NewPlanHandler,
 		func(cfg *config.Config, uc payment.ProcessWebhookUseCase) paymentH.WebhookHandler {
 			return paymentH.NewWebhookHandler(uc, cfg.SePay.APIKey)
 		},
+		paymentH.NewReconciliationHandler,
+		paymentH.NewRefundHandler,
+		paymentH.NewReportHandler,
+		paymentH.NewEarningsHandler,
+		paymentH.NewPayoutHandler,
+		paymentH.NewGiftHandler,
+		paymentH.NewPromoCodeHandler,
 	),
 )
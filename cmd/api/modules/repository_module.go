@@ -22,10 +22,19 @@ var RepositoryModule = fx.Module("repository",
 		pgRepo.NewTagRepository,
 		pgRepo.NewCommentRepository,
 		pgRepo.NewSubscriptionRepository,
+		pgRepo.NewSubscriptionEventRepository,
+		pgRepo.NewOutboxRepository,
 		pgRepo.NewSubscriptionPlanRepository,
 		pgRepo.NewTagTierMappingRepository,
 		pgRepo.NewBookmarkRepository,
 		pgRepo.NewTransactionRepository,
+		pgRepo.NewPaymentReconciliationRepository,
+		pgRepo.NewPaymentRefundRepository,
+		pgRepo.NewPaymentReportRepository,
+		pgRepo.NewLedgerRepository,
+		pgRepo.NewPayoutRepository,
+		pgRepo.NewPromoCodeRepository,
+		pgRepo.NewGiftSubscriptionRepository,
 		pgRepo.NewUserRepository,
 		pgRepo.NewRoleRepository,
 		pgRepo.NewUserVelocityScoreRepository,
@@ -34,13 +43,24 @@ var RepositoryModule = fx.Module("repository",
 		pgRepo.NewSeriesRepository,
 		pgRepo.NewReadingHistoryRepository,
 		pgRepo.NewSocialAccountRepository,
+		pgRepo.NewTwoFactorRepository,
+		pgRepo.NewAPITokenRepository,
+		pgRepo.NewSearchRepository,
+		pgRepo.NewMediaAssetRepository,
 		redisRepo.NewSessionRepository,
+		redisRepo.NewOAuthStateRepository,
+		redisRepo.NewAuthTokenRepository,
 		adapter.NewSystemRepository,
 		adapter.NewSePayAdapter,
 		func(cfg *config.Config) adapter.EmailProvider {
 			return adapter.NewSMTPAdapter(cfg.Email)
 		},
+		// Media blob store selected by storage.driver
+		func(cfg *config.Config) (adapter.BlobStore, error) {
+			return adapter.NewBlobStore(&cfg.Storage)
+		},
 		pgRepo.NewFraudDetectionRepository,
+		pgRepo.NewBatchJobRepository,
 		pgRepo.NewUserSeriesPurchaseRepository,
 		// Notification Repositories
 		pgRepo.NewNotificationRepository,
@@ -2,19 +2,37 @@ package modules
 
 import (
 	"context"
+	"time"
 
+	"github.com/aiagent/internal/domain/repository"
 	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/infrastructure/adapter"
 	"github.com/aiagent/internal/infrastructure/config"
 	"github.com/aiagent/pkg/logger"
 	"go.uber.org/fx"
+	"gorm.io/gorm"
 )
 
 // SchedulerModule provides background job scheduling with lifecycle management
 var SchedulerModule = fx.Module("scheduler",
 	fx.Provide(newRankingJob),
+	fx.Provide(newSubscriptionLifecycleJob),
+	fx.Provide(newPaymentReconciliationJob),
+	fx.Provide(newScheduledPublishJob),
 	fx.Invoke(startScheduler),
+	fx.Invoke(startBatchJobRecovery),
+	fx.Invoke(startSubscriptionLifecycle),
+	fx.Invoke(startPaymentReconciliation),
+	fx.Invoke(startScheduledPublishing),
+	fx.Invoke(startVersionPruning),
 )
 
+// batchJobRecoveryInterval is how often crashed fraud batch jobs are looked for
+const batchJobRecoveryInterval = time.Minute
+
+// versionPruneInterval is how often versions older than their blog's retention age are deleted
+const versionPruneInterval = time.Hour
+
 // newRankingJob creates the ranking job instance
 func newRankingJob(rankingSvc service.RankingService) *service.RankingJob {
 	return service.NewRankingJob(rankingSvc)
@@ -52,3 +70,216 @@ func startScheduler(lc fx.Lifecycle, job *service.RankingJob, cfg *config.Config
 		},
 	})
 }
+
+// startBatchJobRecovery resumes fraud batch jobs left behind by a crashed instance,
+// once on startup and then periodically for jobs whose lease expires later
+func startBatchJobRecovery(lc fx.Lifecycle, jobs service.BatchJobService, cfg *config.Config) {
+	if !cfg.Scheduler.Enabled {
+		return
+	}
+
+	stopCh := make(chan struct{})
+	resume := func() {
+		n, err := jobs.ResumeInterruptedJobs(context.Background())
+		if err != nil {
+			logger.Error("Failed to resume interrupted batch jobs", err)
+			return
+		}
+		if n > 0 {
+			logger.Info("Resumed interrupted batch jobs", map[string]interface{}{"count": n})
+		}
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				resume()
+				ticker := time.NewTicker(batchJobRecoveryInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ticker.C:
+						resume()
+					case <-stopCh:
+						return
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			close(stopCh)
+			return nil
+		},
+	})
+}
+
+// newSubscriptionLifecycleJob creates the subscription lifecycle job from configuration
+func newSubscriptionLifecycleJob(
+	db *gorm.DB,
+	subRepo repository.SubscriptionRepository,
+	eventRepo repository.SubscriptionEventRepository,
+	userRepo repository.UserRepository,
+	dispatcher service.NotificationDispatcher,
+	cfg *config.Config,
+) *service.SubscriptionLifecycleJob {
+	return service.NewSubscriptionLifecycleJob(db, subRepo, eventRepo, userRepo, dispatcher, service.SubscriptionLifecycleConfig{
+		Interval:       cfg.Subscription.LifecycleInterval,
+		ReminderBefore: cfg.Subscription.ReminderBefore,
+		GracePeriod:    cfg.Subscription.GracePeriod,
+		BatchSize:      cfg.Subscription.BatchSize,
+	})
+}
+
+// startSubscriptionLifecycle runs expiry reminders, grace periods and downgrades of paid subscriptions
+func startSubscriptionLifecycle(lc fx.Lifecycle, job *service.SubscriptionLifecycleJob, cfg *config.Config) {
+	if !cfg.Scheduler.Enabled {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("Starting subscription lifecycle job", map[string]interface{}{
+				"interval":        cfg.Subscription.LifecycleInterval.String(),
+				"reminder_before": cfg.Subscription.ReminderBefore.String(),
+				"grace_period":    cfg.Subscription.GracePeriod.String(),
+			})
+			job.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Stopping subscription lifecycle job")
+			job.Stop()
+			return nil
+		},
+	})
+}
+
+// newPaymentReconciliationJob creates the payment reconciliation job from configuration
+func newPaymentReconciliationJob(
+	txRepo repository.TransactionRepository,
+	payments service.PaymentService,
+	refunds service.RefundService,
+	reports service.PaymentReportService,
+	sepayAdapter adapter.SePayAdapter,
+	cfg *config.Config,
+) *service.PaymentReconciliationJob {
+	loc, err := time.LoadLocation(cfg.Scheduler.Timezone)
+	if err != nil {
+		logger.Warn("Unknown scheduler timezone, payment reports use local time", map[string]interface{}{
+			"timezone": cfg.Scheduler.Timezone,
+		})
+		loc = time.Local
+	}
+
+	return service.NewPaymentReconciliationJob(txRepo, payments, refunds, reports, sepayAdapter, service.PaymentReconciliationConfig{
+		Interval:   cfg.Payment.SweepInterval,
+		OrderTTL:   cfg.Payment.OrderTTL,
+		BatchSize:  cfg.Payment.BatchSize,
+		ReportHour: cfg.Payment.ReportHour,
+		Location:   loc,
+	})
+}
+
+// startPaymentReconciliation settles missed SePay transfers, expires stale orders and produces daily reports
+func startPaymentReconciliation(lc fx.Lifecycle, job *service.PaymentReconciliationJob, cfg *config.Config) {
+	if !cfg.Scheduler.Enabled {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("Starting payment reconciliation job", map[string]interface{}{
+				"interval":    cfg.Payment.SweepInterval.String(),
+				"order_ttl":   cfg.Payment.OrderTTL.String(),
+				"report_hour": cfg.Payment.ReportHour,
+			})
+			job.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Stopping payment reconciliation job")
+			job.Stop()
+			return nil
+		},
+	})
+}
+
+// newScheduledPublishJob creates the scheduled publishing job from configuration
+func newScheduledPublishJob(
+	db *gorm.DB,
+	blogRepo repository.BlogRepository,
+	outboxRepo repository.OutboxRepository,
+	versionService service.VersionService,
+	cfg *config.Config,
+) *service.ScheduledPublishJob {
+	return service.NewScheduledPublishJob(db, blogRepo, outboxRepo, versionService, service.ScheduledPublishConfig{
+		Interval:  cfg.Publishing.Interval,
+		BatchSize: cfg.Publishing.BatchSize,
+	})
+}
+
+// startScheduledPublishing announces scheduled blogs to followers once their publish time arrives
+func startScheduledPublishing(lc fx.Lifecycle, job *service.ScheduledPublishJob, cfg *config.Config) {
+	if !cfg.Scheduler.Enabled {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("Starting scheduled publishing job", map[string]interface{}{
+				"interval": cfg.Publishing.Interval.String(),
+			})
+			job.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Stopping scheduled publishing job")
+			job.Stop()
+			return nil
+		},
+	})
+}
+
+// startVersionPruning deletes blog versions older than the age limit of their blog's retention
+// policy; count limits are applied whenever a version is created
+func startVersionPruning(lc fx.Lifecycle, versions service.VersionService, cfg *config.Config) {
+	if !cfg.Scheduler.Enabled {
+		return
+	}
+
+	stopCh := make(chan struct{})
+	prune := func() {
+		n, err := versions.PruneExpiredVersions(context.Background())
+		if err != nil {
+			logger.Error("Failed to prune expired blog versions", err)
+			return
+		}
+		if n > 0 {
+			logger.Info("Pruned expired blog versions", map[string]interface{}{"count": n})
+		}
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				prune()
+				ticker := time.NewTicker(versionPruneInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ticker.C:
+						prune()
+					case <-stopCh:
+						return
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			close(stopCh)
+			return nil
+		},
+	})
+}
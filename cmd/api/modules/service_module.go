@@ -1,12 +1,19 @@
 package modules
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
 	"github.com/aiagent/internal/domain/service"
 	"github.com/aiagent/internal/infrastructure/adapter"
+	"github.com/aiagent/internal/infrastructure/config"
+	"github.com/aiagent/pkg/logger"
+	"github.com/aiagent/pkg/secretbox"
+	"github.com/shopspring/decimal"
 	"go.uber.org/fx"
+	"gorm.io/gorm"
 )
 
 // DomainServiceModule provides domain service dependencies
@@ -24,24 +31,79 @@ var DomainServiceModule = fx.Module("domain_service",
 		service.NewRankingService,
 		service.NewFraudDetectionService,
 		service.NewNotificationService,
+		service.DefaultBotDetectionConfig,
 		service.NewBotDetectionAlgorithm,
+		service.NewFollowerTracker,
 		service.NewBatchJobService,
 		service.NewRecommendationService,
-		service.NewSocialAuthService,
+		// Payment providers by currency; card payments only when Stripe has a secret key
+		func(sepay adapter.SePayAdapter, cfg *config.Config) *service.PaymentProviders {
+			providers := map[entity.TransactionProvider]adapter.PaymentProvider{
+				entity.TransactionProviderSEPAY: adapter.NewSePayProvider(sepay),
+			}
+			if cfg.Stripe.SecretKey != "" {
+				providers[entity.TransactionProviderStripe] = adapter.NewStripeAdapter(&cfg.Stripe)
+			}
+			return service.NewPaymentProviders(providers, cfg.Payment.Providers)
+		},
 		service.NewPaymentService,
+		service.NewRefundService,
+		service.NewPromoCodeService,
+		service.NewPaymentReportService,
+		// Earnings ledger with the platform fee kept per transaction type
+		func(ledgerRepo repository.LedgerRepository, planRepo repository.SubscriptionPlanRepository, seriesRepo repository.SeriesRepository, cfg *config.Config) service.LedgerService {
+			fees := cfg.Earnings.PlatformFee
+			return service.NewLedgerService(ledgerRepo, planRepo, seriesRepo, service.PlatformFees{
+				entity.TransactionTypeSubscription: decimal.NewFromFloat(fees.Subscription),
+				entity.TransactionTypeSeries:       decimal.NewFromFloat(fees.Series),
+				entity.TransactionTypeDonation:     decimal.NewFromFloat(fees.Donation),
+			})
+		},
+		func(db *gorm.DB, payoutRepo repository.PayoutRepository, ledger service.LedgerService, cfg *config.Config) service.PayoutService {
+			return service.NewPayoutService(db, payoutRepo, ledger, decimal.NewFromFloat(cfg.Earnings.MinPayout))
+		},
 		service.NewPlanManagementService,
+		service.NewSubscriptionPricingService,
 		service.NewTagTierService,
+		service.NewSeriesAccessService,
 		service.NewContentAccessService,
 		service.NewVersionService,
+		service.NewContentRenderer,
 		service.NewNotificationAggregator,
 		service.NewNotificationDispatcher,
+		service.NewEventBus,
+		service.NewNotificationEventSubscriber,
+		service.NewPaymentEventSubscriber,
 		// Task Runner for async tasks
 		func() service.TaskRunner {
 			return service.NewTaskRunner(30 * time.Second)
 		},
+		// Social login with the providers that have credentials configured
+		func(stateRepo repository.OAuthStateRepository, cfg *config.Config) service.SocialAuthService {
+			return service.NewSocialAuthService(adapter.NewOAuthProviders(&cfg.OAuth), stateRepo, cfg.OAuth.StateTTL)
+		},
+		func(repo repository.TwoFactorRepository, cfg *config.Config) (service.TwoFactorService, error) {
+			if cfg.TwoFactor.EncryptionKey == "" {
+				logger.Warn("two_factor.encryption_key is not set; two-factor setup is disabled")
+				return service.NewTwoFactorService(repo, cfg.TwoFactor.Issuer, nil), nil
+			}
+			box, err := secretbox.NewFromBase64(cfg.TwoFactor.EncryptionKey)
+			if err != nil {
+				return nil, fmt.Errorf("invalid two_factor.encryption_key: %w", err)
+			}
+			return service.NewTwoFactorService(repo, cfg.TwoFactor.Issuer, box), nil
+		},
+		service.NewAPITokenService,
+		func(db *gorm.DB, mediaRepo repository.MediaAssetRepository, store adapter.BlobStore, cfg *config.Config) service.MediaService {
+			return service.NewMediaService(db, mediaRepo, store, service.MediaConfig{
+				MaxUploadSize: cfg.Storage.MaxUploadSize,
+				UserQuota:     cfg.Storage.UserQuota,
+				MaxPixels:     cfg.Storage.MaxPixels,
+			})
+		},
 		// Email Service
-		func(userRepo repository.UserRepository, provider adapter.EmailProvider, taskRunner service.TaskRunner) service.EmailService {
-			return service.NewEmailServiceImpl(userRepo, provider, taskRunner, "internal/infrastructure/email/templates")
+		func(userRepo repository.UserRepository, provider adapter.EmailProvider, taskRunner service.TaskRunner, cfg *config.Config) service.EmailService {
+			return service.NewEmailServiceImpl(userRepo, provider, taskRunner, "internal/infrastructure/email/templates", cfg.Email.FrontendURL)
 		},
 	),
 )
@@ -8,13 +8,16 @@ import (
 	"github.com/aiagent/internal/application/usecase/category"
 	"github.com/aiagent/internal/application/usecase/comment"
 	"github.com/aiagent/internal/application/usecase/health"
+	"github.com/aiagent/internal/application/usecase/media"
 	"github.com/aiagent/internal/application/usecase/notification"
+	"github.com/aiagent/internal/application/usecase/payment"
 	"github.com/aiagent/internal/application/usecase/permission"
 	"github.com/aiagent/internal/application/usecase/profile"
 	"github.com/aiagent/internal/application/usecase/ranking"
 	"github.com/aiagent/internal/application/usecase/reading_history"
 	"github.com/aiagent/internal/application/usecase/recommendation"
 	"github.com/aiagent/internal/application/usecase/role"
+	"github.com/aiagent/internal/application/usecase/search"
 	"github.com/aiagent/internal/application/usecase/series"
 	"github.com/aiagent/internal/application/usecase/subscription"
 	"github.com/aiagent/internal/application/usecase/tag"
@@ -31,13 +34,24 @@ var UseCaseModule = fx.Module("usecase",
 		category.NewCategoryUseCase,
 		comment.NewCommentUseCase,
 		health.NewHealthUseCase,
+		media.NewMediaUseCase,
 		notification.NewNotificationUseCase,
+		payment.NewCreatePaymentUseCase,
+		payment.NewProcessWebhookUseCase,
+		payment.NewPaymentStatusUseCase,
+		payment.NewReconciliationUseCase,
+		payment.NewRefundUseCase,
+		payment.NewReportUseCase,
+		payment.NewEarningsUseCase,
+		payment.NewGiftUseCase,
+		payment.NewPromoCodeUseCase,
 		permission.NewPermissionUseCase,
 		profile.NewProfileUseCase,
 		ranking.NewRankingUseCase,
 		reading_history.NewReadingHistoryUseCase,
 		recommendation.NewRecommendationUseCase,
 		role.NewRoleUseCase,
+		search.NewSearchUseCase,
 		series.NewSeriesUseCase,
 		subscription.NewSubscriptionUseCase,
 		tag.NewTagUseCase,
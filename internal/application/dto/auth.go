@@ -1,6 +1,10 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type RegisterRequest struct {
 	Name     string `json:"name" validate:"required,min=2,max=100" binding:"required,min=2,max=100"`
@@ -16,11 +20,109 @@ type LoginRequest struct {
 type LoginWithSocialRequest struct {
 	Provider string `json:"provider" validate:"required" binding:"required"`
 	Code     string `json:"code" validate:"required" binding:"required"`
+	State    string `json:"state" validate:"required" binding:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required" binding:"required"`
+}
+
+type EmailRequest struct {
+	Email string `json:"email" validate:"required,email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required" binding:"required"`
+	NewPassword string `json:"newPassword" validate:"required,min=8" binding:"required,min=8"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword" validate:"required,min=8" binding:"required,min=8"`
+}
+
+// TwoFactorLoginRequest completes a login that answered with a two-factor challenge
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challengeToken" validate:"required" binding:"required"`
+	Code           string `json:"code" validate:"required" binding:"required"` // TOTP or recovery code
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required" binding:"required"`
+}
+
+// DisableTwoFactorRequest re-authenticates the user with their password or a two-factor code
+type DisableTwoFactorRequest struct {
+	CurrentPassword string `json:"currentPassword" validate:"required_without=Code" binding:"required_without=Code"`
+	Code            string `json:"code" validate:"required_without=CurrentPassword" binding:"required_without=CurrentPassword"` // TOTP or recovery code
 }
 
+// AuthResponse carries either a session or, when the account uses two-factor
+// authentication, the challenge token to complete the login with
 type AuthResponse struct {
-	SessionID string    `json:"sessionId"`
-	UserID    uuid.UUID `json:"userId"`
-	Email     string    `json:"email"`
-	Name      string    `json:"name"`
+	SessionID         string     `json:"sessionId,omitempty"`
+	SessionExpiresAt  *time.Time `json:"sessionExpiresAt,omitempty"`
+	TwoFactorRequired bool       `json:"twoFactorRequired,omitempty"`
+	ChallengeToken    string     `json:"challengeToken,omitempty"`
+	UserID            uuid.UUID  `json:"userId"`
+	Email             string     `json:"email"`
+	Name              string     `json:"name"`
+}
+
+type TwoFactorSetupResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioningUri"`
+}
+
+type TwoFactorStatusResponse struct {
+	Enabled                bool       `json:"enabled"`
+	EnabledAt              *time.Time `json:"enabledAt,omitempty"`
+	RecoveryCodesRemaining int64      `json:"recoveryCodesRemaining"`
+}
+
+// RecoveryCodesResponse lists freshly generated recovery codes; they cannot be shown again
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// SessionResponse describes one signed-in device
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	Device     string    `json:"device"`
+	IPAddress  string    `json:"ipAddress"`
+	UserAgent  string    `json:"userAgent"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Current    bool      `json:"current"`
+}
+
+// APITokenScopeRequest grants a token the given permission bitmask on a resource
+type APITokenScopeRequest struct {
+	Resource    string `json:"resource" validate:"required" binding:"required"`
+	Permissions int    `json:"permissions" validate:"min=1,max=15" binding:"min=1,max=15"`
+}
+
+type CreateAPITokenRequest struct {
+	Name          string                 `json:"name" validate:"required,max=100" binding:"required,max=100"`
+	Scopes        []APITokenScopeRequest `json:"scopes" validate:"required,min=1,dive" binding:"required,min=1,dive"`
+	ExpiresInDays int                    `json:"expiresInDays" validate:"omitempty,min=1,max=365" binding:"omitempty,min=1,max=365"` // Defaults to 90
+}
+
+// APITokenResponse describes a personal access token without its secret
+type APITokenResponse struct {
+	ID          uuid.UUID            `json:"id"`
+	Name        string               `json:"name"`
+	TokenPrefix string               `json:"tokenPrefix"`
+	Scopes      []PermissionResponse `json:"scopes"`
+	ExpiresAt   time.Time            `json:"expiresAt"`
+	LastUsedAt  *time.Time           `json:"lastUsedAt,omitempty"`
+	RevokedAt   *time.Time           `json:"revokedAt,omitempty"`
+	CreatedAt   time.Time            `json:"createdAt"`
+}
+
+// CreateAPITokenResponse carries the token secret; it cannot be shown again
+type CreateAPITokenResponse struct {
+	APITokenResponse
+	Token string `json:"token"`
 }
@@ -35,6 +35,28 @@ type UpdateBlogRequest struct {
 type PublishBlogRequest struct {
 	Visibility  string     `json:"visibility" binding:"required,oneof=public subscribers_only"`
 	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+	// PublishAt schedules the blog like ScheduleBlogRequest and takes precedence over PublishedAt
+	PublishAt *string `json:"publishAt,omitempty"`
+	Timezone  *string `json:"timezone,omitempty"` // IANA name, e.g. Asia/Ho_Chi_Minh
+}
+
+// ScheduleBlogRequest represents the request to move a scheduled blog to another publish time
+type ScheduleBlogRequest struct {
+	// PublishAt is an RFC 3339 time, or a wall-clock time such as 2026-05-01T09:00 read in Timezone
+	PublishAt string  `json:"publishAt" binding:"required"`
+	Timezone  *string `json:"timezone,omitempty"` // IANA name, e.g. Asia/Ho_Chi_Minh
+}
+
+// ScheduledBlogResponse represents a blog waiting for its publish time
+type ScheduledBlogResponse struct {
+	ID          uuid.UUID             `json:"id"`
+	Title       string                `json:"title"`
+	Slug        string                `json:"slug"`
+	Visibility  entity.BlogVisibility `json:"visibility"`
+	PublishedAt time.Time             `json:"publishedAt"`
+	Timezone    *string               `json:"timezone,omitempty"`
+	LocalTime   *string               `json:"localTime,omitempty"` // Publish time on the wall clock of Timezone
+	UpdatedAt   time.Time             `json:"updatedAt"`
 }
 
 // ReactionRequest represents the request to react to a blog
@@ -52,25 +74,58 @@ type ReactionResponse struct {
 
 // BlogResponse represents a blog in API responses
 type BlogResponse struct {
-	ID            uuid.UUID             `json:"id"`
-	AuthorID      uuid.UUID             `json:"authorId"`
-	Author        *UserBriefResponse    `json:"author,omitempty"`
-	CategoryID    *uuid.UUID            `json:"categoryId,omitempty"`
-	Category      *CategoryResponse     `json:"category,omitempty"`
-	Title         string                `json:"title"`
-	Slug          string                `json:"slug"`
-	Excerpt       *string               `json:"excerpt,omitempty"`
-	Content       string                `json:"content"`
-	ThumbnailURL  *string               `json:"thumbnailUrl,omitempty"`
-	Status        entity.BlogStatus     `json:"status"`
-	Visibility    entity.BlogVisibility `json:"visibility"`
-	PublishedAt   *time.Time            `json:"publishedAt,omitempty"`
-	Tags          []TagResponse         `json:"tags"`
-	UpvoteCount   int                   `json:"upvoteCount"`
-	DownvoteCount int                   `json:"downvoteCount"`
-	UserReaction  *entity.ReactionType  `json:"userReaction,omitempty"` // For the current viewer
-	CreatedAt     time.Time             `json:"createdAt"`
-	UpdatedAt     time.Time             `json:"updatedAt"`
+	ID                 uuid.UUID              `json:"id"`
+	AuthorID           uuid.UUID              `json:"authorId"`
+	Author             *UserBriefResponse     `json:"author,omitempty"`
+	CategoryID         *uuid.UUID             `json:"categoryId,omitempty"`
+	Category           *CategoryResponse      `json:"category,omitempty"`
+	Title              string                 `json:"title"`
+	Slug               string                 `json:"slug"`
+	Excerpt            *string                `json:"excerpt,omitempty"`
+	Content            string                 `json:"content"`     // Markdown source
+	ContentHTML        string                 `json:"contentHtml"` // Sanitized HTML rendered from Content
+	TOC                []TOCEntry             `json:"toc"`         // Headings of the content, in order
+	WordCount          int                    `json:"wordCount"`
+	ReadingTimeMinutes int                    `json:"readingTimeMinutes"`
+	ThumbnailURL       *string                `json:"thumbnailUrl,omitempty"`
+	Status             entity.BlogStatus      `json:"status"`
+	Visibility         entity.BlogVisibility  `json:"visibility"`
+	PublishedAt        *time.Time             `json:"publishedAt,omitempty"`
+	Timezone           *string                `json:"timezone,omitempty"` // Timezone a scheduled blog was scheduled in
+	Tags               []TagResponse          `json:"tags"`
+	UpvoteCount        int                    `json:"upvoteCount"`
+	DownvoteCount      int                    `json:"downvoteCount"`
+	UserReaction       *entity.ReactionType   `json:"userReaction,omitempty"` // For the current viewer
+	Series             []BlogSeriesNavigation `json:"series,omitempty"`       // Series the blog is a chapter of
+	CreatedAt          time.Time              `json:"createdAt"`
+	UpdatedAt          time.Time              `json:"updatedAt"`
+}
+
+// TOCEntry is a heading of a blog's content; ID is its anchor in ContentHTML
+type TOCEntry struct {
+	Level int    `json:"level"`
+	ID    string `json:"id"`
+	Text  string `json:"text"`
+}
+
+// BlogSeriesNavigation locates a blog within a series. Previous and Next skip
+// chapters that are not published yet.
+type BlogSeriesNavigation struct {
+	SeriesID      uuid.UUID    `json:"seriesId"`
+	Title         string       `json:"title"`
+	Slug          string       `json:"slug"`
+	ChapterNumber int          `json:"chapterNumber"`
+	TotalChapters int          `json:"totalChapters"`
+	Previous      *ChapterLink `json:"previous,omitempty"`
+	Next          *ChapterLink `json:"next,omitempty"`
+}
+
+// ChapterLink points to a neighbouring chapter
+type ChapterLink struct {
+	ID            uuid.UUID `json:"id"`
+	Title         string    `json:"title"`
+	Slug          string    `json:"slug"`
+	ChapterNumber int       `json:"chapterNumber"`
 }
 
 // BlogListResponse represents a blog in list view (without full content)
@@ -90,6 +145,7 @@ type BlogListResponse struct {
 	Tags          []TagResponse         `json:"tags"`
 	UpvoteCount   int                   `json:"upvoteCount"`
 	DownvoteCount int                   `json:"downvoteCount"`
+	Locked        bool                  `json:"locked"` // The viewer needs a higher tier or a series purchase to read it
 	CreatedAt     time.Time             `json:"createdAt"`
 }
 
@@ -105,6 +161,16 @@ type BlogFilterParams struct {
 	PageSize   int      `form:"pageSize,default=10"`
 }
 
+// BlogTimelineParams represents query parameters for paging through published blogs
+type BlogTimelineParams struct {
+	AuthorID   *string  `form:"authorId"`
+	CategoryID *string  `form:"categoryId"`
+	TagIDs     []string `form:"tagIds"`
+	Search     *string  `form:"search"`
+	Cursor     string   `form:"cursor"` // nextCursor of the previous page, empty for the first one
+	Limit      int      `form:"limit,default=10" binding:"min=1,max=100"`
+}
+
 // UserBriefResponse represents a brief user info for nested responses
 type UserBriefResponse struct {
 	ID    uuid.UUID `json:"id"`
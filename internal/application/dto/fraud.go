@@ -131,15 +131,34 @@ type BatchAnalyzeRequest struct {
 // BatchAnalyzeResponse represents the result of batch analysis
 type BatchAnalyzeResponse struct {
 	JobID              uuid.UUID  `json:"job_id"`
-	Status             string     `json:"status"` // "started", "completed", "failed"
+	Status             string     `json:"status"` // "started", "running", "completed", "failed", "cancelled"
+	DateFrom           *time.Time `json:"date_from,omitempty"`
+	DateTo             *time.Time `json:"date_to,omitempty"`
 	StartedAt          time.Time  `json:"started_at"`
 	CompletedAt        *time.Time `json:"completed_at"`
 	ProcessedFollowers int        `json:"processed_followers"`
 	NewSignalsDetected int        `json:"new_signals_detected"`
 	UsersScored        int        `json:"users_scored"`
+	Errors             []string   `json:"errors,omitempty"`
 	Message            string     `json:"message"`
 }
 
+// BatchJobListRequest represents filters for listing batch jobs
+type BatchJobListRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=running completed failed cancelled"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// BatchJobListResponse represents a page of batch jobs
+type BatchJobListResponse struct {
+	Jobs       []BatchAnalyzeResponse `json:"jobs"`
+	TotalCount int                    `json:"total_count"`
+	Page       int                    `json:"page"`
+	PageSize   int                    `json:"page_size"`
+	TotalPages int                    `json:"total_pages"`
+}
+
 // UserBadgeResponse represents a user's badge status
 type UserBadgeResponse struct {
 	UserID        uuid.UUID  `json:"user_id"`
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// UploadMediaRequest represents the form fields sent with an uploaded image
+type UploadMediaRequest struct {
+	Purpose string `form:"purpose" binding:"required,oneof=blog_thumbnail blog_inline"` // Avatars are uploaded through the profile
+}
+
+// MediaListRequest represents filters and pagination for the signed-in user's media
+type MediaListRequest struct {
+	Purpose  *string `form:"purpose" binding:"omitempty,oneof=avatar blog_thumbnail blog_inline"`
+	Page     int     `form:"page,default=1" binding:"min=1"`
+	PageSize int     `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// MediaVariantResponse represents a resized copy of an image
+type MediaVariantResponse struct {
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Size   int64  `json:"size"`
+	URL    string `json:"url"`
+}
+
+// MediaResponse represents an uploaded image and its resized copies
+type MediaResponse struct {
+	ID          uuid.UUID              `json:"id"`
+	Purpose     entity.MediaPurpose    `json:"purpose"`
+	ContentType string                 `json:"contentType"`
+	Width       int                    `json:"width"`
+	Height      int                    `json:"height"`
+	Size        int64                  `json:"size"` // Bytes counted against the quota, variants included
+	URL         string                 `json:"url"`
+	Variants    []MediaVariantResponse `json:"variants"` // Narrowest first
+	CreatedAt   time.Time              `json:"createdAt"`
+}
+
+// MediaUsageResponse represents the storage used by the signed-in user
+type MediaUsageResponse struct {
+	BytesUsed int64 `json:"bytesUsed"`
+	Quota     int64 `json:"quota"`
+}
+
+// MediaListResponse represents a page of the signed-in user's media
+type MediaListResponse struct {
+	Media      []MediaResponse    `json:"media"`
+	Usage      MediaUsageResponse `json:"usage"`
+	TotalCount int64              `json:"totalCount"`
+	Page       int                `json:"page"`
+	PageSize   int                `json:"pageSize"`
+	TotalPages int                `json:"totalPages"`
+}
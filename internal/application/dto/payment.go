@@ -1,31 +1,58 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
 // CreatePaymentRequest represents the request to initiate a payment
 type CreatePaymentRequest struct {
 	UserID   string                    `json:"userId" validate:"required"`
-	Amount   decimal.Decimal           `json:"amount" validate:"required,gt=0"`
+	Amount   decimal.Decimal           `json:"amount" validate:"required,gt=0"` // Ignored for series and subscriptions, which are priced server-side
 	Type     entity.TransactionType    `json:"type" validate:"required"`
 	Gateway  entity.TransactionGateway `json:"gateway" validate:"required"`
-	TargetID *string                   `json:"targetId,omitempty"` // ID of Subscription Author or Series
-	PlanID   *string                   `json:"planId,omitempty"`   // Subscription plan ID
+	Currency string                    `json:"currency,omitempty" binding:"omitempty,len=3"` // Donations only, defaults to VND; picks the payment provider
+	TargetID *string                   `json:"targetId,omitempty"`                           // ID of Subscription Author or Series
+	PlanID   *string                   `json:"planId,omitempty"`                             // Subscription plan ID
+
+	// Subscriptions only
+	Gift      bool    `json:"gift,omitempty"`                                 // Pay for the plan as a gift code to hand to someone else
+	PromoCode *string `json:"promoCode,omitempty" binding:"omitempty,max=50"` // Promo code of the author, discounting the plan
 }
 
 // CreatePaymentResponse represents the response after initiating a payment
 type CreatePaymentResponse struct {
 	OrderID       string                    `json:"orderId"`
 	Amount        decimal.Decimal           `json:"amount"`
+	Discount      decimal.Decimal           `json:"discount"` // Taken off the plan price by the promo code
+	Currency      string                    `json:"currency"`
 	Gateway       entity.TransactionGateway `json:"gateway"`
+	CheckoutURL   string                    `json:"checkoutUrl,omitempty"` // Hosted payment page the payer is redirected to for card payments
 	QRDataURL     string                    `json:"qrDataUrl,omitempty"`
 	QRData        string                    `json:"qrData,omitempty"`
 	BankName      string                    `json:"bankName,omitempty"`
 	AccountNo     string                    `json:"accountNo,omitempty"`
 	AccountName   string                    `json:"accountName,omitempty"`
 	ReferenceCode string                    `json:"referenceCode"`
+	Status        entity.TransactionStatus  `json:"status"` // SUCCESS when nothing had to be paid
+}
+
+// PaymentStatusResponse represents the state of an order
+type PaymentStatusResponse struct {
+	OrderID    string                     `json:"orderId"`
+	Type       entity.TransactionType     `json:"type"`
+	Amount     decimal.Decimal            `json:"amount"`
+	Currency   string                     `json:"currency"`
+	Provider   entity.TransactionProvider `json:"provider"`
+	Gateway    *entity.TransactionGateway `json:"gateway,omitempty"`
+	Status     entity.TransactionStatus   `json:"status"`
+	Gift       bool                       `json:"gift,omitempty"` // The code is listed with the payer's gifts once paid
+	PaidAmount *decimal.Decimal           `json:"paidAmount,omitempty"`
+	PaidAt     *time.Time                 `json:"paidAt,omitempty"`
+	CreatedAt  time.Time                  `json:"createdAt"`
 }
 
 // ProcessWebhookRequest represents the payload from SePay webhook
@@ -43,3 +70,249 @@ type ProcessWebhookRequest struct {
 	ReferenceCode   string          `json:"referenceCode"`
 	Description     string          `json:"description"`
 }
+
+// ReconciliationListRequest represents filters for listing flagged transfers
+type ReconciliationListRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=open granted dismissed"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ResolveReconciliationRequest represents an admin decision on a flagged transfer
+type ResolveReconciliationRequest struct {
+	Action string  `json:"action" binding:"required,oneof=grant dismiss"`
+	Note   *string `json:"note,omitempty" binding:"omitempty,max=1000"`
+}
+
+// ReconciliationResponse represents a transfer that did not match its order
+type ReconciliationResponse struct {
+	ID             uuid.UUID                   `json:"id"`
+	TransactionID  uuid.UUID                   `json:"transactionId"`
+	UserID         uuid.UUID                   `json:"userId"`
+	SePayID        string                      `json:"sepayId"`
+	Reason         entity.ReconciliationReason `json:"reason"`
+	ExpectedAmount decimal.Decimal             `json:"expectedAmount"`
+	ReceivedAmount decimal.Decimal             `json:"receivedAmount"`
+	Currency       string                      `json:"currency"`
+	Status         entity.ReconciliationStatus `json:"status"`
+	Note           *string                     `json:"note,omitempty"`
+	ResolvedBy     *uuid.UUID                  `json:"resolvedBy,omitempty"`
+	ResolvedAt     *time.Time                  `json:"resolvedAt,omitempty"`
+	CreatedAt      time.Time                   `json:"createdAt"`
+}
+
+// ReconciliationListResponse represents a page of flagged transfers
+type ReconciliationListResponse struct {
+	Reconciliations []ReconciliationResponse `json:"reconciliations"`
+	TotalCount      int64                    `json:"totalCount"`
+	Page            int                      `json:"page"`
+	PageSize        int                      `json:"pageSize"`
+	TotalPages      int                      `json:"totalPages"`
+}
+
+// CreateRefundRequest represents an admin refund or a chargeback reported by the bank
+type CreateRefundRequest struct {
+	Kind        string           `json:"kind" binding:"required,oneof=refund chargeback"`
+	Amount      *decimal.Decimal `json:"amount,omitempty"` // Omitted to refund everything not refunded yet
+	Reason      string           `json:"reason" binding:"required,max=1000"`
+	ProviderRef *string          `json:"providerRef,omitempty" binding:"omitempty,max=255"`
+}
+
+// RefundResponse represents one entry of a transaction's refund history
+type RefundResponse struct {
+	ID             uuid.UUID           `json:"id"`
+	TransactionID  uuid.UUID           `json:"transactionId"`
+	UserID         uuid.UUID           `json:"userId"`
+	Kind           entity.RefundKind   `json:"kind"`
+	Amount         decimal.Decimal     `json:"amount"`
+	Currency       string              `json:"currency"`
+	Reason         string              `json:"reason"`
+	Status         entity.RefundStatus `json:"status"`
+	ProviderRef    *string             `json:"providerRef,omitempty"`
+	BenefitRevoked bool                `json:"benefitRevoked"`
+	CreatedBy      uuid.UUID           `json:"createdBy"`
+	CreatedAt      time.Time           `json:"createdAt"`
+}
+
+// PaymentReportListRequest represents pagination for listing daily payment reports
+type PaymentReportListRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// PaymentReportResponse represents the reconciliation of one day's orders against SePay
+type PaymentReportResponse struct {
+	ID            uuid.UUID                      `json:"id"`
+	Day           string                         `json:"day"`
+	PeriodStart   time.Time                      `json:"periodStart"`
+	PeriodEnd     time.Time                      `json:"periodEnd"`
+	OrdersCreated int64                          `json:"ordersCreated"`
+	OrdersPaid    int64                          `json:"ordersPaid"`
+	OrdersExpired int64                          `json:"ordersExpired"`
+	OrdersPending int64                          `json:"ordersPending"`
+	PaidAmount    decimal.Decimal                `json:"paidAmount"`
+	Mismatches    []entity.PaymentReportMismatch `json:"mismatches"`
+	CreatedAt     time.Time                      `json:"createdAt"`
+}
+
+// PaymentReportListResponse represents a page of daily payment reports
+type PaymentReportListResponse struct {
+	Reports    []PaymentReportResponse `json:"reports"`
+	TotalCount int64                   `json:"totalCount"`
+	Page       int                     `json:"page"`
+	PageSize   int                     `json:"pageSize"`
+	TotalPages int                     `json:"totalPages"`
+}
+
+// EarningsRequest represents the period of an author's earnings, both days included
+type EarningsRequest struct {
+	Currency string `form:"currency" binding:"omitempty,len=3"` // Defaults to VND
+	From     string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To       string `form:"to" binding:"omitempty,datetime=2006-01-02"`
+}
+
+// BalanceRequest represents the currency of an author's balance
+type BalanceRequest struct {
+	Currency string `form:"currency" binding:"omitempty,len=3"` // Defaults to VND
+}
+
+// BalanceResponse represents what the platform owes an author
+type BalanceResponse struct {
+	Balance       decimal.Decimal `json:"balance"`
+	PendingPayout decimal.Decimal `json:"pendingPayout"` // Requested and waiting for review
+	Currency      string          `json:"currency"`
+}
+
+// EarningsLineResponse represents an author's earnings from one subscription tier, series or donations
+type EarningsLineResponse struct {
+	Type     entity.TransactionType `json:"type"`
+	Tier     *string                `json:"tier,omitempty"`
+	SeriesID *uuid.UUID             `json:"seriesId,omitempty"`
+	Gross    decimal.Decimal        `json:"gross"`
+	Fee      decimal.Decimal        `json:"fee"`
+	Net      decimal.Decimal        `json:"net"`
+	Payments int64                  `json:"payments"`
+}
+
+// EarningsResponse represents an author's earnings over a period, less refunds
+type EarningsResponse struct {
+	From      *string                `json:"from,omitempty"`
+	To        *string                `json:"to,omitempty"`
+	Currency  string                 `json:"currency"`
+	Gross     decimal.Decimal        `json:"gross"` // Paid by readers
+	Fee       decimal.Decimal        `json:"fee"`   // Kept by the platform
+	Net       decimal.Decimal        `json:"net"`   // Credited to the author
+	Payments  int64                  `json:"payments"`
+	Breakdown []EarningsLineResponse `json:"breakdown"`
+}
+
+// CreatePayoutRequest represents an author's request to withdraw their balance
+type CreatePayoutRequest struct {
+	Amount      decimal.Decimal `json:"amount"` // At least earnings.min_payout
+	BankName    string          `json:"bankName" binding:"required,max=100"`
+	AccountNo   string          `json:"accountNo" binding:"required,max=50"`
+	AccountName string          `json:"accountName" binding:"required,max=255"`
+}
+
+// PayoutListRequest represents filters for listing payouts
+type PayoutListRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=pending approved rejected"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ReviewPayoutRequest represents an admin decision on a payout request
+type ReviewPayoutRequest struct {
+	Action    string  `json:"action" binding:"required,oneof=approve reject"`
+	Note      *string `json:"note,omitempty" binding:"omitempty,max=1000"`
+	Reference *string `json:"reference,omitempty" binding:"omitempty,max=255"` // Bank reference of the transfer
+}
+
+// PayoutResponse represents a payout request
+type PayoutResponse struct {
+	ID          uuid.UUID           `json:"id"`
+	AuthorID    uuid.UUID           `json:"authorId"`
+	Amount      decimal.Decimal     `json:"amount"`
+	Currency    string              `json:"currency"`
+	BankName    string              `json:"bankName"`
+	AccountNo   string              `json:"accountNo"`
+	AccountName string              `json:"accountName"`
+	Status      entity.PayoutStatus `json:"status"`
+	Note        *string             `json:"note,omitempty"`
+	Reference   *string             `json:"reference,omitempty"`
+	ReviewedBy  *uuid.UUID          `json:"reviewedBy,omitempty"`
+	ReviewedAt  *time.Time          `json:"reviewedAt,omitempty"`
+	CreatedAt   time.Time           `json:"createdAt"`
+}
+
+// PayoutListResponse represents a page of payouts
+type PayoutListResponse struct {
+	Payouts    []PayoutResponse `json:"payouts"`
+	TotalCount int64            `json:"totalCount"`
+	Page       int              `json:"page"`
+	PageSize   int              `json:"pageSize"`
+	TotalPages int              `json:"totalPages"`
+}
+
+// CreatePromoCodeRequest represents a discount an author offers on their plans
+type CreatePromoCodeRequest struct {
+	Code           string          `json:"code" binding:"required,min=3,max=50"` // Letters, digits, dashes and underscores; matched case-insensitively
+	DiscountType   string          `json:"discountType" binding:"required,oneof=percent fixed"`
+	DiscountValue  decimal.Decimal `json:"discountValue"`                                               // Percentage up to 100, or an amount in the plan's currency
+	Tier           *string         `json:"tier,omitempty" binding:"omitempty,oneof=BRONZE SILVER GOLD"` // Only plans of this tier, any tier when omitted
+	MaxRedemptions *int            `json:"maxRedemptions,omitempty" binding:"omitempty,min=1"`
+	ExpiresAt      *time.Time      `json:"expiresAt,omitempty"`
+}
+
+// PromoCodeResponse represents a promo code of the signed-in author
+type PromoCodeResponse struct {
+	ID              uuid.UUID                `json:"id"`
+	Code            string                   `json:"code"`
+	DiscountType    entity.PromoDiscountType `json:"discountType"`
+	DiscountValue   decimal.Decimal          `json:"discountValue"`
+	Tier            *string                  `json:"tier,omitempty"`
+	MaxRedemptions  *int                     `json:"maxRedemptions,omitempty"`
+	RedemptionCount int                      `json:"redemptionCount"`
+	ExpiresAt       *time.Time               `json:"expiresAt,omitempty"`
+	IsActive        bool                     `json:"isActive"`
+	CreatedAt       time.Time                `json:"createdAt"`
+}
+
+// PromoCodeListResponse represents the promo codes of the signed-in author
+type PromoCodeListResponse struct {
+	PromoCodes []PromoCodeResponse `json:"promoCodes"`
+}
+
+// RedeemGiftRequest represents a gift code entered by its recipient
+type RedeemGiftRequest struct {
+	Code string `json:"code" binding:"required,max=20"`
+}
+
+// GiftListRequest represents pagination for the gifts a user paid for
+type GiftListRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// GiftResponse represents a gift subscription code
+type GiftResponse struct {
+	ID            uuid.UUID         `json:"id"`
+	Code          string            `json:"code"`
+	TransactionID uuid.UUID         `json:"transactionId"`
+	AuthorID      uuid.UUID         `json:"authorId"`
+	PlanID        uuid.UUID         `json:"planId"`
+	Tier          string            `json:"tier"`
+	Status        entity.GiftStatus `json:"status"`
+	RedeemedBy    *uuid.UUID        `json:"redeemedBy,omitempty"`
+	RedeemedAt    *time.Time        `json:"redeemedAt,omitempty"`
+	CreatedAt     time.Time         `json:"createdAt"`
+}
+
+// GiftListResponse represents a page of gifts
+type GiftListResponse struct {
+	Gifts      []GiftResponse `json:"gifts"`
+	TotalCount int64          `json:"totalCount"`
+	Page       int            `json:"page"`
+	PageSize   int            `json:"pageSize"`
+	TotalPages int            `json:"totalPages"`
+}
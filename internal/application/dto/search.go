@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// Search result types accepted by SearchParams.Type
+const (
+	SearchTypeAll     = "all"
+	SearchTypeBlogs   = "blogs"
+	SearchTypeSeries  = "series"
+	SearchTypeTags    = "tags"
+	SearchTypeAuthors = "authors"
+)
+
+// SearchParams represents query parameters for full-text search
+type SearchParams struct {
+	Q          string   `form:"q" binding:"required,max=200"`
+	Type       string   `form:"type,default=all" binding:"omitempty,oneof=all blogs series tags authors"`
+	AuthorID   *string  `form:"authorId"`
+	CategoryID *string  `form:"categoryId"`
+	TagIDs     []string `form:"tagIds"`
+	Page       int      `form:"page,default=1"`
+	PageSize   int      `form:"pageSize,default=10"`
+}
+
+// BlogSearchResultResponse represents a ranked blog match. Highlights are escaped HTML wrapping
+// matched terms in <mark>; locked results need a higher subscription tier or a series purchase to read.
+type BlogSearchResultResponse struct {
+	ID             uuid.UUID             `json:"id"`
+	Title          string                `json:"title"`
+	TitleHighlight string                `json:"titleHighlight"`
+	Slug           string                `json:"slug"`
+	Snippet        string                `json:"snippet"`
+	Author         AuthorBriefResponse   `json:"author"`
+	CategoryID     *uuid.UUID            `json:"categoryId,omitempty"`
+	Visibility     entity.BlogVisibility `json:"visibility"`
+	PublishedAt    *time.Time            `json:"publishedAt,omitempty"`
+	Rank           float64               `json:"rank"`
+	Locked         bool                  `json:"locked"`
+}
+
+// AuthorBriefResponse represents an author without private details
+type AuthorBriefResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+// BlogSearchResults represents one page of blog matches
+type BlogSearchResults struct {
+	Data       []BlogSearchResultResponse `json:"data"`
+	Total      int64                      `json:"total"`
+	Page       int                        `json:"page"`
+	PageSize   int                        `json:"pageSize"`
+	TotalPages int                        `json:"totalPages"`
+}
+
+// SearchFacetResponse represents the number of blog matches for one filter value
+type SearchFacetResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Count int64     `json:"count"`
+}
+
+// SearchFacetsResponse represents blog match counts by category, tag and author
+type SearchFacetsResponse struct {
+	Categories []SearchFacetResponse `json:"categories"`
+	Tags       []SearchFacetResponse `json:"tags"`
+	Authors    []SearchFacetResponse `json:"authors"`
+}
+
+// SearchResultResponse represents a ranked series, tag or author match
+type SearchResultResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Title     string    `json:"title"`
+	Slug      string    `json:"slug,omitempty"`
+	Highlight string    `json:"highlight"`
+	Rank      float64   `json:"rank"`
+}
+
+// SearchResponse represents search results grouped by type. Groups that were
+// not requested are omitted.
+type SearchResponse struct {
+	Blogs   *BlogSearchResults     `json:"blogs,omitempty"`
+	Facets  *SearchFacetsResponse  `json:"facets,omitempty"`
+	Series  []SearchResultResponse `json:"series,omitempty"`
+	Tags    []SearchResultResponse `json:"tags,omitempty"`
+	Authors []SearchResultResponse `json:"authors,omitempty"`
+}
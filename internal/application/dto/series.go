@@ -4,32 +4,48 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
-// CreateSeriesRequest represents the request to create a series
+// CreateSeriesRequest represents the request to create a series.
+// A positive price makes the series paid; currency defaults to VND.
 type CreateSeriesRequest struct {
-	Title       string `json:"title" binding:"required,max=255"`
-	Slug        string `json:"slug" binding:"required,max=255"`
-	Description string `json:"description"`
+	Title       string           `json:"title" binding:"required,max=255"`
+	Slug        string           `json:"slug" binding:"required,max=255"`
+	Description string           `json:"description"`
+	Price       *decimal.Decimal `json:"price,omitempty"`
+	Currency    string           `json:"currency" binding:"omitempty,iso4217"`
 }
 
 // UpdateSeriesRequest represents the request to update a series
 type UpdateSeriesRequest struct {
-	Title       string `json:"title" binding:"omitempty,max=255"`
-	Description string `json:"description"`
+	Title       string           `json:"title" binding:"omitempty,max=255"`
+	Description string           `json:"description"`
+	Price       *decimal.Decimal `json:"price,omitempty"`
+	Currency    *string          `json:"currency,omitempty" binding:"omitempty,iso4217"`
 }
 
 // SeriesResponse represents a series in API responses
 type SeriesResponse struct {
-	ID          uuid.UUID          `json:"id"`
-	AuthorID    uuid.UUID          `json:"authorId"`
-	Title       string             `json:"title"`
-	Slug        string             `json:"slug"`
-	Description string             `json:"description"`
-	CreatedAt   time.Time          `json:"createdAt"`
-	UpdatedAt   time.Time          `json:"updatedAt"`
-	Blogs       []BlogListResponse `json:"blogs,omitempty"`
-	Author      *UserBriefResponse `json:"author,omitempty"`
+	ID             uuid.UUID               `json:"id"`
+	AuthorID       uuid.UUID               `json:"authorId"`
+	Title          string                  `json:"title"`
+	Slug           string                  `json:"slug"`
+	Description    string                  `json:"description"`
+	Price          decimal.Decimal         `json:"price"`
+	Currency       string                  `json:"currency"`
+	CreatedAt      time.Time               `json:"createdAt"`
+	UpdatedAt      time.Time               `json:"updatedAt"`
+	Blogs          []SeriesChapterResponse `json:"blogs,omitempty"`
+	PreviewBlogIDs []uuid.UUID             `json:"previewBlogIds,omitempty"`
+	Author         *UserBriefResponse      `json:"author,omitempty"`
+}
+
+// SeriesChapterResponse represents a blog in a series with its 1-based chapter number among
+// the live chapters; drafts and scheduled chapters have none
+type SeriesChapterResponse struct {
+	BlogListResponse
+	ChapterNumber int `json:"chapterNumber,omitempty"`
 }
 
 // AddBlogToSeriesRequest represents the request to add a blog to a series
@@ -37,6 +53,35 @@ type AddBlogToSeriesRequest struct {
 	BlogID uuid.UUID `json:"blogId" binding:"required"`
 }
 
+// ReorderSeriesBlogsRequest represents the request to reorder chapters.
+// BlogIDs must list every chapter of the series exactly once.
+type ReorderSeriesBlogsRequest struct {
+	BlogIDs []uuid.UUID `json:"blogIds" binding:"required,min=1"`
+}
+
+// SeriesProgressResponse represents how far a user has read through the published chapters of a series
+type SeriesProgressResponse struct {
+	SeriesID       uuid.UUID  `json:"seriesId"`
+	TotalChapters  int        `json:"totalChapters"`
+	ReadChapters   int        `json:"readChapters"`
+	Percent        int        `json:"percent"`
+	LastReadBlogID *uuid.UUID `json:"lastReadBlogId,omitempty"`
+	LastReadAt     *time.Time `json:"lastReadAt,omitempty"`
+	NextBlogID     *uuid.UUID `json:"nextBlogId,omitempty"`
+}
+
+// SetSeriesBlogPreviewRequest represents the request to mark a chapter as a free preview
+type SetSeriesBlogPreviewRequest struct {
+	Preview *bool `json:"preview" binding:"required"`
+}
+
+// LibraryItemResponse represents a series the user bought
+type LibraryItemResponse struct {
+	Series      SeriesResponse  `json:"series"`
+	Amount      decimal.Decimal `json:"amount"`
+	PurchasedAt time.Time       `json:"purchasedAt"`
+}
+
 // SeriesFilterParams represents query parameters for filtering series
 type SeriesFilterParams struct {
 	AuthorID *string `form:"authorId"`
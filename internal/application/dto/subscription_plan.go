@@ -41,6 +41,7 @@ type UpsertPlansResponse struct {
 
 // PlanWithTagsResponse represents a plan with associated tags and counts
 type PlanWithTagsResponse struct {
+	ID           uuid.UUID       `json:"id"`
 	Tier         string          `json:"tier"`
 	Price        decimal.Decimal `json:"price"`
 	DurationDays int             `json:"durationDays"`
@@ -56,6 +57,19 @@ type GetAuthorPlansResponse struct {
 	Plans    []PlanWithTagsResponse `json:"plans"`
 }
 
+// SubscriptionQuoteResponse represents the price of switching the current user to a plan
+type SubscriptionQuoteResponse struct {
+	PlanID      uuid.UUID       `json:"planId"`
+	Tier        string          `json:"tier"`
+	CurrentTier string          `json:"currentTier"`
+	Change      string          `json:"change"` // new, renewal, upgrade or downgrade
+	Price       decimal.Decimal `json:"price"`
+	Credit      decimal.Decimal `json:"credit"`
+	AmountDue   decimal.Decimal `json:"amountDue"`
+	StartsAt    string          `json:"startsAt"`
+	ExpiresAt   string          `json:"expiresAt"`
+}
+
 // ===== Tag-Tier Mapping DTOs =====
 
 // AssignTagTierRequest represents the request to assign a tag to a tier
@@ -96,15 +110,25 @@ type GetTagTiersResponse struct {
 type UpgradeOption struct {
 	Tier         string          `json:"tier"`
 	Price        decimal.Decimal `json:"price"`
+	AmountDue    decimal.Decimal `json:"amountDue"` // Price minus the credit for the unused days of the current tier
 	DurationDays int             `json:"durationDays"`
 	PlanID       uuid.UUID       `json:"planId"`
 }
 
+// SeriesPurchaseOption represents a paid series whose purchase unlocks a blog
+type SeriesPurchaseOption struct {
+	SeriesID uuid.UUID       `json:"seriesId"`
+	Title    string          `json:"title"`
+	Price    decimal.Decimal `json:"price"`
+	Currency string          `json:"currency"`
+}
+
 // CheckBlogAccessResponse represents the response for checking blog access
 type CheckBlogAccessResponse struct {
-	Accessible     bool            `json:"accessible"`
-	UserTier       string          `json:"userTier"`
-	RequiredTier   string          `json:"requiredTier"`
-	Reason         string          `json:"reason"`
-	UpgradeOptions []UpgradeOption `json:"upgradeOptions,omitempty"`
+	Accessible     bool                   `json:"accessible"`
+	UserTier       string                 `json:"userTier"`
+	RequiredTier   string                 `json:"requiredTier"`
+	Reason         string                 `json:"reason"`
+	UpgradeOptions []UpgradeOption        `json:"upgradeOptions,omitempty"`
+	SeriesOptions  []SeriesPurchaseOption `json:"seriesOptions,omitempty"`
 }
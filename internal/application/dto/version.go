@@ -50,3 +50,74 @@ type VersionListResponse struct {
 	PageSize   int               `json:"pageSize"`
 	TotalPages int               `json:"totalPages"`
 }
+
+// VersionRef identifies a version in a diff
+type VersionRef struct {
+	ID            uuid.UUID `json:"id"`
+	VersionNumber int       `json:"versionNumber"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// VersionFieldChange represents a field that differs between two versions. Categories are given
+// by name and tags as sorted lists of names, with the tags added and removed.
+type VersionFieldChange struct {
+	Field   string      `json:"field"` // title, slug, visibility, category or tags
+	From    interface{} `json:"from"`
+	To      interface{} `json:"to"`
+	Added   []string    `json:"added,omitempty"`
+	Removed []string    `json:"removed,omitempty"`
+}
+
+// DiffSegment is a run of words of a changed line
+type DiffSegment struct {
+	Op   string `json:"op"` // equal, insert or delete
+	Text string `json:"text"`
+}
+
+// DiffLine is a line of a diff hunk. Words are set on a deleted line and the inserted line
+// replacing it.
+type DiffLine struct {
+	Op        string        `json:"op"` // equal, insert or delete
+	Text      string        `json:"text"`
+	OldNumber int           `json:"oldNumber,omitempty"`
+	NewNumber int           `json:"newNumber,omitempty"`
+	Words     []DiffSegment `json:"words,omitempty"`
+}
+
+// DiffHunk is a group of changed lines with the unchanged lines around them
+type DiffHunk struct {
+	OldStart int        `json:"oldStart"`
+	OldLines int        `json:"oldLines"`
+	NewStart int        `json:"newStart"`
+	NewLines int        `json:"newLines"`
+	Lines    []DiffLine `json:"lines"`
+}
+
+// ContentDiffResponse represents the line changes of the content
+type ContentDiffResponse struct {
+	Additions int        `json:"additions"`
+	Deletions int        `json:"deletions"`
+	Hunks     []DiffHunk `json:"hunks"`
+}
+
+// VersionDiffResponse represents the changes from one version to another
+type VersionDiffResponse struct {
+	From    VersionRef           `json:"from"`
+	To      VersionRef           `json:"to"`
+	Fields  []VersionFieldChange `json:"fields"`
+	Content ContentDiffResponse  `json:"content"`
+	Unified string               `json:"unified"` // Metadata and content changes as a unified diff
+}
+
+// VersionRetentionRequest represents the request to set how many versions of a blog are kept
+type VersionRetentionRequest struct {
+	MaxVersions int  `json:"maxVersions" binding:"required,min=1,max=200"`
+	MaxAgeDays  *int `json:"maxAgeDays,omitempty" binding:"omitempty,min=1"` // Versions older than this are pruned, the latest excepted
+}
+
+// VersionRetentionResponse represents the version retention policy of a blog
+type VersionRetentionResponse struct {
+	MaxVersions int        `json:"maxVersions"`
+	MaxAgeDays  *int       `json:"maxAgeDays,omitempty"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"` // Not set for the default policy
+}
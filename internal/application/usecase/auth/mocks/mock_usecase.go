@@ -14,6 +14,8 @@ import (
 	reflect "reflect"
 
 	dto "github.com/aiagent/internal/application/dto"
+	valueobject "github.com/aiagent/internal/domain/valueobject"
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,13 +43,87 @@ func (m *MockAuthUseCase) EXPECT() *MockAuthUseCaseMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthUseCase) ChangePassword(ctx context.Context, userID uuid.UUID, req dto.ChangePasswordRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, req, client)
+	ret0, _ := ret[0].(*dto.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthUseCaseMockRecorder) ChangePassword(ctx, userID, req, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthUseCase)(nil).ChangePassword), ctx, userID, req, client)
+}
+
+// CreateAPIToken mocks base method.
+func (m *MockAuthUseCase) CreateAPIToken(ctx context.Context, userID uuid.UUID, req dto.CreateAPITokenRequest) (*dto.CreateAPITokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIToken", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.CreateAPITokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIToken indicates an expected call of CreateAPIToken.
+func (mr *MockAuthUseCaseMockRecorder) CreateAPIToken(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIToken", reflect.TypeOf((*MockAuthUseCase)(nil).CreateAPIToken), ctx, userID, req)
+}
+
+// DisableTwoFactor mocks base method.
+func (m *MockAuthUseCase) DisableTwoFactor(ctx context.Context, userID uuid.UUID, req dto.DisableTwoFactorRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", ctx, userID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockAuthUseCaseMockRecorder) DisableTwoFactor(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockAuthUseCase)(nil).DisableTwoFactor), ctx, userID, req)
+}
+
+// EnableTwoFactor mocks base method.
+func (m *MockAuthUseCase) EnableTwoFactor(ctx context.Context, userID uuid.UUID, sessionID, code string) (*dto.RecoveryCodesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTwoFactor", ctx, userID, sessionID, code)
+	ret0, _ := ret[0].(*dto.RecoveryCodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableTwoFactor indicates an expected call of EnableTwoFactor.
+func (mr *MockAuthUseCaseMockRecorder) EnableTwoFactor(ctx, userID, sessionID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTwoFactor", reflect.TypeOf((*MockAuthUseCase)(nil).EnableTwoFactor), ctx, userID, sessionID, code)
+}
+
+// ForgotPassword mocks base method.
+func (m *MockAuthUseCase) ForgotPassword(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPassword", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForgotPassword indicates an expected call of ForgotPassword.
+func (mr *MockAuthUseCaseMockRecorder) ForgotPassword(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPassword", reflect.TypeOf((*MockAuthUseCase)(nil).ForgotPassword), ctx, email)
+}
+
 // GetSocialAuthURL mocks base method.
-func (m *MockAuthUseCase) GetSocialAuthURL(ctx context.Context, provider string) (string, error) {
+func (m *MockAuthUseCase) GetSocialAuthURL(ctx context.Context, provider string) (string, string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSocialAuthURL", ctx, provider)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetSocialAuthURL indicates an expected call of GetSocialAuthURL.
@@ -56,34 +132,79 @@ func (mr *MockAuthUseCaseMockRecorder) GetSocialAuthURL(ctx, provider any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSocialAuthURL", reflect.TypeOf((*MockAuthUseCase)(nil).GetSocialAuthURL), ctx, provider)
 }
 
+// GetTwoFactorStatus mocks base method.
+func (m *MockAuthUseCase) GetTwoFactorStatus(ctx context.Context, userID uuid.UUID) (*dto.TwoFactorStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwoFactorStatus", ctx, userID)
+	ret0, _ := ret[0].(*dto.TwoFactorStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwoFactorStatus indicates an expected call of GetTwoFactorStatus.
+func (mr *MockAuthUseCaseMockRecorder) GetTwoFactorStatus(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactorStatus", reflect.TypeOf((*MockAuthUseCase)(nil).GetTwoFactorStatus), ctx, userID)
+}
+
+// ListAPITokens mocks base method.
+func (m *MockAuthUseCase) ListAPITokens(ctx context.Context, userID uuid.UUID) ([]dto.APITokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPITokens", ctx, userID)
+	ret0, _ := ret[0].([]dto.APITokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokens indicates an expected call of ListAPITokens.
+func (mr *MockAuthUseCaseMockRecorder) ListAPITokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokens", reflect.TypeOf((*MockAuthUseCase)(nil).ListAPITokens), ctx, userID)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthUseCase) ListSessions(ctx context.Context, userID uuid.UUID, currentSessionID string) ([]dto.SessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userID, currentSessionID)
+	ret0, _ := ret[0].([]dto.SessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthUseCaseMockRecorder) ListSessions(ctx, userID, currentSessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthUseCase)(nil).ListSessions), ctx, userID, currentSessionID)
+}
+
 // Login mocks base method.
-func (m *MockAuthUseCase) Login(ctx context.Context, req dto.LoginRequest) (*dto.AuthResponse, error) {
+func (m *MockAuthUseCase) Login(ctx context.Context, req dto.LoginRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, req)
+	ret := m.ctrl.Call(m, "Login", ctx, req, client)
 	ret0, _ := ret[0].(*dto.AuthResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockAuthUseCaseMockRecorder) Login(ctx, req any) *gomock.Call {
+func (mr *MockAuthUseCaseMockRecorder) Login(ctx, req, client any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthUseCase)(nil).Login), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthUseCase)(nil).Login), ctx, req, client)
 }
 
 // LoginWithSocial mocks base method.
-func (m *MockAuthUseCase) LoginWithSocial(ctx context.Context, req dto.LoginWithSocialRequest) (*dto.AuthResponse, error) {
+func (m *MockAuthUseCase) LoginWithSocial(ctx context.Context, req dto.LoginWithSocialRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LoginWithSocial", ctx, req)
+	ret := m.ctrl.Call(m, "LoginWithSocial", ctx, req, client)
 	ret0, _ := ret[0].(*dto.AuthResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LoginWithSocial indicates an expected call of LoginWithSocial.
-func (mr *MockAuthUseCaseMockRecorder) LoginWithSocial(ctx, req any) *gomock.Call {
+func (mr *MockAuthUseCaseMockRecorder) LoginWithSocial(ctx, req, client any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginWithSocial", reflect.TypeOf((*MockAuthUseCase)(nil).LoginWithSocial), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginWithSocial", reflect.TypeOf((*MockAuthUseCase)(nil).LoginWithSocial), ctx, req, client)
 }
 
 // Logout mocks base method.
@@ -100,6 +221,35 @@ func (mr *MockAuthUseCaseMockRecorder) Logout(ctx, sessionID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthUseCase)(nil).Logout), ctx, sessionID)
 }
 
+// LogoutEverywhere mocks base method.
+func (m *MockAuthUseCase) LogoutEverywhere(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutEverywhere", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogoutEverywhere indicates an expected call of LogoutEverywhere.
+func (mr *MockAuthUseCaseMockRecorder) LogoutEverywhere(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutEverywhere", reflect.TypeOf((*MockAuthUseCase)(nil).LogoutEverywhere), ctx, userID)
+}
+
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockAuthUseCase) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) (*dto.RecoveryCodesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", ctx, userID, code)
+	ret0, _ := ret[0].(*dto.RecoveryCodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockAuthUseCaseMockRecorder) RegenerateRecoveryCodes(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockAuthUseCase)(nil).RegenerateRecoveryCodes), ctx, userID, code)
+}
+
 // Register mocks base method.
 func (m *MockAuthUseCase) Register(ctx context.Context, req dto.RegisterRequest) (*dto.AuthResponse, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +265,77 @@ func (mr *MockAuthUseCaseMockRecorder) Register(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthUseCase)(nil).Register), ctx, req)
 }
 
+// ResendVerification mocks base method.
+func (m *MockAuthUseCase) ResendVerification(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendVerification", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendVerification indicates an expected call of ResendVerification.
+func (mr *MockAuthUseCaseMockRecorder) ResendVerification(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendVerification", reflect.TypeOf((*MockAuthUseCase)(nil).ResendVerification), ctx, email)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthUseCase) ResetPassword(ctx context.Context, req dto.ResetPasswordRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthUseCaseMockRecorder) ResetPassword(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthUseCase)(nil).ResetPassword), ctx, req)
+}
+
+// RevokeAPIToken mocks base method.
+func (m *MockAuthUseCase) RevokeAPIToken(ctx context.Context, userID, tokenID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIToken", ctx, userID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIToken indicates an expected call of RevokeAPIToken.
+func (mr *MockAuthUseCaseMockRecorder) RevokeAPIToken(ctx, userID, tokenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIToken", reflect.TypeOf((*MockAuthUseCase)(nil).RevokeAPIToken), ctx, userID, tokenID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthUseCase) RevokeSession(ctx context.Context, userID uuid.UUID, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthUseCaseMockRecorder) RevokeSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthUseCase)(nil).RevokeSession), ctx, userID, sessionID)
+}
+
+// SetupTwoFactor mocks base method.
+func (m *MockAuthUseCase) SetupTwoFactor(ctx context.Context, userID uuid.UUID) (*dto.TwoFactorSetupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetupTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(*dto.TwoFactorSetupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetupTwoFactor indicates an expected call of SetupTwoFactor.
+func (mr *MockAuthUseCaseMockRecorder) SetupTwoFactor(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupTwoFactor", reflect.TypeOf((*MockAuthUseCase)(nil).SetupTwoFactor), ctx, userID)
+}
+
 // VerifyEmail mocks base method.
 func (m *MockAuthUseCase) VerifyEmail(ctx context.Context, token string) error {
 	m.ctrl.T.Helper()
@@ -128,3 +349,18 @@ func (mr *MockAuthUseCaseMockRecorder) VerifyEmail(ctx, token any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthUseCase)(nil).VerifyEmail), ctx, token)
 }
+
+// VerifyTwoFactorLogin mocks base method.
+func (m *MockAuthUseCase) VerifyTwoFactorLogin(ctx context.Context, req dto.TwoFactorLoginRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTwoFactorLogin", ctx, req, client)
+	ret0, _ := ret[0].(*dto.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyTwoFactorLogin indicates an expected call of VerifyTwoFactorLogin.
+func (mr *MockAuthUseCaseMockRecorder) VerifyTwoFactorLogin(ctx, req, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTwoFactorLogin", reflect.TypeOf((*MockAuthUseCase)(nil).VerifyTwoFactorLogin), ctx, req, client)
+}
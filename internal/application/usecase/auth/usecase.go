@@ -4,6 +4,8 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"time"
@@ -12,24 +14,62 @@ import (
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
 	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/domain/valueobject"
+	"github.com/aiagent/pkg/logger"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type AuthUseCase interface {
 	Register(ctx context.Context, req dto.RegisterRequest) (*dto.AuthResponse, error)
-	Login(ctx context.Context, req dto.LoginRequest) (*dto.AuthResponse, error)
-	LoginWithSocial(ctx context.Context, req dto.LoginWithSocialRequest) (*dto.AuthResponse, error)
-	GetSocialAuthURL(ctx context.Context, provider string) (string, error)
+	Login(ctx context.Context, req dto.LoginRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error)
+	LoginWithSocial(ctx context.Context, req dto.LoginWithSocialRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error)
+	GetSocialAuthURL(ctx context.Context, provider string) (authURL string, state string, err error)
 	Logout(ctx context.Context, sessionID string) error
 	VerifyEmail(ctx context.Context, token string) error
+	ResendVerification(ctx context.Context, email string) error
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, req dto.ResetPasswordRequest) error
+	ChangePassword(ctx context.Context, userID uuid.UUID, req dto.ChangePasswordRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error)
+	ListSessions(ctx context.Context, userID uuid.UUID, currentSessionID string) ([]dto.SessionResponse, error)
+	RevokeSession(ctx context.Context, userID uuid.UUID, sessionID string) error
+	LogoutEverywhere(ctx context.Context, userID uuid.UUID) error
+	VerifyTwoFactorLogin(ctx context.Context, req dto.TwoFactorLoginRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error)
+	GetTwoFactorStatus(ctx context.Context, userID uuid.UUID) (*dto.TwoFactorStatusResponse, error)
+	SetupTwoFactor(ctx context.Context, userID uuid.UUID) (*dto.TwoFactorSetupResponse, error)
+	EnableTwoFactor(ctx context.Context, userID uuid.UUID, sessionID string, code string) (*dto.RecoveryCodesResponse, error)
+	DisableTwoFactor(ctx context.Context, userID uuid.UUID, req dto.DisableTwoFactorRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) (*dto.RecoveryCodesResponse, error)
+	CreateAPIToken(ctx context.Context, userID uuid.UUID, req dto.CreateAPITokenRequest) (*dto.CreateAPITokenResponse, error)
+	ListAPITokens(ctx context.Context, userID uuid.UUID) ([]dto.APITokenResponse, error)
+	RevokeAPIToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error
 }
 
+var (
+	ErrInvalidToken      = errors.New("invalid or expired token")
+	ErrIncorrectPassword = errors.New("current password is incorrect")
+	ErrSessionNotFound   = errors.New("session not found")
+	// ErrSocialEmailNotVerified is returned when a social login would link an existing account
+	// through an address the provider does not vouch for
+	ErrSocialEmailNotVerified = errors.New("email is not verified by the provider")
+)
+
+const (
+	verificationTokenTTL  = 24 * time.Hour
+	passwordResetTokenTTL = time.Hour
+	// Time between the password step and the second factor of a login
+	twoFactorChallengeTTL = 5 * time.Minute
+)
+
 type authUseCase struct {
 	userRepo          repository.UserRepository
 	sessionRepo       repository.SessionRepository
 	socialRepo        repository.SocialAccountRepository
 	socialAuthService service.SocialAuthService
+	tokenRepo         repository.AuthTokenRepository
+	emailService      service.EmailService
+	twoFactorService  service.TwoFactorService
+	apiTokenService   service.APITokenService
 }
 
 func NewAuthUseCase(
@@ -37,12 +77,20 @@ func NewAuthUseCase(
 	sessionRepo repository.SessionRepository,
 	socialRepo repository.SocialAccountRepository,
 	socialAuthService service.SocialAuthService,
+	tokenRepo repository.AuthTokenRepository,
+	emailService service.EmailService,
+	twoFactorService service.TwoFactorService,
+	apiTokenService service.APITokenService,
 ) AuthUseCase {
 	return &authUseCase{
 		userRepo:          userRepo,
 		sessionRepo:       sessionRepo,
 		socialRepo:        socialRepo,
 		socialAuthService: socialAuthService,
+		tokenRepo:         tokenRepo,
+		emailService:      emailService,
+		twoFactorService:  twoFactorService,
+		apiTokenService:   apiTokenService,
 	}
 }
 
@@ -77,17 +125,11 @@ func (u *authUseCase) Register(ctx context.Context, req dto.RegisterRequest) (*d
 		return nil, err
 	}
 
-	// Generate verification token
-	verificationToken := uuid.New().String()
-	// Store token in session repo (reuse generic session storage)
-	// Using a prefix or separate store would be better, but for now we reuse session repo
-	if err := u.sessionRepo.CreateSession(ctx, verificationToken, user.ID.String(), 24*time.Hour); err != nil {
-		return nil, fmt.Errorf("failed to create verification token: %w", err)
+	// The account exists now; a failed email can be retried via resend-verification
+	if err := u.sendVerification(ctx, user); err != nil {
+		logger.Error("failed to send verification email", err, map[string]interface{}{"user_id": user.ID})
 	}
 
-	// Stub: Send email
-	fmt.Printf("[STUB] Sending verification email to %s with token: %s\n", user.Email, verificationToken)
-
 	return &dto.AuthResponse{
 		UserID: user.ID,
 		Email:  user.Email,
@@ -95,7 +137,7 @@ func (u *authUseCase) Register(ctx context.Context, req dto.RegisterRequest) (*d
 	}, nil
 }
 
-func (u *authUseCase) Login(ctx context.Context, req dto.LoginRequest) (*dto.AuthResponse, error) {
+func (u *authUseCase) Login(ctx context.Context, req dto.LoginRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
 	// Find user
 	user, err := u.userRepo.FindByEmail(ctx, req.Email)
 	if err != nil {
@@ -115,19 +157,7 @@ func (u *authUseCase) Login(ctx context.Context, req dto.LoginRequest) (*dto.Aut
 		return nil, errors.New("email not verified")
 	}
 
-	// Generate session
-	sessionID := uuid.New().String()
-	// Create session (24h validity)
-	if err := u.sessionRepo.CreateSession(ctx, sessionID, user.ID.String(), 24*time.Hour); err != nil {
-		return nil, err
-	}
-
-	return &dto.AuthResponse{
-		SessionID: sessionID,
-		UserID:    user.ID,
-		Email:     user.Email,
-		Name:      user.Name,
-	}, nil
+	return u.completeLogin(ctx, user, client)
 }
 
 func (u *authUseCase) Logout(ctx context.Context, sessionID string) error {
@@ -135,14 +165,12 @@ func (u *authUseCase) Logout(ctx context.Context, sessionID string) error {
 }
 
 func (u *authUseCase) VerifyEmail(ctx context.Context, token string) error {
-	userIDStr, err := u.sessionRepo.GetUserID(ctx, token)
+	userID, err := u.tokenRepo.Consume(ctx, repository.AuthTokenEmailVerification, token)
 	if err != nil {
-		return errors.New("invalid or expired verification token")
+		return err
 	}
-
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
-		return errors.New("invalid user ID in token")
+	if userID == uuid.Nil {
+		return ErrInvalidToken
 	}
 
 	user, err := u.userRepo.FindByID(ctx, userID)
@@ -161,19 +189,389 @@ func (u *authUseCase) VerifyEmail(ctx context.Context, token string) error {
 	user.EmailVerifiedAt = &now
 	user.UpdatedAt = now
 
-	if err := u.userRepo.Update(ctx, user); err != nil {
+	return u.userRepo.Update(ctx, user)
+}
+
+// ResendVerification mails a fresh verification link. Unknown and already
+// verified addresses succeed silently so the endpoint does not reveal accounts.
+func (u *authUseCase) ResendVerification(ctx context.Context, email string) error {
+	user, err := u.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.EmailVerifiedAt != nil {
+		return nil
+	}
+	return u.sendVerification(ctx, user)
+}
+
+// ForgotPassword mails a password reset link; unknown addresses succeed silently
+func (u *authUseCase) ForgotPassword(ctx context.Context, email string) error {
+	user, err := u.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.IsActive {
+		return nil
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return err
+	}
+	if err := u.tokenRepo.Create(ctx, repository.AuthTokenPasswordReset, token, user.ID, passwordResetTokenTTL); err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+	return u.emailService.SendPasswordResetEmail(ctx, user.ID, user.Email, token, passwordResetTokenTTL)
+}
+
+// ResetPassword sets a new password from a reset token and signs the user out everywhere
+func (u *authUseCase) ResetPassword(ctx context.Context, req dto.ResetPasswordRequest) error {
+	userID, err := u.tokenRepo.Consume(ctx, repository.AuthTokenPasswordReset, req.Token)
+	if err != nil {
+		return err
+	}
+	if userID == uuid.Nil {
+		return ErrInvalidToken
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("user not found")
+	}
+
+	// Receiving the reset email proves the address, so verify it as well
+	now := time.Now()
+	if user.EmailVerifiedAt == nil {
+		user.EmailVerifiedAt = &now
+	}
+	if err := u.setPassword(ctx, user, req.NewPassword); err != nil {
+		return err
+	}
+	return u.sessionRepo.DeleteUserSessions(ctx, user.ID.String(), "")
+}
+
+// ChangePassword replaces the password, revokes every session and rotates the
+// caller onto a fresh session so a leaked session ID stops working too
+func (u *authUseCase) ChangePassword(ctx context.Context, userID uuid.UUID, req dto.ChangePasswordRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	// Social-only accounts have no password yet and may set one
+	if user.PasswordHash != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.CurrentPassword)); err != nil {
+			return nil, ErrIncorrectPassword
+		}
+	}
+
+	if err := u.setPassword(ctx, user, req.NewPassword); err != nil {
+		return nil, err
+	}
+	if err := u.sessionRepo.DeleteUserSessions(ctx, user.ID.String(), ""); err != nil {
+		return nil, err
+	}
+	return u.startSession(ctx, user, client)
+}
+
+// ListSessions returns the user's signed-in devices, flagging the one making the request
+func (u *authUseCase) ListSessions(ctx context.Context, userID uuid.UUID, currentSessionID string) ([]dto.SessionResponse, error) {
+	sessions, err := u.sessionRepo.ListUserSessions(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		resp = append(resp, dto.SessionResponse{
+			ID:         s.ID,
+			Device:     s.Device,
+			IPAddress:  s.IPAddress,
+			UserAgent:  s.UserAgent,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			ExpiresAt:  s.ExpiresAt,
+			Current:    s.ID == currentSessionID,
+		})
+	}
+	return resp, nil
+}
+
+// RevokeSession signs out one of the user's devices
+func (u *authUseCase) RevokeSession(ctx context.Context, userID uuid.UUID, sessionID string) error {
+	session, err := u.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
 		return err
 	}
+	// Someone else's session is reported as missing so IDs cannot be probed
+	if session == nil || session.UserID != userID {
+		return ErrSessionNotFound
+	}
+	return u.sessionRepo.DeleteSession(ctx, sessionID)
+}
+
+// LogoutEverywhere revokes every session of the user, including the current one
+func (u *authUseCase) LogoutEverywhere(ctx context.Context, userID uuid.UUID) error {
+	return u.sessionRepo.DeleteUserSessions(ctx, userID.String(), "")
+}
+
+// VerifyTwoFactorLogin finishes a login with the second factor. The challenge is
+// single-use, so a wrong code means starting over from the password step.
+func (u *authUseCase) VerifyTwoFactorLogin(ctx context.Context, req dto.TwoFactorLoginRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
+	userID, err := u.tokenRepo.Consume(ctx, repository.AuthTokenTwoFactorChallenge, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	if userID == uuid.Nil {
+		return nil, ErrInvalidToken
+	}
+
+	if err := u.twoFactorService.Verify(ctx, userID, req.Code); err != nil {
+		return nil, err
+	}
 
-	// Invalidate token
-	_ = u.sessionRepo.DeleteSession(ctx, token)
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+	return u.startSession(ctx, user, client)
+}
 
-	return nil
+func (u *authUseCase) GetTwoFactorStatus(ctx context.Context, userID uuid.UUID) (*dto.TwoFactorStatusResponse, error) {
+	status, err := u.twoFactorService.GetStatus(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.TwoFactorStatusResponse{
+		Enabled:                status.Enabled,
+		EnabledAt:              status.EnabledAt,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+	}, nil
 }
 
-func (u *authUseCase) LoginWithSocial(ctx context.Context, req dto.LoginWithSocialRequest) (*dto.AuthResponse, error) {
+func (u *authUseCase) SetupTwoFactor(ctx context.Context, userID uuid.UUID) (*dto.TwoFactorSetupResponse, error) {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	setup, err := u.twoFactorService.Setup(ctx, userID, user.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.TwoFactorSetupResponse{
+		Secret:          setup.Secret,
+		ProvisioningURI: setup.ProvisioningURI,
+	}, nil
+}
+
+// EnableTwoFactor confirms enrollment and signs out every other session, since
+// those were created without the second factor
+func (u *authUseCase) EnableTwoFactor(ctx context.Context, userID uuid.UUID, sessionID string, code string) (*dto.RecoveryCodesResponse, error) {
+	codes, err := u.twoFactorService.Enable(ctx, userID, code)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.sessionRepo.DeleteUserSessions(ctx, userID.String(), sessionID); err != nil {
+		return nil, err
+	}
+	return &dto.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableTwoFactor turns two-factor authentication off once the user proved who they
+// are again: a two-factor code is checked when given, the current password otherwise
+func (u *authUseCase) DisableTwoFactor(ctx context.Context, userID uuid.UUID, req dto.DisableTwoFactorRequest) error {
+	if req.Code != "" {
+		if err := u.twoFactorService.Verify(ctx, userID, req.Code); err != nil {
+			return err
+		}
+		return u.twoFactorService.Disable(ctx, userID)
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("user not found")
+	}
+	// Social-only accounts have no password and must confirm with a code
+	if user.PasswordHash == "" || bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.CurrentPassword)) != nil {
+		return ErrIncorrectPassword
+	}
+	return u.twoFactorService.Disable(ctx, userID)
+}
+
+func (u *authUseCase) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) (*dto.RecoveryCodesResponse, error) {
+	codes, err := u.twoFactorService.RegenerateRecoveryCodes(ctx, userID, code)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// CreateAPIToken issues a personal access token. Scopes only narrow what the
+// token may do; the user's roles are still checked on every request.
+func (u *authUseCase) CreateAPIToken(ctx context.Context, userID uuid.UUID, req dto.CreateAPITokenRequest) (*dto.CreateAPITokenResponse, error) {
+	scopes := make(entity.APITokenScopes, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		scopes[scope.Resource] |= entity.Permission(scope.Permissions)
+	}
+	ttl := time.Duration(req.ExpiresInDays) * 24 * time.Hour
+
+	created, err := u.apiTokenService.Create(ctx, userID, req.Name, scopes, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.CreateAPITokenResponse{
+		APITokenResponse: toAPITokenResponse(created.Token),
+		Token:            created.Secret,
+	}, nil
+}
+
+func (u *authUseCase) ListAPITokens(ctx context.Context, userID uuid.UUID) ([]dto.APITokenResponse, error) {
+	tokens, err := u.apiTokenService.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.APITokenResponse, 0, len(tokens))
+	for i := range tokens {
+		resp = append(resp, toAPITokenResponse(&tokens[i]))
+	}
+	return resp, nil
+}
+
+func (u *authUseCase) RevokeAPIToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error {
+	return u.apiTokenService.Revoke(ctx, userID, tokenID)
+}
+
+// completeLogin starts a session, or issues a two-factor challenge when the account has 2FA enabled
+func (u *authUseCase) completeLogin(ctx context.Context, user *entity.User, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
+	enabled, err := u.twoFactorService.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return u.startSession(ctx, user, client)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	if err := u.tokenRepo.Create(ctx, repository.AuthTokenTwoFactorChallenge, token, user.ID, twoFactorChallengeTTL); err != nil {
+		return nil, fmt.Errorf("failed to create two-factor challenge: %w", err)
+	}
+
+	return &dto.AuthResponse{
+		TwoFactorRequired: true,
+		ChallengeToken:    token,
+		UserID:            user.ID,
+		Email:             user.Email,
+		Name:              user.Name,
+	}, nil
+}
+
+func (u *authUseCase) startSession(ctx context.Context, user *entity.User, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
+	now := time.Now()
+	session := &entity.Session{
+		ID:         uuid.New().String(),
+		UserID:     user.ID,
+		Device:     client.DeviceName(),
+		IPAddress:  client.IPAddress,
+		UserAgent:  client.UserAgent,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+	if err := u.sessionRepo.CreateSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthResponse{
+		SessionID:        session.ID,
+		SessionExpiresAt: &session.ExpiresAt,
+		UserID:           user.ID,
+		Email:            user.Email,
+		Name:             user.Name,
+	}, nil
+}
+
+func (u *authUseCase) setPassword(ctx context.Context, user *entity.User, password string) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	user.PasswordHash = string(hashedPassword)
+	user.UpdatedAt = time.Now()
+	return u.userRepo.Update(ctx, user)
+}
+
+func (u *authUseCase) sendVerification(ctx context.Context, user *entity.User) error {
+	token, err := newToken()
+	if err != nil {
+		return err
+	}
+	if err := u.tokenRepo.Create(ctx, repository.AuthTokenEmailVerification, token, user.ID, verificationTokenTTL); err != nil {
+		return fmt.Errorf("failed to create verification token: %w", err)
+	}
+	return u.emailService.SendVerificationEmail(ctx, user.ID, user.Email, token)
+}
+
+func toAPITokenResponse(token *entity.APIToken) dto.APITokenResponse {
+	scopes := make([]dto.PermissionResponse, 0, len(token.Scopes))
+	for _, resource := range entity.APITokenResources {
+		perm, ok := token.Scopes[resource]
+		if !ok {
+			continue
+		}
+		scopes = append(scopes, dto.PermissionResponse{
+			Resource:    resource,
+			Permissions: int(perm),
+			CanRead:     perm.CanRead(),
+			CanCreate:   perm.CanCreate(),
+			CanUpdate:   perm.CanUpdate(),
+			CanDelete:   perm.CanDelete(),
+		})
+	}
+
+	return dto.APITokenResponse{
+		ID:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      scopes,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		RevokedAt:   token.RevokedAt,
+		CreatedAt:   token.CreatedAt,
+	}
+}
+
+// newToken returns a 256-bit random token that is safe to put in a URL
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (u *authUseCase) LoginWithSocial(ctx context.Context, req dto.LoginWithSocialRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
 	// 1. Get User Info from Provider
-	socialInfo, err := u.socialAuthService.GetUserInfo(ctx, req.Provider, req.Code)
+	socialInfo, err := u.socialAuthService.GetUserInfo(ctx, req.Provider, req.Code, req.State)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +602,11 @@ func (u *authUseCase) LoginWithSocial(ctx context.Context, req dto.LoginWithSoci
 		}
 
 		if existingUser != nil {
-			// Link account to existing user
+			// Only link when the provider vouches for the address, otherwise anyone
+			// could claim an account by registering its email with a provider
+			if !socialInfo.EmailVerified {
+				return nil, ErrSocialEmailNotVerified
+			}
 			user = existingUser
 		} else {
 			// Create new user
@@ -217,6 +619,9 @@ func (u *authUseCase) LoginWithSocial(ctx context.Context, req dto.LoginWithSoci
 				CreatedAt:    time.Now(),
 				UpdatedAt:    time.Now(),
 			}
+			if socialInfo.EmailVerified {
+				user.EmailVerifiedAt = &user.CreatedAt
+			}
 			if err := u.userRepo.Create(ctx, user); err != nil {
 				return nil, err
 			}
@@ -235,19 +640,9 @@ func (u *authUseCase) LoginWithSocial(ctx context.Context, req dto.LoginWithSoci
 	}
 
 	// 3. Create Session
-	sessionID := uuid.New().String()
-	if err := u.sessionRepo.CreateSession(ctx, sessionID, user.ID.String(), 24*time.Hour); err != nil {
-		return nil, err
-	}
-
-	return &dto.AuthResponse{
-		SessionID: sessionID,
-		UserID:    user.ID,
-		Email:     user.Email,
-		Name:      user.Name,
-	}, nil
+	return u.completeLogin(ctx, user, client)
 }
 
-func (u *authUseCase) GetSocialAuthURL(ctx context.Context, provider string) (string, error) {
+func (u *authUseCase) GetSocialAuthURL(ctx context.Context, provider string) (string, string, error) {
 	return u.socialAuthService.GetAuthURL(ctx, provider)
 }
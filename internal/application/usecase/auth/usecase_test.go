@@ -9,9 +9,11 @@ import (
 	"github.com/aiagent/internal/application/dto"
 	"github.com/aiagent/internal/application/usecase/auth"
 	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
 	"github.com/aiagent/internal/domain/repository/mocks"
 	"github.com/aiagent/internal/domain/service"
 	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/aiagent/internal/domain/valueobject"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -24,7 +26,9 @@ func TestAuthUseCase_Register(t *testing.T) {
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
-	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, nil, nil)
+	mockTokenRepo := mocks.NewMockAuthTokenRepository(ctrl)
+	mockEmailService := serviceMocks.NewMockEmailService(ctrl)
+	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, nil, nil, mockTokenRepo, mockEmailService, nil, nil)
 
 	ctx := context.Background()
 
@@ -49,8 +53,18 @@ func TestAuthUseCase_Register(t *testing.T) {
 			return nil
 		})
 
-		// Expect Session Create for Verification Token (Logic to be added)
-		mockSessionRepo.EXPECT().CreateSession(ctx, gomock.Any(), gomock.Any(), 24*time.Hour).Return(nil)
+		// Verification token is stored in its own keyspace and mailed to the user
+		var issuedToken string
+		mockTokenRepo.EXPECT().Create(ctx, repository.AuthTokenEmailVerification, gomock.Any(), gomock.Any(), 24*time.Hour).
+			DoAndReturn(func(_ context.Context, _ repository.AuthTokenPurpose, token string, _ uuid.UUID, _ time.Duration) error {
+				issuedToken = token
+				return nil
+			})
+		mockEmailService.EXPECT().SendVerificationEmail(ctx, gomock.Any(), req.Email, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, token string) error {
+				assert.Equal(t, issuedToken, token)
+				return nil
+			})
 
 		resp, err := authUC.Register(ctx, req)
 		assert.NoError(t, err)
@@ -85,7 +99,9 @@ func TestAuthUseCase_Login(t *testing.T) {
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
-	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, nil, nil)
+	mockTwoFactor := serviceMocks.NewMockTwoFactorService(ctrl)
+	mockTwoFactor.EXPECT().IsEnabled(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, nil, nil, nil, nil, mockTwoFactor, nil)
 
 	ctx := context.Background()
 
@@ -108,12 +124,22 @@ func TestAuthUseCase_Login(t *testing.T) {
 		}
 
 		mockUserRepo.EXPECT().FindByEmail(ctx, req.Email).Return(user, nil)
-		mockSessionRepo.EXPECT().CreateSession(ctx, gomock.Any(), user.ID.String(), 24*time.Hour).Return(nil)
+		mockSessionRepo.EXPECT().CreateSession(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, s *entity.Session) error {
+			assert.Equal(t, user.ID, s.UserID)
+			assert.Equal(t, "Chrome on macOS", s.Device)
+			assert.Equal(t, "198.51.100.4", s.IPAddress)
+			s.ExpiresAt = s.CreatedAt.Add(24 * time.Hour)
+			return nil
+		})
 
-		resp, err := authUC.Login(ctx, req)
+		resp, err := authUC.Login(ctx, req, valueobject.ClientInfo{
+			IPAddress: "198.51.100.4",
+			UserAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0 Safari/537.36",
+		})
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 		assert.NotEmpty(t, resp.SessionID)
+		assert.NotNil(t, resp.SessionExpiresAt)
 		assert.Equal(t, user.ID, resp.UserID)
 	})
 
@@ -135,7 +161,7 @@ func TestAuthUseCase_Login(t *testing.T) {
 
 		mockUserRepo.EXPECT().FindByEmail(ctx, req.Email).Return(user, nil)
 
-		resp, err := authUC.Login(ctx, req)
+		resp, err := authUC.Login(ctx, req, valueobject.ClientInfo{})
 		assert.Error(t, err)
 		assert.Nil(t, resp)
 		assert.Contains(t, err.Error(), "email not verified")
@@ -149,7 +175,7 @@ func TestAuthUseCase_Login(t *testing.T) {
 
 		mockUserRepo.EXPECT().FindByEmail(ctx, req.Email).Return(nil, nil)
 
-		resp, err := authUC.Login(ctx, req)
+		resp, err := authUC.Login(ctx, req, valueobject.ClientInfo{})
 		assert.Error(t, err)
 		assert.Nil(t, resp)
 		assert.Contains(t, err.Error(), "invalid credentials")
@@ -172,7 +198,7 @@ func TestAuthUseCase_Login(t *testing.T) {
 
 		mockUserRepo.EXPECT().FindByEmail(ctx, req.Email).Return(user, nil)
 
-		resp, err := authUC.Login(ctx, req)
+		resp, err := authUC.Login(ctx, req, valueobject.ClientInfo{})
 		assert.Error(t, err)
 		assert.Nil(t, resp)
 		assert.Contains(t, err.Error(), "invalid credentials")
@@ -185,7 +211,7 @@ func TestAuthUseCase_Logout(t *testing.T) {
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
-	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, nil, nil)
+	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 
@@ -215,13 +241,16 @@ func TestAuthUseCase_LoginWithSocial(t *testing.T) {
 	mockSocialRepo := mocks.NewMockSocialAccountRepository(ctrl)
 	mockSocialAuthService := serviceMocks.NewMockSocialAuthService(ctrl)
 
-	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, mockSocialRepo, mockSocialAuthService)
+	mockTwoFactor := serviceMocks.NewMockTwoFactorService(ctrl)
+	mockTwoFactor.EXPECT().IsEnabled(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, mockSocialRepo, mockSocialAuthService, nil, nil, mockTwoFactor, nil)
 	ctx := context.Background()
 
 	t.Run("SocialAccountExists_Login", func(t *testing.T) {
 		req := dto.LoginWithSocialRequest{
 			Provider: "google",
 			Code:     "auth-code",
+			State:    "state-1",
 		}
 
 		socialInfo := &service.SocialUserInfo{
@@ -237,9 +266,12 @@ func TestAuthUseCase_LoginWithSocial(t *testing.T) {
 			ProviderID: socialInfo.ProviderID,
 		}
 
-		mockSocialAuthService.EXPECT().GetUserInfo(ctx, req.Provider, req.Code).Return(socialInfo, nil)
+		mockSocialAuthService.EXPECT().GetUserInfo(ctx, req.Provider, req.Code, req.State).Return(socialInfo, nil)
 		mockSocialRepo.EXPECT().FindByProvider(ctx, req.Provider, socialInfo.ProviderID).Return(socialAccount, nil)
-		mockSessionRepo.EXPECT().CreateSession(ctx, gomock.Any(), userID.String(), 24*time.Hour).Return(nil)
+		mockSessionRepo.EXPECT().CreateSession(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, s *entity.Session) error {
+			assert.Equal(t, userID, s.UserID)
+			return nil
+		})
 
 		// FindByID is used to populate response
 		mockUserRepo.EXPECT().FindByID(ctx, userID).Return(&entity.User{
@@ -248,7 +280,7 @@ func TestAuthUseCase_LoginWithSocial(t *testing.T) {
 			Name:  socialInfo.Name,
 		}, nil)
 
-		resp, err := authUC.LoginWithSocial(ctx, req)
+		resp, err := authUC.LoginWithSocial(ctx, req, valueobject.ClientInfo{})
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 		assert.Equal(t, userID, resp.UserID)
@@ -258,15 +290,17 @@ func TestAuthUseCase_LoginWithSocial(t *testing.T) {
 		req := dto.LoginWithSocialRequest{
 			Provider: "google",
 			Code:     "auth-code-new",
+			State:    "state-2",
 		}
 
 		socialInfo := &service.SocialUserInfo{
-			ProviderID: "google-456",
-			Email:      "new@example.com",
-			Name:       "New User",
+			ProviderID:    "google-456",
+			Email:         "new@example.com",
+			Name:          "New User",
+			EmailVerified: true,
 		}
 
-		mockSocialAuthService.EXPECT().GetUserInfo(ctx, req.Provider, req.Code).Return(socialInfo, nil)
+		mockSocialAuthService.EXPECT().GetUserInfo(ctx, req.Provider, req.Code, req.State).Return(socialInfo, nil)
 		mockSocialRepo.EXPECT().FindByProvider(ctx, req.Provider, socialInfo.ProviderID).Return(nil, nil) // Not found
 		mockUserRepo.EXPECT().FindByEmail(ctx, socialInfo.Email).Return(nil, nil)                         // User not found
 
@@ -274,6 +308,7 @@ func TestAuthUseCase_LoginWithSocial(t *testing.T) {
 		mockUserRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, u *entity.User) error {
 			assert.Equal(t, socialInfo.Email, u.Email)
 			assert.Equal(t, socialInfo.Name, u.Name)
+			assert.NotNil(t, u.EmailVerifiedAt)
 			return nil
 		})
 
@@ -284,13 +319,35 @@ func TestAuthUseCase_LoginWithSocial(t *testing.T) {
 			return nil
 		})
 
-		mockSessionRepo.EXPECT().CreateSession(ctx, gomock.Any(), gomock.Any(), 24*time.Hour).Return(nil)
+		mockSessionRepo.EXPECT().CreateSession(ctx, gomock.Any()).Return(nil)
 
-		resp, err := authUC.LoginWithSocial(ctx, req)
+		resp, err := authUC.LoginWithSocial(ctx, req, valueobject.ClientInfo{})
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 		assert.Equal(t, socialInfo.Email, resp.Email)
 	})
+
+	t.Run("ExistingUser_UnverifiedEmail_NotLinked", func(t *testing.T) {
+		req := dto.LoginWithSocialRequest{
+			Provider: "github",
+			Code:     "auth-code-unverified",
+			State:    "state-3",
+		}
+
+		socialInfo := &service.SocialUserInfo{
+			ProviderID: "github-789",
+			Email:      "victim@example.com",
+			Name:       "Attacker",
+		}
+
+		mockSocialAuthService.EXPECT().GetUserInfo(ctx, req.Provider, req.Code, req.State).Return(socialInfo, nil)
+		mockSocialRepo.EXPECT().FindByProvider(ctx, req.Provider, socialInfo.ProviderID).Return(nil, nil)
+		mockUserRepo.EXPECT().FindByEmail(ctx, socialInfo.Email).Return(&entity.User{ID: uuid.New(), Email: socialInfo.Email}, nil)
+
+		resp, err := authUC.LoginWithSocial(ctx, req, valueobject.ClientInfo{})
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+	})
 }
 
 func TestAuthUseCase_VerifyEmail(t *testing.T) {
@@ -298,8 +355,8 @@ func TestAuthUseCase_VerifyEmail(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
-	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
-	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, nil, nil)
+	mockTokenRepo := mocks.NewMockAuthTokenRepository(ctrl)
+	authUC := auth.NewAuthUseCase(mockUserRepo, nil, nil, nil, mockTokenRepo, nil, nil, nil)
 
 	ctx := context.Background()
 
@@ -312,8 +369,8 @@ func TestAuthUseCase_VerifyEmail(t *testing.T) {
 			EmailVerifiedAt: nil,
 		}
 
-		// 1. Get UserID from token (session)
-		mockSessionRepo.EXPECT().GetUserID(ctx, token).Return(userID.String(), nil)
+		// 1. Consume the single-use token
+		mockTokenRepo.EXPECT().Consume(ctx, repository.AuthTokenEmailVerification, token).Return(userID, nil)
 
 		// 2. Find User
 		mockUserRepo.EXPECT().FindByID(ctx, userID).Return(user, nil)
@@ -325,26 +382,23 @@ func TestAuthUseCase_VerifyEmail(t *testing.T) {
 			return nil
 		})
 
-		// 4. Delete Token (Session)
-		mockSessionRepo.EXPECT().DeleteSession(ctx, token).Return(nil)
-
 		err := authUC.VerifyEmail(ctx, token)
 		assert.NoError(t, err)
 	})
 
 	t.Run("InvalidToken", func(t *testing.T) {
 		token := "invalid-token"
-		mockSessionRepo.EXPECT().GetUserID(ctx, token).Return("", errors.New("not found"))
+		mockTokenRepo.EXPECT().Consume(ctx, repository.AuthTokenEmailVerification, token).Return(uuid.Nil, nil)
 
 		err := authUC.VerifyEmail(ctx, token)
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
 	})
 
 	t.Run("UserNotFound", func(t *testing.T) {
 		token := "valid-token"
 		userID := uuid.New()
 
-		mockSessionRepo.EXPECT().GetUserID(ctx, token).Return(userID.String(), nil)
+		mockTokenRepo.EXPECT().Consume(ctx, repository.AuthTokenEmailVerification, token).Return(userID, nil)
 		mockUserRepo.EXPECT().FindByID(ctx, userID).Return(nil, nil)
 
 		err := authUC.VerifyEmail(ctx, token)
@@ -352,3 +406,369 @@ func TestAuthUseCase_VerifyEmail(t *testing.T) {
 		assert.Contains(t, err.Error(), "user not found")
 	})
 }
+
+func TestAuthUseCase_ResendVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTokenRepo := mocks.NewMockAuthTokenRepository(ctrl)
+	mockEmailService := serviceMocks.NewMockEmailService(ctrl)
+	authUC := auth.NewAuthUseCase(mockUserRepo, nil, nil, nil, mockTokenRepo, mockEmailService, nil, nil)
+
+	ctx := context.Background()
+
+	t.Run("Unverified", func(t *testing.T) {
+		user := &entity.User{ID: uuid.New(), Email: "new@example.com"}
+		mockUserRepo.EXPECT().FindByEmail(ctx, user.Email).Return(user, nil)
+		mockTokenRepo.EXPECT().Create(ctx, repository.AuthTokenEmailVerification, gomock.Any(), user.ID, 24*time.Hour).Return(nil)
+		mockEmailService.EXPECT().SendVerificationEmail(ctx, user.ID, user.Email, gomock.Any()).Return(nil)
+
+		assert.NoError(t, authUC.ResendVerification(ctx, user.Email))
+	})
+
+	t.Run("UnknownOrVerified_Silent", func(t *testing.T) {
+		now := time.Now()
+		mockUserRepo.EXPECT().FindByEmail(ctx, "nobody@example.com").Return(nil, nil)
+		mockUserRepo.EXPECT().FindByEmail(ctx, "done@example.com").Return(&entity.User{ID: uuid.New(), EmailVerifiedAt: &now}, nil)
+
+		assert.NoError(t, authUC.ResendVerification(ctx, "nobody@example.com"))
+		assert.NoError(t, authUC.ResendVerification(ctx, "done@example.com"))
+	})
+}
+
+func TestAuthUseCase_ForgotPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTokenRepo := mocks.NewMockAuthTokenRepository(ctrl)
+	mockEmailService := serviceMocks.NewMockEmailService(ctrl)
+	authUC := auth.NewAuthUseCase(mockUserRepo, nil, nil, nil, mockTokenRepo, mockEmailService, nil, nil)
+
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		user := &entity.User{ID: uuid.New(), Email: "test@example.com", IsActive: true}
+		mockUserRepo.EXPECT().FindByEmail(ctx, user.Email).Return(user, nil)
+		mockTokenRepo.EXPECT().Create(ctx, repository.AuthTokenPasswordReset, gomock.Any(), user.ID, time.Hour).Return(nil)
+		mockEmailService.EXPECT().SendPasswordResetEmail(ctx, user.ID, user.Email, gomock.Any(), time.Hour).Return(nil)
+
+		assert.NoError(t, authUC.ForgotPassword(ctx, user.Email))
+	})
+
+	t.Run("UnknownEmail_Silent", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByEmail(ctx, "nobody@example.com").Return(nil, nil)
+
+		assert.NoError(t, authUC.ForgotPassword(ctx, "nobody@example.com"))
+	})
+}
+
+func TestAuthUseCase_ResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
+	mockTokenRepo := mocks.NewMockAuthTokenRepository(ctrl)
+	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, nil, nil, mockTokenRepo, nil, nil, nil)
+
+	ctx := context.Background()
+
+	t.Run("Success_RevokesAllSessions", func(t *testing.T) {
+		userID := uuid.New()
+		req := dto.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password-123"}
+
+		mockTokenRepo.EXPECT().Consume(ctx, repository.AuthTokenPasswordReset, req.Token).Return(userID, nil)
+		mockUserRepo.EXPECT().FindByID(ctx, userID).Return(&entity.User{ID: userID, PasswordHash: "old"}, nil)
+		mockUserRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, u *entity.User) error {
+			assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(req.NewPassword)))
+			assert.NotNil(t, u.EmailVerifiedAt)
+			return nil
+		})
+		mockSessionRepo.EXPECT().DeleteUserSessions(ctx, userID.String(), "").Return(nil)
+
+		assert.NoError(t, authUC.ResetPassword(ctx, req))
+	})
+
+	t.Run("UsedOrExpiredToken", func(t *testing.T) {
+		mockTokenRepo.EXPECT().Consume(ctx, repository.AuthTokenPasswordReset, "used").Return(uuid.Nil, nil)
+
+		err := authUC.ResetPassword(ctx, dto.ResetPasswordRequest{Token: "used", NewPassword: "new-password-123"})
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+}
+
+func TestAuthUseCase_ChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
+	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("current-password"), bcrypt.MinCost)
+
+	t.Run("Success_RotatesSession", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, userID).Return(&entity.User{ID: userID, PasswordHash: string(hash)}, nil)
+		mockUserRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		gomock.InOrder(
+			mockSessionRepo.EXPECT().DeleteUserSessions(ctx, userID.String(), "").Return(nil),
+			mockSessionRepo.EXPECT().CreateSession(ctx, gomock.Any()).Return(nil),
+		)
+
+		resp, err := authUC.ChangePassword(ctx, userID, dto.ChangePasswordRequest{
+			CurrentPassword: "current-password",
+			NewPassword:     "new-password-123",
+		}, valueobject.ClientInfo{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, resp.SessionID)
+	})
+
+	t.Run("WrongCurrentPassword", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, userID).Return(&entity.User{ID: userID, PasswordHash: string(hash)}, nil)
+
+		_, err := authUC.ChangePassword(ctx, userID, dto.ChangePasswordRequest{
+			CurrentPassword: "guess",
+			NewPassword:     "new-password-123",
+		}, valueobject.ClientInfo{})
+		assert.ErrorIs(t, err, auth.ErrIncorrectPassword)
+	})
+}
+
+func TestAuthUseCase_ListSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
+	authUC := auth.NewAuthUseCase(nil, mockSessionRepo, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockSessionRepo.EXPECT().ListUserSessions(ctx, userID.String()).Return([]entity.Session{
+		{ID: "s1", UserID: userID, Device: "Firefox on Linux"},
+		{ID: "s2", UserID: userID, Device: "Safari on iOS"},
+	}, nil)
+
+	sessions, err := authUC.ListSessions(ctx, userID, "s2")
+
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+	assert.False(t, sessions[0].Current)
+	assert.True(t, sessions[1].Current)
+	assert.Equal(t, "Safari on iOS", sessions[1].Device)
+}
+
+func TestAuthUseCase_RevokeSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
+	authUC := auth.NewAuthUseCase(nil, mockSessionRepo, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("OwnSession", func(t *testing.T) {
+		mockSessionRepo.EXPECT().GetSession(ctx, "s1").Return(&entity.Session{ID: "s1", UserID: userID}, nil)
+		mockSessionRepo.EXPECT().DeleteSession(ctx, "s1").Return(nil)
+
+		assert.NoError(t, authUC.RevokeSession(ctx, userID, "s1"))
+	})
+
+	t.Run("OtherUsersSession", func(t *testing.T) {
+		mockSessionRepo.EXPECT().GetSession(ctx, "s2").Return(&entity.Session{ID: "s2", UserID: uuid.New()}, nil)
+
+		assert.ErrorIs(t, authUC.RevokeSession(ctx, userID, "s2"), auth.ErrSessionNotFound)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		mockSessionRepo.EXPECT().GetSession(ctx, "s3").Return(nil, nil)
+
+		assert.ErrorIs(t, authUC.RevokeSession(ctx, userID, "s3"), auth.ErrSessionNotFound)
+	})
+}
+
+func TestAuthUseCase_LogoutEverywhere(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
+	authUC := auth.NewAuthUseCase(nil, mockSessionRepo, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockSessionRepo.EXPECT().DeleteUserSessions(ctx, userID.String(), "").Return(nil)
+
+	assert.NoError(t, authUC.LogoutEverywhere(ctx, userID))
+}
+
+func TestAuthUseCase_TwoFactorLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
+	mockTokenRepo := mocks.NewMockAuthTokenRepository(ctrl)
+	mockTwoFactor := serviceMocks.NewMockTwoFactorService(ctrl)
+	authUC := auth.NewAuthUseCase(mockUserRepo, mockSessionRepo, nil, nil, mockTokenRepo, nil, mockTwoFactor, nil)
+
+	ctx := context.Background()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	now := time.Now()
+	user := &entity.User{ID: uuid.New(), Email: "ada@example.com", PasswordHash: string(hash), EmailVerifiedAt: &now}
+
+	t.Run("PasswordStepIssuesChallenge", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByEmail(ctx, user.Email).Return(user, nil)
+		mockTwoFactor.EXPECT().IsEnabled(ctx, user.ID).Return(true, nil)
+		mockTokenRepo.EXPECT().Create(ctx, repository.AuthTokenTwoFactorChallenge, gomock.Any(), user.ID, 5*time.Minute).Return(nil)
+
+		resp, err := authUC.Login(ctx, dto.LoginRequest{Email: user.Email, Password: "password123"}, valueobject.ClientInfo{})
+
+		assert.NoError(t, err)
+		assert.True(t, resp.TwoFactorRequired)
+		assert.NotEmpty(t, resp.ChallengeToken)
+		assert.Empty(t, resp.SessionID, "no session before the second factor")
+	})
+
+	t.Run("SecondStepCreatesSession", func(t *testing.T) {
+		mockTokenRepo.EXPECT().Consume(ctx, repository.AuthTokenTwoFactorChallenge, "challenge").Return(user.ID, nil)
+		mockTwoFactor.EXPECT().Verify(ctx, user.ID, "123456").Return(nil)
+		mockUserRepo.EXPECT().FindByID(ctx, user.ID).Return(user, nil)
+		mockSessionRepo.EXPECT().CreateSession(ctx, gomock.Any()).Return(nil)
+
+		resp, err := authUC.VerifyTwoFactorLogin(ctx, dto.TwoFactorLoginRequest{ChallengeToken: "challenge", Code: "123456"}, valueobject.ClientInfo{})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, resp.SessionID)
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		mockTokenRepo.EXPECT().Consume(ctx, repository.AuthTokenTwoFactorChallenge, "challenge").Return(user.ID, nil)
+		mockTwoFactor.EXPECT().Verify(ctx, user.ID, "000000").Return(service.ErrInvalidTwoFactorCode)
+
+		_, err := authUC.VerifyTwoFactorLogin(ctx, dto.TwoFactorLoginRequest{ChallengeToken: "challenge", Code: "000000"}, valueobject.ClientInfo{})
+
+		assert.ErrorIs(t, err, service.ErrInvalidTwoFactorCode)
+	})
+
+	t.Run("ExpiredChallenge", func(t *testing.T) {
+		mockTokenRepo.EXPECT().Consume(ctx, repository.AuthTokenTwoFactorChallenge, "stale").Return(uuid.Nil, nil)
+
+		_, err := authUC.VerifyTwoFactorLogin(ctx, dto.TwoFactorLoginRequest{ChallengeToken: "stale", Code: "123456"}, valueobject.ClientInfo{})
+
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+}
+
+func TestAuthUseCase_EnableTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo := mocks.NewMockSessionRepository(ctrl)
+	mockTwoFactor := serviceMocks.NewMockTwoFactorService(ctrl)
+	authUC := auth.NewAuthUseCase(nil, mockSessionRepo, nil, nil, nil, nil, mockTwoFactor, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockTwoFactor.EXPECT().Enable(ctx, userID, "123456").Return([]string{"abcde-fghjk"}, nil)
+	// Sessions created before enrollment skipped the second factor
+	mockSessionRepo.EXPECT().DeleteUserSessions(ctx, userID.String(), "current-session").Return(nil)
+
+	resp, err := authUC.EnableTwoFactor(ctx, userID, "current-session", "123456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"abcde-fghjk"}, resp.RecoveryCodes)
+}
+
+func TestAuthUseCase_DisableTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTwoFactor := serviceMocks.NewMockTwoFactorService(ctrl)
+	authUC := auth.NewAuthUseCase(mockUserRepo, nil, nil, nil, nil, nil, mockTwoFactor, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	hash, _ := bcrypt.GenerateFromPassword([]byte("current-password"), bcrypt.MinCost)
+
+	t.Run("WithCode", func(t *testing.T) {
+		mockTwoFactor.EXPECT().Verify(ctx, userID, "123456").Return(nil)
+		mockTwoFactor.EXPECT().Disable(ctx, userID).Return(nil)
+
+		assert.NoError(t, authUC.DisableTwoFactor(ctx, userID, dto.DisableTwoFactorRequest{Code: "123456"}))
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		mockTwoFactor.EXPECT().Verify(ctx, userID, "000000").Return(service.ErrInvalidTwoFactorCode)
+
+		err := authUC.DisableTwoFactor(ctx, userID, dto.DisableTwoFactorRequest{Code: "000000"})
+		assert.ErrorIs(t, err, service.ErrInvalidTwoFactorCode)
+	})
+
+	t.Run("WithPassword", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, userID).Return(&entity.User{ID: userID, PasswordHash: string(hash)}, nil)
+		mockTwoFactor.EXPECT().Disable(ctx, userID).Return(nil)
+
+		assert.NoError(t, authUC.DisableTwoFactor(ctx, userID, dto.DisableTwoFactorRequest{CurrentPassword: "current-password"}))
+	})
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, userID).Return(&entity.User{ID: userID, PasswordHash: string(hash)}, nil)
+
+		err := authUC.DisableTwoFactor(ctx, userID, dto.DisableTwoFactorRequest{CurrentPassword: "guess"})
+		assert.ErrorIs(t, err, auth.ErrIncorrectPassword)
+	})
+
+	t.Run("SocialAccountNeedsCode", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, userID).Return(&entity.User{ID: userID}, nil)
+
+		err := authUC.DisableTwoFactor(ctx, userID, dto.DisableTwoFactorRequest{CurrentPassword: "anything"})
+		assert.ErrorIs(t, err, auth.ErrIncorrectPassword)
+	})
+}
+
+func TestAuthUseCase_CreateAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAPITokens := serviceMocks.NewMockAPITokenService(ctrl)
+	authUC := auth.NewAuthUseCase(nil, nil, nil, nil, nil, nil, nil, mockAPITokens)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	req := dto.CreateAPITokenRequest{
+		Name: "cms import",
+		Scopes: []dto.APITokenScopeRequest{
+			{Resource: entity.ResourceBlogs, Permissions: int(entity.PermissionRead)},
+			{Resource: entity.ResourceBlogs, Permissions: int(entity.PermissionCreate)},
+			{Resource: entity.ResourcePlans, Permissions: int(entity.PermissionUpdate)},
+		},
+		ExpiresInDays: 30,
+	}
+	wantScopes := entity.APITokenScopes{
+		entity.ResourceBlogs: entity.PermissionRead | entity.PermissionCreate,
+		entity.ResourcePlans: entity.PermissionUpdate,
+	}
+
+	mockAPITokens.EXPECT().Create(ctx, userID, "cms import", wantScopes, 30*24*time.Hour).
+		Return(&service.CreatedAPIToken{
+			Token:  &entity.APIToken{ID: uuid.New(), Name: "cms import", TokenPrefix: "pat_abcdefgh", Scopes: wantScopes},
+			Secret: "pat_abcdefgh-secret",
+		}, nil)
+
+	resp, err := authUC.CreateAPIToken(ctx, userID, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "pat_abcdefgh-secret", resp.Token)
+	assert.Len(t, resp.Scopes, 2)
+	assert.Equal(t, entity.ResourceBlogs, resp.Scopes[0].Resource)
+	assert.True(t, resp.Scopes[0].CanCreate)
+	assert.False(t, resp.Scopes[0].CanDelete)
+}
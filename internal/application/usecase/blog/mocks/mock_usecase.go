@@ -43,6 +43,21 @@ func (m *MockBlogUseCase) EXPECT() *MockBlogUseCaseMockRecorder {
 	return m.recorder
 }
 
+// CancelSchedule mocks base method.
+func (m *MockBlogUseCase) CancelSchedule(ctx context.Context, id, authorID uuid.UUID) (*dto.BlogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSchedule", ctx, id, authorID)
+	ret0, _ := ret[0].(*dto.BlogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSchedule indicates an expected call of CancelSchedule.
+func (mr *MockBlogUseCaseMockRecorder) CancelSchedule(ctx, id, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSchedule", reflect.TypeOf((*MockBlogUseCase)(nil).CancelSchedule), ctx, id, authorID)
+}
+
 // Create mocks base method.
 func (m *MockBlogUseCase) Create(ctx context.Context, authorID uuid.UUID, req *dto.CreateBlogRequest) (*dto.BlogResponse, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +132,21 @@ func (mr *MockBlogUseCaseMockRecorder) List(ctx, params, viewerID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBlogUseCase)(nil).List), ctx, params, viewerID)
 }
 
+// ListScheduled mocks base method.
+func (m *MockBlogUseCase) ListScheduled(ctx context.Context, authorID uuid.UUID) ([]dto.ScheduledBlogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduled", ctx, authorID)
+	ret0, _ := ret[0].([]dto.ScheduledBlogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduled indicates an expected call of ListScheduled.
+func (mr *MockBlogUseCaseMockRecorder) ListScheduled(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduled", reflect.TypeOf((*MockBlogUseCase)(nil).ListScheduled), ctx, authorID)
+}
+
 // Publish mocks base method.
 func (m *MockBlogUseCase) Publish(ctx context.Context, id, authorID uuid.UUID, req *dto.PublishBlogRequest) (*dto.BlogResponse, error) {
 	m.ctrl.T.Helper()
@@ -147,6 +177,36 @@ func (mr *MockBlogUseCaseMockRecorder) React(ctx, id, userID, req any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "React", reflect.TypeOf((*MockBlogUseCase)(nil).React), ctx, id, userID, req)
 }
 
+// Reschedule mocks base method.
+func (m *MockBlogUseCase) Reschedule(ctx context.Context, id, authorID uuid.UUID, req *dto.ScheduleBlogRequest) (*dto.BlogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", ctx, id, authorID, req)
+	ret0, _ := ret[0].(*dto.BlogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockBlogUseCaseMockRecorder) Reschedule(ctx, id, authorID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockBlogUseCase)(nil).Reschedule), ctx, id, authorID, req)
+}
+
+// Timeline mocks base method.
+func (m *MockBlogUseCase) Timeline(ctx context.Context, params *dto.BlogTimelineParams, viewerID *uuid.UUID) (*repository.CursorPage[dto.BlogListResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeline", ctx, params, viewerID)
+	ret0, _ := ret[0].(*repository.CursorPage[dto.BlogListResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timeline indicates an expected call of Timeline.
+func (mr *MockBlogUseCaseMockRecorder) Timeline(ctx, params, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeline", reflect.TypeOf((*MockBlogUseCase)(nil).Timeline), ctx, params, viewerID)
+}
+
 // Unpublish mocks base method.
 func (m *MockBlogUseCase) Unpublish(ctx context.Context, id, authorID uuid.UUID) (*dto.BlogResponse, error) {
 	m.ctrl.T.Helper()
@@ -14,31 +14,47 @@ import (
 )
 
 var (
-	ErrBlogNotFound         = domainService.ErrBlogNotFound
-	ErrBlogAccessDenied     = domainService.ErrBlogAccessDenied
-	ErrBlogAlreadyPublished = domainService.ErrBlogAlreadyPublished
-	ErrSlugAlreadyExists    = domainService.ErrSlugAlreadyExists
+	ErrBlogNotFound           = domainService.ErrBlogNotFound
+	ErrBlogAccessDenied       = domainService.ErrBlogAccessDenied
+	ErrBlogAlreadyPublished   = domainService.ErrBlogAlreadyPublished
+	ErrSlugAlreadyExists      = domainService.ErrSlugAlreadyExists
+	ErrSeriesPurchaseRequired = domainService.ErrSeriesPurchaseRequired
+	ErrInvalidBlogCursor      = domainService.ErrInvalidBlogCursor
+	ErrBlogNotScheduled       = domainService.ErrBlogNotScheduled
+	ErrInvalidPublishTime     = domainService.ErrInvalidPublishTime
+	ErrInvalidTimezone        = domainService.ErrInvalidTimezone
 )
 
+// localTimeLayouts are the wall-clock formats accepted for a publish time given with a timezone
+var localTimeLayouts = []string{"2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04"}
+
 type BlogUseCase interface {
 	Create(ctx context.Context, authorID uuid.UUID, req *dto.CreateBlogRequest) (*dto.BlogResponse, error)
 	GetByID(ctx context.Context, id uuid.UUID, viewerID *uuid.UUID) (*dto.BlogResponse, error)
 	GetBySlug(ctx context.Context, authorID uuid.UUID, slug string, viewerID *uuid.UUID) (*dto.BlogResponse, error)
 	List(ctx context.Context, params *dto.BlogFilterParams, viewerID *uuid.UUID) (*repository.PaginatedResult[dto.BlogListResponse], error)
+	Timeline(ctx context.Context, params *dto.BlogTimelineParams, viewerID *uuid.UUID) (*repository.CursorPage[dto.BlogListResponse], error)
 	Update(ctx context.Context, id uuid.UUID, authorID uuid.UUID, req *dto.UpdateBlogRequest) (*dto.BlogResponse, error)
 	Delete(ctx context.Context, id uuid.UUID, authorID uuid.UUID) error
 	Publish(ctx context.Context, id uuid.UUID, authorID uuid.UUID, req *dto.PublishBlogRequest) (*dto.BlogResponse, error)
 	Unpublish(ctx context.Context, id uuid.UUID, authorID uuid.UUID) (*dto.BlogResponse, error)
+	ListScheduled(ctx context.Context, authorID uuid.UUID) ([]dto.ScheduledBlogResponse, error)
+	Reschedule(ctx context.Context, id uuid.UUID, authorID uuid.UUID, req *dto.ScheduleBlogRequest) (*dto.BlogResponse, error)
+	CancelSchedule(ctx context.Context, id uuid.UUID, authorID uuid.UUID) (*dto.BlogResponse, error)
 	React(ctx context.Context, id uuid.UUID, userID uuid.UUID, req *dto.ReactionRequest) (*dto.ReactionResponse, error)
 }
 
 type blogUseCase struct {
-	blogSvc domainService.BlogService
+	blogSvc    domainService.BlogService
+	seriesRepo repository.SeriesRepository
+	renderer   domainService.ContentRenderer
 }
 
-func NewBlogUseCase(blogSvc domainService.BlogService) BlogUseCase {
+func NewBlogUseCase(blogSvc domainService.BlogService, seriesRepo repository.SeriesRepository, renderer domainService.ContentRenderer) BlogUseCase {
 	return &blogUseCase{
-		blogSvc: blogSvc,
+		blogSvc:    blogSvc,
+		seriesRepo: seriesRepo,
+		renderer:   renderer,
 	}
 }
 
@@ -81,7 +97,7 @@ func (uc *blogUseCase) GetByID(ctx context.Context, id uuid.UUID, viewerID *uuid
 	if err != nil {
 		return nil, err
 	}
-	return uc.toBlogResponse(blog), nil
+	return uc.toBlogResponseWithSeries(ctx, blog)
 }
 
 func (uc *blogUseCase) GetBySlug(ctx context.Context, authorID uuid.UUID, slug string, viewerID *uuid.UUID) (*dto.BlogResponse, error) {
@@ -89,21 +105,83 @@ func (uc *blogUseCase) GetBySlug(ctx context.Context, authorID uuid.UUID, slug s
 	if err != nil {
 		return nil, err
 	}
-	return uc.toBlogResponse(blog), nil
+	return uc.toBlogResponseWithSeries(ctx, blog)
 }
 
-func (uc *blogUseCase) List(ctx context.Context, params *dto.BlogFilterParams, viewerID *uuid.UUID) (*repository.PaginatedResult[dto.BlogListResponse], error) {
-	filter := repository.BlogFilter{}
-	if params.AuthorID != nil {
-		if id, err := uuid.Parse(*params.AuthorID); err == nil {
-			filter.AuthorID = &id
+// toBlogResponseWithSeries maps a blog and adds its chapter position and neighbours in every series containing it
+func (uc *blogUseCase) toBlogResponseWithSeries(ctx context.Context, blog *entity.Blog) (*dto.BlogResponse, error) {
+	resp, err := uc.toBlogResponse(ctx, blog)
+	if err != nil {
+		return nil, err
+	}
+
+	seriesList, err := uc.seriesRepo.FindContaining(ctx, blog.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, series := range seriesList {
+		chapters, err := uc.seriesRepo.GetChapters(ctx, series.ID)
+		if err != nil {
+			return nil, err
+		}
+		if nav := seriesNavigation(&series, chapters, blog.ID); nav != nil {
+			resp.Series = append(resp.Series, *nav)
 		}
 	}
-	if params.CategoryID != nil {
-		if id, err := uuid.Parse(*params.CategoryID); err == nil {
-			filter.CategoryID = &id
+
+	return resp, nil
+}
+
+// seriesNavigation locates blogID among the ordered chapters. Only live chapters are numbered
+// and counted, and Previous and Next point to the nearest of them so readers are never sent
+// to a draft.
+func seriesNavigation(series *entity.Series, chapters []entity.Blog, blogID uuid.UUID) *dto.BlogSeriesNavigation {
+	var live []*entity.Blog
+	current, currentLive := -1, false
+	for i := range chapters {
+		if chapters[i].ID == blogID {
+			// A chapter that is not live yet takes the number of the next live one
+			current, currentLive = len(live), chapters[i].IsLive()
+		}
+		if chapters[i].IsLive() {
+			live = append(live, &chapters[i])
 		}
 	}
+	if current < 0 {
+		return nil
+	}
+
+	nav := &dto.BlogSeriesNavigation{
+		SeriesID:      series.ID,
+		Title:         series.Title,
+		Slug:          series.Slug,
+		ChapterNumber: current + 1,
+		TotalChapters: len(live),
+	}
+	if current > 0 {
+		nav.Previous = chapterLink(live[current-1], current-1)
+	}
+	next := current
+	if currentLive {
+		next++
+	}
+	if next < len(live) {
+		nav.Next = chapterLink(live[next], next)
+	}
+	return nav
+}
+
+func chapterLink(blog *entity.Blog, index int) *dto.ChapterLink {
+	return &dto.ChapterLink{
+		ID:            blog.ID,
+		Title:         blog.Title,
+		Slug:          blog.Slug,
+		ChapterNumber: index + 1,
+	}
+}
+
+func (uc *blogUseCase) List(ctx context.Context, params *dto.BlogFilterParams, viewerID *uuid.UUID) (*repository.PaginatedResult[dto.BlogListResponse], error) {
+	filter := blogFilter(params.AuthorID, params.CategoryID, params.TagIDs, params.Search)
 	if params.Status != nil {
 		status := entity.BlogStatus(*params.Status)
 		filter.Status = &status
@@ -112,9 +190,6 @@ func (uc *blogUseCase) List(ctx context.Context, params *dto.BlogFilterParams, v
 		visibility := entity.BlogVisibility(*params.Visibility)
 		filter.Visibility = &visibility
 	}
-	if params.Search != nil {
-		filter.Search = params.Search
-	}
 
 	// Filter scheduled posts if not viewing own posts
 	shouldFilterScheduled := true
@@ -146,6 +221,46 @@ func (uc *blogUseCase) List(ctx context.Context, params *dto.BlogFilterParams, v
 	}, nil
 }
 
+func (uc *blogUseCase) Timeline(ctx context.Context, params *dto.BlogTimelineParams, viewerID *uuid.UUID) (*repository.CursorPage[dto.BlogListResponse], error) {
+	filter := blogFilter(params.AuthorID, params.CategoryID, params.TagIDs, params.Search)
+
+	page, err := uc.blogSvc.ListPublished(ctx, filter, params.Cursor, params.Limit, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.BlogListResponse, len(page.Data))
+	for i, blog := range page.Data {
+		items[i] = uc.toBlogListResponse(&blog)
+	}
+
+	return &repository.CursorPage[dto.BlogListResponse]{
+		Data:       items,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// blogFilter builds the filter shared by listings, ignoring malformed IDs
+func blogFilter(authorID, categoryID *string, tagIDs []string, search *string) repository.BlogFilter {
+	filter := repository.BlogFilter{Search: search}
+	if authorID != nil {
+		if id, err := uuid.Parse(*authorID); err == nil {
+			filter.AuthorID = &id
+		}
+	}
+	if categoryID != nil {
+		if id, err := uuid.Parse(*categoryID); err == nil {
+			filter.CategoryID = &id
+		}
+	}
+	for _, idStr := range tagIDs {
+		if id, err := uuid.Parse(idStr); err == nil {
+			filter.TagIDs = append(filter.TagIDs, id)
+		}
+	}
+	return filter
+}
+
 func (uc *blogUseCase) Update(ctx context.Context, id uuid.UUID, authorID uuid.UUID, req *dto.UpdateBlogRequest) (*dto.BlogResponse, error) {
 	// First get the blog to ensure existence and ownership logic is handled by service,
 	// but service Update expects a populated blog object.
@@ -197,6 +312,10 @@ func (uc *blogUseCase) Update(ctx context.Context, id uuid.UUID, authorID uuid.U
 		blog.Slug = *req.Slug
 	}
 	if req.Content != nil {
+		// An excerpt generated from the old content is generated again unless the author sets one
+		if req.Excerpt == nil && blog.Excerpt != nil && *blog.Excerpt == domainService.GenerateExcerpt(blog.Content) {
+			blog.Excerpt = nil
+		}
 		blog.Content = *req.Content
 	}
 	if req.Excerpt != nil {
@@ -237,11 +356,20 @@ func (uc *blogUseCase) Delete(ctx context.Context, id uuid.UUID, authorID uuid.U
 }
 
 func (uc *blogUseCase) Publish(ctx context.Context, id uuid.UUID, authorID uuid.UUID, req *dto.PublishBlogRequest) (*dto.BlogResponse, error) {
-	blog, err := uc.blogSvc.Publish(ctx, id, authorID, entity.BlogVisibility(req.Visibility), req.PublishedAt)
+	publishedAt := req.PublishedAt
+	if req.PublishAt != nil {
+		publishAt, err := parsePublishAt(*req.PublishAt, req.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		publishedAt = &publishAt
+	}
+
+	blog, err := uc.blogSvc.Publish(ctx, id, authorID, entity.BlogVisibility(req.Visibility), publishedAt, req.Timezone)
 	if err != nil {
 		return nil, err
 	}
-	return uc.toBlogResponse(blog), nil
+	return uc.toBlogResponse(ctx, blog)
 }
 
 func (uc *blogUseCase) Unpublish(ctx context.Context, id uuid.UUID, authorID uuid.UUID) (*dto.BlogResponse, error) {
@@ -249,7 +377,77 @@ func (uc *blogUseCase) Unpublish(ctx context.Context, id uuid.UUID, authorID uui
 	if err != nil {
 		return nil, err
 	}
-	return uc.toBlogResponse(blog), nil
+	return uc.toBlogResponse(ctx, blog)
+}
+
+func (uc *blogUseCase) ListScheduled(ctx context.Context, authorID uuid.UUID) ([]dto.ScheduledBlogResponse, error) {
+	blogs, err := uc.blogSvc.ListScheduled(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.ScheduledBlogResponse, len(blogs))
+	for i, blog := range blogs {
+		items[i] = dto.ScheduledBlogResponse{
+			ID:          blog.ID,
+			Title:       blog.Title,
+			Slug:        blog.Slug,
+			Visibility:  blog.Visibility,
+			PublishedAt: *blog.PublishedAt,
+			Timezone:    blog.PublishTimezone,
+			UpdatedAt:   blog.UpdatedAt,
+		}
+		if blog.PublishTimezone != nil {
+			if loc, err := time.LoadLocation(*blog.PublishTimezone); err == nil {
+				local := blog.PublishedAt.In(loc).Format(localTimeLayouts[0])
+				items[i].LocalTime = &local
+			}
+		}
+	}
+	return items, nil
+}
+
+func (uc *blogUseCase) Reschedule(ctx context.Context, id uuid.UUID, authorID uuid.UUID, req *dto.ScheduleBlogRequest) (*dto.BlogResponse, error) {
+	publishAt, err := parsePublishAt(req.PublishAt, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := uc.blogSvc.Reschedule(ctx, id, authorID, publishAt, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toBlogResponse(ctx, blog)
+}
+
+func (uc *blogUseCase) CancelSchedule(ctx context.Context, id uuid.UUID, authorID uuid.UUID) (*dto.BlogResponse, error) {
+	blog, err := uc.blogSvc.CancelSchedule(ctx, id, authorID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toBlogResponse(ctx, blog)
+}
+
+// parsePublishAt reads an RFC 3339 publish time, or a wall-clock time in the given timezone
+// so that "09:00 in Hanoi" stays 09:00 there whatever the server's timezone is
+func parsePublishAt(value string, timezone *string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if timezone == nil {
+		return time.Time{}, ErrInvalidPublishTime
+	}
+
+	loc, err := time.LoadLocation(*timezone)
+	if err != nil {
+		return time.Time{}, ErrInvalidTimezone
+	}
+	for _, layout := range localTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, ErrInvalidPublishTime
 }
 
 func (uc *blogUseCase) React(ctx context.Context, id uuid.UUID, userID uuid.UUID, req *dto.ReactionRequest) (*dto.ReactionResponse, error) {
@@ -266,24 +464,35 @@ func (uc *blogUseCase) React(ctx context.Context, id uuid.UUID, userID uuid.UUID
 	}, nil
 }
 
-func (uc *blogUseCase) toBlogResponse(blog *entity.Blog) *dto.BlogResponse {
+// toBlogResponse maps a blog with its content rendered to HTML
+func (uc *blogUseCase) toBlogResponse(ctx context.Context, blog *entity.Blog) (*dto.BlogResponse, error) {
+	rendered, err := uc.renderer.Render(ctx, blog)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := &dto.BlogResponse{
-		ID:            blog.ID,
-		AuthorID:      blog.AuthorID,
-		CategoryID:    blog.CategoryID,
-		Title:         blog.Title,
-		Slug:          blog.Slug,
-		Excerpt:       blog.Excerpt,
-		Content:       blog.Content,
-		ThumbnailURL:  blog.ThumbnailURL,
-		Status:        blog.Status,
-		Visibility:    blog.Visibility,
-		PublishedAt:   blog.PublishedAt,
-		Tags:          make([]dto.TagResponse, 0),
-		UpvoteCount:   blog.UpvoteCount,
-		DownvoteCount: blog.DownvoteCount,
-		CreatedAt:     blog.CreatedAt,
-		UpdatedAt:     blog.UpdatedAt,
+		ID:                 blog.ID,
+		AuthorID:           blog.AuthorID,
+		CategoryID:         blog.CategoryID,
+		Title:              blog.Title,
+		Slug:               blog.Slug,
+		Excerpt:            blog.Excerpt,
+		Content:            blog.Content,
+		ContentHTML:        rendered.HTML,
+		TOC:                make([]dto.TOCEntry, 0, len(rendered.TOC)),
+		WordCount:          rendered.WordCount,
+		ReadingTimeMinutes: rendered.ReadingTimeMinutes,
+		ThumbnailURL:       blog.ThumbnailURL,
+		Status:             blog.Status,
+		Visibility:         blog.Visibility,
+		PublishedAt:        blog.PublishedAt,
+		Timezone:           blog.PublishTimezone,
+		Tags:               make([]dto.TagResponse, 0),
+		UpvoteCount:        blog.UpvoteCount,
+		DownvoteCount:      blog.DownvoteCount,
+		CreatedAt:          blog.CreatedAt,
+		UpdatedAt:          blog.UpdatedAt,
 	}
 
 	if blog.Author != nil {
@@ -313,7 +522,10 @@ func (uc *blogUseCase) toBlogResponse(blog *entity.Blog) *dto.BlogResponse {
 			CreatedAt: tag.CreatedAt,
 		})
 	}
-	return resp
+	for _, heading := range rendered.TOC {
+		resp.TOC = append(resp.TOC, dto.TOCEntry{Level: heading.Level, ID: heading.ID, Text: heading.Text})
+	}
+	return resp, nil
 }
 
 func (uc *blogUseCase) toBlogListResponse(blog *entity.Blog) dto.BlogListResponse {
@@ -331,6 +543,7 @@ func (uc *blogUseCase) toBlogListResponse(blog *entity.Blog) dto.BlogListRespons
 		Tags:          make([]dto.TagResponse, 0),
 		UpvoteCount:   blog.UpvoteCount,
 		DownvoteCount: blog.DownvoteCount,
+		Locked:        blog.Locked,
 		CreatedAt:     blog.CreatedAt,
 	}
 
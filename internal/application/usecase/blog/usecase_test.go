@@ -9,9 +9,14 @@ import (
 	"github.com/aiagent/internal/application/usecase/blog"
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	domainService "github.com/aiagent/internal/domain/service"
+	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/aiagent/pkg/markdown"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
 )
 
 // MockBlogService is a mock implementation of domainService.BlogService
@@ -48,6 +53,14 @@ func (m *MockBlogService) List(ctx context.Context, filter repository.BlogFilter
 	return args.Get(0).(*repository.PaginatedResult[entity.Blog]), args.Error(1)
 }
 
+func (m *MockBlogService) ListPublished(ctx context.Context, filter repository.BlogFilter, cursor string, limit int, viewerID *uuid.UUID) (*repository.CursorPage[entity.Blog], error) {
+	args := m.Called(ctx, filter, cursor, limit, viewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.CursorPage[entity.Blog]), args.Error(1)
+}
+
 func (m *MockBlogService) Update(ctx context.Context, blog *entity.Blog, tagIDs []uuid.UUID) error {
 	args := m.Called(ctx, blog, tagIDs)
 	return args.Error(0)
@@ -58,8 +71,8 @@ func (m *MockBlogService) Delete(ctx context.Context, id uuid.UUID, authorID uui
 	return args.Error(0)
 }
 
-func (m *MockBlogService) Publish(ctx context.Context, id uuid.UUID, authorID uuid.UUID, visibility entity.BlogVisibility, publishedAt *time.Time) (*entity.Blog, error) {
-	args := m.Called(ctx, id, authorID, visibility, publishedAt)
+func (m *MockBlogService) Publish(ctx context.Context, id uuid.UUID, authorID uuid.UUID, visibility entity.BlogVisibility, publishedAt *time.Time, timezone *string) (*entity.Blog, error) {
+	args := m.Called(ctx, id, authorID, visibility, publishedAt, timezone)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -74,6 +87,30 @@ func (m *MockBlogService) Unpublish(ctx context.Context, id uuid.UUID, authorID
 	return args.Get(0).(*entity.Blog), args.Error(1)
 }
 
+func (m *MockBlogService) ListScheduled(ctx context.Context, authorID uuid.UUID) ([]entity.Blog, error) {
+	args := m.Called(ctx, authorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Blog), args.Error(1)
+}
+
+func (m *MockBlogService) Reschedule(ctx context.Context, id uuid.UUID, authorID uuid.UUID, publishAt time.Time, timezone *string) (*entity.Blog, error) {
+	args := m.Called(ctx, id, authorID, publishAt, timezone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Blog), args.Error(1)
+}
+
+func (m *MockBlogService) CancelSchedule(ctx context.Context, id uuid.UUID, authorID uuid.UUID) (*entity.Blog, error) {
+	args := m.Called(ctx, id, authorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Blog), args.Error(1)
+}
+
 func (m *MockBlogService) React(ctx context.Context, id uuid.UUID, userID uuid.UUID, reactionType entity.ReactionType) (int, int, error) {
 	args := m.Called(ctx, id, userID, reactionType)
 	return args.Int(0), args.Int(1), args.Error(2)
@@ -84,9 +121,20 @@ func (m *MockBlogService) CheckAccess(ctx context.Context, blog *entity.Blog, vi
 	return args.Error(0)
 }
 
+// newRenderer returns a renderer that renders every blog to an empty document
+func newRenderer(ctrl *gomock.Controller) *serviceMocks.MockContentRenderer {
+	renderer := serviceMocks.NewMockContentRenderer(ctrl)
+	renderer.EXPECT().Render(gomock.Any(), gomock.Any()).Return(&markdown.Document{TOC: []markdown.Heading{}}, nil).AnyTimes()
+	return renderer
+}
+
 func TestCreateBlog_WithPublishedAt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
 	mockService := new(MockBlogService)
-	uc := blog.NewBlogUseCase(mockService)
+	mockSeriesRepo := repoMocks.NewMockSeriesRepository(ctrl)
+	uc := blog.NewBlogUseCase(mockService, mockSeriesRepo, newRenderer(ctrl))
 
 	authorID := uuid.New()
 	futureTime := time.Now().Add(24 * time.Hour)
@@ -110,6 +158,7 @@ func TestCreateBlog_WithPublishedAt(t *testing.T) {
 		Title:       req.Title,
 		PublishedAt: &futureTime,
 	}, nil)
+	mockSeriesRepo.EXPECT().FindContaining(gomock.Any(), gomock.Any()).Return(nil, nil)
 
 	_, err := uc.Create(context.Background(), authorID, req)
 
@@ -119,7 +168,7 @@ func TestCreateBlog_WithPublishedAt(t *testing.T) {
 
 func TestListBlog_PublicFiltering(t *testing.T) {
 	mockService := new(MockBlogService)
-	uc := blog.NewBlogUseCase(mockService)
+	uc := blog.NewBlogUseCase(mockService, nil, newRenderer(gomock.NewController(t)))
 
 	// Scenario: Public listing (no viewer, or viewer is not author)
 	// Should set PublishedBefore to Now
@@ -142,3 +191,202 @@ func TestListBlog_PublicFiltering(t *testing.T) {
 	assert.NoError(t, err)
 	mockService.AssertExpectations(t)
 }
+
+func TestGetBlog_SeriesNavigation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := new(MockBlogService)
+	mockSeriesRepo := repoMocks.NewMockSeriesRepository(ctrl)
+	uc := blog.NewBlogUseCase(mockService, mockSeriesRepo, newRenderer(ctrl))
+
+	publishedAt := time.Now().Add(-time.Hour)
+	published := func(title string) entity.Blog {
+		return entity.Blog{ID: uuid.New(), Title: title, Slug: title, Status: entity.BlogStatusPublished, PublishedAt: &publishedAt}
+	}
+	first, current, last := published("one"), published("three"), published("four")
+	draft := entity.Blog{ID: uuid.New(), Title: "two", Status: entity.BlogStatusDraft}
+	series := entity.Series{ID: uuid.New(), Title: "Saga", Slug: "saga"}
+
+	mockService.On("GetByID", mock.Anything, current.ID, mock.Anything).Return(&current, nil)
+	mockSeriesRepo.EXPECT().FindContaining(gomock.Any(), current.ID).Return([]entity.Series{series}, nil)
+	mockSeriesRepo.EXPECT().GetChapters(gomock.Any(), series.ID).Return([]entity.Blog{first, draft, current, last}, nil)
+
+	resp, err := uc.GetByID(context.Background(), current.ID, nil)
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Series, 1)
+	nav := resp.Series[0]
+	// The draft chapter is neither linked nor numbered
+	assert.Equal(t, 2, nav.ChapterNumber)
+	assert.Equal(t, 3, nav.TotalChapters)
+	assert.Equal(t, first.ID, nav.Previous.ID)
+	assert.Equal(t, 1, nav.Previous.ChapterNumber)
+	assert.Equal(t, last.ID, nav.Next.ID)
+	assert.Equal(t, 3, nav.Next.ChapterNumber)
+}
+
+func TestTimeline_PassesFilterAndCursor(t *testing.T) {
+	mockService := new(MockBlogService)
+	uc := blog.NewBlogUseCase(mockService, nil, newRenderer(gomock.NewController(t)))
+
+	authorID := uuid.New()
+	tagID := uuid.New()
+	authorStr := authorID.String()
+	params := &dto.BlogTimelineParams{
+		AuthorID: &authorStr,
+		TagIDs:   []string{tagID.String(), "not-a-uuid"},
+		Cursor:   "abc",
+		Limit:    20,
+	}
+
+	mockService.On("ListPublished", mock.Anything, repository.BlogFilter{AuthorID: &authorID, TagIDs: []uuid.UUID{tagID}}, "abc", 20, (*uuid.UUID)(nil)).
+		Return(&repository.CursorPage[entity.Blog]{Data: []entity.Blog{{ID: uuid.New(), Locked: true}}, NextCursor: "next"}, nil)
+
+	page, err := uc.Timeline(context.Background(), params, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "next", page.NextCursor)
+	assert.True(t, page.Data[0].Locked)
+	mockService.AssertExpectations(t)
+}
+
+func TestReschedule_ReadsWallClockInTimezone(t *testing.T) {
+	mockService := new(MockBlogService)
+	uc := blog.NewBlogUseCase(mockService, nil, newRenderer(gomock.NewController(t)))
+
+	blogID, authorID := uuid.New(), uuid.New()
+	hanoi := "Asia/Ho_Chi_Minh"
+
+	// 09:00 in Hanoi is 02:00 UTC whatever the server's timezone is
+	mockService.On("Reschedule", mock.Anything, blogID, authorID, mock.MatchedBy(func(at time.Time) bool {
+		return at.Equal(time.Date(2030, 5, 1, 2, 0, 0, 0, time.UTC))
+	}), &hanoi).Return(&entity.Blog{ID: blogID, PublishTimezone: &hanoi}, nil)
+
+	resp, err := uc.Reschedule(context.Background(), blogID, authorID, &dto.ScheduleBlogRequest{PublishAt: "2030-05-01T09:00", Timezone: &hanoi})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &hanoi, resp.Timezone)
+	mockService.AssertExpectations(t)
+
+	// A wall-clock time needs a timezone, and the timezone must exist
+	_, err = uc.Reschedule(context.Background(), blogID, authorID, &dto.ScheduleBlogRequest{PublishAt: "2030-05-01T09:00"})
+	assert.ErrorIs(t, err, blog.ErrInvalidPublishTime)
+
+	unknown := "Mars/Olympus_Mons"
+	_, err = uc.Reschedule(context.Background(), blogID, authorID, &dto.ScheduleBlogRequest{PublishAt: "2030-05-01T09:00", Timezone: &unknown})
+	assert.ErrorIs(t, err, blog.ErrInvalidTimezone)
+}
+
+func TestPublish_SchedulesWallClockInTimezone(t *testing.T) {
+	mockService := new(MockBlogService)
+	uc := blog.NewBlogUseCase(mockService, nil, newRenderer(gomock.NewController(t)))
+
+	blogID, authorID := uuid.New(), uuid.New()
+	hanoi := "Asia/Ho_Chi_Minh"
+	publishAt := "2030-05-01T09:00"
+
+	mockService.On("Publish", mock.Anything, blogID, authorID, entity.BlogVisibilityPublic, mock.MatchedBy(func(at *time.Time) bool {
+		return at.Equal(time.Date(2030, 5, 1, 2, 0, 0, 0, time.UTC))
+	}), &hanoi).Return(&entity.Blog{ID: blogID, PublishTimezone: &hanoi}, nil)
+
+	resp, err := uc.Publish(context.Background(), blogID, authorID, &dto.PublishBlogRequest{Visibility: "public", PublishAt: &publishAt, Timezone: &hanoi})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &hanoi, resp.Timezone)
+	mockService.AssertExpectations(t)
+
+	// Validated like a reschedule
+	_, err = uc.Publish(context.Background(), blogID, authorID, &dto.PublishBlogRequest{Visibility: "public", PublishAt: &publishAt})
+	assert.ErrorIs(t, err, blog.ErrInvalidPublishTime)
+}
+
+func TestListScheduled_ShowsLocalTime(t *testing.T) {
+	mockService := new(MockBlogService)
+	uc := blog.NewBlogUseCase(mockService, nil, newRenderer(gomock.NewController(t)))
+
+	authorID := uuid.New()
+	hanoi := "Asia/Ho_Chi_Minh"
+	at := time.Date(2030, 5, 1, 2, 0, 0, 0, time.UTC)
+	mockService.On("ListScheduled", mock.Anything, authorID).Return([]entity.Blog{
+		{ID: uuid.New(), PublishedAt: &at, PublishTimezone: &hanoi},
+		{ID: uuid.New(), PublishedAt: &at},
+	}, nil)
+
+	items, err := uc.ListScheduled(context.Background(), authorID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2030-05-01T09:00:00", *items[0].LocalTime)
+	assert.Nil(t, items[1].LocalTime)
+}
+
+func TestGetBlog_RendersContent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := new(MockBlogService)
+	mockSeriesRepo := repoMocks.NewMockSeriesRepository(ctrl)
+	renderer := serviceMocks.NewMockContentRenderer(ctrl)
+	uc := blog.NewBlogUseCase(mockService, mockSeriesRepo, renderer)
+
+	b := &entity.Blog{ID: uuid.New(), Content: "## Setup\n\nRun it."}
+	mockService.On("GetByID", mock.Anything, b.ID, mock.Anything).Return(b, nil)
+	mockSeriesRepo.EXPECT().FindContaining(gomock.Any(), b.ID).Return(nil, nil)
+	renderer.EXPECT().Render(gomock.Any(), b).Return(&markdown.Document{
+		HTML:               "<h2 id=\"setup\">Setup</h2>\n<p>Run it.</p>\n",
+		TOC:                []markdown.Heading{{Level: 2, ID: "setup", Text: "Setup"}},
+		WordCount:          3,
+		ReadingTimeMinutes: 1,
+	}, nil)
+
+	resp, err := uc.GetByID(context.Background(), b.ID, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, b.Content, resp.Content)
+	assert.Equal(t, "<h2 id=\"setup\">Setup</h2>\n<p>Run it.</p>\n", resp.ContentHTML)
+	assert.Equal(t, []dto.TOCEntry{{Level: 2, ID: "setup", Text: "Setup"}}, resp.TOC)
+	assert.Equal(t, 3, resp.WordCount)
+	assert.Equal(t, 1, resp.ReadingTimeMinutes)
+}
+
+func TestUpdateBlog_RegeneratesGeneratedExcerpt(t *testing.T) {
+	oldContent := "Old intro."
+	generated := domainService.GenerateExcerpt(oldContent)
+	written := "Written by the author"
+	newContent := "New intro."
+
+	tests := []struct {
+		name    string
+		excerpt *string
+		req     dto.UpdateBlogRequest
+		want    *string
+	}{
+		{name: "generated_excerpt_follows_content", excerpt: &generated, req: dto.UpdateBlogRequest{Content: &newContent}, want: nil},
+		{name: "written_excerpt_is_kept", excerpt: &written, req: dto.UpdateBlogRequest{Content: &newContent}, want: &written},
+		{name: "excerpt_in_request_wins", excerpt: &generated, req: dto.UpdateBlogRequest{Content: &newContent, Excerpt: &written}, want: &written},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockService := new(MockBlogService)
+			mockSeriesRepo := repoMocks.NewMockSeriesRepository(ctrl)
+			uc := blog.NewBlogUseCase(mockService, mockSeriesRepo, newRenderer(ctrl))
+
+			authorID := uuid.New()
+			existing := &entity.Blog{ID: uuid.New(), AuthorID: authorID, Content: oldContent, Excerpt: tt.excerpt}
+			mockService.On("GetByID", mock.Anything, existing.ID, &authorID).Return(existing, nil)
+			mockSeriesRepo.EXPECT().FindContaining(gomock.Any(), existing.ID).Return(nil, nil)
+
+			// The service generates the excerpt again when it is left empty
+			mockService.On("Update", mock.Anything, mock.MatchedBy(func(b *entity.Blog) bool {
+				return b.Content == newContent && assert.Equal(t, tt.want, b.Excerpt)
+			}), mock.Anything).Return(nil)
+
+			_, err := uc.Update(context.Background(), existing.ID, authorID, &tt.req)
+
+			assert.NoError(t, err)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
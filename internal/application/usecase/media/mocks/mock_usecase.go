@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=usecase.go -destination=mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	multipart "mime/multipart"
+	reflect "reflect"
+
+	dto "github.com/aiagent/internal/application/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMediaUseCase is a mock of MediaUseCase interface.
+type MockMediaUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockMediaUseCaseMockRecorder is the mock recorder for MockMediaUseCase.
+type MockMediaUseCaseMockRecorder struct {
+	mock *MockMediaUseCase
+}
+
+// NewMockMediaUseCase creates a new mock instance.
+func NewMockMediaUseCase(ctrl *gomock.Controller) *MockMediaUseCase {
+	mock := &MockMediaUseCase{ctrl: ctrl}
+	mock.recorder = &MockMediaUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaUseCase) EXPECT() *MockMediaUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockMediaUseCase) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMediaUseCaseMockRecorder) Delete(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMediaUseCase)(nil).Delete), ctx, userID, id)
+}
+
+// List mocks base method.
+func (m *MockMediaUseCase) List(ctx context.Context, userID uuid.UUID, req dto.MediaListRequest) (*dto.MediaListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.MediaListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockMediaUseCaseMockRecorder) List(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMediaUseCase)(nil).List), ctx, userID, req)
+}
+
+// Upload mocks base method.
+func (m *MockMediaUseCase) Upload(ctx context.Context, userID uuid.UUID, req dto.UploadMediaRequest, file *multipart.FileHeader) (*dto.MediaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, userID, req, file)
+	ret0, _ := ret[0].(*dto.MediaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockMediaUseCaseMockRecorder) Upload(ctx, userID, req, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockMediaUseCase)(nil).Upload), ctx, userID, req, file)
+}
@@ -0,0 +1,127 @@
+package media
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	domainService "github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+// Use case errors
+var (
+	ErrUnsupportedMedia   = domainService.ErrUnsupportedMedia
+	ErrMediaTooLarge      = domainService.ErrMediaTooLarge
+	ErrMediaQuotaExceeded = domainService.ErrMediaQuotaExceeded
+	ErrMediaNotFound      = domainService.ErrMediaNotFound
+	ErrUploadFailed       = errors.New("file upload failed")
+)
+
+// MediaUseCase handles images uploaded for blog thumbnails and blog content
+type MediaUseCase interface {
+	// Upload processes and stores an image of the user
+	Upload(ctx context.Context, userID uuid.UUID, req dto.UploadMediaRequest, file *multipart.FileHeader) (*dto.MediaResponse, error)
+
+	// List returns a page of the user's media with their storage usage
+	List(ctx context.Context, userID uuid.UUID, req dto.MediaListRequest) (*dto.MediaListResponse, error)
+
+	// Delete removes an image of the user
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+}
+
+type mediaUseCase struct {
+	mediaSvc domainService.MediaService
+}
+
+// NewMediaUseCase creates a new media use case
+func NewMediaUseCase(mediaSvc domainService.MediaService) MediaUseCase {
+	return &mediaUseCase{
+		mediaSvc: mediaSvc,
+	}
+}
+
+func (uc *mediaUseCase) Upload(ctx context.Context, userID uuid.UUID, req dto.UploadMediaRequest, file *multipart.FileHeader) (*dto.MediaResponse, error) {
+	src, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUploadFailed, err)
+	}
+	defer src.Close()
+
+	asset, err := uc.mediaSvc.Upload(ctx, userID, entity.MediaPurpose(req.Purpose), src)
+	if err != nil {
+		return nil, err
+	}
+	return toMediaResponse(asset), nil
+}
+
+func (uc *mediaUseCase) List(ctx context.Context, userID uuid.UUID, req dto.MediaListRequest) (*dto.MediaListResponse, error) {
+	var purpose *entity.MediaPurpose
+	if req.Purpose != nil {
+		p := entity.MediaPurpose(*req.Purpose)
+		purpose = &p
+	}
+
+	result, err := uc.mediaSvc.List(ctx, userID, purpose, repository.Pagination{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	usage, err := uc.mediaSvc.GetUsage(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	media := make([]dto.MediaResponse, 0, len(result.Data))
+	for i := range result.Data {
+		media = append(media, *toMediaResponse(&result.Data[i]))
+	}
+
+	return &dto.MediaListResponse{
+		Media: media,
+		Usage: dto.MediaUsageResponse{
+			BytesUsed: usage.Used,
+			Quota:     usage.Quota,
+		},
+		TotalCount: result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func (uc *mediaUseCase) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	return uc.mediaSvc.Delete(ctx, userID, id)
+}
+
+func toMediaResponse(asset *entity.MediaAsset) *dto.MediaResponse {
+	variants := make([]dto.MediaVariantResponse, 0, len(asset.Variants))
+	for _, v := range asset.Variants {
+		variants = append(variants, dto.MediaVariantResponse{
+			Width:  v.Width,
+			Height: v.Height,
+			Size:   v.Size,
+			URL:    v.URL,
+		})
+	}
+
+	return &dto.MediaResponse{
+		ID:          asset.ID,
+		Purpose:     asset.Purpose,
+		ContentType: asset.ContentType,
+		Width:       asset.Width,
+		Height:      asset.Height,
+		Size:        asset.Size,
+		URL:         asset.URL,
+		Variants:    variants,
+		CreatedAt:   asset.CreatedAt,
+	}
+}
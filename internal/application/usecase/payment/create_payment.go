@@ -23,12 +23,15 @@ func NewCreatePaymentUseCase(paymentService service.PaymentService) CreatePaymen
 
 func (u *createPaymentUseCase) Execute(ctx context.Context, req dto.CreatePaymentRequest) (*dto.CreatePaymentResponse, error) {
 	serviceReq := service.CreatePaymentRequest{
-		UserID:   req.UserID,
-		Amount:   req.Amount,
-		Type:     req.Type,
-		Gateway:  req.Gateway,
-		TargetID: req.TargetID,
-		PlanID:   req.PlanID,
+		UserID:    req.UserID,
+		Amount:    req.Amount,
+		Type:      req.Type,
+		Gateway:   req.Gateway,
+		Currency:  req.Currency,
+		TargetID:  req.TargetID,
+		PlanID:    req.PlanID,
+		Gift:      req.Gift,
+		PromoCode: req.PromoCode,
 	}
 
 	resp, err := u.paymentService.InitPayment(ctx, serviceReq)
@@ -39,12 +42,16 @@ func (u *createPaymentUseCase) Execute(ctx context.Context, req dto.CreatePaymen
 	return &dto.CreatePaymentResponse{
 		OrderID:       resp.OrderID,
 		Amount:        resp.Amount,
+		Discount:      resp.Discount,
+		Currency:      resp.Currency,
 		Gateway:       resp.Gateway,
+		CheckoutURL:   resp.CheckoutURL,
 		QRDataURL:     resp.QRDataURL,
 		QRData:        resp.QRData,
 		BankName:      resp.BankName,
 		AccountNo:     resp.AccountNo,
 		AccountName:   resp.AccountName,
 		ReferenceCode: resp.ReferenceCode,
+		Status:        resp.Status,
 	}, nil
 }
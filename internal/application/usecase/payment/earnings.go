@@ -0,0 +1,186 @@
+package payment
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// earningsDayLayout is the format of the days bounding an earnings period, in UTC
+	earningsDayLayout = "2006-01-02"
+	// earningsDefaultCurrency is reported when no currency is asked for
+	earningsDefaultCurrency = "VND"
+)
+
+// EarningsUseCase exposes author balances and earnings, and the payout workflow
+type EarningsUseCase interface {
+	Balance(ctx context.Context, authorID uuid.UUID, req dto.BalanceRequest) (*dto.BalanceResponse, error)
+	Earnings(ctx context.Context, authorID uuid.UUID, req dto.EarningsRequest) (*dto.EarningsResponse, error)
+	RequestPayout(ctx context.Context, authorID uuid.UUID, req dto.CreatePayoutRequest) (*dto.PayoutResponse, error)
+	// ListPayouts returns the payouts of an author, or of every author when authorID is nil
+	ListPayouts(ctx context.Context, authorID *uuid.UUID, req dto.PayoutListRequest) (*dto.PayoutListResponse, error)
+	ReviewPayout(ctx context.Context, id, adminID uuid.UUID, req dto.ReviewPayoutRequest) (*dto.PayoutResponse, error)
+}
+
+type earningsUseCase struct {
+	ledger  service.LedgerService
+	payouts service.PayoutService
+}
+
+func NewEarningsUseCase(ledger service.LedgerService, payouts service.PayoutService) EarningsUseCase {
+	return &earningsUseCase{
+		ledger:  ledger,
+		payouts: payouts,
+	}
+}
+
+func (u *earningsUseCase) Balance(ctx context.Context, authorID uuid.UUID, req dto.BalanceRequest) (*dto.BalanceResponse, error) {
+	currency := earningsCurrency(req.Currency)
+	balance, err := u.ledger.AuthorBalance(ctx, authorID, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	status := entity.PayoutStatusPending
+	pending, err := u.payouts.ListPayouts(ctx, repository.PayoutFilter{AuthorID: &authorID, Status: &status}, repository.Pagination{Page: 1, PageSize: 1})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.BalanceResponse{
+		Balance:       balance,
+		PendingPayout: decimal.Zero,
+		Currency:      currency,
+	}
+	if len(pending.Data) > 0 && pending.Data[0].Currency == currency {
+		resp.PendingPayout = pending.Data[0].Amount
+	}
+	return resp, nil
+}
+
+func (u *earningsUseCase) Earnings(ctx context.Context, authorID uuid.UUID, req dto.EarningsRequest) (*dto.EarningsResponse, error) {
+	filter := repository.LedgerEarningsFilter{Currency: earningsCurrency(req.Currency)}
+	resp := &dto.EarningsResponse{Currency: filter.Currency}
+	if req.From != "" {
+		from, err := time.Parse(earningsDayLayout, req.From)
+		if err != nil {
+			return nil, err
+		}
+		filter.From, resp.From = &from, &req.From
+	}
+	if req.To != "" {
+		to, err := time.Parse(earningsDayLayout, req.To)
+		if err != nil {
+			return nil, err
+		}
+		to = to.AddDate(0, 0, 1) // The last day is included
+		filter.To, resp.To = &to, &req.To
+	}
+
+	earnings, err := u.ledger.AuthorEarnings(ctx, authorID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Gross = earnings.Gross
+	resp.Fee = earnings.Fee
+	resp.Net = earnings.Net
+	resp.Payments = earnings.Payments
+	resp.Breakdown = make([]dto.EarningsLineResponse, 0, len(earnings.Breakdown))
+	for _, row := range earnings.Breakdown {
+		resp.Breakdown = append(resp.Breakdown, dto.EarningsLineResponse{
+			Type:     row.TransactionType,
+			Tier:     row.Tier,
+			SeriesID: row.SeriesID,
+			Gross:    row.Gross,
+			Fee:      row.Fee,
+			Net:      row.Net,
+			Payments: row.Payments,
+		})
+	}
+	return resp, nil
+}
+
+// earningsCurrency returns the requested currency code, VND when none was given
+func earningsCurrency(currency string) string {
+	if currency == "" {
+		return earningsDefaultCurrency
+	}
+	return strings.ToUpper(currency)
+}
+
+func (u *earningsUseCase) RequestPayout(ctx context.Context, authorID uuid.UUID, req dto.CreatePayoutRequest) (*dto.PayoutResponse, error) {
+	payout, err := u.payouts.RequestPayout(ctx, authorID, service.PayoutRequest{
+		Amount:      req.Amount,
+		BankName:    req.BankName,
+		AccountNo:   req.AccountNo,
+		AccountName: req.AccountName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPayoutResponse(payout), nil
+}
+
+func (u *earningsUseCase) ListPayouts(ctx context.Context, authorID *uuid.UUID, req dto.PayoutListRequest) (*dto.PayoutListResponse, error) {
+	filter := repository.PayoutFilter{AuthorID: authorID}
+	if req.Status != "" {
+		status := entity.PayoutStatus(req.Status)
+		filter.Status = &status
+	}
+
+	result, err := u.payouts.ListPayouts(ctx, filter, repository.Pagination{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payouts := make([]dto.PayoutResponse, 0, len(result.Data))
+	for i := range result.Data {
+		payouts = append(payouts, *toPayoutResponse(&result.Data[i]))
+	}
+
+	return &dto.PayoutListResponse{
+		Payouts:    payouts,
+		TotalCount: result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func (u *earningsUseCase) ReviewPayout(ctx context.Context, id, adminID uuid.UUID, req dto.ReviewPayoutRequest) (*dto.PayoutResponse, error) {
+	payout, err := u.payouts.ReviewPayout(ctx, id, adminID, req.Action == "approve", req.Note, req.Reference)
+	if err != nil {
+		return nil, err
+	}
+	return toPayoutResponse(payout), nil
+}
+
+func toPayoutResponse(payout *entity.Payout) *dto.PayoutResponse {
+	return &dto.PayoutResponse{
+		ID:          payout.ID,
+		AuthorID:    payout.AuthorID,
+		Amount:      payout.Amount,
+		Currency:    payout.Currency,
+		BankName:    payout.BankName,
+		AccountNo:   payout.AccountNo,
+		AccountName: payout.AccountName,
+		Status:      payout.Status,
+		Note:        payout.Note,
+		Reference:   payout.Reference,
+		ReviewedBy:  payout.ReviewedBy,
+		ReviewedAt:  payout.ReviewedAt,
+		CreatedAt:   payout.CreatedAt,
+	}
+}
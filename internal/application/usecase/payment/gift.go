@@ -0,0 +1,73 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+// GiftUseCase lets users list the gift codes they paid for and redeem codes given to them
+type GiftUseCase interface {
+	Redeem(ctx context.Context, userID uuid.UUID, req dto.RedeemGiftRequest) (*dto.GiftResponse, error)
+	ListGifts(ctx context.Context, userID uuid.UUID, req dto.GiftListRequest) (*dto.GiftListResponse, error)
+}
+
+type giftUseCase struct {
+	paymentService service.PaymentService
+}
+
+func NewGiftUseCase(paymentService service.PaymentService) GiftUseCase {
+	return &giftUseCase{
+		paymentService: paymentService,
+	}
+}
+
+func (u *giftUseCase) Redeem(ctx context.Context, userID uuid.UUID, req dto.RedeemGiftRequest) (*dto.GiftResponse, error) {
+	gift, err := u.paymentService.RedeemGift(ctx, userID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return toGiftResponse(gift), nil
+}
+
+func (u *giftUseCase) ListGifts(ctx context.Context, userID uuid.UUID, req dto.GiftListRequest) (*dto.GiftListResponse, error) {
+	result, err := u.paymentService.ListGifts(ctx, userID, repository.Pagination{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gifts := make([]dto.GiftResponse, 0, len(result.Data))
+	for i := range result.Data {
+		gifts = append(gifts, *toGiftResponse(&result.Data[i]))
+	}
+
+	return &dto.GiftListResponse{
+		Gifts:      gifts,
+		TotalCount: result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func toGiftResponse(gift *entity.GiftSubscription) *dto.GiftResponse {
+	return &dto.GiftResponse{
+		ID:            gift.ID,
+		Code:          gift.Code,
+		TransactionID: gift.TransactionID,
+		AuthorID:      gift.AuthorID,
+		PlanID:        gift.PlanID,
+		Tier:          gift.Tier,
+		Status:        gift.Status,
+		RedeemedBy:    gift.RedeemedBy,
+		RedeemedAt:    gift.RedeemedAt,
+		CreatedAt:     gift.CreatedAt,
+	}
+}
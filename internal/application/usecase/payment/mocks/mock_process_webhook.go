@@ -56,3 +56,18 @@ func (mr *MockProcessWebhookUseCaseMockRecorder) Execute(ctx, req any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockProcessWebhookUseCase)(nil).Execute), ctx, req)
 }
+
+// ExecuteProvider mocks base method.
+func (m *MockProcessWebhookUseCase) ExecuteProvider(ctx context.Context, provider entity.TransactionProvider, body []byte, signature string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteProvider", ctx, provider, body, signature)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteProvider indicates an expected call of ExecuteProvider.
+func (mr *MockProcessWebhookUseCaseMockRecorder) ExecuteProvider(ctx, provider, body, signature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteProvider", reflect.TypeOf((*MockProcessWebhookUseCase)(nil).ExecuteProvider), ctx, provider, body, signature)
+}
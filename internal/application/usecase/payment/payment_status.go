@@ -0,0 +1,49 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+type PaymentStatusUseCase interface {
+	// Execute returns an order of the user, or service.ErrTransactionNotFound
+	Execute(ctx context.Context, userID uuid.UUID, orderID string) (*dto.PaymentStatusResponse, error)
+}
+
+type paymentStatusUseCase struct {
+	paymentService service.PaymentService
+}
+
+func NewPaymentStatusUseCase(paymentService service.PaymentService) PaymentStatusUseCase {
+	return &paymentStatusUseCase{
+		paymentService: paymentService,
+	}
+}
+
+func (u *paymentStatusUseCase) Execute(ctx context.Context, userID uuid.UUID, orderID string) (*dto.PaymentStatusResponse, error) {
+	tx, err := u.paymentService.GetTransactionStatus(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	// Orders of other users are reported as missing
+	if tx == nil || tx.UserID != userID {
+		return nil, service.ErrTransactionNotFound
+	}
+
+	return &dto.PaymentStatusResponse{
+		OrderID:    tx.OrderID,
+		Type:       tx.Type,
+		Amount:     tx.Amount,
+		Currency:   tx.Currency,
+		Provider:   tx.Provider,
+		Gateway:    tx.Gateway,
+		Status:     tx.Status,
+		Gift:       tx.Gift,
+		PaidAmount: tx.PaidAmount,
+		PaidAt:     tx.PaidAt,
+		CreatedAt:  tx.CreatedAt,
+	}, nil
+}
@@ -10,6 +10,9 @@ import (
 
 type ProcessWebhookUseCase interface {
 	Execute(ctx context.Context, req dto.ProcessWebhookRequest) (*entity.Transaction, error)
+	// ExecuteProvider applies a signed webhook from a payment provider. It returns a nil
+	// transaction for events that do not settle a payment.
+	ExecuteProvider(ctx context.Context, provider entity.TransactionProvider, body []byte, signature string) (*entity.Transaction, error)
 }
 
 type processWebhookUseCase struct {
@@ -40,3 +43,7 @@ func (u *processWebhookUseCase) Execute(ctx context.Context, req dto.ProcessWebh
 
 	return u.paymentService.HandleSePayWebhook(ctx, payload)
 }
+
+func (u *processWebhookUseCase) ExecuteProvider(ctx context.Context, provider entity.TransactionProvider, body []byte, signature string) (*entity.Transaction, error) {
+	return u.paymentService.HandleProviderWebhook(ctx, provider, body, signature)
+}
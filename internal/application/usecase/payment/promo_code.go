@@ -0,0 +1,79 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+// PromoCodeUseCase manages the promo codes of the signed-in author
+type PromoCodeUseCase interface {
+	Create(ctx context.Context, authorID uuid.UUID, req dto.CreatePromoCodeRequest) (*dto.PromoCodeResponse, error)
+	List(ctx context.Context, authorID uuid.UUID) (*dto.PromoCodeListResponse, error)
+	Deactivate(ctx context.Context, authorID, id uuid.UUID) error
+}
+
+type promoCodeUseCase struct {
+	promoCodes service.PromoCodeService
+}
+
+func NewPromoCodeUseCase(promoCodes service.PromoCodeService) PromoCodeUseCase {
+	return &promoCodeUseCase{
+		promoCodes: promoCodes,
+	}
+}
+
+func (u *promoCodeUseCase) Create(ctx context.Context, authorID uuid.UUID, req dto.CreatePromoCodeRequest) (*dto.PromoCodeResponse, error) {
+	serviceReq := service.CreatePromoCodeRequest{
+		Code:           req.Code,
+		DiscountType:   entity.PromoDiscountType(req.DiscountType),
+		DiscountValue:  req.DiscountValue,
+		MaxRedemptions: req.MaxRedemptions,
+		ExpiresAt:      req.ExpiresAt,
+	}
+	if req.Tier != nil {
+		tier := entity.SubscriptionTier(*req.Tier)
+		serviceReq.Tier = &tier
+	}
+
+	promo, err := u.promoCodes.CreatePromoCode(ctx, authorID, serviceReq)
+	if err != nil {
+		return nil, err
+	}
+	return toPromoCodeResponse(promo), nil
+}
+
+func (u *promoCodeUseCase) List(ctx context.Context, authorID uuid.UUID) (*dto.PromoCodeListResponse, error) {
+	promos, err := u.promoCodes.ListPromoCodes(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.PromoCodeListResponse{PromoCodes: make([]dto.PromoCodeResponse, 0, len(promos))}
+	for i := range promos {
+		resp.PromoCodes = append(resp.PromoCodes, *toPromoCodeResponse(&promos[i]))
+	}
+	return resp, nil
+}
+
+func (u *promoCodeUseCase) Deactivate(ctx context.Context, authorID, id uuid.UUID) error {
+	return u.promoCodes.DeactivatePromoCode(ctx, authorID, id)
+}
+
+func toPromoCodeResponse(promo *entity.PromoCode) *dto.PromoCodeResponse {
+	return &dto.PromoCodeResponse{
+		ID:              promo.ID,
+		Code:            promo.Code,
+		DiscountType:    promo.DiscountType,
+		DiscountValue:   promo.DiscountValue,
+		Tier:            promo.Tier,
+		MaxRedemptions:  promo.MaxRedemptions,
+		RedemptionCount: promo.RedemptionCount,
+		ExpiresAt:       promo.ExpiresAt,
+		IsActive:        promo.IsActive,
+		CreatedAt:       promo.CreatedAt,
+	}
+}
@@ -0,0 +1,82 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+// ReconciliationUseCase lets admins review transfers that did not match their order
+type ReconciliationUseCase interface {
+	List(ctx context.Context, req dto.ReconciliationListRequest) (*dto.ReconciliationListResponse, error)
+	Resolve(ctx context.Context, id, adminID uuid.UUID, req dto.ResolveReconciliationRequest) (*dto.ReconciliationResponse, error)
+}
+
+type reconciliationUseCase struct {
+	paymentService service.PaymentService
+}
+
+func NewReconciliationUseCase(paymentService service.PaymentService) ReconciliationUseCase {
+	return &reconciliationUseCase{
+		paymentService: paymentService,
+	}
+}
+
+func (u *reconciliationUseCase) List(ctx context.Context, req dto.ReconciliationListRequest) (*dto.ReconciliationListResponse, error) {
+	var filter repository.PaymentReconciliationFilter
+	if req.Status != "" {
+		status := entity.ReconciliationStatus(req.Status)
+		filter.Status = &status
+	}
+
+	result, err := u.paymentService.ListReconciliations(ctx, filter, repository.Pagination{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recs := make([]dto.ReconciliationResponse, 0, len(result.Data))
+	for i := range result.Data {
+		recs = append(recs, *toReconciliationResponse(&result.Data[i]))
+	}
+
+	return &dto.ReconciliationListResponse{
+		Reconciliations: recs,
+		TotalCount:      result.Total,
+		Page:            result.Page,
+		PageSize:        result.PageSize,
+		TotalPages:      result.TotalPages,
+	}, nil
+}
+
+func (u *reconciliationUseCase) Resolve(ctx context.Context, id, adminID uuid.UUID, req dto.ResolveReconciliationRequest) (*dto.ReconciliationResponse, error) {
+	rec, err := u.paymentService.ResolveReconciliation(ctx, id, adminID, req.Action == "grant", req.Note)
+	if err != nil {
+		return nil, err
+	}
+	return toReconciliationResponse(rec), nil
+}
+
+func toReconciliationResponse(rec *entity.PaymentReconciliation) *dto.ReconciliationResponse {
+	return &dto.ReconciliationResponse{
+		ID:             rec.ID,
+		TransactionID:  rec.TransactionID,
+		UserID:         rec.UserID,
+		SePayID:        rec.SePayID,
+		Reason:         rec.Reason,
+		ExpectedAmount: rec.ExpectedAmount,
+		ReceivedAmount: rec.ReceivedAmount,
+		Currency:       rec.Currency,
+		Status:         rec.Status,
+		Note:           rec.Note,
+		ResolvedBy:     rec.ResolvedBy,
+		ResolvedAt:     rec.ResolvedAt,
+		CreatedAt:      rec.CreatedAt,
+	}
+}
@@ -0,0 +1,69 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+// RefundUseCase lets admins refund transactions and review their refund history
+type RefundUseCase interface {
+	Refund(ctx context.Context, transactionID, adminID uuid.UUID, req dto.CreateRefundRequest) (*dto.RefundResponse, error)
+	List(ctx context.Context, transactionID uuid.UUID) ([]dto.RefundResponse, error)
+}
+
+type refundUseCase struct {
+	refundService service.RefundService
+}
+
+func NewRefundUseCase(refundService service.RefundService) RefundUseCase {
+	return &refundUseCase{
+		refundService: refundService,
+	}
+}
+
+func (u *refundUseCase) Refund(ctx context.Context, transactionID, adminID uuid.UUID, req dto.CreateRefundRequest) (*dto.RefundResponse, error) {
+	refund, err := u.refundService.RefundTransaction(ctx, transactionID, adminID, service.RefundCommand{
+		Kind:        entity.RefundKind(req.Kind),
+		Amount:      req.Amount,
+		Reason:      req.Reason,
+		ProviderRef: req.ProviderRef,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toRefundResponse(refund), nil
+}
+
+func (u *refundUseCase) List(ctx context.Context, transactionID uuid.UUID) ([]dto.RefundResponse, error) {
+	refunds, err := u.refundService.ListRefunds(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.RefundResponse, 0, len(refunds))
+	for i := range refunds {
+		resp = append(resp, *toRefundResponse(&refunds[i]))
+	}
+	return resp, nil
+}
+
+func toRefundResponse(refund *entity.PaymentRefund) *dto.RefundResponse {
+	return &dto.RefundResponse{
+		ID:             refund.ID,
+		TransactionID:  refund.TransactionID,
+		UserID:         refund.UserID,
+		Kind:           refund.Kind,
+		Amount:         refund.Amount,
+		Currency:       refund.Currency,
+		Reason:         refund.Reason,
+		Status:         refund.Status,
+		ProviderRef:    refund.ProviderRef,
+		BenefitRevoked: refund.BenefitRevoked,
+		CreatedBy:      refund.CreatedBy,
+		CreatedAt:      refund.CreatedAt,
+	}
+}
@@ -0,0 +1,73 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/domain/service"
+)
+
+// ReportUseCase lets admins read the daily reconciliation reports of payments
+type ReportUseCase interface {
+	List(ctx context.Context, req dto.PaymentReportListRequest) (*dto.PaymentReportListResponse, error)
+	Get(ctx context.Context, day string) (*dto.PaymentReportResponse, error)
+}
+
+type reportUseCase struct {
+	reportService service.PaymentReportService
+}
+
+func NewReportUseCase(reportService service.PaymentReportService) ReportUseCase {
+	return &reportUseCase{
+		reportService: reportService,
+	}
+}
+
+func (u *reportUseCase) List(ctx context.Context, req dto.PaymentReportListRequest) (*dto.PaymentReportListResponse, error) {
+	result, err := u.reportService.ListReports(ctx, repository.Pagination{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]dto.PaymentReportResponse, 0, len(result.Data))
+	for i := range result.Data {
+		reports = append(reports, *toPaymentReportResponse(&result.Data[i]))
+	}
+
+	return &dto.PaymentReportListResponse{
+		Reports:    reports,
+		TotalCount: result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func (u *reportUseCase) Get(ctx context.Context, day string) (*dto.PaymentReportResponse, error) {
+	report, err := u.reportService.GetReport(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	return toPaymentReportResponse(report), nil
+}
+
+func toPaymentReportResponse(report *entity.PaymentReport) *dto.PaymentReportResponse {
+	return &dto.PaymentReportResponse{
+		ID:            report.ID,
+		Day:           report.Day,
+		PeriodStart:   report.PeriodStart,
+		PeriodEnd:     report.PeriodEnd,
+		OrdersCreated: report.OrdersCreated,
+		OrdersPaid:    report.OrdersPaid,
+		OrdersExpired: report.OrdersExpired,
+		OrdersPending: report.OrdersPending,
+		PaidAmount:    report.PaidAmount,
+		Mismatches:    report.Mismatches,
+		CreatedAt:     report.CreatedAt,
+	}
+}
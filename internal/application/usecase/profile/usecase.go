@@ -6,41 +6,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"mime/multipart"
-	"os"
-	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/aiagent/internal/application/dto"
 	"github.com/aiagent/internal/domain/entity"
 	domainService "github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/pkg/logger"
 	"github.com/google/uuid"
 )
 
 // Use case errors
 var (
-	ErrUserNotFound      = domainService.ErrUserNotFound
-	ErrInvalidFileType   = errors.New("invalid file type, allowed: jpg, jpeg, png, gif, webp")
-	ErrFileTooLarge      = errors.New("file too large, max 5MB allowed")
-	ErrUploadFailed      = errors.New("file upload failed")
-	ErrInvalidAvatarPath = errors.New("invalid avatar path")
+	ErrUserNotFound       = domainService.ErrUserNotFound
+	ErrInvalidFileType    = domainService.ErrUnsupportedMedia
+	ErrFileTooLarge       = domainService.ErrMediaTooLarge
+	ErrMediaQuotaExceeded = domainService.ErrMediaQuotaExceeded
+	ErrUploadFailed       = errors.New("file upload failed")
 )
 
-// Allowed avatar file types
-var allowedAvatarTypes = map[string]bool{
-	".jpg":  true,
-	".jpeg": true,
-	".png":  true,
-	".gif":  true,
-	".webp": true,
-}
-
-const (
-	maxAvatarSize = 5 * 1024 * 1024 // 5MB
-	avatarDir     = "uploads/avatars"
-)
+// avatarWidth is the width of the avatar variant set as the profile picture
+const avatarWidth = 256
 
 // ProfileUseCase handles profile-related application logic
 type ProfileUseCase interface {
@@ -58,13 +45,15 @@ type ProfileUseCase interface {
 }
 
 type profileUseCase struct {
-	userSvc domainService.UserService
+	userSvc  domainService.UserService
+	mediaSvc domainService.MediaService
 }
 
 // NewProfileUseCase creates a new profile use case
-func NewProfileUseCase(userSvc domainService.UserService) ProfileUseCase {
+func NewProfileUseCase(userSvc domainService.UserService, mediaSvc domainService.MediaService) ProfileUseCase {
 	return &profileUseCase{
-		userSvc: userSvc,
+		userSvc:  userSvc,
+		mediaSvc: mediaSvc,
 	}
 }
 
@@ -143,61 +132,29 @@ func (uc *profileUseCase) UpdateProfile(ctx context.Context, userID uuid.UUID, r
 }
 
 func (uc *profileUseCase) UploadAvatar(ctx context.Context, userID uuid.UUID, file *multipart.FileHeader) (*dto.AvatarUploadResponse, error) {
-	// Validation
-	if file.Size > maxAvatarSize {
-		return nil, ErrFileTooLarge
-	}
-
-	ext := strings.ToLower(filepath.Ext(file.Filename))
-	if !allowedAvatarTypes[ext] {
-		return nil, ErrInvalidFileType
-	}
-
-	// Save file logic
-	if err := os.MkdirAll(avatarDir, 0755); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrUploadFailed, err)
-	}
-
-	filename := fmt.Sprintf("%s_%d%s", userID.String(), time.Now().UnixNano(), ext)
-	filePath := filepath.Join(avatarDir, filename)
-
-	// Path traversal check
-	absPath, err := filepath.Abs(filePath)
-	if err != nil {
-		return nil, ErrInvalidAvatarPath
-	}
-	absAvatarDir, err := filepath.Abs(avatarDir)
-	if err != nil {
-		return nil, ErrInvalidAvatarPath
-	}
-	if !strings.HasPrefix(absPath, absAvatarDir) {
-		return nil, ErrInvalidAvatarPath
-	}
-
-	// Save file
 	src, err := file.Open()
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrUploadFailed, err)
 	}
 	defer src.Close()
 
-	dst, err := os.Create(filePath)
+	// Validated by content, re-encoded without metadata and resized by the media pipeline
+	asset, err := uc.mediaSvc.Upload(ctx, userID, entity.MediaPurposeAvatar, src)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrUploadFailed, err)
-	}
-	defer dst.Close()
-
-	if _, err := io.Copy(dst, src); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrUploadFailed, err)
+		return nil, err
 	}
 
-	// Update DB via Domain Service
-	avatarURL := "/" + filePath
+	avatarURL := asset.URLForWidth(avatarWidth)
 	if err := uc.userSvc.UpdateAvatarURL(ctx, userID, avatarURL); err != nil {
-		os.Remove(filePath) // Cleanup
+		uc.mediaSvc.Delete(ctx, userID, asset.ID) // Cleanup
 		return nil, err
 	}
 
+	// Previous avatars are no longer shown; free the storage they use
+	if err := uc.mediaSvc.PruneAvatars(ctx, userID, asset.ID); err != nil {
+		logger.Error("failed to prune previous avatars", err, map[string]interface{}{"user_id": userID})
+	}
+
 	return &dto.AvatarUploadResponse{
 		AvatarURL: avatarURL,
 	}, nil
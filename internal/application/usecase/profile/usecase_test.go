@@ -1,13 +1,17 @@
 package profile_test
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"mime/multipart"
 	"testing"
 	"time"
 
 	"github.com/aiagent/internal/application/dto"
 	"github.com/aiagent/internal/application/usecase/profile"
 	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
 	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,7 +24,7 @@ func TestUpdateProfile_WithGender(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserSvc := serviceMocks.NewMockUserService(ctrl)
-	uc := profile.NewProfileUseCase(mockUserSvc)
+	uc := profile.NewProfileUseCase(mockUserSvc, serviceMocks.NewMockMediaService(ctrl))
 	userID := uuid.New()
 
 	gender := "male"
@@ -53,7 +57,7 @@ func TestUpdateProfile_WithBirthday(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserSvc := serviceMocks.NewMockUserService(ctrl)
-	uc := profile.NewProfileUseCase(mockUserSvc)
+	uc := profile.NewProfileUseCase(mockUserSvc, serviceMocks.NewMockMediaService(ctrl))
 	userID := uuid.New()
 
 	birthdayStr := "1990-01-01"
@@ -87,7 +91,7 @@ func TestUpdateProfile_WithDescription(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserSvc := serviceMocks.NewMockUserService(ctrl)
-	uc := profile.NewProfileUseCase(mockUserSvc)
+	uc := profile.NewProfileUseCase(mockUserSvc, serviceMocks.NewMockMediaService(ctrl))
 	userID := uuid.New()
 
 	description := "This is a long description about the user."
@@ -120,7 +124,7 @@ func TestGetProfile_WithDescription(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserSvc := serviceMocks.NewMockUserService(ctrl)
-	uc := profile.NewProfileUseCase(mockUserSvc)
+	uc := profile.NewProfileUseCase(mockUserSvc, serviceMocks.NewMockMediaService(ctrl))
 	userID := uuid.New()
 
 	description := "User description"
@@ -148,7 +152,7 @@ func TestGetPublicProfile_WithDescription(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserSvc := serviceMocks.NewMockUserService(ctrl)
-	uc := profile.NewProfileUseCase(mockUserSvc)
+	uc := profile.NewProfileUseCase(mockUserSvc, serviceMocks.NewMockMediaService(ctrl))
 	userID := uuid.New()
 
 	description := "Public user description"
@@ -174,7 +178,7 @@ func TestUpdateProfile_WithFacebookURL(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserSvc := serviceMocks.NewMockUserService(ctrl)
-	uc := profile.NewProfileUseCase(mockUserSvc)
+	uc := profile.NewProfileUseCase(mockUserSvc, serviceMocks.NewMockMediaService(ctrl))
 	userID := uuid.New()
 
 	facebookURL := "https://facebook.com/testuser"
@@ -207,7 +211,7 @@ func TestGetProfile_WithFacebookURL(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserSvc := serviceMocks.NewMockUserService(ctrl)
-	uc := profile.NewProfileUseCase(mockUserSvc)
+	uc := profile.NewProfileUseCase(mockUserSvc, serviceMocks.NewMockMediaService(ctrl))
 	userID := uuid.New()
 
 	facebookURL := "https://facebook.com/testuser"
@@ -235,7 +239,7 @@ func TestGetPublicProfile_WithFacebookURL(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserSvc := serviceMocks.NewMockUserService(ctrl)
-	uc := profile.NewProfileUseCase(mockUserSvc)
+	uc := profile.NewProfileUseCase(mockUserSvc, serviceMocks.NewMockMediaService(ctrl))
 	userID := uuid.New()
 
 	facebookURL := "https://facebook.com/testuser"
@@ -254,3 +258,94 @@ func TestGetPublicProfile_WithFacebookURL(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, facebookURL, resp.FacebookURL)
 }
+
+// avatarFileHeader returns a multipart file header as parsed from an upload form
+func avatarFileHeader(t *testing.T, filename string, content []byte) *multipart.FileHeader {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("avatar", filename)
+	assert.NoError(t, err)
+	part.Write(content)
+	writer.Close()
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	assert.NoError(t, err)
+	return form.File["avatar"][0]
+}
+
+func TestUploadAvatar(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserSvc := serviceMocks.NewMockUserService(ctrl)
+	mockMediaSvc := serviceMocks.NewMockMediaService(ctrl)
+	uc := profile.NewProfileUseCase(mockUserSvc, mockMediaSvc)
+	userID := uuid.New()
+	asset := &entity.MediaAsset{
+		ID:  uuid.New(),
+		URL: "https://cdn.example/original.png",
+		Variants: []entity.MediaVariant{
+			{Width: 128, URL: "https://cdn.example/w128.png"},
+			{Width: 256, URL: "https://cdn.example/w256.png"},
+		},
+	}
+
+	mockMediaSvc.EXPECT().Upload(gomock.Any(), userID, entity.MediaPurposeAvatar, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ entity.MediaPurpose, r io.Reader) (*entity.MediaAsset, error) {
+			data, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Equal(t, "image-bytes", string(data))
+			return asset, nil
+		})
+	mockUserSvc.EXPECT().UpdateAvatarURL(gomock.Any(), userID, "https://cdn.example/w256.png").Return(nil)
+	mockMediaSvc.EXPECT().PruneAvatars(gomock.Any(), userID, asset.ID).Return(nil)
+
+	// Act
+	resp, err := uc.UploadAvatar(context.Background(), userID, avatarFileHeader(t, "me.png", []byte("image-bytes")))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.example/w256.png", resp.AvatarURL)
+}
+
+func TestUploadAvatar_RejectedByContent(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMediaSvc := serviceMocks.NewMockMediaService(ctrl)
+	uc := profile.NewProfileUseCase(serviceMocks.NewMockUserService(ctrl), mockMediaSvc)
+	userID := uuid.New()
+
+	// The extension is not trusted; the media pipeline sniffs the content
+	mockMediaSvc.EXPECT().Upload(gomock.Any(), userID, entity.MediaPurposeAvatar, gomock.Any()).Return(nil, service.ErrUnsupportedMedia)
+
+	// Act
+	_, err := uc.UploadAvatar(context.Background(), userID, avatarFileHeader(t, "me.png", []byte("<?php echo 1; ?>")))
+
+	// Assert
+	assert.ErrorIs(t, err, profile.ErrInvalidFileType)
+}
+
+func TestUploadAvatar_ProfileUpdateFails(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserSvc := serviceMocks.NewMockUserService(ctrl)
+	mockMediaSvc := serviceMocks.NewMockMediaService(ctrl)
+	uc := profile.NewProfileUseCase(mockUserSvc, mockMediaSvc)
+	userID := uuid.New()
+	asset := &entity.MediaAsset{ID: uuid.New(), URL: "https://cdn.example/original.png"}
+
+	mockMediaSvc.EXPECT().Upload(gomock.Any(), userID, entity.MediaPurposeAvatar, gomock.Any()).Return(asset, nil)
+	mockUserSvc.EXPECT().UpdateAvatarURL(gomock.Any(), userID, asset.URL).Return(profile.ErrUserNotFound)
+	mockMediaSvc.EXPECT().Delete(gomock.Any(), userID, asset.ID).Return(nil)
+
+	// Act
+	_, err := uc.UploadAvatar(context.Background(), userID, avatarFileHeader(t, "me.png", []byte("image-bytes")))
+
+	// Assert
+	assert.ErrorIs(t, err, profile.ErrUserNotFound)
+}
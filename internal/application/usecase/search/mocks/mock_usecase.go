@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=usecase.go -destination=mocks/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/aiagent/internal/application/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSearchUseCase is a mock of SearchUseCase interface.
+type MockSearchUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchUseCaseMockRecorder is the mock recorder for MockSearchUseCase.
+type MockSearchUseCaseMockRecorder struct {
+	mock *MockSearchUseCase
+}
+
+// NewMockSearchUseCase creates a new mock instance.
+func NewMockSearchUseCase(ctrl *gomock.Controller) *MockSearchUseCase {
+	mock := &MockSearchUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchUseCase) EXPECT() *MockSearchUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearchUseCase) Search(ctx context.Context, params *dto.SearchParams, viewerID *uuid.UUID) (*dto.SearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, params, viewerID)
+	ret0, _ := ret[0].(*dto.SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchUseCaseMockRecorder) Search(ctx, params, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchUseCase)(nil).Search), ctx, params, viewerID)
+}
@@ -0,0 +1,184 @@
+package search
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// groupLimit caps series, tag and author results when every type is searched at once
+	groupLimit = 5
+	// maxGroupLimit caps them when a single type is searched
+	maxGroupLimit = 50
+	facetLimit    = 10
+)
+
+type SearchUseCase interface {
+	// Search runs a full-text query. viewerID decides which drafts, subscriber-only
+	// and tier-gated blogs are included; it is nil for anonymous requests.
+	Search(ctx context.Context, params *dto.SearchParams, viewerID *uuid.UUID) (*dto.SearchResponse, error)
+}
+
+type searchUseCase struct {
+	searchRepo repository.SearchRepository
+}
+
+func NewSearchUseCase(searchRepo repository.SearchRepository) SearchUseCase {
+	return &searchUseCase{
+		searchRepo: searchRepo,
+	}
+}
+
+func (uc *searchUseCase) Search(ctx context.Context, params *dto.SearchParams, viewerID *uuid.UUID) (*dto.SearchResponse, error) {
+	query := strings.TrimSpace(params.Q)
+	searchType := params.Type
+	if searchType == "" {
+		searchType = dto.SearchTypeAll
+	}
+
+	limit := groupLimit
+	if searchType != dto.SearchTypeAll {
+		limit = min(max(params.PageSize, 1), maxGroupLimit)
+	}
+
+	var resp dto.SearchResponse
+	var err error
+
+	if searchType == dto.SearchTypeAll || searchType == dto.SearchTypeBlogs {
+		filter := uc.toBlogSearchFilter(query, params, viewerID)
+		if resp.Blogs, err = uc.searchBlogs(ctx, filter, params); err != nil {
+			return nil, err
+		}
+		if resp.Facets, err = uc.blogFacets(ctx, filter); err != nil {
+			return nil, err
+		}
+	}
+	if searchType == dto.SearchTypeAll || searchType == dto.SearchTypeSeries {
+		if resp.Series, err = uc.searchGroup(ctx, uc.searchRepo.SearchSeries, query, limit); err != nil {
+			return nil, err
+		}
+	}
+	if searchType == dto.SearchTypeAll || searchType == dto.SearchTypeTags {
+		if resp.Tags, err = uc.searchGroup(ctx, uc.searchRepo.SearchTags, query, limit); err != nil {
+			return nil, err
+		}
+	}
+	if searchType == dto.SearchTypeAll || searchType == dto.SearchTypeAuthors {
+		if resp.Authors, err = uc.searchGroup(ctx, uc.searchRepo.SearchAuthors, query, limit); err != nil {
+			return nil, err
+		}
+	}
+
+	return &resp, nil
+}
+
+func (uc *searchUseCase) searchBlogs(ctx context.Context, filter repository.BlogSearchFilter, params *dto.SearchParams) (*dto.BlogSearchResults, error) {
+	result, err := uc.searchRepo.SearchBlogs(ctx, filter, repository.Pagination{
+		Page:     params.Page,
+		PageSize: params.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.BlogSearchResultResponse, len(result.Data))
+	for i, hit := range result.Data {
+		results[i] = dto.BlogSearchResultResponse{
+			ID:             hit.ID,
+			Title:          hit.Title,
+			TitleHighlight: hit.TitleHighlight,
+			Slug:           hit.Slug,
+			Snippet:        hit.Snippet,
+			Author:         dto.AuthorBriefResponse{ID: hit.AuthorID, Name: hit.AuthorName},
+			CategoryID:     hit.CategoryID,
+			Visibility:     hit.Visibility,
+			PublishedAt:    hit.PublishedAt,
+			Rank:           hit.Rank,
+			Locked:         hit.Locked,
+		}
+	}
+
+	return &dto.BlogSearchResults{
+		Data:       results,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func (uc *searchUseCase) blogFacets(ctx context.Context, filter repository.BlogSearchFilter) (*dto.SearchFacetsResponse, error) {
+	facets, err := uc.searchRepo.BlogFacets(ctx, filter, facetLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SearchFacetsResponse{
+		Categories: toFacetResponses(facets.Categories),
+		Tags:       toFacetResponses(facets.Tags),
+		Authors:    toFacetResponses(facets.Authors),
+	}, nil
+}
+
+func (uc *searchUseCase) searchGroup(
+	ctx context.Context,
+	search func(ctx context.Context, query string, limit int) ([]repository.SearchHit, error),
+	query string,
+	limit int,
+) ([]dto.SearchResultResponse, error) {
+	hits, err := search(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.SearchResultResponse, len(hits))
+	for i, hit := range hits {
+		results[i] = dto.SearchResultResponse{
+			ID:        hit.ID,
+			Title:     hit.Title,
+			Slug:      hit.Slug,
+			Highlight: hit.Snippet,
+			Rank:      hit.Rank,
+		}
+	}
+	return results, nil
+}
+
+func (uc *searchUseCase) toBlogSearchFilter(query string, params *dto.SearchParams, viewerID *uuid.UUID) repository.BlogSearchFilter {
+	filter := repository.BlogSearchFilter{
+		Query:    query,
+		ViewerID: viewerID,
+	}
+
+	if params.AuthorID != nil {
+		if id, err := uuid.Parse(*params.AuthorID); err == nil {
+			filter.AuthorID = &id
+		}
+	}
+	if params.CategoryID != nil {
+		if id, err := uuid.Parse(*params.CategoryID); err == nil {
+			filter.CategoryID = &id
+		}
+	}
+	for _, idStr := range params.TagIDs {
+		if id, err := uuid.Parse(idStr); err == nil {
+			filter.TagIDs = append(filter.TagIDs, id)
+		}
+	}
+
+	return filter
+}
+
+func toFacetResponses(facets []repository.SearchFacet) []dto.SearchFacetResponse {
+	responses := make([]dto.SearchFacetResponse, len(facets))
+	for i, facet := range facets {
+		responses[i] = dto.SearchFacetResponse{ID: facet.ID, Name: facet.Name, Count: facet.Count}
+	}
+	return responses
+}
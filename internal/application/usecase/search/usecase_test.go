@@ -0,0 +1,86 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/application/usecase/search"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchUseCase_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSearchRepository(ctrl)
+	uc := search.NewSearchUseCase(mockRepo)
+
+	ctx := context.Background()
+	viewerID := uuid.New()
+	categoryID := uuid.New()
+	tagID := uuid.New()
+
+	t.Run("all_types", func(t *testing.T) {
+		categoryStr, tagStr := categoryID.String(), tagID.String()
+		params := &dto.SearchParams{
+			Q:          "  golang generics ",
+			Type:       dto.SearchTypeAll,
+			CategoryID: &categoryStr,
+			TagIDs:     []string{tagStr, "not-a-uuid"},
+			Page:       2,
+			PageSize:   10,
+		}
+		wantFilter := repository.BlogSearchFilter{
+			Query:      "golang generics",
+			CategoryID: &categoryID,
+			TagIDs:     []uuid.UUID{tagID},
+			ViewerID:   &viewerID,
+		}
+		hit := repository.BlogSearchHit{ID: uuid.New(), AuthorID: uuid.New(), AuthorName: "Ada", Title: "Go generics", Locked: true}
+
+		mockRepo.EXPECT().SearchBlogs(ctx, wantFilter, repository.Pagination{Page: 2, PageSize: 10}).
+			Return(&repository.PaginatedResult[repository.BlogSearchHit]{Data: []repository.BlogSearchHit{hit}, Total: 11, Page: 2, PageSize: 10, TotalPages: 2}, nil)
+		mockRepo.EXPECT().BlogFacets(ctx, wantFilter, 10).
+			Return(&repository.BlogSearchFacets{Tags: []repository.SearchFacet{{ID: tagID, Name: "go", Count: 11}}}, nil)
+		mockRepo.EXPECT().SearchSeries(ctx, "golang generics", 5).Return(nil, nil)
+		mockRepo.EXPECT().SearchTags(ctx, "golang generics", 5).Return([]repository.SearchHit{{ID: tagID, Title: "golang", Snippet: "<mark>golang</mark>"}}, nil)
+		mockRepo.EXPECT().SearchAuthors(ctx, "golang generics", 5).Return(nil, nil)
+
+		resp, err := uc.Search(ctx, params, &viewerID)
+
+		require.NoError(t, err)
+		require.Len(t, resp.Blogs.Data, 1)
+		assert.Equal(t, "Ada", resp.Blogs.Data[0].Author.Name)
+		assert.True(t, resp.Blogs.Data[0].Locked)
+		assert.Equal(t, 2, resp.Blogs.TotalPages)
+		assert.Equal(t, int64(11), resp.Facets.Tags[0].Count)
+		assert.Empty(t, resp.Facets.Categories)
+		assert.Equal(t, "<mark>golang</mark>", resp.Tags[0].Highlight)
+	})
+
+	t.Run("single_type_uses_page_size", func(t *testing.T) {
+		mockRepo.EXPECT().SearchAuthors(ctx, "ada", 20).Return([]repository.SearchHit{{ID: uuid.New(), Title: "Ada"}}, nil)
+
+		resp, err := uc.Search(ctx, &dto.SearchParams{Q: "ada", Type: dto.SearchTypeAuthors, Page: 1, PageSize: 20}, nil)
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.Blogs)
+		assert.Nil(t, resp.Facets)
+		assert.Len(t, resp.Authors, 1)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		mockRepo.EXPECT().SearchSeries(ctx, "go", 10).Return(nil, errors.New("db down"))
+
+		_, err := uc.Search(ctx, &dto.SearchParams{Q: "go", Type: dto.SearchTypeSeries, Page: 1, PageSize: 10}, nil)
+
+		assert.Error(t, err)
+	})
+}
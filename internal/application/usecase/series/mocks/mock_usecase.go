@@ -100,6 +100,36 @@ func (mr *MockSeriesUseCaseMockRecorder) GetHighlightedSeries(ctx any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighlightedSeries", reflect.TypeOf((*MockSeriesUseCase)(nil).GetHighlightedSeries), ctx)
 }
 
+// GetLibrary mocks base method.
+func (m *MockSeriesUseCase) GetLibrary(ctx context.Context, userID uuid.UUID) ([]dto.LibraryItemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLibrary", ctx, userID)
+	ret0, _ := ret[0].([]dto.LibraryItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLibrary indicates an expected call of GetLibrary.
+func (mr *MockSeriesUseCaseMockRecorder) GetLibrary(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLibrary", reflect.TypeOf((*MockSeriesUseCase)(nil).GetLibrary), ctx, userID)
+}
+
+// GetProgress mocks base method.
+func (m *MockSeriesUseCase) GetProgress(ctx context.Context, userID, seriesID uuid.UUID) (*dto.SeriesProgressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProgress", ctx, userID, seriesID)
+	ret0, _ := ret[0].(*dto.SeriesProgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProgress indicates an expected call of GetProgress.
+func (mr *MockSeriesUseCaseMockRecorder) GetProgress(ctx, userID, seriesID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProgress", reflect.TypeOf((*MockSeriesUseCase)(nil).GetProgress), ctx, userID, seriesID)
+}
+
 // GetSeriesByID mocks base method.
 func (m *MockSeriesUseCase) GetSeriesByID(ctx context.Context, id uuid.UUID) (*dto.SeriesResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +190,34 @@ func (mr *MockSeriesUseCaseMockRecorder) RemoveBlogFromSeries(ctx, userID, serie
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlogFromSeries", reflect.TypeOf((*MockSeriesUseCase)(nil).RemoveBlogFromSeries), ctx, userID, seriesID, blogID)
 }
 
+// ReorderBlogs mocks base method.
+func (m *MockSeriesUseCase) ReorderBlogs(ctx context.Context, userID, seriesID uuid.UUID, blogIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderBlogs", ctx, userID, seriesID, blogIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderBlogs indicates an expected call of ReorderBlogs.
+func (mr *MockSeriesUseCaseMockRecorder) ReorderBlogs(ctx, userID, seriesID, blogIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderBlogs", reflect.TypeOf((*MockSeriesUseCase)(nil).ReorderBlogs), ctx, userID, seriesID, blogIDs)
+}
+
+// SetBlogPreview mocks base method.
+func (m *MockSeriesUseCase) SetBlogPreview(ctx context.Context, userID, seriesID, blogID uuid.UUID, preview bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlogPreview", ctx, userID, seriesID, blogID, preview)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBlogPreview indicates an expected call of SetBlogPreview.
+func (mr *MockSeriesUseCaseMockRecorder) SetBlogPreview(ctx, userID, seriesID, blogID, preview any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlogPreview", reflect.TypeOf((*MockSeriesUseCase)(nil).SetBlogPreview), ctx, userID, seriesID, blogID, preview)
+}
+
 // UpdateSeries mocks base method.
 func (m *MockSeriesUseCase) UpdateSeries(ctx context.Context, userID, seriesID uuid.UUID, req *dto.UpdateSeriesRequest) (*dto.SeriesResponse, error) {
 	m.ctrl.T.Helper()
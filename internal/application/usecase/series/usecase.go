@@ -5,6 +5,7 @@ package series
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/aiagent/internal/application/dto"
 	"github.com/aiagent/internal/domain/entity"
@@ -12,6 +13,16 @@ import (
 	"github.com/google/uuid"
 )
 
+const defaultSeriesCurrency = "VND"
+
+var (
+	ErrNotSeriesAuthor     = errors.New("unauthorized: you are not the author of this series")
+	ErrInvalidSeriesPrice  = errors.New("series price must not be negative")
+	ErrBlogNotInSeries     = errors.New("blog is not part of this series")
+	ErrInvalidChapterOrder = errors.New("chapter order must list every blog in the series exactly once")
+	ErrSeriesNotFound      = errors.New("series not found")
+)
+
 // SeriesUseCase defines the interface for series business logic
 type SeriesUseCase interface {
 	CreateSeries(ctx context.Context, userID uuid.UUID, req *dto.CreateSeriesRequest) (*dto.SeriesResponse, error)
@@ -23,17 +34,33 @@ type SeriesUseCase interface {
 	AddBlogToSeries(ctx context.Context, userID, seriesID, blogID uuid.UUID) error
 	RemoveBlogFromSeries(ctx context.Context, userID, seriesID, blogID uuid.UUID) error
 	GetHighlightedSeries(ctx context.Context) ([]*dto.HighlightedSeriesResponse, error)
+	// SetBlogPreview marks a chapter of a paid series as free to read for everyone
+	SetBlogPreview(ctx context.Context, userID, seriesID, blogID uuid.UUID, preview bool) error
+	// GetLibrary lists the series the user bought, newest purchase first
+	GetLibrary(ctx context.Context, userID uuid.UUID) ([]dto.LibraryItemResponse, error)
+	// ReorderBlogs sets the chapter order; blogIDs must be a permutation of the current chapters
+	ReorderBlogs(ctx context.Context, userID, seriesID uuid.UUID, blogIDs []uuid.UUID) error
+	// GetProgress reports which published chapters the user has read, based on their reading history
+	GetProgress(ctx context.Context, userID, seriesID uuid.UUID) (*dto.SeriesProgressResponse, error)
 }
 
 type seriesUseCase struct {
-	seriesRepo repository.SeriesRepository
-	blogRepo   repository.BlogRepository // Needed to verify blog ownership/existence? Maybe not strictly if DB enforces FK.
+	seriesRepo   repository.SeriesRepository
+	purchaseRepo repository.UserSeriesPurchaseRepository
+	historyRepo  repository.ReadingHistoryRepository
+	blogRepo     repository.BlogRepository // Needed to verify blog ownership/existence? Maybe not strictly if DB enforces FK.
 }
 
 // NewSeriesUseCase creates a new instance of SeriesUseCase
-func NewSeriesUseCase(seriesRepo repository.SeriesRepository) SeriesUseCase {
+func NewSeriesUseCase(
+	seriesRepo repository.SeriesRepository,
+	purchaseRepo repository.UserSeriesPurchaseRepository,
+	historyRepo repository.ReadingHistoryRepository,
+) SeriesUseCase {
 	return &seriesUseCase{
-		seriesRepo: seriesRepo,
+		seriesRepo:   seriesRepo,
+		purchaseRepo: purchaseRepo,
+		historyRepo:  historyRepo,
 	}
 }
 
@@ -43,6 +70,16 @@ func (u *seriesUseCase) CreateSeries(ctx context.Context, userID uuid.UUID, req
 		Title:       req.Title,
 		Slug:        req.Slug,
 		Description: req.Description,
+		Currency:    defaultSeriesCurrency,
+	}
+	if req.Price != nil {
+		if req.Price.IsNegative() {
+			return nil, ErrInvalidSeriesPrice
+		}
+		series.Price = *req.Price
+	}
+	if req.Currency != "" {
+		series.Currency = strings.ToUpper(req.Currency)
 	}
 
 	if err := u.seriesRepo.Create(ctx, series); err != nil {
@@ -59,7 +96,7 @@ func (u *seriesUseCase) UpdateSeries(ctx context.Context, userID, seriesID uuid.
 	}
 
 	if series.AuthorID != userID {
-		return nil, errors.New("unauthorized: you are not the author of this series")
+		return nil, ErrNotSeriesAuthor
 	}
 
 	if req.Title != "" {
@@ -68,6 +105,15 @@ func (u *seriesUseCase) UpdateSeries(ctx context.Context, userID, seriesID uuid.
 	if req.Description != "" {
 		series.Description = req.Description
 	}
+	if req.Price != nil {
+		if req.Price.IsNegative() {
+			return nil, ErrInvalidSeriesPrice
+		}
+		series.Price = *req.Price
+	}
+	if req.Currency != nil {
+		series.Currency = strings.ToUpper(*req.Currency)
+	}
 
 	if err := u.seriesRepo.Update(ctx, series); err != nil {
 		return nil, err
@@ -83,7 +129,7 @@ func (u *seriesUseCase) DeleteSeries(ctx context.Context, userID, seriesID uuid.
 	}
 
 	if series.AuthorID != userID {
-		return errors.New("unauthorized: you are not the author of this series")
+		return ErrNotSeriesAuthor
 	}
 
 	return u.seriesRepo.Delete(ctx, seriesID)
@@ -94,7 +140,7 @@ func (u *seriesUseCase) GetSeriesByID(ctx context.Context, id uuid.UUID) (*dto.S
 	if err != nil {
 		return nil, err
 	}
-	return u.mapSeriesToDTO(series), nil
+	return u.mapSeriesWithPreviews(ctx, series)
 }
 
 func (u *seriesUseCase) GetSeriesBySlug(ctx context.Context, slug string) (*dto.SeriesResponse, error) {
@@ -102,7 +148,20 @@ func (u *seriesUseCase) GetSeriesBySlug(ctx context.Context, slug string) (*dto.
 	if err != nil {
 		return nil, err
 	}
-	return u.mapSeriesToDTO(series), nil
+	return u.mapSeriesWithPreviews(ctx, series)
+}
+
+// mapSeriesWithPreviews maps a series and, when it is paid, lists its free preview chapters
+func (u *seriesUseCase) mapSeriesWithPreviews(ctx context.Context, series *entity.Series) (*dto.SeriesResponse, error) {
+	resp := u.mapSeriesToDTO(series)
+	if series.IsPaid() {
+		previews, err := u.seriesRepo.GetPreviewBlogIDs(ctx, series.ID)
+		if err != nil {
+			return nil, err
+		}
+		resp.PreviewBlogIDs = previews
+	}
+	return resp, nil
 }
 
 func (u *seriesUseCase) ListSeries(ctx context.Context, params *dto.SeriesFilterParams) ([]dto.SeriesResponse, int64, error) {
@@ -136,7 +195,7 @@ func (u *seriesUseCase) AddBlogToSeries(ctx context.Context, userID, seriesID, b
 	}
 
 	if series.AuthorID != userID {
-		return errors.New("unauthorized: you are not the author of this series")
+		return ErrNotSeriesAuthor
 	}
 
 	// Should we check if the user is also the author of the blog?
@@ -155,12 +214,135 @@ func (u *seriesUseCase) RemoveBlogFromSeries(ctx context.Context, userID, series
 	}
 
 	if series.AuthorID != userID {
-		return errors.New("unauthorized: you are not the author of this series")
+		return ErrNotSeriesAuthor
 	}
 
 	return u.seriesRepo.RemoveBlog(ctx, seriesID, blogID)
 }
 
+func (u *seriesUseCase) SetBlogPreview(ctx context.Context, userID, seriesID, blogID uuid.UUID, preview bool) error {
+	series, err := u.seriesRepo.GetByID(ctx, seriesID)
+	if err != nil {
+		return err
+	}
+
+	if series.AuthorID != userID {
+		return ErrNotSeriesAuthor
+	}
+
+	updated, err := u.seriesRepo.SetBlogPreview(ctx, seriesID, blogID, preview)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return ErrBlogNotInSeries
+	}
+	return nil
+}
+
+func (u *seriesUseCase) GetLibrary(ctx context.Context, userID uuid.UUID) ([]dto.LibraryItemResponse, error) {
+	purchases, err := u.purchaseRepo.GetUserPurchases(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	library := make([]dto.LibraryItemResponse, 0, len(purchases))
+	for _, purchase := range purchases {
+		// Buyers keep their purchase record, but deleted series are no longer readable
+		if purchase.Series == nil || purchase.Series.DeletedAt != nil {
+			continue
+		}
+		library = append(library, dto.LibraryItemResponse{
+			Series:      *u.mapSeriesToDTO(purchase.Series),
+			Amount:      purchase.Amount,
+			PurchasedAt: purchase.CreatedAt,
+		})
+	}
+
+	return library, nil
+}
+
+func (u *seriesUseCase) ReorderBlogs(ctx context.Context, userID, seriesID uuid.UUID, blogIDs []uuid.UUID) error {
+	series, err := u.seriesRepo.GetByID(ctx, seriesID)
+	if err != nil {
+		return err
+	}
+
+	if series.AuthorID != userID {
+		return ErrNotSeriesAuthor
+	}
+
+	if len(blogIDs) != len(series.Blogs) {
+		return ErrInvalidChapterOrder
+	}
+	pending := make(map[uuid.UUID]bool, len(series.Blogs))
+	for _, blog := range series.Blogs {
+		pending[blog.ID] = true
+	}
+	for _, id := range blogIDs {
+		if !pending[id] {
+			return ErrInvalidChapterOrder
+		}
+		delete(pending, id)
+	}
+
+	return u.seriesRepo.ReorderBlogs(ctx, seriesID, blogIDs)
+}
+
+func (u *seriesUseCase) GetProgress(ctx context.Context, userID, seriesID uuid.UUID) (*dto.SeriesProgressResponse, error) {
+	exists, err := u.seriesRepo.Exists(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrSeriesNotFound
+	}
+
+	chapters, err := u.seriesRepo.GetChapters(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drafts and chapters scheduled for later are not counted until readers can open them
+	published := make([]uuid.UUID, 0, len(chapters))
+	for _, chapter := range chapters {
+		if chapter.IsLive() {
+			published = append(published, chapter.ID)
+		}
+	}
+
+	histories, err := u.historyRepo.GetByBlogIDs(ctx, userID, published)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.SeriesProgressResponse{
+		SeriesID:      seriesID,
+		TotalChapters: len(published),
+		ReadChapters:  len(histories),
+	}
+	read := make(map[uuid.UUID]bool, len(histories))
+	for _, history := range histories {
+		read[history.BlogID] = true
+		if resp.LastReadAt == nil || history.LastReadAt.After(*resp.LastReadAt) {
+			blogID, lastReadAt := history.BlogID, history.LastReadAt
+			resp.LastReadBlogID, resp.LastReadAt = &blogID, &lastReadAt
+		}
+	}
+	for _, id := range published {
+		if !read[id] {
+			next := id
+			resp.NextBlogID = &next
+			break
+		}
+	}
+	if resp.TotalChapters > 0 {
+		resp.Percent = resp.ReadChapters * 100 / resp.TotalChapters
+	}
+
+	return resp, nil
+}
+
 func (u *seriesUseCase) mapSeriesToDTO(series *entity.Series) *dto.SeriesResponse {
 	resp := &dto.SeriesResponse{
 		ID:          series.ID,
@@ -168,6 +350,8 @@ func (u *seriesUseCase) mapSeriesToDTO(series *entity.Series) *dto.SeriesRespons
 		Title:       series.Title,
 		Slug:        series.Slug,
 		Description: series.Description,
+		Price:       series.Price,
+		Currency:    series.Currency,
 		CreatedAt:   series.CreatedAt,
 		UpdatedAt:   series.UpdatedAt,
 	}
@@ -181,11 +365,17 @@ func (u *seriesUseCase) mapSeriesToDTO(series *entity.Series) *dto.SeriesRespons
 	}
 
 	if len(series.Blogs) > 0 {
-		resp.Blogs = make([]dto.BlogListResponse, len(series.Blogs))
+		resp.Blogs = make([]dto.SeriesChapterResponse, len(series.Blogs))
+		chapter := 0
 		for i, blog := range series.Blogs {
 			// Using a helper to map blog to DTO would be better to avoid duplication with BookmarkUseCase
 			// For now, I'll do a simple mapping
-			resp.Blogs[i] = dto.BlogListResponse{
+			// Chapters are numbered like the blog navigation: drafts and scheduled posts are skipped
+			if blog.IsLive() {
+				chapter++
+				resp.Blogs[i].ChapterNumber = chapter
+			}
+			resp.Blogs[i].BlogListResponse = dto.BlogListResponse{
 				ID:           blog.ID,
 				AuthorID:     blog.AuthorID,
 				Title:        blog.Title,
@@ -226,7 +416,7 @@ func (u *seriesUseCase) mapSeriesToDTO(series *entity.Series) *dto.SeriesRespons
 			}
 		}
 	} else {
-		resp.Blogs = []dto.BlogListResponse{}
+		resp.Blogs = []dto.SeriesChapterResponse{}
 	}
 
 	return resp
@@ -12,6 +12,7 @@ import (
 	"github.com/aiagent/internal/domain/repository"
 	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -21,7 +22,7 @@ func TestCreateSeries(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := repoMocks.NewMockSeriesRepository(ctrl)
-	uc := series.NewSeriesUseCase(mockRepo)
+	uc := series.NewSeriesUseCase(mockRepo, nil, nil)
 	userID := uuid.New()
 
 	req := &dto.CreateSeriesRequest{
@@ -46,7 +47,7 @@ func TestUpdateSeries_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := repoMocks.NewMockSeriesRepository(ctrl)
-	uc := series.NewSeriesUseCase(mockRepo)
+	uc := series.NewSeriesUseCase(mockRepo, nil, nil)
 	userID := uuid.New()
 	seriesID := uuid.New()
 
@@ -81,7 +82,7 @@ func TestUpdateSeries_Unauthorized(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := repoMocks.NewMockSeriesRepository(ctrl)
-	uc := series.NewSeriesUseCase(mockRepo)
+	uc := series.NewSeriesUseCase(mockRepo, nil, nil)
 	userID := uuid.New()
 	otherUserID := uuid.New()
 	seriesID := uuid.New()
@@ -110,7 +111,7 @@ func TestDeleteSeries_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := repoMocks.NewMockSeriesRepository(ctrl)
-	uc := series.NewSeriesUseCase(mockRepo)
+	uc := series.NewSeriesUseCase(mockRepo, nil, nil)
 	userID := uuid.New()
 	seriesID := uuid.New()
 
@@ -127,12 +128,183 @@ func TestDeleteSeries_Success(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateSeries_Paid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockSeriesRepository(ctrl)
+	uc := series.NewSeriesUseCase(mockRepo, nil, nil)
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		price := decimal.NewFromInt(99000)
+		req := &dto.CreateSeriesRequest{Title: "Paid", Slug: "paid", Price: &price, Currency: "vnd"}
+
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := uc.CreateSeries(context.Background(), userID, req)
+
+		assert.NoError(t, err)
+		assert.True(t, price.Equal(resp.Price))
+		assert.Equal(t, "VND", resp.Currency)
+	})
+
+	t.Run("negative_price", func(t *testing.T) {
+		price := decimal.NewFromInt(-1)
+
+		resp, err := uc.CreateSeries(context.Background(), userID, &dto.CreateSeriesRequest{Title: "Paid", Slug: "paid", Price: &price})
+
+		assert.ErrorIs(t, err, series.ErrInvalidSeriesPrice)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestSetBlogPreview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockSeriesRepository(ctrl)
+	uc := series.NewSeriesUseCase(mockRepo, nil, nil)
+	userID := uuid.New()
+	seriesID := uuid.New()
+	blogID := uuid.New()
+	existingSeries := &entity.Series{ID: seriesID, AuthorID: userID, Price: decimal.NewFromInt(99000)}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), seriesID).Return(existingSeries, nil)
+		mockRepo.EXPECT().SetBlogPreview(gomock.Any(), seriesID, blogID, true).Return(true, nil)
+
+		assert.NoError(t, uc.SetBlogPreview(context.Background(), userID, seriesID, blogID, true))
+	})
+
+	t.Run("blog_not_in_series", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), seriesID).Return(existingSeries, nil)
+		mockRepo.EXPECT().SetBlogPreview(gomock.Any(), seriesID, blogID, true).Return(false, nil)
+
+		assert.ErrorIs(t, uc.SetBlogPreview(context.Background(), userID, seriesID, blogID, true), series.ErrBlogNotInSeries)
+	})
+
+	t.Run("not_author", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), seriesID).Return(existingSeries, nil)
+
+		assert.ErrorIs(t, uc.SetBlogPreview(context.Background(), uuid.New(), seriesID, blogID, true), series.ErrNotSeriesAuthor)
+	})
+}
+
+func TestGetLibrary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPurchaseRepo := repoMocks.NewMockUserSeriesPurchaseRepository(ctrl)
+	uc := series.NewSeriesUseCase(repoMocks.NewMockSeriesRepository(ctrl), mockPurchaseRepo, nil)
+	userID := uuid.New()
+	deletedAt := time.Now()
+	owned := &entity.Series{ID: uuid.New(), Title: "Owned", Price: decimal.NewFromInt(99000), Currency: "VND"}
+	removed := &entity.Series{ID: uuid.New(), Title: "Removed", DeletedAt: &deletedAt}
+
+	mockPurchaseRepo.EXPECT().GetUserPurchases(gomock.Any(), userID).Return([]*entity.UserSeriesPurchase{
+		{UserID: userID, SeriesID: owned.ID, Amount: decimal.NewFromInt(99000), CreatedAt: time.Now(), Series: owned},
+		{UserID: userID, SeriesID: removed.ID, Amount: decimal.NewFromInt(49000), Series: removed},
+	}, nil)
+
+	library, err := uc.GetLibrary(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, library, 1)
+	assert.Equal(t, owned.ID, library[0].Series.ID)
+	assert.True(t, decimal.NewFromInt(99000).Equal(library[0].Amount))
+}
+
+func TestReorderBlogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockSeriesRepository(ctrl)
+	uc := series.NewSeriesUseCase(mockRepo, nil, nil)
+	userID := uuid.New()
+	seriesID := uuid.New()
+	a, b := entity.Blog{ID: uuid.New()}, entity.Blog{ID: uuid.New()}
+	existingSeries := &entity.Series{ID: seriesID, AuthorID: userID, Blogs: []entity.Blog{a, b}}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), seriesID).Return(existingSeries, nil)
+		mockRepo.EXPECT().ReorderBlogs(gomock.Any(), seriesID, []uuid.UUID{b.ID, a.ID}).Return(nil)
+
+		assert.NoError(t, uc.ReorderBlogs(context.Background(), userID, seriesID, []uuid.UUID{b.ID, a.ID}))
+	})
+
+	t.Run("duplicate_chapter", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), seriesID).Return(existingSeries, nil)
+
+		err := uc.ReorderBlogs(context.Background(), userID, seriesID, []uuid.UUID{a.ID, a.ID})
+
+		assert.ErrorIs(t, err, series.ErrInvalidChapterOrder)
+	})
+
+	t.Run("missing_chapter", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(gomock.Any(), seriesID).Return(existingSeries, nil)
+
+		err := uc.ReorderBlogs(context.Background(), userID, seriesID, []uuid.UUID{a.ID})
+
+		assert.ErrorIs(t, err, series.ErrInvalidChapterOrder)
+	})
+}
+
+func TestGetProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockSeriesRepository(ctrl)
+	mockHistoryRepo := repoMocks.NewMockReadingHistoryRepository(ctrl)
+	uc := series.NewSeriesUseCase(mockRepo, nil, mockHistoryRepo)
+	userID := uuid.New()
+	seriesID := uuid.New()
+
+	publishedAt := time.Now().Add(-time.Hour)
+	one := entity.Blog{ID: uuid.New(), Status: entity.BlogStatusPublished, PublishedAt: &publishedAt}
+	two := entity.Blog{ID: uuid.New(), Status: entity.BlogStatusDraft}
+	three := entity.Blog{ID: uuid.New(), Status: entity.BlogStatusPublished, PublishedAt: &publishedAt}
+	four := entity.Blog{ID: uuid.New(), Status: entity.BlogStatusPublished, PublishedAt: &publishedAt}
+	scheduledAt := time.Now().Add(time.Hour)
+	five := entity.Blog{ID: uuid.New(), Status: entity.BlogStatusPublished, PublishedAt: &scheduledAt}
+	readAt := time.Now().Add(-time.Minute)
+
+	mockRepo.EXPECT().Exists(gomock.Any(), seriesID).Return(true, nil)
+	mockRepo.EXPECT().GetChapters(gomock.Any(), seriesID).Return([]entity.Blog{one, two, three, four, five}, nil)
+	mockHistoryRepo.EXPECT().GetByBlogIDs(gomock.Any(), userID, []uuid.UUID{one.ID, three.ID, four.ID}).
+		Return([]*entity.UserReadingHistory{{UserID: userID, BlogID: three.ID, LastReadAt: readAt}}, nil)
+
+	progress, err := uc.GetProgress(context.Background(), userID, seriesID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, progress.TotalChapters)
+	assert.Equal(t, 1, progress.ReadChapters)
+	assert.Equal(t, 33, progress.Percent)
+	assert.Equal(t, three.ID, *progress.LastReadBlogID)
+	assert.Equal(t, one.ID, *progress.NextBlogID)
+}
+
+func TestGetProgress_SeriesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockSeriesRepository(ctrl)
+	uc := series.NewSeriesUseCase(mockRepo, nil, nil)
+	seriesID := uuid.New()
+
+	mockRepo.EXPECT().Exists(gomock.Any(), seriesID).Return(false, nil)
+
+	_, err := uc.GetProgress(context.Background(), uuid.New(), seriesID)
+
+	assert.ErrorIs(t, err, series.ErrSeriesNotFound)
+}
+
 func TestGetHighlightedSeries(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := repoMocks.NewMockSeriesRepository(ctrl)
-	uc := series.NewSeriesUseCase(mockRepo)
+	uc := series.NewSeriesUseCase(mockRepo, nil, nil)
 
 	t.Run("success", func(t *testing.T) {
 		seriesID := uuid.New()
@@ -15,6 +15,7 @@ import (
 
 	dto "github.com/aiagent/internal/application/dto"
 	repository "github.com/aiagent/internal/domain/repository"
+	valueobject "github.com/aiagent/internal/domain/valueobject"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -119,18 +120,18 @@ func (mr *MockSubscriptionUseCaseMockRecorder) IsSubscribed(ctx, subscriberID, a
 }
 
 // Subscribe mocks base method.
-func (m *MockSubscriptionUseCase) Subscribe(ctx context.Context, subscriberID, authorID uuid.UUID) (*dto.SubscriptionResponse, error) {
+func (m *MockSubscriptionUseCase) Subscribe(ctx context.Context, subscriberID, authorID uuid.UUID, client valueobject.ClientInfo) (*dto.SubscriptionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subscribe", ctx, subscriberID, authorID)
+	ret := m.ctrl.Call(m, "Subscribe", ctx, subscriberID, authorID, client)
 	ret0, _ := ret[0].(*dto.SubscriptionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Subscribe indicates an expected call of Subscribe.
-func (mr *MockSubscriptionUseCaseMockRecorder) Subscribe(ctx, subscriberID, authorID any) *gomock.Call {
+func (mr *MockSubscriptionUseCaseMockRecorder) Subscribe(ctx, subscriberID, authorID, client any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSubscriptionUseCase)(nil).Subscribe), ctx, subscriberID, authorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSubscriptionUseCase)(nil).Subscribe), ctx, subscriberID, authorID, client)
 }
 
 // Unsubscribe mocks base method.
@@ -9,6 +9,8 @@ import (
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
 	domainService "github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/domain/valueobject"
+	"github.com/aiagent/pkg/logger"
 	"github.com/google/uuid"
 )
 
@@ -19,7 +21,7 @@ var (
 )
 
 type SubscriptionUseCase interface {
-	Subscribe(ctx context.Context, subscriberID, authorID uuid.UUID) (*dto.SubscriptionResponse, error)
+	Subscribe(ctx context.Context, subscriberID, authorID uuid.UUID, client valueobject.ClientInfo) (*dto.SubscriptionResponse, error)
 	Unsubscribe(ctx context.Context, subscriberID, authorID uuid.UUID) error
 	IsSubscribed(ctx context.Context, subscriberID, authorID uuid.UUID) (bool, error)
 	GetSubscriptions(ctx context.Context, subscriberID uuid.UUID, page, pageSize int) (*repository.PaginatedResult[dto.SubscriptionResponse], error)
@@ -30,19 +32,30 @@ type SubscriptionUseCase interface {
 
 type subscriptionUseCase struct {
 	subscriptionSvc domainService.SubscriptionService
+	followerTracker domainService.FollowerTracker
 }
 
-func NewSubscriptionUseCase(subscriptionSvc domainService.SubscriptionService) SubscriptionUseCase {
+func NewSubscriptionUseCase(subscriptionSvc domainService.SubscriptionService, followerTracker domainService.FollowerTracker) SubscriptionUseCase {
 	return &subscriptionUseCase{
 		subscriptionSvc: subscriptionSvc,
+		followerTracker: followerTracker,
 	}
 }
 
-func (uc *subscriptionUseCase) Subscribe(ctx context.Context, subscriberID, authorID uuid.UUID) (*dto.SubscriptionResponse, error) {
+func (uc *subscriptionUseCase) Subscribe(ctx context.Context, subscriberID, authorID uuid.UUID, client valueobject.ClientInfo) (*dto.SubscriptionResponse, error) {
 	sub, err := uc.subscriptionSvc.Subscribe(ctx, subscriberID, authorID)
 	if err != nil {
 		return nil, err
 	}
+
+	// Fraud tracking must never block a legitimate follow
+	if err := uc.followerTracker.RecordFollow(ctx, subscriberID, authorID, client); err != nil {
+		logger.Error("failed to record follower event", err, map[string]interface{}{
+			"follower_id":  subscriberID,
+			"following_id": authorID,
+		})
+	}
+
 	return uc.toSubscriptionResponse(sub), nil
 }
 
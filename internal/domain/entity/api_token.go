@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ResourcePlans covers an author's subscription plan management. Roles do not
+// grant it; it only exists so API tokens can be scoped to those endpoints.
+const ResourcePlans = "plans"
+
+// APITokenResources lists the resources an API token can be scoped to
+var APITokenResources = []string{
+	ResourceBlogs,
+	ResourceCategories,
+	ResourceTags,
+	ResourceComments,
+	ResourceSeries,
+	ResourcePlans,
+}
+
+// IsAPITokenResource checks if tokens can be scoped to the resource
+func IsAPITokenResource(resource string) bool {
+	for _, r := range APITokenResources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// APITokenScopes maps a resource to the permissions a token may use on it
+type APITokenScopes map[string]Permission
+
+// Allows checks if the scopes include the given permission on the resource
+func (s APITokenScopes) Allows(resource string, perm Permission) bool {
+	return s[resource].Has(perm)
+}
+
+// APIToken is a personal access token for machine clients. Requests made with it
+// act as the owning user, limited to the intersection of its scopes and the user's roles.
+type APIToken struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID      uuid.UUID      `gorm:"type:uuid;not null;index" json:"userId"`
+	Name        string         `gorm:"size:100;not null" json:"name"`
+	TokenPrefix string         `gorm:"size:16;not null" json:"tokenPrefix"` // Shown in listings to tell tokens apart
+	TokenHash   string         `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	Scopes      APITokenScopes `gorm:"type:jsonb;serializer:json;not null;default:'{}'" json:"scopes"`
+	ExpiresAt   time.Time      `gorm:"not null" json:"expiresAt"`
+	LastUsedAt  *time.Time     `json:"lastUsedAt,omitempty"`
+	RevokedAt   *time.Time     `json:"revokedAt,omitempty"`
+	CreatedAt   time.Time      `gorm:"not null;default:now()" json:"createdAt"`
+	UpdatedAt   time.Time      `gorm:"not null;default:now()" json:"updatedAt"`
+}
+
+// TableName returns the table name for APIToken
+func (APIToken) TableName() string {
+	return "api_tokens"
+}
+
+// IsActive checks if the token is neither revoked nor expired at the given time
+func (t *APIToken) IsActive(at time.Time) bool {
+	return t.RevokedAt == nil && at.Before(t.ExpiresAt)
+}
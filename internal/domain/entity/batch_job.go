@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BatchJobStatus represents the lifecycle state of a batch job
+type BatchJobStatus string
+
+const (
+	BatchJobStatusRunning   BatchJobStatus = "running"
+	BatchJobStatusCompleted BatchJobStatus = "completed"
+	BatchJobStatusFailed    BatchJobStatus = "failed"
+	BatchJobStatusCancelled BatchJobStatus = "cancelled"
+)
+
+// BatchJobTypeFraudAnalysis is the batch job that scores users from bot detection signals
+const BatchJobTypeFraudAnalysis = "fraud_analysis"
+
+// maxBatchJobErrors caps the error list so a job failing on every item stays small
+const maxBatchJobErrors = 50
+
+// BatchJob persists a long-running background job with progress and a checkpoint,
+// so it survives restarts and is visible from every API replica
+type BatchJob struct {
+	ID                 uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	JobType            string         `gorm:"type:varchar(50);not null;index:idx_batch_jobs_type" json:"job_type"`
+	Status             BatchJobStatus `gorm:"type:varchar(20);not null;index:idx_batch_jobs_status" json:"status"`
+	DateFrom           time.Time      `gorm:"not null" json:"date_from"`
+	DateTo             time.Time      `gorm:"not null" json:"date_to"`
+	ProcessedFollowers int            `gorm:"not null;default:0" json:"processed_followers"`
+	NewSignalsDetected int            `gorm:"not null;default:0" json:"new_signals_detected"`
+	UsersScored        int            `gorm:"not null;default:0" json:"users_scored"`
+	CheckpointID       *uuid.UUID     `gorm:"type:uuid" json:"checkpoint_id"` // Last processed signal
+	CheckpointAt       *time.Time     `json:"checkpoint_at"`                  // detected_at of the last processed signal
+	Errors             []string       `gorm:"type:jsonb;serializer:json;not null;default:'[]'" json:"errors"`
+	Message            string         `gorm:"type:varchar(255)" json:"message"`
+	LockedBy           *string        `gorm:"type:varchar(100)" json:"locked_by"`
+	LockedUntil        *time.Time     `json:"locked_until"`
+	StartedAt          time.Time      `gorm:"not null" json:"started_at"`
+	CompletedAt        *time.Time     `json:"completed_at"`
+	CreatedAt          time.Time      `gorm:"not null;default:now()" json:"created_at"`
+	UpdatedAt          time.Time      `gorm:"not null;default:now()" json:"updated_at"`
+}
+
+func (BatchJob) TableName() string {
+	return "batch_jobs"
+}
+
+// IsFinished reports whether the job has reached a terminal state
+func (j *BatchJob) IsFinished() bool {
+	return j.Status == BatchJobStatusCompleted || j.Status == BatchJobStatusFailed || j.Status == BatchJobStatusCancelled
+}
+
+// AddError records a non-fatal error, keeping only the most recent ones
+func (j *BatchJob) AddError(msg string) {
+	j.Errors = append(j.Errors, msg)
+	if len(j.Errors) > maxBatchJobErrors {
+		j.Errors = j.Errors[len(j.Errors)-maxBatchJobErrors:]
+	}
+}
+
+// Checkpoint advances the resume point to the given signal
+func (j *BatchJob) Checkpoint(signalID uuid.UUID, detectedAt time.Time) {
+	j.CheckpointID = &signalID
+	j.CheckpointAt = &detectedAt
+}
@@ -36,14 +36,22 @@ type Blog struct {
 	Status       BlogStatus     `gorm:"type:blog_status;not null;default:'draft'" json:"status"`
 	Visibility   BlogVisibility `gorm:"type:blog_visibility;not null;default:'public'" json:"visibility"`
 	PublishedAt  *time.Time     `json:"publishedAt,omitempty"`
-	CreatedAt    time.Time      `gorm:"not null;default:now()" json:"createdAt"`
-	UpdatedAt    time.Time      `gorm:"not null;default:now()" json:"updatedAt"`
-	DeletedAt    gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
+	// PublishTimezone is the IANA timezone the author scheduled the post in, kept to show them local times
+	PublishTimezone *string `gorm:"size:64" json:"publishTimezone,omitempty"`
+	// AnnouncedAt is set once followers were told about the post. It is only written by
+	// BlogRepository.MarkAnnounced and ResetAnnouncement so saving a stale copy cannot undo it.
+	AnnouncedAt *time.Time     `gorm:"->" json:"-"`
+	CreatedAt   time.Time      `gorm:"not null;default:now()" json:"createdAt"`
+	UpdatedAt   time.Time      `gorm:"not null;default:now()" json:"updatedAt"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
 
 	// Reactions (Denormalized counts)
 	UpvoteCount   int `gorm:"not null;default:0" json:"upvoteCount"`
 	DownvoteCount int `gorm:"not null;default:0" json:"downvoteCount"`
 
+	// Locked is only loaded by listings: the viewer needs a higher tier or a series purchase to read it
+	Locked bool `gorm:"->;-:migration" json:"locked,omitempty"`
+
 	// Relationships
 	Author   *User     `gorm:"foreignKey:AuthorID" json:"author,omitempty"`
 	Category *Category `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
@@ -66,6 +74,11 @@ func (b *Blog) IsScheduled() bool {
 	return b.Status == BlogStatusPublished && b.PublishedAt != nil && b.PublishedAt.After(time.Now())
 }
 
+// IsLive checks if the blog is published and its publish time has passed
+func (b *Blog) IsLive() bool {
+	return b.IsPublished() && !b.IsScheduled()
+}
+
 // IsDraft checks if the blog is a draft
 func (b *Blog) IsDraft() bool {
 	return b.Status == BlogStatusDraft
@@ -81,19 +94,29 @@ func (b *Blog) IsSubscribersOnly() bool {
 	return b.Visibility == BlogVisibilitySubscribersOnly
 }
 
-// Publish publishes the blog
-func (b *Blog) Publish(at *time.Time) {
+// Publish publishes the blog. A timezone replaces the one the author last scheduled in.
+func (b *Blog) Publish(at *time.Time, timezone *string) {
 	if at != nil {
 		b.PublishedAt = at
 	} else {
 		now := time.Now()
 		b.PublishedAt = &now
 	}
+	if timezone != nil {
+		b.PublishTimezone = timezone
+	}
 	b.Status = BlogStatusPublished
 }
 
+// Reschedule moves the publish time of a scheduled blog, remembering the author's timezone
+func (b *Blog) Reschedule(at time.Time, timezone *string) {
+	b.PublishedAt = &at
+	b.PublishTimezone = timezone
+}
+
 // Unpublish reverts the blog to draft
 func (b *Blog) Unpublish() {
 	b.Status = BlogStatusDraft
 	b.PublishedAt = nil
+	b.PublishTimezone = nil
 }
@@ -33,3 +33,18 @@ type BlogVersion struct {
 func (BlogVersion) TableName() string {
 	return "blog_versions"
 }
+
+// BlogVersionRetention is the retention policy of a blog's versions. Versions beyond the
+// MaxVersions most recent, or older than MaxAgeDays when set, are pruned; the latest version
+// is always kept.
+type BlogVersionRetention struct {
+	BlogID      uuid.UUID `gorm:"type:uuid;primary_key" json:"blogId"`
+	MaxVersions int       `gorm:"not null" json:"maxVersions"`
+	MaxAgeDays  *int      `json:"maxAgeDays,omitempty"`
+	UpdatedAt   time.Time `gorm:"not null;default:now()" json:"updatedAt"`
+}
+
+// TableName returns the table name for BlogVersionRetention
+func (BlogVersionRetention) TableName() string {
+	return "blog_version_retention"
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GiftStatus is the state of a gift subscription code
+type GiftStatus string
+
+const (
+	GiftStatusIssued   GiftStatus = "issued"   // Paid for and waiting to be redeemed
+	GiftStatusRedeemed GiftStatus = "redeemed" // Turned into a subscription period of RedeemedBy
+	GiftStatusRevoked  GiftStatus = "revoked"  // The payment was refunded before the code was redeemed
+)
+
+// GiftSubscription is a subscription plan paid by one user for another. The paid transaction
+// issues a code; the period starts when a recipient redeems it.
+type GiftSubscription struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Code          string     `gorm:"size:20;not null;uniqueIndex" json:"code"`
+	TransactionID uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex" json:"transactionId"`
+	PurchaserID   uuid.UUID  `gorm:"type:uuid;not null;index" json:"purchaserId"`
+	AuthorID      uuid.UUID  `gorm:"type:uuid;not null" json:"authorId"`
+	PlanID        uuid.UUID  `gorm:"type:uuid;not null" json:"planId"`
+	Tier          string     `gorm:"size:20;not null" json:"tier"`
+	Status        GiftStatus `gorm:"size:20;not null;default:'issued'" json:"status"`
+	RedeemedBy    *uuid.UUID `gorm:"type:uuid" json:"redeemedBy,omitempty"`
+	RedeemedAt    *time.Time `json:"redeemedAt,omitempty"`
+	CreatedAt     time.Time  `gorm:"not null;default:now()" json:"createdAt"`
+	UpdatedAt     time.Time  `gorm:"not null;default:now()" json:"updatedAt"`
+}
+
+// TableName returns the table name for GiftSubscription
+func (GiftSubscription) TableName() string {
+	return "gift_subscriptions"
+}
+
+// IsRedeemable returns true while the code has not been redeemed or revoked
+func (g *GiftSubscription) IsRedeemable() bool {
+	return g.Status == GiftStatusIssued
+}
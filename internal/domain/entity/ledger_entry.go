@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LedgerAccount is an account of the double-entry earnings ledger
+type LedgerAccount string
+
+const (
+	LedgerAccountGateway         LedgerAccount = "gateway"          // Money the platform holds at the payment gateway
+	LedgerAccountPlatformRevenue LedgerAccount = "platform_revenue" // Platform fees kept from payments
+	LedgerAccountAuthorPayable   LedgerAccount = "author_payable"   // What the platform owes an author
+)
+
+// LedgerEntryKind is the event a journal records
+type LedgerEntryKind string
+
+const (
+	LedgerEntryPayment LedgerEntryKind = "payment" // Source is the transaction
+	LedgerEntryRefund  LedgerEntryKind = "refund"  // Source is the payment refund
+	LedgerEntryPayout  LedgerEntryKind = "payout"  // Source is the payout
+)
+
+// LedgerEntry is one line of a journal. Amount is positive for a debit and negative for
+// a credit, and the entries sharing a JournalID always sum to zero.
+//
+// Entries of payment and refund journals carry the author the money is attributed to and
+// what was paid for, so earnings can be broken down without joining the transactions.
+type LedgerEntry struct {
+	ID              uuid.UUID        `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	JournalID       uuid.UUID        `gorm:"type:uuid;not null;index" json:"journalId"`
+	Kind            LedgerEntryKind  `gorm:"size:20;not null" json:"kind"`
+	SourceID        uuid.UUID        `gorm:"type:uuid;not null" json:"sourceId"`
+	Account         LedgerAccount    `gorm:"size:30;not null" json:"account"`
+	AuthorID        *uuid.UUID       `gorm:"type:uuid;index" json:"authorId,omitempty"`
+	Amount          decimal.Decimal  `gorm:"type:decimal(19,4);not null" json:"amount"`
+	Currency        string           `gorm:"size:10;not null;default:'VND'" json:"currency"`
+	TransactionID   *uuid.UUID       `gorm:"type:uuid" json:"transactionId,omitempty"`
+	TransactionType *TransactionType `gorm:"size:20" json:"transactionType,omitempty"`
+	Tier            *string          `gorm:"size:20" json:"tier,omitempty"`
+	SeriesID        *uuid.UUID       `gorm:"type:uuid" json:"seriesId,omitempty"`
+	CreatedAt       time.Time        `gorm:"not null;default:now()" json:"createdAt"`
+}
+
+// TableName returns the table name for LedgerEntry
+func (LedgerEntry) TableName() string {
+	return "ledger_entries"
+}
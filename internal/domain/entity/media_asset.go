@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MediaPurpose is what an uploaded image is used for
+type MediaPurpose string
+
+const (
+	MediaPurposeAvatar        MediaPurpose = "avatar"
+	MediaPurposeBlogThumbnail MediaPurpose = "blog_thumbnail"
+	MediaPurposeBlogInline    MediaPurpose = "blog_inline" // Embedded in a blog's content
+)
+
+// IsValid returns true for known purposes
+func (p MediaPurpose) IsValid() bool {
+	switch p {
+	case MediaPurposeAvatar, MediaPurposeBlogThumbnail, MediaPurposeBlogInline:
+		return true
+	}
+	return false
+}
+
+// MediaVariant is a downscaled copy of a media asset
+type MediaVariant struct {
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Size   int64  `json:"size"`
+	Key    string `json:"key"`
+	URL    string `json:"url"`
+}
+
+// MediaAsset is an image uploaded by a user. The stored original has been re-encoded without
+// metadata; Size counts the original and all variants against the owner's quota.
+type MediaAsset struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OwnerID     uuid.UUID      `gorm:"type:uuid;not null;index" json:"ownerId"`
+	Purpose     MediaPurpose   `gorm:"size:20;not null" json:"purpose"`
+	ContentType string         `gorm:"size:50;not null" json:"contentType"`
+	Width       int            `gorm:"not null" json:"width"`
+	Height      int            `gorm:"not null" json:"height"`
+	Size        int64          `gorm:"not null" json:"size"`
+	Key         string         `gorm:"size:255;not null" json:"key"` // Blob store key of the original
+	URL         string         `gorm:"size:500;not null" json:"url"`
+	Variants    []MediaVariant `gorm:"type:jsonb;serializer:json;not null;default:'[]'" json:"variants"` // Narrowest first
+	CreatedAt   time.Time      `gorm:"not null;default:now()" json:"createdAt"`
+}
+
+// TableName returns the table name for MediaAsset
+func (MediaAsset) TableName() string {
+	return "media_assets"
+}
+
+// Keys returns the blob store keys of the original and every variant
+func (m *MediaAsset) Keys() []string {
+	keys := []string{m.Key}
+	for _, v := range m.Variants {
+		keys = append(keys, v.Key)
+	}
+	return keys
+}
+
+// URLForWidth returns the narrowest copy at least width pixels wide, falling back to the original
+func (m *MediaAsset) URLForWidth(width int) string {
+	for _, v := range m.Variants {
+		if v.Width >= width {
+			return v.URL
+		}
+	}
+	return m.URL
+}
+
+// MediaUsage is how many bytes of media a user stores
+type MediaUsage struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primary_key" json:"userId"`
+	BytesUsed int64     `gorm:"not null;default:0" json:"bytesUsed"`
+	UpdatedAt time.Time `gorm:"not null;default:now()" json:"updatedAt"`
+}
+
+// TableName returns the table name for MediaUsage
+func (MediaUsage) TableName() string {
+	return "user_media_usage"
+}
@@ -18,6 +18,9 @@ const (
 	NotificationTypeSeriesUpdate         NotificationType = "series_update"
 	NotificationTypeBotFollowerDetected  NotificationType = "bot_follower_detected"
 	NotificationTypeBadgeStatusChange    NotificationType = "badge_status_change"
+	NotificationTypeSubscriptionExpiring NotificationType = "subscription_expiring"
+	NotificationTypeSubscriptionGrace    NotificationType = "subscription_grace"
+	NotificationTypeSubscriptionEnded    NotificationType = "subscription_ended"
 )
 
 type NotificationCategory string
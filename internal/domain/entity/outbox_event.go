@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxStatus represents the delivery state of an outbox event
+type OutboxStatus string
+
+const (
+	OutboxStatusPending    OutboxStatus = "PENDING"
+	OutboxStatusProcessing OutboxStatus = "PROCESSING"
+	OutboxStatusProcessed  OutboxStatus = "PROCESSED"
+	OutboxStatusDead       OutboxStatus = "DEAD"
+)
+
+// DefaultOutboxMaxAttempts is the number of deliveries tried before an event is dead-lettered
+const DefaultOutboxMaxAttempts = 10
+
+// OutboxEvent is a side effect recorded in the same database transaction as the
+// business change that caused it, and delivered later by the outbox relay
+type OutboxEvent struct {
+	ID            uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventType     string       `gorm:"size:100;not null" json:"eventType"`
+	AggregateType string       `gorm:"size:50;not null" json:"aggregateType"`
+	AggregateID   uuid.UUID    `gorm:"type:uuid;not null" json:"aggregateId"`
+	Payload       string       `gorm:"type:jsonb;not null" json:"payload"`
+	Status        OutboxStatus `gorm:"size:20;not null;default:'PENDING'" json:"status"`
+	Attempts      int          `gorm:"not null;default:0" json:"attempts"`
+	MaxAttempts   int          `gorm:"not null;default:10" json:"maxAttempts"`
+	NextAttemptAt time.Time    `gorm:"not null;default:now()" json:"nextAttemptAt"`
+	LockedBy      *string      `gorm:"size:100" json:"lockedBy,omitempty"`
+	LockedUntil   *time.Time   `json:"lockedUntil,omitempty"`
+	LastError     *string      `gorm:"type:text" json:"lastError,omitempty"`
+	ProcessedAt   *time.Time   `json:"processedAt,omitempty"`
+	CreatedAt     time.Time    `gorm:"not null;default:now()" json:"createdAt"`
+	UpdatedAt     time.Time    `gorm:"not null;default:now()" json:"updatedAt"`
+}
+
+// TableName returns the table name for OutboxEvent
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+// HasAttemptsLeft reports whether a failed delivery should be retried
+func (e *OutboxEvent) HasAttemptsLeft() bool {
+	return e.Attempts < e.MaxAttempts
+}
+
+// OutboxDelivery records that one event bus handler received an outbox event
+type OutboxDelivery struct {
+	EventID     uuid.UUID `gorm:"type:uuid;primaryKey" json:"eventId"`
+	Handler     string    `gorm:"size:100;primaryKey" json:"handler"`
+	DeliveredAt time.Time `gorm:"not null;default:now()" json:"deliveredAt"`
+}
+
+// TableName returns the table name for OutboxDelivery
+func (OutboxDelivery) TableName() string {
+	return "outbox_deliveries"
+}
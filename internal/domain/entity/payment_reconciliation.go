@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationReason explains why a transfer was flagged instead of completing its order
+type ReconciliationReason string
+
+const (
+	ReconciliationReasonUnderpaid    ReconciliationReason = "underpaid"     // Less than the order amount was transferred
+	ReconciliationReasonOverpaid     ReconciliationReason = "overpaid"      // More than the order amount was transferred
+	ReconciliationReasonOrderClosed  ReconciliationReason = "order_closed"  // Transfer for an order that was already paid, flagged or failed
+	ReconciliationReasonPlanConflict ReconciliationReason = "plan_conflict" // Subscription changed after the order was quoted
+)
+
+// ReconciliationStatus is the review state of a flagged transfer
+type ReconciliationStatus string
+
+const (
+	ReconciliationStatusOpen      ReconciliationStatus = "open"
+	ReconciliationStatusGranted   ReconciliationStatus = "granted"   // Order completed and benefits granted despite the mismatch
+	ReconciliationStatusDismissed ReconciliationStatus = "dismissed" // No benefits; any refund is handled outside the order
+)
+
+// PaymentReconciliation is a transfer that did not match its order and waits for an admin
+type PaymentReconciliation struct {
+	ID             uuid.UUID            `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	TransactionID  uuid.UUID            `gorm:"type:uuid;not null;index" json:"transactionId"`
+	UserID         uuid.UUID            `gorm:"type:uuid;not null" json:"userId"`
+	SePayID        string               `gorm:"column:sepay_id;size:100;not null;unique" json:"sepayId"`
+	Reason         ReconciliationReason `gorm:"size:20;not null" json:"reason"`
+	ExpectedAmount decimal.Decimal      `gorm:"type:decimal(19,4);not null" json:"expectedAmount"`
+	ReceivedAmount decimal.Decimal      `gorm:"type:decimal(19,4);not null" json:"receivedAmount"`
+	Currency       string               `gorm:"size:10;not null;default:'VND'" json:"currency"`
+	Status         ReconciliationStatus `gorm:"size:20;not null;default:'open'" json:"status"`
+	Note           *string              `gorm:"type:text" json:"note,omitempty"`
+	ResolvedBy     *uuid.UUID           `gorm:"type:uuid" json:"resolvedBy,omitempty"`
+	ResolvedAt     *time.Time           `json:"resolvedAt,omitempty"`
+	CreatedAt      time.Time            `gorm:"not null;default:now()" json:"createdAt"`
+	UpdatedAt      time.Time            `gorm:"not null;default:now()" json:"updatedAt"`
+
+	// Relationships
+	Transaction *Transaction `gorm:"foreignKey:TransactionID" json:"transaction,omitempty"`
+}
+
+// TableName returns the table name for PaymentReconciliation
+func (PaymentReconciliation) TableName() string {
+	return "payment_reconciliations"
+}
+
+// IsOpen returns true while the reconciliation waits for a decision
+func (r *PaymentReconciliation) IsOpen() bool {
+	return r.Status == ReconciliationStatusOpen
+}
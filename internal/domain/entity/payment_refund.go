@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RefundKind tells whether money went back at our initiative or was reversed by the bank
+type RefundKind string
+
+const (
+	RefundKindRefund     RefundKind = "refund"     // Returned to the payer by an admin
+	RefundKindChargeback RefundKind = "chargeback" // Reversed by the payer's bank; always revokes the benefit
+)
+
+// RefundStatus tells whether the money of a refund is known to be back with the payer
+type RefundStatus string
+
+const (
+	RefundStatusPending   RefundStatus = "pending"   // Sent to the provider, not confirmed yet; the transaction is unchanged
+	RefundStatusCompleted RefundStatus = "completed" // Money returned and applied to the transaction and ledger
+	RefundStatusFailed    RefundStatus = "failed"    // Declined by the provider; nothing was returned
+)
+
+// PaymentRefund records money returned for a transaction. Rows are never deleted and are only
+// updated to settle a pending refund, so together they form the audit trail of a transaction's refunds.
+type PaymentRefund struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	TransactionID  uuid.UUID       `gorm:"type:uuid;not null;index" json:"transactionId"`
+	UserID         uuid.UUID       `gorm:"type:uuid;not null" json:"userId"`
+	Kind           RefundKind      `gorm:"size:20;not null" json:"kind"`
+	Amount         decimal.Decimal `gorm:"type:decimal(19,4);not null" json:"amount"`
+	Currency       string          `gorm:"size:10;not null;default:'VND'" json:"currency"`
+	Reason         string          `gorm:"type:text;not null" json:"reason"`
+	Status         RefundStatus    `gorm:"size:20;not null;default:'completed'" json:"status"`
+	ProviderRef    *string         `gorm:"size:255" json:"providerRef,omitempty"` // Bank reference of the outgoing transfer or chargeback
+	BenefitRevoked bool            `gorm:"not null;default:false" json:"benefitRevoked"`
+	CreatedBy      uuid.UUID       `gorm:"type:uuid;not null" json:"createdBy"`
+	CreatedAt      time.Time       `gorm:"not null;default:now()" json:"createdAt"`
+
+	// Relationships
+	Transaction *Transaction `gorm:"foreignKey:TransactionID" json:"transaction,omitempty"`
+}
+
+// TableName returns the table name for PaymentRefund
+func (PaymentRefund) TableName() string {
+	return "payment_refunds"
+}
+
+// IsPending returns true while the provider has not confirmed the refund
+func (r *PaymentRefund) IsPending() bool {
+	return r.Status == RefundStatusPending
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentMismatchKind explains why a payment shows up in a reconciliation report
+type PaymentMismatchKind string
+
+const (
+	PaymentMismatchUnderpaid    PaymentMismatchKind = "underpaid"     // Flagged by the webhook, see ReconciliationReasonUnderpaid
+	PaymentMismatchOverpaid     PaymentMismatchKind = "overpaid"      // Flagged by the webhook, see ReconciliationReasonOverpaid
+	PaymentMismatchOrderClosed  PaymentMismatchKind = "order_closed"  // Flagged by the webhook, see ReconciliationReasonOrderClosed
+	PaymentMismatchPlanConflict PaymentMismatchKind = "plan_conflict" // Flagged by the webhook, see ReconciliationReasonPlanConflict
+
+	PaymentMismatchProviderMissing   PaymentMismatchKind = "provider_missing"   // SePay has no record of the settled transfer
+	PaymentMismatchProviderAmount    PaymentMismatchKind = "provider_amount"    // SePay recorded another amount than the one applied
+	PaymentMismatchProviderReference PaymentMismatchKind = "provider_reference" // SePay transfer content does not name the order
+)
+
+// PaymentReportMismatch is one payment that needs a second look
+type PaymentReportMismatch struct {
+	Kind             PaymentMismatchKind `json:"kind"`
+	TransactionID    uuid.UUID           `json:"transactionId"`
+	ReconciliationID *uuid.UUID          `json:"reconciliationId,omitempty"`
+	OrderID          string              `json:"orderId,omitempty"`
+	SePayID          string              `json:"sepayId"`
+	ExpectedAmount   decimal.Decimal     `json:"expectedAmount"`
+	ReceivedAmount   *decimal.Decimal    `json:"receivedAmount,omitempty"`
+}
+
+// PaymentReport is the daily reconciliation of orders against the transfers SePay recorded.
+// Day is the calendar day covered, in the scheduler timezone.
+type PaymentReport struct {
+	ID            uuid.UUID               `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Day           string                  `gorm:"size:10;not null;unique" json:"day"`
+	PeriodStart   time.Time               `gorm:"not null" json:"periodStart"`
+	PeriodEnd     time.Time               `gorm:"not null" json:"periodEnd"`
+	OrdersCreated int64                   `gorm:"not null;default:0" json:"ordersCreated"`
+	OrdersPaid    int64                   `gorm:"not null;default:0" json:"ordersPaid"`    // Transfers applied during the day
+	OrdersExpired int64                   `gorm:"not null;default:0" json:"ordersExpired"` // Created during the day, never paid
+	OrdersPending int64                   `gorm:"not null;default:0" json:"ordersPending"` // Created during the day, still payable
+	PaidAmount    decimal.Decimal         `gorm:"type:decimal(19,4);not null;default:0" json:"paidAmount"`
+	Mismatches    []PaymentReportMismatch `gorm:"type:jsonb;serializer:json;not null;default:'[]'" json:"mismatches"`
+	CreatedAt     time.Time               `gorm:"not null;default:now()" json:"createdAt"`
+}
+
+// TableName returns the table name for PaymentReport
+func (PaymentReport) TableName() string {
+	return "payment_reports"
+}
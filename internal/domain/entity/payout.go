@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayoutStatus is the review state of a payout request
+type PayoutStatus string
+
+const (
+	PayoutStatusPending  PayoutStatus = "pending"
+	PayoutStatusApproved PayoutStatus = "approved" // Money sent to the author and posted to the ledger
+	PayoutStatusRejected PayoutStatus = "rejected"
+)
+
+// Payout is an author's request to withdraw earnings to a bank account.
+// An author has at most one pending request at a time.
+type Payout struct {
+	ID          uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	AuthorID    uuid.UUID       `gorm:"type:uuid;not null;index" json:"authorId"`
+	Amount      decimal.Decimal `gorm:"type:decimal(19,4);not null" json:"amount"`
+	Currency    string          `gorm:"size:10;not null;default:'VND'" json:"currency"`
+	BankName    string          `gorm:"size:100;not null" json:"bankName"`
+	AccountNo   string          `gorm:"size:50;not null" json:"accountNo"`
+	AccountName string          `gorm:"size:255;not null" json:"accountName"`
+	Status      PayoutStatus    `gorm:"size:20;not null;default:'pending'" json:"status"`
+	Note        *string         `gorm:"type:text" json:"note,omitempty"`     // Admin note on the decision
+	Reference   *string         `gorm:"size:255" json:"reference,omitempty"` // Bank reference of the transfer to the author
+	ReviewedBy  *uuid.UUID      `gorm:"type:uuid" json:"reviewedBy,omitempty"`
+	ReviewedAt  *time.Time      `json:"reviewedAt,omitempty"`
+	CreatedAt   time.Time       `gorm:"not null;default:now()" json:"createdAt"`
+	UpdatedAt   time.Time       `gorm:"not null;default:now()" json:"updatedAt"`
+}
+
+// TableName returns the table name for Payout
+func (Payout) TableName() string {
+	return "payouts"
+}
+
+// IsPending returns true while the payout waits for an admin
+func (p *Payout) IsPending() bool {
+	return p.Status == PayoutStatusPending
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PromoDiscountType is how a promo code lowers the price of a plan
+type PromoDiscountType string
+
+const (
+	PromoDiscountPercent PromoDiscountType = "percent" // DiscountValue is a percentage of the price
+	PromoDiscountFixed   PromoDiscountType = "fixed"   // DiscountValue is taken off the price, in the plan's currency
+)
+
+// PromoCode is a discount an author offers on their subscription plans. Codes are unique per
+// author and entered by readers when paying for a plan, for themselves or as a gift.
+type PromoCode struct {
+	ID              uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	AuthorID        uuid.UUID         `gorm:"type:uuid;not null;uniqueIndex:idx_promo_codes_author_code" json:"authorId"`
+	Code            string            `gorm:"size:50;not null;uniqueIndex:idx_promo_codes_author_code" json:"code"` // Stored uppercase
+	DiscountType    PromoDiscountType `gorm:"size:20;not null" json:"discountType"`
+	DiscountValue   decimal.Decimal   `gorm:"type:decimal(19,4);not null" json:"discountValue"`
+	Tier            *string           `gorm:"size:20" json:"tier,omitempty"`             // Only plans of this tier are discounted, any tier when nil
+	MaxRedemptions  *int              `json:"maxRedemptions,omitempty"`                  // Unlimited when nil
+	RedemptionCount int               `gorm:"not null;default:0" json:"redemptionCount"` // Paid orders that used the code
+	ExpiresAt       *time.Time        `json:"expiresAt,omitempty"`
+	IsActive        bool              `gorm:"not null;default:true" json:"isActive"`
+	CreatedAt       time.Time         `gorm:"not null;default:now()" json:"createdAt"`
+	UpdatedAt       time.Time         `gorm:"not null;default:now()" json:"updatedAt"`
+}
+
+// TableName returns the table name for PromoCode
+func (PromoCode) TableName() string {
+	return "promo_codes"
+}
+
+// IsRedeemable returns true if the code is active, not expired and below its usage cap at now
+func (p *PromoCode) IsRedeemable(now time.Time) bool {
+	if !p.IsActive {
+		return false
+	}
+	if p.ExpiresAt != nil && !p.ExpiresAt.After(now) {
+		return false
+	}
+	return p.MaxRedemptions == nil || p.RedemptionCount < *p.MaxRedemptions
+}
+
+// AppliesTo returns true if the code discounts plans of tier
+func (p *PromoCode) AppliesTo(tier SubscriptionTier) bool {
+	return p.Tier == nil || *p.Tier == tier.String()
+}
+
+// Discount returns how much the code takes off price, never more than price
+func (p *PromoCode) Discount(price decimal.Decimal) decimal.Decimal {
+	discount := p.DiscountValue
+	if p.DiscountType == PromoDiscountPercent {
+		discount = price.Mul(p.DiscountValue).Div(decimal.NewFromInt(100))
+	}
+	return decimal.Min(decimal.Max(discount, decimal.Zero), price)
+}
@@ -4,24 +4,33 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // Series represents a collection of blog posts
 type Series struct {
-	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	AuthorID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"authorId"`
-	Title       string     `gorm:"size:255;not null" json:"title"`
-	Slug        string     `gorm:"size:255;not null;index" json:"slug"`
-	Description string     `gorm:"type:text" json:"description"`
-	CreatedAt   time.Time  `gorm:"not null;default:now()" json:"createdAt"`
-	UpdatedAt   time.Time  `gorm:"not null;default:now()" json:"updatedAt"`
-	DeletedAt   *time.Time `gorm:"index" json:"deletedAt,omitempty"`
+	ID          uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	AuthorID    uuid.UUID       `gorm:"type:uuid;not null;index" json:"authorId"`
+	Title       string          `gorm:"size:255;not null" json:"title"`
+	Slug        string          `gorm:"size:255;not null;index" json:"slug"`
+	Description string          `gorm:"type:text" json:"description"`
+	Price       decimal.Decimal `gorm:"type:decimal(20,2);not null;default:0" json:"price"`
+	Currency    string          `gorm:"size:3;not null;default:'VND'" json:"currency"`
+	CreatedAt   time.Time       `gorm:"not null;default:now()" json:"createdAt"`
+	UpdatedAt   time.Time       `gorm:"not null;default:now()" json:"updatedAt"`
+	DeletedAt   *time.Time      `gorm:"index" json:"deletedAt,omitempty"`
 
 	// Relationships
 	Author *User  `gorm:"foreignKey:AuthorID" json:"author,omitempty"`
 	Blogs  []Blog `gorm:"many2many:series_blogs;" json:"blogs,omitempty"`
 }
 
+// IsPaid reports whether readers must buy the series to read its chapters.
+// Chapters marked as free previews stay readable for everyone.
+func (s *Series) IsPaid() bool {
+	return s.Price.IsPositive()
+}
+
 // TableName returns the table name for Series
 func (Series) TableName() string {
 	return "series"
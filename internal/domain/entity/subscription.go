@@ -16,6 +16,15 @@ type Subscription struct {
 	ExpiresAt *time.Time `gorm:"index" json:"expiresAt,omitempty"`   // Null = Free Follower
 	Tier      string     `gorm:"size:20;default:'FREE'" json:"tier"` // FREE, PREMIUM, VIP
 
+	// Lifecycle of a paid subscription after ExpiresAt, see SubscriptionLifecycleJob
+	GraceEndsAt    *time.Time `json:"graceEndsAt,omitempty"` // Set once the paid period lapsed; paid access continues until then
+	ReminderSentAt *time.Time `json:"-"`                     // Set once the expiry reminder for the current period was sent
+
+	// A paid downgrade waiting for the current period to end; the lifecycle job then switches
+	// Tier and ExpiresAt to these values
+	ScheduledTier      *string    `gorm:"size:20" json:"scheduledTier,omitempty"`
+	ScheduledExpiresAt *time.Time `json:"scheduledExpiresAt,omitempty"`
+
 	CreatedAt time.Time `gorm:"not null;default:now()" json:"createdAt"`
 	UpdatedAt time.Time `gorm:"not null;default:now()" json:"updatedAt"`
 
@@ -29,12 +38,37 @@ func (Subscription) TableName() string {
 	return "subscriptions"
 }
 
-// IsActive returns true if subscription is active (free follower or paid and not expired)
+// IsActive returns true if subscription is active (free follower, paid and not expired, in its grace period,
+// or waiting for a scheduled downgrade that is already paid for)
 func (s *Subscription) IsActive() bool {
 	if s.ExpiresAt == nil {
 		return true // Free follower
 	}
-	return s.ExpiresAt.After(time.Now())
+	if s.ScheduledExpiresAt != nil && s.ScheduledExpiresAt.After(time.Now()) {
+		return true
+	}
+	return s.ExpiresAt.After(time.Now()) || s.InGracePeriod()
+}
+
+// PaidTier returns the tier of a paid subscription that is still active, FREE otherwise
+func (s *Subscription) PaidTier() SubscriptionTier {
+	if !s.IsPaid() || !s.IsActive() {
+		return TierFree
+	}
+	if tier := SubscriptionTier(s.Tier); tier.IsValid() {
+		return tier
+	}
+	return TierFree
+}
+
+// HasScheduledChange returns true if a downgrade is waiting for the current period to end
+func (s *Subscription) HasScheduledChange() bool {
+	return s.ScheduledTier != nil
+}
+
+// InGracePeriod returns true if the paid period lapsed but the grace period has not ended yet
+func (s *Subscription) InGracePeriod() bool {
+	return s.GraceEndsAt != nil && s.GraceEndsAt.After(time.Now())
 }
 
 // IsPaid returns true if this is a paid subscription
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SubscriptionEventType is a transition in the lifecycle of a paid subscription
+type SubscriptionEventType string
+
+const (
+	SubscriptionEventActivated SubscriptionEventType = "activated" // First paid period, or a new one after the previous lapsed
+	SubscriptionEventRenewed   SubscriptionEventType = "renewed"   // Paid again before the current period ran out
+	SubscriptionEventUpgraded  SubscriptionEventType = "upgraded"  // Moved to a higher tier, unused days of the old one credited
+
+	SubscriptionEventDowngradeScheduled SubscriptionEventType = "downgrade_scheduled" // Lower tier paid, starts when the current period ends
+	SubscriptionEventTierChanged        SubscriptionEventType = "tier_changed"        // Scheduled downgrade took effect
+
+	SubscriptionEventReminderSent SubscriptionEventType = "reminder_sent" // Subscriber was warned about the upcoming expiry
+	SubscriptionEventGraceStarted SubscriptionEventType = "grace_started" // Paid period lapsed, access continues until GraceEndsAt
+	SubscriptionEventDowngraded   SubscriptionEventType = "downgraded"    // Grace period ended, subscription fell back to FREE
+
+	SubscriptionEventRefunded SubscriptionEventType = "refunded" // Period paid by TransactionID revoked after a refund or chargeback
+)
+
+// SubscriptionEvent records one lifecycle transition of a subscription.
+// Tier, ExpiresAt and GraceEndsAt hold the state right after the transition; for paid
+// transitions PreviousTier and PreviousExpiresAt hold the state right before it.
+type SubscriptionEvent struct {
+	ID             uuid.UUID             `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	SubscriptionID uuid.UUID             `gorm:"type:uuid;not null;index" json:"subscriptionId"`
+	SubscriberID   uuid.UUID             `gorm:"type:uuid;not null" json:"subscriberId"`
+	AuthorID       uuid.UUID             `gorm:"type:uuid;not null" json:"authorId"`
+	Type           SubscriptionEventType `gorm:"size:20;not null" json:"type"`
+	Tier           string                `gorm:"size:20;not null" json:"tier"`
+	ExpiresAt      *time.Time            `json:"expiresAt,omitempty"`
+	GraceEndsAt    *time.Time            `json:"graceEndsAt,omitempty"`
+	TransactionID  *uuid.UUID            `gorm:"type:uuid" json:"transactionId,omitempty"`
+
+	PreviousTier      *string    `gorm:"size:20" json:"previousTier,omitempty"`
+	PreviousExpiresAt *time.Time `json:"previousExpiresAt,omitempty"`
+
+	CreatedAt time.Time `gorm:"not null;default:now()" json:"createdAt"`
+}
+
+// TableName returns the table name for SubscriptionEvent
+func (SubscriptionEvent) TableName() string {
+	return "subscription_events"
+}
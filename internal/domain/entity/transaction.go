@@ -25,6 +25,16 @@ const (
 	TransactionStatusPending TransactionStatus = "PENDING"
 	TransactionStatusSuccess TransactionStatus = "SUCCESS"
 	TransactionStatusFailed  TransactionStatus = "FAILED"
+	// TransactionStatusReview marks a payment whose transfer did not match the order;
+	// benefits are held until the reconciliation is resolved
+	TransactionStatusReview TransactionStatus = "REVIEW"
+	// TransactionStatusExpired marks an order that was not paid in time; later transfers for it
+	// are queued for reconciliation
+	TransactionStatusExpired TransactionStatus = "EXPIRED"
+	// TransactionStatusPartiallyRefunded keeps the benefit; part of the payment was returned
+	TransactionStatusPartiallyRefunded TransactionStatus = "PARTIALLY_REFUNDED"
+	// TransactionStatusRefunded means the whole payment was returned and the benefit revoked
+	TransactionStatusRefunded TransactionStatus = "REFUNDED"
 )
 
 // TransactionProvider represents the payment provider
@@ -32,7 +42,8 @@ type TransactionProvider string
 
 // TransactionProvider enum values
 const (
-	TransactionProviderSEPAY TransactionProvider = "SEPAY"
+	TransactionProviderSEPAY  TransactionProvider = "SEPAY"
+	TransactionProviderStripe TransactionProvider = "STRIPE"
 )
 
 // TransactionGateway represents the payment gateway method
@@ -42,30 +53,57 @@ type TransactionGateway string
 const (
 	TransactionGatewayVietQR       TransactionGateway = "VIETQR"
 	TransactionGatewayBankTransfer TransactionGateway = "BANK_TRANSFER"
+	TransactionGatewayCard         TransactionGateway = "CARD"
 )
 
 // Transaction represents a payment transaction
 type Transaction struct {
-	ID             uuid.UUID              `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	UserID         uuid.UUID              `gorm:"type:uuid;not null;index" json:"userId"`
-	Amount         decimal.Decimal        `gorm:"type:decimal(19,4);not null" json:"amount"`
-	Currency       string                 `gorm:"size:3;not null;default:'VND'" json:"currency"`
-	Provider       TransactionProvider    `gorm:"size:20;not null" json:"provider"`
-	Gateway        *TransactionGateway    `gorm:"size:20" json:"gateway,omitempty"`
-	Type           TransactionType        `gorm:"size:20;not null" json:"type"`
-	Status         TransactionStatus      `gorm:"size:20;not null;default:'PENDING'" json:"status"`
-	TargetID       *uuid.UUID             `gorm:"type:uuid" json:"targetId,omitempty"`
-	PlanID         *string                `gorm:"size:50" json:"planId,omitempty"`
-	Content        string                 `gorm:"type:text" json:"content,omitempty"`
-	SePayID        string                 `gorm:"column:sepay_id;size:255;unique;index" json:"sepayId"`
-	ReferenceCode  string                 `gorm:"size:255;not null;index" json:"referenceCode"`
-	OrderID        string                 `gorm:"size:255;not null;index" json:"orderId"`
-	WebhookPayload map[string]interface{} `gorm:"type:jsonb;serializer:json;default:'{}'" json:"webhookPayload"`
-	CreatedAt      time.Time              `gorm:"not null;default:now()" json:"createdAt"`
-	UpdatedAt      time.Time              `gorm:"not null;default:now()" json:"updatedAt"`
+	ID             uuid.UUID           `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID         uuid.UUID           `gorm:"type:uuid;not null;index" json:"userId"`
+	Amount         decimal.Decimal     `gorm:"type:decimal(19,4);not null" json:"amount"`
+	PaidAmount     *decimal.Decimal    `gorm:"type:decimal(19,4)" json:"paidAmount,omitempty"` // Amount actually transferred
+	PaidAt         *time.Time          `json:"paidAt,omitempty"`                               // When the transfer was applied to the order
+	RefundedAmount decimal.Decimal     `gorm:"type:decimal(19,4);not null;default:0" json:"refundedAmount"`
+	Currency       string              `gorm:"size:3;not null;default:'VND'" json:"currency"`
+	Provider       TransactionProvider `gorm:"size:20;not null" json:"provider"`
+	Gateway        *TransactionGateway `gorm:"size:20" json:"gateway,omitempty"`
+	Type           TransactionType     `gorm:"size:20;not null" json:"type"`
+	Status         TransactionStatus   `gorm:"size:20;not null;default:'PENDING'" json:"status"`
+	TargetID       *uuid.UUID          `gorm:"type:uuid" json:"targetId,omitempty"`
+	PlanID         *string             `gorm:"size:50" json:"planId,omitempty"`
+	Gift           bool                `gorm:"not null;default:false" json:"gift"`                    // Subscription paid for someone else, issues a gift code
+	PromoCodeID    *uuid.UUID          `gorm:"type:uuid" json:"promoCodeId,omitempty"`                // Author promo code applied to the plan price
+	Discount       decimal.Decimal     `gorm:"type:decimal(19,4);not null;default:0" json:"discount"` // Taken off the plan price by the promo code
+	// Subscription quote priced at checkout, applied as is once the order is paid
+	SubscriptionChange *string                `gorm:"size:20" json:"subscriptionChange,omitempty"`
+	PeriodStartsAt     *time.Time             `json:"periodStartsAt,omitempty"`
+	PeriodExpiresAt    *time.Time             `json:"periodExpiresAt,omitempty"`
+	QuotedExpiresAt    *time.Time             `json:"-"` // Expiry of the subscription the quote was priced from
+	Content            string                 `gorm:"type:text" json:"content,omitempty"`
+	SePayID            string                 `gorm:"column:sepay_id;size:255;unique;index" json:"sepayId"`
+	ProviderRef        *string                `gorm:"size:255" json:"providerRef,omitempty"` // Checkout session at card providers
+	ReferenceCode      string                 `gorm:"size:255;not null;index" json:"referenceCode"`
+	OrderID            string                 `gorm:"size:255;not null;index" json:"orderId"`
+	WebhookPayload     map[string]interface{} `gorm:"type:jsonb;serializer:json;default:'{}'" json:"webhookPayload"`
+	CreatedAt          time.Time              `gorm:"not null;default:now()" json:"createdAt"`
+	UpdatedAt          time.Time              `gorm:"not null;default:now()" json:"updatedAt"`
 }
 
 // TableName returns the table name for Transaction
 func (Transaction) TableName() string {
 	return "transactions"
 }
+
+// IsRefundable returns true if the transaction was paid and not refunded in full yet
+func (t *Transaction) IsRefundable() bool {
+	return t.Status == TransactionStatusSuccess || t.Status == TransactionStatusPartiallyRefunded
+}
+
+// RefundableAmount returns how much of the received payment has not been refunded yet
+func (t *Transaction) RefundableAmount() decimal.Decimal {
+	paid := t.Amount
+	if t.PaidAmount != nil {
+		paid = *t.PaidAmount
+	}
+	return paid.Sub(t.RefundedAmount)
+}
@@ -217,3 +217,28 @@ func TestTransaction_OptionalFields(t *testing.T) {
 	assert.Nil(t, tx.PlanID)
 	assert.Nil(t, tx.Gateway)
 }
+
+// TestTransaction_RefundableAmount verifies refunds are bounded by what was actually received
+func TestTransaction_RefundableAmount(t *testing.T) {
+	paid := decimal.NewFromInt(120000)
+
+	tests := []struct {
+		name     string
+		tx       entity.Transaction
+		expected string
+	}{
+		{"order amount", entity.Transaction{Amount: decimal.NewFromInt(100000)}, "100000"},
+		{"received amount", entity.Transaction{Amount: decimal.NewFromInt(100000), PaidAmount: &paid}, "120000"},
+		{"after partial refund", entity.Transaction{Amount: decimal.NewFromInt(100000), RefundedAmount: decimal.NewFromInt(40000)}, "60000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.tx.RefundableAmount().String())
+		})
+	}
+
+	assert.True(t, (&entity.Transaction{Status: entity.TransactionStatusPartiallyRefunded}).IsRefundable())
+	assert.False(t, (&entity.Transaction{Status: entity.TransactionStatusRefunded}).IsRefundable())
+	assert.False(t, (&entity.Transaction{Status: entity.TransactionStatusReview}).IsRefundable())
+}
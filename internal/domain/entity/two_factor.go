@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserTwoFactor holds a user's encrypted TOTP secret. The row exists from setup on;
+// two-factor authentication is only active once EnabledAt is set.
+type UserTwoFactor struct {
+	UserID       uuid.UUID  `gorm:"type:uuid;primary_key" json:"userId"`
+	Secret       string     `gorm:"size:255;not null" json:"-"` // Base32 as shown to authenticator apps, encrypted with the application key
+	EnabledAt    *time.Time `json:"enabledAt,omitempty"`
+	LastUsedStep int64      `gorm:"not null;default:0" json:"-"` // Highest accepted TOTP time step, blocks code replay
+	CreatedAt    time.Time  `gorm:"not null;default:now()" json:"createdAt"`
+	UpdatedAt    time.Time  `gorm:"not null;default:now()" json:"updatedAt"`
+}
+
+// TableName returns the table name for UserTwoFactor
+func (UserTwoFactor) TableName() string {
+	return "user_two_factor"
+}
+
+// IsEnabled reports whether logins must pass the second factor
+func (t *UserTwoFactor) IsEnabled() bool {
+	return t != nil && t.EnabledAt != nil
+}
+
+// TwoFactorRecoveryCode is a single-use backup code; only its hash is stored
+type TwoFactorRecoveryCode struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null" json:"userId"`
+	CodeHash  string     `gorm:"size:64;not null" json:"-"`
+	UsedAt    *time.Time `json:"usedAt,omitempty"`
+	CreatedAt time.Time  `gorm:"not null;default:now()" json:"createdAt"`
+}
+
+// TableName returns the table name for TwoFactorRecoveryCode
+func (TwoFactorRecoveryCode) TableName() string {
+	return "two_factor_recovery_codes"
+}
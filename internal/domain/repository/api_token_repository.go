@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+// APITokenRepository defines the interface for personal access token persistence
+type APITokenRepository interface {
+	Create(ctx context.Context, token *entity.APIToken) error
+	// FindByHash returns the token with the given hash, or nil when there is none
+	FindByHash(ctx context.Context, tokenHash string) (*entity.APIToken, error)
+	// ListByUser returns the user's tokens, newest first, including revoked and expired ones
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]entity.APIToken, error)
+	// CountActiveByUser counts the user's tokens that are neither revoked nor expired at the given time
+	CountActiveByUser(ctx context.Context, userID uuid.UUID, at time.Time) (int64, error)
+	// Revoke revokes one of the user's tokens; it reports false when the user has no such unrevoked token
+	Revoke(ctx context.Context, userID, tokenID uuid.UUID) (bool, error)
+	// RevokeAllByUser revokes every unrevoked token of the user
+	RevokeAllByUser(ctx context.Context, userID uuid.UUID) error
+	UpdateLastUsed(ctx context.Context, tokenID uuid.UUID, at time.Time) error
+}
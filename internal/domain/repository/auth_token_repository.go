@@ -0,0 +1,28 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuthTokenPurpose separates the keyspaces of single-use account tokens
+type AuthTokenPurpose string
+
+const (
+	AuthTokenEmailVerification AuthTokenPurpose = "email_verification"
+	AuthTokenPasswordReset     AuthTokenPurpose = "password_reset"
+	// AuthTokenTwoFactorChallenge links the password step of a login to its second factor
+	AuthTokenTwoFactorChallenge AuthTokenPurpose = "two_factor_challenge"
+)
+
+// AuthTokenRepository stores single-use tokens issued to users
+type AuthTokenRepository interface {
+	// Create stores a token for the user, invalidating any earlier token with the same purpose
+	Create(ctx context.Context, purpose AuthTokenPurpose, token string, userID uuid.UUID, ttl time.Duration) error
+	// Consume deletes the token and returns its user; uuid.Nil when unknown or expired
+	Consume(ctx context.Context, purpose AuthTokenPurpose, token string) (uuid.UUID, error)
+}
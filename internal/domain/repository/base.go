@@ -23,6 +23,12 @@ type PaginatedResult[T any] struct {
 	TotalPages int   `json:"totalPages"`
 }
 
+// CursorPage holds one page of keyset-paginated results
+type CursorPage[T any] struct {
+	Data       []T    `json:"data"`
+	NextCursor string `json:"nextCursor,omitempty"` // Empty on the last page
+}
+
 // BaseRepository defines the base repository interface using generics
 type BaseRepository[T any] interface {
 	Create(ctx context.Context, entity *T) error
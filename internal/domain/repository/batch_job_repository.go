@@ -0,0 +1,40 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// BatchJobFilter defines filter options for batch job queries
+type BatchJobFilter struct {
+	JobType *string
+	Status  *entity.BatchJobStatus
+}
+
+// BatchJobRepository defines the interface for persistent batch job operations
+type BatchJobRepository interface {
+	Create(ctx context.Context, job *entity.BatchJob) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.BatchJob, error)
+	FindAll(ctx context.Context, filter BatchJobFilter, pagination Pagination) (*PaginatedResult[entity.BatchJob], error)
+
+	// FindStale returns running jobs whose lease has expired, i.e. whose worker died
+	FindStale(ctx context.Context, jobType string, now time.Time) ([]entity.BatchJob, error)
+
+	// Claim takes over the lease of a running job that is unleased or whose lease expired.
+	// A worker renews its own lease the same way. It returns false when another worker
+	// holds a live lease or the job is no longer running.
+	Claim(ctx context.Context, id uuid.UUID, workerID string, leaseUntil time.Time) (bool, error)
+
+	// SaveProgress persists counters, checkpoint, errors and status while workerID still
+	// holds the lease on a running job. It returns false when the lease was lost or the
+	// job was cancelled, in which case the worker must stop.
+	SaveProgress(ctx context.Context, job *entity.BatchJob, workerID string) (bool, error)
+
+	// Cancel marks a running job as cancelled. It returns false when the job is already finished.
+	Cancel(ctx context.Context, id uuid.UUID, message string) (bool, error)
+}
@@ -19,6 +19,20 @@ type BlogFilter struct {
 	TagIDs          []uuid.UUID
 	Search          *string // search in title or content
 	PublishedBefore *time.Time
+	// Access restricts the query to the blogs a viewer may see and marks the tier-gated ones
+	// as locked; nil returns every blog, for admin views
+	Access *BlogAccess
+}
+
+// BlogAccess identifies who a blog query is run for
+type BlogAccess struct {
+	ViewerID *uuid.UUID // nil for anonymous readers
+}
+
+// BlogCursor is the position of a blog in the (published_at, id) order of published blogs
+type BlogCursor struct {
+	PublishedAt time.Time
+	ID          uuid.UUID
 }
 
 // BlogRepository defines the interface for blog data operations
@@ -27,9 +41,31 @@ type BlogRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Blog, error)
 	FindBySlug(ctx context.Context, authorID uuid.UUID, slug string) (*entity.Blog, error)
 	FindAll(ctx context.Context, filter BlogFilter, pagination Pagination) (*PaginatedResult[entity.Blog], error)
+	// FindPublishedAfter returns up to limit published blogs matching the filter, newest first,
+	// starting after the cursor when it is set
+	FindPublishedAfter(ctx context.Context, filter BlogFilter, after *BlogCursor, limit int) ([]entity.Blog, error)
 	Update(ctx context.Context, blog *entity.Blog) error
 	Delete(ctx context.Context, id uuid.UUID) error
 
+	// Scheduled publishing
+	// FindScheduledDue returns published blogs whose publish time has passed but whose
+	// followers were not told yet, oldest publish time first
+	FindScheduledDue(ctx context.Context, now time.Time, limit int) ([]entity.Blog, error)
+	// FindScheduledByAuthor returns the author's published blogs that were not announced yet,
+	// soonest first
+	FindScheduledByAuthor(ctx context.Context, authorID uuid.UUID) ([]entity.Blog, error)
+	// MarkAnnounced records that the blog was announced, unless it already was, is no longer
+	// published or was rescheduled away from publishedAt. It reports whether the blog was marked.
+	MarkAnnounced(ctx context.Context, id uuid.UUID, publishedAt, now time.Time) (bool, error)
+	// Reschedule moves a blog that still waits for its publish time to publishAt. It reports
+	// false once the publish time passed or the blog was announced.
+	Reschedule(ctx context.Context, id uuid.UUID, publishAt time.Time, timezone *string, now time.Time) (bool, error)
+	// CancelSchedule turns a blog that still waits for its publish time back into a draft and
+	// forgets its announcement. It reports false once the publish time passed or the blog was announced.
+	CancelSchedule(ctx context.Context, id uuid.UUID, now time.Time) (bool, error)
+	// ResetAnnouncement forgets the announcement of an unpublished blog so publishing it again announces it
+	ResetAnnouncement(ctx context.Context, id uuid.UUID) error
+
 	// Tag operations
 	AddTags(ctx context.Context, blogID uuid.UUID, tagIDs []uuid.UUID) error
 	RemoveTags(ctx context.Context, blogID uuid.UUID, tagIDs []uuid.UUID) error
@@ -50,4 +86,7 @@ type BlogRepository interface {
 
 	// ExistsByAuthorAndTag checks if any blog by the author has the specified tag
 	ExistsByAuthorAndTag(ctx context.Context, authorID, tagID uuid.UUID) (bool, error)
+
+	// WithTx returns a new repository with the given transaction
+	WithTx(tx interface{}) BlogRepository
 }
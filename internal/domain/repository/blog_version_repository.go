@@ -4,6 +4,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/google/uuid"
@@ -31,4 +32,17 @@ type BlogVersionRepository interface {
 
 	// DeleteOldest deletes oldest versions keeping only 'keep' most recent
 	DeleteOldest(ctx context.Context, blogID uuid.UUID, keep int) error
+
+	// DeleteCreatedBefore deletes the versions of a blog created before a time, except the latest
+	DeleteCreatedBefore(ctx context.Context, blogID uuid.UUID, before time.Time) (int64, error)
+
+	// DeleteExpired deletes versions older than the age limit of their blog's retention policy,
+	// except the latest version of each blog
+	DeleteExpired(ctx context.Context) (int64, error)
+
+	// FindRetention finds the retention policy of a blog, nil when it has none
+	FindRetention(ctx context.Context, blogID uuid.UUID) (*entity.BlogVersionRetention, error)
+
+	// SaveRetention creates or replaces the retention policy of a blog
+	SaveRetention(ctx context.Context, retention *entity.BlogVersionRetention) error
 }
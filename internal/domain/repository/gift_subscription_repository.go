@@ -0,0 +1,32 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// GiftSubscriptionRepository defines the interface for gift subscription codes
+type GiftSubscriptionRepository interface {
+	Create(ctx context.Context, gift *entity.GiftSubscription) error
+	FindByCode(ctx context.Context, code string) (*entity.GiftSubscription, error)
+	FindByTransactionID(ctx context.Context, transactionID uuid.UUID) (*entity.GiftSubscription, error)
+
+	// FindByPurchaser returns the gifts a user paid for, most recent first
+	FindByPurchaser(ctx context.Context, purchaserID uuid.UUID, pagination Pagination) (*PaginatedResult[entity.GiftSubscription], error)
+
+	// Redeem hands an issued gift to its recipient. It returns false when the gift was already
+	// redeemed or revoked.
+	Redeem(ctx context.Context, id, recipientID uuid.UUID, redeemedAt time.Time) (bool, error)
+
+	// Revoke voids the gift paid by a transaction. It returns false when there is no such gift
+	// or it was already redeemed.
+	Revoke(ctx context.Context, transactionID uuid.UUID) (bool, error)
+
+	// WithTx returns a new repository with the given transaction
+	WithTx(tx interface{}) GiftSubscriptionRepository
+}
@@ -0,0 +1,49 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LedgerEarningsFilter restricts earnings to journals in Currency posted within [From, To)
+type LedgerEarningsFilter struct {
+	Currency string
+	From     *time.Time
+	To       *time.Time
+}
+
+// LedgerEarningsRow aggregates an author's payments and refunds for one thing paid for:
+// a subscription tier, a series or donations
+type LedgerEarningsRow struct {
+	TransactionType entity.TransactionType
+	Tier            *string
+	SeriesID        *uuid.UUID
+	Gross           decimal.Decimal // Paid by readers, less refunds
+	Fee             decimal.Decimal // Kept by the platform
+	Net             decimal.Decimal // Credited to the author
+	Payments        int64
+}
+
+// LedgerRepository defines the interface for the append-only earnings ledger
+type LedgerRepository interface {
+	// CreateJournal stores the entries of one journal
+	CreateJournal(ctx context.Context, entries []entity.LedgerEntry) error
+
+	// FindBySource returns the entries posted for a payment, refund or payout
+	FindBySource(ctx context.Context, kind entity.LedgerEntryKind, sourceID uuid.UUID) ([]entity.LedgerEntry, error)
+
+	// AuthorBalance returns what the platform owes an author in a currency
+	AuthorBalance(ctx context.Context, authorID uuid.UUID, currency string) (decimal.Decimal, error)
+
+	// AuthorEarnings returns an author's payments and refunds grouped by what was paid for
+	AuthorEarnings(ctx context.Context, authorID uuid.UUID, filter LedgerEarningsFilter) ([]LedgerEarningsRow, error)
+
+	// WithTx returns a new repository with the given transaction
+	WithTx(tx interface{}) LedgerRepository
+}
@@ -0,0 +1,34 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// MediaAssetRepository defines the interface for uploaded media and the storage used by each user
+type MediaAssetRepository interface {
+	Create(ctx context.Context, asset *entity.MediaAsset) error
+
+	// FindByOwner returns a page of a user's media, most recent first, of one purpose when purpose is set
+	FindByOwner(ctx context.Context, ownerID uuid.UUID, purpose *entity.MediaPurpose, pagination Pagination) (*PaginatedResult[entity.MediaAsset], error)
+
+	// Delete removes a user's asset and returns it, or nil when it does not exist or belongs to another user
+	Delete(ctx context.Context, id, ownerID uuid.UUID) (*entity.MediaAsset, error)
+
+	// ReserveQuota adds bytes to the storage used by a user unless the total would exceed limit.
+	// It returns false when the quota is exceeded.
+	ReserveQuota(ctx context.Context, ownerID uuid.UUID, bytes, limit int64) (bool, error)
+
+	// ReleaseQuota gives back bytes reserved by a user
+	ReleaseQuota(ctx context.Context, ownerID uuid.UUID, bytes int64) error
+
+	// GetUsage returns the bytes stored by a user
+	GetUsage(ctx context.Context, ownerID uuid.UUID) (int64, error)
+
+	// WithTx returns a new repository with the given transaction
+	WithTx(tx interface{}) MediaAssetRepository
+}
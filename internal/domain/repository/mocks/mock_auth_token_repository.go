@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: auth_token_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=auth_token_repository.go -destination=mocks/mock_auth_token_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	repository "github.com/aiagent/internal/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthTokenRepository is a mock of AuthTokenRepository interface.
+type MockAuthTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthTokenRepositoryMockRecorder is the mock recorder for MockAuthTokenRepository.
+type MockAuthTokenRepositoryMockRecorder struct {
+	mock *MockAuthTokenRepository
+}
+
+// NewMockAuthTokenRepository creates a new mock instance.
+func NewMockAuthTokenRepository(ctrl *gomock.Controller) *MockAuthTokenRepository {
+	mock := &MockAuthTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthTokenRepository) EXPECT() *MockAuthTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockAuthTokenRepository) Consume(ctx context.Context, purpose repository.AuthTokenPurpose, token string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, purpose, token)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockAuthTokenRepositoryMockRecorder) Consume(ctx, purpose, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockAuthTokenRepository)(nil).Consume), ctx, purpose, token)
+}
+
+// Create mocks base method.
+func (m *MockAuthTokenRepository) Create(ctx context.Context, purpose repository.AuthTokenPurpose, token string, userID uuid.UUID, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, purpose, token, userID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuthTokenRepositoryMockRecorder) Create(ctx, purpose, token, userID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuthTokenRepository)(nil).Create), ctx, purpose, token, userID, ttl)
+}
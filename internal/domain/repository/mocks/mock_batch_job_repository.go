@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: batch_job_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=batch_job_repository.go -destination=mocks/mock_batch_job_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBatchJobRepository is a mock of BatchJobRepository interface.
+type MockBatchJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchJobRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchJobRepositoryMockRecorder is the mock recorder for MockBatchJobRepository.
+type MockBatchJobRepositoryMockRecorder struct {
+	mock *MockBatchJobRepository
+}
+
+// NewMockBatchJobRepository creates a new mock instance.
+func NewMockBatchJobRepository(ctrl *gomock.Controller) *MockBatchJobRepository {
+	mock := &MockBatchJobRepository{ctrl: ctrl}
+	mock.recorder = &MockBatchJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchJobRepository) EXPECT() *MockBatchJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockBatchJobRepository) Cancel(ctx context.Context, id uuid.UUID, message string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id, message)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockBatchJobRepositoryMockRecorder) Cancel(ctx, id, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockBatchJobRepository)(nil).Cancel), ctx, id, message)
+}
+
+// Claim mocks base method.
+func (m *MockBatchJobRepository) Claim(ctx context.Context, id uuid.UUID, workerID string, leaseUntil time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, id, workerID, leaseUntil)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockBatchJobRepositoryMockRecorder) Claim(ctx, id, workerID, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockBatchJobRepository)(nil).Claim), ctx, id, workerID, leaseUntil)
+}
+
+// Create mocks base method.
+func (m *MockBatchJobRepository) Create(ctx context.Context, job *entity.BatchJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBatchJobRepositoryMockRecorder) Create(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBatchJobRepository)(nil).Create), ctx, job)
+}
+
+// FindAll mocks base method.
+func (m *MockBatchJobRepository) FindAll(ctx context.Context, filter repository.BatchJobFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.BatchJob], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.BatchJob])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockBatchJobRepositoryMockRecorder) FindAll(ctx, filter, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockBatchJobRepository)(nil).FindAll), ctx, filter, pagination)
+}
+
+// FindByID mocks base method.
+func (m *MockBatchJobRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.BatchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.BatchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockBatchJobRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockBatchJobRepository)(nil).FindByID), ctx, id)
+}
+
+// FindStale mocks base method.
+func (m *MockBatchJobRepository) FindStale(ctx context.Context, jobType string, now time.Time) ([]entity.BatchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStale", ctx, jobType, now)
+	ret0, _ := ret[0].([]entity.BatchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStale indicates an expected call of FindStale.
+func (mr *MockBatchJobRepositoryMockRecorder) FindStale(ctx, jobType, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStale", reflect.TypeOf((*MockBatchJobRepository)(nil).FindStale), ctx, jobType, now)
+}
+
+// SaveProgress mocks base method.
+func (m *MockBatchJobRepository) SaveProgress(ctx context.Context, job *entity.BatchJob, workerID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProgress", ctx, job, workerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveProgress indicates an expected call of SaveProgress.
+func (mr *MockBatchJobRepositoryMockRecorder) SaveProgress(ctx, job, workerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProgress", reflect.TypeOf((*MockBatchJobRepository)(nil).SaveProgress), ctx, job, workerID)
+}
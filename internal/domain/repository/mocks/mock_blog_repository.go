@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/aiagent/internal/domain/entity"
 	repository "github.com/aiagent/internal/domain/repository"
@@ -57,6 +58,21 @@ func (mr *MockBlogRepositoryMockRecorder) AddTags(ctx, blogID, tagIDs any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockBlogRepository)(nil).AddTags), ctx, blogID, tagIDs)
 }
 
+// CancelSchedule mocks base method.
+func (m *MockBlogRepository) CancelSchedule(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSchedule", ctx, id, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSchedule indicates an expected call of CancelSchedule.
+func (mr *MockBlogRepositoryMockRecorder) CancelSchedule(ctx, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSchedule", reflect.TypeOf((*MockBlogRepository)(nil).CancelSchedule), ctx, id, now)
+}
+
 // CountByMonth mocks base method.
 func (m *MockBlogRepository) CountByMonth(ctx context.Context, months int) ([]entity.MonthlyCount, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +176,21 @@ func (mr *MockBlogRepositoryMockRecorder) FindBySlug(ctx, authorID, slug any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockBlogRepository)(nil).FindBySlug), ctx, authorID, slug)
 }
 
+// FindPublishedAfter mocks base method.
+func (m *MockBlogRepository) FindPublishedAfter(ctx context.Context, filter repository.BlogFilter, after *repository.BlogCursor, limit int) ([]entity.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPublishedAfter", ctx, filter, after, limit)
+	ret0, _ := ret[0].([]entity.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPublishedAfter indicates an expected call of FindPublishedAfter.
+func (mr *MockBlogRepositoryMockRecorder) FindPublishedAfter(ctx, filter, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublishedAfter", reflect.TypeOf((*MockBlogRepository)(nil).FindPublishedAfter), ctx, filter, after, limit)
+}
+
 // FindRelated mocks base method.
 func (m *MockBlogRepository) FindRelated(ctx context.Context, blogID uuid.UUID, limit int) ([]entity.Blog, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +206,51 @@ func (mr *MockBlogRepositoryMockRecorder) FindRelated(ctx, blogID, limit any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRelated", reflect.TypeOf((*MockBlogRepository)(nil).FindRelated), ctx, blogID, limit)
 }
 
+// FindScheduledByAuthor mocks base method.
+func (m *MockBlogRepository) FindScheduledByAuthor(ctx context.Context, authorID uuid.UUID) ([]entity.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindScheduledByAuthor", ctx, authorID)
+	ret0, _ := ret[0].([]entity.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindScheduledByAuthor indicates an expected call of FindScheduledByAuthor.
+func (mr *MockBlogRepositoryMockRecorder) FindScheduledByAuthor(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindScheduledByAuthor", reflect.TypeOf((*MockBlogRepository)(nil).FindScheduledByAuthor), ctx, authorID)
+}
+
+// FindScheduledDue mocks base method.
+func (m *MockBlogRepository) FindScheduledDue(ctx context.Context, now time.Time, limit int) ([]entity.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindScheduledDue", ctx, now, limit)
+	ret0, _ := ret[0].([]entity.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindScheduledDue indicates an expected call of FindScheduledDue.
+func (mr *MockBlogRepositoryMockRecorder) FindScheduledDue(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindScheduledDue", reflect.TypeOf((*MockBlogRepository)(nil).FindScheduledDue), ctx, now, limit)
+}
+
+// MarkAnnounced mocks base method.
+func (m *MockBlogRepository) MarkAnnounced(ctx context.Context, id uuid.UUID, publishedAt, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAnnounced", ctx, id, publishedAt, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAnnounced indicates an expected call of MarkAnnounced.
+func (mr *MockBlogRepositoryMockRecorder) MarkAnnounced(ctx, id, publishedAt, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAnnounced", reflect.TypeOf((*MockBlogRepository)(nil).MarkAnnounced), ctx, id, publishedAt, now)
+}
+
 // React mocks base method.
 func (m *MockBlogRepository) React(ctx context.Context, blogID, userID uuid.UUID, reactionType entity.ReactionType) (int, int, error) {
 	m.ctrl.T.Helper()
@@ -219,6 +295,35 @@ func (mr *MockBlogRepositoryMockRecorder) ReplaceTags(ctx, blogID, tagIDs any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTags", reflect.TypeOf((*MockBlogRepository)(nil).ReplaceTags), ctx, blogID, tagIDs)
 }
 
+// Reschedule mocks base method.
+func (m *MockBlogRepository) Reschedule(ctx context.Context, id uuid.UUID, publishAt time.Time, timezone *string, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", ctx, id, publishAt, timezone, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockBlogRepositoryMockRecorder) Reschedule(ctx, id, publishAt, timezone, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockBlogRepository)(nil).Reschedule), ctx, id, publishAt, timezone, now)
+}
+
+// ResetAnnouncement mocks base method.
+func (m *MockBlogRepository) ResetAnnouncement(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetAnnouncement", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetAnnouncement indicates an expected call of ResetAnnouncement.
+func (mr *MockBlogRepositoryMockRecorder) ResetAnnouncement(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetAnnouncement", reflect.TypeOf((*MockBlogRepository)(nil).ResetAnnouncement), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockBlogRepository) Update(ctx context.Context, blog *entity.Blog) error {
 	m.ctrl.T.Helper()
@@ -246,3 +351,17 @@ func (mr *MockBlogRepositoryMockRecorder) UpdateCounts(ctx, blogID, upDelta, dow
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCounts", reflect.TypeOf((*MockBlogRepository)(nil).UpdateCounts), ctx, blogID, upDelta, downDelta)
 }
+
+// WithTx mocks base method.
+func (m *MockBlogRepository) WithTx(tx any) repository.BlogRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.BlogRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockBlogRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockBlogRepository)(nil).WithTx), tx)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/aiagent/internal/domain/entity"
 	repository "github.com/aiagent/internal/domain/repository"
@@ -86,6 +87,36 @@ func (mr *MockBlogVersionRepositoryMockRecorder) Delete(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBlogVersionRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteCreatedBefore mocks base method.
+func (m *MockBlogVersionRepository) DeleteCreatedBefore(ctx context.Context, blogID uuid.UUID, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCreatedBefore", ctx, blogID, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCreatedBefore indicates an expected call of DeleteCreatedBefore.
+func (mr *MockBlogVersionRepositoryMockRecorder) DeleteCreatedBefore(ctx, blogID, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCreatedBefore", reflect.TypeOf((*MockBlogVersionRepository)(nil).DeleteCreatedBefore), ctx, blogID, before)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockBlogVersionRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockBlogVersionRepositoryMockRecorder) DeleteExpired(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockBlogVersionRepository)(nil).DeleteExpired), ctx)
+}
+
 // DeleteOldest mocks base method.
 func (m *MockBlogVersionRepository) DeleteOldest(ctx context.Context, blogID uuid.UUID, keep int) error {
 	m.ctrl.T.Helper()
@@ -130,6 +161,21 @@ func (mr *MockBlogVersionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockBlogVersionRepository)(nil).FindByID), ctx, id)
 }
 
+// FindRetention mocks base method.
+func (m *MockBlogVersionRepository) FindRetention(ctx context.Context, blogID uuid.UUID) (*entity.BlogVersionRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRetention", ctx, blogID)
+	ret0, _ := ret[0].(*entity.BlogVersionRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRetention indicates an expected call of FindRetention.
+func (mr *MockBlogVersionRepositoryMockRecorder) FindRetention(ctx, blogID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRetention", reflect.TypeOf((*MockBlogVersionRepository)(nil).FindRetention), ctx, blogID)
+}
+
 // GetNextVersionNumber mocks base method.
 func (m *MockBlogVersionRepository) GetNextVersionNumber(ctx context.Context, blogID uuid.UUID) (int, error) {
 	m.ctrl.T.Helper()
@@ -144,3 +190,17 @@ func (mr *MockBlogVersionRepositoryMockRecorder) GetNextVersionNumber(ctx, blogI
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextVersionNumber", reflect.TypeOf((*MockBlogVersionRepository)(nil).GetNextVersionNumber), ctx, blogID)
 }
+
+// SaveRetention mocks base method.
+func (m *MockBlogVersionRepository) SaveRetention(ctx context.Context, retention *entity.BlogVersionRetention) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRetention", ctx, retention)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRetention indicates an expected call of SaveRetention.
+func (mr *MockBlogVersionRepositoryMockRecorder) SaveRetention(ctx, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRetention", reflect.TypeOf((*MockBlogVersionRepository)(nil).SaveRetention), ctx, retention)
+}
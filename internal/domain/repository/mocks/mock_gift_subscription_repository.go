@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: gift_subscription_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=gift_subscription_repository.go -destination=mocks/mock_gift_subscription_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGiftSubscriptionRepository is a mock of GiftSubscriptionRepository interface.
+type MockGiftSubscriptionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftSubscriptionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGiftSubscriptionRepositoryMockRecorder is the mock recorder for MockGiftSubscriptionRepository.
+type MockGiftSubscriptionRepositoryMockRecorder struct {
+	mock *MockGiftSubscriptionRepository
+}
+
+// NewMockGiftSubscriptionRepository creates a new mock instance.
+func NewMockGiftSubscriptionRepository(ctrl *gomock.Controller) *MockGiftSubscriptionRepository {
+	mock := &MockGiftSubscriptionRepository{ctrl: ctrl}
+	mock.recorder = &MockGiftSubscriptionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftSubscriptionRepository) EXPECT() *MockGiftSubscriptionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockGiftSubscriptionRepository) Create(ctx context.Context, gift *entity.GiftSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, gift)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGiftSubscriptionRepositoryMockRecorder) Create(ctx, gift any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGiftSubscriptionRepository)(nil).Create), ctx, gift)
+}
+
+// FindByCode mocks base method.
+func (m *MockGiftSubscriptionRepository) FindByCode(ctx context.Context, code string) (*entity.GiftSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", ctx, code)
+	ret0, _ := ret[0].(*entity.GiftSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockGiftSubscriptionRepositoryMockRecorder) FindByCode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockGiftSubscriptionRepository)(nil).FindByCode), ctx, code)
+}
+
+// FindByPurchaser mocks base method.
+func (m *MockGiftSubscriptionRepository) FindByPurchaser(ctx context.Context, purchaserID uuid.UUID, pagination repository.Pagination) (*repository.PaginatedResult[entity.GiftSubscription], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPurchaser", ctx, purchaserID, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.GiftSubscription])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPurchaser indicates an expected call of FindByPurchaser.
+func (mr *MockGiftSubscriptionRepositoryMockRecorder) FindByPurchaser(ctx, purchaserID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPurchaser", reflect.TypeOf((*MockGiftSubscriptionRepository)(nil).FindByPurchaser), ctx, purchaserID, pagination)
+}
+
+// FindByTransactionID mocks base method.
+func (m *MockGiftSubscriptionRepository) FindByTransactionID(ctx context.Context, transactionID uuid.UUID) (*entity.GiftSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].(*entity.GiftSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTransactionID indicates an expected call of FindByTransactionID.
+func (mr *MockGiftSubscriptionRepositoryMockRecorder) FindByTransactionID(ctx, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTransactionID", reflect.TypeOf((*MockGiftSubscriptionRepository)(nil).FindByTransactionID), ctx, transactionID)
+}
+
+// Redeem mocks base method.
+func (m *MockGiftSubscriptionRepository) Redeem(ctx context.Context, id, recipientID uuid.UUID, redeemedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, id, recipientID, redeemedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockGiftSubscriptionRepositoryMockRecorder) Redeem(ctx, id, recipientID, redeemedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockGiftSubscriptionRepository)(nil).Redeem), ctx, id, recipientID, redeemedAt)
+}
+
+// Revoke mocks base method.
+func (m *MockGiftSubscriptionRepository) Revoke(ctx context.Context, transactionID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, transactionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockGiftSubscriptionRepositoryMockRecorder) Revoke(ctx, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockGiftSubscriptionRepository)(nil).Revoke), ctx, transactionID)
+}
+
+// WithTx mocks base method.
+func (m *MockGiftSubscriptionRepository) WithTx(tx any) repository.GiftSubscriptionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.GiftSubscriptionRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockGiftSubscriptionRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockGiftSubscriptionRepository)(nil).WithTx), tx)
+}
@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ledger_repository.go -destination=mocks/mock_ledger_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerRepository is a mock of LedgerRepository interface.
+type MockLedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerRepositoryMockRecorder is the mock recorder for MockLedgerRepository.
+type MockLedgerRepositoryMockRecorder struct {
+	mock *MockLedgerRepository
+}
+
+// NewMockLedgerRepository creates a new mock instance.
+func NewMockLedgerRepository(ctrl *gomock.Controller) *MockLedgerRepository {
+	mock := &MockLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepository) EXPECT() *MockLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AuthorBalance mocks base method.
+func (m *MockLedgerRepository) AuthorBalance(ctx context.Context, authorID uuid.UUID, currency string) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorBalance", ctx, authorID, currency)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorBalance indicates an expected call of AuthorBalance.
+func (mr *MockLedgerRepositoryMockRecorder) AuthorBalance(ctx, authorID, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorBalance", reflect.TypeOf((*MockLedgerRepository)(nil).AuthorBalance), ctx, authorID, currency)
+}
+
+// AuthorEarnings mocks base method.
+func (m *MockLedgerRepository) AuthorEarnings(ctx context.Context, authorID uuid.UUID, filter repository.LedgerEarningsFilter) ([]repository.LedgerEarningsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorEarnings", ctx, authorID, filter)
+	ret0, _ := ret[0].([]repository.LedgerEarningsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorEarnings indicates an expected call of AuthorEarnings.
+func (mr *MockLedgerRepositoryMockRecorder) AuthorEarnings(ctx, authorID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorEarnings", reflect.TypeOf((*MockLedgerRepository)(nil).AuthorEarnings), ctx, authorID, filter)
+}
+
+// CreateJournal mocks base method.
+func (m *MockLedgerRepository) CreateJournal(ctx context.Context, entries []entity.LedgerEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJournal", ctx, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateJournal indicates an expected call of CreateJournal.
+func (mr *MockLedgerRepositoryMockRecorder) CreateJournal(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJournal", reflect.TypeOf((*MockLedgerRepository)(nil).CreateJournal), ctx, entries)
+}
+
+// FindBySource mocks base method.
+func (m *MockLedgerRepository) FindBySource(ctx context.Context, kind entity.LedgerEntryKind, sourceID uuid.UUID) ([]entity.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySource", ctx, kind, sourceID)
+	ret0, _ := ret[0].([]entity.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySource indicates an expected call of FindBySource.
+func (mr *MockLedgerRepositoryMockRecorder) FindBySource(ctx, kind, sourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySource", reflect.TypeOf((*MockLedgerRepository)(nil).FindBySource), ctx, kind, sourceID)
+}
+
+// WithTx mocks base method.
+func (m *MockLedgerRepository) WithTx(tx any) repository.LedgerRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.LedgerRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockLedgerRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockLedgerRepository)(nil).WithTx), tx)
+}
@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: media_asset_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=media_asset_repository.go -destination=mocks/mock_media_asset_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMediaAssetRepository is a mock of MediaAssetRepository interface.
+type MockMediaAssetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaAssetRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMediaAssetRepositoryMockRecorder is the mock recorder for MockMediaAssetRepository.
+type MockMediaAssetRepositoryMockRecorder struct {
+	mock *MockMediaAssetRepository
+}
+
+// NewMockMediaAssetRepository creates a new mock instance.
+func NewMockMediaAssetRepository(ctrl *gomock.Controller) *MockMediaAssetRepository {
+	mock := &MockMediaAssetRepository{ctrl: ctrl}
+	mock.recorder = &MockMediaAssetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaAssetRepository) EXPECT() *MockMediaAssetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockMediaAssetRepository) Create(ctx context.Context, asset *entity.MediaAsset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, asset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMediaAssetRepositoryMockRecorder) Create(ctx, asset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMediaAssetRepository)(nil).Create), ctx, asset)
+}
+
+// Delete mocks base method.
+func (m *MockMediaAssetRepository) Delete(ctx context.Context, id, ownerID uuid.UUID) (*entity.MediaAsset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, ownerID)
+	ret0, _ := ret[0].(*entity.MediaAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMediaAssetRepositoryMockRecorder) Delete(ctx, id, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMediaAssetRepository)(nil).Delete), ctx, id, ownerID)
+}
+
+// FindByOwner mocks base method.
+func (m *MockMediaAssetRepository) FindByOwner(ctx context.Context, ownerID uuid.UUID, purpose *entity.MediaPurpose, pagination repository.Pagination) (*repository.PaginatedResult[entity.MediaAsset], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOwner", ctx, ownerID, purpose, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.MediaAsset])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOwner indicates an expected call of FindByOwner.
+func (mr *MockMediaAssetRepositoryMockRecorder) FindByOwner(ctx, ownerID, purpose, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOwner", reflect.TypeOf((*MockMediaAssetRepository)(nil).FindByOwner), ctx, ownerID, purpose, pagination)
+}
+
+// GetUsage mocks base method.
+func (m *MockMediaAssetRepository) GetUsage(ctx context.Context, ownerID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, ownerID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockMediaAssetRepositoryMockRecorder) GetUsage(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockMediaAssetRepository)(nil).GetUsage), ctx, ownerID)
+}
+
+// ReleaseQuota mocks base method.
+func (m *MockMediaAssetRepository) ReleaseQuota(ctx context.Context, ownerID uuid.UUID, bytes int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseQuota", ctx, ownerID, bytes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseQuota indicates an expected call of ReleaseQuota.
+func (mr *MockMediaAssetRepositoryMockRecorder) ReleaseQuota(ctx, ownerID, bytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseQuota", reflect.TypeOf((*MockMediaAssetRepository)(nil).ReleaseQuota), ctx, ownerID, bytes)
+}
+
+// ReserveQuota mocks base method.
+func (m *MockMediaAssetRepository) ReserveQuota(ctx context.Context, ownerID uuid.UUID, bytes, limit int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveQuota", ctx, ownerID, bytes, limit)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveQuota indicates an expected call of ReserveQuota.
+func (mr *MockMediaAssetRepositoryMockRecorder) ReserveQuota(ctx, ownerID, bytes, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveQuota", reflect.TypeOf((*MockMediaAssetRepository)(nil).ReserveQuota), ctx, ownerID, bytes, limit)
+}
+
+// WithTx mocks base method.
+func (m *MockMediaAssetRepository) WithTx(tx any) repository.MediaAssetRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.MediaAssetRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockMediaAssetRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockMediaAssetRepository)(nil).WithTx), tx)
+}
@@ -42,6 +42,20 @@ func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRec
 	return m.recorder
 }
 
+// CreateBatch mocks base method.
+func (m *MockNotificationRepository) CreateBatch(ctx context.Context, notifications []*entity.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, notifications)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockNotificationRepositoryMockRecorder) CreateBatch(ctx, notifications any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockNotificationRepository)(nil).CreateBatch), ctx, notifications)
+}
+
 // DeleteExpired mocks base method.
 func (m *MockNotificationRepository) DeleteExpired(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -168,6 +182,21 @@ func (m *MockNotificationPreferenceRepository) EXPECT() *MockNotificationPrefere
 	return m.recorder
 }
 
+// FindDisabledUserIDs mocks base method.
+func (m *MockNotificationPreferenceRepository) FindDisabledUserIDs(ctx context.Context, userIDs []uuid.UUID, notifType entity.NotificationType, channel string) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDisabledUserIDs", ctx, userIDs, notifType, channel)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDisabledUserIDs indicates an expected call of FindDisabledUserIDs.
+func (mr *MockNotificationPreferenceRepositoryMockRecorder) FindDisabledUserIDs(ctx, userIDs, notifType, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDisabledUserIDs", reflect.TypeOf((*MockNotificationPreferenceRepository)(nil).FindDisabledUserIDs), ctx, userIDs, notifType, channel)
+}
+
 // GetByUserID mocks base method.
 func (m *MockNotificationPreferenceRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.NotificationPreference, error) {
 	m.ctrl.T.Helper()
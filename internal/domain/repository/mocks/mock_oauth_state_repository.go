@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oauth_state_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=oauth_state_repository.go -destination=mocks/mock_oauth_state_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	repository "github.com/aiagent/internal/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOAuthStateRepository is a mock of OAuthStateRepository interface.
+type MockOAuthStateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOAuthStateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOAuthStateRepositoryMockRecorder is the mock recorder for MockOAuthStateRepository.
+type MockOAuthStateRepositoryMockRecorder struct {
+	mock *MockOAuthStateRepository
+}
+
+// NewMockOAuthStateRepository creates a new mock instance.
+func NewMockOAuthStateRepository(ctrl *gomock.Controller) *MockOAuthStateRepository {
+	mock := &MockOAuthStateRepository{ctrl: ctrl}
+	mock.recorder = &MockOAuthStateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthStateRepository) EXPECT() *MockOAuthStateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockOAuthStateRepository) Consume(ctx context.Context, state string) (*repository.OAuthState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, state)
+	ret0, _ := ret[0].(*repository.OAuthState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockOAuthStateRepositoryMockRecorder) Consume(ctx, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockOAuthStateRepository)(nil).Consume), ctx, state)
+}
+
+// Save mocks base method.
+func (m *MockOAuthStateRepository) Save(ctx context.Context, state string, data *repository.OAuthState, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, state, data, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockOAuthStateRepositoryMockRecorder) Save(ctx, state, data, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOAuthStateRepository)(nil).Save), ctx, state, data, ttl)
+}
@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_reconciliation_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payment_reconciliation_repository.go -destination=mocks/mock_payment_reconciliation_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentReconciliationRepository is a mock of PaymentReconciliationRepository interface.
+type MockPaymentReconciliationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentReconciliationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentReconciliationRepositoryMockRecorder is the mock recorder for MockPaymentReconciliationRepository.
+type MockPaymentReconciliationRepositoryMockRecorder struct {
+	mock *MockPaymentReconciliationRepository
+}
+
+// NewMockPaymentReconciliationRepository creates a new mock instance.
+func NewMockPaymentReconciliationRepository(ctrl *gomock.Controller) *MockPaymentReconciliationRepository {
+	mock := &MockPaymentReconciliationRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentReconciliationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentReconciliationRepository) EXPECT() *MockPaymentReconciliationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPaymentReconciliationRepository) Create(ctx context.Context, rec *entity.PaymentReconciliation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentReconciliationRepositoryMockRecorder) Create(ctx, rec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentReconciliationRepository)(nil).Create), ctx, rec)
+}
+
+// FindAll mocks base method.
+func (m *MockPaymentReconciliationRepository) FindAll(ctx context.Context, filter repository.PaymentReconciliationFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.PaymentReconciliation], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.PaymentReconciliation])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPaymentReconciliationRepositoryMockRecorder) FindAll(ctx, filter, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPaymentReconciliationRepository)(nil).FindAll), ctx, filter, pagination)
+}
+
+// FindByID mocks base method.
+func (m *MockPaymentReconciliationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.PaymentReconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.PaymentReconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPaymentReconciliationRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPaymentReconciliationRepository)(nil).FindByID), ctx, id)
+}
+
+// FindBySePayID mocks base method.
+func (m *MockPaymentReconciliationRepository) FindBySePayID(ctx context.Context, sePayID string) (*entity.PaymentReconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySePayID", ctx, sePayID)
+	ret0, _ := ret[0].(*entity.PaymentReconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySePayID indicates an expected call of FindBySePayID.
+func (mr *MockPaymentReconciliationRepositoryMockRecorder) FindBySePayID(ctx, sePayID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySePayID", reflect.TypeOf((*MockPaymentReconciliationRepository)(nil).FindBySePayID), ctx, sePayID)
+}
+
+// FindCreatedBetween mocks base method.
+func (m *MockPaymentReconciliationRepository) FindCreatedBetween(ctx context.Context, from, to time.Time) ([]entity.PaymentReconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCreatedBetween", ctx, from, to)
+	ret0, _ := ret[0].([]entity.PaymentReconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCreatedBetween indicates an expected call of FindCreatedBetween.
+func (mr *MockPaymentReconciliationRepositoryMockRecorder) FindCreatedBetween(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCreatedBetween", reflect.TypeOf((*MockPaymentReconciliationRepository)(nil).FindCreatedBetween), ctx, from, to)
+}
+
+// Resolve mocks base method.
+func (m *MockPaymentReconciliationRepository) Resolve(ctx context.Context, id uuid.UUID, status entity.ReconciliationStatus, resolvedBy uuid.UUID, note *string, resolvedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, id, status, resolvedBy, note, resolvedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockPaymentReconciliationRepositoryMockRecorder) Resolve(ctx, id, status, resolvedBy, note, resolvedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockPaymentReconciliationRepository)(nil).Resolve), ctx, id, status, resolvedBy, note, resolvedAt)
+}
+
+// WithTx mocks base method.
+func (m *MockPaymentReconciliationRepository) WithTx(tx any) repository.PaymentReconciliationRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.PaymentReconciliationRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockPaymentReconciliationRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockPaymentReconciliationRepository)(nil).WithTx), tx)
+}
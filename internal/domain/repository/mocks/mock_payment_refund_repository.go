@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_refund_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payment_refund_repository.go -destination=mocks/mock_payment_refund_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentRefundRepository is a mock of PaymentRefundRepository interface.
+type MockPaymentRefundRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRefundRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentRefundRepositoryMockRecorder is the mock recorder for MockPaymentRefundRepository.
+type MockPaymentRefundRepositoryMockRecorder struct {
+	mock *MockPaymentRefundRepository
+}
+
+// NewMockPaymentRefundRepository creates a new mock instance.
+func NewMockPaymentRefundRepository(ctrl *gomock.Controller) *MockPaymentRefundRepository {
+	mock := &MockPaymentRefundRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentRefundRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRefundRepository) EXPECT() *MockPaymentRefundRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockPaymentRefundRepository) Complete(ctx context.Context, id uuid.UUID, providerRef *string, benefitRevoked bool) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, id, providerRef, benefitRevoked)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockPaymentRefundRepositoryMockRecorder) Complete(ctx, id, providerRef, benefitRevoked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockPaymentRefundRepository)(nil).Complete), ctx, id, providerRef, benefitRevoked)
+}
+
+// Create mocks base method.
+func (m *MockPaymentRefundRepository) Create(ctx context.Context, refund *entity.PaymentRefund) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, refund)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentRefundRepositoryMockRecorder) Create(ctx, refund any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentRefundRepository)(nil).Create), ctx, refund)
+}
+
+// CreatePending mocks base method.
+func (m *MockPaymentRefundRepository) CreatePending(ctx context.Context, refund *entity.PaymentRefund) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePending", ctx, refund)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePending indicates an expected call of CreatePending.
+func (mr *MockPaymentRefundRepositoryMockRecorder) CreatePending(ctx, refund any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePending", reflect.TypeOf((*MockPaymentRefundRepository)(nil).CreatePending), ctx, refund)
+}
+
+// Fail mocks base method.
+func (m *MockPaymentRefundRepository) Fail(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fail", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fail indicates an expected call of Fail.
+func (mr *MockPaymentRefundRepositoryMockRecorder) Fail(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fail", reflect.TypeOf((*MockPaymentRefundRepository)(nil).Fail), ctx, id)
+}
+
+// FindByTransactionID mocks base method.
+func (m *MockPaymentRefundRepository) FindByTransactionID(ctx context.Context, transactionID uuid.UUID) ([]entity.PaymentRefund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].([]entity.PaymentRefund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTransactionID indicates an expected call of FindByTransactionID.
+func (mr *MockPaymentRefundRepositoryMockRecorder) FindByTransactionID(ctx, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTransactionID", reflect.TypeOf((*MockPaymentRefundRepository)(nil).FindByTransactionID), ctx, transactionID)
+}
+
+// FindPending mocks base method.
+func (m *MockPaymentRefundRepository) FindPending(ctx context.Context, createdBefore time.Time, limit int) ([]entity.PaymentRefund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx, createdBefore, limit)
+	ret0, _ := ret[0].([]entity.PaymentRefund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockPaymentRefundRepositoryMockRecorder) FindPending(ctx, createdBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockPaymentRefundRepository)(nil).FindPending), ctx, createdBefore, limit)
+}
+
+// WithTx mocks base method.
+func (m *MockPaymentRefundRepository) WithTx(tx any) repository.PaymentRefundRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.PaymentRefundRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockPaymentRefundRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockPaymentRefundRepository)(nil).WithTx), tx)
+}
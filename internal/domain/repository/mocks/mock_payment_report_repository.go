@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_report_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payment_report_repository.go -destination=mocks/mock_payment_report_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentReportRepository is a mock of PaymentReportRepository interface.
+type MockPaymentReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentReportRepositoryMockRecorder is the mock recorder for MockPaymentReportRepository.
+type MockPaymentReportRepositoryMockRecorder struct {
+	mock *MockPaymentReportRepository
+}
+
+// NewMockPaymentReportRepository creates a new mock instance.
+func NewMockPaymentReportRepository(ctrl *gomock.Controller) *MockPaymentReportRepository {
+	mock := &MockPaymentReportRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentReportRepository) EXPECT() *MockPaymentReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPaymentReportRepository) Create(ctx context.Context, report *entity.PaymentReport) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, report)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentReportRepositoryMockRecorder) Create(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentReportRepository)(nil).Create), ctx, report)
+}
+
+// FindAll mocks base method.
+func (m *MockPaymentReportRepository) FindAll(ctx context.Context, pagination repository.Pagination) (*repository.PaginatedResult[entity.PaymentReport], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.PaymentReport])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPaymentReportRepositoryMockRecorder) FindAll(ctx, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPaymentReportRepository)(nil).FindAll), ctx, pagination)
+}
+
+// FindByDay mocks base method.
+func (m *MockPaymentReportRepository) FindByDay(ctx context.Context, day string) (*entity.PaymentReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDay", ctx, day)
+	ret0, _ := ret[0].(*entity.PaymentReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByDay indicates an expected call of FindByDay.
+func (mr *MockPaymentReportRepositoryMockRecorder) FindByDay(ctx, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDay", reflect.TypeOf((*MockPaymentReportRepository)(nil).FindByDay), ctx, day)
+}
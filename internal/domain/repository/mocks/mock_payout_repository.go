@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payout_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payout_repository.go -destination=mocks/mock_payout_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPayoutRepository is a mock of PayoutRepository interface.
+type MockPayoutRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPayoutRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPayoutRepositoryMockRecorder is the mock recorder for MockPayoutRepository.
+type MockPayoutRepositoryMockRecorder struct {
+	mock *MockPayoutRepository
+}
+
+// NewMockPayoutRepository creates a new mock instance.
+func NewMockPayoutRepository(ctrl *gomock.Controller) *MockPayoutRepository {
+	mock := &MockPayoutRepository{ctrl: ctrl}
+	mock.recorder = &MockPayoutRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPayoutRepository) EXPECT() *MockPayoutRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePending mocks base method.
+func (m *MockPayoutRepository) CreatePending(ctx context.Context, payout *entity.Payout) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePending", ctx, payout)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePending indicates an expected call of CreatePending.
+func (mr *MockPayoutRepositoryMockRecorder) CreatePending(ctx, payout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePending", reflect.TypeOf((*MockPayoutRepository)(nil).CreatePending), ctx, payout)
+}
+
+// FindAll mocks base method.
+func (m *MockPayoutRepository) FindAll(ctx context.Context, filter repository.PayoutFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.Payout], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.Payout])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPayoutRepositoryMockRecorder) FindAll(ctx, filter, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPayoutRepository)(nil).FindAll), ctx, filter, pagination)
+}
+
+// FindByID mocks base method.
+func (m *MockPayoutRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPayoutRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPayoutRepository)(nil).FindByID), ctx, id)
+}
+
+// FindPendingByAuthor mocks base method.
+func (m *MockPayoutRepository) FindPendingByAuthor(ctx context.Context, authorID uuid.UUID) (*entity.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingByAuthor", ctx, authorID)
+	ret0, _ := ret[0].(*entity.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingByAuthor indicates an expected call of FindPendingByAuthor.
+func (mr *MockPayoutRepositoryMockRecorder) FindPendingByAuthor(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingByAuthor", reflect.TypeOf((*MockPayoutRepository)(nil).FindPendingByAuthor), ctx, authorID)
+}
+
+// Review mocks base method.
+func (m *MockPayoutRepository) Review(ctx context.Context, id uuid.UUID, status entity.PayoutStatus, reviewedBy uuid.UUID, note, reference *string, reviewedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Review", ctx, id, status, reviewedBy, note, reference, reviewedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Review indicates an expected call of Review.
+func (mr *MockPayoutRepositoryMockRecorder) Review(ctx, id, status, reviewedBy, note, reference, reviewedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Review", reflect.TypeOf((*MockPayoutRepository)(nil).Review), ctx, id, status, reviewedBy, note, reference, reviewedAt)
+}
+
+// WithTx mocks base method.
+func (m *MockPayoutRepository) WithTx(tx any) repository.PayoutRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.PayoutRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockPayoutRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockPayoutRepository)(nil).WithTx), tx)
+}
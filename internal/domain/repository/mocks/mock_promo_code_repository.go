@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: promo_code_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=promo_code_repository.go -destination=mocks/mock_promo_code_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPromoCodeRepository is a mock of PromoCodeRepository interface.
+type MockPromoCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoCodeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPromoCodeRepositoryMockRecorder is the mock recorder for MockPromoCodeRepository.
+type MockPromoCodeRepositoryMockRecorder struct {
+	mock *MockPromoCodeRepository
+}
+
+// NewMockPromoCodeRepository creates a new mock instance.
+func NewMockPromoCodeRepository(ctrl *gomock.Controller) *MockPromoCodeRepository {
+	mock := &MockPromoCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockPromoCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoCodeRepository) EXPECT() *MockPromoCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPromoCodeRepository) Create(ctx context.Context, promo *entity.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, promo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromoCodeRepositoryMockRecorder) Create(ctx, promo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromoCodeRepository)(nil).Create), ctx, promo)
+}
+
+// Deactivate mocks base method.
+func (m *MockPromoCodeRepository) Deactivate(ctx context.Context, id, authorID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, id, authorID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockPromoCodeRepositoryMockRecorder) Deactivate(ctx, id, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockPromoCodeRepository)(nil).Deactivate), ctx, id, authorID)
+}
+
+// FindByAuthor mocks base method.
+func (m *MockPromoCodeRepository) FindByAuthor(ctx context.Context, authorID uuid.UUID) ([]entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAuthor", ctx, authorID)
+	ret0, _ := ret[0].([]entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAuthor indicates an expected call of FindByAuthor.
+func (mr *MockPromoCodeRepositoryMockRecorder) FindByAuthor(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAuthor", reflect.TypeOf((*MockPromoCodeRepository)(nil).FindByAuthor), ctx, authorID)
+}
+
+// FindByAuthorAndCode mocks base method.
+func (m *MockPromoCodeRepository) FindByAuthorAndCode(ctx context.Context, authorID uuid.UUID, code string) (*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAuthorAndCode", ctx, authorID, code)
+	ret0, _ := ret[0].(*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAuthorAndCode indicates an expected call of FindByAuthorAndCode.
+func (mr *MockPromoCodeRepositoryMockRecorder) FindByAuthorAndCode(ctx, authorID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAuthorAndCode", reflect.TypeOf((*MockPromoCodeRepository)(nil).FindByAuthorAndCode), ctx, authorID, code)
+}
+
+// IncrementRedemptions mocks base method.
+func (m *MockPromoCodeRepository) IncrementRedemptions(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementRedemptions", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementRedemptions indicates an expected call of IncrementRedemptions.
+func (mr *MockPromoCodeRepositoryMockRecorder) IncrementRedemptions(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementRedemptions", reflect.TypeOf((*MockPromoCodeRepository)(nil).IncrementRedemptions), ctx, id)
+}
+
+// ReleaseRedemption mocks base method.
+func (m *MockPromoCodeRepository) ReleaseRedemption(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRedemption", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseRedemption indicates an expected call of ReleaseRedemption.
+func (mr *MockPromoCodeRepositoryMockRecorder) ReleaseRedemption(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRedemption", reflect.TypeOf((*MockPromoCodeRepository)(nil).ReleaseRedemption), ctx, id)
+}
+
+// ReserveRedemption mocks base method.
+func (m *MockPromoCodeRepository) ReserveRedemption(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveRedemption", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveRedemption indicates an expected call of ReserveRedemption.
+func (mr *MockPromoCodeRepositoryMockRecorder) ReserveRedemption(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveRedemption", reflect.TypeOf((*MockPromoCodeRepository)(nil).ReserveRedemption), ctx, id)
+}
+
+// WithTx mocks base method.
+func (m *MockPromoCodeRepository) WithTx(tx any) repository.PromoCodeRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.PromoCodeRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockPromoCodeRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockPromoCodeRepository)(nil).WithTx), tx)
+}
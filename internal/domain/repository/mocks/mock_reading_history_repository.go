@@ -42,6 +42,21 @@ func (m *MockReadingHistoryRepository) EXPECT() *MockReadingHistoryRepositoryMoc
 	return m.recorder
 }
 
+// GetByBlogIDs mocks base method.
+func (m *MockReadingHistoryRepository) GetByBlogIDs(ctx context.Context, userID uuid.UUID, blogIDs []uuid.UUID) ([]*entity.UserReadingHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByBlogIDs", ctx, userID, blogIDs)
+	ret0, _ := ret[0].([]*entity.UserReadingHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByBlogIDs indicates an expected call of GetByBlogIDs.
+func (mr *MockReadingHistoryRepositoryMockRecorder) GetByBlogIDs(ctx, userID, blogIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByBlogIDs", reflect.TypeOf((*MockReadingHistoryRepository)(nil).GetByBlogIDs), ctx, userID, blogIDs)
+}
+
 // GetRecentByUserID mocks base method.
 func (m *MockReadingHistoryRepository) GetRecentByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.UserReadingHistory, error) {
 	m.ctrl.T.Helper()
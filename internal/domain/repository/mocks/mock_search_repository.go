@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: search_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=search_repository.go -destination=mocks/mock_search_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repository "github.com/aiagent/internal/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSearchRepository is a mock of SearchRepository interface.
+type MockSearchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchRepositoryMockRecorder is the mock recorder for MockSearchRepository.
+type MockSearchRepositoryMockRecorder struct {
+	mock *MockSearchRepository
+}
+
+// NewMockSearchRepository creates a new mock instance.
+func NewMockSearchRepository(ctrl *gomock.Controller) *MockSearchRepository {
+	mock := &MockSearchRepository{ctrl: ctrl}
+	mock.recorder = &MockSearchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchRepository) EXPECT() *MockSearchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BlogFacets mocks base method.
+func (m *MockSearchRepository) BlogFacets(ctx context.Context, filter repository.BlogSearchFilter, limit int) (*repository.BlogSearchFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlogFacets", ctx, filter, limit)
+	ret0, _ := ret[0].(*repository.BlogSearchFacets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlogFacets indicates an expected call of BlogFacets.
+func (mr *MockSearchRepositoryMockRecorder) BlogFacets(ctx, filter, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlogFacets", reflect.TypeOf((*MockSearchRepository)(nil).BlogFacets), ctx, filter, limit)
+}
+
+// SearchAuthors mocks base method.
+func (m *MockSearchRepository) SearchAuthors(ctx context.Context, query string, limit int) ([]repository.SearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAuthors", ctx, query, limit)
+	ret0, _ := ret[0].([]repository.SearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAuthors indicates an expected call of SearchAuthors.
+func (mr *MockSearchRepositoryMockRecorder) SearchAuthors(ctx, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAuthors", reflect.TypeOf((*MockSearchRepository)(nil).SearchAuthors), ctx, query, limit)
+}
+
+// SearchBlogs mocks base method.
+func (m *MockSearchRepository) SearchBlogs(ctx context.Context, filter repository.BlogSearchFilter, pagination repository.Pagination) (*repository.PaginatedResult[repository.BlogSearchHit], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchBlogs", ctx, filter, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[repository.BlogSearchHit])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchBlogs indicates an expected call of SearchBlogs.
+func (mr *MockSearchRepositoryMockRecorder) SearchBlogs(ctx, filter, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBlogs", reflect.TypeOf((*MockSearchRepository)(nil).SearchBlogs), ctx, filter, pagination)
+}
+
+// SearchSeries mocks base method.
+func (m *MockSearchRepository) SearchSeries(ctx context.Context, query string, limit int) ([]repository.SearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSeries", ctx, query, limit)
+	ret0, _ := ret[0].([]repository.SearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSeries indicates an expected call of SearchSeries.
+func (mr *MockSearchRepositoryMockRecorder) SearchSeries(ctx, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSeries", reflect.TypeOf((*MockSearchRepository)(nil).SearchSeries), ctx, query, limit)
+}
+
+// SearchTags mocks base method.
+func (m *MockSearchRepository) SearchTags(ctx context.Context, query string, limit int) ([]repository.SearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTags", ctx, query, limit)
+	ret0, _ := ret[0].([]repository.SearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTags indicates an expected call of SearchTags.
+func (mr *MockSearchRepositoryMockRecorder) SearchTags(ctx, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTags", reflect.TypeOf((*MockSearchRepository)(nil).SearchTags), ctx, query, limit)
+}
@@ -85,6 +85,51 @@ func (mr *MockSeriesRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSeriesRepository)(nil).Delete), ctx, id)
 }
 
+// Exists mocks base method.
+func (m *MockSeriesRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockSeriesRepositoryMockRecorder) Exists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockSeriesRepository)(nil).Exists), ctx, id)
+}
+
+// FindContaining mocks base method.
+func (m *MockSeriesRepository) FindContaining(ctx context.Context, blogID uuid.UUID) ([]entity.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindContaining", ctx, blogID)
+	ret0, _ := ret[0].([]entity.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindContaining indicates an expected call of FindContaining.
+func (mr *MockSeriesRepositoryMockRecorder) FindContaining(ctx, blogID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindContaining", reflect.TypeOf((*MockSeriesRepository)(nil).FindContaining), ctx, blogID)
+}
+
+// FindPaidContaining mocks base method.
+func (m *MockSeriesRepository) FindPaidContaining(ctx context.Context, blogID uuid.UUID) ([]entity.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaidContaining", ctx, blogID)
+	ret0, _ := ret[0].([]entity.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaidContaining indicates an expected call of FindPaidContaining.
+func (mr *MockSeriesRepositoryMockRecorder) FindPaidContaining(ctx, blogID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaidContaining", reflect.TypeOf((*MockSeriesRepository)(nil).FindPaidContaining), ctx, blogID)
+}
+
 // GetByID mocks base method.
 func (m *MockSeriesRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Series, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +160,21 @@ func (mr *MockSeriesRepositoryMockRecorder) GetBySlug(ctx, slug any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockSeriesRepository)(nil).GetBySlug), ctx, slug)
 }
 
+// GetChapters mocks base method.
+func (m *MockSeriesRepository) GetChapters(ctx context.Context, seriesID uuid.UUID) ([]entity.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChapters", ctx, seriesID)
+	ret0, _ := ret[0].([]entity.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChapters indicates an expected call of GetChapters.
+func (mr *MockSeriesRepositoryMockRecorder) GetChapters(ctx, seriesID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChapters", reflect.TypeOf((*MockSeriesRepository)(nil).GetChapters), ctx, seriesID)
+}
+
 // GetHighlighted mocks base method.
 func (m *MockSeriesRepository) GetHighlighted(ctx context.Context, limit int) ([]repository.HighlightedSeriesResult, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +190,21 @@ func (mr *MockSeriesRepositoryMockRecorder) GetHighlighted(ctx, limit any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighlighted", reflect.TypeOf((*MockSeriesRepository)(nil).GetHighlighted), ctx, limit)
 }
 
+// GetPreviewBlogIDs mocks base method.
+func (m *MockSeriesRepository) GetPreviewBlogIDs(ctx context.Context, seriesID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviewBlogIDs", ctx, seriesID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviewBlogIDs indicates an expected call of GetPreviewBlogIDs.
+func (mr *MockSeriesRepositoryMockRecorder) GetPreviewBlogIDs(ctx, seriesID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviewBlogIDs", reflect.TypeOf((*MockSeriesRepository)(nil).GetPreviewBlogIDs), ctx, seriesID)
+}
+
 // List mocks base method.
 func (m *MockSeriesRepository) List(ctx context.Context, params map[string]any) ([]entity.Series, int64, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +235,35 @@ func (mr *MockSeriesRepositoryMockRecorder) RemoveBlog(ctx, seriesID, blogID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlog", reflect.TypeOf((*MockSeriesRepository)(nil).RemoveBlog), ctx, seriesID, blogID)
 }
 
+// ReorderBlogs mocks base method.
+func (m *MockSeriesRepository) ReorderBlogs(ctx context.Context, seriesID uuid.UUID, blogIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderBlogs", ctx, seriesID, blogIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderBlogs indicates an expected call of ReorderBlogs.
+func (mr *MockSeriesRepositoryMockRecorder) ReorderBlogs(ctx, seriesID, blogIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderBlogs", reflect.TypeOf((*MockSeriesRepository)(nil).ReorderBlogs), ctx, seriesID, blogIDs)
+}
+
+// SetBlogPreview mocks base method.
+func (m *MockSeriesRepository) SetBlogPreview(ctx context.Context, seriesID, blogID uuid.UUID, preview bool) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlogPreview", ctx, seriesID, blogID, preview)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBlogPreview indicates an expected call of SetBlogPreview.
+func (mr *MockSeriesRepositoryMockRecorder) SetBlogPreview(ctx, seriesID, blogID, preview any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlogPreview", reflect.TypeOf((*MockSeriesRepository)(nil).SetBlogPreview), ctx, seriesID, blogID, preview)
+}
+
 // Update mocks base method.
 func (m *MockSeriesRepository) Update(ctx context.Context, series *entity.Series) error {
 	m.ctrl.T.Helper()
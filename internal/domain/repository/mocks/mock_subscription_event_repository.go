@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: subscription_event_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=subscription_event_repository.go -destination=mocks/mock_subscription_event_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSubscriptionEventRepository is a mock of SubscriptionEventRepository interface.
+type MockSubscriptionEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionEventRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSubscriptionEventRepositoryMockRecorder is the mock recorder for MockSubscriptionEventRepository.
+type MockSubscriptionEventRepositoryMockRecorder struct {
+	mock *MockSubscriptionEventRepository
+}
+
+// NewMockSubscriptionEventRepository creates a new mock instance.
+func NewMockSubscriptionEventRepository(ctrl *gomock.Controller) *MockSubscriptionEventRepository {
+	mock := &MockSubscriptionEventRepository{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionEventRepository) EXPECT() *MockSubscriptionEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSubscriptionEventRepository) Create(ctx context.Context, event *entity.SubscriptionEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSubscriptionEventRepositoryMockRecorder) Create(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSubscriptionEventRepository)(nil).Create), ctx, event)
+}
+
+// FindPaidByTransactionID mocks base method.
+func (m *MockSubscriptionEventRepository) FindPaidByTransactionID(ctx context.Context, transactionID uuid.UUID) (*entity.SubscriptionEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaidByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].(*entity.SubscriptionEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaidByTransactionID indicates an expected call of FindPaidByTransactionID.
+func (mr *MockSubscriptionEventRepositoryMockRecorder) FindPaidByTransactionID(ctx, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaidByTransactionID", reflect.TypeOf((*MockSubscriptionEventRepository)(nil).FindPaidByTransactionID), ctx, transactionID)
+}
+
+// WithTx mocks base method.
+func (m *MockSubscriptionEventRepository) WithTx(tx any) repository.SubscriptionEventRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.SubscriptionEventRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockSubscriptionEventRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockSubscriptionEventRepository)(nil).WithTx), tx)
+}
@@ -44,6 +44,36 @@ func (m *MockSubscriptionRepository) EXPECT() *MockSubscriptionRepositoryMockRec
 	return m.recorder
 }
 
+// ApplyScheduledChange mocks base method.
+func (m *MockSubscriptionRepository) ApplyScheduledChange(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyScheduledChange", ctx, id, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyScheduledChange indicates an expected call of ApplyScheduledChange.
+func (mr *MockSubscriptionRepositoryMockRecorder) ApplyScheduledChange(ctx, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyScheduledChange", reflect.TypeOf((*MockSubscriptionRepository)(nil).ApplyScheduledChange), ctx, id, now)
+}
+
+// CancelScheduledChange mocks base method.
+func (m *MockSubscriptionRepository) CancelScheduledChange(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledChange", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduledChange indicates an expected call of CancelScheduledChange.
+func (mr *MockSubscriptionRepositoryMockRecorder) CancelScheduledChange(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledChange", reflect.TypeOf((*MockSubscriptionRepository)(nil).CancelScheduledChange), ctx, id)
+}
+
 // CountBySubscriber mocks base method.
 func (m *MockSubscriptionRepository) CountBySubscriber(ctx context.Context, subscriberID uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +132,21 @@ func (mr *MockSubscriptionRepositoryMockRecorder) Delete(ctx, subscriberID, auth
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSubscriptionRepository)(nil).Delete), ctx, subscriberID, authorID)
 }
 
+// Downgrade mocks base method.
+func (m *MockSubscriptionRepository) Downgrade(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Downgrade", ctx, id, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Downgrade indicates an expected call of Downgrade.
+func (mr *MockSubscriptionRepositoryMockRecorder) Downgrade(ctx, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Downgrade", reflect.TypeOf((*MockSubscriptionRepository)(nil).Downgrade), ctx, id, now)
+}
+
 // Exists mocks base method.
 func (m *MockSubscriptionRepository) Exists(ctx context.Context, subscriberID, authorID uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -162,6 +207,155 @@ func (mr *MockSubscriptionRepositoryMockRecorder) FindBySubscriber(ctx, subscrib
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySubscriber", reflect.TypeOf((*MockSubscriptionRepository)(nil).FindBySubscriber), ctx, subscriberID, pagination)
 }
 
+// FindBySubscriberAndAuthor mocks base method.
+func (m *MockSubscriptionRepository) FindBySubscriberAndAuthor(ctx context.Context, subscriberID, authorID uuid.UUID) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySubscriberAndAuthor", ctx, subscriberID, authorID)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySubscriberAndAuthor indicates an expected call of FindBySubscriberAndAuthor.
+func (mr *MockSubscriptionRepositoryMockRecorder) FindBySubscriberAndAuthor(ctx, subscriberID, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySubscriberAndAuthor", reflect.TypeOf((*MockSubscriptionRepository)(nil).FindBySubscriberAndAuthor), ctx, subscriberID, authorID)
+}
+
+// FindExpiringSoon mocks base method.
+func (m *MockSubscriptionRepository) FindExpiringSoon(ctx context.Context, now, before time.Time, limit int) ([]entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiringSoon", ctx, now, before, limit)
+	ret0, _ := ret[0].([]entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiringSoon indicates an expected call of FindExpiringSoon.
+func (mr *MockSubscriptionRepositoryMockRecorder) FindExpiringSoon(ctx, now, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiringSoon", reflect.TypeOf((*MockSubscriptionRepository)(nil).FindExpiringSoon), ctx, now, before, limit)
+}
+
+// FindGraceEnded mocks base method.
+func (m *MockSubscriptionRepository) FindGraceEnded(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGraceEnded", ctx, now, limit)
+	ret0, _ := ret[0].([]entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGraceEnded indicates an expected call of FindGraceEnded.
+func (mr *MockSubscriptionRepositoryMockRecorder) FindGraceEnded(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGraceEnded", reflect.TypeOf((*MockSubscriptionRepository)(nil).FindGraceEnded), ctx, now, limit)
+}
+
+// FindLapsed mocks base method.
+func (m *MockSubscriptionRepository) FindLapsed(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLapsed", ctx, now, limit)
+	ret0, _ := ret[0].([]entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLapsed indicates an expected call of FindLapsed.
+func (mr *MockSubscriptionRepositoryMockRecorder) FindLapsed(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLapsed", reflect.TypeOf((*MockSubscriptionRepository)(nil).FindLapsed), ctx, now, limit)
+}
+
+// FindScheduledDue mocks base method.
+func (m *MockSubscriptionRepository) FindScheduledDue(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindScheduledDue", ctx, now, limit)
+	ret0, _ := ret[0].([]entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindScheduledDue indicates an expected call of FindScheduledDue.
+func (mr *MockSubscriptionRepositoryMockRecorder) FindScheduledDue(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindScheduledDue", reflect.TypeOf((*MockSubscriptionRepository)(nil).FindScheduledDue), ctx, now, limit)
+}
+
+// FindSubscriberIDs mocks base method.
+func (m *MockSubscriptionRepository) FindSubscriberIDs(ctx context.Context, authorID, afterID uuid.UUID, limit int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubscriberIDs", ctx, authorID, afterID, limit)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubscriberIDs indicates an expected call of FindSubscriberIDs.
+func (mr *MockSubscriptionRepositoryMockRecorder) FindSubscriberIDs(ctx, authorID, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubscriberIDs", reflect.TypeOf((*MockSubscriptionRepository)(nil).FindSubscriberIDs), ctx, authorID, afterID, limit)
+}
+
+// MarkReminderSent mocks base method.
+func (m *MockSubscriptionRepository) MarkReminderSent(ctx context.Context, id uuid.UUID, now, before time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReminderSent", ctx, id, now, before)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReminderSent indicates an expected call of MarkReminderSent.
+func (mr *MockSubscriptionRepositoryMockRecorder) MarkReminderSent(ctx, id, now, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReminderSent", reflect.TypeOf((*MockSubscriptionRepository)(nil).MarkReminderSent), ctx, id, now, before)
+}
+
+// RevokePeriod mocks base method.
+func (m *MockSubscriptionRepository) RevokePeriod(ctx context.Context, id uuid.UUID, tier string, expiresAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePeriod", ctx, id, tier, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokePeriod indicates an expected call of RevokePeriod.
+func (mr *MockSubscriptionRepositoryMockRecorder) RevokePeriod(ctx, id, tier, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePeriod", reflect.TypeOf((*MockSubscriptionRepository)(nil).RevokePeriod), ctx, id, tier, expiresAt)
+}
+
+// ScheduleTierChange mocks base method.
+func (m *MockSubscriptionRepository) ScheduleTierChange(ctx context.Context, id uuid.UUID, now time.Time, tier string, scheduledExpiresAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleTierChange", ctx, id, now, tier, scheduledExpiresAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleTierChange indicates an expected call of ScheduleTierChange.
+func (mr *MockSubscriptionRepositoryMockRecorder) ScheduleTierChange(ctx, id, now, tier, scheduledExpiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleTierChange", reflect.TypeOf((*MockSubscriptionRepository)(nil).ScheduleTierChange), ctx, id, now, tier, scheduledExpiresAt)
+}
+
+// StartGracePeriod mocks base method.
+func (m *MockSubscriptionRepository) StartGracePeriod(ctx context.Context, id uuid.UUID, now, graceEndsAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartGracePeriod", ctx, id, now, graceEndsAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartGracePeriod indicates an expected call of StartGracePeriod.
+func (mr *MockSubscriptionRepositoryMockRecorder) StartGracePeriod(ctx, id, now, graceEndsAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGracePeriod", reflect.TypeOf((*MockSubscriptionRepository)(nil).StartGracePeriod), ctx, id, now, graceEndsAt)
+}
+
 // UpdateExpiry mocks base method.
 func (m *MockSubscriptionRepository) UpdateExpiry(ctx context.Context, userID, authorID uuid.UUID, expiresAt time.Time, tier string) error {
 	m.ctrl.T.Helper()
@@ -12,10 +12,12 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/aiagent/internal/domain/entity"
 	repository "github.com/aiagent/internal/domain/repository"
 	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -43,6 +45,51 @@ func (m *MockTransactionRepository) EXPECT() *MockTransactionRepositoryMockRecor
 	return m.recorder
 }
 
+// ApplyRefund mocks base method.
+func (m *MockTransactionRepository) ApplyRefund(ctx context.Context, id uuid.UUID, expected, refunded decimal.Decimal, status entity.TransactionStatus) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyRefund", ctx, id, expected, refunded, status)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyRefund indicates an expected call of ApplyRefund.
+func (mr *MockTransactionRepositoryMockRecorder) ApplyRefund(ctx, id, expected, refunded, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyRefund", reflect.TypeOf((*MockTransactionRepository)(nil).ApplyRefund), ctx, id, expected, refunded, status)
+}
+
+// Claim mocks base method.
+func (m *MockTransactionRepository) Claim(ctx context.Context, tx *entity.Transaction, from entity.TransactionStatus) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, tx, from)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockTransactionRepositoryMockRecorder) Claim(ctx, tx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockTransactionRepository)(nil).Claim), ctx, tx, from)
+}
+
+// CountCreatedByStatus mocks base method.
+func (m *MockTransactionRepository) CountCreatedByStatus(ctx context.Context, from, to time.Time) (map[entity.TransactionStatus]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCreatedByStatus", ctx, from, to)
+	ret0, _ := ret[0].(map[entity.TransactionStatus]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCreatedByStatus indicates an expected call of CountCreatedByStatus.
+func (mr *MockTransactionRepositoryMockRecorder) CountCreatedByStatus(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCreatedByStatus", reflect.TypeOf((*MockTransactionRepository)(nil).CountCreatedByStatus), ctx, from, to)
+}
+
 // Create mocks base method.
 func (m *MockTransactionRepository) Create(ctx context.Context, tx *entity.Transaction) error {
 	m.ctrl.T.Helper()
@@ -57,6 +104,51 @@ func (mr *MockTransactionRepositoryMockRecorder) Create(ctx, tx any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionRepository)(nil).Create), ctx, tx)
 }
 
+// ExpirePending mocks base method.
+func (m *MockTransactionRepository) ExpirePending(ctx context.Context, ids []uuid.UUID, createdBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePending", ctx, ids, createdBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePending indicates an expected call of ExpirePending.
+func (mr *MockTransactionRepositoryMockRecorder) ExpirePending(ctx, ids, createdBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePending", reflect.TypeOf((*MockTransactionRepository)(nil).ExpirePending), ctx, ids, createdBefore)
+}
+
+// FindByID mocks base method.
+func (m *MockTransactionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTransactionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTransactionRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByProviderRef mocks base method.
+func (m *MockTransactionRepository) FindByProviderRef(ctx context.Context, provider entity.TransactionProvider, providerRef string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProviderRef", ctx, provider, providerRef)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProviderRef indicates an expected call of FindByProviderRef.
+func (mr *MockTransactionRepositoryMockRecorder) FindByProviderRef(ctx, provider, providerRef any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProviderRef", reflect.TypeOf((*MockTransactionRepository)(nil).FindByProviderRef), ctx, provider, providerRef)
+}
+
 // FindByRefID mocks base method.
 func (m *MockTransactionRepository) FindByRefID(ctx context.Context, refID string) (*entity.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +194,36 @@ func (mr *MockTransactionRepositoryMockRecorder) FindByUserID(ctx, userID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockTransactionRepository)(nil).FindByUserID), ctx, userID)
 }
 
+// FindPaidBetween mocks base method.
+func (m *MockTransactionRepository) FindPaidBetween(ctx context.Context, from, to time.Time) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaidBetween", ctx, from, to)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaidBetween indicates an expected call of FindPaidBetween.
+func (mr *MockTransactionRepositoryMockRecorder) FindPaidBetween(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaidBetween", reflect.TypeOf((*MockTransactionRepository)(nil).FindPaidBetween), ctx, from, to)
+}
+
+// FindPending mocks base method.
+func (m *MockTransactionRepository) FindPending(ctx context.Context, limit int) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx, limit)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockTransactionRepositoryMockRecorder) FindPending(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockTransactionRepository)(nil).FindPending), ctx, limit)
+}
+
 // Update mocks base method.
 func (m *MockTransactionRepository) Update(ctx context.Context, tx *entity.Transaction) error {
 	m.ctrl.T.Helper()
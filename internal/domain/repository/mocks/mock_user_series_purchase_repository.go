@@ -57,6 +57,20 @@ func (mr *MockUserSeriesPurchaseRepositoryMockRecorder) Create(ctx, purchase any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserSeriesPurchaseRepository)(nil).Create), ctx, purchase)
 }
 
+// Delete mocks base method.
+func (m *MockUserSeriesPurchaseRepository) Delete(ctx context.Context, userID, seriesID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, seriesID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserSeriesPurchaseRepositoryMockRecorder) Delete(ctx, userID, seriesID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserSeriesPurchaseRepository)(nil).Delete), ctx, userID, seriesID)
+}
+
 // GetUserPurchases mocks base method.
 func (m *MockUserSeriesPurchaseRepository) GetUserPurchases(ctx context.Context, userID uuid.UUID) ([]*entity.UserSeriesPurchase, error) {
 	m.ctrl.T.Helper()
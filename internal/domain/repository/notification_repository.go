@@ -14,6 +14,9 @@ type NotificationRepository interface {
 	// Save creates or updates a notification with upsert logic for grouping
 	Save(ctx context.Context, notification *entity.Notification) error
 
+	// CreateBatch inserts notifications in a single statement, skipping any whose ID already exists
+	CreateBatch(ctx context.Context, notifications []*entity.Notification) error
+
 	// FindByUserID retrieves notifications for a user with pagination and total count
 	FindByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.Notification, int64, error)
 
@@ -43,6 +46,9 @@ type NotificationPreferenceRepository interface {
 
 	// IsEnabled checks if a specific notification type and channel is enabled for a user
 	IsEnabled(ctx context.Context, userID uuid.UUID, notifType entity.NotificationType, channel string) (bool, error)
+
+	// FindDisabledUserIDs returns which of the given users turned off a notification type on a channel
+	FindDisabledUserIDs(ctx context.Context, userIDs []uuid.UUID, notifType entity.NotificationType, channel string) ([]uuid.UUID, error)
 }
 
 // DeviceTokenRepository defines the interface for device token operations
@@ -0,0 +1,21 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"time"
+)
+
+// OAuthState is what a social login remembers between the redirect and the callback
+type OAuthState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// OAuthStateRepository stores pending social login attempts keyed by their state parameter
+type OAuthStateRepository interface {
+	Save(ctx context.Context, state string, data *OAuthState, ttl time.Duration) error
+	// Consume returns and deletes the state so it can be used only once; nil when unknown or expired
+	Consume(ctx context.Context, state string) (*OAuthState, error)
+}
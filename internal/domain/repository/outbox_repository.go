@@ -0,0 +1,46 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// OutboxRepository defines the interface for transactional outbox operations
+type OutboxRepository interface {
+	// Create records an event; call on a WithTx repository to share the business transaction
+	Create(ctx context.Context, event *entity.OutboxEvent) error
+
+	// CreateBatch records several events in one statement; events whose ID is already stored are skipped
+	CreateBatch(ctx context.Context, events []*entity.OutboxEvent) error
+
+	// ClaimBatch leases up to limit due events to workerID for the lease duration.
+	// Events whose lease expired (crashed worker) are claimed again.
+	ClaimBatch(ctx context.Context, workerID string, lease time.Duration, limit int) ([]*entity.OutboxEvent, error)
+
+	// ExtendLease renews workerID's lease on an event for another lease duration. It returns
+	// false when the lease expired or was claimed by another worker.
+	ExtendLease(ctx context.Context, id uuid.UUID, workerID string, lease time.Duration) (bool, error)
+
+	// MarkProcessed marks a leased event as delivered
+	MarkProcessed(ctx context.Context, id uuid.UUID, workerID string) error
+
+	// MarkFailed releases a leased event for another attempt at nextAttemptAt
+	MarkFailed(ctx context.Context, id uuid.UUID, workerID string, lastError string, nextAttemptAt time.Time) error
+
+	// MarkDead moves a leased event to the dead-letter state
+	MarkDead(ctx context.Context, id uuid.UUID, workerID string, lastError string) error
+
+	// FindDeliveredHandlers returns the names of the handlers that already received an event
+	FindDeliveredHandlers(ctx context.Context, eventID uuid.UUID) ([]string, error)
+
+	// RecordDelivery records that a handler received an event; recording it again is a no-op
+	RecordDelivery(ctx context.Context, eventID uuid.UUID, handler string) error
+
+	// WithTx returns a new repository with the given transaction
+	WithTx(tx interface{}) OutboxRepository
+}
@@ -0,0 +1,36 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// PaymentReconciliationFilter defines filter options for reconciliation queries
+type PaymentReconciliationFilter struct {
+	Status *entity.ReconciliationStatus
+}
+
+// PaymentReconciliationRepository defines the interface for the queue of mismatched transfers
+type PaymentReconciliationRepository interface {
+	Create(ctx context.Context, rec *entity.PaymentReconciliation) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.PaymentReconciliation, error)
+
+	// FindBySePayID finds the reconciliation recorded for a SePay transfer (for idempotency)
+	FindBySePayID(ctx context.Context, sePayID string) (*entity.PaymentReconciliation, error)
+
+	FindAll(ctx context.Context, filter PaymentReconciliationFilter, pagination Pagination) (*PaginatedResult[entity.PaymentReconciliation], error)
+
+	// FindCreatedBetween returns the transfers flagged within [from, to), oldest first
+	FindCreatedBetween(ctx context.Context, from, to time.Time) ([]entity.PaymentReconciliation, error)
+
+	// Resolve closes an open reconciliation. It returns false when it was already resolved.
+	Resolve(ctx context.Context, id uuid.UUID, status entity.ReconciliationStatus, resolvedBy uuid.UUID, note *string, resolvedAt time.Time) (bool, error)
+
+	// WithTx returns a new repository with the given transaction
+	WithTx(tx interface{}) PaymentReconciliationRepository
+}
@@ -0,0 +1,35 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// PaymentRefundRepository defines the interface for the append-only refund history of transactions
+type PaymentRefundRepository interface {
+	Create(ctx context.Context, refund *entity.PaymentRefund) error
+
+	// CreatePending records a refund about to be sent to the provider. It returns false when the
+	// transaction already has a pending refund.
+	CreatePending(ctx context.Context, refund *entity.PaymentRefund) (bool, error)
+
+	// Complete marks a pending refund as completed. It returns false when it was not pending anymore.
+	Complete(ctx context.Context, id uuid.UUID, providerRef *string, benefitRevoked bool) (bool, error)
+
+	// Fail marks a pending refund as declined by the provider. It returns false when it was not pending anymore.
+	Fail(ctx context.Context, id uuid.UUID) (bool, error)
+
+	// FindPending returns up to limit refunds created before the given time that are still pending, oldest first
+	FindPending(ctx context.Context, createdBefore time.Time, limit int) ([]entity.PaymentRefund, error)
+
+	// FindByTransactionID returns the refunds of a transaction, oldest first
+	FindByTransactionID(ctx context.Context, transactionID uuid.UUID) ([]entity.PaymentRefund, error)
+
+	// WithTx returns a new repository with the given transaction
+	WithTx(tx interface{}) PaymentRefundRepository
+}
@@ -0,0 +1,21 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/domain/entity"
+)
+
+// PaymentReportRepository defines the interface for daily payment reconciliation reports
+type PaymentReportRepository interface {
+	// Create stores a report. It returns false when a report for the same day already exists.
+	Create(ctx context.Context, report *entity.PaymentReport) (bool, error)
+
+	// FindByDay finds the report of a day (YYYY-MM-DD)
+	FindByDay(ctx context.Context, day string) (*entity.PaymentReport, error)
+
+	// FindAll returns reports, most recent day first
+	FindAll(ctx context.Context, pagination Pagination) (*PaginatedResult[entity.PaymentReport], error)
+}
@@ -0,0 +1,37 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// PayoutFilter defines filter options for payout queries
+type PayoutFilter struct {
+	AuthorID *uuid.UUID
+	Status   *entity.PayoutStatus
+}
+
+// PayoutRepository defines the interface for author payout requests
+type PayoutRepository interface {
+	// CreatePending records a request waiting for review. It returns false when the author
+	// already has one.
+	CreatePending(ctx context.Context, payout *entity.Payout) (bool, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Payout, error)
+
+	// FindPendingByAuthor returns the request of an author waiting for review, if any
+	FindPendingByAuthor(ctx context.Context, authorID uuid.UUID) (*entity.Payout, error)
+
+	// FindAll returns payouts, most recent first
+	FindAll(ctx context.Context, filter PayoutFilter, pagination Pagination) (*PaginatedResult[entity.Payout], error)
+
+	// Review closes a pending payout. It returns false when it was already reviewed.
+	Review(ctx context.Context, id uuid.UUID, status entity.PayoutStatus, reviewedBy uuid.UUID, note, reference *string, reviewedAt time.Time) (bool, error)
+
+	// WithTx returns a new repository with the given transaction
+	WithTx(tx interface{}) PayoutRepository
+}
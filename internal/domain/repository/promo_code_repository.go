@@ -0,0 +1,38 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// PromoCodeRepository defines the interface for author promo codes
+type PromoCodeRepository interface {
+	Create(ctx context.Context, promo *entity.PromoCode) error
+
+	// FindByAuthorAndCode returns an author's code, matched on its uppercase form
+	FindByAuthorAndCode(ctx context.Context, authorID uuid.UUID, code string) (*entity.PromoCode, error)
+
+	// FindByAuthor returns all codes of an author, most recent first
+	FindByAuthor(ctx context.Context, authorID uuid.UUID) ([]entity.PromoCode, error)
+
+	// Deactivate stops an author's code from being used. It returns false when the code does not
+	// exist or belongs to another author.
+	Deactivate(ctx context.Context, id, authorID uuid.UUID) (bool, error)
+
+	// ReserveRedemption counts an order placed with the code. It returns false when the code has
+	// reached its usage cap, so concurrent orders can never take more redemptions than it allows.
+	ReserveRedemption(ctx context.Context, id uuid.UUID) (bool, error)
+
+	// ReleaseRedemption gives back the redemption of an order that failed or was never paid
+	ReleaseRedemption(ctx context.Context, id uuid.UUID) error
+
+	// IncrementRedemptions counts a paid order whose redemption had been released, even past the cap
+	IncrementRedemptions(ctx context.Context, id uuid.UUID) error
+
+	// WithTx returns a new repository with the given transaction
+	WithTx(tx interface{}) PromoCodeRepository
+}
@@ -17,4 +17,7 @@ type ReadingHistoryRepository interface {
 	// GetRecentByUserID retrieves the recently viewed blogs for a user.
 	// It returns a list of UserReadingHistory with the associated Blog preloaded.
 	GetRecentByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.UserReadingHistory, error)
+
+	// GetByBlogIDs retrieves the user's reading records for the given blogs, without preloads.
+	GetByBlogIDs(ctx context.Context, userID uuid.UUID, blogIDs []uuid.UUID) ([]*entity.UserReadingHistory, error)
 }
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+// BlogSearchFilter narrows a full-text blog search. ViewerID decides which
+// drafts, subscriber-only and tier-gated posts the searcher may see.
+type BlogSearchFilter struct {
+	Query      string
+	AuthorID   *uuid.UUID
+	CategoryID *uuid.UUID
+	TagIDs     []uuid.UUID
+	ViewerID   *uuid.UUID
+}
+
+// BlogSearchHit is a ranked blog match. Highlighted text is escaped HTML marking matches with <mark>;
+// Locked hits need a higher tier, so their snippet never quotes the content.
+type BlogSearchHit struct {
+	ID             uuid.UUID
+	AuthorID       uuid.UUID
+	AuthorName     string
+	CategoryID     *uuid.UUID
+	Title          string
+	Slug           string
+	TitleHighlight string
+	Snippet        string
+	Visibility     entity.BlogVisibility
+	PublishedAt    *time.Time
+	Rank           float64
+	Locked         bool
+}
+
+// SearchFacet counts the blog matches sharing one category, tag or author
+type SearchFacet struct {
+	ID    uuid.UUID
+	Name  string
+	Count int64
+}
+
+// BlogSearchFacets groups facet counts over all matches, not just the current page
+type BlogSearchFacets struct {
+	Categories []SearchFacet
+	Tags       []SearchFacet
+	Authors    []SearchFacet
+}
+
+// SearchHit is a ranked series, tag or author match; Snippet is escaped HTML like BlogSearchHit's
+type SearchHit struct {
+	ID      uuid.UUID
+	Title   string
+	Slug    string
+	Snippet string
+	Rank    float64
+}
+
+// SearchRepository defines full-text search across blogs, series, tags and authors
+type SearchRepository interface {
+	SearchBlogs(ctx context.Context, filter BlogSearchFilter, pagination Pagination) (*PaginatedResult[BlogSearchHit], error)
+	// BlogFacets counts the visible matches of the filter by category, tag and author
+	BlogFacets(ctx context.Context, filter BlogSearchFilter, limit int) (*BlogSearchFacets, error)
+	SearchSeries(ctx context.Context, query string, limit int) ([]SearchHit, error)
+	SearchTags(ctx context.Context, query string, limit int) ([]SearchHit, error)
+	// SearchAuthors only returns users with at least one published blog
+	SearchAuthors(ctx context.Context, query string, limit int) ([]SearchHit, error)
+}
@@ -21,9 +21,23 @@ type SeriesRepository interface {
 	Update(ctx context.Context, series *entity.Series) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Series, error)
+	// Exists reports whether a series that was not deleted has the given ID
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
 	GetBySlug(ctx context.Context, slug string) (*entity.Series, error)
 	List(ctx context.Context, params map[string]interface{}) ([]entity.Series, int64, error)
+	// AddBlog appends the blog as the last chapter of the series
 	AddBlog(ctx context.Context, seriesID, blogID uuid.UUID) error
 	RemoveBlog(ctx context.Context, seriesID, blogID uuid.UUID) error
 	GetHighlighted(ctx context.Context, limit int) ([]HighlightedSeriesResult, error)
+	// SetBlogPreview marks a chapter as a free preview; it reports false if the blog is not in the series
+	SetBlogPreview(ctx context.Context, seriesID, blogID uuid.UUID, preview bool) (bool, error)
+	GetPreviewBlogIDs(ctx context.Context, seriesID uuid.UUID) ([]uuid.UUID, error)
+	// ReorderBlogs renumbers chapters so they follow the order of blogIDs
+	ReorderBlogs(ctx context.Context, seriesID uuid.UUID, blogIDs []uuid.UUID) error
+	// GetChapters returns the series' blogs in chapter order
+	GetChapters(ctx context.Context, seriesID uuid.UUID) ([]entity.Blog, error)
+	// FindContaining returns the series in which the blog is a chapter
+	FindContaining(ctx context.Context, blogID uuid.UUID) ([]entity.Series, error)
+	// FindPaidContaining returns the paid series in which the blog is a chapter that is not a free preview
+	FindPaidContaining(ctx context.Context, blogID uuid.UUID) ([]entity.Series, error)
 }
@@ -5,11 +5,23 @@ package repository
 import (
 	"context"
 	"time"
+
+	"github.com/aiagent/internal/domain/entity"
 )
 
 // SessionRepository defines the interface for session management
 type SessionRepository interface {
-	CreateSession(ctx context.Context, sessionID string, userID string, duration time.Duration) error
+	// CreateSession stores a new session and sets its ExpiresAt
+	CreateSession(ctx context.Context, session *entity.Session) error
 	GetUserID(ctx context.Context, sessionID string) (string, error)
+	// GetSession returns the session, or nil when it does not exist or has expired
+	GetSession(ctx context.Context, sessionID string) (*entity.Session, error)
+	// TouchSession records activity and slides the expiry, never past the session's maximum lifetime.
+	// It updates LastSeenAt and ExpiresAt on the given session.
+	TouchSession(ctx context.Context, session *entity.Session, seenAt time.Time) error
+	// ListUserSessions returns the user's live sessions, most recently used first
+	ListUserSessions(ctx context.Context, userID string) ([]entity.Session, error)
 	DeleteSession(ctx context.Context, sessionID string) error
+	// DeleteUserSessions revokes every session of the user except exceptSessionID (pass "" to revoke all)
+	DeleteUserSessions(ctx context.Context, userID string, exceptSessionID string) error
 }
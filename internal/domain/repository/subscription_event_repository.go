@@ -0,0 +1,21 @@
+package repository
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// SubscriptionEventRepository defines the interface for subscription lifecycle history
+type SubscriptionEventRepository interface {
+	Create(ctx context.Context, event *entity.SubscriptionEvent) error
+
+	// FindPaidByTransactionID returns the paid transition recorded for a transaction, nil if there is none
+	FindPaidByTransactionID(ctx context.Context, transactionID uuid.UUID) (*entity.SubscriptionEvent, error)
+
+	// WithTx returns a new repository with the given transaction
+	WithTx(tx interface{}) SubscriptionEventRepository
+}
@@ -19,9 +19,59 @@ type SubscriptionRepository interface {
 	FindByAuthor(ctx context.Context, authorID uuid.UUID, pagination Pagination) (*PaginatedResult[entity.Subscription], error)
 	CountSubscribers(ctx context.Context, authorID uuid.UUID) (int64, error)
 	CountBySubscriber(ctx context.Context, subscriberID uuid.UUID) (int64, error)
+	FindBySubscriberAndAuthor(ctx context.Context, subscriberID, authorID uuid.UUID) (*entity.Subscription, error)
+
+	// UpdateExpiry starts a new paid period and clears the grace period, expiry reminder and scheduled change of the previous one
 	UpdateExpiry(ctx context.Context, userID, authorID uuid.UUID, expiresAt time.Time, tier string) error
+
+	// FindActiveSubscription returns the subscription if it is a free follow, paid and not expired, in its grace period
+	// or waiting for a paid scheduled downgrade
 	FindActiveSubscription(ctx context.Context, userID, authorID uuid.UUID) (*entity.Subscription, error)
 
+	// FindExpiringSoon returns paid subscriptions expiring between now and before that were not reminded yet
+	// and have no scheduled change
+	FindExpiringSoon(ctx context.Context, now, before time.Time, limit int) ([]entity.Subscription, error)
+
+	// FindLapsed returns paid subscriptions that expired before now and have neither a grace period nor a scheduled change
+	FindLapsed(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error)
+
+	// FindScheduledDue returns subscriptions whose scheduled change is due because their period ended before now
+	FindScheduledDue(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error)
+
+	// FindGraceEnded returns paid subscriptions whose grace period ended before now
+	FindGraceEnded(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error)
+
+	// The lifecycle transitions below only apply while the subscription is still in the expected
+	// state, so a renewal that lands concurrently wins. They report whether the row was updated.
+
+	// MarkReminderSent records the expiry reminder of a subscription still expiring between now and before
+	MarkReminderSent(ctx context.Context, id uuid.UUID, now, before time.Time) (bool, error)
+
+	// StartGracePeriod sets the grace period end of a subscription that lapsed before now
+	StartGracePeriod(ctx context.Context, id uuid.UUID, now, graceEndsAt time.Time) (bool, error)
+
+	// Downgrade turns a subscription whose grace period ended before now back into a free follow
+	Downgrade(ctx context.Context, id uuid.UUID, now time.Time) (bool, error)
+
+	// ScheduleTierChange records a paid downgrade that starts when the current period ends.
+	// It only applies to a subscription that has not expired and has no other change scheduled.
+	ScheduleTierChange(ctx context.Context, id uuid.UUID, now time.Time, tier string, scheduledExpiresAt time.Time) (bool, error)
+
+	// ApplyScheduledChange switches a subscription whose period ended before now to its scheduled tier and expiry
+	ApplyScheduledChange(ctx context.Context, id uuid.UUID, now time.Time) (bool, error)
+
+	// CancelScheduledChange drops a downgrade that has not taken effect yet
+	CancelScheduledChange(ctx context.Context, id uuid.UUID) (bool, error)
+
+	// RevokePeriod sets the tier and expiry a subscription falls back to after a paid period was
+	// refunded, clearing its grace period, expiry reminder and scheduled change. A nil expiresAt
+	// turns it back into a free follow.
+	RevokePeriod(ctx context.Context, id uuid.UUID, tier string, expiresAt *time.Time) error
+
+	// FindSubscriberIDs returns up to limit subscriber IDs of an author ordered by ID,
+	// starting after afterID (uuid.Nil for the first batch). Used for keyset fan-out.
+	FindSubscriberIDs(ctx context.Context, authorID, afterID uuid.UUID, limit int) ([]uuid.UUID, error)
+
 	// WithTx returns a new repository with the given transaction
 	WithTx(tx interface{}) SubscriptionRepository
 }
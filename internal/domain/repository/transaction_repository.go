@@ -4,9 +4,11 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionRepository defines the interface for transaction data operations
@@ -14,18 +16,45 @@ type TransactionRepository interface {
 	// Create creates a new transaction
 	Create(ctx context.Context, tx *entity.Transaction) error
 
+	// FindByID finds a transaction by ID
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error)
+
 	// FindByRefID finds a transaction by reference code
 	FindByRefID(ctx context.Context, refID string) (*entity.Transaction, error)
 
 	// FindBySePayID finds a transaction by SePay ID (for idempotency)
 	FindBySePayID(ctx context.Context, sePayID string) (*entity.Transaction, error)
 
+	// FindByProviderRef finds a transaction by the ID of its payment at a provider
+	FindByProviderRef(ctx context.Context, provider entity.TransactionProvider, providerRef string) (*entity.Transaction, error)
+
 	// UpdateStatus updates the status of a transaction
 	UpdateStatus(ctx context.Context, id uuid.UUID, status entity.TransactionStatus) error
 
 	// Update updates a transaction
 	Update(ctx context.Context, tx *entity.Transaction) error
 
+	// Claim saves tx only while its stored status is still from. It returns false when another
+	// writer moved the transaction on first, so each status change is applied exactly once.
+	Claim(ctx context.Context, tx *entity.Transaction, from entity.TransactionStatus) (bool, error)
+
+	// ApplyRefund raises the refunded amount of a paid transaction from expected to refunded and sets
+	// its status. It returns false when another refund changed the transaction in the meantime.
+	ApplyRefund(ctx context.Context, id uuid.UUID, expected, refunded decimal.Decimal, status entity.TransactionStatus) (bool, error)
+
+	// FindPending returns up to limit unpaid orders, oldest first
+	FindPending(ctx context.Context, limit int) ([]*entity.Transaction, error)
+
+	// ExpirePending marks the given orders expired if they are still unpaid and were created before
+	// the given time, gives back the promo code redemptions they reserved and returns how many were expired
+	ExpirePending(ctx context.Context, ids []uuid.UUID, createdBefore time.Time) (int64, error)
+
+	// FindPaidBetween returns the transactions whose transfer was applied within [from, to)
+	FindPaidBetween(ctx context.Context, from, to time.Time) ([]*entity.Transaction, error)
+
+	// CountCreatedByStatus counts the orders created within [from, to) per current status
+	CountCreatedByStatus(ctx context.Context, from, to time.Time) (map[entity.TransactionStatus]int64, error)
+
 	// FindByUserID finds all transactions for a user
 	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Transaction, error)
 
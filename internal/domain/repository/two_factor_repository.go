@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+// TwoFactorRepository defines the interface for TOTP secrets and recovery codes
+type TwoFactorRepository interface {
+	// FindByUserID returns the user's two-factor settings, or nil when never set up
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserTwoFactor, error)
+	// Save creates or replaces the user's two-factor settings
+	Save(ctx context.Context, twoFactor *entity.UserTwoFactor) error
+	// UpdateSecret replaces only the stored secret, leaving the enrollment and used steps untouched
+	UpdateSecret(ctx context.Context, userID uuid.UUID, secret string) error
+	// Delete removes the settings and all recovery codes of the user
+	Delete(ctx context.Context, userID uuid.UUID) error
+	// AdvanceStep records a used TOTP step; it reports false when that step or a later one was already used
+	AdvanceStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	// ReplaceRecoveryCodes discards the user's recovery codes and stores the given hashes
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+	// UseRecoveryCode marks an unused code as used; it reports false when no such unused code exists
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+}
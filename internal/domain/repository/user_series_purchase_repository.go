@@ -15,6 +15,9 @@ type UserSeriesPurchaseRepository interface {
 	HasPurchased(ctx context.Context, userID, seriesID uuid.UUID) (bool, error)
 	GetUserPurchases(ctx context.Context, userID uuid.UUID) ([]*entity.UserSeriesPurchase, error)
 
+	// Delete removes a purchase, e.g. after its payment was refunded
+	Delete(ctx context.Context, userID, seriesID uuid.UUID) error
+
 	// WithTx returns a new repository with the given transaction
 	WithTx(tx interface{}) UserSeriesPurchaseRepository
 }
@@ -0,0 +1,169 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/pkg/logger"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAPIToken       = errors.New("invalid or expired API token")
+	ErrAPITokenNotFound      = errors.New("API token not found")
+	ErrInvalidAPITokenScope  = errors.New("invalid API token scope")
+	ErrInvalidAPITokenExpiry = errors.New("invalid API token expiry")
+	ErrAPITokenLimitReached  = errors.New("too many active API tokens")
+)
+
+const (
+	apiTokenPrefix        = "pat_"
+	apiTokenDisplayLength = 12 // Prefix plus the first characters of the secret
+	apiTokenDefaultTTL    = 90 * 24 * time.Hour
+	apiTokenMaxTTL        = 365 * 24 * time.Hour
+	maxActiveAPITokens    = 20
+
+	// Last-used is informational, so it is written at most this often per token
+	apiTokenLastUsedInterval = time.Minute
+)
+
+// CreatedAPIToken is a newly issued token; Secret is only available at creation
+type CreatedAPIToken struct {
+	Token  *entity.APIToken
+	Secret string
+}
+
+// APITokenService issues and checks personal access tokens
+type APITokenService interface {
+	// Create issues a token; a zero ttl uses the default lifetime
+	Create(ctx context.Context, userID uuid.UUID, name string, scopes entity.APITokenScopes, ttl time.Duration) (*CreatedAPIToken, error)
+	// Authenticate resolves a presented token and records its use
+	Authenticate(ctx context.Context, secret string) (*entity.APIToken, error)
+	List(ctx context.Context, userID uuid.UUID) ([]entity.APIToken, error)
+	Revoke(ctx context.Context, userID, tokenID uuid.UUID) error
+}
+
+type apiTokenService struct {
+	repo repository.APITokenRepository
+}
+
+// NewAPITokenService creates a new API token service
+func NewAPITokenService(repo repository.APITokenRepository) APITokenService {
+	return &apiTokenService{repo: repo}
+}
+
+func (s *apiTokenService) Create(ctx context.Context, userID uuid.UUID, name string, scopes entity.APITokenScopes, ttl time.Duration) (*CreatedAPIToken, error) {
+	if err := validateAPITokenScopes(scopes); err != nil {
+		return nil, err
+	}
+	if ttl == 0 {
+		ttl = apiTokenDefaultTTL
+	}
+	if ttl < 0 || ttl > apiTokenMaxTTL {
+		return nil, ErrInvalidAPITokenExpiry
+	}
+
+	now := time.Now()
+	active, err := s.repo.CountActiveByUser(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	if active >= maxActiveAPITokens {
+		return nil, ErrAPITokenLimitReached
+	}
+
+	random, err := randomURLToken(32)
+	if err != nil {
+		return nil, err
+	}
+	secret := apiTokenPrefix + random
+
+	token := &entity.APIToken{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Name:        name,
+		TokenPrefix: secret[:apiTokenDisplayLength],
+		TokenHash:   hashAPIToken(secret),
+		Scopes:      scopes,
+		ExpiresAt:   now.Add(ttl),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.repo.Create(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return &CreatedAPIToken{Token: token, Secret: secret}, nil
+}
+
+func (s *apiTokenService) Authenticate(ctx context.Context, secret string) (*entity.APIToken, error) {
+	if !strings.HasPrefix(secret, apiTokenPrefix) {
+		return nil, ErrInvalidAPIToken
+	}
+
+	token, err := s.repo.FindByHash(ctx, hashAPIToken(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if token == nil || !token.IsActive(now) {
+		return nil, ErrInvalidAPIToken
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= apiTokenLastUsedInterval {
+		if err := s.repo.UpdateLastUsed(ctx, token.ID, now); err != nil {
+			logger.Error("failed to record API token use", err, map[string]interface{}{"token_id": token.ID})
+		} else {
+			token.LastUsedAt = &now
+		}
+	}
+
+	return token, nil
+}
+
+func (s *apiTokenService) List(ctx context.Context, userID uuid.UUID) ([]entity.APIToken, error) {
+	return s.repo.ListByUser(ctx, userID)
+}
+
+func (s *apiTokenService) Revoke(ctx context.Context, userID, tokenID uuid.UUID) error {
+	revoked, err := s.repo.Revoke(ctx, userID, tokenID)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return ErrAPITokenNotFound
+	}
+	return nil
+}
+
+// validateAPITokenScopes requires at least one scope, each on a known resource with a valid permission mask
+func validateAPITokenScopes(scopes entity.APITokenScopes) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidAPITokenScope)
+	}
+	for resource, perm := range scopes {
+		if !entity.IsAPITokenResource(resource) {
+			return fmt.Errorf("%w: unknown resource %q", ErrInvalidAPITokenScope, resource)
+		}
+		if perm <= 0 || perm&^entity.PermissionAll != 0 {
+			return fmt.Errorf("%w: invalid permissions %d for %q", ErrInvalidAPITokenScope, perm, resource)
+		}
+	}
+	return nil
+}
+
+// hashAPIToken returns the SHA-256 hex digest under which a token is stored
+func hashAPIToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,138 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAPITokenService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAPITokenRepository(ctrl)
+	svc := service.NewAPITokenService(mockRepo)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	scopes := entity.APITokenScopes{entity.ResourceBlogs: entity.PermissionRead | entity.PermissionCreate}
+
+	t.Run("stores_only_the_hash", func(t *testing.T) {
+		var stored *entity.APIToken
+		mockRepo.EXPECT().CountActiveByUser(ctx, userID, gomock.Any()).Return(int64(0), nil)
+		mockRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, token *entity.APIToken) error {
+			stored = token
+			return nil
+		})
+
+		created, err := svc.Create(ctx, userID, "cms import", scopes, 0)
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(created.Secret, "pat_"))
+		assert.True(t, strings.HasPrefix(created.Secret, stored.TokenPrefix))
+		sum := sha256.Sum256([]byte(created.Secret))
+		assert.Equal(t, hex.EncodeToString(sum[:]), stored.TokenHash)
+		assert.WithinDuration(t, time.Now().Add(90*24*time.Hour), stored.ExpiresAt, time.Minute)
+	})
+
+	t.Run("unknown_resource", func(t *testing.T) {
+		_, err := svc.Create(ctx, userID, "bad", entity.APITokenScopes{entity.ResourceRoles: entity.PermissionAll}, 0)
+
+		assert.ErrorIs(t, err, service.ErrInvalidAPITokenScope)
+	})
+
+	t.Run("invalid_permission_mask", func(t *testing.T) {
+		_, err := svc.Create(ctx, userID, "bad", entity.APITokenScopes{entity.ResourceBlogs: 16}, 0)
+
+		assert.ErrorIs(t, err, service.ErrInvalidAPITokenScope)
+	})
+
+	t.Run("expiry_beyond_maximum", func(t *testing.T) {
+		_, err := svc.Create(ctx, userID, "bad", scopes, 400*24*time.Hour)
+
+		assert.ErrorIs(t, err, service.ErrInvalidAPITokenExpiry)
+	})
+
+	t.Run("limit_reached", func(t *testing.T) {
+		mockRepo.EXPECT().CountActiveByUser(ctx, userID, gomock.Any()).Return(int64(20), nil)
+
+		_, err := svc.Create(ctx, userID, "one too many", scopes, 0)
+
+		assert.ErrorIs(t, err, service.ErrAPITokenLimitReached)
+	})
+}
+
+func TestAPITokenService_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockAPITokenRepository(ctrl)
+	svc := service.NewAPITokenService(mockRepo)
+
+	ctx := context.Background()
+	secret := "pat_0123456789abcdefghijklmnopqrstuvwxyzABCDE"
+	sum := sha256.Sum256([]byte(secret))
+	hash := hex.EncodeToString(sum[:])
+
+	t.Run("records_use", func(t *testing.T) {
+		token := &entity.APIToken{ID: uuid.New(), ExpiresAt: time.Now().Add(time.Hour)}
+		mockRepo.EXPECT().FindByHash(ctx, hash).Return(token, nil)
+		mockRepo.EXPECT().UpdateLastUsed(ctx, token.ID, gomock.Any()).Return(nil)
+
+		got, err := svc.Authenticate(ctx, secret)
+
+		require.NoError(t, err)
+		assert.NotNil(t, got.LastUsedAt)
+	})
+
+	t.Run("recently_used_is_not_rewritten", func(t *testing.T) {
+		lastUsed := time.Now().Add(-10 * time.Second)
+		mockRepo.EXPECT().FindByHash(ctx, hash).Return(&entity.APIToken{ExpiresAt: time.Now().Add(time.Hour), LastUsedAt: &lastUsed}, nil)
+
+		_, err := svc.Authenticate(ctx, secret)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		revokedAt := time.Now().Add(-time.Minute)
+		mockRepo.EXPECT().FindByHash(ctx, hash).Return(&entity.APIToken{ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}, nil)
+
+		_, err := svc.Authenticate(ctx, secret)
+
+		assert.ErrorIs(t, err, service.ErrInvalidAPIToken)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		mockRepo.EXPECT().FindByHash(ctx, hash).Return(&entity.APIToken{ExpiresAt: time.Now().Add(-time.Second)}, nil)
+
+		_, err := svc.Authenticate(ctx, secret)
+
+		assert.ErrorIs(t, err, service.ErrInvalidAPIToken)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		mockRepo.EXPECT().FindByHash(ctx, hash).Return(nil, nil)
+
+		_, err := svc.Authenticate(ctx, secret)
+
+		assert.ErrorIs(t, err, service.ErrInvalidAPIToken)
+	})
+
+	t.Run("not_a_token", func(t *testing.T) {
+		_, err := svc.Authenticate(ctx, "some-session-id")
+
+		assert.ErrorIs(t, err, service.ErrInvalidAPIToken)
+	})
+}
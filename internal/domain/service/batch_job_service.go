@@ -2,15 +2,23 @@ package service
 
 import (
 	"context"
-	"sync"
+	"errors"
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
 	"github.com/aiagent/internal/domain/valueobject"
 	"github.com/aiagent/pkg/logger"
 	"github.com/google/uuid"
 )
 
+var (
+	ErrBatchJobNotFound       = errors.New("batch job not found")
+	ErrBatchJobNotCancellable = errors.New("batch job is not running")
+)
+
 // Constants for batch job processing
 const (
 	// Risk score thresholds
@@ -30,159 +38,267 @@ const (
 	revocationReasonHighRisk = "Risk score exceeded threshold"
 
 	// Batch processing
-	defaultSignalsBatchSize = 1000
+	defaultSignalsBatchSize = 200
 	defaultAnalysisDays     = 1
+	defaultJobsPageSize     = 20
+
+	// batchJobLease is how long a worker owns a job without reporting progress;
+	// a job whose lease ran out is considered crashed and may be resumed elsewhere
+	batchJobLease = 5 * time.Minute
 )
 
 // batchJobService implements the BatchJobService interface
 type batchJobService struct {
 	repo      FraudDetectionRepository
+	jobRepo   repository.BatchJobRepository
 	algorithm BotDetectionAlgorithm
 	notifier  NotificationService
-	jobs      map[uuid.UUID]*valueobject.BatchAnalyzeResult
-	mu        sync.RWMutex
+	workerID  string
+	batchSize int
 }
 
 // NewBatchJobService creates a new batch job service instance
-func NewBatchJobService(repo FraudDetectionRepository, algorithm BotDetectionAlgorithm, notifier NotificationService) BatchJobService {
+func NewBatchJobService(repo FraudDetectionRepository, jobRepo repository.BatchJobRepository, algorithm BotDetectionAlgorithm, notifier NotificationService) BatchJobService {
+	host, _ := os.Hostname()
 	return &batchJobService{
 		repo:      repo,
+		jobRepo:   jobRepo,
 		algorithm: algorithm,
 		notifier:  notifier,
-		jobs:      make(map[uuid.UUID]*valueobject.BatchAnalyzeResult),
+		workerID:  fmt.Sprintf("%s-%s", host, uuid.NewString()[:8]),
+		batchSize: defaultSignalsBatchSize,
 	}
 }
 
-// StartBatchAnalysis starts the batch analysis job
+// StartBatchAnalysis persists a new job and runs it in the background
 func (s *batchJobService) StartBatchAnalysis(ctx context.Context, dateFrom, dateTo *time.Time) (uuid.UUID, error) {
-	jobID := uuid.New()
-
 	// Determine date range
 	now := time.Now()
-	var from, to time.Time
-
+	from := now.AddDate(0, 0, -defaultAnalysisDays)
 	if dateFrom != nil {
 		from = *dateFrom
-	} else {
-		// Default: analyze last N days
-		from = now.AddDate(0, 0, -defaultAnalysisDays)
 	}
-
+	to := now
 	if dateTo != nil {
 		to = *dateTo
-	} else {
-		to = now
 	}
 
-	// Initialize job status
-	s.mu.Lock()
-	s.jobs[jobID] = &valueobject.BatchAnalyzeResult{
-		JobID:     jobID,
-		Status:    "running", // "started" was defined as const but string is used in struct. Using "running" or const.
-		StartedAt: time.Now(),
-		Message:   "Analysis in progress",
+	leaseUntil := now.Add(batchJobLease)
+	job := &entity.BatchJob{
+		ID:          uuid.New(),
+		JobType:     entity.BatchJobTypeFraudAnalysis,
+		Status:      entity.BatchJobStatusRunning,
+		DateFrom:    from,
+		DateTo:      to,
+		Errors:      []string{},
+		Message:     "Analysis in progress",
+		LockedBy:    &s.workerID,
+		LockedUntil: &leaseUntil,
+		StartedAt:   now,
+	}
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create batch job: %w", err)
 	}
-	s.mu.Unlock()
 
 	// Run analysis in background (non-blocking)
-	go s.runAnalysis(context.Background(), jobID, from, to)
+	go s.runAnalysis(context.Background(), job)
 
-	return jobID, nil
+	return job.ID, nil
 }
 
-// runAnalysis performs the actual batch analysis
-func (s *batchJobService) runAnalysis(ctx context.Context, jobID uuid.UUID, from, to time.Time) {
-	// This is a simplified implementation
-	// In production, you'd want to:
-	// 1. Track job progress in a database
-	// 2. Process in smaller batches to avoid memory issues
-	// 3. Handle errors gracefully
-	// 4. Send notifications when complete
-
-	// Step 1: Get all follower events in the time range
-	// (This is simplified - you'd need to implement GetFollowerEventsInRange in the repository)
-
-	// Step 2: Analyze each unique follower
-	processedFollowers := 0
-	newSignals := 0
-	usersScored := make(map[uuid.UUID]bool)
-
-	// Get unprocessed bot signals to analyze
-	signals, err := s.repo.GetUnprocessedBotSignals(ctx, defaultSignalsBatchSize)
+// ResumeInterruptedJobs claims running jobs whose lease expired and continues them from their checkpoint
+func (s *batchJobService) ResumeInterruptedJobs(ctx context.Context) (int, error) {
+	jobs, err := s.jobRepo.FindStale(ctx, entity.BatchJobTypeFraudAnalysis, time.Now())
 	if err != nil {
-		logger.Error("Error getting unprocessed signals", err, map[string]interface{}{"job_id": jobID})
-		return
+		return 0, fmt.Errorf("failed to find interrupted batch jobs: %w", err)
 	}
 
-	// Group signals by user and calculate risk scores
-	userSignals := make(map[uuid.UUID][]entity.BotDetectionSignal)
-	for _, signal := range signals {
-		userSignals[signal.UserID] = append(userSignals[signal.UserID], signal)
-		processedFollowers++
-		newSignals++
-
-		// Mark signal as processed
-		if err := s.repo.MarkBotSignalAsProcessed(ctx, signal.ID); err != nil {
-			logger.Error("Error marking signal as processed", err, map[string]interface{}{"job_id": jobID})
+	resumed := 0
+	for i := range jobs {
+		job := jobs[i]
+		leaseUntil := time.Now().Add(batchJobLease)
+		claimed, err := s.jobRepo.Claim(ctx, job.ID, s.workerID, leaseUntil)
+		if err != nil {
+			logger.Error("Error claiming batch job", err, map[string]interface{}{"job_id": job.ID})
+			continue
+		}
+		if !claimed {
+			continue // Another replica got there first
 		}
+
+		job.LockedBy = &s.workerID
+		job.LockedUntil = &leaseUntil
+		logger.Info("Resuming batch job from checkpoint", map[string]interface{}{
+			"job_id":        job.ID,
+			"checkpoint_id": job.CheckpointID,
+		})
+		go s.runAnalysis(context.Background(), &job)
+		resumed++
 	}
+	return resumed, nil
+}
 
-	// Calculate risk scores for each user
-	for userID, signals := range userSignals {
-		riskScore, err := s.algorithm.CalculateRiskScore(ctx, userID, signals, 0)
+// runAnalysis scores users from unprocessed bot signals batch by batch.
+// Progress and the checkpoint are saved after every batch so a crashed run
+// resumes where it stopped, and a cancelled job stops at the next batch.
+func (s *batchJobService) runAnalysis(ctx context.Context, job *entity.BatchJob) {
+	fields := map[string]interface{}{"job_id": job.ID}
+
+	for {
+		signals, err := s.repo.GetUnprocessedBotSignalsAfter(ctx, job.DateFrom, job.DateTo, job.CheckpointAt, job.CheckpointID, s.batchSize)
 		if err != nil {
-			logger.Error("Error calculating risk score", err, map[string]interface{}{"job_id": jobID, "user_id": userID})
-			continue
+			logger.Error("Error getting unprocessed signals", err, fields)
+			job.AddError(err.Error())
+			s.finish(ctx, job, entity.BatchJobStatusFailed, "Analysis failed")
+			return
+		}
+		if len(signals) == 0 {
+			break
 		}
 
-		// Save risk score
-		if err := s.repo.CreateOrUpdateRiskScore(ctx, riskScore); err != nil {
-			logger.Error("Error saving risk score", err, map[string]interface{}{"job_id": jobID, "user_id": userID})
-			continue
+		if !s.processBatch(ctx, job, signals) {
+			return
+		}
+
+		last := signals[len(signals)-1]
+		job.Checkpoint(last.ID, last.DetectedAt)
+		leaseUntil := time.Now().Add(batchJobLease)
+		job.LockedUntil = &leaseUntil
+
+		if !s.save(ctx, job) {
+			return
 		}
+	}
 
-		usersScored[userID] = true
+	logger.Info("Batch job completed", map[string]interface{}{
+		"job_id":              job.ID,
+		"processed_followers": job.ProcessedFollowers,
+		"new_signals":         job.NewSignalsDetected,
+		"users_scored":        job.UsersScored,
+	})
+	s.finish(ctx, job, entity.BatchJobStatusCompleted, "Analysis completed successfully")
+}
 
-		// Update badge status based on risk score
-		if err := s.updateBadgeStatus(ctx, userID, riskScore); err != nil {
-			logger.Error("Error updating badge status", err, map[string]interface{}{"job_id": jobID, "user_id": userID})
+// processBatch scores every user referenced by the batch using all of their unprocessed signals.
+// The lease is renewed before each user so a slow batch is never resumed by another worker
+// while it is still running; it reports false when the lease was lost and the worker must stop.
+func (s *batchJobService) processBatch(ctx context.Context, job *entity.BatchJob, batch []entity.BotDetectionSignal) bool {
+	var userIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, signal := range batch {
+		if !seen[signal.UserID] {
+			seen[signal.UserID] = true
+			userIDs = append(userIDs, signal.UserID)
 		}
+	}
 
-		// Send notifications for high-risk users
-		if riskScore.OverallScore > notificationThreshold {
-			if err := s.sendBotNotifications(ctx, userID, signals); err != nil {
-				logger.Error("Error sending notifications", err, map[string]interface{}{"job_id": jobID, "user_id": userID})
-			}
+	var batchSignals []entity.BotDetectionSignal
+	for _, userID := range userIDs {
+		if !s.renewLease(ctx, job) {
+			return false
 		}
+		signals, err := s.scoreUser(ctx, job, userID)
+		if err != nil {
+			logger.Error("Error scoring user", err, map[string]interface{}{"job_id": job.ID, "user_id": userID})
+			job.AddError(fmt.Sprintf("user %s: %v", userID, err))
+			continue
+		}
+		batchSignals = append(batchSignals, signals...)
 	}
 
 	// Detect coordinated bot networks
-	networks, err := s.algorithm.DetectCoordinatedBots(ctx, signals)
+	networks, err := s.algorithm.DetectCoordinatedBots(ctx, batchSignals)
 	if err != nil {
-		logger.Error("Error detecting coordinated bots", err, map[string]interface{}{"job_id": jobID})
+		logger.Error("Error detecting coordinated bots", err, map[string]interface{}{"job_id": job.ID})
 	} else if len(networks) > 0 {
-		logger.Info("Detected coordinated bot networks", map[string]interface{}{"job_id": jobID, "network_count": len(networks)})
+		logger.Info("Detected coordinated bot networks", map[string]interface{}{"job_id": job.ID, "network_count": len(networks)})
 	}
+	return true
+}
 
-	logger.Info("Batch job completed", map[string]interface{}{
-		"job_id":              jobID,
-		"processed_followers": processedFollowers,
-		"new_signals":         newSignals,
-		"users_scored":        len(usersScored),
-	})
+// renewLease extends the worker's lease and reports whether the job is still ours to run
+func (s *batchJobService) renewLease(ctx context.Context, job *entity.BatchJob) bool {
+	leaseUntil := time.Now().Add(batchJobLease)
+	renewed, err := s.jobRepo.Claim(ctx, job.ID, s.workerID, leaseUntil)
+	if err != nil {
+		// Stop without releasing the lease; once it expires the job is resumed from the last checkpoint
+		logger.Error("Error renewing batch job lease", err, map[string]interface{}{"job_id": job.ID})
+		return false
+	}
+	if !renewed {
+		logger.Info("Batch job stopped: cancelled or taken over", map[string]interface{}{"job_id": job.ID})
+		return false
+	}
+	job.LockedUntil = &leaseUntil
+	return true
+}
+
+// scoreUser recalculates one user's risk score and marks the signals it used as processed
+func (s *batchJobService) scoreUser(ctx context.Context, job *entity.BatchJob, userID uuid.UUID) ([]entity.BotDetectionSignal, error) {
+	signals, err := s.repo.GetBotSignalsByUser(ctx, userID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signals: %w", err)
+	}
+	if len(signals) == 0 {
+		return nil, nil // Already scored by an earlier, interrupted run
+	}
+
+	riskScore, err := s.algorithm.CalculateRiskScore(ctx, userID, signals, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate risk score: %w", err)
+	}
+	if err := s.repo.CreateOrUpdateRiskScore(ctx, riskScore); err != nil {
+		return nil, fmt.Errorf("failed to save risk score: %w", err)
+	}
 
-	// Update job status
-	s.mu.Lock()
-	if job, exists := s.jobs[jobID]; exists {
-		now := time.Now()
-		job.Status = "completed"
-		job.CompletedAt = &now
-		job.ProcessedFollowers = processedFollowers
-		job.NewSignalsDetected = newSignals
-		job.UsersScored = len(usersScored)
-		job.Message = "Analysis completed successfully"
-	}
-	s.mu.Unlock()
+	job.ProcessedFollowers++
+	job.NewSignalsDetected += len(signals)
+	job.UsersScored++
+
+	// Update badge status based on risk score
+	if err := s.updateBadgeStatus(ctx, userID, riskScore); err != nil {
+		logger.Error("Error updating badge status", err, map[string]interface{}{"job_id": job.ID, "user_id": userID})
+	}
+
+	// Send notifications for high-risk users
+	if riskScore.OverallScore > notificationThreshold {
+		if err := s.sendBotNotifications(ctx, userID, signals); err != nil {
+			logger.Error("Error sending notifications", err, map[string]interface{}{"job_id": job.ID, "user_id": userID})
+		}
+	}
+
+	// Signals are marked last so an interrupted run scores the user again instead of skipping them
+	for _, signal := range signals {
+		if err := s.repo.MarkBotSignalAsProcessed(ctx, signal.ID); err != nil {
+			logger.Error("Error marking signal as processed", err, map[string]interface{}{"job_id": job.ID})
+		}
+	}
+
+	return signals, nil
+}
+
+// save persists progress and reports whether the worker should keep going
+func (s *batchJobService) save(ctx context.Context, job *entity.BatchJob) bool {
+	ok, err := s.jobRepo.SaveProgress(ctx, job, s.workerID)
+	if err != nil {
+		// Stop without releasing the lease; once it expires the job is resumed from the last checkpoint
+		logger.Error("Error saving batch job progress", err, map[string]interface{}{"job_id": job.ID})
+		return false
+	}
+	if !ok {
+		logger.Info("Batch job stopped: cancelled or taken over", map[string]interface{}{"job_id": job.ID})
+		return false
+	}
+	return true
+}
+
+// finish moves the job to a terminal state
+func (s *batchJobService) finish(ctx context.Context, job *entity.BatchJob, status entity.BatchJobStatus, message string) {
+	now := time.Now()
+	job.Status = status
+	job.CompletedAt = &now
+	job.Message = message
+	s.save(ctx, job)
 }
 
 // updateBadgeStatus updates the badge status based on risk score
@@ -234,14 +350,16 @@ func (s *batchJobService) updateBadgeStatus(ctx context.Context, userID uuid.UUI
 	return s.repo.CreateOrUpdateBadgeStatus(ctx, status)
 }
 
-// sendBotNotifications sends notifications to users about flagged bot followers
+// sendBotNotifications sends notifications to users about flagged bot followers.
+// Notification IDs are derived from the signal, so a user scored again after an
+// interrupted run is only notified about signals it was not told about before.
 func (s *batchJobService) sendBotNotifications(ctx context.Context, userID uuid.UUID, signals []entity.BotDetectionSignal) error {
 	// Create notification records
 	notifications := make([]valueobject.BotFollowerNotificationResult, 0, len(signals))
 
 	for _, signal := range signals {
 		notification := &entity.BotFollowerNotification{
-			ID:               uuid.New(),
+			ID:               uuid.NewSHA1(signal.ID, userID[:]),
 			UserID:           userID,
 			BotFollowerID:    signal.UserID,
 			SignalID:         signal.ID,
@@ -249,9 +367,13 @@ func (s *batchJobService) sendBotNotifications(ctx context.Context, userID uuid.
 			SentAt:           time.Now(),
 		}
 
-		if err := s.repo.CreateBotNotification(ctx, notification); err != nil {
+		created, err := s.repo.CreateBotNotification(ctx, notification)
+		if err != nil {
 			return err
 		}
+		if !created {
+			continue
+		}
 
 		notifications = append(notifications, valueobject.BotFollowerNotificationResult{
 			ID:              notification.ID,
@@ -272,20 +394,88 @@ func (s *batchJobService) sendBotNotifications(ctx context.Context, userID uuid.
 
 // GetBatchJobStatus retrieves the status of a batch job
 func (s *batchJobService) GetBatchJobStatus(ctx context.Context, jobID uuid.UUID) (*valueobject.BatchAnalyzeResult, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	job, exists := s.jobs[jobID]
-	if !exists {
+	job, err := s.jobRepo.FindByID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
 		return &valueobject.BatchAnalyzeResult{
 			JobID:   jobID,
 			Status:  "unknown",
 			Message: "Job not found",
 		}, nil
 	}
+	return toBatchAnalyzeResult(job), nil
+}
+
+// ListBatchJobs retrieves fraud analysis batch jobs, newest first
+func (s *batchJobService) ListBatchJobs(ctx context.Context, filter valueobject.BatchJobFilter) (*valueobject.BatchJobListResult, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = defaultJobsPageSize
+	}
+
+	jobType := entity.BatchJobTypeFraudAnalysis
+	repoFilter := repository.BatchJobFilter{JobType: &jobType}
+	if filter.Status != "" {
+		status := entity.BatchJobStatus(filter.Status)
+		repoFilter.Status = &status
+	}
+
+	result, err := s.jobRepo.FindAll(ctx, repoFilter, repository.Pagination{Page: filter.Page, PageSize: filter.PageSize})
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]valueobject.BatchAnalyzeResult, 0, len(result.Data))
+	for i := range result.Data {
+		jobs = append(jobs, *toBatchAnalyzeResult(&result.Data[i]))
+	}
 
-	// Return a copy to avoid race conditions if caller modifies it (though returning pointer... technically safe if we don't modify the struct fields in place after returning, but struct has value semantics mostly)
-	// Be safe and return a copy or new struct
-	result := *job
-	return &result, nil
+	return &valueobject.BatchJobListResult{
+		Jobs:       jobs,
+		TotalCount: int(result.Total),
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+// CancelBatchJob marks a running job as cancelled; its worker stops after the current batch
+func (s *batchJobService) CancelBatchJob(ctx context.Context, jobID uuid.UUID) (*valueobject.BatchAnalyzeResult, error) {
+	cancelled, err := s.jobRepo.Cancel(ctx, jobID, "Cancelled by admin")
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.jobRepo.FindByID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, ErrBatchJobNotFound
+	}
+	if !cancelled {
+		return nil, ErrBatchJobNotCancellable
+	}
+	return toBatchAnalyzeResult(job), nil
+}
+
+// toBatchAnalyzeResult converts a persisted job into its result view
+func toBatchAnalyzeResult(job *entity.BatchJob) *valueobject.BatchAnalyzeResult {
+	return &valueobject.BatchAnalyzeResult{
+		JobID:              job.ID,
+		Status:             string(job.Status),
+		DateFrom:           job.DateFrom,
+		DateTo:             job.DateTo,
+		StartedAt:          job.StartedAt,
+		CompletedAt:        job.CompletedAt,
+		ProcessedFollowers: job.ProcessedFollowers,
+		NewSignalsDetected: job.NewSignalsDetected,
+		UsersScored:        job.UsersScored,
+		Errors:             job.Errors,
+		Message:            job.Message,
+	}
 }
@@ -0,0 +1,310 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/aiagent/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBatchJobService_ResumeInterruptedJobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := serviceMocks.NewMockFraudDetectionRepository(ctrl)
+	mockJobRepo := repoMocks.NewMockBatchJobRepository(ctrl)
+	mockAlgorithm := serviceMocks.NewMockBotDetectionAlgorithm(ctrl)
+	mockNotifier := serviceMocks.NewMockNotificationService(ctrl)
+	svc := service.NewBatchJobService(mockRepo, mockJobRepo, mockAlgorithm, mockNotifier)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	checkpointID := uuid.New()
+	checkpointAt := time.Now().Add(-time.Hour)
+	job := entity.BatchJob{
+		ID:           uuid.New(),
+		JobType:      entity.BatchJobTypeFraudAnalysis,
+		Status:       entity.BatchJobStatusRunning,
+		DateFrom:     time.Now().AddDate(0, 0, -1),
+		DateTo:       time.Now(),
+		CheckpointID: &checkpointID,
+		CheckpointAt: &checkpointAt,
+		UsersScored:  3,
+	}
+	claimedByOther := entity.BatchJob{ID: uuid.New(), Status: entity.BatchJobStatusRunning}
+	signal := entity.BotDetectionSignal{ID: uuid.New(), UserID: userID, DetectedAt: time.Now()}
+
+	mockJobRepo.EXPECT().FindStale(ctx, entity.BatchJobTypeFraudAnalysis, gomock.Any()).Return([]entity.BatchJob{job, claimedByOther}, nil)
+	// Claimed once to resume and renewed again before scoring the user
+	mockJobRepo.EXPECT().Claim(ctx, job.ID, gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+	mockJobRepo.EXPECT().Claim(ctx, claimedByOther.ID, gomock.Any(), gomock.Any()).Return(false, nil)
+
+	// The resumed run continues after the stored checkpoint
+	gomock.InOrder(
+		mockRepo.EXPECT().GetUnprocessedBotSignalsAfter(gomock.Any(), job.DateFrom, job.DateTo, &checkpointAt, &checkpointID, gomock.Any()).
+			Return([]entity.BotDetectionSignal{signal}, nil),
+		mockRepo.EXPECT().GetUnprocessedBotSignalsAfter(gomock.Any(), job.DateFrom, job.DateTo, gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ time.Time, afterAt *time.Time, afterID *uuid.UUID, _ int) ([]entity.BotDetectionSignal, error) {
+				assert.Equal(t, signal.ID, *afterID)
+				assert.True(t, signal.DetectedAt.Equal(*afterAt))
+				return nil, nil
+			}),
+	)
+	mockRepo.EXPECT().GetBotSignalsByUser(gomock.Any(), userID, false).Return([]entity.BotDetectionSignal{signal}, nil)
+	mockAlgorithm.EXPECT().CalculateRiskScore(gomock.Any(), userID, gomock.Any(), 0).Return(&entity.UserRiskScore{UserID: userID, OverallScore: 40}, nil)
+	mockRepo.EXPECT().CreateOrUpdateRiskScore(gomock.Any(), gomock.Any()).Return(nil)
+	mockRepo.EXPECT().GetBadgeStatusByUser(gomock.Any(), userID).Return(nil, nil)
+	mockRepo.EXPECT().MarkBotSignalAsProcessed(gomock.Any(), signal.ID).Return(nil)
+	mockAlgorithm.EXPECT().DetectCoordinatedBots(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	done := make(chan entity.BatchJob, 1)
+	mockJobRepo.EXPECT().SaveProgress(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(_ context.Context, j *entity.BatchJob, _ string) (bool, error) {
+			if j.IsFinished() {
+				done <- *j
+			}
+			return true, nil
+		})
+
+	resumed, err := svc.ResumeInterruptedJobs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resumed)
+
+	select {
+	case finished := <-done:
+		assert.Equal(t, entity.BatchJobStatusCompleted, finished.Status)
+		assert.Equal(t, 4, finished.UsersScored)
+		assert.Equal(t, signal.ID, *finished.CheckpointID)
+		assert.NotNil(t, finished.CompletedAt)
+	case <-time.After(2 * time.Second):
+		t.Fatal("resumed job did not complete")
+	}
+}
+
+func TestBatchJobService_StopsWhenCancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := serviceMocks.NewMockFraudDetectionRepository(ctrl)
+	mockJobRepo := repoMocks.NewMockBatchJobRepository(ctrl)
+	mockAlgorithm := serviceMocks.NewMockBotDetectionAlgorithm(ctrl)
+	mockNotifier := serviceMocks.NewMockNotificationService(ctrl)
+	svc := service.NewBatchJobService(mockRepo, mockJobRepo, mockAlgorithm, mockNotifier)
+
+	userID := uuid.New()
+	signal := entity.BotDetectionSignal{ID: uuid.New(), UserID: userID, DetectedAt: time.Now()}
+
+	mockJobRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, j *entity.BatchJob) error {
+		assert.Equal(t, entity.BatchJobStatusRunning, j.Status)
+		assert.NotNil(t, j.LockedBy)
+		return nil
+	})
+	mockRepo.EXPECT().GetUnprocessedBotSignalsAfter(gomock.Any(), gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).
+		Return([]entity.BotDetectionSignal{signal}, nil)
+	mockJobRepo.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+	mockRepo.EXPECT().GetBotSignalsByUser(gomock.Any(), userID, false).Return(nil, nil)
+	mockAlgorithm.EXPECT().DetectCoordinatedBots(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	// SaveProgress reports the job is no longer ours; the worker must not fetch another batch
+	stopped := make(chan struct{})
+	mockJobRepo.EXPECT().SaveProgress(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *entity.BatchJob, _ string) (bool, error) {
+		close(stopped)
+		return false, nil
+	})
+
+	jobID, err := svc.StartBatchAnalysis(context.Background(), nil, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, jobID)
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("worker did not save progress")
+	}
+	// Give the worker a moment; an extra fetch would fail the gomock expectations
+	time.Sleep(50 * time.Millisecond)
+}
+
+func TestBatchJobService_StopsWhenLeaseLostMidBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := serviceMocks.NewMockFraudDetectionRepository(ctrl)
+	mockJobRepo := repoMocks.NewMockBatchJobRepository(ctrl)
+	mockAlgorithm := serviceMocks.NewMockBotDetectionAlgorithm(ctrl)
+	mockNotifier := serviceMocks.NewMockNotificationService(ctrl)
+	svc := service.NewBatchJobService(mockRepo, mockJobRepo, mockAlgorithm, mockNotifier)
+
+	first, second := uuid.New(), uuid.New()
+	signals := []entity.BotDetectionSignal{
+		{ID: uuid.New(), UserID: first, DetectedAt: time.Now()},
+		{ID: uuid.New(), UserID: second, DetectedAt: time.Now()},
+	}
+
+	mockJobRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	mockRepo.EXPECT().GetUnprocessedBotSignalsAfter(gomock.Any(), gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).Return(signals, nil)
+
+	// The first user is scored, then the lease turns out to have been taken over
+	stopped := make(chan struct{})
+	gomock.InOrder(
+		mockJobRepo.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil),
+		mockRepo.EXPECT().GetBotSignalsByUser(gomock.Any(), first, false).Return(nil, nil),
+		mockJobRepo.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, _ string, _ time.Time) (bool, error) {
+				close(stopped)
+				return false, nil
+			}),
+	)
+
+	_, err := svc.StartBatchAnalysis(context.Background(), nil, nil)
+	require.NoError(t, err)
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("worker did not renew its lease")
+	}
+	// The second user, coordinated detection and progress belong to the new owner
+	time.Sleep(50 * time.Millisecond)
+}
+
+func TestBatchJobService_RescoredUserIsNotNotifiedTwice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := serviceMocks.NewMockFraudDetectionRepository(ctrl)
+	mockJobRepo := repoMocks.NewMockBatchJobRepository(ctrl)
+	mockAlgorithm := serviceMocks.NewMockBotDetectionAlgorithm(ctrl)
+	mockNotifier := serviceMocks.NewMockNotificationService(ctrl)
+	svc := service.NewBatchJobService(mockRepo, mockJobRepo, mockAlgorithm, mockNotifier)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	notified := entity.BotDetectionSignal{ID: uuid.New(), UserID: userID, DetectedAt: time.Now()}
+	fresh := entity.BotDetectionSignal{ID: uuid.New(), UserID: userID, DetectedAt: time.Now()}
+	job := entity.BatchJob{ID: uuid.New(), Status: entity.BatchJobStatusRunning}
+
+	mockJobRepo.EXPECT().FindStale(ctx, entity.BatchJobTypeFraudAnalysis, gomock.Any()).Return([]entity.BatchJob{job}, nil)
+	mockJobRepo.EXPECT().Claim(gomock.Any(), job.ID, gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+	gomock.InOrder(
+		mockRepo.EXPECT().GetUnprocessedBotSignalsAfter(gomock.Any(), gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).
+			Return([]entity.BotDetectionSignal{notified, fresh}, nil),
+		mockRepo.EXPECT().GetUnprocessedBotSignalsAfter(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil),
+	)
+	mockRepo.EXPECT().GetBotSignalsByUser(gomock.Any(), userID, false).Return([]entity.BotDetectionSignal{notified, fresh}, nil)
+	mockAlgorithm.EXPECT().CalculateRiskScore(gomock.Any(), userID, gomock.Any(), 0).Return(&entity.UserRiskScore{UserID: userID, OverallScore: 60}, nil)
+	mockRepo.EXPECT().CreateOrUpdateRiskScore(gomock.Any(), gomock.Any()).Return(nil)
+	mockRepo.EXPECT().GetBadgeStatusByUser(gomock.Any(), userID).Return(nil, nil)
+
+	// The interrupted run already stored the first notification, which keeps its ID
+	mockRepo.EXPECT().CreateBotNotification(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, n *entity.BotFollowerNotification) (bool, error) {
+			assert.Equal(t, uuid.NewSHA1(n.SignalID, userID[:]), n.ID)
+			return n.SignalID == fresh.ID, nil
+		}).Times(2)
+	mockNotifier.EXPECT().SendBotFollowerNotification(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, results []valueobject.BotFollowerNotificationResult) error {
+			require.Len(t, results, 1)
+			assert.Equal(t, uuid.NewSHA1(fresh.ID, userID[:]), results[0].ID)
+			return nil
+		})
+	mockRepo.EXPECT().MarkBotSignalAsProcessed(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mockAlgorithm.EXPECT().DetectCoordinatedBots(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	done := make(chan struct{})
+	mockJobRepo.EXPECT().SaveProgress(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(_ context.Context, j *entity.BatchJob, _ string) (bool, error) {
+			if j.IsFinished() {
+				close(done)
+			}
+			return true, nil
+		})
+
+	_, err := svc.ResumeInterruptedJobs(ctx)
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("resumed job did not complete")
+	}
+}
+
+func TestBatchJobService_CancelBatchJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := serviceMocks.NewMockFraudDetectionRepository(ctrl)
+	mockJobRepo := repoMocks.NewMockBatchJobRepository(ctrl)
+	svc := service.NewBatchJobService(mockRepo, mockJobRepo, nil, nil)
+
+	ctx := context.Background()
+	jobID := uuid.New()
+
+	t.Run("running_job", func(t *testing.T) {
+		mockJobRepo.EXPECT().Cancel(ctx, jobID, gomock.Any()).Return(true, nil)
+		mockJobRepo.EXPECT().FindByID(ctx, jobID).Return(&entity.BatchJob{ID: jobID, Status: entity.BatchJobStatusCancelled}, nil)
+
+		result, err := svc.CancelBatchJob(ctx, jobID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "cancelled", result.Status)
+	})
+
+	t.Run("finished_job", func(t *testing.T) {
+		mockJobRepo.EXPECT().Cancel(ctx, jobID, gomock.Any()).Return(false, nil)
+		mockJobRepo.EXPECT().FindByID(ctx, jobID).Return(&entity.BatchJob{ID: jobID, Status: entity.BatchJobStatusCompleted}, nil)
+
+		_, err := svc.CancelBatchJob(ctx, jobID)
+
+		assert.ErrorIs(t, err, service.ErrBatchJobNotCancellable)
+	})
+
+	t.Run("missing_job", func(t *testing.T) {
+		mockJobRepo.EXPECT().Cancel(ctx, jobID, gomock.Any()).Return(false, nil)
+		mockJobRepo.EXPECT().FindByID(ctx, jobID).Return(nil, nil)
+
+		_, err := svc.CancelBatchJob(ctx, jobID)
+
+		assert.ErrorIs(t, err, service.ErrBatchJobNotFound)
+	})
+}
+
+func TestBatchJobService_ListBatchJobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := serviceMocks.NewMockFraudDetectionRepository(ctrl)
+	mockJobRepo := repoMocks.NewMockBatchJobRepository(ctrl)
+	svc := service.NewBatchJobService(mockRepo, mockJobRepo, nil, nil)
+
+	ctx := context.Background()
+	job := entity.BatchJob{ID: uuid.New(), Status: entity.BatchJobStatusFailed, Errors: []string{"boom"}}
+
+	mockJobRepo.EXPECT().FindAll(ctx, gomock.Any(), repository.Pagination{Page: 1, PageSize: 20}).
+		DoAndReturn(func(_ context.Context, f repository.BatchJobFilter, p repository.Pagination) (*repository.PaginatedResult[entity.BatchJob], error) {
+			require.NotNil(t, f.Status)
+			assert.Equal(t, entity.BatchJobStatusFailed, *f.Status)
+			assert.Equal(t, entity.BatchJobTypeFraudAnalysis, *f.JobType)
+			return &repository.PaginatedResult[entity.BatchJob]{Data: []entity.BatchJob{job}, Total: 1, Page: 1, PageSize: 20, TotalPages: 1}, nil
+		})
+
+	result, err := svc.ListBatchJobs(ctx, valueobject.BatchJobFilter{Status: "failed"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Jobs, 1)
+	assert.Equal(t, job.ID, result.Jobs[0].JobID)
+	assert.Equal(t, []string{"boom"}, result.Jobs[0].Errors)
+	assert.Equal(t, 1, result.TotalCount)
+}
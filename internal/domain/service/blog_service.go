@@ -4,7 +4,9 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/aiagent/internal/domain/entity"
@@ -12,6 +14,7 @@ import (
 	"github.com/aiagent/internal/infrastructure/cache"
 	"github.com/aiagent/pkg/logger"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 var (
@@ -19,11 +22,17 @@ var (
 	ErrBlogAccessDenied     = errors.New("access denied to this blog")
 	ErrBlogAlreadyPublished = errors.New("blog is already published")
 	ErrSlugAlreadyExists    = errors.New("slug already exists for this author")
+	ErrInvalidBlogCursor    = errors.New("invalid blog cursor")
+	ErrBlogNotScheduled     = errors.New("blog is not scheduled for publication")
+	ErrInvalidPublishTime   = errors.New("publish time must be in the future")
+	ErrInvalidTimezone      = errors.New("unknown timezone")
 )
 
 const (
 	VersionInitial  = "Initial version"
 	VersionAutoSave = "Auto-saved"
+	// VersionPublished labels the snapshot of what followers were told about
+	VersionPublished = "Published"
 )
 
 type BlogService interface {
@@ -31,39 +40,58 @@ type BlogService interface {
 	GetByID(ctx context.Context, id uuid.UUID, viewerID *uuid.UUID) (*entity.Blog, error)
 	GetBySlug(ctx context.Context, authorID uuid.UUID, slug string, viewerID *uuid.UUID) (*entity.Blog, error)
 	List(ctx context.Context, filter repository.BlogFilter, pagination repository.Pagination, viewerID *uuid.UUID) (*repository.PaginatedResult[entity.Blog], error)
+	// ListPublished pages through the published blogs the viewer may see, newest first. The
+	// cursor is the NextCursor of the previous page, empty for the first one.
+	ListPublished(ctx context.Context, filter repository.BlogFilter, cursor string, limit int, viewerID *uuid.UUID) (*repository.CursorPage[entity.Blog], error)
 	Update(ctx context.Context, blog *entity.Blog, tagIDs []uuid.UUID) error
 	Delete(ctx context.Context, id uuid.UUID, authorID uuid.UUID) error
-	Publish(ctx context.Context, id uuid.UUID, authorID uuid.UUID, visibility entity.BlogVisibility, publishedAt *time.Time) (*entity.Blog, error)
+	Publish(ctx context.Context, id uuid.UUID, authorID uuid.UUID, visibility entity.BlogVisibility, publishedAt *time.Time, timezone *string) (*entity.Blog, error)
 	Unpublish(ctx context.Context, id uuid.UUID, authorID uuid.UUID) (*entity.Blog, error)
+	// ListScheduled returns the author's blogs waiting for their publish time, soonest first
+	ListScheduled(ctx context.Context, authorID uuid.UUID) ([]entity.Blog, error)
+	// Reschedule moves a scheduled blog to another future publish time. The timezone is the
+	// IANA name the author picked the time in, nil when it was given as an absolute time.
+	Reschedule(ctx context.Context, id uuid.UUID, authorID uuid.UUID, publishAt time.Time, timezone *string) (*entity.Blog, error)
+	// CancelSchedule turns a scheduled blog back into a draft
+	CancelSchedule(ctx context.Context, id uuid.UUID, authorID uuid.UUID) (*entity.Blog, error)
 	React(ctx context.Context, id uuid.UUID, userID uuid.UUID, reactionType entity.ReactionType) (upvotes, downvotes int, err error)
 	CheckAccess(ctx context.Context, blog *entity.Blog, viewerID *uuid.UUID) error
 }
 
 type blogService struct {
+	db               *gorm.DB
 	blogRepo         repository.BlogRepository
 	subscriptionRepo repository.SubscriptionRepository
 	tagRepo          repository.TagRepository
 	versionService   VersionService
 	batcher          *ReactionBatcher
+	outboxRepo       repository.OutboxRepository
+	seriesAccess     SeriesAccessService
 }
 
 func NewBlogService(
+	db *gorm.DB,
 	blogRepo repository.BlogRepository,
 	subscriptionRepo repository.SubscriptionRepository,
 	tagRepo repository.TagRepository,
 	redis *cache.RedisClient,
 	versionService VersionService,
+	outboxRepo repository.OutboxRepository,
+	seriesAccess SeriesAccessService,
 ) BlogService {
 	// Initialize batcher with 5 second flush interval, now using Redis
 	batcher := NewReactionBatcher(blogRepo, redis, 5*time.Second)
 	batcher.Start()
 
 	return &blogService{
+		db:               db,
 		blogRepo:         blogRepo,
 		subscriptionRepo: subscriptionRepo,
 		tagRepo:          tagRepo,
 		batcher:          batcher,
 		versionService:   versionService,
+		outboxRepo:       outboxRepo,
+		seriesAccess:     seriesAccess,
 	}
 }
 
@@ -73,6 +101,7 @@ func (s *blogService) Create(ctx context.Context, blog *entity.Blog, tagIDs []uu
 		return ErrSlugAlreadyExists
 	}
 
+	fillExcerpt(blog)
 	if err := s.blogRepo.Create(ctx, blog); err != nil {
 		return err
 	}
@@ -124,30 +153,61 @@ func (s *blogService) GetBySlug(ctx context.Context, authorID uuid.UUID, slug st
 }
 
 func (s *blogService) List(ctx context.Context, filter repository.BlogFilter, pagination repository.Pagination, viewerID *uuid.UUID) (*repository.PaginatedResult[entity.Blog], error) {
-	result, err := s.blogRepo.FindAll(ctx, filter, pagination)
+	// Access is checked by the query so pages are full and totals only count what the viewer
+	// may see. Locked chapters and tier-gated posts stay listed as teasers: listings carry no content.
+	filter.Access = &repository.BlogAccess{ViewerID: viewerID}
+	return s.blogRepo.FindAll(ctx, filter, pagination)
+}
+
+func (s *blogService) ListPublished(ctx context.Context, filter repository.BlogFilter, cursor string, limit int, viewerID *uuid.UUID) (*repository.CursorPage[entity.Blog], error) {
+	var after *repository.BlogCursor
+	if cursor != "" {
+		decoded, err := decodeBlogCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = decoded
+	}
+
+	// One extra blog tells whether there is a next page
+	filter.Access = &repository.BlogAccess{ViewerID: viewerID}
+	blogs, err := s.blogRepo.FindPublishedAfter(ctx, filter, after, limit+1)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter by access
-	accessibleBlogs := make([]entity.Blog, 0, len(result.Data))
-	for _, blog := range result.Data {
-		if s.CheckAccess(ctx, &blog, viewerID) == nil {
-			accessibleBlogs = append(accessibleBlogs, blog)
-		}
+	page := &repository.CursorPage[entity.Blog]{Data: blogs}
+	if len(blogs) > limit {
+		page.Data = blogs[:limit]
+		last := page.Data[limit-1]
+		page.NextCursor = encodeBlogCursor(repository.BlogCursor{PublishedAt: *last.PublishedAt, ID: last.ID})
 	}
+	return page, nil
+}
 
-	// Note: Total count might be inaccurate if filtered, but efficient count with permission is complex.
-	// For now keeping simple: return filtered list.
-	// Accurate count would requires repo support for permission check query.
+// encodeBlogCursor returns an opaque cursor for the position of a blog
+func encodeBlogCursor(c repository.BlogCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.PublishedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID.String()))
+}
+
+func decodeBlogCursor(cursor string) (*repository.BlogCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidBlogCursor
+	}
+	publishedAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, ErrInvalidBlogCursor
+	}
 
-	return &repository.PaginatedResult[entity.Blog]{
-		Data:       accessibleBlogs,
-		Total:      result.Total, // Should be count of accessible, strictly speaking.
-		Page:       result.Page,
-		PageSize:   result.PageSize,
-		TotalPages: result.TotalPages,
-	}, nil
+	var c repository.BlogCursor
+	if c.PublishedAt, err = time.Parse(time.RFC3339Nano, publishedAt); err != nil {
+		return nil, ErrInvalidBlogCursor
+	}
+	if c.ID, err = uuid.Parse(id); err != nil {
+		return nil, ErrInvalidBlogCursor
+	}
+	return &c, nil
 }
 
 func (s *blogService) Update(ctx context.Context, blog *entity.Blog, tagIDs []uuid.UUID) error {
@@ -156,6 +216,7 @@ func (s *blogService) Update(ctx context.Context, blog *entity.Blog, tagIDs []uu
 		return ErrSlugAlreadyExists
 	}
 
+	fillExcerpt(blog)
 	if err := s.blogRepo.Update(ctx, blog); err != nil {
 		return err
 	}
@@ -174,6 +235,18 @@ func (s *blogService) Update(ctx context.Context, blog *entity.Blog, tagIDs []uu
 	return nil
 }
 
+// fillExcerpt generates the excerpt of a blog whose author left it empty
+func fillExcerpt(blog *entity.Blog) {
+	if blog.Excerpt != nil && strings.TrimSpace(*blog.Excerpt) != "" {
+		return
+	}
+	if excerpt := GenerateExcerpt(blog.Content); excerpt != "" {
+		blog.Excerpt = &excerpt
+	} else {
+		blog.Excerpt = nil
+	}
+}
+
 func (s *blogService) Delete(ctx context.Context, id uuid.UUID, authorID uuid.UUID) error {
 	blog, err := s.blogRepo.FindByID(ctx, id)
 	if err != nil {
@@ -190,7 +263,11 @@ func (s *blogService) Delete(ctx context.Context, id uuid.UUID, authorID uuid.UU
 	return s.blogRepo.Delete(ctx, id)
 }
 
-func (s *blogService) Publish(ctx context.Context, id uuid.UUID, authorID uuid.UUID, visibility entity.BlogVisibility, publishedAt *time.Time) (*entity.Blog, error) {
+func (s *blogService) Publish(ctx context.Context, id uuid.UUID, authorID uuid.UUID, visibility entity.BlogVisibility, publishedAt *time.Time, timezone *string) (*entity.Blog, error) {
+	if timezone != nil && !validTimezone(*timezone) {
+		return nil, ErrInvalidTimezone
+	}
+
 	blog, err := s.blogRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -203,12 +280,40 @@ func (s *blogService) Publish(ctx context.Context, id uuid.UUID, authorID uuid.U
 		return nil, ErrBlogAccessDenied
 	}
 
+	wasLive := blog.IsLive()
+
 	blog.Visibility = visibility
-	blog.Publish(publishedAt)
+	blog.Publish(publishedAt, timezone)
 
-	if err := s.blogRepo.Update(ctx, blog); err != nil {
+	// Scheduled posts are announced by ScheduledPublishJob when their publish time arrives, and
+	// re-publishing a live post (e.g. to change visibility) announces nothing.
+	if wasLive || blog.IsScheduled() {
+		if err := s.blogRepo.Update(ctx, blog); err != nil {
+			return nil, err
+		}
+		return blog, nil
+	}
+
+	// The announcement is written to the outbox in the same transaction as the
+	// status change so a crash can neither lose it nor announce an unsaved post
+	announced := false
+	err = s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		if err := s.blogRepo.WithTx(dbTx).Update(ctx, blog); err != nil {
+			return err
+		}
+
+		// A scheduled post may have been announced by the publishing job meanwhile
+		ok, err := announceBlog(ctx, s.blogRepo.WithTx(dbTx), s.outboxRepo.WithTx(dbTx), blog, time.Now())
+		announced = ok
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
+
+	if announced {
+		snapshotPublished(ctx, s.versionService, blog)
+	}
 	return blog, nil
 }
 
@@ -227,12 +332,124 @@ func (s *blogService) Unpublish(ctx context.Context, id uuid.UUID, authorID uuid
 
 	blog.Unpublish()
 
-	if err := s.blogRepo.Update(ctx, blog); err != nil {
+	err = s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		if err := s.blogRepo.WithTx(dbTx).Update(ctx, blog); err != nil {
+			return err
+		}
+		return s.blogRepo.WithTx(dbTx).ResetAnnouncement(ctx, blog.ID)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return blog, nil
 }
 
+func (s *blogService) ListScheduled(ctx context.Context, authorID uuid.UUID) ([]entity.Blog, error) {
+	return s.blogRepo.FindScheduledByAuthor(ctx, authorID)
+}
+
+func (s *blogService) Reschedule(ctx context.Context, id uuid.UUID, authorID uuid.UUID, publishAt time.Time, timezone *string) (*entity.Blog, error) {
+	if !publishAt.After(time.Now()) {
+		return nil, ErrInvalidPublishTime
+	}
+	if timezone != nil && !validTimezone(*timezone) {
+		return nil, ErrInvalidTimezone
+	}
+
+	blog, err := s.findScheduled(ctx, id, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The update only applies while the blog still waits for its publish time, so it either
+	// lands before the publishing job announces the blog or fails after it did
+	rescheduled, err := s.blogRepo.Reschedule(ctx, blog.ID, publishAt, timezone, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !rescheduled {
+		return nil, ErrBlogNotScheduled
+	}
+	blog.Reschedule(publishAt, timezone)
+	return blog, nil
+}
+
+func (s *blogService) CancelSchedule(ctx context.Context, id uuid.UUID, authorID uuid.UUID) (*entity.Blog, error) {
+	blog, err := s.findScheduled(ctx, id, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := s.blogRepo.CancelSchedule(ctx, blog.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !cancelled {
+		return nil, ErrBlogNotScheduled
+	}
+	blog.Unpublish()
+	return blog, nil
+}
+
+// validTimezone checks for an IANA timezone name; the server's local zone means nothing to authors
+func validTimezone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// findScheduled loads a blog of the author that waits for its publish time
+func (s *blogService) findScheduled(ctx context.Context, id uuid.UUID, authorID uuid.UUID) (*entity.Blog, error) {
+	blog, err := s.blogRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if blog == nil {
+		return nil, ErrBlogNotFound
+	}
+	if blog.AuthorID != authorID {
+		return nil, ErrBlogAccessDenied
+	}
+	if !blog.IsScheduled() || blog.AnnouncedAt != nil {
+		return nil, ErrBlogNotScheduled
+	}
+	return blog, nil
+}
+
+// announceBlog marks the blog announced and queues its BlogPublishedEvent, using repositories
+// bound to the caller's transaction. It returns false when the blog was announced already or
+// no longer publishes at the time it was loaded with.
+func announceBlog(ctx context.Context, blogRepo repository.BlogRepository, outboxRepo repository.OutboxRepository, blog *entity.Blog, now time.Time) (bool, error) {
+	marked, err := blogRepo.MarkAnnounced(ctx, blog.ID, *blog.PublishedAt, now)
+	if err != nil || !marked {
+		return false, err
+	}
+
+	outboxEvent, err := NewOutboxEvent(BlogPublishedEvent{
+		BlogID:      blog.ID,
+		AuthorID:    blog.AuthorID,
+		Title:       blog.Title,
+		PublishedAt: *blog.PublishedAt,
+	}, OutboxAggregateBlog, blog.ID)
+	if err != nil {
+		return false, err
+	}
+	if err := outboxRepo.Create(ctx, outboxEvent); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// snapshotPublished keeps the announced content as a blog version. The announcement is
+// already committed, so a failure is only logged.
+func snapshotPublished(ctx context.Context, versionService VersionService, blog *entity.Blog) {
+	if _, err := versionService.CreateVersion(ctx, blog, blog.AuthorID, VersionPublished); err != nil {
+		logger.Error("failed to create published blog version", err, map[string]interface{}{"blog_id": blog.ID})
+	}
+}
+
 func (s *blogService) React(ctx context.Context, id uuid.UUID, userID uuid.UUID, reactionType entity.ReactionType) (int, int, error) {
 	// 1. Check if blog exists
 	blog, err := s.blogRepo.FindByID(ctx, id)
@@ -279,21 +496,27 @@ func (s *blogService) CheckAccess(ctx context.Context, blog *entity.Blog, viewer
 		return nil
 	}
 
-	if blog.IsPublic() {
-		return nil
+	if blog.IsSubscribersOnly() && !s.canReadSubscribersOnly(ctx, blog, viewerID) {
+		return ErrBlogAccessDenied
 	}
 
-	if blog.IsSubscribersOnly() {
-		if viewerID != nil && *viewerID == blog.AuthorID {
-			return nil
-		}
-		if viewerID != nil {
-			isSubscribed, _ := s.subscriptionRepo.Exists(ctx, *viewerID, blog.AuthorID)
-			if isSubscribed {
-				return nil
-			}
-		}
-		return ErrBlogAccessDenied
+	locking, err := s.seriesAccess.LockingSeries(ctx, blog, viewerID)
+	if err != nil {
+		return err
+	}
+	if len(locking) > 0 {
+		return ErrSeriesPurchaseRequired
 	}
 	return nil
 }
+
+func (s *blogService) canReadSubscribersOnly(ctx context.Context, blog *entity.Blog, viewerID *uuid.UUID) bool {
+	if viewerID == nil {
+		return false
+	}
+	if *viewerID == blog.AuthorID {
+		return true
+	}
+	isSubscribed, _ := s.subscriptionRepo.Exists(ctx, *viewerID, blog.AuthorID)
+	return isSubscribed
+}
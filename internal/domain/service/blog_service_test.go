@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
 	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
 	"github.com/aiagent/internal/domain/service"
 	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
 func TestBlogService_Create_AutoSave(t *testing.T) {
@@ -40,12 +45,54 @@ func TestBlogService_Create_AutoSave(t *testing.T) {
 	// Expect Version Creation
 	mockVersionService.EXPECT().CreateVersion(ctx, blog, blog.AuthorID, service.VersionInitial).Return(nil, nil)
 
-	s := service.NewBlogService(mockBlogRepo, mockSubRepo, mockTagRepo, nil, mockVersionService)
+	s := service.NewBlogService(nil, mockBlogRepo, mockSubRepo, mockTagRepo, nil, mockVersionService, nil, nil)
 
 	err := s.Create(ctx, blog, nil)
 	assert.NoError(t, err)
 }
 
+func TestBlogService_Create_GeneratesExcerpt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlogRepo := repoMocks.NewMockBlogRepository(ctrl)
+	mockVersionService := serviceMocks.NewMockVersionService(ctrl)
+	ctx := context.Background()
+
+	generated := "Intro with a link."
+	written := "Written by the author"
+	blank := "  "
+	tests := []struct {
+		name    string
+		excerpt *string
+		want    *string
+	}{
+		{name: "missing", excerpt: nil, want: &generated},
+		{name: "blank", excerpt: &blank, want: &generated},
+		{name: "written", excerpt: &written, want: &written},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blog := &entity.Blog{
+				ID:       uuid.New(),
+				AuthorID: uuid.New(),
+				Slug:     "markdown",
+				Content:  "# Title\n\nIntro with [a link](https://example.com).",
+				Excerpt:  tt.excerpt,
+			}
+			mockBlogRepo.EXPECT().FindBySlug(ctx, blog.AuthorID, blog.Slug).Return(nil, nil)
+			mockBlogRepo.EXPECT().Create(ctx, blog).Return(nil)
+			mockVersionService.EXPECT().CreateVersion(ctx, blog, blog.AuthorID, service.VersionInitial).Return(nil, nil)
+
+			s := service.NewBlogService(nil, mockBlogRepo, nil, nil, nil, mockVersionService, nil, nil)
+
+			assert.NoError(t, s.Create(ctx, blog, nil))
+			assert.Equal(t, tt.want, blog.Excerpt)
+		})
+	}
+}
+
 func TestBlogService_Update_AutoSave(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -72,7 +119,7 @@ func TestBlogService_Update_AutoSave(t *testing.T) {
 	// Expect Version Creation
 	mockVersionService.EXPECT().CreateVersion(ctx, blog, blog.AuthorID, service.VersionAutoSave).Return(nil, nil)
 
-	s := service.NewBlogService(mockBlogRepo, mockSubRepo, mockTagRepo, nil, mockVersionService)
+	s := service.NewBlogService(nil, mockBlogRepo, mockSubRepo, mockTagRepo, nil, mockVersionService, nil, nil)
 
 	err := s.Update(ctx, blog, nil)
 	assert.NoError(t, err)
@@ -106,9 +153,412 @@ func TestBlogService_Update_VersionFailureDoesNotBlock(t *testing.T) {
 		CreateVersion(ctx, blog, blog.AuthorID, service.VersionAutoSave).
 		Return(nil, errors.New("version creation failed"))
 
-	s := service.NewBlogService(mockBlogRepo, mockSubRepo, mockTagRepo, nil, mockVersionService)
+	s := service.NewBlogService(nil, mockBlogRepo, mockSubRepo, mockTagRepo, nil, mockVersionService, nil, nil)
 
 	// Should still return no error
 	err := s.Update(ctx, blog, nil)
 	assert.NoError(t, err)
 }
+
+func TestBlogService_Publish_EnqueuesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlogRepo := repoMocks.NewMockBlogRepository(ctrl)
+	mockSubRepo := repoMocks.NewMockSubscriptionRepository(ctrl)
+	mockTagRepo := repoMocks.NewMockTagRepository(ctrl)
+	mockVersionService := serviceMocks.NewMockVersionService(ctrl)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepository(ctrl)
+
+	db, sqlMock, _ := sqlmock.New()
+	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+
+	authorID := uuid.New()
+	blog := &entity.Blog{
+		ID:       uuid.New(),
+		AuthorID: authorID,
+		Title:    "Draft Blog",
+		Status:   entity.BlogStatusDraft,
+	}
+
+	ctx := context.Background()
+
+	mockBlogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+	sqlMock.ExpectBegin()
+	mockBlogRepo.EXPECT().WithTx(gomock.Any()).Return(mockBlogRepo).Times(2)
+	mockBlogRepo.EXPECT().Update(ctx, blog).Return(nil)
+	mockBlogRepo.EXPECT().MarkAnnounced(ctx, blog.ID, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, publishedAt, now time.Time) (bool, error) {
+			assert.Equal(t, *blog.PublishedAt, publishedAt)
+			assert.False(t, now.Before(publishedAt))
+			return true, nil
+		})
+	mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+	mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.OutboxEvent) error {
+		assert.Equal(t, string(service.EventBlogPublished), e.EventType)
+		assert.Equal(t, service.OutboxAggregateBlog, e.AggregateType)
+		assert.Equal(t, blog.ID, e.AggregateID)
+		assert.Contains(t, e.Payload, "Draft Blog")
+		return nil
+	})
+	sqlMock.ExpectCommit()
+	mockVersionService.EXPECT().CreateVersion(ctx, blog, authorID, service.VersionPublished).Return(nil, nil)
+
+	s := service.NewBlogService(gormDB, mockBlogRepo, mockSubRepo, mockTagRepo, nil, mockVersionService, mockOutboxRepo, nil)
+
+	_, err := s.Publish(ctx, blog.ID, authorID, entity.BlogVisibilityPublic, nil, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestBlogService_Publish_OutboxFailureRollsBack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlogRepo := repoMocks.NewMockBlogRepository(ctrl)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepository(ctrl)
+
+	db, sqlMock, _ := sqlmock.New()
+	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+
+	authorID := uuid.New()
+	blog := &entity.Blog{ID: uuid.New(), AuthorID: authorID, Title: "Draft Blog", Status: entity.BlogStatusDraft}
+	ctx := context.Background()
+
+	mockBlogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+	sqlMock.ExpectBegin()
+	mockBlogRepo.EXPECT().WithTx(gomock.Any()).Return(mockBlogRepo).Times(2)
+	mockBlogRepo.EXPECT().Update(ctx, blog).Return(nil)
+	mockBlogRepo.EXPECT().MarkAnnounced(ctx, blog.ID, gomock.Any(), gomock.Any()).Return(true, nil)
+	mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+	mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("db down"))
+	sqlMock.ExpectRollback()
+
+	s := service.NewBlogService(gormDB, mockBlogRepo, nil, nil, nil, nil, mockOutboxRepo, nil)
+
+	_, err := s.Publish(ctx, blog.ID, authorID, entity.BlogVisibilityPublic, nil, nil)
+	assert.Error(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestBlogService_Publish_ScheduledOrAlreadyLive_NoEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlogRepo := repoMocks.NewMockBlogRepository(ctrl)
+	mockSubRepo := repoMocks.NewMockSubscriptionRepository(ctrl)
+	mockTagRepo := repoMocks.NewMockTagRepository(ctrl)
+	mockVersionService := serviceMocks.NewMockVersionService(ctrl)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepository(ctrl)
+
+	authorID := uuid.New()
+	ctx := context.Background()
+	s := service.NewBlogService(nil, mockBlogRepo, mockSubRepo, mockTagRepo, nil, mockVersionService, mockOutboxRepo, nil)
+
+	// Scheduled for the future
+	draft := &entity.Blog{ID: uuid.New(), AuthorID: authorID, Status: entity.BlogStatusDraft}
+	future := time.Now().Add(time.Hour)
+	mockBlogRepo.EXPECT().FindByID(ctx, draft.ID).Return(draft, nil)
+	mockBlogRepo.EXPECT().Update(ctx, draft).Return(nil)
+
+	hanoi := "Asia/Ho_Chi_Minh"
+	_, err := s.Publish(ctx, draft.ID, authorID, entity.BlogVisibilityPublic, &future, &hanoi)
+	assert.NoError(t, err)
+	assert.Equal(t, &hanoi, draft.PublishTimezone)
+
+	unknown := "Local"
+	_, err = s.Publish(ctx, draft.ID, authorID, entity.BlogVisibilityPublic, &future, &unknown)
+	assert.ErrorIs(t, err, service.ErrInvalidTimezone)
+
+	// Already live, only visibility changes
+	past := time.Now().Add(-time.Hour)
+	live := &entity.Blog{ID: uuid.New(), AuthorID: authorID, Status: entity.BlogStatusPublished, PublishedAt: &past}
+	mockBlogRepo.EXPECT().FindByID(ctx, live.ID).Return(live, nil)
+	mockBlogRepo.EXPECT().Update(ctx, live).Return(nil)
+
+	_, err = s.Publish(ctx, live.ID, authorID, entity.BlogVisibilitySubscribersOnly, nil, nil)
+	assert.NoError(t, err)
+}
+
+func TestBlogService_Publish_AnnouncedByJobMeanwhile_NoEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlogRepo := repoMocks.NewMockBlogRepository(ctrl)
+	mockOutboxRepo := repoMocks.NewMockOutboxRepository(ctrl)
+	mockVersionService := serviceMocks.NewMockVersionService(ctrl)
+
+	db, sqlMock, _ := sqlmock.New()
+	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+
+	authorID := uuid.New()
+	scheduledAt := time.Now().Add(time.Hour)
+	blog := &entity.Blog{ID: uuid.New(), AuthorID: authorID, Status: entity.BlogStatusPublished, PublishedAt: &scheduledAt}
+	ctx := context.Background()
+
+	// Publishing a scheduled post now loses the race against the publishing job
+	mockBlogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+	sqlMock.ExpectBegin()
+	mockBlogRepo.EXPECT().WithTx(gomock.Any()).Return(mockBlogRepo).Times(2)
+	mockBlogRepo.EXPECT().Update(ctx, blog).Return(nil)
+	mockBlogRepo.EXPECT().MarkAnnounced(ctx, blog.ID, gomock.Any(), gomock.Any()).Return(false, nil)
+	mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+	sqlMock.ExpectCommit()
+
+	s := service.NewBlogService(gormDB, mockBlogRepo, nil, nil, nil, mockVersionService, mockOutboxRepo, nil)
+
+	_, err := s.Publish(ctx, blog.ID, authorID, entity.BlogVisibilityPublic, nil, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestBlogService_Unpublish_ResetsAnnouncement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlogRepo := repoMocks.NewMockBlogRepository(ctrl)
+
+	db, sqlMock, _ := sqlmock.New()
+	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+
+	authorID := uuid.New()
+	past := time.Now().Add(-time.Hour)
+	blog := &entity.Blog{ID: uuid.New(), AuthorID: authorID, Status: entity.BlogStatusPublished, PublishedAt: &past, AnnouncedAt: &past}
+	ctx := context.Background()
+
+	mockBlogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+	sqlMock.ExpectBegin()
+	mockBlogRepo.EXPECT().WithTx(gomock.Any()).Return(mockBlogRepo).Times(2)
+	mockBlogRepo.EXPECT().Update(ctx, blog).Return(nil)
+	mockBlogRepo.EXPECT().ResetAnnouncement(ctx, blog.ID).Return(nil)
+	sqlMock.ExpectCommit()
+
+	s := service.NewBlogService(gormDB, mockBlogRepo, nil, nil, nil, nil, nil, nil)
+
+	unpublished, err := s.Unpublish(ctx, blog.ID, authorID)
+	assert.NoError(t, err)
+	assert.True(t, unpublished.IsDraft())
+	assert.Nil(t, unpublished.PublishedAt)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestBlogService_Reschedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlogRepo := repoMocks.NewMockBlogRepository(ctrl)
+	s := service.NewBlogService(nil, mockBlogRepo, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	authorID := uuid.New()
+	hanoi := "Asia/Ho_Chi_Minh"
+	newTime := time.Now().Add(48 * time.Hour)
+	scheduled := func() *entity.Blog {
+		at := time.Now().Add(time.Hour)
+		return &entity.Blog{ID: uuid.New(), AuthorID: authorID, Status: entity.BlogStatusPublished, PublishedAt: &at}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		blog := scheduled()
+		mockBlogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+		mockBlogRepo.EXPECT().Reschedule(ctx, blog.ID, newTime, &hanoi, gomock.Any()).Return(true, nil)
+
+		rescheduled, err := s.Reschedule(ctx, blog.ID, authorID, newTime, &hanoi)
+
+		assert.NoError(t, err)
+		assert.Equal(t, newTime, *rescheduled.PublishedAt)
+		assert.Equal(t, &hanoi, rescheduled.PublishTimezone)
+	})
+
+	t.Run("announced_meanwhile", func(t *testing.T) {
+		blog := scheduled()
+		mockBlogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+		// The publishing job announced the blog after it was loaded
+		mockBlogRepo.EXPECT().Reschedule(ctx, blog.ID, newTime, nil, gomock.Any()).Return(false, nil)
+
+		_, err := s.Reschedule(ctx, blog.ID, authorID, newTime, nil)
+
+		assert.ErrorIs(t, err, service.ErrBlogNotScheduled)
+	})
+
+	t.Run("time_in_the_past", func(t *testing.T) {
+		_, err := s.Reschedule(ctx, uuid.New(), authorID, time.Now().Add(-time.Minute), nil)
+
+		assert.ErrorIs(t, err, service.ErrInvalidPublishTime)
+	})
+
+	t.Run("unknown_timezone", func(t *testing.T) {
+		for _, tz := range []string{"Mars/Olympus_Mons", "", "Local"} {
+			_, err := s.Reschedule(ctx, uuid.New(), authorID, newTime, &tz)
+
+			assert.ErrorIs(t, err, service.ErrInvalidTimezone, tz)
+		}
+	})
+
+	t.Run("draft_or_live_or_announced", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+		announced := scheduled()
+		announced.AnnouncedAt = &past
+		for _, blog := range []*entity.Blog{
+			{ID: uuid.New(), AuthorID: authorID, Status: entity.BlogStatusDraft},
+			{ID: uuid.New(), AuthorID: authorID, Status: entity.BlogStatusPublished, PublishedAt: &past},
+			announced,
+		} {
+			mockBlogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+
+			_, err := s.Reschedule(ctx, blog.ID, authorID, newTime, nil)
+
+			assert.ErrorIs(t, err, service.ErrBlogNotScheduled)
+		}
+	})
+
+	t.Run("other_author", func(t *testing.T) {
+		blog := scheduled()
+		mockBlogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+
+		_, err := s.Reschedule(ctx, blog.ID, uuid.New(), newTime, nil)
+
+		assert.ErrorIs(t, err, service.ErrBlogAccessDenied)
+	})
+}
+
+func TestBlogService_CancelSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlogRepo := repoMocks.NewMockBlogRepository(ctrl)
+	s := service.NewBlogService(nil, mockBlogRepo, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	authorID := uuid.New()
+	at := time.Now().Add(time.Hour)
+	hanoi := "Asia/Ho_Chi_Minh"
+	scheduled := func() *entity.Blog {
+		return &entity.Blog{ID: uuid.New(), AuthorID: authorID, Status: entity.BlogStatusPublished, PublishedAt: &at, PublishTimezone: &hanoi}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		blog := scheduled()
+		mockBlogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+		mockBlogRepo.EXPECT().CancelSchedule(ctx, blog.ID, gomock.Any()).Return(true, nil)
+
+		cancelled, err := s.CancelSchedule(ctx, blog.ID, authorID)
+
+		assert.NoError(t, err)
+		assert.True(t, cancelled.IsDraft())
+		assert.Nil(t, cancelled.PublishedAt)
+		assert.Nil(t, cancelled.PublishTimezone)
+	})
+
+	t.Run("announced_meanwhile", func(t *testing.T) {
+		blog := scheduled()
+		mockBlogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+		mockBlogRepo.EXPECT().CancelSchedule(ctx, blog.ID, gomock.Any()).Return(false, nil)
+
+		_, err := s.CancelSchedule(ctx, blog.ID, authorID)
+
+		assert.ErrorIs(t, err, service.ErrBlogNotScheduled)
+	})
+}
+
+func TestBlogService_CheckAccess_PaidSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeriesAccess := serviceMocks.NewMockSeriesAccessService(ctrl)
+	s := service.NewBlogService(nil, nil, nil, nil, nil, nil, nil, mockSeriesAccess)
+
+	publishedAt := time.Now().Add(-time.Hour)
+	blog := &entity.Blog{
+		ID:          uuid.New(),
+		AuthorID:    uuid.New(),
+		Status:      entity.BlogStatusPublished,
+		Visibility:  entity.BlogVisibilityPublic,
+		PublishedAt: &publishedAt,
+	}
+	readerID := uuid.New()
+
+	t.Run("locked_until_bought", func(t *testing.T) {
+		mockSeriesAccess.EXPECT().LockingSeries(gomock.Any(), blog, &readerID).Return([]entity.Series{{ID: uuid.New()}}, nil)
+
+		assert.ErrorIs(t, s.CheckAccess(context.Background(), blog, &readerID), service.ErrSeriesPurchaseRequired)
+	})
+
+	t.Run("bought_or_preview", func(t *testing.T) {
+		mockSeriesAccess.EXPECT().LockingSeries(gomock.Any(), blog, &readerID).Return(nil, nil)
+
+		assert.NoError(t, s.CheckAccess(context.Background(), blog, &readerID))
+	})
+}
+
+func TestBlogService_List_FiltersAccessInQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlogRepo := repoMocks.NewMockBlogRepository(ctrl)
+	s := service.NewBlogService(nil, mockBlogRepo, nil, nil, nil, nil, nil, nil)
+
+	readerID := uuid.New()
+	pagination := repository.Pagination{Page: 2, PageSize: 10}
+	expected := &repository.PaginatedResult[entity.Blog]{Data: make([]entity.Blog, 10), Total: 25, Page: 2, PageSize: 10, TotalPages: 3}
+
+	// The repository counts and pages what the reader may see, so the result is returned as is
+	mockBlogRepo.EXPECT().FindAll(gomock.Any(), repository.BlogFilter{Access: &repository.BlogAccess{ViewerID: &readerID}}, pagination).
+		Return(expected, nil)
+
+	result, err := s.List(context.Background(), repository.BlogFilter{}, pagination, &readerID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestBlogService_ListPublished(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlogRepo := repoMocks.NewMockBlogRepository(ctrl)
+	s := service.NewBlogService(nil, mockBlogRepo, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	now := time.Now()
+	blogs := make([]entity.Blog, 3)
+	for i := range blogs {
+		publishedAt := now.Add(-time.Duration(i) * time.Minute)
+		blogs[i] = entity.Blog{ID: uuid.New(), PublishedAt: &publishedAt}
+	}
+	anonymous := repository.BlogFilter{Access: &repository.BlogAccess{}}
+
+	var nextCursor string
+	t.Run("first_page_has_next_cursor", func(t *testing.T) {
+		mockBlogRepo.EXPECT().FindPublishedAfter(ctx, anonymous, (*repository.BlogCursor)(nil), 3).Return(blogs, nil)
+
+		page, err := s.ListPublished(ctx, repository.BlogFilter{}, "", 2, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, blogs[:2], page.Data)
+		assert.NotEmpty(t, page.NextCursor)
+		nextCursor = page.NextCursor
+	})
+
+	t.Run("cursor_resumes_after_last_blog", func(t *testing.T) {
+		after := &repository.BlogCursor{PublishedAt: *blogs[1].PublishedAt, ID: blogs[1].ID}
+		mockBlogRepo.EXPECT().FindPublishedAfter(ctx, anonymous, gomock.Any(), 3).
+			DoAndReturn(func(_ context.Context, _ repository.BlogFilter, c *repository.BlogCursor, _ int) ([]entity.Blog, error) {
+				assert.True(t, after.PublishedAt.Equal(c.PublishedAt))
+				assert.Equal(t, after.ID, c.ID)
+				return blogs[2:], nil
+			})
+
+		page, err := s.ListPublished(ctx, repository.BlogFilter{}, nextCursor, 2, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Data, 1)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		_, err := s.ListPublished(ctx, repository.BlogFilter{}, "not-a-cursor", 2, nil)
+
+		assert.ErrorIs(t, err, service.ErrInvalidBlogCursor)
+	})
+}
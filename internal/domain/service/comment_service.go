@@ -5,6 +5,7 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
@@ -26,16 +27,32 @@ type CommentService interface {
 
 type commentService struct {
 	commentRepo repository.CommentRepository
+	events      EventBus
 }
 
-func NewCommentService(commentRepo repository.CommentRepository) CommentService {
+func NewCommentService(commentRepo repository.CommentRepository, events EventBus) CommentService {
 	return &commentService{
 		commentRepo: commentRepo,
+		events:      events,
 	}
 }
 
 func (s *commentService) Create(ctx context.Context, comment *entity.Comment) error {
-	return s.commentRepo.Create(ctx, comment)
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		return err
+	}
+
+	if s.events != nil {
+		s.events.Publish(ctx, CommentCreatedEvent{
+			CommentID:  comment.ID,
+			BlogID:     comment.BlogID,
+			UserID:     comment.UserID,
+			ParentID:   comment.ParentID,
+			OccurredAt: time.Now(),
+		})
+	}
+
+	return nil
 }
 
 func (s *commentService) GetByID(ctx context.Context, id uuid.UUID) (*entity.Comment, error) {
@@ -1,10 +1,62 @@
 package service_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+
+	"github.com/aiagent/internal/domain/entity"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 )
 
-// Tests were removed because the functionality they tested (notification on comment)
-// is not currently implemented in CommentService.
-func TestCommentService_Placeholder(t *testing.T) {
+func TestCommentService_Create_PublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCommentRepo := repoMocks.NewMockCommentRepository(ctrl)
+	mockBus := serviceMocks.NewMockEventBus(ctrl)
+
+	ctx := context.Background()
+	parentID := uuid.New()
+	comment := &entity.Comment{
+		ID:       uuid.New(),
+		BlogID:   uuid.New(),
+		UserID:   uuid.New(),
+		ParentID: &parentID,
+		Content:  "Nice post",
+	}
+
+	mockCommentRepo.EXPECT().Create(ctx, comment).Return(nil)
+	mockBus.EXPECT().Publish(ctx, gomock.Any()).Do(func(_ context.Context, event service.DomainEvent) {
+		e, ok := event.(service.CommentCreatedEvent)
+		assert.True(t, ok)
+		assert.Equal(t, comment.ID, e.CommentID)
+		assert.Equal(t, comment.BlogID, e.BlogID)
+		assert.Equal(t, comment.UserID, e.UserID)
+		assert.Equal(t, &parentID, e.ParentID)
+	})
+
+	s := service.NewCommentService(mockCommentRepo, mockBus)
+	assert.NoError(t, s.Create(ctx, comment))
+}
+
+func TestCommentService_Create_RepoError_NoEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCommentRepo := repoMocks.NewMockCommentRepository(ctrl)
+	mockBus := serviceMocks.NewMockEventBus(ctrl)
+
+	ctx := context.Background()
+	comment := &entity.Comment{BlogID: uuid.New(), UserID: uuid.New(), Content: "Nice post"}
+
+	mockCommentRepo.EXPECT().Create(ctx, comment).Return(errors.New("db error"))
+
+	s := service.NewCommentService(mockCommentRepo, mockBus)
+	assert.Error(t, s.Create(ctx, comment))
 }
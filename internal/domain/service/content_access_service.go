@@ -27,6 +27,8 @@ type AccessResult struct {
 	RequiredTier   entity.SubscriptionTier
 	Reason         string
 	UpgradeOptions []UpgradeOption
+	// SeriesOptions lists the paid series whose purchase unlocks the blog
+	SeriesOptions []SeriesOption
 }
 
 // UpgradeOption represents an available upgrade option for blocked content
@@ -35,4 +37,15 @@ type UpgradeOption struct {
 	Tier         entity.SubscriptionTier
 	Price        string
 	DurationDays int
+	// AmountDue is what the user pays for the plan, the price minus the credit for the
+	// unused days of their current tier
+	AmountDue string
+}
+
+// SeriesOption represents a paid series containing a locked blog
+type SeriesOption struct {
+	SeriesID uuid.UUID
+	Title    string
+	Price    string
+	Currency string
 }
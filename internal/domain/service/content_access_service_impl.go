@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
@@ -14,6 +15,7 @@ type contentAccessService struct {
 	tagTierService TagTierService
 	subRepo        repository.SubscriptionRepository
 	planRepo       repository.SubscriptionPlanRepository
+	seriesAccess   SeriesAccessService
 }
 
 // NewContentAccessService creates a new ContentAccessService instance
@@ -21,11 +23,13 @@ func NewContentAccessService(
 	tagTierService TagTierService,
 	subRepo repository.SubscriptionRepository,
 	planRepo repository.SubscriptionPlanRepository,
+	seriesAccess SeriesAccessService,
 ) ContentAccessService {
 	return &contentAccessService{
 		tagTierService: tagTierService,
 		subRepo:        subRepo,
 		planRepo:       planRepo,
+		seriesAccess:   seriesAccess,
 	}
 }
 
@@ -43,9 +47,10 @@ func (s *contentAccessService) CheckBlogAccess(
 
 	// Determine user tier (FREE for anonymous users)
 	userTier := entity.TierFree
+	var subscription *entity.Subscription
 	if userID != nil {
 		// For logged-in users, get their subscription tier for the blog's author
-		subscription, err := s.subRepo.FindActiveSubscription(ctx, *userID, authorID)
+		subscription, err = s.subRepo.FindActiveSubscription(ctx, *userID, authorID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get user tier: %w", err)
 		}
@@ -68,12 +73,30 @@ func (s *contentAccessService) CheckBlogAccess(
 		RequiredTier: requiredTier,
 	}
 
+	// Chapters of paid series also need a purchase, whatever the tier
+	locking, err := s.seriesAccess.LockingSeries(ctx, &entity.Blog{ID: blogID, AuthorID: authorID}, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check series purchase: %w", err)
+	}
+	if len(locking) > 0 {
+		result.Accessible = false
+		result.Reason = ErrSeriesPurchaseRequired.Error()
+		for _, series := range locking {
+			result.SeriesOptions = append(result.SeriesOptions, SeriesOption{
+				SeriesID: series.ID,
+				Title:    series.Title,
+				Price:    series.Price.String(),
+				Currency: series.Currency,
+			})
+		}
+	}
+
 	// Generate upgrade options if blocked
 	if !accessible && userID != nil {
-		result.UpgradeOptions = s.generateUpgradeOptions(ctx, authorID, requiredTier)
+		result.UpgradeOptions = s.generateUpgradeOptions(ctx, authorID, requiredTier, subscription)
 	} else if !accessible && userID == nil {
 		// For anonymous users, show all available plans
-		result.UpgradeOptions = s.generateUpgradeOptions(ctx, authorID, entity.TierFree)
+		result.UpgradeOptions = s.generateUpgradeOptions(ctx, authorID, entity.TierFree, nil)
 	}
 
 	return result, nil
@@ -104,11 +127,13 @@ func (s *contentAccessService) GetUserTier(
 	return tier, nil
 }
 
-// generateUpgradeOptions generates upgrade options from higher tiers when blocked
+// generateUpgradeOptions generates upgrade options from higher tiers when blocked.
+// The amount due is prorated against the user's current subscription, if any.
 func (s *contentAccessService) generateUpgradeOptions(
 	ctx context.Context,
 	authorID uuid.UUID,
 	requiredTier entity.SubscriptionTier,
+	subscription *entity.Subscription,
 ) []UpgradeOption {
 	// Get all active plans for the author
 	plans, err := s.planRepo.FindActiveByAuthor(ctx, authorID)
@@ -118,13 +143,21 @@ func (s *contentAccessService) generateUpgradeOptions(
 
 	// Filter plans with tier level >= required tier level (plans that would grant access)
 	var options []UpgradeOption
+	now := time.Now()
 	for _, plan := range plans {
 		if plan.Tier.Level() >= requiredTier.Level() && plan.IsActive {
+			amountDue := plan.Price
+			if subscription != nil {
+				if quote, err := quoteSubscription(ctx, s.planRepo, subscription, &plan, now); err == nil {
+					amountDue = quote.AmountDue
+				}
+			}
 			options = append(options, UpgradeOption{
 				PlanID:       plan.ID,
 				Tier:         plan.Tier,
 				Price:        plan.Price.String(),
 				DurationDays: plan.DurationDays,
+				AmountDue:    amountDue.String(),
 			})
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository/mocks"
@@ -12,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -22,8 +24,11 @@ func TestContentAccessService_CheckBlogAccess(t *testing.T) {
 	mockTagTierService := serviceMocks.NewMockTagTierService(ctrl)
 	mockSubRepo := mocks.NewMockSubscriptionRepository(ctrl)
 	mockPlanRepo := mocks.NewMockSubscriptionPlanRepository(ctrl)
+	mockSeriesAccess := serviceMocks.NewMockSeriesAccessService(ctrl)
+	// None of the tier cases belong to a paid series
+	mockSeriesAccess.EXPECT().LockingSeries(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
-	svc := service.NewContentAccessService(mockTagTierService, mockSubRepo, mockPlanRepo)
+	svc := service.NewContentAccessService(mockTagTierService, mockSubRepo, mockPlanRepo, mockSeriesAccess)
 
 	ctx := context.Background()
 	blogID := uuid.New()
@@ -199,6 +204,31 @@ func TestContentAccessService_CheckBlogAccess(t *testing.T) {
 		assert.NotEmpty(t, result.UpgradeOptions)
 	})
 
+	t.Run("upgrade_options_prorated_for_paid_subscriber", func(t *testing.T) {
+		// Half of a 10.00 Bronze period is left, so 5 is credited on every upgrade
+		expiresAt := time.Now().Add(15*24*time.Hour + time.Second)
+		bronzePlan := createPlan(bronzePlanID, entity.TierBronze, "10")
+		plans := []entity.SubscriptionPlan{
+			bronzePlan,
+			createPlan(silverPlanID, entity.TierSilver, "20"),
+			createPlan(goldPlanID, entity.TierGold, "30"),
+		}
+
+		mockTagTierService.EXPECT().GetRequiredTierForBlog(ctx, blogID).Return(entity.TierSilver, authorID, nil)
+		mockSubRepo.EXPECT().FindActiveSubscription(ctx, userID, authorID).
+			Return(&entity.Subscription{SubscriberID: userID, AuthorID: authorID, Tier: "BRONZE", ExpiresAt: &expiresAt}, nil)
+		mockPlanRepo.EXPECT().FindActiveByAuthor(ctx, authorID).Return(plans, nil)
+		mockPlanRepo.EXPECT().FindByAuthorAndTier(ctx, authorID, entity.TierBronze).Return(&bronzePlan, nil).Times(2)
+
+		result, err := svc.CheckBlogAccess(ctx, blogID, &userID)
+
+		assert.NoError(t, err)
+		require.Len(t, result.UpgradeOptions, 2)
+		assert.Equal(t, "20", result.UpgradeOptions[0].Price)
+		assert.Equal(t, "15", result.UpgradeOptions[0].AmountDue)
+		assert.Equal(t, "25", result.UpgradeOptions[1].AmountDue)
+	})
+
 	t.Run("no_upgrade_options_when_accessible", func(t *testing.T) {
 		// Setup: GOLD user accessing GOLD content (accessible)
 		sub := &entity.Subscription{
@@ -314,7 +344,7 @@ func TestContentAccessService_GetUserTier(t *testing.T) {
 	mockSubRepo := mocks.NewMockSubscriptionRepository(ctrl)
 	mockPlanRepo := mocks.NewMockSubscriptionPlanRepository(ctrl)
 
-	svc := service.NewContentAccessService(mockTagTierService, mockSubRepo, mockPlanRepo)
+	svc := service.NewContentAccessService(mockTagTierService, mockSubRepo, mockPlanRepo, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
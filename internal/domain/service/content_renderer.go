@@ -0,0 +1,70 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/infrastructure/cache"
+	"github.com/aiagent/pkg/logger"
+	"github.com/aiagent/pkg/markdown"
+)
+
+const (
+	// ExcerptMaxLength is the length of excerpts generated for blogs without one
+	ExcerptMaxLength = 200
+
+	renderedContentCacheTTL = 24 * time.Hour
+)
+
+// ContentRenderer renders blog Markdown to sanitized HTML for display
+type ContentRenderer interface {
+	// Render returns the rendered content of a blog, from cache when this version was rendered before
+	Render(ctx context.Context, blog *entity.Blog) (*markdown.Document, error)
+}
+
+type contentRenderer struct {
+	cache cache.Cache
+}
+
+// NewContentRenderer creates a new instance of ContentRenderer
+func NewContentRenderer(cache cache.Cache) ContentRenderer {
+	return &contentRenderer{cache: cache}
+}
+
+// Render caches under a hash of the content, so every saved version of a blog gets its own
+// entry without invalidation and restoring an earlier version hits the cache again. Cache
+// failures only cost a render.
+func (r *contentRenderer) Render(ctx context.Context, blog *entity.Blog) (*markdown.Document, error) {
+	key := renderedContentCacheKey(blog)
+
+	var cached markdown.Document
+	if err := r.cache.Get(ctx, key, &cached); err == nil {
+		return &cached, nil
+	}
+
+	doc, err := markdown.Render(blog.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render blog content: %w", err)
+	}
+
+	if err := r.cache.Set(ctx, key, doc, renderedContentCacheTTL); err != nil {
+		logger.Error("failed to cache rendered blog content", err, map[string]interface{}{"blog_id": blog.ID})
+	}
+	return doc, nil
+}
+
+func renderedContentCacheKey(blog *entity.Blog) string {
+	sum := sha256.Sum256([]byte(blog.Content))
+	return fmt.Sprintf("blog_content:%s:%s", blog.ID, hex.EncodeToString(sum[:16]))
+}
+
+// GenerateExcerpt returns the excerpt shown for a blog whose author did not write one
+func GenerateExcerpt(content string) string {
+	return markdown.Excerpt(content, ExcerptMaxLength)
+}
@@ -0,0 +1,84 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	cacheMocks "github.com/aiagent/internal/infrastructure/cache/mocks"
+	"github.com/aiagent/pkg/markdown"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestContentRenderer_Render(t *testing.T) {
+	ctx := context.Background()
+	blog := &entity.Blog{ID: uuid.New(), Content: "## Intro\n\nHello <b>there</b>"}
+
+	t.Run("renders_and_caches_on_miss", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCache := cacheMocks.NewMockCache(ctrl)
+
+		var key string
+		mockCache.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, k string, _ interface{}) error {
+				key = k
+				return errors.New("redis: nil")
+			})
+		mockCache.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, k string, _ interface{}, _ interface{}) error {
+				assert.Equal(t, key, k)
+				return nil
+			})
+
+		doc, err := service.NewContentRenderer(mockCache).Render(ctx, blog)
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(key, "blog_content:"+blog.ID.String()+":"))
+		assert.Equal(t, `<h2 id="intro">Intro</h2>`+"\n<p>Hello there</p>\n", doc.HTML)
+		assert.Equal(t, []markdown.Heading{{Level: 2, ID: "intro", Text: "Intro"}}, doc.TOC)
+		assert.Equal(t, 1, doc.ReadingTimeMinutes)
+	})
+
+	t.Run("serves_cached_version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCache := cacheMocks.NewMockCache(ctrl)
+
+		mockCache.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest interface{}) error {
+				*dest.(*markdown.Document) = markdown.Document{HTML: "<p>cached</p>"}
+				return nil
+			})
+
+		doc, err := service.NewContentRenderer(mockCache).Render(ctx, blog)
+
+		require.NoError(t, err)
+		assert.Equal(t, "<p>cached</p>", doc.HTML)
+	})
+
+	t.Run("new_content_uses_new_key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCache := cacheMocks.NewMockCache(ctrl)
+
+		var keys []string
+		mockCache.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, k string, _ interface{}) error {
+				keys = append(keys, k)
+				return errors.New("redis: nil")
+			}).Times(2)
+		mockCache.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection refused")).Times(2)
+
+		renderer := service.NewContentRenderer(mockCache)
+		_, err := renderer.Render(ctx, blog)
+		require.NoError(t, err)
+		_, err = renderer.Render(ctx, &entity.Blog{ID: blog.ID, Content: blog.Content + "!"})
+		require.NoError(t, err)
+
+		assert.NotEqual(t, keys[0], keys[1])
+	})
+}
@@ -2,14 +2,27 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
+// PaymentReceipt holds the details printed on a payment receipt email
+type PaymentReceipt struct {
+	OrderID     string
+	Description string
+	Amount      decimal.Decimal
+	Currency    string
+	PaidAt      time.Time
+}
+
 // EmailService defines the domain service for sending emails
 type EmailService interface {
 	SendNotification(ctx context.Context, userID uuid.UUID, notifType entity.NotificationType, data map[string]interface{}) error
 	SendWelcomeEmail(ctx context.Context, userID uuid.UUID, email string, name string) error
 	SendVerificationEmail(ctx context.Context, userID uuid.UUID, email string, token string) error
+	SendPasswordResetEmail(ctx context.Context, userID uuid.UUID, email string, token string, expiresIn time.Duration) error
+	SendPaymentReceipt(ctx context.Context, email string, name string, receipt PaymentReceipt) error
 }
@@ -5,7 +5,10 @@ import (
 	"context"
 	"fmt"
 	"html/template"
+	"net/url"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
@@ -19,21 +22,25 @@ type emailServiceImpl struct {
 	provider    adapter.EmailProvider
 	taskRunner  TaskRunner
 	templateDir string
+	frontendURL string
 	templates   map[string]*template.Template
 }
 
-// NewEmailServiceImpl creates a new implementation of EmailService
+// NewEmailServiceImpl creates a new implementation of EmailService;
+// links in emails point to pages of the web app served at frontendURL
 func NewEmailServiceImpl(
 	userRepo repository.UserRepository,
 	provider adapter.EmailProvider,
 	taskRunner TaskRunner,
 	templateDir string,
+	frontendURL string,
 ) EmailService {
 	s := &emailServiceImpl{
 		userRepo:    userRepo,
 		provider:    provider,
 		taskRunner:  taskRunner,
 		templateDir: templateDir,
+		frontendURL: strings.TrimRight(frontendURL, "/"),
 		templates:   make(map[string]*template.Template),
 	}
 
@@ -118,12 +125,20 @@ func (s *emailServiceImpl) SendNotification(ctx context.Context, userID uuid.UUI
 	return nil
 }
 
+// link builds an absolute URL to a page of the web app
+func (s *emailServiceImpl) link(path string, query url.Values) string {
+	if len(query) == 0 {
+		return s.frontendURL + path
+	}
+	return s.frontendURL + path + "?" + query.Encode()
+}
+
 func (s *emailServiceImpl) SendWelcomeEmail(ctx context.Context, userID uuid.UUID, email string, name string) error {
 	subject := "Welcome to AI Agent!"
 
 	tmplData := map[string]interface{}{
 		"Name":   name,
-		"AppURL": "https://aiagent.com/get-started",
+		"AppURL": s.link("/get-started", nil),
 	}
 
 	htmlBody, textBody, err := s.renderTemplate("welcome.html", tmplData)
@@ -138,7 +153,7 @@ func (s *emailServiceImpl) SendVerificationEmail(ctx context.Context, userID uui
 	subject := "Verify your email address"
 
 	tmplData := map[string]interface{}{
-		"VerificationURL": fmt.Sprintf("https://aiagent.com/verify?token=%s", token),
+		"VerificationURL": s.link("/verify", url.Values{"token": {token}}),
 	}
 
 	htmlBody, textBody, err := s.renderTemplate("verification.html", tmplData)
@@ -149,6 +164,54 @@ func (s *emailServiceImpl) SendVerificationEmail(ctx context.Context, userID uui
 	return s.provider.Send(ctx, []string{email}, subject, htmlBody, textBody)
 }
 
+func (s *emailServiceImpl) SendPasswordResetEmail(ctx context.Context, userID uuid.UUID, email string, token string, expiresIn time.Duration) error {
+	subject := "Reset your password"
+
+	tmplData := map[string]interface{}{
+		"ResetURL":  s.link("/reset-password", url.Values{"token": {token}}),
+		"ExpiresIn": humanizeDuration(expiresIn),
+	}
+
+	htmlBody, textBody, err := s.renderTemplate("password_reset.html", tmplData)
+	if err != nil {
+		return fmt.Errorf("failed to render password reset email: %w", err)
+	}
+
+	return s.provider.Send(ctx, []string{email}, subject, htmlBody, textBody)
+}
+
+func (s *emailServiceImpl) SendPaymentReceipt(ctx context.Context, email string, name string, receipt PaymentReceipt) error {
+	subject := fmt.Sprintf("Your receipt for order %s", receipt.OrderID)
+
+	tmplData := map[string]interface{}{
+		"Name":        name,
+		"OrderID":     receipt.OrderID,
+		"Description": receipt.Description,
+		"Amount":      receipt.Amount.String(),
+		"Currency":    receipt.Currency,
+		"PaidAt":      receipt.PaidAt.Format("02 Jan 2006 15:04 MST"),
+	}
+
+	htmlBody, textBody, err := s.renderTemplate("receipt.html", tmplData)
+	if err != nil {
+		return fmt.Errorf("failed to render receipt email: %w", err)
+	}
+
+	return s.provider.Send(ctx, []string{email}, subject, htmlBody, textBody)
+}
+
+// humanizeDuration renders a token lifetime for email copy, e.g. "1 hour" or "30 minutes"
+func humanizeDuration(d time.Duration) string {
+	unit, n := "minute", int(d.Minutes())
+	if d >= time.Hour && d%time.Hour == 0 {
+		unit, n = "hour", int(d.Hours())
+	}
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
 func (s *emailServiceImpl) renderTemplate(tmplName string, data interface{}) (string, string, error) {
 	tmpl, ok := s.templates[tmplName]
 	if !ok {
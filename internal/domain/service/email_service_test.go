@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
@@ -33,7 +34,7 @@ func TestEmailService_SendWelcomeEmail(t *testing.T) {
 	wd, _ := os.Getwd()
 	templateDir := filepath.Join(wd, "../../infrastructure/email/templates")
 
-	service := NewEmailServiceImpl(mockUserRepo, mockProvider, taskRunner, templateDir)
+	service := NewEmailServiceImpl(mockUserRepo, mockProvider, taskRunner, templateDir, "https://app.example.com/")
 
 	userID := uuid.New()
 	email := "test@example.com"
@@ -58,7 +59,7 @@ func TestEmailService_SendNotification(t *testing.T) {
 	wd, _ := os.Getwd()
 	templateDir := filepath.Join(wd, "../../infrastructure/email/templates")
 
-	service := NewEmailServiceImpl(mockUserRepo, mockProvider, taskRunner, templateDir)
+	service := NewEmailServiceImpl(mockUserRepo, mockProvider, taskRunner, templateDir, "https://app.example.com/")
 
 	userID := uuid.New()
 	user := &entity.User{
@@ -94,7 +95,7 @@ func TestEmailService_SendVerificationEmail(t *testing.T) {
 	wd, _ := os.Getwd()
 	templateDir := filepath.Join(wd, "../../infrastructure/email/templates")
 
-	service := NewEmailServiceImpl(mockUserRepo, mockProvider, taskRunner, templateDir)
+	service := NewEmailServiceImpl(mockUserRepo, mockProvider, taskRunner, templateDir, "https://app.example.com/")
 
 	userID := uuid.New()
 	email := "test@example.com"
@@ -107,3 +108,31 @@ func TestEmailService_SendVerificationEmail(t *testing.T) {
 	err := service.SendVerificationEmail(context.Background(), userID, email, token)
 	assert.NoError(t, err)
 }
+
+func TestEmailService_SendPasswordResetEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repoMocks.NewMockUserRepository(ctrl)
+	mockProvider := adapterMocks.NewMockEmailProvider(ctrl)
+	taskRunner := &syncTaskRunner{}
+
+	wd, _ := os.Getwd()
+	templateDir := filepath.Join(wd, "../../infrastructure/email/templates")
+
+	service := NewEmailServiceImpl(mockUserRepo, mockProvider, taskRunner, templateDir, "https://app.example.com/")
+
+	email := "test@example.com"
+	token := "reset-123"
+
+	mockProvider.EXPECT().
+		Send(gomock.Any(), []string{email}, "Reset your password", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []string, _ string, htmlBody string, textBody string) error {
+			assert.Contains(t, htmlBody, "https://app.example.com/reset-password?token="+token)
+			assert.Contains(t, textBody, "1 hour")
+			return nil
+		})
+
+	err := service.SendPasswordResetEmail(context.Background(), uuid.New(), email, token, time.Hour)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,188 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// EventName identifies a kind of domain event
+type EventName string
+
+const (
+	EventUserFollowed              EventName = "user.followed"
+	EventCommentCreated            EventName = "comment.created"
+	EventBlogPublished             EventName = "blog.published"
+	EventBlogFanOut                EventName = "blog.fan_out"
+	EventPaymentSucceeded          EventName = "payment.succeeded"
+	EventSubscriptionExpiryUpdated EventName = "subscription.expiry_updated"
+)
+
+// DomainEvent is implemented by every event published on the EventBus
+type DomainEvent interface {
+	Name() EventName
+}
+
+// UserFollowedEvent is emitted when a user follows (subscribes to) another user
+type UserFollowedEvent struct {
+	FollowerID uuid.UUID `json:"followerId"`
+	FolloweeID uuid.UUID `json:"followeeId"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// Name returns the event name
+func (UserFollowedEvent) Name() EventName { return EventUserFollowed }
+
+// CommentCreatedEvent is emitted when a comment or a reply is posted on a blog
+type CommentCreatedEvent struct {
+	CommentID  uuid.UUID  `json:"commentId"`
+	BlogID     uuid.UUID  `json:"blogId"`
+	UserID     uuid.UUID  `json:"userId"`
+	ParentID   *uuid.UUID `json:"parentId,omitempty"`
+	OccurredAt time.Time  `json:"occurredAt"`
+}
+
+// Name returns the event name
+func (CommentCreatedEvent) Name() EventName { return EventCommentCreated }
+
+// BlogPublishedEvent is emitted when a blog becomes visible to readers
+type BlogPublishedEvent struct {
+	BlogID      uuid.UUID `json:"blogId"`
+	AuthorID    uuid.UUID `json:"authorId"`
+	Title       string    `json:"title"`
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+// Name returns the event name
+func (BlogPublishedEvent) Name() EventName { return EventBlogPublished }
+
+// BlogFanOutEvent carries one batch of followers to notify about a published blog
+type BlogFanOutEvent struct {
+	BlogID      uuid.UUID   `json:"blogId"`
+	AuthorID    uuid.UUID   `json:"authorId"`
+	Title       string      `json:"title"`
+	AuthorName  string      `json:"authorName"`
+	FollowerIDs []uuid.UUID `json:"followerIds"`
+}
+
+// Name returns the event name
+func (BlogFanOutEvent) Name() EventName { return EventBlogFanOut }
+
+// PaymentSucceededEvent is emitted when a payment is confirmed and its benefit granted
+type PaymentSucceededEvent struct {
+	TransactionID uuid.UUID              `json:"transactionId"`
+	UserID        uuid.UUID              `json:"userId"`
+	Type          entity.TransactionType `json:"type"`
+	TargetID      *uuid.UUID             `json:"targetId,omitempty"`
+	Amount        decimal.Decimal        `json:"amount"`
+	Currency      string                 `json:"currency"`
+	OrderID       string                 `json:"orderId"`
+	PaidAt        time.Time              `json:"paidAt"`
+}
+
+// Name returns the event name
+func (PaymentSucceededEvent) Name() EventName { return EventPaymentSucceeded }
+
+// SubscriptionExpiryUpdatedEvent is emitted when a paid subscription's tier or expiry changes
+type SubscriptionExpiryUpdatedEvent struct {
+	SubscriberID  uuid.UUID  `json:"subscriberId"`
+	AuthorID      uuid.UUID  `json:"authorId"`
+	Tier          string     `json:"tier"`
+	ExpiresAt     time.Time  `json:"expiresAt"`
+	TransactionID *uuid.UUID `json:"transactionId,omitempty"`
+}
+
+// Name returns the event name
+func (SubscriptionExpiryUpdatedEvent) Name() EventName { return EventSubscriptionExpiryUpdated }
+
+// EventHandler handles a single domain event
+type EventHandler func(ctx context.Context, event DomainEvent) error
+
+// EventBus is an in-process publish/subscribe bus for domain events.
+// Handlers run asynchronously so publishers never wait on side effects.
+type EventBus interface {
+	Publish(ctx context.Context, event DomainEvent)
+	// Handlers returns the names of the handlers subscribed to an event
+	Handlers(name EventName) []string
+	// Dispatch runs the named handler inline and returns its error, letting durable
+	// callers (the outbox relay) record and retry each handler's delivery on its own
+	Dispatch(ctx context.Context, event DomainEvent, handler string) error
+	// Subscribe registers fn under a handler name that is unique for the event. The name
+	// is stored with outbox deliveries, so it must not change between releases.
+	Subscribe(name EventName, handler string, fn EventHandler)
+}
+
+// subscription is a handler registered for an event
+type subscription struct {
+	name string
+	fn   EventHandler
+}
+
+type eventBus struct {
+	mu         sync.RWMutex
+	handlers   map[EventName][]subscription
+	taskRunner TaskRunner
+}
+
+// NewEventBus creates a new EventBus that dispatches handlers through the TaskRunner
+func NewEventBus(taskRunner TaskRunner) EventBus {
+	return &eventBus{
+		handlers:   make(map[EventName][]subscription),
+		taskRunner: taskRunner,
+	}
+}
+
+// Subscribe registers a handler for the given event name
+func (b *eventBus) Subscribe(name EventName, handler string, fn EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[name] = append(b.handlers[name], subscription{name: handler, fn: fn})
+}
+
+// subscriptionsFor returns a snapshot of the handlers registered for an event
+func (b *eventBus) subscriptionsFor(name EventName) []subscription {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return append([]subscription(nil), b.handlers[name]...)
+}
+
+// Handlers returns the names of the handlers registered for an event
+func (b *eventBus) Handlers(name EventName) []string {
+	subs := b.subscriptionsFor(name)
+	names := make([]string, 0, len(subs))
+	for _, sub := range subs {
+		names = append(names, sub.name)
+	}
+	return names
+}
+
+// Publish hands the event to every registered handler in the background.
+// The request context is not propagated because handlers outlive the request.
+func (b *eventBus) Publish(_ context.Context, event DomainEvent) {
+	for _, sub := range b.subscriptionsFor(event.Name()) {
+		sub := sub
+		b.taskRunner.Submit(func(ctx context.Context) {
+			if err := sub.fn(ctx, event); err != nil {
+				logger.Error("domain event handler failed", err, map[string]interface{}{"event": event.Name(), "handler": sub.name})
+			}
+		})
+	}
+}
+
+// Dispatch runs the named handler for the event in the caller's goroutine
+func (b *eventBus) Dispatch(ctx context.Context, event DomainEvent, handler string) error {
+	for _, sub := range b.subscriptionsFor(event.Name()) {
+		if sub.name == handler {
+			return sub.fn(ctx, event)
+		}
+	}
+	return fmt.Errorf("no handler %q for event %s", handler, event.Name())
+}
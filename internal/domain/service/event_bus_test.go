@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestEventBus_PublishDispatchesToSubscribers(t *testing.T) {
+	bus := NewEventBus(NewTaskRunner(time.Second))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	var mu sync.Mutex
+	received := make([]EventName, 0, 2)
+	handler := func(ctx context.Context, event DomainEvent) error {
+		mu.Lock()
+		received = append(received, event.Name())
+		mu.Unlock()
+		wg.Done()
+		return nil
+	}
+	bus.Subscribe(EventUserFollowed, "record", handler)
+	bus.Subscribe(EventUserFollowed, "fail", func(ctx context.Context, event DomainEvent) error {
+		defer wg.Done()
+		return errors.New("handler errors are logged, not propagated")
+	})
+	bus.Subscribe(EventBlogPublished, "other", func(ctx context.Context, event DomainEvent) error {
+		t.Error("handler for another event must not be called")
+		return nil
+	})
+
+	bus.Publish(context.Background(), UserFollowedEvent{FollowerID: uuid.New(), FolloweeID: uuid.New()})
+	wg.Wait()
+
+	if len(received) != 1 || received[0] != EventUserFollowed {
+		t.Errorf("unexpected events received: %v", received)
+	}
+}
+
+func TestEventBus_PublishWithoutSubscribers(t *testing.T) {
+	bus := NewEventBus(NewTaskRunner(time.Second))
+	bus.Publish(context.Background(), BlogPublishedEvent{BlogID: uuid.New()})
+}
+
+func TestEventBus_DispatchRunsNamedHandler(t *testing.T) {
+	bus := NewEventBus(NewTaskRunner(time.Second))
+
+	var called []string
+	bus.Subscribe(EventBlogPublished, "first", func(ctx context.Context, event DomainEvent) error {
+		called = append(called, "first")
+		return nil
+	})
+	bus.Subscribe(EventBlogPublished, "second", func(ctx context.Context, event DomainEvent) error {
+		called = append(called, "second")
+		return errors.New("boom")
+	})
+
+	if names := bus.Handlers(EventBlogPublished); len(names) != 2 || names[0] != "first" || names[1] != "second" {
+		t.Errorf("unexpected handlers: %v", names)
+	}
+	if err := bus.Dispatch(context.Background(), BlogPublishedEvent{}, "second"); err == nil {
+		t.Error("expected the handler error")
+	}
+	if len(called) != 1 || called[0] != "second" {
+		t.Errorf("only the named handler must run, got %v", called)
+	}
+	if err := bus.Dispatch(context.Background(), BlogPublishedEvent{}, "missing"); err == nil {
+		t.Error("expected an error for an unknown handler")
+	}
+}
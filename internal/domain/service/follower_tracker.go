@@ -0,0 +1,109 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/valueobject"
+	"github.com/aiagent/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// Column limits of follower_events
+const (
+	maxIPAddressLength = 45
+	maxReferrerLength  = 500
+)
+
+// FollowerTracker records follow actions as FollowerEvents and screens them for bots
+type FollowerTracker interface {
+	// RecordFollow stores the follow with its client metadata and
+	// analyzes it against the followee's recent events in the background
+	RecordFollow(ctx context.Context, followerID, followingID uuid.UUID, client valueobject.ClientInfo) error
+}
+
+type followerTracker struct {
+	repo       FraudDetectionRepository
+	algorithm  BotDetectionAlgorithm
+	config     *BotDetectionConfig
+	taskRunner TaskRunner
+}
+
+// NewFollowerTracker creates a new FollowerTracker
+func NewFollowerTracker(
+	repo FraudDetectionRepository,
+	algorithm BotDetectionAlgorithm,
+	config *BotDetectionConfig,
+	taskRunner TaskRunner,
+) FollowerTracker {
+	if config == nil {
+		config = DefaultBotDetectionConfig()
+	}
+	return &followerTracker{
+		repo:       repo,
+		algorithm:  algorithm,
+		config:     config,
+		taskRunner: taskRunner,
+	}
+}
+
+// RecordFollow stores the follower event and schedules bot analysis
+func (t *followerTracker) RecordFollow(ctx context.Context, followerID, followingID uuid.UUID, client valueobject.ClientInfo) error {
+	now := time.Now()
+	event := entity.FollowerEvent{
+		ID:          uuid.New(),
+		FollowerID:  followerID,
+		FollowingID: followingID,
+		Timestamp:   now,
+		IPAddress:   truncateString(client.IPAddress, maxIPAddressLength),
+		UserAgent:   client.UserAgent,
+		Referrer:    truncateString(client.Referrer, maxReferrerLength),
+		CreatedAt:   now,
+	}
+	if err := t.repo.CreateFollowerEvent(ctx, &event); err != nil {
+		return err
+	}
+
+	t.taskRunner.Submit(func(ctx context.Context) {
+		t.analyze(ctx, event)
+	})
+	return nil
+}
+
+// analyze runs the bot detection rules for one follow and stores any signals found
+func (t *followerTracker) analyze(ctx context.Context, event entity.FollowerEvent) {
+	fields := map[string]interface{}{"follower_id": event.FollowerID, "following_id": event.FollowingID}
+
+	from := event.Timestamp.Add(-t.config.RapidFollowTimeWindow)
+	recentEvents, err := t.repo.GetFollowerEventsByUser(ctx, event.FollowingID, &from, nil)
+	if err != nil {
+		logger.Error("failed to load recent follower events", err, fields)
+		return
+	}
+
+	signals, err := t.algorithm.AnalyzeFollower(ctx, event, recentEvents)
+	if err != nil {
+		// Signals found before the failure are still worth keeping
+		logger.Error("follower analysis failed", err, fields)
+	}
+
+	for i := range signals {
+		if err := t.repo.CreateBotSignal(ctx, &signals[i]); err != nil {
+			logger.Error("failed to store bot detection signal", err, map[string]interface{}{
+				"user_id":     signals[i].UserID,
+				"signal_type": signals[i].SignalType,
+			})
+		}
+	}
+}
+
+// truncateString shortens s to at most max bytes
+func truncateString(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
@@ -0,0 +1,84 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/aiagent/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFollowerTracker_RecordFollow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := serviceMocks.NewMockFraudDetectionRepository(ctrl)
+	mockAlgorithm := serviceMocks.NewMockBotDetectionAlgorithm(ctrl)
+	mockTaskRunner := serviceMocks.NewMockTaskRunner(ctrl)
+	mockTaskRunner.EXPECT().Submit(gomock.Any()).AnyTimes().Do(func(task func(ctx context.Context)) {
+		task(context.Background())
+	})
+
+	tracker := service.NewFollowerTracker(mockRepo, mockAlgorithm, nil, mockTaskRunner)
+
+	ctx := context.Background()
+	followerID := uuid.New()
+	followingID := uuid.New()
+	client := valueobject.ClientInfo{
+		IPAddress: "203.0.113.7",
+		UserAgent: "Mozilla/5.0",
+		Referrer:  "https://example.com/" + strings.Repeat("a", 600),
+	}
+
+	t.Run("stores_event_and_signals", func(t *testing.T) {
+		var stored entity.FollowerEvent
+		recent := []entity.FollowerEvent{{FollowerID: uuid.New(), FollowingID: followingID}}
+		signal := entity.BotDetectionSignal{ID: uuid.New(), UserID: followerID, SignalType: "ip_cluster"}
+
+		mockRepo.EXPECT().CreateFollowerEvent(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.FollowerEvent) error {
+			stored = *e
+			return nil
+		})
+		mockRepo.EXPECT().GetFollowerEventsByUser(gomock.Any(), followingID, gomock.Not(gomock.Nil()), gomock.Nil()).Return(recent, nil)
+		mockAlgorithm.EXPECT().AnalyzeFollower(gomock.Any(), gomock.Any(), recent).Return([]entity.BotDetectionSignal{signal}, nil)
+		mockRepo.EXPECT().CreateBotSignal(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *entity.BotDetectionSignal) error {
+			assert.Equal(t, signal.ID, s.ID)
+			return nil
+		})
+
+		err := tracker.RecordFollow(ctx, followerID, followingID, client)
+
+		assert.NoError(t, err)
+		assert.Equal(t, followerID, stored.FollowerID)
+		assert.Equal(t, followingID, stored.FollowingID)
+		assert.Equal(t, "203.0.113.7", stored.IPAddress)
+		assert.Equal(t, "Mozilla/5.0", stored.UserAgent)
+		assert.Len(t, stored.Referrer, 500)
+		assert.False(t, stored.Timestamp.IsZero())
+	})
+
+	t.Run("keeps_partial_signals_on_analysis_error", func(t *testing.T) {
+		signal := entity.BotDetectionSignal{ID: uuid.New(), UserID: followerID, SignalType: "rapid_follows"}
+
+		mockRepo.EXPECT().CreateFollowerEvent(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().GetFollowerEventsByUser(gomock.Any(), followingID, gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockAlgorithm.EXPECT().AnalyzeFollower(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]entity.BotDetectionSignal{signal}, errors.New("ip lookup failed"))
+		mockRepo.EXPECT().CreateBotSignal(gomock.Any(), gomock.Any()).Return(nil)
+
+		assert.NoError(t, tracker.RecordFollow(ctx, followerID, followingID, client))
+	})
+
+	t.Run("create_error_skips_analysis", func(t *testing.T) {
+		mockRepo.EXPECT().CreateFollowerEvent(ctx, gomock.Any()).Return(errors.New("db down"))
+
+		assert.Error(t, tracker.RecordFollow(ctx, followerID, followingID, client))
+	})
+}
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
 	"github.com/aiagent/internal/domain/valueobject"
+	"github.com/aiagent/pkg/logger"
 	"github.com/google/uuid"
 )
 
@@ -39,17 +41,21 @@ const (
 
 // fraudDetectionService implements the FraudDetectionService interface
 type fraudDetectionService struct {
-	repo     FraudDetectionRepository
-	notifier NotificationService
-	batchJob BatchJobService
+	repo        FraudDetectionRepository
+	notifier    NotificationService
+	batchJob    BatchJobService
+	sessionRepo repository.SessionRepository
+	tokenRepo   repository.APITokenRepository
 }
 
 // NewFraudDetectionService creates a new fraud detection service instance
-func NewFraudDetectionService(repo FraudDetectionRepository, notifier NotificationService, batchJob BatchJobService) FraudDetectionService {
+func NewFraudDetectionService(repo FraudDetectionRepository, notifier NotificationService, batchJob BatchJobService, sessionRepo repository.SessionRepository, tokenRepo repository.APITokenRepository) FraudDetectionService {
 	return &fraudDetectionService{
-		repo:     repo,
-		notifier: notifier,
-		batchJob: batchJob,
+		repo:        repo,
+		notifier:    notifier,
+		batchJob:    batchJob,
+		sessionRepo: sessionRepo,
+		tokenRepo:   tokenRepo,
 	}
 }
 
@@ -219,6 +225,17 @@ func (s *fraudDetectionService) BanUser(ctx context.Context, adminID, userID uui
 		return nil, err
 	}
 
+	// Sign the user out of every device right away instead of waiting for sessions to expire.
+	// The ban is already recorded, so a failure here is logged for follow-up rather than
+	// reported as a failed ban the admin would retry.
+	fields := map[string]interface{}{"user_id": userID, "review_id": review.ID}
+	if err := s.sessionRepo.DeleteUserSessions(ctx, userID.String(), ""); err != nil {
+		logger.Error("failed to revoke sessions of banned user", err, fields)
+	}
+	if err := s.tokenRepo.RevokeAllByUser(ctx, userID); err != nil {
+		logger.Error("failed to revoke API tokens of banned user", err, fields)
+	}
+
 	return &valueobject.BanUserResult{
 		ReviewID: review.ID,
 		UserID:   review.UserID,
@@ -324,6 +341,16 @@ func (s *fraudDetectionService) TriggerBatchAnalysis(ctx context.Context, cmd va
 	}, nil
 }
 
+// ListBatchJobs retrieves the fraud batch analysis jobs
+func (s *fraudDetectionService) ListBatchJobs(ctx context.Context, filter valueobject.BatchJobFilter) (*valueobject.BatchJobListResult, error) {
+	return s.batchJob.ListBatchJobs(ctx, filter)
+}
+
+// CancelBatchJob cancels a running fraud batch analysis job
+func (s *fraudDetectionService) CancelBatchJob(ctx context.Context, jobID uuid.UUID) (*valueobject.BatchAnalyzeResult, error) {
+	return s.batchJob.CancelBatchJob(ctx, jobID)
+}
+
 // GetUserBadgeStatus retrieves the badge status for a user
 func (s *fraudDetectionService) GetUserBadgeStatus(ctx context.Context, userID uuid.UUID) (*valueobject.UserBadgeResult, error) {
 	badge, err := s.repo.GetBadgeStatusByUser(ctx, userID)
@@ -36,6 +36,12 @@ type FraudDetectionService interface {
 
 	// GetUserBotNotifications retrieves notifications about flagged bot followers
 	GetUserBotNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool) ([]valueobject.BotFollowerNotificationResult, error)
+
+	// ListBatchJobs retrieves the fraud batch analysis jobs
+	ListBatchJobs(ctx context.Context, filter valueobject.BatchJobFilter) (*valueobject.BatchJobListResult, error)
+
+	// CancelBatchJob cancels a running fraud batch analysis job
+	CancelBatchJob(ctx context.Context, jobID uuid.UUID) (*valueobject.BatchAnalyzeResult, error)
 }
 
 // FraudDetectionRepository defines the interface for fraud detection data access
@@ -49,6 +55,9 @@ type FraudDetectionRepository interface {
 	CreateBotSignal(ctx context.Context, signal *entity.BotDetectionSignal) error
 	GetBotSignalsByUser(ctx context.Context, userID uuid.UUID, processed bool) ([]entity.BotDetectionSignal, error)
 	GetUnprocessedBotSignals(ctx context.Context, limit int) ([]entity.BotDetectionSignal, error)
+	// GetUnprocessedBotSignalsAfter pages unprocessed signals detected in [from, to] ordered by
+	// (detected_at, id), starting after the given cursor when it is set
+	GetUnprocessedBotSignalsAfter(ctx context.Context, from, to time.Time, afterDetectedAt *time.Time, afterID *uuid.UUID, limit int) ([]entity.BotDetectionSignal, error)
 	MarkBotSignalAsProcessed(ctx context.Context, signalID uuid.UUID) error
 
 	// User Risk Scores
@@ -66,7 +75,8 @@ type FraudDetectionRepository interface {
 	GetLastReviewByUser(ctx context.Context, userID uuid.UUID) (*entity.AdminReview, error)
 
 	// Notifications
-	CreateBotNotification(ctx context.Context, notification *entity.BotFollowerNotification) error
+	// CreateBotNotification stores the notification unless one with the same ID exists and reports whether it was created
+	CreateBotNotification(ctx context.Context, notification *entity.BotFollowerNotification) (bool, error)
 	GetBotNotificationsByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool) ([]entity.BotFollowerNotification, error)
 	MarkNotificationAsRead(ctx context.Context, notificationID uuid.UUID) error
 
@@ -108,4 +118,13 @@ type BatchJobService interface {
 
 	// GetBatchJobStatus retrieves the status of a batch job
 	GetBatchJobStatus(ctx context.Context, jobID uuid.UUID) (*valueobject.BatchAnalyzeResult, error)
+
+	// ListBatchJobs retrieves batch jobs, newest first
+	ListBatchJobs(ctx context.Context, filter valueobject.BatchJobFilter) (*valueobject.BatchJobListResult, error)
+
+	// CancelBatchJob stops a running batch job after its current batch
+	CancelBatchJob(ctx context.Context, jobID uuid.UUID) (*valueobject.BatchAnalyzeResult, error)
+
+	// ResumeInterruptedJobs continues running jobs whose worker died from their checkpoint
+	ResumeInterruptedJobs(ctx context.Context) (int, error)
 }
@@ -2,10 +2,12 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/aiagent/internal/domain/entity"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
 	"github.com/aiagent/internal/domain/service"
 	"github.com/aiagent/internal/domain/service/mocks"
 	"github.com/aiagent/internal/domain/valueobject"
@@ -21,7 +23,7 @@ func TestFraudDetectionService_GetUserRiskScore(t *testing.T) {
 	mockRepo := mocks.NewMockFraudDetectionRepository(ctrl)
 	mockNotif := mocks.NewMockNotificationService(ctrl)
 	mockBatch := mocks.NewMockBatchJobService(ctrl)
-	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch)
+	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch, nil, nil)
 
 	userID := uuid.New()
 	expectedScore := &entity.UserRiskScore{
@@ -64,7 +66,7 @@ func TestFraudDetectionService_GetUserRiskScore_NotFound(t *testing.T) {
 	mockRepo := mocks.NewMockFraudDetectionRepository(ctrl)
 	mockNotif := mocks.NewMockNotificationService(ctrl)
 	mockBatch := mocks.NewMockBatchJobService(ctrl)
-	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch)
+	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch, nil, nil)
 
 	userID := uuid.New()
 
@@ -85,7 +87,7 @@ func TestFraudDetectionService_GetFraudDashboard(t *testing.T) {
 	mockRepo := mocks.NewMockFraudDetectionRepository(ctrl)
 	mockNotif := mocks.NewMockNotificationService(ctrl)
 	mockBatch := mocks.NewMockBatchJobService(ctrl)
-	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch)
+	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch, nil, nil)
 
 	minScore := 70
 	req := valueobject.FraudDashboardFilter{
@@ -135,7 +137,7 @@ func TestFraudDetectionService_ReviewUser(t *testing.T) {
 	mockRepo := mocks.NewMockFraudDetectionRepository(ctrl)
 	mockNotif := mocks.NewMockNotificationService(ctrl)
 	mockBatch := mocks.NewMockBatchJobService(ctrl)
-	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch)
+	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch, nil, nil)
 
 	adminID := uuid.New()
 	userID := uuid.New()
@@ -171,7 +173,9 @@ func TestFraudDetectionService_BanUser(t *testing.T) {
 	mockRepo := mocks.NewMockFraudDetectionRepository(ctrl)
 	mockNotif := mocks.NewMockNotificationService(ctrl)
 	mockBatch := mocks.NewMockBatchJobService(ctrl)
-	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch)
+	mockSessions := repoMocks.NewMockSessionRepository(ctrl)
+	mockTokens := repoMocks.NewMockAPITokenRepository(ctrl)
+	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch, mockSessions, mockTokens)
 
 	adminID := uuid.New()
 	userID := uuid.New()
@@ -188,6 +192,8 @@ func TestFraudDetectionService_BanUser(t *testing.T) {
 
 	mockRepo.EXPECT().GetRiskScoreByUser(gomock.Any(), userID).Return(riskScore, nil)
 	mockRepo.EXPECT().CreateAdminReview(gomock.Any(), gomock.Any()).Return(nil)
+	mockSessions.EXPECT().DeleteUserSessions(gomock.Any(), userID.String(), "").Return(nil)
+	mockTokens.EXPECT().RevokeAllByUser(gomock.Any(), userID).Return(nil)
 
 	// Act
 	result, err := svc.BanUser(context.Background(), adminID, userID, req)
@@ -201,6 +207,30 @@ func TestFraudDetectionService_BanUser(t *testing.T) {
 	assert.Equal(t, req.Reason, result.Reason)
 }
 
+func TestFraudDetectionService_BanUser_RevocationFailsAfterBan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockFraudDetectionRepository(ctrl)
+	mockSessions := repoMocks.NewMockSessionRepository(ctrl)
+	mockTokens := repoMocks.NewMockAPITokenRepository(ctrl)
+	svc := service.NewFraudDetectionService(mockRepo, nil, nil, mockSessions, mockTokens)
+
+	userID := uuid.New()
+
+	mockRepo.EXPECT().GetRiskScoreByUser(gomock.Any(), userID).Return(nil, nil)
+	mockRepo.EXPECT().CreateAdminReview(gomock.Any(), gomock.Any()).Return(nil)
+	mockSessions.EXPECT().DeleteUserSessions(gomock.Any(), userID.String(), "").Return(errors.New("redis down"))
+	// Token revocation is still attempted after the session cleanup failed
+	mockTokens.EXPECT().RevokeAllByUser(gomock.Any(), userID).Return(nil)
+
+	// The ban is stored, so it is reported as done
+	result, err := svc.BanUser(context.Background(), uuid.New(), userID, valueobject.BanUserCommand{Reason: "spam"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "banned", result.Action)
+}
+
 func TestFraudDetectionService_GetFraudTrends(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -208,7 +238,7 @@ func TestFraudDetectionService_GetFraudTrends(t *testing.T) {
 	mockRepo := mocks.NewMockFraudDetectionRepository(ctrl)
 	mockNotif := mocks.NewMockNotificationService(ctrl)
 	mockBatch := mocks.NewMockBatchJobService(ctrl)
-	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch)
+	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch, nil, nil)
 
 	req := valueobject.FraudTrendsFilter{
 		Period: "7d",
@@ -254,7 +284,7 @@ func TestFraudDetectionService_TriggerBatchAnalysis(t *testing.T) {
 	mockRepo := mocks.NewMockFraudDetectionRepository(ctrl)
 	mockNotif := mocks.NewMockNotificationService(ctrl)
 	mockBatch := mocks.NewMockBatchJobService(ctrl)
-	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch)
+	svc := service.NewFraudDetectionService(mockRepo, mockNotif, mockBatch, nil, nil)
 
 	req := valueobject.BatchAnalyzeCommand{}
 	expectedJobID := uuid.New()
@@ -0,0 +1,254 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// PlatformFees holds the percentage of a payment kept by the platform, by transaction type.
+// Types without a fee are credited to the author in full.
+type PlatformFees map[entity.TransactionType]decimal.Decimal
+
+// AuthorEarnings summarizes what readers paid an author over a period
+type AuthorEarnings struct {
+	Gross     decimal.Decimal // Paid by readers, less refunds
+	Fee       decimal.Decimal // Kept by the platform
+	Net       decimal.Decimal // Credited to the author
+	Payments  int64
+	Breakdown []repository.LedgerEarningsRow // By subscription tier, series and donations
+}
+
+// LedgerService posts payments, refunds and payouts to the double-entry earnings ledger.
+//
+// A payment debits the gateway and credits the platform fee to platform revenue and the rest
+// to the author it is attributed to: the subscribed or donated-to author, or the series author.
+// Refunds reverse their payment proportionally and an approved payout debits the author.
+// Posting methods run within dbTx so the ledger commits together with what it records.
+type LedgerService interface {
+	RecordPayment(ctx context.Context, dbTx *gorm.DB, tx *entity.Transaction) error
+	RecordRefund(ctx context.Context, dbTx *gorm.DB, tx *entity.Transaction, refund *entity.PaymentRefund) error
+	RecordPayout(ctx context.Context, dbTx *gorm.DB, payout *entity.Payout) error
+
+	// AuthorBalance returns what the platform owes an author in a currency; each currency is
+	// accounted for separately
+	AuthorBalance(ctx context.Context, authorID uuid.UUID, currency string) (decimal.Decimal, error)
+	AuthorEarnings(ctx context.Context, authorID uuid.UUID, filter repository.LedgerEarningsFilter) (*AuthorEarnings, error)
+}
+
+type ledgerService struct {
+	ledgerRepo repository.LedgerRepository
+	planRepo   repository.SubscriptionPlanRepository
+	seriesRepo repository.SeriesRepository
+	fees       PlatformFees
+}
+
+// NewLedgerService creates a new instance of LedgerService
+func NewLedgerService(
+	ledgerRepo repository.LedgerRepository,
+	planRepo repository.SubscriptionPlanRepository,
+	seriesRepo repository.SeriesRepository,
+	fees PlatformFees,
+) LedgerService {
+	return &ledgerService{
+		ledgerRepo: ledgerRepo,
+		planRepo:   planRepo,
+		seriesRepo: seriesRepo,
+		fees:       fees,
+	}
+}
+
+var hundred = decimal.NewFromInt(100)
+
+// RecordPayment splits the amount received for tx between the platform fee and its author
+func (s *ledgerService) RecordPayment(ctx context.Context, dbTx *gorm.DB, tx *entity.Transaction) error {
+	received := tx.Amount
+	if tx.PaidAmount != nil {
+		received = *tx.PaidAmount
+	}
+	if !received.IsPositive() {
+		return nil // Upgrades covered by the credit move no money
+	}
+
+	line, err := s.paymentLine(ctx, dbTx, tx)
+	if err != nil {
+		return err
+	}
+
+	fee := received.Mul(s.fees[tx.Type]).Div(hundred).Round(minorUnits(tx.Currency))
+	if line.AuthorID == nil {
+		fee = received // Nobody to credit, the platform keeps the payment
+	}
+
+	journal := newJournal(line, entity.LedgerEntryPayment, tx.ID)
+	journal.post(entity.LedgerAccountGateway, received)
+	journal.post(entity.LedgerAccountPlatformRevenue, fee.Neg())
+	journal.post(entity.LedgerAccountAuthorPayable, received.Sub(fee).Neg())
+
+	if err := s.ledgerRepo.WithTx(dbTx).CreateJournal(ctx, journal.entries); err != nil {
+		return fmt.Errorf("failed to post payment to ledger: %w", err)
+	}
+	return nil
+}
+
+// paymentLine returns the attribution shared by all entries of the journal of tx
+func (s *ledgerService) paymentLine(ctx context.Context, dbTx *gorm.DB, tx *entity.Transaction) (entity.LedgerEntry, error) {
+	txType := tx.Type
+	line := entity.LedgerEntry{
+		TransactionID:   &tx.ID,
+		TransactionType: &txType,
+		Currency:        tx.Currency,
+	}
+	if tx.TargetID == nil {
+		return line, nil
+	}
+
+	switch tx.Type {
+	case entity.TransactionTypeSubscription, entity.TransactionTypeDonation:
+		line.AuthorID = tx.TargetID
+	case entity.TransactionTypeSeries:
+		line.SeriesID = tx.TargetID
+		series, err := s.seriesRepo.GetByID(ctx, *tx.TargetID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return line, fmt.Errorf("failed to load series: %w", err)
+		}
+		if series != nil {
+			line.AuthorID = &series.AuthorID
+		}
+	}
+
+	if tx.Type == entity.TransactionTypeSubscription && tx.PlanID != nil {
+		planUUID, err := uuid.Parse(*tx.PlanID)
+		if err != nil {
+			return line, fmt.Errorf("invalid plan ID: %w", err)
+		}
+		plan, err := s.planRepo.WithTx(dbTx).FindByID(ctx, planUUID)
+		if err != nil {
+			return line, fmt.Errorf("failed to load plan: %w", err)
+		}
+		if plan != nil {
+			tier := plan.Tier.String()
+			line.Tier = &tier
+		}
+	}
+	return line, nil
+}
+
+// RecordRefund reverses the payment of tx in proportion to the refunded amount
+func (s *ledgerService) RecordRefund(ctx context.Context, dbTx *gorm.DB, tx *entity.Transaction, refund *entity.PaymentRefund) error {
+	ledgerRepo := s.ledgerRepo.WithTx(dbTx)
+
+	entries, err := ledgerRepo.FindBySource(ctx, entity.LedgerEntryPayment, tx.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load payment journal: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil // Paid before the ledger existed
+	}
+
+	var gross, fee decimal.Decimal
+	for _, e := range entries {
+		switch e.Account {
+		case entity.LedgerAccountGateway:
+			gross = e.Amount
+		case entity.LedgerAccountPlatformRevenue:
+			fee = e.Amount.Neg()
+		}
+	}
+	if !gross.IsPositive() {
+		return nil
+	}
+
+	line := entries[0]
+	feePart := refund.Amount.Mul(fee).Div(gross).Round(minorUnits(line.Currency))
+
+	journal := newJournal(line, entity.LedgerEntryRefund, refund.ID)
+	journal.post(entity.LedgerAccountGateway, refund.Amount.Neg())
+	journal.post(entity.LedgerAccountPlatformRevenue, feePart)
+	journal.post(entity.LedgerAccountAuthorPayable, refund.Amount.Sub(feePart))
+
+	if err := ledgerRepo.CreateJournal(ctx, journal.entries); err != nil {
+		return fmt.Errorf("failed to post refund to ledger: %w", err)
+	}
+	return nil
+}
+
+// RecordPayout debits the author for money sent to their bank account
+func (s *ledgerService) RecordPayout(ctx context.Context, dbTx *gorm.DB, payout *entity.Payout) error {
+	line := entity.LedgerEntry{AuthorID: &payout.AuthorID, Currency: payout.Currency}
+	journal := newJournal(line, entity.LedgerEntryPayout, payout.ID)
+	journal.post(entity.LedgerAccountAuthorPayable, payout.Amount)
+	journal.post(entity.LedgerAccountGateway, payout.Amount.Neg())
+
+	if err := s.ledgerRepo.WithTx(dbTx).CreateJournal(ctx, journal.entries); err != nil {
+		return fmt.Errorf("failed to post payout to ledger: %w", err)
+	}
+	return nil
+}
+
+// AuthorBalance returns what the platform owes an author in a currency
+func (s *ledgerService) AuthorBalance(ctx context.Context, authorID uuid.UUID, currency string) (decimal.Decimal, error) {
+	return s.ledgerRepo.AuthorBalance(ctx, authorID, currency)
+}
+
+// AuthorEarnings returns an author's earnings with their breakdown
+func (s *ledgerService) AuthorEarnings(ctx context.Context, authorID uuid.UUID, filter repository.LedgerEarningsFilter) (*AuthorEarnings, error) {
+	rows, err := s.ledgerRepo.AuthorEarnings(ctx, authorID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load earnings: %w", err)
+	}
+
+	earnings := &AuthorEarnings{Breakdown: rows}
+	for _, row := range rows {
+		earnings.Gross = earnings.Gross.Add(row.Gross)
+		earnings.Fee = earnings.Fee.Add(row.Fee)
+		earnings.Net = earnings.Net.Add(row.Net)
+		earnings.Payments += row.Payments
+	}
+	return earnings, nil
+}
+
+// minorUnits returns the number of decimals amounts in currency are rounded to
+func minorUnits(currency string) int32 {
+	switch currency {
+	case "VND", "JPY", "KRW":
+		return 0
+	}
+	return 2
+}
+
+// ledgerJournal collects the entries of one journal
+type ledgerJournal struct {
+	line    entity.LedgerEntry
+	entries []entity.LedgerEntry
+}
+
+// newJournal starts a journal whose entries share the attribution of line
+func newJournal(line entity.LedgerEntry, kind entity.LedgerEntryKind, sourceID uuid.UUID) *ledgerJournal {
+	line.ID = uuid.Nil
+	line.CreatedAt = time.Time{}
+	line.JournalID = uuid.New()
+	line.Kind = kind
+	line.SourceID = sourceID
+	return &ledgerJournal{line: line}
+}
+
+// post adds an entry to the journal, skipping zero amounts
+func (j *ledgerJournal) post(account entity.LedgerAccount, amount decimal.Decimal) {
+	if amount.IsZero() {
+		return
+	}
+	entry := j.line
+	entry.Account = account
+	entry.Amount = amount
+	j.entries = append(j.entries, entry)
+}
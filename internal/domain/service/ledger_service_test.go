@@ -0,0 +1,267 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+// journalAmounts returns the amount posted to each account of a journal
+func journalAmounts(t *testing.T, entries []entity.LedgerEntry) map[entity.LedgerAccount]string {
+	t.Helper()
+	amounts := map[entity.LedgerAccount]string{}
+	sum := decimal.Zero
+	for _, e := range entries {
+		assert.Equal(t, entries[0].JournalID, e.JournalID)
+		amounts[e.Account] = e.Amount.String()
+		sum = sum.Add(e.Amount)
+	}
+	assert.True(t, sum.IsZero(), "journal does not balance")
+	return amounts
+}
+
+func TestLedgerService_RecordPayment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	ledgerRepo := mocks.NewMockLedgerRepository(ctrl)
+	planRepo := mocks.NewMockSubscriptionPlanRepository(ctrl)
+	seriesRepo := mocks.NewMockSeriesRepository(ctrl)
+	ledgerRepo.EXPECT().WithTx(gomock.Any()).Return(ledgerRepo).AnyTimes()
+	planRepo.EXPECT().WithTx(gomock.Any()).Return(planRepo).AnyTimes()
+
+	svc := service.NewLedgerService(ledgerRepo, planRepo, seriesRepo, service.PlatformFees{
+		entity.TransactionTypeSubscription: decimal.NewFromInt(20),
+		entity.TransactionTypeSeries:       decimal.NewFromFloat(12.5),
+	})
+	var dbTx *gorm.DB
+
+	authorID := uuid.New()
+	paid := func(txType entity.TransactionType, amount int64) *entity.Transaction {
+		target := authorID
+		return &entity.Transaction{
+			ID:       uuid.New(),
+			UserID:   uuid.New(),
+			Amount:   decimal.NewFromInt(amount),
+			Currency: "VND",
+			Type:     txType,
+			Status:   entity.TransactionStatusSuccess,
+			TargetID: &target,
+		}
+	}
+	capture := func(entries *[]entity.LedgerEntry) {
+		ledgerRepo.EXPECT().CreateJournal(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e []entity.LedgerEntry) error {
+			*entries = e
+			return nil
+		})
+	}
+
+	t.Run("subscription_is_split_with_the_author", func(t *testing.T) {
+		plan := &entity.SubscriptionPlan{ID: uuid.New(), AuthorID: authorID, Tier: entity.TierSilver}
+		planID := plan.ID.String()
+		tx := paid(entity.TransactionTypeSubscription, 100000)
+		tx.PlanID = &planID
+		// The amount actually received is split, not the price
+		received := decimal.NewFromInt(100001)
+		tx.PaidAmount = &received
+
+		planRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		var entries []entity.LedgerEntry
+		capture(&entries)
+
+		require.NoError(t, svc.RecordPayment(ctx, dbTx, tx))
+
+		assert.Equal(t, map[entity.LedgerAccount]string{
+			entity.LedgerAccountGateway:         "100001",
+			entity.LedgerAccountPlatformRevenue: "-20000",
+			entity.LedgerAccountAuthorPayable:   "-80001",
+		}, journalAmounts(t, entries))
+		for _, e := range entries {
+			assert.Equal(t, entity.LedgerEntryPayment, e.Kind)
+			assert.Equal(t, tx.ID, e.SourceID)
+			assert.Equal(t, authorID, *e.AuthorID)
+			assert.Equal(t, "SILVER", *e.Tier)
+			assert.Nil(t, e.SeriesID)
+		}
+	})
+
+	t.Run("series_is_credited_to_its_author", func(t *testing.T) {
+		tx := paid(entity.TransactionTypeSeries, 49000)
+		seriesID := *tx.TargetID
+		seriesAuthor := uuid.New()
+
+		seriesRepo.EXPECT().GetByID(ctx, seriesID).Return(&entity.Series{ID: seriesID, AuthorID: seriesAuthor}, nil)
+		var entries []entity.LedgerEntry
+		capture(&entries)
+
+		require.NoError(t, svc.RecordPayment(ctx, dbTx, tx))
+
+		assert.Equal(t, map[entity.LedgerAccount]string{
+			entity.LedgerAccountGateway:         "49000",
+			entity.LedgerAccountPlatformRevenue: "-6125",
+			entity.LedgerAccountAuthorPayable:   "-42875",
+		}, journalAmounts(t, entries))
+		assert.Equal(t, seriesAuthor, *entries[0].AuthorID)
+		assert.Equal(t, seriesID, *entries[0].SeriesID)
+	})
+
+	t.Run("donation_without_fee_goes_to_the_target_author", func(t *testing.T) {
+		tx := paid(entity.TransactionTypeDonation, 20000)
+
+		var entries []entity.LedgerEntry
+		capture(&entries)
+
+		require.NoError(t, svc.RecordPayment(ctx, dbTx, tx))
+
+		assert.Equal(t, map[entity.LedgerAccount]string{
+			entity.LedgerAccountGateway:       "20000",
+			entity.LedgerAccountAuthorPayable: "-20000",
+		}, journalAmounts(t, entries))
+		assert.Equal(t, authorID, *entries[0].AuthorID)
+	})
+
+	t.Run("deleted_series_leaves_the_payment_to_the_platform", func(t *testing.T) {
+		tx := paid(entity.TransactionTypeSeries, 49000)
+
+		seriesRepo.EXPECT().GetByID(ctx, *tx.TargetID).Return(nil, gorm.ErrRecordNotFound)
+		var entries []entity.LedgerEntry
+		capture(&entries)
+
+		require.NoError(t, svc.RecordPayment(ctx, dbTx, tx))
+
+		assert.Equal(t, map[entity.LedgerAccount]string{
+			entity.LedgerAccountGateway:         "49000",
+			entity.LedgerAccountPlatformRevenue: "-49000",
+		}, journalAmounts(t, entries))
+	})
+
+	t.Run("nothing_paid_is_not_posted", func(t *testing.T) {
+		tx := paid(entity.TransactionTypeSubscription, 0)
+
+		assert.NoError(t, svc.RecordPayment(ctx, dbTx, tx))
+	})
+}
+
+func TestLedgerService_RecordRefund(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	ledgerRepo := mocks.NewMockLedgerRepository(ctrl)
+	ledgerRepo.EXPECT().WithTx(gomock.Any()).Return(ledgerRepo).AnyTimes()
+	svc := service.NewLedgerService(ledgerRepo, nil, nil, nil)
+	var dbTx *gorm.DB
+
+	authorID := uuid.New()
+	tier := "GOLD"
+	tx := &entity.Transaction{ID: uuid.New(), Type: entity.TransactionTypeSubscription}
+	payment := func(account entity.LedgerAccount, amount int64) entity.LedgerEntry {
+		return entity.LedgerEntry{
+			ID:        uuid.New(),
+			JournalID: uuid.New(),
+			Kind:      entity.LedgerEntryPayment,
+			SourceID:  tx.ID,
+			Account:   account,
+			AuthorID:  &authorID,
+			Amount:    decimal.NewFromInt(amount),
+			Currency:  "VND",
+			Tier:      &tier,
+		}
+	}
+
+	t.Run("reverses_the_payment_in_proportion", func(t *testing.T) {
+		refund := &entity.PaymentRefund{ID: uuid.New(), TransactionID: tx.ID, Amount: decimal.NewFromInt(30000)}
+
+		ledgerRepo.EXPECT().FindBySource(ctx, entity.LedgerEntryPayment, tx.ID).Return([]entity.LedgerEntry{
+			payment(entity.LedgerAccountGateway, 100000),
+			payment(entity.LedgerAccountPlatformRevenue, -20000),
+			payment(entity.LedgerAccountAuthorPayable, -80000),
+		}, nil)
+		var entries []entity.LedgerEntry
+		ledgerRepo.EXPECT().CreateJournal(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e []entity.LedgerEntry) error {
+			entries = e
+			return nil
+		})
+
+		require.NoError(t, svc.RecordRefund(ctx, dbTx, tx, refund))
+
+		assert.Equal(t, map[entity.LedgerAccount]string{
+			entity.LedgerAccountGateway:         "-30000",
+			entity.LedgerAccountPlatformRevenue: "6000",
+			entity.LedgerAccountAuthorPayable:   "24000",
+		}, journalAmounts(t, entries))
+		for _, e := range entries {
+			assert.Equal(t, entity.LedgerEntryRefund, e.Kind)
+			assert.Equal(t, refund.ID, e.SourceID)
+			assert.Equal(t, uuid.Nil, e.ID)
+			assert.True(t, e.CreatedAt.IsZero())
+			assert.Equal(t, authorID, *e.AuthorID)
+			assert.Equal(t, "GOLD", *e.Tier)
+		}
+	})
+
+	t.Run("payment_before_the_ledger_is_skipped", func(t *testing.T) {
+		refund := &entity.PaymentRefund{ID: uuid.New(), TransactionID: tx.ID, Amount: decimal.NewFromInt(30000)}
+		ledgerRepo.EXPECT().FindBySource(ctx, entity.LedgerEntryPayment, tx.ID).Return(nil, nil)
+
+		assert.NoError(t, svc.RecordRefund(ctx, dbTx, tx, refund))
+	})
+}
+
+func TestLedgerService_RecordPayout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	ledgerRepo := mocks.NewMockLedgerRepository(ctrl)
+	ledgerRepo.EXPECT().WithTx(gomock.Any()).Return(ledgerRepo)
+	svc := service.NewLedgerService(ledgerRepo, nil, nil, nil)
+
+	payout := &entity.Payout{ID: uuid.New(), AuthorID: uuid.New(), Amount: decimal.NewFromInt(150000), Currency: "VND"}
+	var entries []entity.LedgerEntry
+	ledgerRepo.EXPECT().CreateJournal(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e []entity.LedgerEntry) error {
+		entries = e
+		return nil
+	})
+
+	require.NoError(t, svc.RecordPayout(ctx, nil, payout))
+
+	assert.Equal(t, map[entity.LedgerAccount]string{
+		entity.LedgerAccountAuthorPayable: "150000",
+		entity.LedgerAccountGateway:       "-150000",
+	}, journalAmounts(t, entries))
+	assert.Equal(t, payout.AuthorID, *entries[0].AuthorID)
+}
+
+func TestLedgerService_AuthorEarnings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	ledgerRepo := mocks.NewMockLedgerRepository(ctrl)
+	svc := service.NewLedgerService(ledgerRepo, nil, nil, nil)
+
+	authorID := uuid.New()
+	silver := "SILVER"
+	seriesID := uuid.New()
+	ledgerRepo.EXPECT().AuthorEarnings(ctx, authorID, repository.LedgerEarningsFilter{}).Return([]repository.LedgerEarningsRow{
+		{TransactionType: entity.TransactionTypeSubscription, Tier: &silver, Gross: decimal.NewFromInt(100000), Fee: decimal.NewFromInt(20000), Net: decimal.NewFromInt(80000), Payments: 2},
+		{TransactionType: entity.TransactionTypeSeries, SeriesID: &seriesID, Gross: decimal.NewFromInt(49000), Fee: decimal.NewFromInt(14700), Net: decimal.NewFromInt(34300), Payments: 1},
+	}, nil)
+
+	earnings, err := svc.AuthorEarnings(ctx, authorID, repository.LedgerEarningsFilter{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "149000", earnings.Gross.String())
+	assert.Equal(t, "34700", earnings.Fee.String())
+	assert.Equal(t, "114300", earnings.Net.String())
+	assert.Equal(t, int64(3), earnings.Payments)
+	assert.Len(t, earnings.Breakdown, 2)
+}
@@ -0,0 +1,232 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/infrastructure/adapter"
+	"github.com/aiagent/pkg/imaging"
+	"github.com/aiagent/pkg/logger"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrMediaTooLarge       = errors.New("media file or image dimensions too large")
+	ErrUnsupportedMedia    = errors.New("unsupported media type, allowed: jpeg, png, gif, webp")
+	ErrMediaQuotaExceeded  = errors.New("media storage quota exceeded")
+	ErrMediaNotFound       = errors.New("media not found")
+	ErrInvalidMediaPurpose = errors.New("invalid media purpose")
+)
+
+// mediaVariantWidths are the widths images are downscaled to for each purpose
+var mediaVariantWidths = map[entity.MediaPurpose][]int{
+	entity.MediaPurposeAvatar:        {64, 128, 256},
+	entity.MediaPurposeBlogThumbnail: {320, 640, 1280},
+	entity.MediaPurposeBlogInline:    {640, 1280},
+}
+
+// MediaConfig holds the limits applied to uploads
+type MediaConfig struct {
+	MaxUploadSize int64 // Largest accepted upload, in bytes
+	UserQuota     int64 // Bytes a user may store, variants included
+	MaxPixels     int   // Images with more pixels are rejected before being decoded
+}
+
+// MediaUsage is the storage used by a user against their quota
+type MediaUsage struct {
+	Used  int64
+	Quota int64
+}
+
+// MediaService stores user uploaded images. Uploads are identified by their magic bytes,
+// re-encoded without EXIF metadata and resized into variants before reaching the blob store.
+type MediaService interface {
+	// Upload processes the image read from r and stores it with its variants
+	Upload(ctx context.Context, ownerID uuid.UUID, purpose entity.MediaPurpose, r io.Reader) (*entity.MediaAsset, error)
+	// List returns a page of a user's media, of one purpose when purpose is set
+	List(ctx context.Context, ownerID uuid.UUID, purpose *entity.MediaPurpose, pagination repository.Pagination) (*repository.PaginatedResult[entity.MediaAsset], error)
+	// Delete removes a user's asset and gives its bytes back to their quota
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	// PruneAvatars deletes the avatars of a user other than keepID, once a new one is in use
+	PruneAvatars(ctx context.Context, ownerID, keepID uuid.UUID) error
+	GetUsage(ctx context.Context, ownerID uuid.UUID) (*MediaUsage, error)
+}
+
+type mediaService struct {
+	db        *gorm.DB
+	mediaRepo repository.MediaAssetRepository
+	store     adapter.BlobStore
+	cfg       MediaConfig
+}
+
+// NewMediaService creates a new instance of MediaService
+func NewMediaService(db *gorm.DB, mediaRepo repository.MediaAssetRepository, store adapter.BlobStore, cfg MediaConfig) MediaService {
+	return &mediaService{
+		db:        db,
+		mediaRepo: mediaRepo,
+		store:     store,
+		cfg:       cfg,
+	}
+}
+
+// Upload reserves the quota before writing blobs, so concurrent uploads cannot exceed it, and
+// removes what it wrote and gives the quota back when a later step fails
+func (s *mediaService) Upload(ctx context.Context, ownerID uuid.UUID, purpose entity.MediaPurpose, r io.Reader) (*entity.MediaAsset, error) {
+	if !purpose.IsValid() {
+		return nil, ErrInvalidMediaPurpose
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, s.cfg.MaxUploadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if int64(len(data)) > s.cfg.MaxUploadSize {
+		return nil, ErrMediaTooLarge
+	}
+
+	processed, err := imaging.Process(data, imaging.Options{
+		MaxPixels: s.cfg.MaxPixels,
+		Widths:    mediaVariantWidths[purpose],
+	})
+	switch {
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		return nil, ErrUnsupportedMedia
+	case errors.Is(err, imaging.ErrImageTooLarge):
+		return nil, ErrMediaTooLarge
+	case err != nil:
+		return nil, fmt.Errorf("failed to process image: %w", err)
+	}
+
+	asset := &entity.MediaAsset{
+		ID:          uuid.New(),
+		OwnerID:     ownerID,
+		Purpose:     purpose,
+		ContentType: processed.Original.ContentType,
+		Width:       processed.Original.Width,
+		Height:      processed.Original.Height,
+		Variants:    []entity.MediaVariant{},
+	}
+	prefix := fmt.Sprintf("media/%s/%s/", ownerID, asset.ID)
+	asset.Key = prefix + "original" + processed.Original.Ext
+	asset.URL = s.store.URL(asset.Key)
+	asset.Size = int64(len(processed.Original.Data))
+
+	keys := []string{asset.Key}
+	images := []imaging.Image{processed.Original}
+	for _, img := range processed.Variants {
+		key := fmt.Sprintf("%sw%d%s", prefix, img.Width, img.Ext)
+		keys = append(keys, key)
+		images = append(images, img)
+		asset.Size += int64(len(img.Data))
+		asset.Variants = append(asset.Variants, entity.MediaVariant{
+			Width:  img.Width,
+			Height: img.Height,
+			Size:   int64(len(img.Data)),
+			Key:    key,
+			URL:    s.store.URL(key),
+		})
+	}
+
+	reserved, err := s.mediaRepo.ReserveQuota(ctx, ownerID, asset.Size, s.cfg.UserQuota)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve media quota: %w", err)
+	}
+	if !reserved {
+		return nil, ErrMediaQuotaExceeded
+	}
+
+	for i, key := range keys {
+		if err := s.store.Put(ctx, key, images[i].Data, images[i].ContentType); err != nil {
+			s.discardUpload(ctx, ownerID, keys[:i], asset.Size)
+			return nil, fmt.Errorf("failed to store media: %w", err)
+		}
+	}
+
+	if err := s.mediaRepo.Create(ctx, asset); err != nil {
+		s.discardUpload(ctx, ownerID, keys, asset.Size)
+		return nil, fmt.Errorf("failed to save media: %w", err)
+	}
+
+	return asset, nil
+}
+
+// List returns a user's media, most recent first
+func (s *mediaService) List(ctx context.Context, ownerID uuid.UUID, purpose *entity.MediaPurpose, pagination repository.Pagination) (*repository.PaginatedResult[entity.MediaAsset], error) {
+	return s.mediaRepo.FindByOwner(ctx, ownerID, purpose, pagination)
+}
+
+// Delete removes the record and releases the quota together, then the blobs. A blob that
+// fails to delete is only logged: it no longer counts against the quota either way.
+func (s *mediaService) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	var asset *entity.MediaAsset
+	err := s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		mediaRepo := s.mediaRepo.WithTx(dbTx)
+
+		deleted, err := mediaRepo.Delete(ctx, id, ownerID)
+		if err != nil {
+			return fmt.Errorf("failed to delete media: %w", err)
+		}
+		if deleted == nil {
+			return ErrMediaNotFound
+		}
+		asset = deleted
+		return mediaRepo.ReleaseQuota(ctx, ownerID, deleted.Size)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.deleteBlobs(ctx, asset.Keys())
+	return nil
+}
+
+// PruneAvatars deletes previous avatars; failures are logged as the new avatar is already in use
+func (s *mediaService) PruneAvatars(ctx context.Context, ownerID, keepID uuid.UUID) error {
+	purpose := entity.MediaPurposeAvatar
+	result, err := s.mediaRepo.FindByOwner(ctx, ownerID, &purpose, repository.Pagination{Page: 1, PageSize: 100})
+	if err != nil {
+		return fmt.Errorf("failed to load avatars: %w", err)
+	}
+
+	for _, avatar := range result.Data {
+		if avatar.ID == keepID {
+			continue
+		}
+		if err := s.Delete(ctx, ownerID, avatar.ID); err != nil && !errors.Is(err, ErrMediaNotFound) {
+			logger.Error("failed to delete previous avatar", err, map[string]interface{}{"media_id": avatar.ID})
+		}
+	}
+	return nil
+}
+
+// GetUsage returns the storage used by a user and their quota
+func (s *mediaService) GetUsage(ctx context.Context, ownerID uuid.UUID) (*MediaUsage, error) {
+	used, err := s.mediaRepo.GetUsage(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load media usage: %w", err)
+	}
+	return &MediaUsage{Used: used, Quota: s.cfg.UserQuota}, nil
+}
+
+// discardUpload removes the blobs of a failed upload and gives its bytes back to the quota
+func (s *mediaService) discardUpload(ctx context.Context, ownerID uuid.UUID, keys []string, size int64) {
+	s.deleteBlobs(ctx, keys)
+	if err := s.mediaRepo.ReleaseQuota(ctx, ownerID, size); err != nil {
+		logger.Error("failed to release media quota", err, map[string]interface{}{"owner_id": ownerID, "bytes": size})
+	}
+}
+
+func (s *mediaService) deleteBlobs(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			logger.Error("failed to delete media blob", err, map[string]interface{}{"key": key})
+		}
+	}
+}
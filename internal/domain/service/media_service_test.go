@@ -0,0 +1,229 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	adapterMocks "github.com/aiagent/internal/infrastructure/adapter/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type mediaFixture struct {
+	svc       service.MediaService
+	sqlMock   sqlmock.Sqlmock
+	mediaRepo *mocks.MockMediaAssetRepository
+	store     *adapterMocks.MockBlobStore
+}
+
+func newMediaFixture(t *testing.T) *mediaFixture {
+	ctrl := gomock.NewController(t)
+
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+	require.NoError(t, err)
+
+	f := &mediaFixture{
+		sqlMock:   sqlMock,
+		mediaRepo: mocks.NewMockMediaAssetRepository(ctrl),
+		store:     adapterMocks.NewMockBlobStore(ctrl),
+	}
+	f.mediaRepo.EXPECT().WithTx(gomock.Any()).Return(f.mediaRepo).AnyTimes()
+	f.store.EXPECT().URL(gomock.Any()).DoAndReturn(func(key string) string { return "https://cdn.example/" + key }).AnyTimes()
+
+	f.svc = service.NewMediaService(gormDB, f.mediaRepo, f.store, service.MediaConfig{
+		MaxUploadSize: 1 << 20,
+		UserQuota:     10 << 20,
+		MaxPixels:     4_000_000,
+	})
+	return f
+}
+
+func testPNG(t *testing.T, w, h int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, w, h))))
+	return buf.Bytes()
+}
+
+func TestMediaService_Upload(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+
+	t.Run("stores_original_and_variants", func(t *testing.T) {
+		f := newMediaFixture(t)
+
+		var reserved int64
+		f.mediaRepo.EXPECT().ReserveQuota(ctx, ownerID, gomock.Any(), int64(10<<20)).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, bytes, _ int64) (bool, error) {
+				reserved = bytes
+				return true, nil
+			})
+		var keys []string
+		f.store.EXPECT().Put(ctx, gomock.Any(), gomock.Any(), "image/png").DoAndReturn(
+			func(_ context.Context, key string, _ []byte, _ string) error {
+				keys = append(keys, key)
+				return nil
+			}).Times(3)
+		f.mediaRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		// Thumbnails are resized to 320, 640 and 1280; the image is narrower than 1280
+		asset, err := f.svc.Upload(ctx, ownerID, entity.MediaPurposeBlogThumbnail, bytes.NewReader(testPNG(t, 800, 400)))
+
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", asset.ContentType)
+		assert.Equal(t, 800, asset.Width)
+		assert.Equal(t, 400, asset.Height)
+		require.Len(t, asset.Variants, 2)
+		assert.Equal(t, 320, asset.Variants[0].Width)
+		assert.Equal(t, 640, asset.Variants[1].Width)
+		assert.Equal(t, reserved, asset.Size)
+		assert.Equal(t, asset.Keys(), keys)
+		assert.True(t, strings.HasPrefix(asset.Key, "media/"+ownerID.String()+"/"+asset.ID.String()+"/"))
+		assert.Equal(t, "https://cdn.example/"+asset.Key, asset.URL)
+		assert.Equal(t, asset.Variants[1].URL, asset.URLForWidth(500))
+	})
+
+	t.Run("not_an_image", func(t *testing.T) {
+		f := newMediaFixture(t)
+
+		_, err := f.svc.Upload(ctx, ownerID, entity.MediaPurposeBlogInline, strings.NewReader(`<svg xmlns="http://www.w3.org/2000/svg" onload="alert(1)"/>`))
+
+		assert.ErrorIs(t, err, service.ErrUnsupportedMedia)
+	})
+
+	t.Run("file_too_large", func(t *testing.T) {
+		f := newMediaFixture(t)
+
+		_, err := f.svc.Upload(ctx, ownerID, entity.MediaPurposeBlogInline, bytes.NewReader(make([]byte, 1<<20+1)))
+
+		assert.ErrorIs(t, err, service.ErrMediaTooLarge)
+	})
+
+	t.Run("too_many_pixels", func(t *testing.T) {
+		f := newMediaFixture(t)
+
+		_, err := f.svc.Upload(ctx, ownerID, entity.MediaPurposeBlogInline, bytes.NewReader(testPNG(t, 4000, 1001)))
+
+		assert.ErrorIs(t, err, service.ErrMediaTooLarge)
+	})
+
+	t.Run("invalid_purpose", func(t *testing.T) {
+		f := newMediaFixture(t)
+
+		_, err := f.svc.Upload(ctx, ownerID, "banner", bytes.NewReader(testPNG(t, 10, 10)))
+
+		assert.ErrorIs(t, err, service.ErrInvalidMediaPurpose)
+	})
+
+	t.Run("quota_exceeded", func(t *testing.T) {
+		f := newMediaFixture(t)
+		f.mediaRepo.EXPECT().ReserveQuota(ctx, ownerID, gomock.Any(), gomock.Any()).Return(false, nil)
+
+		_, err := f.svc.Upload(ctx, ownerID, entity.MediaPurposeAvatar, bytes.NewReader(testPNG(t, 100, 100)))
+
+		assert.ErrorIs(t, err, service.ErrMediaQuotaExceeded)
+	})
+
+	t.Run("store_failure_cleans_up", func(t *testing.T) {
+		f := newMediaFixture(t)
+
+		var reserved int64
+		f.mediaRepo.EXPECT().ReserveQuota(ctx, ownerID, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, bytes, _ int64) (bool, error) {
+				reserved = bytes
+				return true, nil
+			})
+		var stored string
+		gomock.InOrder(
+			f.store.EXPECT().Put(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, key string, _ []byte, _ string) error {
+					stored = key
+					return nil
+				}),
+			f.store.EXPECT().Put(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("bucket unavailable")),
+		)
+		f.store.EXPECT().Delete(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, key string) error {
+			assert.Equal(t, stored, key)
+			return nil
+		})
+		f.mediaRepo.EXPECT().ReleaseQuota(ctx, ownerID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, bytes int64) error {
+				assert.Equal(t, reserved, bytes)
+				return nil
+			})
+
+		_, err := f.svc.Upload(ctx, ownerID, entity.MediaPurposeBlogInline, bytes.NewReader(testPNG(t, 800, 400)))
+
+		assert.ErrorContains(t, err, "bucket unavailable")
+	})
+}
+
+func TestMediaService_Delete(t *testing.T) {
+	ctx := context.Background()
+	ownerID, id := uuid.New(), uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		f := newMediaFixture(t)
+		asset := &entity.MediaAsset{
+			ID: id, OwnerID: ownerID, Size: 4096, Key: "media/o/a/original.jpg",
+			Variants: []entity.MediaVariant{{Width: 320, Key: "media/o/a/w320.jpg"}},
+		}
+
+		f.sqlMock.ExpectBegin()
+		f.mediaRepo.EXPECT().Delete(ctx, id, ownerID).Return(asset, nil)
+		f.mediaRepo.EXPECT().ReleaseQuota(ctx, ownerID, int64(4096)).Return(nil)
+		f.sqlMock.ExpectCommit()
+		f.store.EXPECT().Delete(ctx, "media/o/a/original.jpg").Return(nil)
+		f.store.EXPECT().Delete(ctx, "media/o/a/w320.jpg").Return(errors.New("timeout"))
+
+		assert.NoError(t, f.svc.Delete(ctx, ownerID, id))
+		assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("media_of_other_user", func(t *testing.T) {
+		f := newMediaFixture(t)
+
+		f.sqlMock.ExpectBegin()
+		f.mediaRepo.EXPECT().Delete(ctx, id, ownerID).Return(nil, nil)
+		f.sqlMock.ExpectRollback()
+
+		assert.ErrorIs(t, f.svc.Delete(ctx, ownerID, id), service.ErrMediaNotFound)
+		assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestMediaService_PruneAvatars(t *testing.T) {
+	ctx := context.Background()
+	f := newMediaFixture(t)
+	ownerID, keepID, oldID := uuid.New(), uuid.New(), uuid.New()
+	avatar := entity.MediaPurposeAvatar
+
+	f.mediaRepo.EXPECT().FindByOwner(ctx, ownerID, &avatar, repository.Pagination{Page: 1, PageSize: 100}).
+		Return(&repository.PaginatedResult[entity.MediaAsset]{Data: []entity.MediaAsset{
+			{ID: keepID, OwnerID: ownerID, Key: "media/new/original.png"},
+			{ID: oldID, OwnerID: ownerID, Key: "media/old/original.png", Size: 100},
+		}}, nil)
+	f.sqlMock.ExpectBegin()
+	f.mediaRepo.EXPECT().Delete(ctx, oldID, ownerID).Return(&entity.MediaAsset{ID: oldID, Key: "media/old/original.png", Size: 100}, nil)
+	f.mediaRepo.EXPECT().ReleaseQuota(ctx, ownerID, int64(100)).Return(nil)
+	f.sqlMock.ExpectCommit()
+	f.store.EXPECT().Delete(ctx, "media/old/original.png").Return(nil)
+
+	assert.NoError(t, f.svc.PruneAvatars(ctx, ownerID, keepID))
+	assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+}
@@ -44,6 +44,21 @@ func (m *MockBlogService) EXPECT() *MockBlogServiceMockRecorder {
 	return m.recorder
 }
 
+// CancelSchedule mocks base method.
+func (m *MockBlogService) CancelSchedule(ctx context.Context, id, authorID uuid.UUID) (*entity.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSchedule", ctx, id, authorID)
+	ret0, _ := ret[0].(*entity.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSchedule indicates an expected call of CancelSchedule.
+func (mr *MockBlogServiceMockRecorder) CancelSchedule(ctx, id, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSchedule", reflect.TypeOf((*MockBlogService)(nil).CancelSchedule), ctx, id, authorID)
+}
+
 // CheckAccess mocks base method.
 func (m *MockBlogService) CheckAccess(ctx context.Context, blog *entity.Blog, viewerID *uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -131,19 +146,49 @@ func (mr *MockBlogServiceMockRecorder) List(ctx, filter, pagination, viewerID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBlogService)(nil).List), ctx, filter, pagination, viewerID)
 }
 
+// ListPublished mocks base method.
+func (m *MockBlogService) ListPublished(ctx context.Context, filter repository.BlogFilter, cursor string, limit int, viewerID *uuid.UUID) (*repository.CursorPage[entity.Blog], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublished", ctx, filter, cursor, limit, viewerID)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.Blog])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublished indicates an expected call of ListPublished.
+func (mr *MockBlogServiceMockRecorder) ListPublished(ctx, filter, cursor, limit, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublished", reflect.TypeOf((*MockBlogService)(nil).ListPublished), ctx, filter, cursor, limit, viewerID)
+}
+
+// ListScheduled mocks base method.
+func (m *MockBlogService) ListScheduled(ctx context.Context, authorID uuid.UUID) ([]entity.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduled", ctx, authorID)
+	ret0, _ := ret[0].([]entity.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduled indicates an expected call of ListScheduled.
+func (mr *MockBlogServiceMockRecorder) ListScheduled(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduled", reflect.TypeOf((*MockBlogService)(nil).ListScheduled), ctx, authorID)
+}
+
 // Publish mocks base method.
-func (m *MockBlogService) Publish(ctx context.Context, id, authorID uuid.UUID, visibility entity.BlogVisibility, publishedAt *time.Time) (*entity.Blog, error) {
+func (m *MockBlogService) Publish(ctx context.Context, id, authorID uuid.UUID, visibility entity.BlogVisibility, publishedAt *time.Time, timezone *string) (*entity.Blog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Publish", ctx, id, authorID, visibility, publishedAt)
+	ret := m.ctrl.Call(m, "Publish", ctx, id, authorID, visibility, publishedAt, timezone)
 	ret0, _ := ret[0].(*entity.Blog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Publish indicates an expected call of Publish.
-func (mr *MockBlogServiceMockRecorder) Publish(ctx, id, authorID, visibility, publishedAt any) *gomock.Call {
+func (mr *MockBlogServiceMockRecorder) Publish(ctx, id, authorID, visibility, publishedAt, timezone any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockBlogService)(nil).Publish), ctx, id, authorID, visibility, publishedAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockBlogService)(nil).Publish), ctx, id, authorID, visibility, publishedAt, timezone)
 }
 
 // React mocks base method.
@@ -162,6 +207,21 @@ func (mr *MockBlogServiceMockRecorder) React(ctx, id, userID, reactionType any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "React", reflect.TypeOf((*MockBlogService)(nil).React), ctx, id, userID, reactionType)
 }
 
+// Reschedule mocks base method.
+func (m *MockBlogService) Reschedule(ctx context.Context, id, authorID uuid.UUID, publishAt time.Time, timezone *string) (*entity.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", ctx, id, authorID, publishAt, timezone)
+	ret0, _ := ret[0].(*entity.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockBlogServiceMockRecorder) Reschedule(ctx, id, authorID, publishAt, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockBlogService)(nil).Reschedule), ctx, id, authorID, publishAt, timezone)
+}
+
 // Unpublish mocks base method.
 func (m *MockBlogService) Unpublish(ctx context.Context, id, authorID uuid.UUID) (*entity.Blog, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_renderer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_renderer.go -destination=mocks/mock_content_renderer.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	markdown "github.com/aiagent/pkg/markdown"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContentRenderer is a mock of ContentRenderer interface.
+type MockContentRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentRendererMockRecorder
+	isgomock struct{}
+}
+
+// MockContentRendererMockRecorder is the mock recorder for MockContentRenderer.
+type MockContentRendererMockRecorder struct {
+	mock *MockContentRenderer
+}
+
+// NewMockContentRenderer creates a new mock instance.
+func NewMockContentRenderer(ctrl *gomock.Controller) *MockContentRenderer {
+	mock := &MockContentRenderer{ctrl: ctrl}
+	mock.recorder = &MockContentRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentRenderer) EXPECT() *MockContentRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockContentRenderer) Render(ctx context.Context, blog *entity.Blog) (*markdown.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", ctx, blog)
+	ret0, _ := ret[0].(*markdown.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockContentRendererMockRecorder) Render(ctx, blog any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockContentRenderer)(nil).Render), ctx, blog)
+}
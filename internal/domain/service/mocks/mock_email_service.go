@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: internal/domain/service/email_service.go
+// Source: email_service.go
 //
 // Generated by this command:
 //
-//	mockgen -source=internal/domain/service/email_service.go -destination=internal/domain/service/mocks/mock_email_service.go -package=mocks
+//	mockgen -source=email_service.go -destination=mocks/mock_email_service.go -package=mocks
 //
 
 // Package mocks is a generated GoMock package.
@@ -12,8 +12,10 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/aiagent/internal/domain/entity"
+	service "github.com/aiagent/internal/domain/service"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -56,6 +58,34 @@ func (mr *MockEmailServiceMockRecorder) SendNotification(ctx, userID, notifType,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNotification", reflect.TypeOf((*MockEmailService)(nil).SendNotification), ctx, userID, notifType, data)
 }
 
+// SendPasswordResetEmail mocks base method.
+func (m *MockEmailService) SendPasswordResetEmail(ctx context.Context, userID uuid.UUID, email, token string, expiresIn time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordResetEmail", ctx, userID, email, token, expiresIn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordResetEmail indicates an expected call of SendPasswordResetEmail.
+func (mr *MockEmailServiceMockRecorder) SendPasswordResetEmail(ctx, userID, email, token, expiresIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordResetEmail", reflect.TypeOf((*MockEmailService)(nil).SendPasswordResetEmail), ctx, userID, email, token, expiresIn)
+}
+
+// SendPaymentReceipt mocks base method.
+func (m *MockEmailService) SendPaymentReceipt(ctx context.Context, email, name string, receipt service.PaymentReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPaymentReceipt", ctx, email, name, receipt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPaymentReceipt indicates an expected call of SendPaymentReceipt.
+func (mr *MockEmailServiceMockRecorder) SendPaymentReceipt(ctx, email, name, receipt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPaymentReceipt", reflect.TypeOf((*MockEmailService)(nil).SendPaymentReceipt), ctx, email, name, receipt)
+}
+
 // SendVerificationEmail mocks base method.
 func (m *MockEmailService) SendVerificationEmail(ctx context.Context, userID uuid.UUID, email, token string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: event_bus.go
+//
+// Generated by this command:
+//
+//	mockgen -source=event_bus.go -destination=mocks/mock_event_bus.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	service "github.com/aiagent/internal/domain/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainEvent is a mock of DomainEvent interface.
+type MockDomainEvent struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainEventMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainEventMockRecorder is the mock recorder for MockDomainEvent.
+type MockDomainEventMockRecorder struct {
+	mock *MockDomainEvent
+}
+
+// NewMockDomainEvent creates a new mock instance.
+func NewMockDomainEvent(ctrl *gomock.Controller) *MockDomainEvent {
+	mock := &MockDomainEvent{ctrl: ctrl}
+	mock.recorder = &MockDomainEventMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainEvent) EXPECT() *MockDomainEventMockRecorder {
+	return m.recorder
+}
+
+// Name mocks base method.
+func (m *MockDomainEvent) Name() service.EventName {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(service.EventName)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockDomainEventMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockDomainEvent)(nil).Name))
+}
+
+// MockEventBus is a mock of EventBus interface.
+type MockEventBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventBusMockRecorder
+	isgomock struct{}
+}
+
+// MockEventBusMockRecorder is the mock recorder for MockEventBus.
+type MockEventBusMockRecorder struct {
+	mock *MockEventBus
+}
+
+// NewMockEventBus creates a new mock instance.
+func NewMockEventBus(ctrl *gomock.Controller) *MockEventBus {
+	mock := &MockEventBus{ctrl: ctrl}
+	mock.recorder = &MockEventBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventBus) EXPECT() *MockEventBusMockRecorder {
+	return m.recorder
+}
+
+// Dispatch mocks base method.
+func (m *MockEventBus) Dispatch(ctx context.Context, event service.DomainEvent, handler string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dispatch", ctx, event, handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Dispatch indicates an expected call of Dispatch.
+func (mr *MockEventBusMockRecorder) Dispatch(ctx, event, handler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispatch", reflect.TypeOf((*MockEventBus)(nil).Dispatch), ctx, event, handler)
+}
+
+// Handlers mocks base method.
+func (m *MockEventBus) Handlers(name service.EventName) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handlers", name)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Handlers indicates an expected call of Handlers.
+func (mr *MockEventBusMockRecorder) Handlers(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handlers", reflect.TypeOf((*MockEventBus)(nil).Handlers), name)
+}
+
+// Publish mocks base method.
+func (m *MockEventBus) Publish(ctx context.Context, event service.DomainEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", ctx, event)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventBusMockRecorder) Publish(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventBus)(nil).Publish), ctx, event)
+}
+
+// Subscribe mocks base method.
+func (m *MockEventBus) Subscribe(name service.EventName, handler string, fn service.EventHandler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", name, handler, fn)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventBusMockRecorder) Subscribe(name, handler, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventBus)(nil).Subscribe), name, handler, fn)
+}
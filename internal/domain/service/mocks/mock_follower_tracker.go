@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: follower_tracker.go
+//
+// Generated by this command:
+//
+//	mockgen -source=follower_tracker.go -destination=mocks/mock_follower_tracker.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	valueobject "github.com/aiagent/internal/domain/valueobject"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFollowerTracker is a mock of FollowerTracker interface.
+type MockFollowerTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowerTrackerMockRecorder
+	isgomock struct{}
+}
+
+// MockFollowerTrackerMockRecorder is the mock recorder for MockFollowerTracker.
+type MockFollowerTrackerMockRecorder struct {
+	mock *MockFollowerTracker
+}
+
+// NewMockFollowerTracker creates a new mock instance.
+func NewMockFollowerTracker(ctrl *gomock.Controller) *MockFollowerTracker {
+	mock := &MockFollowerTracker{ctrl: ctrl}
+	mock.recorder = &MockFollowerTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowerTracker) EXPECT() *MockFollowerTrackerMockRecorder {
+	return m.recorder
+}
+
+// RecordFollow mocks base method.
+func (m *MockFollowerTracker) RecordFollow(ctx context.Context, followerID, followingID uuid.UUID, client valueobject.ClientInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFollow", ctx, followerID, followingID, client)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFollow indicates an expected call of RecordFollow.
+func (mr *MockFollowerTrackerMockRecorder) RecordFollow(ctx, followerID, followingID, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFollow", reflect.TypeOf((*MockFollowerTracker)(nil).RecordFollow), ctx, followerID, followingID, client)
+}
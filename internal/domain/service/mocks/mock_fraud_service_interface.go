@@ -59,6 +59,21 @@ func (mr *MockFraudDetectionServiceMockRecorder) BanUser(ctx, adminID, userID, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockFraudDetectionService)(nil).BanUser), ctx, adminID, userID, cmd)
 }
 
+// CancelBatchJob mocks base method.
+func (m *MockFraudDetectionService) CancelBatchJob(ctx context.Context, jobID uuid.UUID) (*valueobject.BatchAnalyzeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBatchJob", ctx, jobID)
+	ret0, _ := ret[0].(*valueobject.BatchAnalyzeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelBatchJob indicates an expected call of CancelBatchJob.
+func (mr *MockFraudDetectionServiceMockRecorder) CancelBatchJob(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBatchJob", reflect.TypeOf((*MockFraudDetectionService)(nil).CancelBatchJob), ctx, jobID)
+}
+
 // GetFraudDashboard mocks base method.
 func (m *MockFraudDetectionService) GetFraudDashboard(ctx context.Context, filter valueobject.FraudDashboardFilter) (*valueobject.FraudDashboardResult, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +149,21 @@ func (mr *MockFraudDetectionServiceMockRecorder) GetUserRiskScore(ctx, userID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRiskScore", reflect.TypeOf((*MockFraudDetectionService)(nil).GetUserRiskScore), ctx, userID)
 }
 
+// ListBatchJobs mocks base method.
+func (m *MockFraudDetectionService) ListBatchJobs(ctx context.Context, filter valueobject.BatchJobFilter) (*valueobject.BatchJobListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBatchJobs", ctx, filter)
+	ret0, _ := ret[0].(*valueobject.BatchJobListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBatchJobs indicates an expected call of ListBatchJobs.
+func (mr *MockFraudDetectionServiceMockRecorder) ListBatchJobs(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchJobs", reflect.TypeOf((*MockFraudDetectionService)(nil).ListBatchJobs), ctx, filter)
+}
+
 // ReviewUser mocks base method.
 func (m *MockFraudDetectionService) ReviewUser(ctx context.Context, adminID, userID uuid.UUID, cmd valueobject.ReviewUserCommand) (*valueobject.ReviewUserResult, error) {
 	m.ctrl.T.Helper()
@@ -203,11 +233,12 @@ func (mr *MockFraudDetectionRepositoryMockRecorder) CreateAdminReview(ctx, revie
 }
 
 // CreateBotNotification mocks base method.
-func (m *MockFraudDetectionRepository) CreateBotNotification(ctx context.Context, notification *entity.BotFollowerNotification) error {
+func (m *MockFraudDetectionRepository) CreateBotNotification(ctx context.Context, notification *entity.BotFollowerNotification) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateBotNotification", ctx, notification)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateBotNotification indicates an expected call of CreateBotNotification.
@@ -512,6 +543,21 @@ func (mr *MockFraudDetectionRepositoryMockRecorder) GetUnprocessedBotSignals(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnprocessedBotSignals", reflect.TypeOf((*MockFraudDetectionRepository)(nil).GetUnprocessedBotSignals), ctx, limit)
 }
 
+// GetUnprocessedBotSignalsAfter mocks base method.
+func (m *MockFraudDetectionRepository) GetUnprocessedBotSignalsAfter(ctx context.Context, from, to time.Time, afterDetectedAt *time.Time, afterID *uuid.UUID, limit int) ([]entity.BotDetectionSignal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnprocessedBotSignalsAfter", ctx, from, to, afterDetectedAt, afterID, limit)
+	ret0, _ := ret[0].([]entity.BotDetectionSignal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnprocessedBotSignalsAfter indicates an expected call of GetUnprocessedBotSignalsAfter.
+func (mr *MockFraudDetectionRepositoryMockRecorder) GetUnprocessedBotSignalsAfter(ctx, from, to, afterDetectedAt, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnprocessedBotSignalsAfter", reflect.TypeOf((*MockFraudDetectionRepository)(nil).GetUnprocessedBotSignalsAfter), ctx, from, to, afterDetectedAt, afterID, limit)
+}
+
 // GetUsersByRiskScoreRange mocks base method.
 func (m *MockFraudDetectionRepository) GetUsersByRiskScoreRange(ctx context.Context, minScore, maxScore, page, pageSize int) ([]entity.UserRiskScore, int, error) {
 	m.ctrl.T.Helper()
@@ -701,6 +747,21 @@ func (m *MockBatchJobService) EXPECT() *MockBatchJobServiceMockRecorder {
 	return m.recorder
 }
 
+// CancelBatchJob mocks base method.
+func (m *MockBatchJobService) CancelBatchJob(ctx context.Context, jobID uuid.UUID) (*valueobject.BatchAnalyzeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBatchJob", ctx, jobID)
+	ret0, _ := ret[0].(*valueobject.BatchAnalyzeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelBatchJob indicates an expected call of CancelBatchJob.
+func (mr *MockBatchJobServiceMockRecorder) CancelBatchJob(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBatchJob", reflect.TypeOf((*MockBatchJobService)(nil).CancelBatchJob), ctx, jobID)
+}
+
 // GetBatchJobStatus mocks base method.
 func (m *MockBatchJobService) GetBatchJobStatus(ctx context.Context, jobID uuid.UUID) (*valueobject.BatchAnalyzeResult, error) {
 	m.ctrl.T.Helper()
@@ -716,6 +777,36 @@ func (mr *MockBatchJobServiceMockRecorder) GetBatchJobStatus(ctx, jobID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchJobStatus", reflect.TypeOf((*MockBatchJobService)(nil).GetBatchJobStatus), ctx, jobID)
 }
 
+// ListBatchJobs mocks base method.
+func (m *MockBatchJobService) ListBatchJobs(ctx context.Context, filter valueobject.BatchJobFilter) (*valueobject.BatchJobListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBatchJobs", ctx, filter)
+	ret0, _ := ret[0].(*valueobject.BatchJobListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBatchJobs indicates an expected call of ListBatchJobs.
+func (mr *MockBatchJobServiceMockRecorder) ListBatchJobs(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchJobs", reflect.TypeOf((*MockBatchJobService)(nil).ListBatchJobs), ctx, filter)
+}
+
+// ResumeInterruptedJobs mocks base method.
+func (m *MockBatchJobService) ResumeInterruptedJobs(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeInterruptedJobs", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeInterruptedJobs indicates an expected call of ResumeInterruptedJobs.
+func (mr *MockBatchJobServiceMockRecorder) ResumeInterruptedJobs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeInterruptedJobs", reflect.TypeOf((*MockBatchJobService)(nil).ResumeInterruptedJobs), ctx)
+}
+
 // StartBatchAnalysis mocks base method.
 func (m *MockBatchJobService) StartBatchAnalysis(ctx context.Context, dateFrom, dateTo *time.Time) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
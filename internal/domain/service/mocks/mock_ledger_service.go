@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ledger_service.go -destination=mocks/mock_ledger_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	service "github.com/aiagent/internal/domain/service"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockLedgerService is a mock of LedgerService interface.
+type MockLedgerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerServiceMockRecorder is the mock recorder for MockLedgerService.
+type MockLedgerServiceMockRecorder struct {
+	mock *MockLedgerService
+}
+
+// NewMockLedgerService creates a new mock instance.
+func NewMockLedgerService(ctrl *gomock.Controller) *MockLedgerService {
+	mock := &MockLedgerService{ctrl: ctrl}
+	mock.recorder = &MockLedgerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerService) EXPECT() *MockLedgerServiceMockRecorder {
+	return m.recorder
+}
+
+// AuthorBalance mocks base method.
+func (m *MockLedgerService) AuthorBalance(ctx context.Context, authorID uuid.UUID, currency string) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorBalance", ctx, authorID, currency)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorBalance indicates an expected call of AuthorBalance.
+func (mr *MockLedgerServiceMockRecorder) AuthorBalance(ctx, authorID, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorBalance", reflect.TypeOf((*MockLedgerService)(nil).AuthorBalance), ctx, authorID, currency)
+}
+
+// AuthorEarnings mocks base method.
+func (m *MockLedgerService) AuthorEarnings(ctx context.Context, authorID uuid.UUID, filter repository.LedgerEarningsFilter) (*service.AuthorEarnings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorEarnings", ctx, authorID, filter)
+	ret0, _ := ret[0].(*service.AuthorEarnings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorEarnings indicates an expected call of AuthorEarnings.
+func (mr *MockLedgerServiceMockRecorder) AuthorEarnings(ctx, authorID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorEarnings", reflect.TypeOf((*MockLedgerService)(nil).AuthorEarnings), ctx, authorID, filter)
+}
+
+// RecordPayment mocks base method.
+func (m *MockLedgerService) RecordPayment(ctx context.Context, dbTx *gorm.DB, tx *entity.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPayment", ctx, dbTx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPayment indicates an expected call of RecordPayment.
+func (mr *MockLedgerServiceMockRecorder) RecordPayment(ctx, dbTx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPayment", reflect.TypeOf((*MockLedgerService)(nil).RecordPayment), ctx, dbTx, tx)
+}
+
+// RecordPayout mocks base method.
+func (m *MockLedgerService) RecordPayout(ctx context.Context, dbTx *gorm.DB, payout *entity.Payout) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPayout", ctx, dbTx, payout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPayout indicates an expected call of RecordPayout.
+func (mr *MockLedgerServiceMockRecorder) RecordPayout(ctx, dbTx, payout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPayout", reflect.TypeOf((*MockLedgerService)(nil).RecordPayout), ctx, dbTx, payout)
+}
+
+// RecordRefund mocks base method.
+func (m *MockLedgerService) RecordRefund(ctx context.Context, dbTx *gorm.DB, tx *entity.Transaction, refund *entity.PaymentRefund) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRefund", ctx, dbTx, tx, refund)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordRefund indicates an expected call of RecordRefund.
+func (mr *MockLedgerServiceMockRecorder) RecordRefund(ctx, dbTx, tx, refund any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRefund", reflect.TypeOf((*MockLedgerService)(nil).RecordRefund), ctx, dbTx, tx, refund)
+}
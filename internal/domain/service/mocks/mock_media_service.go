@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: media_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=media_service.go -destination=mocks/mock_media_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	service "github.com/aiagent/internal/domain/service"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMediaService is a mock of MediaService interface.
+type MockMediaService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMediaServiceMockRecorder is the mock recorder for MockMediaService.
+type MockMediaServiceMockRecorder struct {
+	mock *MockMediaService
+}
+
+// NewMockMediaService creates a new mock instance.
+func NewMockMediaService(ctrl *gomock.Controller) *MockMediaService {
+	mock := &MockMediaService{ctrl: ctrl}
+	mock.recorder = &MockMediaServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaService) EXPECT() *MockMediaServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockMediaService) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMediaServiceMockRecorder) Delete(ctx, ownerID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMediaService)(nil).Delete), ctx, ownerID, id)
+}
+
+// GetUsage mocks base method.
+func (m *MockMediaService) GetUsage(ctx context.Context, ownerID uuid.UUID) (*service.MediaUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, ownerID)
+	ret0, _ := ret[0].(*service.MediaUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockMediaServiceMockRecorder) GetUsage(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockMediaService)(nil).GetUsage), ctx, ownerID)
+}
+
+// List mocks base method.
+func (m *MockMediaService) List(ctx context.Context, ownerID uuid.UUID, purpose *entity.MediaPurpose, pagination repository.Pagination) (*repository.PaginatedResult[entity.MediaAsset], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, ownerID, purpose, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.MediaAsset])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockMediaServiceMockRecorder) List(ctx, ownerID, purpose, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMediaService)(nil).List), ctx, ownerID, purpose, pagination)
+}
+
+// PruneAvatars mocks base method.
+func (m *MockMediaService) PruneAvatars(ctx context.Context, ownerID, keepID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneAvatars", ctx, ownerID, keepID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneAvatars indicates an expected call of PruneAvatars.
+func (mr *MockMediaServiceMockRecorder) PruneAvatars(ctx, ownerID, keepID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneAvatars", reflect.TypeOf((*MockMediaService)(nil).PruneAvatars), ctx, ownerID, keepID)
+}
+
+// Upload mocks base method.
+func (m *MockMediaService) Upload(ctx context.Context, ownerID uuid.UUID, purpose entity.MediaPurpose, r io.Reader) (*entity.MediaAsset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, ownerID, purpose, r)
+	ret0, _ := ret[0].(*entity.MediaAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockMediaServiceMockRecorder) Upload(ctx, ownerID, purpose, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockMediaService)(nil).Upload), ctx, ownerID, purpose, r)
+}
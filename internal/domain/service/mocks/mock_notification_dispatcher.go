@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_dispatcher.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification_dispatcher.go -destination=mocks/mock_notification_dispatcher.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationDispatcher is a mock of NotificationDispatcher interface.
+type MockNotificationDispatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationDispatcherMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationDispatcherMockRecorder is the mock recorder for MockNotificationDispatcher.
+type MockNotificationDispatcherMockRecorder struct {
+	mock *MockNotificationDispatcher
+}
+
+// NewMockNotificationDispatcher creates a new mock instance.
+func NewMockNotificationDispatcher(ctrl *gomock.Controller) *MockNotificationDispatcher {
+	mock := &MockNotificationDispatcher{ctrl: ctrl}
+	mock.recorder = &MockNotificationDispatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationDispatcher) EXPECT() *MockNotificationDispatcherMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotificationDispatcher) Notify(ctx context.Context, userID uuid.UUID, notifType entity.NotificationType, data map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, userID, notifType, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotificationDispatcherMockRecorder) Notify(ctx, userID, notifType, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotificationDispatcher)(nil).Notify), ctx, userID, notifType, data)
+}
+
+// NotifyMany mocks base method.
+func (m *MockNotificationDispatcher) NotifyMany(ctx context.Context, userIDs []uuid.UUID, notifType entity.NotificationType, data map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyMany", ctx, userIDs, notifType, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyMany indicates an expected call of NotifyMany.
+func (mr *MockNotificationDispatcherMockRecorder) NotifyMany(ctx, userIDs, notifType, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyMany", reflect.TypeOf((*MockNotificationDispatcher)(nil).NotifyMany), ctx, userIDs, notifType, data)
+}
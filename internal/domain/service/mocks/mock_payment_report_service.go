@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_report_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payment_report_service.go -destination=mocks/mock_payment_report_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentReportService is a mock of PaymentReportService interface.
+type MockPaymentReportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentReportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentReportServiceMockRecorder is the mock recorder for MockPaymentReportService.
+type MockPaymentReportServiceMockRecorder struct {
+	mock *MockPaymentReportService
+}
+
+// NewMockPaymentReportService creates a new mock instance.
+func NewMockPaymentReportService(ctrl *gomock.Controller) *MockPaymentReportService {
+	mock := &MockPaymentReportService{ctrl: ctrl}
+	mock.recorder = &MockPaymentReportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentReportService) EXPECT() *MockPaymentReportServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateDailyReport mocks base method.
+func (m *MockPaymentReportService) GenerateDailyReport(ctx context.Context, day time.Time) (*entity.PaymentReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateDailyReport", ctx, day)
+	ret0, _ := ret[0].(*entity.PaymentReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateDailyReport indicates an expected call of GenerateDailyReport.
+func (mr *MockPaymentReportServiceMockRecorder) GenerateDailyReport(ctx, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDailyReport", reflect.TypeOf((*MockPaymentReportService)(nil).GenerateDailyReport), ctx, day)
+}
+
+// GetReport mocks base method.
+func (m *MockPaymentReportService) GetReport(ctx context.Context, day string) (*entity.PaymentReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", ctx, day)
+	ret0, _ := ret[0].(*entity.PaymentReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockPaymentReportServiceMockRecorder) GetReport(ctx, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockPaymentReportService)(nil).GetReport), ctx, day)
+}
+
+// ListReports mocks base method.
+func (m *MockPaymentReportService) ListReports(ctx context.Context, pagination repository.Pagination) (*repository.PaginatedResult[entity.PaymentReport], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReports", ctx, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.PaymentReport])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReports indicates an expected call of ListReports.
+func (mr *MockPaymentReportServiceMockRecorder) ListReports(ctx, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*MockPaymentReportService)(nil).ListReports), ctx, pagination)
+}
@@ -14,7 +14,9 @@ import (
 	reflect "reflect"
 
 	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
 	service "github.com/aiagent/internal/domain/service"
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -57,6 +59,21 @@ func (mr *MockPaymentServiceMockRecorder) GetTransactionStatus(ctx, orderID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionStatus", reflect.TypeOf((*MockPaymentService)(nil).GetTransactionStatus), ctx, orderID)
 }
 
+// HandleProviderWebhook mocks base method.
+func (m *MockPaymentService) HandleProviderWebhook(ctx context.Context, provider entity.TransactionProvider, body []byte, signature string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleProviderWebhook", ctx, provider, body, signature)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleProviderWebhook indicates an expected call of HandleProviderWebhook.
+func (mr *MockPaymentServiceMockRecorder) HandleProviderWebhook(ctx, provider, body, signature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleProviderWebhook", reflect.TypeOf((*MockPaymentService)(nil).HandleProviderWebhook), ctx, provider, body, signature)
+}
+
 // HandleSePayWebhook mocks base method.
 func (m *MockPaymentService) HandleSePayWebhook(ctx context.Context, payload service.SePayWebhookPayload) (*entity.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -87,16 +104,62 @@ func (mr *MockPaymentServiceMockRecorder) InitPayment(ctx, req any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitPayment", reflect.TypeOf((*MockPaymentService)(nil).InitPayment), ctx, req)
 }
 
-// VerifySePayWebhookSignature mocks base method.
-func (m *MockPaymentService) VerifySePayWebhookSignature(payload map[string]any, signature string) bool {
+// ListGifts mocks base method.
+func (m *MockPaymentService) ListGifts(ctx context.Context, purchaserID uuid.UUID, pagination repository.Pagination) (*repository.PaginatedResult[entity.GiftSubscription], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerifySePayWebhookSignature", payload, signature)
-	ret0, _ := ret[0].(bool)
-	return ret0
+	ret := m.ctrl.Call(m, "ListGifts", ctx, purchaserID, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.GiftSubscription])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGifts indicates an expected call of ListGifts.
+func (mr *MockPaymentServiceMockRecorder) ListGifts(ctx, purchaserID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGifts", reflect.TypeOf((*MockPaymentService)(nil).ListGifts), ctx, purchaserID, pagination)
+}
+
+// ListReconciliations mocks base method.
+func (m *MockPaymentService) ListReconciliations(ctx context.Context, filter repository.PaymentReconciliationFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.PaymentReconciliation], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReconciliations", ctx, filter, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.PaymentReconciliation])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReconciliations indicates an expected call of ListReconciliations.
+func (mr *MockPaymentServiceMockRecorder) ListReconciliations(ctx, filter, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReconciliations", reflect.TypeOf((*MockPaymentService)(nil).ListReconciliations), ctx, filter, pagination)
+}
+
+// RedeemGift mocks base method.
+func (m *MockPaymentService) RedeemGift(ctx context.Context, recipientID uuid.UUID, code string) (*entity.GiftSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemGift", ctx, recipientID, code)
+	ret0, _ := ret[0].(*entity.GiftSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemGift indicates an expected call of RedeemGift.
+func (mr *MockPaymentServiceMockRecorder) RedeemGift(ctx, recipientID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemGift", reflect.TypeOf((*MockPaymentService)(nil).RedeemGift), ctx, recipientID, code)
+}
+
+// ResolveReconciliation mocks base method.
+func (m *MockPaymentService) ResolveReconciliation(ctx context.Context, id, adminID uuid.UUID, grant bool, note *string) (*entity.PaymentReconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReconciliation", ctx, id, adminID, grant, note)
+	ret0, _ := ret[0].(*entity.PaymentReconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// VerifySePayWebhookSignature indicates an expected call of VerifySePayWebhookSignature.
-func (mr *MockPaymentServiceMockRecorder) VerifySePayWebhookSignature(payload, signature any) *gomock.Call {
+// ResolveReconciliation indicates an expected call of ResolveReconciliation.
+func (mr *MockPaymentServiceMockRecorder) ResolveReconciliation(ctx, id, adminID, grant, note any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySePayWebhookSignature", reflect.TypeOf((*MockPaymentService)(nil).VerifySePayWebhookSignature), payload, signature)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReconciliation", reflect.TypeOf((*MockPaymentService)(nil).ResolveReconciliation), ctx, id, adminID, grant, note)
 }
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payout_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=payout_service.go -destination=mocks/mock_payout_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	repository "github.com/aiagent/internal/domain/repository"
+	service "github.com/aiagent/internal/domain/service"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPayoutService is a mock of PayoutService interface.
+type MockPayoutService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPayoutServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPayoutServiceMockRecorder is the mock recorder for MockPayoutService.
+type MockPayoutServiceMockRecorder struct {
+	mock *MockPayoutService
+}
+
+// NewMockPayoutService creates a new mock instance.
+func NewMockPayoutService(ctrl *gomock.Controller) *MockPayoutService {
+	mock := &MockPayoutService{ctrl: ctrl}
+	mock.recorder = &MockPayoutServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPayoutService) EXPECT() *MockPayoutServiceMockRecorder {
+	return m.recorder
+}
+
+// ListPayouts mocks base method.
+func (m *MockPayoutService) ListPayouts(ctx context.Context, filter repository.PayoutFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.Payout], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPayouts", ctx, filter, pagination)
+	ret0, _ := ret[0].(*repository.PaginatedResult[entity.Payout])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPayouts indicates an expected call of ListPayouts.
+func (mr *MockPayoutServiceMockRecorder) ListPayouts(ctx, filter, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayouts", reflect.TypeOf((*MockPayoutService)(nil).ListPayouts), ctx, filter, pagination)
+}
+
+// RequestPayout mocks base method.
+func (m *MockPayoutService) RequestPayout(ctx context.Context, authorID uuid.UUID, req service.PayoutRequest) (*entity.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPayout", ctx, authorID, req)
+	ret0, _ := ret[0].(*entity.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPayout indicates an expected call of RequestPayout.
+func (mr *MockPayoutServiceMockRecorder) RequestPayout(ctx, authorID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPayout", reflect.TypeOf((*MockPayoutService)(nil).RequestPayout), ctx, authorID, req)
+}
+
+// ReviewPayout mocks base method.
+func (m *MockPayoutService) ReviewPayout(ctx context.Context, id, adminID uuid.UUID, approve bool, note, reference *string) (*entity.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewPayout", ctx, id, adminID, approve, note, reference)
+	ret0, _ := ret[0].(*entity.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewPayout indicates an expected call of ReviewPayout.
+func (mr *MockPayoutServiceMockRecorder) ReviewPayout(ctx, id, adminID, approve, note, reference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewPayout", reflect.TypeOf((*MockPayoutService)(nil).ReviewPayout), ctx, id, adminID, approve, note, reference)
+}
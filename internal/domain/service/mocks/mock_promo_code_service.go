@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: promo_code_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=promo_code_service.go -destination=mocks/mock_promo_code_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	service "github.com/aiagent/internal/domain/service"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPromoCodeService is a mock of PromoCodeService interface.
+type MockPromoCodeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoCodeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPromoCodeServiceMockRecorder is the mock recorder for MockPromoCodeService.
+type MockPromoCodeServiceMockRecorder struct {
+	mock *MockPromoCodeService
+}
+
+// NewMockPromoCodeService creates a new mock instance.
+func NewMockPromoCodeService(ctrl *gomock.Controller) *MockPromoCodeService {
+	mock := &MockPromoCodeService{ctrl: ctrl}
+	mock.recorder = &MockPromoCodeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoCodeService) EXPECT() *MockPromoCodeServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockPromoCodeService) CreatePromoCode(ctx context.Context, authorID uuid.UUID, req service.CreatePromoCodeRequest) (*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", ctx, authorID, req)
+	ret0, _ := ret[0].(*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockPromoCodeServiceMockRecorder) CreatePromoCode(ctx, authorID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockPromoCodeService)(nil).CreatePromoCode), ctx, authorID, req)
+}
+
+// DeactivatePromoCode mocks base method.
+func (m *MockPromoCodeService) DeactivatePromoCode(ctx context.Context, authorID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivatePromoCode", ctx, authorID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivatePromoCode indicates an expected call of DeactivatePromoCode.
+func (mr *MockPromoCodeServiceMockRecorder) DeactivatePromoCode(ctx, authorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivatePromoCode", reflect.TypeOf((*MockPromoCodeService)(nil).DeactivatePromoCode), ctx, authorID, id)
+}
+
+// ListPromoCodes mocks base method.
+func (m *MockPromoCodeService) ListPromoCodes(ctx context.Context, authorID uuid.UUID) ([]entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPromoCodes", ctx, authorID)
+	ret0, _ := ret[0].([]entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPromoCodes indicates an expected call of ListPromoCodes.
+func (mr *MockPromoCodeServiceMockRecorder) ListPromoCodes(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromoCodes", reflect.TypeOf((*MockPromoCodeService)(nil).ListPromoCodes), ctx, authorID)
+}
@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: refund_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=refund_service.go -destination=mocks/mock_refund_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	service "github.com/aiagent/internal/domain/service"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefundService is a mock of RefundService interface.
+type MockRefundService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefundServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRefundServiceMockRecorder is the mock recorder for MockRefundService.
+type MockRefundServiceMockRecorder struct {
+	mock *MockRefundService
+}
+
+// NewMockRefundService creates a new mock instance.
+func NewMockRefundService(ctrl *gomock.Controller) *MockRefundService {
+	mock := &MockRefundService{ctrl: ctrl}
+	mock.recorder = &MockRefundServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundService) EXPECT() *MockRefundServiceMockRecorder {
+	return m.recorder
+}
+
+// ListRefunds mocks base method.
+func (m *MockRefundService) ListRefunds(ctx context.Context, transactionID uuid.UUID) ([]entity.PaymentRefund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRefunds", ctx, transactionID)
+	ret0, _ := ret[0].([]entity.PaymentRefund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRefunds indicates an expected call of ListRefunds.
+func (mr *MockRefundServiceMockRecorder) ListRefunds(ctx, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRefunds", reflect.TypeOf((*MockRefundService)(nil).ListRefunds), ctx, transactionID)
+}
+
+// RefundTransaction mocks base method.
+func (m *MockRefundService) RefundTransaction(ctx context.Context, transactionID, adminID uuid.UUID, cmd service.RefundCommand) (*entity.PaymentRefund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundTransaction", ctx, transactionID, adminID, cmd)
+	ret0, _ := ret[0].(*entity.PaymentRefund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundTransaction indicates an expected call of RefundTransaction.
+func (mr *MockRefundServiceMockRecorder) RefundTransaction(ctx, transactionID, adminID, cmd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundTransaction", reflect.TypeOf((*MockRefundService)(nil).RefundTransaction), ctx, transactionID, adminID, cmd)
+}
+
+// RetryPendingRefunds mocks base method.
+func (m *MockRefundService) RetryPendingRefunds(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryPendingRefunds", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryPendingRefunds indicates an expected call of RetryPendingRefunds.
+func (mr *MockRefundServiceMockRecorder) RetryPendingRefunds(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryPendingRefunds", reflect.TypeOf((*MockRefundService)(nil).RetryPendingRefunds), ctx, limit)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: series_access_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=series_access_service.go -destination=mocks/mock_series_access_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/aiagent/internal/domain/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSeriesAccessService is a mock of SeriesAccessService interface.
+type MockSeriesAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesAccessServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSeriesAccessServiceMockRecorder is the mock recorder for MockSeriesAccessService.
+type MockSeriesAccessServiceMockRecorder struct {
+	mock *MockSeriesAccessService
+}
+
+// NewMockSeriesAccessService creates a new mock instance.
+func NewMockSeriesAccessService(ctrl *gomock.Controller) *MockSeriesAccessService {
+	mock := &MockSeriesAccessService{ctrl: ctrl}
+	mock.recorder = &MockSeriesAccessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesAccessService) EXPECT() *MockSeriesAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// LockingSeries mocks base method.
+func (m *MockSeriesAccessService) LockingSeries(ctx context.Context, blog *entity.Blog, viewerID *uuid.UUID) ([]entity.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockingSeries", ctx, blog, viewerID)
+	ret0, _ := ret[0].([]entity.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockingSeries indicates an expected call of LockingSeries.
+func (mr *MockSeriesAccessServiceMockRecorder) LockingSeries(ctx, blog, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockingSeries", reflect.TypeOf((*MockSeriesAccessService)(nil).LockingSeries), ctx, blog, viewerID)
+}
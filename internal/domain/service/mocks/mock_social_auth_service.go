@@ -42,12 +42,13 @@ func (m *MockSocialAuthService) EXPECT() *MockSocialAuthServiceMockRecorder {
 }
 
 // GetAuthURL mocks base method.
-func (m *MockSocialAuthService) GetAuthURL(ctx context.Context, provider string) (string, error) {
+func (m *MockSocialAuthService) GetAuthURL(ctx context.Context, provider string) (string, string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAuthURL", ctx, provider)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetAuthURL indicates an expected call of GetAuthURL.
@@ -57,16 +58,16 @@ func (mr *MockSocialAuthServiceMockRecorder) GetAuthURL(ctx, provider any) *gomo
 }
 
 // GetUserInfo mocks base method.
-func (m *MockSocialAuthService) GetUserInfo(ctx context.Context, provider, code string) (*service.SocialUserInfo, error) {
+func (m *MockSocialAuthService) GetUserInfo(ctx context.Context, provider, code, state string) (*service.SocialUserInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserInfo", ctx, provider, code)
+	ret := m.ctrl.Call(m, "GetUserInfo", ctx, provider, code, state)
 	ret0, _ := ret[0].(*service.SocialUserInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserInfo indicates an expected call of GetUserInfo.
-func (mr *MockSocialAuthServiceMockRecorder) GetUserInfo(ctx, provider, code any) *gomock.Call {
+func (mr *MockSocialAuthServiceMockRecorder) GetUserInfo(ctx, provider, code, state any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockSocialAuthService)(nil).GetUserInfo), ctx, provider, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockSocialAuthService)(nil).GetUserInfo), ctx, provider, code, state)
 }
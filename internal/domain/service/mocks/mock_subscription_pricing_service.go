@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: subscription_pricing_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=subscription_pricing_service.go -destination=mocks/mock_subscription_pricing_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	service "github.com/aiagent/internal/domain/service"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSubscriptionPricingService is a mock of SubscriptionPricingService interface.
+type MockSubscriptionPricingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionPricingServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSubscriptionPricingServiceMockRecorder is the mock recorder for MockSubscriptionPricingService.
+type MockSubscriptionPricingServiceMockRecorder struct {
+	mock *MockSubscriptionPricingService
+}
+
+// NewMockSubscriptionPricingService creates a new mock instance.
+func NewMockSubscriptionPricingService(ctrl *gomock.Controller) *MockSubscriptionPricingService {
+	mock := &MockSubscriptionPricingService{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionPricingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionPricingService) EXPECT() *MockSubscriptionPricingServiceMockRecorder {
+	return m.recorder
+}
+
+// Quote mocks base method.
+func (m *MockSubscriptionPricingService) Quote(ctx context.Context, subscriberID, authorID, planID uuid.UUID) (*service.SubscriptionQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", ctx, subscriberID, authorID, planID)
+	ret0, _ := ret[0].(*service.SubscriptionQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockSubscriptionPricingServiceMockRecorder) Quote(ctx, subscriberID, authorID, planID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockSubscriptionPricingService)(nil).Quote), ctx, subscriberID, authorID, planID)
+}
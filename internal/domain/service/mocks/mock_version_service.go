@@ -15,6 +15,7 @@ import (
 
 	entity "github.com/aiagent/internal/domain/entity"
 	repository "github.com/aiagent/internal/domain/repository"
+	service "github.com/aiagent/internal/domain/service"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -43,6 +44,21 @@ func (m *MockVersionService) EXPECT() *MockVersionServiceMockRecorder {
 	return m.recorder
 }
 
+// CompareVersions mocks base method.
+func (m *MockVersionService) CompareVersions(ctx context.Context, blogID, fromID, toID uuid.UUID) (*service.VersionComparison, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareVersions", ctx, blogID, fromID, toID)
+	ret0, _ := ret[0].(*service.VersionComparison)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareVersions indicates an expected call of CompareVersions.
+func (mr *MockVersionServiceMockRecorder) CompareVersions(ctx, blogID, fromID, toID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareVersions", reflect.TypeOf((*MockVersionService)(nil).CompareVersions), ctx, blogID, fromID, toID)
+}
+
 // CreateVersion mocks base method.
 func (m *MockVersionService) CreateVersion(ctx context.Context, blog *entity.Blog, editorID uuid.UUID, changeSummary string) (*entity.BlogVersion, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +88,21 @@ func (mr *MockVersionServiceMockRecorder) DeleteVersion(ctx, versionID, requeste
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersion", reflect.TypeOf((*MockVersionService)(nil).DeleteVersion), ctx, versionID, requesterID)
 }
 
+// GetRetention mocks base method.
+func (m *MockVersionService) GetRetention(ctx context.Context, blogID uuid.UUID) (*entity.BlogVersionRetention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRetention", ctx, blogID)
+	ret0, _ := ret[0].(*entity.BlogVersionRetention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRetention indicates an expected call of GetRetention.
+func (mr *MockVersionServiceMockRecorder) GetRetention(ctx, blogID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetention", reflect.TypeOf((*MockVersionService)(nil).GetRetention), ctx, blogID)
+}
+
 // GetVersion mocks base method.
 func (m *MockVersionService) GetVersion(ctx context.Context, versionID uuid.UUID) (*entity.BlogVersion, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +133,21 @@ func (mr *MockVersionServiceMockRecorder) ListVersions(ctx, blogID, pagination a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockVersionService)(nil).ListVersions), ctx, blogID, pagination)
 }
 
+// PruneExpiredVersions mocks base method.
+func (m *MockVersionService) PruneExpiredVersions(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneExpiredVersions", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneExpiredVersions indicates an expected call of PruneExpiredVersions.
+func (mr *MockVersionServiceMockRecorder) PruneExpiredVersions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneExpiredVersions", reflect.TypeOf((*MockVersionService)(nil).PruneExpiredVersions), ctx)
+}
+
 // RestoreVersion mocks base method.
 func (m *MockVersionService) RestoreVersion(ctx context.Context, blogID, versionID, editorID uuid.UUID) (*entity.Blog, error) {
 	m.ctrl.T.Helper()
@@ -116,3 +162,17 @@ func (mr *MockVersionServiceMockRecorder) RestoreVersion(ctx, blogID, versionID,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVersion", reflect.TypeOf((*MockVersionService)(nil).RestoreVersion), ctx, blogID, versionID, editorID)
 }
+
+// SetRetention mocks base method.
+func (m *MockVersionService) SetRetention(ctx context.Context, retention *entity.BlogVersionRetention) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRetention", ctx, retention)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRetention indicates an expected call of SetRetention.
+func (mr *MockVersionServiceMockRecorder) SetRetention(ctx, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetention", reflect.TypeOf((*MockVersionService)(nil).SetRetention), ctx, retention)
+}
@@ -1,5 +1,7 @@
 package service
 
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
 import (
 	"context"
 	"fmt"
@@ -28,6 +30,11 @@ type NotificationDispatcher interface {
 	// 7. Send FCM push if channel enabled
 	// 8. Handle errors gracefully
 	Notify(ctx context.Context, userID uuid.UUID, notifType entity.NotificationType, data map[string]interface{}) error
+
+	// NotifyMany sends one notification to a batch of users with a single in-app insert.
+	// It returns an error when the batch could not be stored so the caller can retry it;
+	// a retry does not duplicate in-app notifications but sends push and email again.
+	NotifyMany(ctx context.Context, userIDs []uuid.UUID, notifType entity.NotificationType, data map[string]interface{}) error
 }
 
 // notificationDispatcher implements the NotificationDispatcher interface
@@ -132,67 +139,147 @@ func (d *notificationDispatcher) Notify(
 	}
 
 	// Step 6: Asynchronous dispatch to other channels
-	channels := []string{"push", "email"}
-	for _, channel := range channels {
-		switch channel {
-		case "push":
-			if pushEnabled && d.firebase != nil && d.taskRunner != nil {
-				d.taskRunner.Submit(func(ctx context.Context) {
-					tokens, err := d.tokenRepo.FindByUserID(ctx, userID)
-					if err != nil {
-						log.Printf("Error: failed to get device tokens for user %s: %v", userID, err)
-						return
-					}
-					if len(tokens) > 0 {
-						title := d.generateTitle(notifType, data)
-						body := d.generateBody(notifType, data)
-
-						// Enrich data map for Firebase validation
-						enrichedData := make(map[string]interface{})
-						for k, v := range data {
-							enrichedData[k] = v
-						}
-						if _, ok := enrichedData["category"]; !ok {
-							enrichedData["category"] = string(d.getCategory(notifType))
-						}
-						if _, ok := enrichedData["target_type"]; !ok {
-							// Infer target type from notification type if missing
-							switch notifType {
-							case entity.NotificationTypeBlogLike, entity.NotificationTypeBlogComment, entity.NotificationTypeNewBlogFromFollowing:
-								enrichedData["target_type"] = "blog"
-							case entity.NotificationTypeCommentReply:
-								enrichedData["target_type"] = "comment"
-							case entity.NotificationTypeNewFollower, entity.NotificationTypeMention:
-								enrichedData["target_type"] = "user"
-							default:
-								enrichedData["target_type"] = "user"
-							}
-						}
-						// Ensure target_id is present for validation
-						if _, ok := enrichedData["target_id"]; !ok {
-							enrichedData["target_id"] = userID.String()
-						}
-
-						if err := d.firebase.SendPushToUser(ctx, userID, title, body, enrichedData); err != nil {
-							log.Printf("Error: failed to send FCM push for user %s: %v", userID, err)
-						}
-					}
-				})
-			}
-		case "email":
-			if emailEnabled && d.email != nil && d.taskRunner != nil {
-				d.taskRunner.Submit(func(ctx context.Context) {
-					if err := d.email.SendNotification(ctx, userID, notifType, data); err != nil {
-						log.Printf("Error: failed to send email notification for user %s: %v", userID, err)
-					}
-				})
-			}
+	if pushEnabled {
+		d.submitPush(userID, notifType, data)
+	}
+	if emailEnabled {
+		d.submitEmail(userID, notifType, data)
+	}
+
+	return nil
+}
+
+// NotifyMany sends the same notification to a batch of users. In-app notifications
+// are inserted in one statement and keyed by user and target, so retrying a batch
+// does not store them twice. Push and email are at-least-once: a retried batch sends
+// them again to every recipient. Rate limiting and aggregation are skipped because
+// each recipient gets the notification once per batch.
+func (d *notificationDispatcher) NotifyMany(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+	notifType entity.NotificationType,
+	data map[string]interface{},
+) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	inAppDisabled, err := d.disabledUsers(ctx, userIDs, notifType, "in_app")
+	if err != nil {
+		return err
+	}
+	pushDisabled, err := d.disabledUsers(ctx, userIDs, notifType, "push")
+	if err != nil {
+		return err
+	}
+	emailDisabled, err := d.disabledUsers(ctx, userIDs, notifType, "email")
+	if err != nil {
+		return err
+	}
+
+	targetID := extractTargetID(data)
+	notifs := make([]*entity.Notification, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if inAppDisabled[userID] {
+			continue
 		}
+		notif := d.prepareNotification(userID, notifType, data)
+		notif.ID = uuid.NewSHA1(targetID, []byte(string(notifType)+"/"+userID.String()))
+		notifs = append(notifs, notif)
+	}
+	if err := d.notifRepo.CreateBatch(ctx, notifs); err != nil {
+		return fmt.Errorf("failed to save notifications: %w", err)
 	}
 
+	for _, userID := range userIDs {
+		if !pushDisabled[userID] {
+			d.submitPush(userID, notifType, data)
+		}
+		if !emailDisabled[userID] {
+			d.submitEmail(userID, notifType, data)
+		}
+	}
 	return nil
 }
 
+// disabledUsers returns the set of users who turned a channel off for the notification type
+func (d *notificationDispatcher) disabledUsers(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+	notifType entity.NotificationType,
+	channel string,
+) (map[uuid.UUID]bool, error) {
+	ids, err := d.prefRepo.FindDisabledUserIDs(ctx, userIDs, notifType, channel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s preferences: %w", channel, err)
+	}
+	disabled := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		disabled[id] = true
+	}
+	return disabled, nil
+}
+
+// submitPush queues an FCM push to every registered device of the user
+func (d *notificationDispatcher) submitPush(userID uuid.UUID, notifType entity.NotificationType, data map[string]interface{}) {
+	if d.firebase == nil || d.taskRunner == nil {
+		return
+	}
+	d.taskRunner.Submit(func(ctx context.Context) {
+		tokens, err := d.tokenRepo.FindByUserID(ctx, userID)
+		if err != nil {
+			log.Printf("Error: failed to get device tokens for user %s: %v", userID, err)
+			return
+		}
+		if len(tokens) > 0 {
+			title := d.generateTitle(notifType, data)
+			body := d.generateBody(notifType, data)
+
+			// Enrich data map for Firebase validation
+			enrichedData := make(map[string]interface{})
+			for k, v := range data {
+				enrichedData[k] = v
+			}
+			if _, ok := enrichedData["category"]; !ok {
+				enrichedData["category"] = string(d.getCategory(notifType))
+			}
+			if _, ok := enrichedData["target_type"]; !ok {
+				// Infer target type from notification type if missing
+				switch notifType {
+				case entity.NotificationTypeBlogLike, entity.NotificationTypeBlogComment, entity.NotificationTypeNewBlogFromFollowing:
+					enrichedData["target_type"] = "blog"
+				case entity.NotificationTypeCommentReply:
+					enrichedData["target_type"] = "comment"
+				case entity.NotificationTypeNewFollower, entity.NotificationTypeMention:
+					enrichedData["target_type"] = "user"
+				default:
+					enrichedData["target_type"] = "user"
+				}
+			}
+			// Ensure target_id is present for validation
+			if _, ok := enrichedData["target_id"]; !ok {
+				enrichedData["target_id"] = userID.String()
+			}
+
+			if err := d.firebase.SendPushToUser(ctx, userID, title, body, enrichedData); err != nil {
+				log.Printf("Error: failed to send FCM push for user %s: %v", userID, err)
+			}
+		}
+	})
+}
+
+// submitEmail queues an email notification to the user
+func (d *notificationDispatcher) submitEmail(userID uuid.UUID, notifType entity.NotificationType, data map[string]interface{}) {
+	if d.email == nil || d.taskRunner == nil {
+		return
+	}
+	d.taskRunner.Submit(func(ctx context.Context) {
+		if err := d.email.SendNotification(ctx, userID, notifType, data); err != nil {
+			log.Printf("Error: failed to send email notification for user %s: %v", userID, err)
+		}
+	})
+}
+
 // prepareNotification creates a new notification entity
 func (d *notificationDispatcher) prepareNotification(
 	userID uuid.UUID,
@@ -244,7 +331,10 @@ func (d *notificationDispatcher) getCategory(notifType entity.NotificationType)
 		entity.NotificationTypeSeriesUpdate:
 		return entity.NotificationCategoryContent
 	case entity.NotificationTypeBotFollowerDetected,
-		entity.NotificationTypeBadgeStatusChange:
+		entity.NotificationTypeBadgeStatusChange,
+		entity.NotificationTypeSubscriptionExpiring,
+		entity.NotificationTypeSubscriptionGrace,
+		entity.NotificationTypeSubscriptionEnded:
 		return entity.NotificationCategorySystem
 	default:
 		return entity.NotificationCategorySocial
@@ -272,6 +362,12 @@ func (d *notificationDispatcher) generateTitle(notifType entity.NotificationType
 		return "Bot Follower Detected"
 	case entity.NotificationTypeBadgeStatusChange:
 		return "Badge Status Changed"
+	case entity.NotificationTypeSubscriptionExpiring:
+		return "Subscription Expiring Soon"
+	case entity.NotificationTypeSubscriptionGrace:
+		return "Subscription Expired"
+	case entity.NotificationTypeSubscriptionEnded:
+		return "Subscription Ended"
 	default:
 		return "Notification"
 	}
@@ -323,6 +419,20 @@ func (d *notificationDispatcher) generateBody(notifType entity.NotificationType,
 			status = s
 		}
 		return "Your badge status has changed to: " + status
+	case entity.NotificationTypeSubscriptionExpiring:
+		expiresAt := ""
+		if at, ok := data["expires_at"].(string); ok {
+			expiresAt = at
+		}
+		return "Your subscription to " + actorName + " expires on " + expiresAt + ". Renew to keep your access"
+	case entity.NotificationTypeSubscriptionGrace:
+		graceEndsAt := ""
+		if at, ok := data["grace_ends_at"].(string); ok {
+			graceEndsAt = at
+		}
+		return "Your subscription to " + actorName + " has expired. Renew before " + graceEndsAt + " to keep your access"
+	case entity.NotificationTypeSubscriptionEnded:
+		return "Your subscription to " + actorName + " has ended and you are now a free follower"
 	default:
 		return "You have a new notification"
 	}
@@ -524,3 +524,68 @@ func TestNotificationDispatcher_Notify_EmailOnly_Success(t *testing.T) {
 	dispatcher := service.NewNotificationDispatcher(mockNotifRepo, mockPrefRepo, mockTokenRepo, mockAggregator, mockFirebase, mockEmail, mockTaskRunner)
 	assert.NoError(t, dispatcher.Notify(ctx, userID, notifType, data))
 }
+
+func TestNotificationDispatcher_NotifyMany_InsertsOneBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	notifType := entity.NotificationTypeNewBlogFromFollowing
+	blogID := uuid.New()
+	active, muted := uuid.New(), uuid.New()
+	data := map[string]interface{}{"target_id": blogID.String(), "actor_name": "Eve", "blog_title": "Hello"}
+
+	mockNotifRepo := mocks.NewMockNotificationRepository(ctrl)
+	mockPrefRepo := mocks.NewMockNotificationPreferenceRepository(ctrl)
+	mockTaskRunner := servicemocks.NewMockTaskRunner(ctrl)
+	mockEmail := servicemocks.NewMockEmailService(ctrl)
+
+	users := []uuid.UUID{active, muted}
+	mockPrefRepo.EXPECT().FindDisabledUserIDs(ctx, users, notifType, "in_app").Return([]uuid.UUID{muted}, nil)
+	mockPrefRepo.EXPECT().FindDisabledUserIDs(ctx, users, notifType, "push").Return(nil, nil)
+	mockPrefRepo.EXPECT().FindDisabledUserIDs(ctx, users, notifType, "email").Return([]uuid.UUID{active, muted}, nil)
+
+	var saved []*entity.Notification
+	mockNotifRepo.EXPECT().CreateBatch(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, notifs []*entity.Notification) error {
+		saved = notifs
+		return nil
+	})
+
+	dispatcher := service.NewNotificationDispatcher(mockNotifRepo, mockPrefRepo, nil, nil, nil, mockEmail, mockTaskRunner)
+	assert.NoError(t, dispatcher.NotifyMany(ctx, users, notifType, data))
+
+	if assert.Len(t, saved, 1) {
+		assert.Equal(t, active, saved[0].UserID)
+		assert.Equal(t, "Eve published a new blog: Hello", saved[0].Body)
+		firstID := saved[0].ID
+
+		// A retried batch produces the same IDs, so the insert skips rows it already stored
+		mockPrefRepo.EXPECT().FindDisabledUserIDs(ctx, users, notifType, gomock.Any()).Return(nil, nil).Times(3)
+		mockNotifRepo.EXPECT().CreateBatch(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, notifs []*entity.Notification) error {
+			assert.Equal(t, firstID, notifs[0].ID)
+			return nil
+		})
+		mockTaskRunner.EXPECT().Submit(gomock.Any()).Times(2)
+		assert.NoError(t, dispatcher.NotifyMany(ctx, users, notifType, data))
+	}
+}
+
+func TestNotificationDispatcher_NotifyMany_SaveError_ReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	notifType := entity.NotificationTypeNewBlogFromFollowing
+	users := []uuid.UUID{uuid.New()}
+
+	mockNotifRepo := mocks.NewMockNotificationRepository(ctrl)
+	mockPrefRepo := mocks.NewMockNotificationPreferenceRepository(ctrl)
+	mockTaskRunner := servicemocks.NewMockTaskRunner(ctrl)
+
+	mockPrefRepo.EXPECT().FindDisabledUserIDs(ctx, users, notifType, gomock.Any()).Return(nil, nil).Times(3)
+	mockNotifRepo.EXPECT().CreateBatch(ctx, gomock.Any()).Return(errors.New("statement timeout"))
+
+	// Nothing is pushed or mailed for a batch that was not stored
+	dispatcher := service.NewNotificationDispatcher(mockNotifRepo, mockPrefRepo, nil, nil, nil, servicemocks.NewMockEmailService(ctrl), mockTaskRunner)
+	assert.Error(t, dispatcher.NotifyMany(ctx, users, notifType, map[string]interface{}{}))
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/pkg/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	// followerFanOutBatchSize is how many followers one fan-out event notifies
+	// when a blog is published, keeping each delivery well within the relay lease.
+	followerFanOutBatchSize = 100
+)
+
+// NotificationEventSubscriber turns domain events into user notifications
+type NotificationEventSubscriber struct {
+	dispatcher       NotificationDispatcher
+	userRepo         repository.UserRepository
+	blogRepo         repository.BlogRepository
+	commentRepo      repository.CommentRepository
+	subscriptionRepo repository.SubscriptionRepository
+	outboxRepo       repository.OutboxRepository
+	batchSize        int
+}
+
+// NewNotificationEventSubscriber creates a new NotificationEventSubscriber
+func NewNotificationEventSubscriber(
+	dispatcher NotificationDispatcher,
+	userRepo repository.UserRepository,
+	blogRepo repository.BlogRepository,
+	commentRepo repository.CommentRepository,
+	subscriptionRepo repository.SubscriptionRepository,
+	outboxRepo repository.OutboxRepository,
+) *NotificationEventSubscriber {
+	return &NotificationEventSubscriber{
+		dispatcher:       dispatcher,
+		userRepo:         userRepo,
+		blogRepo:         blogRepo,
+		commentRepo:      commentRepo,
+		subscriptionRepo: subscriptionRepo,
+		outboxRepo:       outboxRepo,
+		batchSize:        followerFanOutBatchSize,
+	}
+}
+
+// Register subscribes the notification handlers to the event bus
+func (s *NotificationEventSubscriber) Register(bus EventBus) {
+	bus.Subscribe(EventUserFollowed, "notification.new_follower", s.HandleUserFollowed)
+	bus.Subscribe(EventCommentCreated, "notification.comment", s.HandleCommentCreated)
+	bus.Subscribe(EventBlogPublished, "notification.blog_published", s.HandleBlogPublished)
+	bus.Subscribe(EventBlogFanOut, "notification.blog_fan_out", s.HandleBlogFanOut)
+}
+
+// HandleUserFollowed notifies the followed user about their new follower
+func (s *NotificationEventSubscriber) HandleUserFollowed(ctx context.Context, event DomainEvent) error {
+	e, ok := event.(UserFollowedEvent)
+	if !ok {
+		return fmt.Errorf("unexpected event type %T", event)
+	}
+
+	data := map[string]interface{}{
+		"target_id":   e.FollowerID.String(),
+		"target_type": "user",
+		"actor_id":    e.FollowerID.String(),
+		"actor_name":  s.actorName(ctx, e.FollowerID),
+	}
+	return s.dispatcher.Notify(ctx, e.FolloweeID, entity.NotificationTypeNewFollower, data)
+}
+
+// HandleCommentCreated notifies the parent comment author about a reply
+// and the blog author about a new comment on their blog
+func (s *NotificationEventSubscriber) HandleCommentCreated(ctx context.Context, event DomainEvent) error {
+	e, ok := event.(CommentCreatedEvent)
+	if !ok {
+		return fmt.Errorf("unexpected event type %T", event)
+	}
+
+	blog, err := s.blogRepo.FindByID(ctx, e.BlogID)
+	if err != nil {
+		return fmt.Errorf("failed to load blog: %w", err)
+	}
+	if blog == nil {
+		return nil
+	}
+
+	actorName := s.actorName(ctx, e.UserID)
+	notified := map[uuid.UUID]bool{e.UserID: true}
+
+	if e.ParentID != nil {
+		parent, err := s.commentRepo.FindByID(ctx, *e.ParentID)
+		if err != nil {
+			return fmt.Errorf("failed to load parent comment: %w", err)
+		}
+		if parent != nil && !notified[parent.UserID] {
+			notified[parent.UserID] = true
+			data := map[string]interface{}{
+				"target_id":   parent.ID.String(),
+				"target_type": "comment",
+				"comment_id":  e.CommentID.String(),
+				"blog_id":     blog.ID.String(),
+				"blog_title":  blog.Title,
+				"actor_id":    e.UserID.String(),
+				"actor_name":  actorName,
+			}
+			if err := s.dispatcher.Notify(ctx, parent.UserID, entity.NotificationTypeCommentReply, data); err != nil {
+				logger.Error("failed to notify comment reply", err, map[string]interface{}{"comment_id": e.CommentID})
+			}
+		}
+	}
+
+	if notified[blog.AuthorID] {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"target_id":   blog.ID.String(),
+		"target_type": "blog",
+		"comment_id":  e.CommentID.String(),
+		"blog_title":  blog.Title,
+		"actor_id":    e.UserID.String(),
+		"actor_name":  actorName,
+	}
+	return s.dispatcher.Notify(ctx, blog.AuthorID, entity.NotificationTypeBlogComment, data)
+}
+
+// HandleBlogPublished fans the new blog out to every follower of its author.
+// Followers are read in keyset batches and each batch is recorded as its own outbox
+// event before the handler returns, so no batch is lost if the process stops.
+func (s *NotificationEventSubscriber) HandleBlogPublished(ctx context.Context, event DomainEvent) error {
+	e, ok := event.(BlogPublishedEvent)
+	if !ok {
+		return fmt.Errorf("unexpected event type %T", event)
+	}
+
+	authorName := s.actorName(ctx, e.AuthorID)
+	var batches []*entity.OutboxEvent
+	afterID := uuid.Nil
+	for {
+		followerIDs, err := s.subscriptionRepo.FindSubscriberIDs(ctx, e.AuthorID, afterID, s.batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to load followers: %w", err)
+		}
+		if len(followerIDs) == 0 {
+			break
+		}
+
+		batch, err := NewOutboxEvent(BlogFanOutEvent{
+			BlogID:      e.BlogID,
+			AuthorID:    e.AuthorID,
+			Title:       e.Title,
+			AuthorName:  authorName,
+			FollowerIDs: followerIDs,
+		}, OutboxAggregateBlog, e.BlogID)
+		if err != nil {
+			return err
+		}
+		// Stable IDs let a retried fan-out skip the batches it already recorded
+		batch.ID = uuid.NewSHA1(e.BlogID, []byte(fmt.Sprintf("%d/%d", e.PublishedAt.UnixNano(), len(batches))))
+		batches = append(batches, batch)
+
+		if len(followerIDs) < s.batchSize {
+			break
+		}
+		afterID = followerIDs[len(followerIDs)-1]
+	}
+
+	if err := s.outboxRepo.CreateBatch(ctx, batches); err != nil {
+		return fmt.Errorf("failed to record follower fan-out: %w", err)
+	}
+	return nil
+}
+
+// HandleBlogFanOut sends the new blog notification to one batch of followers.
+// A failed batch is returned to the outbox relay, which retries it; followers of a
+// retried batch may receive the push and email twice.
+func (s *NotificationEventSubscriber) HandleBlogFanOut(ctx context.Context, event DomainEvent) error {
+	e, ok := event.(BlogFanOutEvent)
+	if !ok {
+		return fmt.Errorf("unexpected event type %T", event)
+	}
+
+	data := map[string]interface{}{
+		"target_id":   e.BlogID.String(),
+		"target_type": "blog",
+		"blog_title":  e.Title,
+		"actor_id":    e.AuthorID.String(),
+		"actor_name":  e.AuthorName,
+	}
+	if err := s.dispatcher.NotifyMany(ctx, e.FollowerIDs, entity.NotificationTypeNewBlogFromFollowing, data); err != nil {
+		logger.Error("failed to notify follower batch", err, map[string]interface{}{"blog_id": e.BlogID, "followers": len(e.FollowerIDs)})
+		return err
+	}
+	return nil
+}
+
+// actorName resolves the display name of the user who triggered the event
+func (s *NotificationEventSubscriber) actorName(ctx context.Context, userID uuid.UUID) string {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return "Someone"
+	}
+	return user.GetDisplayName()
+}
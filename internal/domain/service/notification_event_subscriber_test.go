@@ -0,0 +1,220 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type subscriberMocks struct {
+	dispatcher  *serviceMocks.MockNotificationDispatcher
+	userRepo    *repoMocks.MockUserRepository
+	blogRepo    *repoMocks.MockBlogRepository
+	commentRepo *repoMocks.MockCommentRepository
+	subRepo     *repoMocks.MockSubscriptionRepository
+	outboxRepo  *repoMocks.MockOutboxRepository
+}
+
+func newTestNotificationSubscriber(ctrl *gomock.Controller) (*service.NotificationEventSubscriber, subscriberMocks) {
+	m := subscriberMocks{
+		dispatcher:  serviceMocks.NewMockNotificationDispatcher(ctrl),
+		userRepo:    repoMocks.NewMockUserRepository(ctrl),
+		blogRepo:    repoMocks.NewMockBlogRepository(ctrl),
+		commentRepo: repoMocks.NewMockCommentRepository(ctrl),
+		subRepo:     repoMocks.NewMockSubscriptionRepository(ctrl),
+		outboxRepo:  repoMocks.NewMockOutboxRepository(ctrl),
+	}
+	s := service.NewNotificationEventSubscriber(m.dispatcher, m.userRepo, m.blogRepo, m.commentRepo, m.subRepo, m.outboxRepo)
+	return s, m
+}
+
+func TestNotificationEventSubscriber_UserFollowed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, m := newTestNotificationSubscriber(ctrl)
+	ctx := context.Background()
+	followerID := uuid.New()
+	followeeID := uuid.New()
+
+	m.userRepo.EXPECT().FindByID(ctx, followerID).Return(&entity.User{ID: followerID, Name: "Alice"}, nil)
+	m.dispatcher.EXPECT().
+		Notify(ctx, followeeID, entity.NotificationTypeNewFollower, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ entity.NotificationType, data map[string]interface{}) error {
+			assert.Equal(t, "Alice", data["actor_name"])
+			assert.Equal(t, followerID.String(), data["target_id"])
+			return nil
+		})
+
+	err := s.HandleUserFollowed(ctx, service.UserFollowedEvent{FollowerID: followerID, FolloweeID: followeeID})
+	assert.NoError(t, err)
+}
+
+func TestNotificationEventSubscriber_CommentCreated_TopLevel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, m := newTestNotificationSubscriber(ctrl)
+	ctx := context.Background()
+	blog := &entity.Blog{ID: uuid.New(), AuthorID: uuid.New(), Title: "Go Tips"}
+	commenterID := uuid.New()
+
+	m.blogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+	m.userRepo.EXPECT().FindByID(ctx, commenterID).Return(&entity.User{ID: commenterID, Name: "Bob"}, nil)
+	m.dispatcher.EXPECT().Notify(ctx, blog.AuthorID, entity.NotificationTypeBlogComment, gomock.Any()).Return(nil)
+
+	err := s.HandleCommentCreated(ctx, service.CommentCreatedEvent{CommentID: uuid.New(), BlogID: blog.ID, UserID: commenterID})
+	assert.NoError(t, err)
+}
+
+func TestNotificationEventSubscriber_CommentCreated_ReplyNotifiesParentAndAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, m := newTestNotificationSubscriber(ctrl)
+	ctx := context.Background()
+	blog := &entity.Blog{ID: uuid.New(), AuthorID: uuid.New(), Title: "Go Tips"}
+	parent := &entity.Comment{ID: uuid.New(), UserID: uuid.New(), BlogID: blog.ID}
+	replierID := uuid.New()
+
+	m.blogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+	m.userRepo.EXPECT().FindByID(ctx, replierID).Return(&entity.User{ID: replierID, Name: "Carol"}, nil)
+	m.commentRepo.EXPECT().FindByID(ctx, parent.ID).Return(parent, nil)
+	m.dispatcher.EXPECT().Notify(ctx, parent.UserID, entity.NotificationTypeCommentReply, gomock.Any()).Return(nil)
+	m.dispatcher.EXPECT().Notify(ctx, blog.AuthorID, entity.NotificationTypeBlogComment, gomock.Any()).Return(nil)
+
+	err := s.HandleCommentCreated(ctx, service.CommentCreatedEvent{CommentID: uuid.New(), BlogID: blog.ID, UserID: replierID, ParentID: &parent.ID})
+	assert.NoError(t, err)
+}
+
+func TestNotificationEventSubscriber_CommentCreated_AuthorReplyingNotifiesOnlyParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, m := newTestNotificationSubscriber(ctrl)
+	ctx := context.Background()
+	blog := &entity.Blog{ID: uuid.New(), AuthorID: uuid.New(), Title: "Go Tips"}
+	parent := &entity.Comment{ID: uuid.New(), UserID: uuid.New(), BlogID: blog.ID}
+
+	m.blogRepo.EXPECT().FindByID(ctx, blog.ID).Return(blog, nil)
+	m.userRepo.EXPECT().FindByID(ctx, blog.AuthorID).Return(&entity.User{ID: blog.AuthorID, Name: "Dave"}, nil)
+	m.commentRepo.EXPECT().FindByID(ctx, parent.ID).Return(parent, nil)
+	m.dispatcher.EXPECT().Notify(ctx, parent.UserID, entity.NotificationTypeCommentReply, gomock.Any()).Return(nil)
+
+	err := s.HandleCommentCreated(ctx, service.CommentCreatedEvent{CommentID: uuid.New(), BlogID: blog.ID, UserID: blog.AuthorID, ParentID: &parent.ID})
+	assert.NoError(t, err)
+}
+
+func TestNotificationEventSubscriber_BlogPublished_BatchesFollowers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, m := newTestNotificationSubscriber(ctrl)
+	ctx := context.Background()
+	authorID := uuid.New()
+	event := service.BlogPublishedEvent{BlogID: uuid.New(), AuthorID: authorID, Title: "Hello", PublishedAt: time.Now()}
+
+	// Fewer followers than the batch size: one query, one fan-out event
+	followers := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	m.userRepo.EXPECT().FindByID(ctx, authorID).Return(&entity.User{ID: authorID, Name: "Eve"}, nil)
+	m.subRepo.EXPECT().FindSubscriberIDs(ctx, authorID, uuid.Nil, gomock.Any()).Return(followers, nil)
+	var recorded []*entity.OutboxEvent
+	m.outboxRepo.EXPECT().CreateBatch(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []*entity.OutboxEvent) error {
+		recorded = events
+		return nil
+	})
+	// Nobody is notified before the batches are stored
+
+	err := s.HandleBlogPublished(ctx, event)
+	require.NoError(t, err)
+	require.Len(t, recorded, 1)
+	assert.Equal(t, string(service.EventBlogFanOut), recorded[0].EventType)
+	assert.Contains(t, recorded[0].Payload, followers[2].String())
+
+	// A retried delivery records the same batch again, which the insert skips
+	m.userRepo.EXPECT().FindByID(ctx, authorID).Return(&entity.User{ID: authorID, Name: "Eve"}, nil)
+	m.subRepo.EXPECT().FindSubscriberIDs(ctx, authorID, uuid.Nil, gomock.Any()).Return(followers, nil)
+	m.outboxRepo.EXPECT().CreateBatch(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []*entity.OutboxEvent) error {
+		assert.Equal(t, recorded[0].ID, events[0].ID)
+		return nil
+	})
+
+	assert.NoError(t, s.HandleBlogPublished(ctx, event))
+}
+
+func TestNotificationEventSubscriber_BlogPublished_FanOutNotRecorded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, m := newTestNotificationSubscriber(ctrl)
+	ctx := context.Background()
+	authorID := uuid.New()
+
+	m.userRepo.EXPECT().FindByID(ctx, authorID).Return(&entity.User{ID: authorID, Name: "Eve"}, nil)
+	m.subRepo.EXPECT().FindSubscriberIDs(ctx, authorID, uuid.Nil, gomock.Any()).Return([]uuid.UUID{uuid.New()}, nil)
+	m.outboxRepo.EXPECT().CreateBatch(ctx, gomock.Any()).Return(errors.New("db down"))
+
+	// The error reaches the relay, which retries the handler
+	err := s.HandleBlogPublished(ctx, service.BlogPublishedEvent{BlogID: uuid.New(), AuthorID: authorID})
+	assert.Error(t, err)
+}
+
+func TestNotificationEventSubscriber_BlogFanOut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, m := newTestNotificationSubscriber(ctrl)
+	ctx := context.Background()
+	followers := []uuid.UUID{uuid.New(), uuid.New()}
+	event := service.BlogFanOutEvent{BlogID: uuid.New(), AuthorID: uuid.New(), Title: "Hello", AuthorName: "Eve", FollowerIDs: followers}
+
+	m.dispatcher.EXPECT().NotifyMany(ctx, followers, entity.NotificationTypeNewBlogFromFollowing, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []uuid.UUID, _ entity.NotificationType, data map[string]interface{}) error {
+			assert.Equal(t, "Eve", data["actor_name"])
+			return nil
+		})
+
+	assert.NoError(t, s.HandleBlogFanOut(ctx, event))
+}
+
+func TestNotificationEventSubscriber_BlogFanOut_BatchFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, m := newTestNotificationSubscriber(ctrl)
+	ctx := context.Background()
+	event := service.BlogFanOutEvent{BlogID: uuid.New(), AuthorID: uuid.New(), FollowerIDs: []uuid.UUID{uuid.New()}}
+
+	m.dispatcher.EXPECT().NotifyMany(ctx, event.FollowerIDs, entity.NotificationTypeNewBlogFromFollowing, gomock.Any()).
+		Return(errors.New("statement timeout"))
+
+	// The failed batch goes back to the relay to be retried
+	assert.Error(t, s.HandleBlogFanOut(ctx, event))
+}
+
+func TestNotificationEventSubscriber_BlogPublished_NoFollowers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, m := newTestNotificationSubscriber(ctrl)
+	ctx := context.Background()
+	authorID := uuid.New()
+
+	m.userRepo.EXPECT().FindByID(ctx, authorID).Return(&entity.User{ID: authorID, Name: "Eve"}, nil)
+	m.subRepo.EXPECT().FindSubscriberIDs(ctx, authorID, uuid.Nil, gomock.Any()).Return(nil, nil)
+	m.outboxRepo.EXPECT().CreateBatch(ctx, gomock.Len(0)).Return(nil)
+
+	err := s.HandleBlogPublished(ctx, service.BlogPublishedEvent{BlogID: uuid.New(), AuthorID: authorID})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// Outbox aggregate types
+const (
+	OutboxAggregateTransaction  = "transaction"
+	OutboxAggregateSubscription = "subscription"
+	OutboxAggregateBlog         = "blog"
+)
+
+// OutboxRelayConfig holds tuning for the outbox relay worker
+type OutboxRelayConfig struct {
+	PollInterval time.Duration // How often to look for due events
+	BatchSize    int           // Max events claimed per poll
+	Lease        time.Duration // How long a claimed event is reserved for this worker
+	BaseBackoff  time.Duration // Delay before the first retry, doubled for each further attempt
+	MaxBackoff   time.Duration // Upper bound for the retry delay
+}
+
+// NewOutboxEvent serializes a domain event into an outbox row.
+// Persist it with a transaction-bound OutboxRepository alongside the business change.
+func NewOutboxEvent(event DomainEvent, aggregateType string, aggregateID uuid.UUID) (*entity.OutboxEvent, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal outbox payload: %w", err)
+	}
+	return &entity.OutboxEvent{
+		ID:            uuid.New(),
+		EventType:     string(event.Name()),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       string(payload),
+		Status:        entity.OutboxStatusPending,
+		MaxAttempts:   entity.DefaultOutboxMaxAttempts,
+		NextAttemptAt: time.Now(),
+	}, nil
+}
+
+// decodeOutboxEvent turns a stored outbox row back into its domain event
+func decodeOutboxEvent(e *entity.OutboxEvent) (DomainEvent, error) {
+	switch EventName(e.EventType) {
+	case EventBlogPublished:
+		return decodePayload[BlogPublishedEvent](e.Payload)
+	case EventBlogFanOut:
+		return decodePayload[BlogFanOutEvent](e.Payload)
+	case EventPaymentSucceeded:
+		return decodePayload[PaymentSucceededEvent](e.Payload)
+	case EventSubscriptionExpiryUpdated:
+		return decodePayload[SubscriptionExpiryUpdatedEvent](e.Payload)
+	default:
+		return nil, fmt.Errorf("unknown outbox event type %q", e.EventType)
+	}
+}
+
+func decodePayload[T DomainEvent](payload string) (DomainEvent, error) {
+	var event T
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal outbox payload: %w", err)
+	}
+	return event, nil
+}
+
+// OutboxRelay delivers outbox events to the event bus with lease-based claiming,
+// exponential backoff between attempts and a dead-letter state once attempts run out.
+// Deliveries are recorded per handler, so a retry skips the handlers that already succeeded.
+type OutboxRelay struct {
+	repo     repository.OutboxRepository
+	bus      EventBus
+	cfg      OutboxRelayConfig
+	workerID string
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// NewOutboxRelay creates a new outbox relay
+func NewOutboxRelay(repo repository.OutboxRepository, bus EventBus, cfg OutboxRelayConfig) *OutboxRelay {
+	host, _ := os.Hostname()
+	return &OutboxRelay{
+		repo:     repo,
+		bus:      bus,
+		cfg:      cfg,
+		workerID: fmt.Sprintf("%s-%s", host, uuid.NewString()[:8]),
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Start begins polling for due events
+func (r *OutboxRelay) Start() {
+	go func() {
+		defer close(r.doneCh)
+		ticker := time.NewTicker(r.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				// Drain everything that is due before waiting for the next tick
+				for {
+					n, err := r.ProcessBatch(context.Background())
+					if err != nil {
+						logger.Error("outbox relay poll failed", err)
+					}
+					if err != nil || n < r.cfg.BatchSize {
+						break
+					}
+				}
+			case <-r.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the relay and waits for the current batch to finish
+func (r *OutboxRelay) Stop() {
+	close(r.stopCh)
+	<-r.doneCh
+}
+
+// ProcessBatch claims and delivers one batch of due events, returning how many were claimed
+func (r *OutboxRelay) ProcessBatch(ctx context.Context) (int, error) {
+	events, err := r.repo.ClaimBatch(ctx, r.workerID, r.cfg.Lease, r.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	for _, e := range events {
+		r.deliver(ctx, e)
+	}
+	return len(events), nil
+}
+
+// deliver dispatches a single event and records the outcome. The batch shares one lease, so it is
+// renewed before each event; an event whose lease ran out while earlier ones were delivered may
+// already belong to another relay and is left to it.
+func (r *OutboxRelay) deliver(ctx context.Context, e *entity.OutboxEvent) {
+	fields := map[string]interface{}{"outbox_id": e.ID, "event_type": e.EventType, "attempt": e.Attempts}
+
+	leaseEnd := time.Now().Add(r.cfg.Lease)
+	extended, err := r.repo.ExtendLease(ctx, e.ID, r.workerID, r.cfg.Lease)
+	if err != nil {
+		logger.Error("failed to extend outbox lease", err, fields)
+		return
+	}
+	if !extended {
+		logger.Warn("outbox lease lost before delivery, skipping event", fields)
+		return
+	}
+
+	// Delivery must finish while the lease is held, or another relay may run the handlers again
+	deliveryCtx, cancel := context.WithDeadline(ctx, leaseEnd)
+	defer cancel()
+
+	event, err := decodeOutboxEvent(e)
+	if err == nil {
+		err = r.dispatch(deliveryCtx, e, event)
+	}
+
+	if err == nil {
+		if markErr := r.repo.MarkProcessed(ctx, e.ID, r.workerID); markErr != nil {
+			logger.Error("failed to mark outbox event processed", markErr, fields)
+		}
+		return
+	}
+
+	if !e.HasAttemptsLeft() {
+		logger.Error("outbox event moved to dead letter", err, fields)
+		if markErr := r.repo.MarkDead(ctx, e.ID, r.workerID, err.Error()); markErr != nil {
+			logger.Error("failed to dead-letter outbox event", markErr, fields)
+		}
+		return
+	}
+
+	logger.Error("outbox event delivery failed, will retry", err, fields)
+	if markErr := r.repo.MarkFailed(ctx, e.ID, r.workerID, err.Error(), time.Now().Add(r.backoff(e.Attempts))); markErr != nil {
+		logger.Error("failed to reschedule outbox event", markErr, fields)
+	}
+}
+
+// dispatch runs the handlers that have not received the event yet and records each delivery,
+// so a retry only runs the handlers that failed
+func (r *OutboxRelay) dispatch(ctx context.Context, e *entity.OutboxEvent, event DomainEvent) error {
+	delivered, err := r.repo.FindDeliveredHandlers(ctx, e.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load outbox deliveries: %w", err)
+	}
+	done := make(map[string]bool, len(delivered))
+	for _, handler := range delivered {
+		done[handler] = true
+	}
+
+	var errs []error
+	for _, handler := range r.bus.Handlers(event.Name()) {
+		if done[handler] {
+			continue
+		}
+		if err := r.bus.Dispatch(ctx, event, handler); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", handler, err))
+			continue
+		}
+		if err := r.repo.RecordDelivery(ctx, e.ID, handler); err != nil {
+			// The handler already ran; it only runs again if another handler fails too
+			logger.Error("failed to record outbox delivery", err, map[string]interface{}{"outbox_id": e.ID, "handler": handler})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// backoff returns the retry delay after the given number of attempts
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	delay := r.cfg.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= r.cfg.MaxBackoff {
+			return r.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
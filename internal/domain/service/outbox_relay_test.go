@@ -0,0 +1,376 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func newTestOutboxRelay(ctrl *gomock.Controller) (*service.OutboxRelay, *repoMocks.MockOutboxRepository, *serviceMocks.MockEventBus) {
+	repo := repoMocks.NewMockOutboxRepository(ctrl)
+	bus := serviceMocks.NewMockEventBus(ctrl)
+	relay := service.NewOutboxRelay(repo, bus, service.OutboxRelayConfig{
+		PollInterval: time.Second,
+		BatchSize:    10,
+		Lease:        time.Minute,
+		BaseBackoff:  10 * time.Second,
+		MaxBackoff:   time.Minute,
+	})
+	return relay, repo, bus
+}
+
+// expectLease lets the relay renew its lease on row before delivering it
+func expectLease(repo *repoMocks.MockOutboxRepository, row *entity.OutboxEvent) {
+	repo.EXPECT().ExtendLease(gomock.Any(), row.ID, gomock.Any(), time.Minute).Return(true, nil)
+}
+
+// expectHandlers makes row an event with a single "mailer" handler that has not received it yet
+func expectHandlers(repo *repoMocks.MockOutboxRepository, bus *serviceMocks.MockEventBus, row *entity.OutboxEvent) {
+	expectLease(repo, row)
+	repo.EXPECT().FindDeliveredHandlers(gomock.Any(), row.ID).Return(nil, nil)
+	bus.EXPECT().Handlers(service.EventName(row.EventType)).Return([]string{"mailer"})
+}
+
+func TestNewOutboxEvent_RoundTrip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	relay, repo, bus := newTestOutboxRelay(ctrl)
+
+	targetID := uuid.New()
+	original := service.PaymentSucceededEvent{
+		TransactionID: uuid.New(),
+		UserID:        uuid.New(),
+		Type:          entity.TransactionTypeSeries,
+		TargetID:      &targetID,
+		Amount:        decimal.NewFromInt(150000),
+		Currency:      "VND",
+		OrderID:       "ORDER-SEPAY-1",
+		PaidAt:        time.Now().UTC().Truncate(time.Second),
+	}
+
+	row, err := service.NewOutboxEvent(original, service.OutboxAggregateTransaction, original.TransactionID)
+	require.NoError(t, err)
+	assert.Equal(t, string(service.EventPaymentSucceeded), row.EventType)
+	assert.Equal(t, entity.OutboxStatusPending, row.Status)
+	row.Attempts = 1
+
+	repo.EXPECT().ClaimBatch(gomock.Any(), gomock.Any(), time.Minute, 10).Return([]*entity.OutboxEvent{row}, nil)
+	expectLease(repo, row)
+	repo.EXPECT().FindDeliveredHandlers(gomock.Any(), row.ID).Return(nil, nil)
+	bus.EXPECT().Handlers(service.EventPaymentSucceeded).Return([]string{"payment.receipt"})
+	bus.EXPECT().Dispatch(gomock.Any(), gomock.Any(), "payment.receipt").DoAndReturn(func(_ context.Context, event service.DomainEvent, _ string) error {
+		decoded, ok := event.(service.PaymentSucceededEvent)
+		require.True(t, ok)
+		assert.Equal(t, original.TransactionID, decoded.TransactionID)
+		assert.Equal(t, targetID, *decoded.TargetID)
+		assert.True(t, original.Amount.Equal(decoded.Amount))
+		assert.True(t, original.PaidAt.Equal(decoded.PaidAt))
+		return nil
+	})
+	repo.EXPECT().RecordDelivery(gomock.Any(), row.ID, "payment.receipt").Return(nil)
+	repo.EXPECT().MarkProcessed(gomock.Any(), row.ID, gomock.Any()).Return(nil)
+
+	n, err := relay.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestOutboxRelay_ProcessBatch_RetriesWithBackoff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	relay, repo, bus := newTestOutboxRelay(ctrl)
+
+	row, err := service.NewOutboxEvent(service.BlogPublishedEvent{BlogID: uuid.New()}, service.OutboxAggregateBlog, uuid.New())
+	require.NoError(t, err)
+	row.Attempts = 3
+
+	repo.EXPECT().ClaimBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*entity.OutboxEvent{row}, nil)
+	expectHandlers(repo, bus, row)
+	bus.EXPECT().Dispatch(gomock.Any(), gomock.Any(), "mailer").Return(errors.New("smtp down"))
+	repo.EXPECT().MarkFailed(gomock.Any(), row.ID, gomock.Any(), "mailer: smtp down", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, _ string, next time.Time) error {
+			// Third attempt: 10s doubled twice
+			delay := time.Until(next)
+			assert.InDelta(t, float64(40*time.Second), float64(delay), float64(2*time.Second))
+			return nil
+		})
+
+	_, err = relay.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestOutboxRelay_ProcessBatch_BackoffIsCapped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	relay, repo, bus := newTestOutboxRelay(ctrl)
+
+	row, err := service.NewOutboxEvent(service.BlogPublishedEvent{BlogID: uuid.New()}, service.OutboxAggregateBlog, uuid.New())
+	require.NoError(t, err)
+	row.Attempts = 8
+
+	repo.EXPECT().ClaimBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*entity.OutboxEvent{row}, nil)
+	expectHandlers(repo, bus, row)
+	bus.EXPECT().Dispatch(gomock.Any(), gomock.Any(), "mailer").Return(errors.New("boom"))
+	repo.EXPECT().MarkFailed(gomock.Any(), row.ID, gomock.Any(), "mailer: boom", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, _ string, next time.Time) error {
+			assert.InDelta(t, float64(time.Minute), float64(time.Until(next)), float64(2*time.Second))
+			return nil
+		})
+
+	_, err = relay.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestOutboxRelay_ProcessBatch_DeadLettersWhenAttemptsExhausted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	relay, repo, bus := newTestOutboxRelay(ctrl)
+
+	row, err := service.NewOutboxEvent(service.BlogPublishedEvent{BlogID: uuid.New()}, service.OutboxAggregateBlog, uuid.New())
+	require.NoError(t, err)
+	row.Attempts = row.MaxAttempts
+
+	repo.EXPECT().ClaimBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*entity.OutboxEvent{row}, nil)
+	expectHandlers(repo, bus, row)
+	bus.EXPECT().Dispatch(gomock.Any(), gomock.Any(), "mailer").Return(errors.New("still failing"))
+	repo.EXPECT().MarkDead(gomock.Any(), row.ID, gomock.Any(), "mailer: still failing").Return(nil)
+
+	_, err = relay.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestOutboxRelay_ProcessBatch_RetriesOnlyFailedHandlers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	relay, repo, bus := newTestOutboxRelay(ctrl)
+
+	row, err := service.NewOutboxEvent(service.BlogPublishedEvent{BlogID: uuid.New()}, service.OutboxAggregateBlog, uuid.New())
+	require.NoError(t, err)
+	row.Attempts = 2
+
+	// The search indexer received the event on the first attempt and is not run again
+	repo.EXPECT().ClaimBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*entity.OutboxEvent{row}, nil)
+	expectLease(repo, row)
+	repo.EXPECT().FindDeliveredHandlers(gomock.Any(), row.ID).Return([]string{"search.index"}, nil)
+	bus.EXPECT().Handlers(service.EventBlogPublished).Return([]string{"search.index", "notification.blog_published", "mailer"})
+	bus.EXPECT().Dispatch(gomock.Any(), gomock.Any(), "notification.blog_published").Return(nil)
+	repo.EXPECT().RecordDelivery(gomock.Any(), row.ID, "notification.blog_published").Return(nil)
+	bus.EXPECT().Dispatch(gomock.Any(), gomock.Any(), "mailer").Return(errors.New("smtp down"))
+	repo.EXPECT().MarkFailed(gomock.Any(), row.ID, gomock.Any(), "mailer: smtp down", gomock.Any()).Return(nil)
+
+	_, err = relay.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestOutboxRelay_ProcessBatch_UnknownEventType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	relay, repo, _ := newTestOutboxRelay(ctrl)
+
+	row := &entity.OutboxEvent{ID: uuid.New(), EventType: "legacy.event", Payload: "{}", Attempts: 1, MaxAttempts: 10}
+
+	repo.EXPECT().ClaimBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*entity.OutboxEvent{row}, nil)
+	expectLease(repo, row)
+	repo.EXPECT().MarkFailed(gomock.Any(), row.ID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := relay.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestOutboxRelay_ProcessBatch_SkipsEventsWhoseLeaseWasLost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	relay, repo, _ := newTestOutboxRelay(ctrl)
+
+	row, err := service.NewOutboxEvent(service.BlogPublishedEvent{BlogID: uuid.New()}, service.OutboxAggregateBlog, uuid.New())
+	require.NoError(t, err)
+
+	// Another relay re-claimed the event after the lease expired; nothing is dispatched or released
+	repo.EXPECT().ClaimBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*entity.OutboxEvent{row}, nil)
+	repo.EXPECT().ExtendLease(gomock.Any(), row.ID, gomock.Any(), time.Minute).Return(false, nil)
+
+	n, err := relay.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestOutboxRelay_ProcessBatch_ClaimError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	relay, repo, _ := newTestOutboxRelay(ctrl)
+
+	repo.EXPECT().ClaimBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+	n, err := relay.ProcessBatch(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 0, n)
+}
+
+// leaseOutboxRepository is an in-memory outbox that grants leases against a manual clock, so
+// relays sharing it see each other's claims the way they would in the database
+type leaseOutboxRepository struct {
+	now        time.Time
+	events     []*entity.OutboxEvent
+	deliveries map[uuid.UUID][]string
+}
+
+func (r *leaseOutboxRepository) Create(ctx context.Context, event *entity.OutboxEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *leaseOutboxRepository) CreateBatch(ctx context.Context, events []*entity.OutboxEvent) error {
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func (r *leaseOutboxRepository) ClaimBatch(ctx context.Context, workerID string, lease time.Duration, limit int) ([]*entity.OutboxEvent, error) {
+	var claimed []*entity.OutboxEvent
+	for _, e := range r.events {
+		due := e.Status == entity.OutboxStatusPending && !e.NextAttemptAt.After(r.now)
+		expired := e.Status == entity.OutboxStatusProcessing && e.LockedUntil.Before(r.now)
+		if len(claimed) == limit || (!due && !expired) {
+			continue
+		}
+		until := r.now.Add(lease)
+		e.Status = entity.OutboxStatusProcessing
+		e.LockedBy = &workerID
+		e.LockedUntil = &until
+		e.Attempts++
+		copied := *e
+		claimed = append(claimed, &copied)
+	}
+	return claimed, nil
+}
+
+func (r *leaseOutboxRepository) ExtendLease(ctx context.Context, id uuid.UUID, workerID string, lease time.Duration) (bool, error) {
+	e := r.find(id)
+	if e.Status != entity.OutboxStatusProcessing || *e.LockedBy != workerID || !e.LockedUntil.After(r.now) {
+		return false, nil
+	}
+	until := r.now.Add(lease)
+	e.LockedUntil = &until
+	return true, nil
+}
+
+func (r *leaseOutboxRepository) MarkProcessed(ctx context.Context, id uuid.UUID, workerID string) error {
+	return r.release(id, workerID, entity.OutboxStatusProcessed)
+}
+
+func (r *leaseOutboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, workerID string, lastError string, nextAttemptAt time.Time) error {
+	if err := r.release(id, workerID, entity.OutboxStatusPending); err != nil {
+		return err
+	}
+	r.find(id).NextAttemptAt = nextAttemptAt
+	return nil
+}
+
+func (r *leaseOutboxRepository) MarkDead(ctx context.Context, id uuid.UUID, workerID string, lastError string) error {
+	return r.release(id, workerID, entity.OutboxStatusDead)
+}
+
+func (r *leaseOutboxRepository) FindDeliveredHandlers(ctx context.Context, eventID uuid.UUID) ([]string, error) {
+	return r.deliveries[eventID], nil
+}
+
+func (r *leaseOutboxRepository) RecordDelivery(ctx context.Context, eventID uuid.UUID, handler string) error {
+	r.deliveries[eventID] = append(r.deliveries[eventID], handler)
+	return nil
+}
+
+func (r *leaseOutboxRepository) WithTx(tx interface{}) repository.OutboxRepository {
+	return r
+}
+
+func (r *leaseOutboxRepository) find(id uuid.UUID) *entity.OutboxEvent {
+	for _, e := range r.events {
+		if e.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+func (r *leaseOutboxRepository) release(id uuid.UUID, workerID string, status entity.OutboxStatus) error {
+	e := r.find(id)
+	if e.LockedBy == nil || *e.LockedBy != workerID {
+		return gorm.ErrRecordNotFound
+	}
+	e.Status = status
+	e.LockedBy = nil
+	e.LockedUntil = nil
+	return nil
+}
+
+func TestOutboxRelay_ProcessBatch_OverlappingRelaysDispatchOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := &leaseOutboxRepository{now: time.Now(), deliveries: map[uuid.UUID][]string{}}
+	bus := serviceMocks.NewMockEventBus(ctrl)
+	cfg := service.OutboxRelayConfig{PollInterval: time.Second, BatchSize: 10, Lease: time.Minute}
+	first := service.NewOutboxRelay(repo, bus, cfg)
+	second := service.NewOutboxRelay(repo, bus, cfg)
+
+	var blogIDs []uuid.UUID
+	for i := 0; i < 3; i++ {
+		blogID := uuid.New()
+		row, err := service.NewOutboxEvent(service.BlogPublishedEvent{BlogID: blogID}, service.OutboxAggregateBlog, blogID)
+		require.NoError(t, err)
+		row.NextAttemptAt = repo.now
+		require.NoError(t, repo.Create(context.Background(), row))
+		blogIDs = append(blogIDs, blogID)
+	}
+
+	// Each delivery takes 40s, so the third event's batch lease runs out while the first relay
+	// delivers the second one. The second relay claims it in the meantime and its attempt fails,
+	// leaving the event for a later retry that the first relay must not run early.
+	dispatched := map[uuid.UUID]int{}
+	bus.EXPECT().Handlers(service.EventBlogPublished).Return([]string{"mailer"}).AnyTimes()
+	bus.EXPECT().Dispatch(gomock.Any(), gomock.Any(), "mailer").DoAndReturn(func(ctx context.Context, event service.DomainEvent, _ string) error {
+		blogID := event.(service.BlogPublishedEvent).BlogID
+		dispatched[blogID]++
+		repo.now = repo.now.Add(40 * time.Second)
+		switch blogID {
+		case blogIDs[1]:
+			n, err := second.ProcessBatch(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 1, n)
+		case blogIDs[2]:
+			return errors.New("smtp down")
+		}
+		return nil
+	}).AnyTimes()
+
+	n, err := first.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	for _, blogID := range blogIDs {
+		assert.Equal(t, 1, dispatched[blogID])
+	}
+	assert.Equal(t, entity.OutboxStatusProcessed, repo.events[0].Status)
+	assert.Equal(t, entity.OutboxStatusProcessed, repo.events[1].Status)
+	assert.Equal(t, entity.OutboxStatusPending, repo.events[2].Status)
+}
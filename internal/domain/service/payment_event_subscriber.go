@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+)
+
+// PaymentEventSubscriber handles follow-up work for confirmed payments
+type PaymentEventSubscriber struct {
+	emailService EmailService
+	userRepo     repository.UserRepository
+}
+
+// NewPaymentEventSubscriber creates a new PaymentEventSubscriber
+func NewPaymentEventSubscriber(emailService EmailService, userRepo repository.UserRepository) *PaymentEventSubscriber {
+	return &PaymentEventSubscriber{
+		emailService: emailService,
+		userRepo:     userRepo,
+	}
+}
+
+// Register subscribes the payment handlers to the event bus
+func (s *PaymentEventSubscriber) Register(bus EventBus) {
+	bus.Subscribe(EventPaymentSucceeded, "payment.receipt", s.HandlePaymentSucceeded)
+}
+
+// HandlePaymentSucceeded emails the payer a receipt.
+// Errors are returned so the outbox relay retries the delivery.
+func (s *PaymentEventSubscriber) HandlePaymentSucceeded(ctx context.Context, event DomainEvent) error {
+	e, ok := event.(PaymentSucceededEvent)
+	if !ok {
+		return fmt.Errorf("unexpected event type %T", event)
+	}
+
+	user, err := s.userRepo.FindByID(ctx, e.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load payer: %w", err)
+	}
+	if user == nil || user.Email == "" {
+		return nil
+	}
+
+	receipt := PaymentReceipt{
+		OrderID:     e.OrderID,
+		Description: receiptDescription(e.Type),
+		Amount:      e.Amount,
+		Currency:    e.Currency,
+		PaidAt:      e.PaidAt,
+	}
+	return s.emailService.SendPaymentReceipt(ctx, user.Email, user.GetDisplayName(), receipt)
+}
+
+// receiptDescription returns the line item shown on the receipt
+func receiptDescription(txType entity.TransactionType) string {
+	switch txType {
+	case entity.TransactionTypeSubscription:
+		return "Author subscription"
+	case entity.TransactionTypeSeries:
+		return "Series purchase"
+	case entity.TransactionTypeDonation:
+		return "Donation"
+	default:
+		return string(txType)
+	}
+}
@@ -0,0 +1,60 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPaymentEventSubscriber_HandlePaymentSucceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmail := serviceMocks.NewMockEmailService(ctrl)
+	mockUserRepo := repoMocks.NewMockUserRepository(ctrl)
+	subscriber := service.NewPaymentEventSubscriber(mockEmail, mockUserRepo)
+
+	ctx := context.Background()
+	user := &entity.User{ID: uuid.New(), Email: "payer@example.com"}
+	event := service.PaymentSucceededEvent{
+		TransactionID: uuid.New(),
+		UserID:        user.ID,
+		Type:          entity.TransactionTypeDonation,
+		Amount:        decimal.NewFromInt(50000),
+		Currency:      "VND",
+		OrderID:       "ORDER-SEPAY-42",
+		PaidAt:        time.Now(),
+	}
+
+	t.Run("sends_receipt", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, user.ID).Return(user, nil)
+		mockEmail.EXPECT().SendPaymentReceipt(ctx, user.Email, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ string, receipt service.PaymentReceipt) error {
+				assert.Equal(t, "ORDER-SEPAY-42", receipt.OrderID)
+				assert.Equal(t, "Donation", receipt.Description)
+				assert.True(t, event.Amount.Equal(receipt.Amount))
+				return nil
+			})
+
+		assert.NoError(t, subscriber.HandlePaymentSucceeded(ctx, event))
+	})
+
+	t.Run("skips_user_without_email", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, user.ID).Return(&entity.User{ID: user.ID}, nil)
+
+		assert.NoError(t, subscriber.HandlePaymentSucceeded(ctx, event))
+	})
+
+	t.Run("rejects_wrong_event", func(t *testing.T) {
+		assert.Error(t, subscriber.HandlePaymentSucceeded(ctx, service.BlogPublishedEvent{}))
+	})
+}
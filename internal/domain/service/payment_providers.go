@@ -0,0 +1,44 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/infrastructure/adapter"
+)
+
+// PaymentProviders routes each order to the payment provider collecting its currency
+type PaymentProviders struct {
+	providers  map[entity.TransactionProvider]adapter.PaymentProvider
+	currencies map[string]entity.TransactionProvider
+}
+
+// NewPaymentProviders creates a router over the available providers. Routes map a currency to
+// the name of its provider; currencies routed to a provider that is not available cannot be paid.
+func NewPaymentProviders(providers map[entity.TransactionProvider]adapter.PaymentProvider, routes map[string]string) *PaymentProviders {
+	currencies := make(map[string]entity.TransactionProvider, len(routes))
+	for currency, provider := range routes {
+		// Config keys come back lowercased
+		currencies[strings.ToUpper(currency)] = entity.TransactionProvider(strings.ToUpper(provider))
+	}
+	return &PaymentProviders{providers: providers, currencies: currencies}
+}
+
+// ForCurrency returns the provider collecting payments in currency
+func (p *PaymentProviders) ForCurrency(currency string) (entity.TransactionProvider, adapter.PaymentProvider, error) {
+	name, ok := p.currencies[strings.ToUpper(currency)]
+	if !ok {
+		return "", nil, ErrUnsupportedCurrency
+	}
+	provider, ok := p.providers[name]
+	if !ok {
+		return "", nil, ErrUnsupportedCurrency
+	}
+	return name, provider, nil
+}
+
+// Get returns a provider by name
+func (p *PaymentProviders) Get(name entity.TransactionProvider) (adapter.PaymentProvider, bool) {
+	provider, ok := p.providers[name]
+	return provider, ok
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/infrastructure/adapter"
+	"github.com/aiagent/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentReconciliationConfig holds tuning for the payment reconciliation job
+type PaymentReconciliationConfig struct {
+	Interval   time.Duration  // How often the job runs
+	OrderTTL   time.Duration  // How long an unpaid order stays payable
+	BatchSize  int            // Max pending orders checked per run and SePay transfers listed per page
+	ReportHour int            // Hour of the day after which yesterday's report is produced
+	Location   *time.Location // Timezone the report days are cut in
+}
+
+// PaymentSweepResult summarizes one run of the payment reconciliation job
+type PaymentSweepResult struct {
+	Settled  int                   // Orders paid through a transfer whose webhook never arrived
+	Expired  int64                 // Unpaid orders past their TTL
+	Refunded int                   // Pending refunds the provider confirmed on retry
+	Report   *entity.PaymentReport // Report produced during the run, if one was due
+}
+
+// PaymentReconciliationJob keeps orders in line with the transfers SePay received. Each run
+// polls SePay for transfers naming one of the oldest pending orders, in case the webhook was
+// lost, and settles them through HandleSePayWebhook exactly as if it had been delivered. Those
+// orders are then expired if still unpaid after their TTL, card refunds whose provider could
+// not be reached are retried, and once a day the previous day is reconciled into a report.
+//
+// Several instances can run the job at once: completing an order claims it from pending, so a
+// transfer settled by two runs is applied once, and expiry only touches orders still pending.
+type PaymentReconciliationJob struct {
+	txRepo       repository.TransactionRepository
+	payments     PaymentService
+	refunds      RefundService
+	reports      PaymentReportService
+	sepayAdapter adapter.SePayAdapter
+	cfg          PaymentReconciliationConfig
+	lastReport   string
+	stopCh       chan struct{}
+	doneCh       chan struct{}
+}
+
+// NewPaymentReconciliationJob creates a new payment reconciliation job
+func NewPaymentReconciliationJob(
+	txRepo repository.TransactionRepository,
+	payments PaymentService,
+	refunds RefundService,
+	reports PaymentReportService,
+	sepayAdapter adapter.SePayAdapter,
+	cfg PaymentReconciliationConfig,
+) *PaymentReconciliationJob {
+	if cfg.Location == nil {
+		cfg.Location = time.Local
+	}
+	return &PaymentReconciliationJob{
+		txRepo:       txRepo,
+		payments:     payments,
+		refunds:      refunds,
+		reports:      reports,
+		sepayAdapter: sepayAdapter,
+		cfg:          cfg,
+		stopCh:       make(chan struct{}),
+		doneCh:       make(chan struct{}),
+	}
+}
+
+// Start runs the job once and then on every interval
+func (j *PaymentReconciliationJob) Start() {
+	go func() {
+		defer close(j.doneCh)
+		ticker := time.NewTicker(j.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := j.RunOnce(context.Background()); err != nil {
+				logger.Error("payment reconciliation run failed", err)
+			}
+
+			select {
+			case <-ticker.C:
+			case <-j.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the job and waits for the current run to finish
+func (j *PaymentReconciliationJob) Stop() {
+	close(j.stopCh)
+	<-j.doneCh
+}
+
+// RunOnce settles missed transfers, expires stale orders, retries pending refunds and produces
+// yesterday's report when due.
+// Only orders whose transfers were all listed from SePay are expired, so a paid order is never
+// expired because SePay could not be reached or its transfer was not looked at.
+func (j *PaymentReconciliationJob) RunOnce(ctx context.Context) (PaymentSweepResult, error) {
+	var result PaymentSweepResult
+	now := time.Now()
+
+	settled, checked, err := j.settleMissedTransfers(ctx)
+	result.Settled = settled
+	if err != nil {
+		return result, err
+	}
+
+	expired, err := j.txRepo.ExpirePending(ctx, checked, now.Add(-j.cfg.OrderTTL))
+	if err != nil {
+		return result, fmt.Errorf("failed to expire orders: %w", err)
+	}
+	result.Expired = expired
+	if expired > 0 {
+		logger.Info("Expired unpaid orders", map[string]interface{}{"count": expired})
+	}
+
+	// A provider still unreachable must not hold back the report
+	refunded, err := j.refunds.RetryPendingRefunds(ctx, j.cfg.BatchSize)
+	result.Refunded = refunded
+	if err != nil {
+		logger.Error("failed to retry pending refunds", err)
+	}
+	if refunded > 0 {
+		logger.Info("Completed pending refunds", map[string]interface{}{"count": refunded})
+	}
+
+	report, err := j.reportIfDue(ctx, now)
+	result.Report = report
+	return result, err
+}
+
+// settleMissedTransfers feeds SePay transfers naming a pending order to the webhook path. It
+// returns the pending orders that were checked against every transfer since they were placed,
+// leaving out those whose transfer could not be settled.
+func (j *PaymentReconciliationJob) settleMissedTransfers(ctx context.Context) (int, []uuid.UUID, error) {
+	pending, err := j.txRepo.FindPending(ctx, j.cfg.BatchSize)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to load pending orders: %w", err)
+	}
+	if len(pending) == 0 {
+		return 0, nil, nil
+	}
+
+	// Pending orders come oldest first, so no transfer for them predates the first one. SePay
+	// lists newest first, so each page ends where the previous one stopped.
+	since := pending[0].CreatedAt
+	var until time.Time
+	seen := make(map[string]bool)
+	unsettled := make(map[uuid.UUID]bool)
+	settled := 0
+	for {
+		transfers, err := j.sepayAdapter.ListTransactions(ctx, since, until, j.cfg.BatchSize)
+		if err != nil {
+			return settled, nil, fmt.Errorf("failed to list sepay transfers: %w", err)
+		}
+
+		next := until
+		for _, transfer := range transfers {
+			if seen[transfer.ID] {
+				continue // Transfers at the page boundary are listed twice
+			}
+			seen[transfer.ID] = true
+			if date, err := time.Parse(adapter.SePayDateLayout, transfer.TransferDate); err == nil && (next.IsZero() || date.Before(next)) {
+				next = date
+			}
+
+			order := matchPendingOrder(transfer, pending)
+			if order == nil {
+				continue
+			}
+			ok, err := j.settleTransfer(ctx, transfer, order)
+			if err != nil {
+				unsettled[order.ID] = true
+				continue
+			}
+			if ok {
+				settled++
+			}
+		}
+
+		if len(transfers) < j.cfg.BatchSize || next.IsZero() {
+			break
+		}
+		// The date filter is inclusive and to the second, so a page that did not get past the
+		// second it started from moves on to the one before
+		if next.Equal(until) {
+			next = next.Add(-time.Second)
+		}
+		until = next
+	}
+
+	checked := make([]uuid.UUID, 0, len(pending))
+	for _, tx := range pending {
+		if !unsettled[tx.ID] {
+			checked = append(checked, tx.ID)
+		}
+	}
+	return settled, checked, nil
+}
+
+// settleTransfer applies a transfer to the pending order it names and reports whether it paid it
+func (j *PaymentReconciliationJob) settleTransfer(ctx context.Context, transfer adapter.SePayTransaction, order *entity.Transaction) (bool, error) {
+	payload, err := webhookPayloadFromTransfer(transfer, order.OrderID)
+	if err != nil {
+		logger.Warn("Skipping sepay transfer with unexpected ID", map[string]interface{}{
+			"sepayId": transfer.ID,
+			"orderId": order.OrderID,
+		})
+		return false, nil
+	}
+
+	// A second transfer for the same order reaches the webhook path too and is
+	// flagged there as a transfer for a closed order
+	tx, err := j.payments.HandleSePayWebhook(ctx, payload)
+	if err != nil {
+		logger.Error("failed to settle missed sepay transfer", err, map[string]interface{}{
+			"sepayId": transfer.ID,
+			"orderId": order.OrderID,
+		})
+		return false, err
+	}
+	if tx.SePayID != transfer.ID {
+		return false, nil
+	}
+
+	logger.Info("Settled order whose SePay webhook was missed", map[string]interface{}{
+		"orderId": tx.OrderID,
+		"sepayId": transfer.ID,
+		"status":  tx.Status,
+	})
+	return true, nil
+}
+
+// reportIfDue produces the report of the previous day once the report hour has passed
+func (j *PaymentReconciliationJob) reportIfDue(ctx context.Context, now time.Time) (*entity.PaymentReport, error) {
+	local := now.In(j.cfg.Location)
+	if local.Hour() < j.cfg.ReportHour {
+		return nil, nil
+	}
+
+	yesterday := local.AddDate(0, 0, -1)
+	day := yesterday.Format(paymentReportDayLayout)
+	if day == j.lastReport {
+		return nil, nil
+	}
+
+	report, err := j.reports.GenerateDailyReport(ctx, yesterday)
+	if err != nil {
+		return nil, fmt.Errorf("failed to produce payment report for %s: %w", day, err)
+	}
+	j.lastReport = day
+	return report, nil
+}
+
+// matchPendingOrder returns the pending order an incoming transfer pays for, if any
+func matchPendingOrder(transfer adapter.SePayTransaction, pending []*entity.Transaction) *entity.Transaction {
+	if transfer.Amount <= 0 {
+		return nil // Outgoing transfers never pay an order
+	}
+	for _, tx := range pending {
+		if transferNamesOrder(transfer.Content, tx.OrderID) {
+			return tx
+		}
+	}
+	return nil
+}
+
+// webhookPayloadFromTransfer builds the webhook SePay would have sent for a transfer
+func webhookPayloadFromTransfer(transfer adapter.SePayTransaction, orderID string) (SePayWebhookPayload, error) {
+	id, err := strconv.ParseInt(transfer.ID, 10, 64)
+	if err != nil {
+		return SePayWebhookPayload{}, err
+	}
+	return SePayWebhookPayload{
+		ID:              id,
+		Gateway:         transfer.BankName,
+		TransactionDate: transfer.TransferDate,
+		AccountNumber:   transfer.AccountNo,
+		Content:         orderID,
+		TransferType:    "in",
+		TransferAmount:  decimal.NewFromFloat(transfer.Amount),
+		ReferenceCode:   transfer.ReferenceCode,
+		Description:     transfer.Content,
+	}, nil
+}
@@ -0,0 +1,300 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	servicemocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/aiagent/internal/infrastructure/adapter"
+	"github.com/aiagent/internal/infrastructure/config"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// sepayFake serves the SePay transaction API from memory
+type sepayFake struct {
+	server    *httptest.Server
+	transfers []adapter.SePayTransaction
+	down      bool
+	listCalls atomic.Int32
+}
+
+func newSePayFake(t *testing.T, transfers ...adapter.SePayTransaction) *sepayFake {
+	f := &sepayFake{transfers: transfers}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer fake_key", r.Header.Get("Authorization"))
+		if f.down {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/transactions/list":
+			f.listCalls.Add(1)
+			json.NewEncoder(w).Encode(adapter.SePayTransactionListResponse{Status: 200, Data: f.list(r)})
+		case strings.HasPrefix(r.URL.Path, "/transactions/details/"):
+			id := strings.TrimPrefix(r.URL.Path, "/transactions/details/")
+			for _, transfer := range f.transfers {
+				if transfer.ID == id {
+					json.NewEncoder(w).Encode(adapter.SePayTransactionResponse{Status: 200, Data: transfer})
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// list pages through the transfers like SePay: they are held newest first and cut at
+// transaction_date_max and limit
+func (f *sepayFake) list(r *http.Request) []adapter.SePayTransaction {
+	until := r.URL.Query().Get("transaction_date_max")
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	page := []adapter.SePayTransaction{}
+	for _, transfer := range f.transfers {
+		if until != "" && transfer.TransferDate > until {
+			continue
+		}
+		if len(page) == limit {
+			break
+		}
+		page = append(page, transfer)
+	}
+	return page
+}
+
+func (f *sepayFake) adapter() adapter.SePayAdapter {
+	return adapter.NewSePayAdapter(&config.SePayConfig{BaseURL: f.server.URL, APIKey: "fake_key"})
+}
+
+type reconciliationJobFixture struct {
+	txRepo    *mocks.MockTransactionRepository
+	payments  *servicemocks.MockPaymentService
+	refunds   *servicemocks.MockRefundService
+	reports   *servicemocks.MockPaymentReportService
+	batchSize int
+}
+
+func newReconciliationJobFixture(t *testing.T) *reconciliationJobFixture {
+	ctrl := gomock.NewController(t)
+	return &reconciliationJobFixture{
+		txRepo:    mocks.NewMockTransactionRepository(ctrl),
+		payments:  servicemocks.NewMockPaymentService(ctrl),
+		refunds:   servicemocks.NewMockRefundService(ctrl),
+		reports:   servicemocks.NewMockPaymentReportService(ctrl),
+		batchSize: 50,
+	}
+}
+
+func (f *reconciliationJobFixture) job(sepay adapter.SePayAdapter, reportHour int) *service.PaymentReconciliationJob {
+	return service.NewPaymentReconciliationJob(f.txRepo, f.payments, f.refunds, f.reports, sepay, service.PaymentReconciliationConfig{
+		Interval:   time.Minute,
+		OrderTTL:   24 * time.Hour,
+		BatchSize:  f.batchSize,
+		ReportHour: reportHour,
+		Location:   time.UTC,
+	})
+}
+
+func pendingOrder(createdAt time.Time) *entity.Transaction {
+	orderID := "ORDER-SEPAY-" + uuid.New().String()
+	return &entity.Transaction{
+		ID:            uuid.New(),
+		Amount:        decimal.NewFromInt(50000),
+		Status:        entity.TransactionStatusPending,
+		OrderID:       orderID,
+		ReferenceCode: orderID,
+		CreatedAt:     createdAt,
+	}
+}
+
+func TestPaymentReconciliationJob_SettlesMissedTransfers(t *testing.T) {
+	f := newReconciliationJobFixture(t)
+	ctx := context.Background()
+
+	order := pendingOrder(time.Now().Add(-2 * time.Hour))
+	other := pendingOrder(time.Now().Add(-time.Hour))
+	// Banks strip punctuation and change case in transfer contents
+	bankContent := "CK " + strings.ToLower(strings.ReplaceAll(order.OrderID, "-", ""))
+	fake := newSePayFake(t,
+		adapter.SePayTransaction{ID: "9001", BankName: "MBBank", Amount: 50000, Content: bankContent, ReferenceCode: "FT1"},
+		adapter.SePayTransaction{ID: "9002", Amount: 75000, Content: "rent october"},
+		adapter.SePayTransaction{ID: "9003", Amount: -50000, Content: other.OrderID},
+	)
+
+	f.txRepo.EXPECT().FindPending(ctx, 50).Return([]*entity.Transaction{order, other}, nil)
+	f.payments.EXPECT().HandleSePayWebhook(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, payload service.SePayWebhookPayload) (*entity.Transaction, error) {
+			assert.Equal(t, int64(9001), payload.ID)
+			assert.Equal(t, order.OrderID, payload.Content)
+			assert.Equal(t, bankContent, payload.Description)
+			assert.Equal(t, "in", payload.TransferType)
+			assert.True(t, decimal.NewFromInt(50000).Equal(payload.TransferAmount))
+
+			settled := *order
+			settled.Status = entity.TransactionStatusSuccess
+			settled.SePayID = "9001"
+			return &settled, nil
+		})
+	f.txRepo.EXPECT().ExpirePending(ctx, []uuid.UUID{order.ID, other.ID}, gomock.Any()).DoAndReturn(func(_ context.Context, _ []uuid.UUID, before time.Time) (int64, error) {
+		assert.WithinDuration(t, time.Now().Add(-24*time.Hour), before, time.Minute)
+		return 3, nil
+	})
+	f.refunds.EXPECT().RetryPendingRefunds(ctx, 50).Return(2, nil)
+	report := &entity.PaymentReport{Day: time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02")}
+	f.reports.EXPECT().GenerateDailyReport(ctx, gomock.Any()).Return(report, nil)
+
+	job := f.job(fake.adapter(), 0)
+	result, err := job.RunOnce(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Settled)
+	assert.Equal(t, int64(3), result.Expired)
+	assert.Equal(t, 2, result.Refunded)
+	assert.Equal(t, report, result.Report)
+
+	t.Run("report_is_produced_once_a_day", func(t *testing.T) {
+		f.txRepo.EXPECT().FindPending(ctx, 50).Return(nil, nil)
+		f.txRepo.EXPECT().ExpirePending(ctx, gomock.Len(0), gomock.Any()).Return(int64(0), nil)
+		f.refunds.EXPECT().RetryPendingRefunds(ctx, 50).Return(0, nil)
+
+		result, err := job.RunOnce(ctx)
+
+		require.NoError(t, err)
+		assert.Nil(t, result.Report)
+	})
+}
+
+func TestPaymentReconciliationJob_DoesNotExpireWhenSePayIsDown(t *testing.T) {
+	f := newReconciliationJobFixture(t)
+	ctx := context.Background()
+
+	fake := newSePayFake(t)
+	fake.down = true
+
+	f.txRepo.EXPECT().FindPending(ctx, 50).Return([]*entity.Transaction{pendingOrder(time.Now().Add(-30 * time.Hour))}, nil)
+
+	_, err := f.job(fake.adapter(), 0).RunOnce(ctx)
+
+	assert.Error(t, err)
+}
+
+func TestPaymentReconciliationJob_NoPendingOrders(t *testing.T) {
+	f := newReconciliationJobFixture(t)
+	ctx := context.Background()
+
+	fake := newSePayFake(t)
+
+	f.txRepo.EXPECT().FindPending(ctx, 50).Return(nil, nil)
+	f.txRepo.EXPECT().ExpirePending(ctx, gomock.Len(0), gomock.Any()).Return(int64(0), nil)
+	// A provider that is still down does not fail the run
+	f.refunds.EXPECT().RetryPendingRefunds(ctx, 50).Return(0, errors.New("stripe api returned status: 503"))
+
+	// The report hour is not reached yet
+	result, err := f.job(fake.adapter(), 24).RunOnce(ctx)
+
+	require.NoError(t, err)
+	assert.Zero(t, result.Settled)
+	assert.Nil(t, result.Report)
+	assert.Zero(t, fake.listCalls.Load())
+}
+
+func TestPaymentReconciliationJob_SecondTransferIsNotCountedAsSettled(t *testing.T) {
+	f := newReconciliationJobFixture(t)
+	ctx := context.Background()
+
+	order := pendingOrder(time.Now().Add(-time.Hour))
+	fake := newSePayFake(t,
+		adapter.SePayTransaction{ID: "1", Amount: 50000, Content: order.OrderID},
+		adapter.SePayTransaction{ID: "2", Amount: 50000, Content: order.OrderID},
+	)
+
+	settled := *order
+	settled.Status = entity.TransactionStatusSuccess
+	settled.SePayID = "1"
+
+	f.txRepo.EXPECT().FindPending(ctx, 50).Return([]*entity.Transaction{order}, nil)
+	// Both transfers go through the webhook path; the second one is flagged there
+	f.payments.EXPECT().HandleSePayWebhook(ctx, gomock.Any()).Return(&settled, nil).Times(2)
+	f.txRepo.EXPECT().ExpirePending(ctx, []uuid.UUID{order.ID}, gomock.Any()).Return(int64(0), nil)
+	f.refunds.EXPECT().RetryPendingRefunds(ctx, 50).Return(0, nil)
+
+	result, err := f.job(fake.adapter(), 24).RunOnce(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Settled)
+}
+
+func TestPaymentReconciliationJob_PagesThroughTransfers(t *testing.T) {
+	f := newReconciliationJobFixture(t)
+	f.batchSize = 2
+	ctx := context.Background()
+
+	order := pendingOrder(time.Now().Add(-30 * time.Hour))
+	// The order's transfer is the oldest, past the first page
+	fake := newSePayFake(t,
+		adapter.SePayTransaction{ID: "4", Amount: 10000, Content: "rent", TransferDate: "2026-10-16 12:00:00"},
+		adapter.SePayTransaction{ID: "3", Amount: 10000, Content: "rent", TransferDate: "2026-10-16 11:00:00"},
+		adapter.SePayTransaction{ID: "2", Amount: 10000, Content: "rent", TransferDate: "2026-10-16 11:00:00"},
+		adapter.SePayTransaction{ID: "1", Amount: 50000, Content: order.OrderID, TransferDate: "2026-10-16 10:00:00"},
+	)
+
+	settled := *order
+	settled.Status = entity.TransactionStatusSuccess
+	settled.SePayID = "1"
+
+	f.txRepo.EXPECT().FindPending(ctx, 2).Return([]*entity.Transaction{order}, nil)
+	f.payments.EXPECT().HandleSePayWebhook(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, payload service.SePayWebhookPayload) (*entity.Transaction, error) {
+			assert.Equal(t, int64(1), payload.ID)
+			return &settled, nil
+		})
+	f.txRepo.EXPECT().ExpirePending(ctx, []uuid.UUID{order.ID}, gomock.Any()).Return(int64(0), nil)
+	f.refunds.EXPECT().RetryPendingRefunds(ctx, 2).Return(0, nil)
+
+	result, err := f.job(fake.adapter(), 24).RunOnce(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Settled)
+	assert.Equal(t, int32(3), fake.listCalls.Load())
+}
+
+func TestPaymentReconciliationJob_KeepsOrderWhoseTransferFailedToSettle(t *testing.T) {
+	f := newReconciliationJobFixture(t)
+	ctx := context.Background()
+
+	order := pendingOrder(time.Now().Add(-30 * time.Hour))
+	stale := pendingOrder(time.Now().Add(-29 * time.Hour))
+	fake := newSePayFake(t, adapter.SePayTransaction{ID: "1", Amount: 50000, Content: order.OrderID})
+
+	f.txRepo.EXPECT().FindPending(ctx, 50).Return([]*entity.Transaction{order, stale}, nil)
+	f.payments.EXPECT().HandleSePayWebhook(ctx, gomock.Any()).Return(nil, errors.New("connection reset"))
+	// The paid order is left pending for the next run instead of being expired
+	f.txRepo.EXPECT().ExpirePending(ctx, []uuid.UUID{stale.ID}, gomock.Any()).Return(int64(1), nil)
+	f.refunds.EXPECT().RetryPendingRefunds(ctx, 50).Return(0, nil)
+
+	result, err := f.job(fake.adapter(), 24).RunOnce(ctx)
+
+	require.NoError(t, err)
+	assert.Zero(t, result.Settled)
+	assert.Equal(t, int64(1), result.Expired)
+}
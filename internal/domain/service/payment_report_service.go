@@ -0,0 +1,214 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/infrastructure/adapter"
+	"github.com/aiagent/pkg/logger"
+	"github.com/shopspring/decimal"
+)
+
+var ErrPaymentReportNotFound = errors.New("payment report not found")
+
+// paymentReportDayLayout is the format of PaymentReport.Day
+const paymentReportDayLayout = "2006-01-02"
+
+// PaymentReportService produces the daily reconciliation of orders against SePay
+type PaymentReportService interface {
+	// GenerateDailyReport reconciles the calendar day containing day, in day's location. Every
+	// transfer applied that day is checked against the record SePay holds, and transfers flagged
+	// by the webhook are listed. A day is only reported once; later calls return the stored report.
+	GenerateDailyReport(ctx context.Context, day time.Time) (*entity.PaymentReport, error)
+
+	ListReports(ctx context.Context, pagination repository.Pagination) (*repository.PaginatedResult[entity.PaymentReport], error)
+
+	// GetReport returns the report of a day formatted as YYYY-MM-DD
+	GetReport(ctx context.Context, day string) (*entity.PaymentReport, error)
+}
+
+type paymentReportService struct {
+	txRepo       repository.TransactionRepository
+	reconRepo    repository.PaymentReconciliationRepository
+	reportRepo   repository.PaymentReportRepository
+	sepayAdapter adapter.SePayAdapter
+}
+
+// NewPaymentReportService creates a new instance of PaymentReportService
+func NewPaymentReportService(
+	txRepo repository.TransactionRepository,
+	reconRepo repository.PaymentReconciliationRepository,
+	reportRepo repository.PaymentReportRepository,
+	sepayAdapter adapter.SePayAdapter,
+) PaymentReportService {
+	return &paymentReportService{
+		txRepo:       txRepo,
+		reconRepo:    reconRepo,
+		reportRepo:   reportRepo,
+		sepayAdapter: sepayAdapter,
+	}
+}
+
+// GenerateDailyReport builds and stores the reconciliation report of a day
+func (s *paymentReportService) GenerateDailyReport(ctx context.Context, day time.Time) (*entity.PaymentReport, error) {
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	to := from.AddDate(0, 0, 1)
+	key := from.Format(paymentReportDayLayout)
+
+	existing, err := s.reportRepo.FindByDay(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load report: %w", err)
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	counts, err := s.txRepo.CountCreatedByStatus(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count orders: %w", err)
+	}
+	report := &entity.PaymentReport{
+		Day:           key,
+		PeriodStart:   from,
+		PeriodEnd:     to,
+		OrdersExpired: counts[entity.TransactionStatusExpired],
+		OrdersPending: counts[entity.TransactionStatusPending],
+		PaidAmount:    decimal.Zero,
+		Mismatches:    []entity.PaymentReportMismatch{},
+	}
+	for _, n := range counts {
+		report.OrdersCreated += n
+	}
+
+	recs, err := s.reconRepo.FindCreatedBetween(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reconciliations: %w", err)
+	}
+	for i := range recs {
+		rec := &recs[i]
+		report.Mismatches = append(report.Mismatches, entity.PaymentReportMismatch{
+			Kind:             entity.PaymentMismatchKind(rec.Reason),
+			TransactionID:    rec.TransactionID,
+			ReconciliationID: &rec.ID,
+			SePayID:          rec.SePayID,
+			ExpectedAmount:   rec.ExpectedAmount,
+			ReceivedAmount:   &rec.ReceivedAmount,
+		})
+	}
+
+	paid, err := s.txRepo.FindPaidBetween(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load paid orders: %w", err)
+	}
+	for _, tx := range paid {
+		applied := tx.Amount
+		if tx.PaidAmount != nil {
+			applied = *tx.PaidAmount
+		}
+		report.OrdersPaid++
+		report.PaidAmount = report.PaidAmount.Add(applied)
+
+		mismatch, err := s.verifyTransfer(ctx, tx, applied)
+		if err != nil {
+			return nil, err
+		}
+		if mismatch != nil {
+			report.Mismatches = append(report.Mismatches, *mismatch)
+		}
+	}
+
+	created, err := s.reportRepo.Create(ctx, report)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store report: %w", err)
+	}
+	if !created {
+		// Another instance stored the day first
+		return s.reportRepo.FindByDay(ctx, key)
+	}
+
+	logger.Info("Payment reconciliation report produced", map[string]interface{}{
+		"day":        report.Day,
+		"created":    report.OrdersCreated,
+		"paid":       report.OrdersPaid,
+		"expired":    report.OrdersExpired,
+		"mismatches": len(report.Mismatches),
+	})
+	return report, nil
+}
+
+// verifyTransfer compares a settled order with the transfer SePay recorded for it.
+// It returns nil when both agree.
+func (s *paymentReportService) verifyTransfer(ctx context.Context, tx *entity.Transaction, applied decimal.Decimal) (*entity.PaymentReportMismatch, error) {
+	if tx.SePayID == "" {
+		return nil, nil // Settled without a transfer
+	}
+
+	mismatch := &entity.PaymentReportMismatch{
+		TransactionID:  tx.ID,
+		OrderID:        tx.OrderID,
+		SePayID:        tx.SePayID,
+		ExpectedAmount: tx.Amount,
+	}
+
+	transfer, err := s.sepayAdapter.GetTransactionBySePayID(ctx, tx.SePayID)
+	if errors.Is(err, adapter.ErrSePayTransactionNotFound) {
+		mismatch.Kind = entity.PaymentMismatchProviderMissing
+		return mismatch, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify transfer %s: %w", tx.SePayID, err)
+	}
+
+	received := decimal.NewFromFloat(transfer.Amount)
+	mismatch.ReceivedAmount = &received
+	switch {
+	case !received.Equal(applied):
+		mismatch.Kind = entity.PaymentMismatchProviderAmount
+	case !transferNamesOrder(transfer.Content, tx.OrderID):
+		mismatch.Kind = entity.PaymentMismatchProviderReference
+	default:
+		return nil, nil
+	}
+	return mismatch, nil
+}
+
+// ListReports returns stored reports, most recent day first
+func (s *paymentReportService) ListReports(ctx context.Context, pagination repository.Pagination) (*repository.PaginatedResult[entity.PaymentReport], error) {
+	return s.reportRepo.FindAll(ctx, pagination)
+}
+
+// GetReport returns the report of a day
+func (s *paymentReportService) GetReport(ctx context.Context, day string) (*entity.PaymentReport, error) {
+	report, err := s.reportRepo.FindByDay(ctx, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load report: %w", err)
+	}
+	if report == nil {
+		return nil, ErrPaymentReportNotFound
+	}
+	return report, nil
+}
+
+// transferNamesOrder returns true if the content of a bank transfer contains the order ID.
+// Banks drop punctuation and change case in transfer contents, so only letters and digits are compared.
+func transferNamesOrder(content, orderID string) bool {
+	ref := normalizeTransferRef(orderID)
+	return ref != "" && strings.Contains(normalizeTransferRef(content), ref)
+}
+
+func normalizeTransferRef(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, s)
+}
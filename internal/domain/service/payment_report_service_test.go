@@ -0,0 +1,160 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/infrastructure/adapter"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPaymentReportService_GenerateDailyReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	txRepo := mocks.NewMockTransactionRepository(ctrl)
+	reconRepo := mocks.NewMockPaymentReconciliationRepository(ctrl)
+	reportRepo := mocks.NewMockPaymentReportRepository(ctrl)
+
+	loc := time.FixedZone("ICT", 7*60*60)
+	day := time.Date(2026, 10, 15, 13, 0, 0, 0, loc)
+	from := time.Date(2026, 10, 15, 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 0, 1)
+
+	paidOrder := func(sePayID string) *entity.Transaction {
+		amount := decimal.NewFromInt(50000)
+		return &entity.Transaction{
+			ID:         uuid.New(),
+			Amount:     amount,
+			PaidAmount: &amount,
+			Status:     entity.TransactionStatusSuccess,
+			SePayID:    sePayID,
+			OrderID:    "ORDER-SEPAY-" + sePayID,
+		}
+	}
+	matching := paidOrder("101")
+	wrongAmount := paidOrder("102")
+	missing := paidOrder("103")
+	wrongContent := paidOrder("104")
+	noTransfer := paidOrder("")
+
+	svc := service.NewPaymentReportService(txRepo, reconRepo, reportRepo, newSePayFake(t,
+		adapter.SePayTransaction{ID: "101", Amount: 50000, Content: "ORDER-SEPAY-101"},
+		adapter.SePayTransaction{ID: "102", Amount: 5000, Content: "ORDER-SEPAY-102"},
+		adapter.SePayTransaction{ID: "104", Amount: 50000, Content: "gift for mom"},
+	).adapter())
+
+	t.Run("reconciles_the_day", func(t *testing.T) {
+		rec := entity.PaymentReconciliation{
+			ID:             uuid.New(),
+			TransactionID:  uuid.New(),
+			SePayID:        "99",
+			Reason:         entity.ReconciliationReasonUnderpaid,
+			ExpectedAmount: decimal.NewFromInt(50000),
+			ReceivedAmount: decimal.NewFromInt(40000),
+		}
+
+		reportRepo.EXPECT().FindByDay(ctx, "2026-10-15").Return(nil, nil)
+		txRepo.EXPECT().CountCreatedByStatus(ctx, from, to).Return(map[entity.TransactionStatus]int64{
+			entity.TransactionStatusSuccess: 5,
+			entity.TransactionStatusExpired: 2,
+			entity.TransactionStatusPending: 1,
+		}, nil)
+		reconRepo.EXPECT().FindCreatedBetween(ctx, from, to).Return([]entity.PaymentReconciliation{rec}, nil)
+		txRepo.EXPECT().FindPaidBetween(ctx, from, to).Return([]*entity.Transaction{matching, wrongAmount, missing, wrongContent, noTransfer}, nil)
+		reportRepo.EXPECT().Create(ctx, gomock.Any()).Return(true, nil)
+
+		report, err := svc.GenerateDailyReport(ctx, day)
+
+		require.NoError(t, err)
+		assert.Equal(t, "2026-10-15", report.Day)
+		assert.Equal(t, from, report.PeriodStart)
+		assert.Equal(t, int64(8), report.OrdersCreated)
+		assert.Equal(t, int64(5), report.OrdersPaid)
+		assert.Equal(t, int64(2), report.OrdersExpired)
+		assert.Equal(t, int64(1), report.OrdersPending)
+		assert.True(t, decimal.NewFromInt(250000).Equal(report.PaidAmount))
+
+		kinds := map[uuid.UUID]entity.PaymentMismatchKind{}
+		for _, m := range report.Mismatches {
+			kinds[m.TransactionID] = m.Kind
+		}
+		assert.Equal(t, map[uuid.UUID]entity.PaymentMismatchKind{
+			rec.TransactionID: entity.PaymentMismatchUnderpaid,
+			wrongAmount.ID:    entity.PaymentMismatchProviderAmount,
+			missing.ID:        entity.PaymentMismatchProviderMissing,
+			wrongContent.ID:   entity.PaymentMismatchProviderReference,
+		}, kinds)
+	})
+
+	t.Run("already_reported", func(t *testing.T) {
+		existing := &entity.PaymentReport{Day: "2026-10-15"}
+		reportRepo.EXPECT().FindByDay(ctx, "2026-10-15").Return(existing, nil)
+
+		report, err := svc.GenerateDailyReport(ctx, day)
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, report)
+	})
+
+	t.Run("reported_by_another_instance_meanwhile", func(t *testing.T) {
+		existing := &entity.PaymentReport{ID: uuid.New(), Day: "2026-10-15"}
+		reportRepo.EXPECT().FindByDay(ctx, "2026-10-15").Return(nil, nil)
+		txRepo.EXPECT().CountCreatedByStatus(ctx, from, to).Return(nil, nil)
+		reconRepo.EXPECT().FindCreatedBetween(ctx, from, to).Return(nil, nil)
+		txRepo.EXPECT().FindPaidBetween(ctx, from, to).Return(nil, nil)
+		reportRepo.EXPECT().Create(ctx, gomock.Any()).Return(false, nil)
+		reportRepo.EXPECT().FindByDay(ctx, "2026-10-15").Return(existing, nil)
+
+		report, err := svc.GenerateDailyReport(ctx, day)
+
+		require.NoError(t, err)
+		assert.Equal(t, existing.ID, report.ID)
+	})
+}
+
+func TestPaymentReportService_GenerateDailyReport_SePayDown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	txRepo := mocks.NewMockTransactionRepository(ctrl)
+	reconRepo := mocks.NewMockPaymentReconciliationRepository(ctrl)
+	reportRepo := mocks.NewMockPaymentReportRepository(ctrl)
+
+	fake := newSePayFake(t)
+	fake.down = true
+	svc := service.NewPaymentReportService(txRepo, reconRepo, reportRepo, fake.adapter())
+
+	amount := decimal.NewFromInt(50000)
+	reportRepo.EXPECT().FindByDay(ctx, gomock.Any()).Return(nil, nil)
+	txRepo.EXPECT().CountCreatedByStatus(ctx, gomock.Any(), gomock.Any()).Return(nil, nil)
+	reconRepo.EXPECT().FindCreatedBetween(ctx, gomock.Any(), gomock.Any()).Return(nil, nil)
+	txRepo.EXPECT().FindPaidBetween(ctx, gomock.Any(), gomock.Any()).Return([]*entity.Transaction{
+		{ID: uuid.New(), Amount: amount, PaidAmount: &amount, SePayID: "1"},
+	}, nil)
+
+	// Nothing is stored so the day is reported again on the next run
+	_, err := svc.GenerateDailyReport(ctx, time.Now())
+
+	assert.Error(t, err)
+}
+
+func TestPaymentReportService_GetReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reportRepo := mocks.NewMockPaymentReportRepository(ctrl)
+	svc := service.NewPaymentReportService(nil, nil, reportRepo, nil)
+
+	reportRepo.EXPECT().FindByDay(gomock.Any(), "2026-01-01").Return(nil, nil)
+
+	_, err := svc.GetReport(context.Background(), "2026-01-01")
+
+	assert.ErrorIs(t, err, service.ErrPaymentReportNotFound)
+}
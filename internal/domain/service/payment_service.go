@@ -4,8 +4,12 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aiagent/internal/domain/entity"
@@ -17,27 +21,69 @@ import (
 	"gorm.io/gorm"
 )
 
+var (
+	ErrSeriesNotForSale       = errors.New("series is not for sale")
+	ErrSeriesAlreadyPurchased = errors.New("series already purchased")
+	ErrUnsupportedCurrency    = errors.New("currency is not supported by the payment gateway")
+	ErrInvalidPaymentAmount   = errors.New("payment amount must be greater than zero")
+	ErrPaymentAmountPrecision = errors.New("payment amount has more decimal places than the currency allows")
+	ErrInvalidPaymentTarget   = errors.New("payment target is missing or invalid")
+	ErrCannotDonateToSelf     = errors.New("cannot donate to yourself")
+	ErrUnsupportedPaymentType = errors.New("payment type or gateway is not supported")
+
+	ErrPromoCodeInvalid       = errors.New("promo code does not exist, has expired or has been used up")
+	ErrPromoCodeNotApplicable = errors.New("promo code does not apply to this order")
+	ErrGiftNotFound           = errors.New("gift code not found")
+	ErrGiftNotRedeemable      = errors.New("gift code was already redeemed or revoked")
+	ErrOwnGift                = errors.New("gift codes cannot be redeemed by their purchaser")
+
+	ErrReconciliationNotFound     = errors.New("payment reconciliation not found")
+	ErrReconciliationResolved     = errors.New("payment reconciliation is already resolved")
+	ErrReconciliationNotGrantable = errors.New("order of this reconciliation is already paid")
+
+	// errOrderClaimed means another payment or admin changed the order's status first
+	errOrderClaimed = errors.New("order status changed concurrently")
+)
+
+// defaultCurrency is used for orders whose price does not carry a currency
+const defaultCurrency = "VND"
+
+const (
+	// giftCodeAlphabet leaves out characters that are easily confused when a code is typed
+	giftCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	giftCodeLength   = 12
+)
+
 // CreatePaymentRequest represents the request to initiate a payment
 type CreatePaymentRequest struct {
 	UserID   string                    `json:"userId" validate:"required"`
 	Amount   decimal.Decimal           `json:"amount" validate:"required,gt=0"`
 	Type     entity.TransactionType    `json:"type" validate:"required"`
 	Gateway  entity.TransactionGateway `json:"gateway" validate:"required"`
+	Currency string                    `json:"currency,omitempty"` // Donations only, defaults to VND
 	TargetID *string                   `json:"targetId,omitempty"` // ID of Subscription Author or Series
 	PlanID   *string                   `json:"planId,omitempty"`   // Subscription plan ID
+
+	// Subscriptions only
+	Gift      bool    `json:"gift,omitempty"`      // Pay for the plan as a gift code instead of for the payer
+	PromoCode *string `json:"promoCode,omitempty"` // Promo code of the author, discounting the plan
 }
 
 // PaymentResponse represents the response after initiating a payment
 type PaymentResponse struct {
 	OrderID       string                    `json:"orderId"`
 	Amount        decimal.Decimal           `json:"amount"`
+	Discount      decimal.Decimal           `json:"discount"` // Taken off by the promo code
+	Currency      string                    `json:"currency"`
 	Gateway       entity.TransactionGateway `json:"gateway"`
+	CheckoutURL   string                    `json:"checkoutUrl,omitempty"` // Hosted payment page of card providers
 	QRDataURL     string                    `json:"qrDataUrl,omitempty"`
 	QRData        string                    `json:"qrData,omitempty"`
 	BankName      string                    `json:"bankName,omitempty"`
 	AccountNo     string                    `json:"accountNo,omitempty"`
 	AccountName   string                    `json:"accountName,omitempty"`
 	ReferenceCode string                    `json:"referenceCode"`
+	Status        entity.TransactionStatus  `json:"status"`
 }
 
 // SePayWebhookPayload represents the payload from SePay webhook
@@ -61,9 +107,25 @@ type SePayWebhookPayload struct {
 // PaymentService defines the interface for payment operations
 type PaymentService interface {
 	InitPayment(ctx context.Context, req CreatePaymentRequest) (*PaymentResponse, error)
+	// HandleSePayWebhook applies a transfer reported by SePay. It returns a nil transaction for
+	// outgoing transfers, which never pay an order.
 	HandleSePayWebhook(ctx context.Context, payload SePayWebhookPayload) (*entity.Transaction, error)
+	// HandleProviderWebhook verifies and applies a payment notification from a provider. It
+	// returns a nil transaction for events that do not settle a payment.
+	HandleProviderWebhook(ctx context.Context, provider entity.TransactionProvider, body []byte, signature string) (*entity.Transaction, error)
+	// GetTransactionStatus returns an order, first settling it if its provider reports it paid
 	GetTransactionStatus(ctx context.Context, orderID string) (*entity.Transaction, error)
-	VerifySePayWebhookSignature(payload map[string]interface{}, signature string) bool
+
+	// ListReconciliations returns the transfers flagged because they did not match their order
+	ListReconciliations(ctx context.Context, filter repository.PaymentReconciliationFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.PaymentReconciliation], error)
+	// ResolveReconciliation closes a flagged transfer. When grant is true the order is completed
+	// and its benefits granted; otherwise a held order is marked as failed.
+	ResolveReconciliation(ctx context.Context, id, adminID uuid.UUID, grant bool, note *string) (*entity.PaymentReconciliation, error)
+
+	// RedeemGift starts the subscription period paid by a gift code for the recipient
+	RedeemGift(ctx context.Context, recipientID uuid.UUID, code string) (*entity.GiftSubscription, error)
+	// ListGifts returns the gift codes a user paid for, most recent first
+	ListGifts(ctx context.Context, purchaserID uuid.UUID, pagination repository.Pagination) (*repository.PaginatedResult[entity.GiftSubscription], error)
 }
 
 type paymentService struct {
@@ -71,8 +133,16 @@ type paymentService struct {
 	txRepo       repository.TransactionRepository
 	subRepo      repository.SubscriptionRepository
 	purchaseRepo repository.UserSeriesPurchaseRepository
+	seriesRepo   repository.SeriesRepository
+	userRepo     repository.UserRepository
 	planRepo     repository.SubscriptionPlanRepository
-	sepayAdapter adapter.SePayAdapter
+	outboxRepo   repository.OutboxRepository
+	subEventRepo repository.SubscriptionEventRepository
+	reconRepo    repository.PaymentReconciliationRepository
+	promoRepo    repository.PromoCodeRepository
+	giftRepo     repository.GiftSubscriptionRepository
+	ledger       LedgerService
+	providers    *PaymentProviders
 }
 
 // NewPaymentService creates a new instance of PaymentService
@@ -81,16 +151,32 @@ func NewPaymentService(
 	txRepo repository.TransactionRepository,
 	subRepo repository.SubscriptionRepository,
 	purchaseRepo repository.UserSeriesPurchaseRepository,
+	seriesRepo repository.SeriesRepository,
+	userRepo repository.UserRepository,
 	planRepo repository.SubscriptionPlanRepository,
-	sepayAdapter adapter.SePayAdapter,
+	outboxRepo repository.OutboxRepository,
+	subEventRepo repository.SubscriptionEventRepository,
+	reconRepo repository.PaymentReconciliationRepository,
+	promoRepo repository.PromoCodeRepository,
+	giftRepo repository.GiftSubscriptionRepository,
+	ledger LedgerService,
+	providers *PaymentProviders,
 ) PaymentService {
 	return &paymentService{
 		db:           db,
 		txRepo:       txRepo,
 		subRepo:      subRepo,
 		purchaseRepo: purchaseRepo,
+		seriesRepo:   seriesRepo,
+		userRepo:     userRepo,
 		planRepo:     planRepo,
-		sepayAdapter: sepayAdapter,
+		outboxRepo:   outboxRepo,
+		subEventRepo: subEventRepo,
+		reconRepo:    reconRepo,
+		promoRepo:    promoRepo,
+		giftRepo:     giftRepo,
+		ledger:       ledger,
+		providers:    providers,
 	}
 }
 
@@ -101,65 +187,298 @@ func (s *paymentService) InitPayment(ctx context.Context, req CreatePaymentReque
 		return nil, fmt.Errorf("invalid user id: %w", err)
 	}
 
-	orderID := fmt.Sprintf("ORDER-SEPAY-%s", uuid.New().String())
+	// The order is bound to a well-formed target so the webhook grants exactly what was priced
+	var targetUUID *uuid.UUID
+	if req.TargetID != nil {
+		id, err := uuid.Parse(*req.TargetID)
+		if err != nil {
+			return nil, ErrInvalidPaymentTarget
+		}
+		targetUUID = &id
+	}
+
+	amount, currency := req.Amount, defaultCurrency
+	var planID *string
+	var plan *entity.SubscriptionPlan
+	var quote *SubscriptionQuote
+	if req.Gift && req.Type != entity.TransactionTypeSubscription {
+		return nil, ErrUnsupportedPaymentType
+	}
+	if req.PromoCode != nil && req.Type != entity.TransactionTypeSubscription {
+		return nil, ErrPromoCodeNotApplicable
+	}
+
+	switch req.Type {
+	case entity.TransactionTypeSeries:
+		series, err := s.seriesForPurchase(ctx, userUUID, req.TargetID)
+		if err != nil {
+			return nil, err
+		}
+		// The price always comes from the series, never from the client
+		amount, currency = series.Price, series.Currency
+	case entity.TransactionTypeSubscription:
+		q, err := s.subscriptionQuote(ctx, userUUID, req.TargetID, req.PlanID, req.Gift)
+		if err != nil {
+			return nil, err
+		}
+		// Upgrades only charge the difference to the unused days of the current plan
+		amount, planID, plan, quote = q.AmountDue, req.PlanID, q.Plan, q
+	case entity.TransactionTypeDonation:
+		// Donations are the only orders whose amount is chosen by the payer
+		if targetUUID == nil {
+			return nil, ErrInvalidPaymentTarget
+		}
+		if *targetUUID == userUUID {
+			return nil, ErrCannotDonateToSelf
+		}
+		if !amount.IsPositive() {
+			return nil, ErrInvalidPaymentAmount
+		}
+		recipient, err := s.userRepo.FindByID(ctx, *targetUUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load donation recipient: %w", err)
+		}
+		if recipient == nil {
+			return nil, ErrInvalidPaymentTarget
+		}
+		if req.Currency != "" {
+			currency = strings.ToUpper(req.Currency)
+		}
+		// Providers collect whole minor units, so a fraction of one could never be paid exactly
+		if !amount.Equal(amount.Truncate(minorUnits(currency))) {
+			return nil, ErrPaymentAmountPrecision
+		}
+	default:
+		return nil, ErrUnsupportedPaymentType
+	}
+
+	// Each currency is collected by one provider, which must offer the chosen payment method
+	providerName, provider, err := s.providers.ForCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	if !provider.SupportsGateway(string(req.Gateway)) {
+		return nil, ErrUnsupportedPaymentType
+	}
+
+	// The promo code is checked last so an order that fails for another reason does not look at it
+	discount := decimal.Zero
+	var promoCodeID *uuid.UUID
+	if req.PromoCode != nil {
+		promo, err := s.promoCodeFor(ctx, plan, *req.PromoCode)
+		if err != nil {
+			return nil, err
+		}
+		// The redemption is taken when the order is placed and given back if it is never paid
+		reserved, err := s.promoRepo.ReserveRedemption(ctx, promo.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reserve promo code: %w", err)
+		}
+		if !reserved {
+			return nil, ErrPromoCodeInvalid
+		}
+		discount = promo.Discount(amount).RoundFloor(minorUnits(currency))
+		amount, promoCodeID = amount.Sub(discount), &promo.ID
+	}
+
+	orderID := fmt.Sprintf("ORDER-%s-%s", providerName, uuid.New().String())
 
 	tx := &entity.Transaction{
 		ID:            uuid.New(),
 		UserID:        userUUID,
-		Amount:        req.Amount,
-		Currency:      "VND",
-		Provider:      entity.TransactionProviderSEPAY,
+		Amount:        amount,
+		Currency:      currency,
+		Provider:      providerName,
 		Gateway:       &req.Gateway,
 		Type:          req.Type,
 		Status:        entity.TransactionStatusPending,
+		TargetID:      targetUUID,
+		PlanID:        planID,
+		Gift:          req.Gift,
+		PromoCodeID:   promoCodeID,
+		Discount:      discount,
 		OrderID:       orderID,
 		ReferenceCode: orderID,
 	}
-
-	if req.TargetID != nil {
-		targetUUID, err := uuid.Parse(*req.TargetID)
-		if err == nil {
-			tx.TargetID = &targetUUID
-		}
-	}
-	tx.PlanID = req.PlanID
-
-	if err := s.txRepo.Create(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to create transaction: %w", err)
+	// The payment grants the change priced here; gifts are priced when they are redeemed
+	if quote != nil && !req.Gift {
+		storeQuote(tx, quote)
 	}
 
 	resp := &PaymentResponse{
 		OrderID:       orderID,
-		Amount:        req.Amount,
+		Amount:        amount,
+		Discount:      discount,
+		Currency:      currency,
 		Gateway:       req.Gateway,
 		ReferenceCode: orderID,
+		Status:        tx.Status,
 	}
 
-	if req.Gateway == entity.TransactionGatewayVietQR {
-		qrReq := adapter.CreateVietQRRequest{
-			Amount:  int(req.Amount.IntPart()),
-			AddInfo: orderID,
+	// An upgrade fully covered by the credit or a promo code has nothing to transfer, so it completes right away
+	if req.Type == entity.TransactionTypeSubscription && amount.IsZero() {
+		if err := s.txRepo.Create(ctx, tx); err != nil {
+			s.releasePromoCode(ctx, tx)
+			return nil, fmt.Errorf("failed to create transaction: %w", err)
 		}
-		qrResp, err := s.sepayAdapter.CreateVietQR(ctx, qrReq)
+		err := s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+			return s.completeTransaction(ctx, dbTx, tx)
+		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to create VietQR: %w", err)
+			return nil, err
 		}
-		resp.QRData = qrResp.Data.QrCode
-		resp.QRDataURL = qrResp.Data.QrDataURL
-	} else if req.Gateway == entity.TransactionGatewayBankTransfer {
-		bankInfo := s.sepayAdapter.GetBankTransferInfo()
-		resp.BankName = bankInfo.BankName
-		resp.AccountNo = bankInfo.AccountNo
-		resp.AccountName = bankInfo.AccountName
+		resp.Status = tx.Status
+		return resp, nil
+	}
+
+	// The session is opened first so card orders are stored with the reference their webhook reports
+	session, err := provider.CreatePayment(ctx, adapter.PaymentSessionRequest{
+		OrderID:     orderID,
+		Amount:      amount,
+		Currency:    currency,
+		Gateway:     string(req.Gateway),
+		Description: paymentDescription(req.Type),
+	})
+	if err != nil {
+		s.releasePromoCode(ctx, tx)
+		return nil, fmt.Errorf("failed to start %s payment: %w", providerName, err)
+	}
+	if session.ProviderRef != "" {
+		tx.ProviderRef = &session.ProviderRef
 	}
 
+	if err := s.txRepo.Create(ctx, tx); err != nil {
+		s.releasePromoCode(ctx, tx)
+		return nil, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	resp.CheckoutURL = session.CheckoutURL
+	resp.QRData = session.QRData
+	resp.QRDataURL = session.QRDataURL
+	resp.BankName = session.BankName
+	resp.AccountNo = session.AccountNo
+	resp.AccountName = session.AccountName
 	return resp, nil
 }
 
+// paymentDescription names what an order pays for on the provider's checkout page
+func paymentDescription(txType entity.TransactionType) string {
+	switch txType {
+	case entity.TransactionTypeSubscription:
+		return "Author subscription"
+	case entity.TransactionTypeSeries:
+		return "Series purchase"
+	default:
+		return "Donation"
+	}
+}
+
+// subscriptionQuote prices the plan a user is about to pay for. A gift is priced like a new
+// subscription since it does not change the payer's own.
+func (s *paymentService) subscriptionQuote(ctx context.Context, userID uuid.UUID, targetID, planID *string, gift bool) (*SubscriptionQuote, error) {
+	if targetID == nil || planID == nil {
+		return nil, ErrPlanNotFound
+	}
+	authorID, err := uuid.Parse(*targetID)
+	if err != nil {
+		return nil, ErrPlanNotFound
+	}
+	planUUID, err := uuid.Parse(*planID)
+	if err != nil {
+		return nil, ErrPlanNotFound
+	}
+
+	if !gift {
+		return quotePlan(ctx, s.subRepo, s.planRepo, userID, authorID, planUUID, time.Now())
+	}
+
+	if userID == authorID {
+		return nil, ErrCannotSubscribeToSelf
+	}
+	plan, err := s.planRepo.FindByID(ctx, planUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plan: %w", err)
+	}
+	if plan == nil || !plan.IsActive || plan.AuthorID != authorID {
+		return nil, ErrPlanNotFound
+	}
+	return quoteSubscription(ctx, s.planRepo, nil, plan, time.Now())
+}
+
+// promoCodeFor loads a promo code of the plan's author that can discount the plan
+func (s *paymentService) promoCodeFor(ctx context.Context, plan *entity.SubscriptionPlan, code string) (*entity.PromoCode, error) {
+	promo, err := s.promoRepo.FindByAuthorAndCode(ctx, plan.AuthorID, strings.TrimSpace(code))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load promo code: %w", err)
+	}
+	if promo == nil || !promo.IsRedeemable(time.Now()) {
+		return nil, ErrPromoCodeInvalid
+	}
+	if !promo.AppliesTo(plan.Tier) {
+		return nil, ErrPromoCodeNotApplicable
+	}
+	return promo, nil
+}
+
+// releasePromoCode gives back the promo code redemption of an order that was not placed. The
+// order's error is what the caller reports, so a failure here is only logged.
+func (s *paymentService) releasePromoCode(ctx context.Context, tx *entity.Transaction) {
+	if tx.PromoCodeID == nil {
+		return
+	}
+	if err := s.promoRepo.ReleaseRedemption(ctx, *tx.PromoCodeID); err != nil {
+		logger.Error("Failed to release promo code redemption", err, map[string]interface{}{
+			"orderId":     tx.OrderID,
+			"promoCodeId": tx.PromoCodeID,
+		})
+	}
+}
+
+// seriesForPurchase loads the paid series a user is about to buy
+func (s *paymentService) seriesForPurchase(ctx context.Context, userID uuid.UUID, targetID *string) (*entity.Series, error) {
+	if targetID == nil {
+		return nil, ErrSeriesNotFound
+	}
+	seriesID, err := uuid.Parse(*targetID)
+	if err != nil {
+		return nil, ErrSeriesNotFound
+	}
+
+	series, err := s.seriesRepo.GetByID(ctx, seriesID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrSeriesNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load series: %w", err)
+	}
+	if !series.IsPaid() || series.AuthorID == userID {
+		return nil, ErrSeriesNotForSale
+	}
+
+	purchased, err := s.purchaseRepo.HasPurchased(ctx, userID, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check series purchase: %w", err)
+	}
+	if purchased {
+		return nil, ErrSeriesAlreadyPurchased
+	}
+
+	return series, nil
+}
+
 // HandleSePayWebhook processes the incoming webhook from SePay
 func (s *paymentService) HandleSePayWebhook(ctx context.Context, payload SePayWebhookPayload) (*entity.Transaction, error) {
 	sePayID := strconv.FormatInt(payload.ID, 10)
 
+	// SePay also reports money leaving the account; only incoming transfers pay an order
+	if payload.TransferType != "in" {
+		logger.Info("Ignoring outgoing sepay transfer", map[string]interface{}{
+			"sepayId":      sePayID,
+			"transferType": payload.TransferType,
+		})
+		return nil, nil
+	}
+
 	// Idempotency check
 	existingTx, err := s.txRepo.FindBySePayID(ctx, sePayID)
 	if err == nil && existingTx != nil {
@@ -175,39 +494,343 @@ func (s *paymentService) HandleSePayWebhook(ctx context.Context, payload SePayWe
 		return nil, fmt.Errorf("transaction not found")
 	}
 
-	if tx.Status == entity.TransactionStatusSuccess {
+	// A transfer for an order that is no longer payable is never applied to it
+	if tx.Status != entity.TransactionStatusPending {
+		if err := s.flagClosedOrderTransfer(ctx, tx, sePayID, payload.TransferAmount); err != nil {
+			return nil, err
+		}
 		return tx, nil
 	}
 
+	tx.SePayID = sePayID
+	return s.applyPayment(ctx, tx, sePayID, payload.TransferAmount)
+}
+
+// HandleProviderWebhook applies a payment notification verified by its provider
+func (s *paymentService) HandleProviderWebhook(ctx context.Context, providerName entity.TransactionProvider, body []byte, signature string) (*entity.Transaction, error) {
+	provider, ok := s.providers.Get(providerName)
+	if !ok {
+		return nil, ErrUnsupportedPaymentType
+	}
+
+	payment, err := provider.ParseWebhook(body, signature)
+	if err != nil {
+		return nil, err
+	}
+	if payment == nil || !payment.IsPaid() {
+		return nil, nil
+	}
+
+	return s.settleProviderPayment(ctx, providerName, payment)
+}
+
+// settleProviderPayment applies a payment collected on a checkout opened for one of our orders
+func (s *paymentService) settleProviderPayment(ctx context.Context, providerName entity.TransactionProvider, payment *adapter.ProviderPayment) (*entity.Transaction, error) {
+	tx, err := s.txRepo.FindByProviderRef(ctx, providerName, payment.ProviderRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+	if tx == nil {
+		return nil, ErrTransactionNotFound
+	}
+
+	// Redelivered notification for a payment that was already applied
+	if tx.PaidAt != nil {
+		return tx, nil
+	}
+
+	if tx.Status != entity.TransactionStatusPending {
+		if err := s.flagClosedOrderTransfer(ctx, tx, payment.ProviderRef, payment.Amount); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	}
+
+	if !strings.EqualFold(payment.Currency, tx.Currency) {
+		return nil, fmt.Errorf("%s paid in %s for an order in %s", providerName, payment.Currency, tx.Currency)
+	}
+	return s.applyPayment(ctx, tx, payment.ProviderRef, payment.Amount)
+}
+
+// applyPayment records the money received for a pending order and completes it, or holds it for
+// reconciliation when the amount does not match. paymentRef identifies the payment at the provider.
+func (s *paymentService) applyPayment(ctx context.Context, tx *entity.Transaction, paymentRef string, paid decimal.Decimal) (*entity.Transaction, error) {
+	paidAt := time.Now()
+	tx.PaidAmount = &paid
+	tx.PaidAt = &paidAt
+
+	// Underpaid and overpaid orders are held for an admin instead of granting benefits
+	if !paid.Equal(tx.Amount) {
+		reason := entity.ReconciliationReasonUnderpaid
+		if paid.GreaterThan(tx.Amount) {
+			reason = entity.ReconciliationReasonOverpaid
+		}
+		return s.holdPayment(ctx, tx, paymentRef, paid, reason)
+	}
+
 	// Use transaction to ensure atomicity
-	var resultTx *entity.Transaction
-	err = s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
-		txRepo := s.txRepo.WithTx(dbTx)
-		subRepo := s.subRepo.WithTx(dbTx)
-		purchaseRepo := s.purchaseRepo.WithTx(dbTx)
-		planRepo := s.planRepo.WithTx(dbTx)
+	err := s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		return s.completeTransaction(ctx, dbTx, tx)
+	})
+	if errors.Is(err, errOrderClaimed) {
+		return s.paymentRaced(ctx, tx.ID, paymentRef, paid)
+	}
+	// The subscription changed after checkout; the quote no longer applies, so an admin
+	// decides between granting the order at today's terms and refunding it
+	if errors.Is(err, ErrSubscriptionChangePending) || errors.Is(err, ErrSubscriptionQuoteOutdated) {
+		tx.Status = entity.TransactionStatusPending
+		return s.holdPayment(ctx, tx, paymentRef, paid, entity.ReconciliationReasonPlanConflict)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-		// Update transaction with SePayID for idempotency
-		tx.SePayID = sePayID
-		tx.Status = entity.TransactionStatusSuccess
-		if err := txRepo.Update(ctx, tx); err != nil {
+	return tx, nil
+}
+
+// holdPayment puts a pending order in review and queues its payment for reconciliation
+func (s *paymentService) holdPayment(ctx context.Context, tx *entity.Transaction, paymentRef string, paid decimal.Decimal, reason entity.ReconciliationReason) (*entity.Transaction, error) {
+	err := s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		tx.Status = entity.TransactionStatusReview
+		claimed, err := s.txRepo.WithTx(dbTx).Claim(ctx, tx, entity.TransactionStatusPending)
+		if err != nil {
 			return fmt.Errorf("failed to update transaction: %w", err)
 		}
+		if !claimed {
+			return errOrderClaimed
+		}
+		return s.reconRepo.WithTx(dbTx).Create(ctx, newReconciliation(tx, paymentRef, reason, paid))
+	})
+	if errors.Is(err, errOrderClaimed) {
+		return s.paymentRaced(ctx, tx.ID, paymentRef, paid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to flag transfer: %w", err)
+	}
 
-		// Grant benefits based on transaction type
-		if err := s.grantBenefits(ctx, tx, subRepo, purchaseRepo, planRepo); err != nil {
-			return err
+	logger.Warn("Payment flagged for reconciliation", map[string]interface{}{
+		"orderId":  tx.OrderID,
+		"reason":   reason,
+		"expected": tx.Amount,
+		"received": paid,
+	})
+	return tx, nil
+}
+
+// paymentRaced handles a payment whose order was settled by another delivery while it was being
+// applied. The same payment delivered twice is already done; any other payment is flagged.
+func (s *paymentService) paymentRaced(ctx context.Context, id uuid.UUID, ref string, paid decimal.Decimal) (*entity.Transaction, error) {
+	current, err := s.txRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transaction: %w", err)
+	}
+	if current == nil {
+		return nil, ErrTransactionNotFound
+	}
+	if paymentRef(current) != ref {
+		if err := s.flagClosedOrderTransfer(ctx, current, ref, paid); err != nil {
+			return nil, err
 		}
+	}
+	return current, nil
+}
 
-		resultTx = tx
+// paymentRef returns the ID at its provider of the payment applied to tx
+func paymentRef(tx *entity.Transaction) string {
+	if tx.Provider == entity.TransactionProviderSEPAY || tx.ProviderRef == nil {
+		return tx.SePayID
+	}
+	return *tx.ProviderRef
+}
+
+// flagClosedOrderTransfer queues a transfer received for an order that was already paid,
+// held or failed. Redelivered webhooks for the same transfer are ignored.
+func (s *paymentService) flagClosedOrderTransfer(ctx context.Context, tx *entity.Transaction, sePayID string, received decimal.Decimal) error {
+	existing, err := s.reconRepo.FindBySePayID(ctx, sePayID)
+	if err != nil {
+		return fmt.Errorf("failed to load reconciliation: %w", err)
+	}
+	if existing != nil {
 		return nil
+	}
+
+	if err := s.reconRepo.Create(ctx, newReconciliation(tx, sePayID, entity.ReconciliationReasonOrderClosed, received)); err != nil {
+		return fmt.Errorf("failed to flag transfer: %w", err)
+	}
+
+	logger.Warn("Transfer for closed order flagged for reconciliation", map[string]interface{}{
+		"orderId":  tx.OrderID,
+		"status":   tx.Status,
+		"received": received,
 	})
+	return nil
+}
+
+// newReconciliation builds the open reconciliation for a transfer that did not match tx
+func newReconciliation(tx *entity.Transaction, sePayID string, reason entity.ReconciliationReason, received decimal.Decimal) *entity.PaymentReconciliation {
+	return &entity.PaymentReconciliation{
+		TransactionID:  tx.ID,
+		UserID:         tx.UserID,
+		SePayID:        sePayID,
+		Reason:         reason,
+		ExpectedAmount: tx.Amount,
+		ReceivedAmount: received,
+		Currency:       tx.Currency,
+		Status:         entity.ReconciliationStatusOpen,
+	}
+}
+
+// ListReconciliations returns the transfers flagged because they did not match their order
+func (s *paymentService) ListReconciliations(ctx context.Context, filter repository.PaymentReconciliationFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.PaymentReconciliation], error) {
+	return s.reconRepo.FindAll(ctx, filter, pagination)
+}
+
+// ResolveReconciliation closes a flagged transfer, granting or refusing its order
+func (s *paymentService) ResolveReconciliation(ctx context.Context, id, adminID uuid.UUID, grant bool, note *string) (*entity.PaymentReconciliation, error) {
+	rec, err := s.reconRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reconciliation: %w", err)
+	}
+	if rec == nil {
+		return nil, ErrReconciliationNotFound
+	}
+	if !rec.IsOpen() {
+		return nil, ErrReconciliationResolved
+	}
 
+	tx, err := s.txRepo.FindByID(ctx, rec.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transaction: %w", err)
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("failed to load transaction: %w", gorm.ErrRecordNotFound)
+	}
+
+	status := entity.ReconciliationStatusDismissed
+	if grant {
+		if tx.Status == entity.TransactionStatusSuccess {
+			return nil, ErrReconciliationNotGrantable
+		}
+		status = entity.ReconciliationStatusGranted
+	}
+
+	now := time.Now()
+	err = s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		resolved, err := s.reconRepo.WithTx(dbTx).Resolve(ctx, rec.ID, status, adminID, note, now)
+		if err != nil {
+			return fmt.Errorf("failed to resolve reconciliation: %w", err)
+		}
+		if !resolved {
+			return ErrReconciliationResolved
+		}
+
+		if grant {
+			// The subscription may have moved on since checkout, so a granted order is priced
+			// against the subscription as it is now
+			storeQuote(tx, nil)
+			// A late transfer for a failed order becomes that order's payment
+			if tx.SePayID == "" && tx.PaidAt == nil {
+				if tx.Provider == entity.TransactionProviderSEPAY {
+					tx.SePayID = rec.SePayID
+				}
+				tx.PaidAmount = &rec.ReceivedAmount
+				tx.PaidAt = &now
+			}
+			return s.completeTransaction(ctx, dbTx, tx)
+		}
+
+		// Only the transfer that put the order on hold releases it
+		if tx.Status == entity.TransactionStatusReview && paymentRef(tx) == rec.SePayID {
+			tx.Status = entity.TransactionStatusFailed
+			claimed, err := s.txRepo.WithTx(dbTx).Claim(ctx, tx, entity.TransactionStatusReview)
+			if err != nil {
+				return fmt.Errorf("failed to update transaction: %w", err)
+			}
+			if !claimed {
+				return errOrderClaimed
+			}
+			if tx.PromoCodeID != nil {
+				if err := s.promoRepo.WithTx(dbTx).ReleaseRedemption(ctx, *tx.PromoCodeID); err != nil {
+					return fmt.Errorf("failed to release promo code redemption: %w", err)
+				}
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errOrderClaimed) {
+		if grant {
+			return nil, ErrReconciliationNotGrantable
+		}
+		return nil, ErrReconciliationResolved
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return resultTx, nil
+	rec.Status = status
+	rec.Note = note
+	rec.ResolvedBy = &adminID
+	rec.ResolvedAt = &now
+	return rec, nil
+}
+
+// completeTransaction marks a transaction as paid and grants its benefits within dbTx
+func (s *paymentService) completeTransaction(ctx context.Context, dbTx *gorm.DB, tx *entity.Transaction) error {
+	txRepo := s.txRepo.WithTx(dbTx)
+	subRepo := s.subRepo.WithTx(dbTx)
+	purchaseRepo := s.purchaseRepo.WithTx(dbTx)
+	planRepo := s.planRepo.WithTx(dbTx)
+	outboxRepo := s.outboxRepo.WithTx(dbTx)
+	subEventRepo := s.subEventRepo.WithTx(dbTx)
+
+	// Orders that expired or failed gave their promo code redemption back, which a late payment takes again
+	from := tx.Status
+	released := from == entity.TransactionStatusExpired || from == entity.TransactionStatusFailed
+
+	// The order is claimed before anything is granted so a concurrent delivery of the same
+	// payment cannot complete it a second time
+	tx.Status = entity.TransactionStatusSuccess
+	claimed, err := txRepo.Claim(ctx, tx, from)
+	if err != nil {
+		return fmt.Errorf("failed to update transaction: %w", err)
+	}
+	if !claimed {
+		tx.Status = from
+		return errOrderClaimed
+	}
+
+	if tx.PromoCodeID != nil && released {
+		if err := s.promoRepo.WithTx(dbTx).IncrementRedemptions(ctx, *tx.PromoCodeID); err != nil {
+			return fmt.Errorf("failed to count promo code redemption: %w", err)
+		}
+	}
+
+	// Grant benefits based on transaction type
+	if tx.Gift {
+		if err := s.issueGift(ctx, tx, s.giftRepo.WithTx(dbTx), planRepo); err != nil {
+			return err
+		}
+	} else if err := s.grantBenefits(ctx, tx, subRepo, purchaseRepo, planRepo, outboxRepo, subEventRepo); err != nil {
+		return err
+	}
+
+	// The payment is split between the author and the platform fee in the same transaction
+	if err := s.ledger.RecordPayment(ctx, dbTx, tx); err != nil {
+		return err
+	}
+
+	// Side effects (receipt, author notification) are recorded in the outbox
+	// so they commit or roll back together with the payment
+	return s.enqueueEvent(ctx, outboxRepo, PaymentSucceededEvent{
+		TransactionID: tx.ID,
+		UserID:        tx.UserID,
+		Type:          tx.Type,
+		TargetID:      tx.TargetID,
+		Amount:        tx.Amount,
+		Currency:      tx.Currency,
+		OrderID:       tx.OrderID,
+		PaidAt:        time.Now(),
+	}, OutboxAggregateTransaction, tx.ID)
 }
 
 // grantBenefits handles granting benefits for different transaction types
@@ -217,10 +840,12 @@ func (s *paymentService) grantBenefits(
 	subRepo repository.SubscriptionRepository,
 	purchaseRepo repository.UserSeriesPurchaseRepository,
 	planRepo repository.SubscriptionPlanRepository,
+	outboxRepo repository.OutboxRepository,
+	subEventRepo repository.SubscriptionEventRepository,
 ) error {
 	switch tx.Type {
 	case entity.TransactionTypeSubscription:
-		return s.processSubscriptionPayment(ctx, tx, subRepo, planRepo)
+		return s.processSubscriptionPayment(ctx, tx, subRepo, planRepo, outboxRepo, subEventRepo)
 	case entity.TransactionTypeSeries:
 		return s.processSeriesPurchase(ctx, tx, purchaseRepo)
 	case entity.TransactionTypeDonation:
@@ -236,6 +861,8 @@ func (s *paymentService) processSubscriptionPayment(
 	tx *entity.Transaction,
 	subRepo repository.SubscriptionRepository,
 	planRepo repository.SubscriptionPlanRepository,
+	outboxRepo repository.OutboxRepository,
+	subEventRepo repository.SubscriptionEventRepository,
 ) error {
 	if tx.TargetID == nil || tx.PlanID == nil {
 		return nil // Nothing to process without target or plan
@@ -246,20 +873,275 @@ func (s *paymentService) processSubscriptionPayment(
 		return fmt.Errorf("invalid plan ID: %w", err)
 	}
 
-	plan, err := planRepo.FindByID(ctx, planUUID)
+	return s.grantSubscription(ctx, tx.ID, tx.UserID, *tx.TargetID, planUUID, storedQuote(tx),
+		subRepo, planRepo, outboxRepo, subEventRepo)
+}
+
+// storeQuote keeps on tx the subscription change it pays for; a nil quote clears it
+func storeQuote(tx *entity.Transaction, quote *SubscriptionQuote) {
+	if quote == nil {
+		tx.SubscriptionChange, tx.PeriodStartsAt, tx.PeriodExpiresAt, tx.QuotedExpiresAt = nil, nil, nil, nil
+		return
+	}
+	change := string(quote.Change)
+	startsAt, expiresAt := quote.StartsAt, quote.ExpiresAt
+	tx.SubscriptionChange = &change
+	tx.PeriodStartsAt = &startsAt
+	tx.PeriodExpiresAt = &expiresAt
+	tx.QuotedExpiresAt = quote.Basis
+}
+
+// storedQuote returns the subscription change tx was priced with at checkout, nil if none was kept
+func storedQuote(tx *entity.Transaction) *SubscriptionQuote {
+	if tx.SubscriptionChange == nil || tx.PeriodStartsAt == nil || tx.PeriodExpiresAt == nil {
+		return nil
+	}
+	return &SubscriptionQuote{
+		Change:    SubscriptionChange(*tx.SubscriptionChange),
+		StartsAt:  *tx.PeriodStartsAt,
+		ExpiresAt: *tx.PeriodExpiresAt,
+		Basis:     tx.QuotedExpiresAt,
+	}
+}
+
+// sameExpiry reports whether two optional expiries are the same instant
+func sameExpiry(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// grantSubscription starts the period of the plan paid by transaction txID for the subscriber.
+// A quote priced at checkout is applied as is, provided the subscription has not changed since;
+// without one the change is priced now.
+func (s *paymentService) grantSubscription(
+	ctx context.Context,
+	txID, subscriberID, authorID, planID uuid.UUID,
+	quote *SubscriptionQuote,
+	subRepo repository.SubscriptionRepository,
+	planRepo repository.SubscriptionPlanRepository,
+	outboxRepo repository.OutboxRepository,
+	subEventRepo repository.SubscriptionEventRepository,
+) error {
+	plan, err := planRepo.FindByID(ctx, planID)
 	if err != nil {
 		return fmt.Errorf("plan not found: %w", err)
 	}
+	if plan == nil {
+		return fmt.Errorf("failed to grant subscription: %w", ErrPlanNotFound)
+	}
+
+	sub, err := subRepo.FindBySubscriberAndAuthor(ctx, subscriberID, authorID)
+	if err != nil {
+		return fmt.Errorf("failed to load subscription: %w", err)
+	}
+	if sub == nil {
+		return fmt.Errorf("failed to update subscription: %w", gorm.ErrRecordNotFound)
+	}
+
+	// Gifts and admin grants are priced when they are applied so the new period lines up with
+	// the current one: renewals stack, upgrades start now and downgrades wait for the expiry
+	now := time.Now()
+	switch {
+	case quote == nil:
+		quote, err = quoteSubscription(ctx, planRepo, sub, plan, now)
+		if err != nil {
+			return err
+		}
+	case sub.HasScheduledChange():
+		return ErrSubscriptionChangePending
+	case !sameExpiry(sub.ExpiresAt, quote.Basis):
+		return ErrSubscriptionQuoteOutdated
+	}
+
+	previousTier := sub.Tier
+	event := &entity.SubscriptionEvent{
+		SubscriptionID: sub.ID,
+		SubscriberID:   subscriberID,
+		AuthorID:       authorID,
+		Tier:           plan.Tier.String(),
+		ExpiresAt:      &quote.ExpiresAt,
+		TransactionID:  &txID,
+		// Kept so a refund can roll the subscription back to where it was
+		PreviousTier:      &previousTier,
+		PreviousExpiresAt: sub.ExpiresAt,
+	}
+
+	if quote.Change == SubscriptionChangeDowngrade {
+		scheduled, err := subRepo.ScheduleTierChange(ctx, sub.ID, now, plan.Tier.String(), quote.ExpiresAt)
+		if err != nil {
+			return fmt.Errorf("failed to schedule tier change: %w", err)
+		}
+		if !scheduled {
+			return fmt.Errorf("failed to schedule tier change: %w", ErrSubscriptionChangePending)
+		}
+		event.Type = entity.SubscriptionEventDowngradeScheduled
+		if err := subEventRepo.Create(ctx, event); err != nil {
+			return fmt.Errorf("failed to record subscription event: %w", err)
+		}
+		return nil
+	}
 
-	// Calculate expiry based on plan duration
-	expiry := time.Now().AddDate(0, 0, plan.DurationDays)
-	if err := subRepo.UpdateExpiry(ctx, tx.UserID, *tx.TargetID, expiry, plan.Tier.String()); err != nil {
+	if err := subRepo.UpdateExpiry(ctx, subscriberID, authorID, quote.ExpiresAt, plan.Tier.String()); err != nil {
 		return fmt.Errorf("failed to update subscription: %w", err)
 	}
 
+	switch quote.Change {
+	case SubscriptionChangeRenewal:
+		event.Type = entity.SubscriptionEventRenewed
+	case SubscriptionChangeUpgrade:
+		event.Type = entity.SubscriptionEventUpgraded
+	default:
+		event.Type = entity.SubscriptionEventActivated
+	}
+	if err := subEventRepo.Create(ctx, event); err != nil {
+		return fmt.Errorf("failed to record subscription event: %w", err)
+	}
+
+	return s.enqueueEvent(ctx, outboxRepo, SubscriptionExpiryUpdatedEvent{
+		SubscriberID:  subscriberID,
+		AuthorID:      authorID,
+		Tier:          plan.Tier.String(),
+		ExpiresAt:     quote.ExpiresAt,
+		TransactionID: &txID,
+	}, OutboxAggregateSubscription, authorID)
+}
+
+// issueGift creates the code of a gift paid by tx. The period only starts once it is redeemed.
+func (s *paymentService) issueGift(
+	ctx context.Context,
+	tx *entity.Transaction,
+	giftRepo repository.GiftSubscriptionRepository,
+	planRepo repository.SubscriptionPlanRepository,
+) error {
+	if tx.TargetID == nil || tx.PlanID == nil {
+		return nil // Nothing to gift without target or plan
+	}
+
+	planUUID, err := uuid.Parse(*tx.PlanID)
+	if err != nil {
+		return fmt.Errorf("invalid plan ID: %w", err)
+	}
+	plan, err := planRepo.FindByID(ctx, planUUID)
+	if err != nil {
+		return fmt.Errorf("plan not found: %w", err)
+	}
+	if plan == nil {
+		return fmt.Errorf("failed to issue gift: %w", ErrPlanNotFound)
+	}
+
+	code, err := randomGiftCode()
+	if err != nil {
+		return err
+	}
+	gift := &entity.GiftSubscription{
+		Code:          code,
+		TransactionID: tx.ID,
+		PurchaserID:   tx.UserID,
+		AuthorID:      *tx.TargetID,
+		PlanID:        plan.ID,
+		Tier:          plan.Tier.String(),
+		Status:        entity.GiftStatusIssued,
+	}
+	if err := giftRepo.Create(ctx, gift); err != nil {
+		return fmt.Errorf("failed to issue gift: %w", err)
+	}
 	return nil
 }
 
+// RedeemGift grants the gifted plan to the recipient through the same path as a paid order,
+// following the author first if needed. The period is tied to the gift's transaction so a
+// refund of it revokes the recipient's period.
+func (s *paymentService) RedeemGift(ctx context.Context, recipientID uuid.UUID, code string) (*entity.GiftSubscription, error) {
+	gift, err := s.giftRepo.FindByCode(ctx, normalizeGiftCode(code))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gift: %w", err)
+	}
+	if gift == nil {
+		return nil, ErrGiftNotFound
+	}
+	if !gift.IsRedeemable() {
+		return nil, ErrGiftNotRedeemable
+	}
+	if gift.PurchaserID == recipientID {
+		return nil, ErrOwnGift
+	}
+	if gift.AuthorID == recipientID {
+		return nil, ErrCannotSubscribeToSelf
+	}
+
+	now := time.Now()
+	err = s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		redeemed, err := s.giftRepo.WithTx(dbTx).Redeem(ctx, gift.ID, recipientID, now)
+		if err != nil {
+			return fmt.Errorf("failed to redeem gift: %w", err)
+		}
+		if !redeemed {
+			return ErrGiftNotRedeemable
+		}
+
+		subRepo := s.subRepo.WithTx(dbTx)
+		sub, err := subRepo.FindBySubscriberAndAuthor(ctx, recipientID, gift.AuthorID)
+		if err != nil {
+			return fmt.Errorf("failed to load subscription: %w", err)
+		}
+		if sub == nil {
+			if err := subRepo.Create(ctx, &entity.Subscription{SubscriberID: recipientID, AuthorID: gift.AuthorID}); err != nil {
+				return fmt.Errorf("failed to follow author: %w", err)
+			}
+		} else if sub.HasScheduledChange() {
+			return ErrSubscriptionChangePending
+		}
+
+		return s.grantSubscription(ctx, gift.TransactionID, recipientID, gift.AuthorID, gift.PlanID, nil,
+			subRepo, s.planRepo.WithTx(dbTx), s.outboxRepo.WithTx(dbTx), s.subEventRepo.WithTx(dbTx))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gift.Status = entity.GiftStatusRedeemed
+	gift.RedeemedBy = &recipientID
+	gift.RedeemedAt = &now
+
+	logger.Info("Gift subscription redeemed", map[string]interface{}{
+		"giftId":      gift.ID,
+		"recipientId": recipientID,
+		"authorId":    gift.AuthorID,
+		"tier":        gift.Tier,
+	})
+	return gift, nil
+}
+
+// ListGifts returns the gift codes a user paid for
+func (s *paymentService) ListGifts(ctx context.Context, purchaserID uuid.UUID, pagination repository.Pagination) (*repository.PaginatedResult[entity.GiftSubscription], error) {
+	return s.giftRepo.FindByPurchaser(ctx, purchaserID, pagination)
+}
+
+// randomGiftCode returns a code grouped in blocks of four, e.g. ABCD-EFGH-JKLM
+func randomGiftCode() (string, error) {
+	b := make([]byte, giftCodeLength)
+	alphabetSize := big.NewInt(int64(len(giftCodeAlphabet)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate gift code: %w", err)
+		}
+		b[i] = giftCodeAlphabet[n.Int64()]
+	}
+	return string(b[:4]) + "-" + string(b[4:8]) + "-" + string(b[8:]), nil
+}
+
+// normalizeGiftCode uppercases a typed code and restores its dashes
+func normalizeGiftCode(code string) string {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(code) != giftCodeLength {
+		return code
+	}
+	return code[:4] + "-" + code[4:8] + "-" + code[8:]
+}
+
 // processSeriesPurchase handles series purchase benefit granting
 func (s *paymentService) processSeriesPurchase(
 	ctx context.Context,
@@ -282,20 +1164,57 @@ func (s *paymentService) processSeriesPurchase(
 	return nil
 }
 
+// enqueueEvent writes a domain event to the outbox within the current transaction
+func (s *paymentService) enqueueEvent(
+	ctx context.Context,
+	outboxRepo repository.OutboxRepository,
+	event DomainEvent,
+	aggregateType string,
+	aggregateID uuid.UUID,
+) error {
+	outboxEvent, err := NewOutboxEvent(event, aggregateType, aggregateID)
+	if err != nil {
+		return err
+	}
+	if err := outboxRepo.Create(ctx, outboxEvent); err != nil {
+		return fmt.Errorf("failed to enqueue %s event: %w", event.Name(), err)
+	}
+	return nil
+}
+
 // logDonation logs donation transactions
 func (s *paymentService) logDonation(tx *entity.Transaction) {
 	logger.Info("Donation received", map[string]interface{}{
-		"amount": tx.Amount,
-		"userId": tx.UserID,
+		"amount":   tx.Amount,
+		"userId":   tx.UserID,
+		"authorId": tx.TargetID,
 	})
 }
 
-// GetTransactionStatus retrieves the status of a transaction
+// GetTransactionStatus retrieves the status of a transaction. A pending card order is looked up at
+// its provider so a payer returning from checkout sees it paid even if the webhook is late.
 func (s *paymentService) GetTransactionStatus(ctx context.Context, orderID string) (*entity.Transaction, error) {
-	return s.txRepo.FindByRefID(ctx, orderID)
-}
+	tx, err := s.txRepo.FindByRefID(ctx, orderID)
+	if err != nil || tx == nil || tx.Status != entity.TransactionStatusPending || tx.ProviderRef == nil {
+		return tx, err
+	}
 
-// VerifySePayWebhookSignature verifies the webhook signature
-func (s *paymentService) VerifySePayWebhookSignature(payload map[string]interface{}, signature string) bool {
-	return s.sepayAdapter.VerifyWebhookSignature(payload, signature)
+	provider, ok := s.providers.Get(tx.Provider)
+	if !ok {
+		return tx, nil
+	}
+	payment, err := provider.GetPayment(ctx, *tx.ProviderRef)
+	if err != nil {
+		// The webhook still settles the order; the payer sees it pending meanwhile
+		logger.Warn("Failed to look up payment at provider", map[string]interface{}{
+			"orderId":  tx.OrderID,
+			"provider": tx.Provider,
+			"error":    err.Error(),
+		})
+		return tx, nil
+	}
+	if !payment.IsPaid() {
+		return tx, nil
+	}
+	return s.settleProviderPayment(ctx, tx.Provider, payment)
 }
@@ -2,12 +2,16 @@ package service_test
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository/mocks"
 	"github.com/aiagent/internal/domain/service"
+	servicemocks "github.com/aiagent/internal/domain/service/mocks"
 	"github.com/aiagent/internal/infrastructure/adapter"
 	adapter_mocks "github.com/aiagent/internal/infrastructure/adapter/mocks"
 	"github.com/google/uuid"
@@ -25,10 +29,16 @@ func TestPaymentService_InitPayment(t *testing.T) {
 	mockTxRepo := mocks.NewMockTransactionRepository(ctrl)
 	mockSubRepo := mocks.NewMockSubscriptionRepository(ctrl)
 	mockPurchaseRepo := mocks.NewMockUserSeriesPurchaseRepository(ctrl)
+	mockSeriesRepo := mocks.NewMockSeriesRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockPlanRepo := mocks.NewMockSubscriptionPlanRepository(ctrl)
+	mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	mockSubEventRepo := mocks.NewMockSubscriptionEventRepository(ctrl)
+	mockReconRepo := mocks.NewMockPaymentReconciliationRepository(ctrl)
+	mockLedger := servicemocks.NewMockLedgerService(ctrl)
 	mockSePayAdapter := adapter_mocks.NewMockSePayAdapter(ctrl)
 
-	db, _, _ := sqlmock.New()
+	db, sqlMock, _ := sqlmock.New()
 	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
 
 	svc := service.NewPaymentService(
@@ -36,22 +46,42 @@ func TestPaymentService_InitPayment(t *testing.T) {
 		mockTxRepo,
 		mockSubRepo,
 		mockPurchaseRepo,
+		mockSeriesRepo,
+		mockUserRepo,
 		mockPlanRepo,
-		mockSePayAdapter,
+		mockOutboxRepo,
+		mockSubEventRepo,
+		mockReconRepo,
+		nil,
+		nil,
+		mockLedger,
+		sepayProviders(mockSePayAdapter),
 	)
 
 	ctx := context.Background()
 	userID := uuid.New()
 	amount := decimal.NewFromInt(100000)
 
-	t.Run("success_vietqr", func(t *testing.T) {
-		req := service.CreatePaymentRequest{
-			UserID:  userID.String(),
-			Amount:  amount,
-			Type:    entity.TransactionTypeSubscription,
-			Gateway: entity.TransactionGatewayVietQR,
+	authorID := uuid.New()
+	authorTarget := authorID.String()
+	silverPlan := &entity.SubscriptionPlan{ID: uuid.New(), AuthorID: authorID, Tier: entity.TierSilver, Price: amount, DurationDays: 30, IsActive: true}
+	silverPlanID := silverPlan.ID.String()
+	subscriptionReq := func(gateway entity.TransactionGateway) service.CreatePaymentRequest {
+		return service.CreatePaymentRequest{
+			UserID:   userID.String(),
+			Amount:   decimal.NewFromInt(1),
+			Type:     entity.TransactionTypeSubscription,
+			Gateway:  gateway,
+			TargetID: &authorTarget,
+			PlanID:   &silverPlanID,
 		}
+	}
+
+	t.Run("success_vietqr", func(t *testing.T) {
+		req := subscriptionReq(entity.TransactionGatewayVietQR)
 
+		mockPlanRepo.EXPECT().FindByID(ctx, silverPlan.ID).Return(silverPlan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).Return(nil, nil)
 		mockTxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 		mockSePayAdapter.EXPECT().CreateVietQR(gomock.Any(), gomock.Any()).Return(&adapter.VietQRResponse{
 			Status: 200,
@@ -74,13 +104,10 @@ func TestPaymentService_InitPayment(t *testing.T) {
 	})
 
 	t.Run("success_bank_transfer", func(t *testing.T) {
-		req := service.CreatePaymentRequest{
-			UserID:  userID.String(),
-			Amount:  amount,
-			Type:    entity.TransactionTypeSubscription,
-			Gateway: entity.TransactionGatewayBankTransfer,
-		}
+		req := subscriptionReq(entity.TransactionGatewayBankTransfer)
 
+		mockPlanRepo.EXPECT().FindByID(ctx, silverPlan.ID).Return(silverPlan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).Return(nil, nil)
 		mockTxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 		mockSePayAdapter.EXPECT().GetBankTransferInfo().Return(adapter.BankTransferInfo{
 			BankName:    "MB Bank",
@@ -95,6 +122,247 @@ func TestPaymentService_InitPayment(t *testing.T) {
 		assert.Equal(t, "MB Bank", resp.BankName)
 		assert.Equal(t, "123456789", resp.AccountNo)
 	})
+
+	t.Run("subscription_upgrade_charges_difference", func(t *testing.T) {
+		// 15 of 30 days of a 60000 Bronze plan left, so half of it is credited
+		expiresAt := time.Now().Add(15*24*time.Hour + time.Second)
+		bronzePlan := &entity.SubscriptionPlan{ID: uuid.New(), AuthorID: authorID, Tier: entity.TierBronze, Price: decimal.NewFromInt(60000), DurationDays: 30}
+
+		mockPlanRepo.EXPECT().FindByID(ctx, silverPlan.ID).Return(silverPlan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).
+			Return(&entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: authorID, Tier: "BRONZE", ExpiresAt: &expiresAt}, nil)
+		mockPlanRepo.EXPECT().FindByAuthorAndTier(ctx, authorID, entity.TierBronze).Return(bronzePlan, nil)
+		mockTxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tx *entity.Transaction) error {
+			assert.Equal(t, "70000", tx.Amount.String())
+			// The change is kept so the payment grants exactly what was quoted
+			assert.Equal(t, string(service.SubscriptionChangeUpgrade), *tx.SubscriptionChange)
+			assert.Equal(t, &expiresAt, tx.QuotedExpiresAt)
+			assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), *tx.PeriodExpiresAt, time.Minute)
+			return nil
+		})
+		mockSePayAdapter.EXPECT().GetBankTransferInfo().Return(adapter.BankTransferInfo{})
+
+		resp, err := svc.InitPayment(ctx, subscriptionReq(entity.TransactionGatewayBankTransfer))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "70000", resp.Amount.String())
+		assert.Equal(t, entity.TransactionStatusPending, resp.Status)
+	})
+
+	t.Run("subscription_upgrade_fully_credited_completes", func(t *testing.T) {
+		// Two stacked Bronze periods are worth more than the Silver plan
+		expiresAt := time.Now().Add(60 * 24 * time.Hour)
+		bronzePlan := &entity.SubscriptionPlan{ID: uuid.New(), AuthorID: authorID, Tier: entity.TierBronze, Price: decimal.NewFromInt(60000), DurationDays: 30}
+		sub := &entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: authorID, Tier: "BRONZE", ExpiresAt: &expiresAt}
+
+		mockPlanRepo.EXPECT().FindByID(ctx, silverPlan.ID).Return(silverPlan, nil).Times(2)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).Return(sub, nil).Times(2)
+		mockPlanRepo.EXPECT().FindByAuthorAndTier(ctx, authorID, entity.TierBronze).Return(bronzePlan, nil)
+		mockTxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockSubRepo.EXPECT().UpdateExpiry(ctx, userID, authorID, gomock.Any(), entity.TierSilver.String()).Return(nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, entity.SubscriptionEventUpgraded, e.Type)
+			return nil
+		})
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(2)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		resp, err := svc.InitPayment(ctx, subscriptionReq(entity.TransactionGatewayBankTransfer))
+
+		assert.NoError(t, err)
+		assert.True(t, resp.Amount.IsZero())
+		assert.Equal(t, entity.TransactionStatusSuccess, resp.Status)
+		assert.Empty(t, resp.AccountNo)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("subscription_change_pending", func(t *testing.T) {
+		expiresAt := time.Now().Add(10 * 24 * time.Hour)
+		scheduledTier := "BRONZE"
+
+		mockPlanRepo.EXPECT().FindByID(ctx, silverPlan.ID).Return(silverPlan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).
+			Return(&entity.Subscription{ID: uuid.New(), Tier: "GOLD", ExpiresAt: &expiresAt, ScheduledTier: &scheduledTier}, nil)
+
+		_, err := svc.InitPayment(ctx, subscriptionReq(entity.TransactionGatewayBankTransfer))
+
+		assert.ErrorIs(t, err, service.ErrSubscriptionChangePending)
+	})
+
+	t.Run("subscription_plan_of_other_author", func(t *testing.T) {
+		otherPlan := *silverPlan
+		otherPlan.AuthorID = uuid.New()
+		mockPlanRepo.EXPECT().FindByID(ctx, silverPlan.ID).Return(&otherPlan, nil)
+
+		_, err := svc.InitPayment(ctx, subscriptionReq(entity.TransactionGatewayBankTransfer))
+
+		assert.ErrorIs(t, err, service.ErrPlanNotFound)
+	})
+
+	seriesID := uuid.New()
+	seriesTarget := seriesID.String()
+	paidSeries := &entity.Series{ID: seriesID, AuthorID: uuid.New(), Price: decimal.NewFromInt(49000), Currency: "VND"}
+	seriesReq := service.CreatePaymentRequest{
+		UserID:   userID.String(),
+		Amount:   decimal.NewFromInt(1),
+		Type:     entity.TransactionTypeSeries,
+		Gateway:  entity.TransactionGatewayBankTransfer,
+		TargetID: &seriesTarget,
+	}
+
+	t.Run("series_uses_server_price", func(t *testing.T) {
+		mockSeriesRepo.EXPECT().GetByID(ctx, seriesID).Return(paidSeries, nil)
+		mockPurchaseRepo.EXPECT().HasPurchased(ctx, userID, seriesID).Return(false, nil)
+		mockTxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tx *entity.Transaction) error {
+			assert.True(t, paidSeries.Price.Equal(tx.Amount))
+			assert.Equal(t, "VND", tx.Currency)
+			return nil
+		})
+		mockSePayAdapter.EXPECT().GetBankTransferInfo().Return(adapter.BankTransferInfo{})
+
+		resp, err := svc.InitPayment(ctx, seriesReq)
+
+		assert.NoError(t, err)
+		assert.True(t, paidSeries.Price.Equal(resp.Amount))
+	})
+
+	t.Run("series_already_purchased", func(t *testing.T) {
+		mockSeriesRepo.EXPECT().GetByID(ctx, seriesID).Return(paidSeries, nil)
+		mockPurchaseRepo.EXPECT().HasPurchased(ctx, userID, seriesID).Return(true, nil)
+
+		_, err := svc.InitPayment(ctx, seriesReq)
+
+		assert.ErrorIs(t, err, service.ErrSeriesAlreadyPurchased)
+	})
+
+	t.Run("free_series_is_not_for_sale", func(t *testing.T) {
+		mockSeriesRepo.EXPECT().GetByID(ctx, seriesID).Return(&entity.Series{ID: seriesID, AuthorID: uuid.New()}, nil)
+
+		_, err := svc.InitPayment(ctx, seriesReq)
+
+		assert.ErrorIs(t, err, service.ErrSeriesNotForSale)
+	})
+
+	t.Run("series_currency_unsupported", func(t *testing.T) {
+		usdSeries := *paidSeries
+		usdSeries.Currency = "USD"
+		mockSeriesRepo.EXPECT().GetByID(ctx, seriesID).Return(&usdSeries, nil)
+		mockPurchaseRepo.EXPECT().HasPurchased(ctx, userID, seriesID).Return(false, nil)
+
+		_, err := svc.InitPayment(ctx, seriesReq)
+
+		assert.ErrorIs(t, err, service.ErrUnsupportedCurrency)
+	})
+
+	t.Run("series_not_found", func(t *testing.T) {
+		mockSeriesRepo.EXPECT().GetByID(ctx, seriesID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := svc.InitPayment(ctx, seriesReq)
+
+		assert.ErrorIs(t, err, service.ErrSeriesNotFound)
+	})
+
+	t.Run("invalid_target_is_rejected", func(t *testing.T) {
+		badTarget := "not-a-uuid"
+		req := seriesReq
+		req.TargetID = &badTarget
+
+		_, err := svc.InitPayment(ctx, req)
+
+		assert.ErrorIs(t, err, service.ErrInvalidPaymentTarget)
+	})
+
+	t.Run("unsupported_gateway", func(t *testing.T) {
+		req := seriesReq
+		req.Gateway = entity.TransactionGatewayCard
+		mockSeriesRepo.EXPECT().GetByID(ctx, seriesID).Return(paidSeries, nil)
+		mockPurchaseRepo.EXPECT().HasPurchased(ctx, userID, seriesID).Return(false, nil)
+
+		_, err := svc.InitPayment(ctx, req)
+
+		assert.ErrorIs(t, err, service.ErrUnsupportedPaymentType)
+	})
+
+	donationReq := service.CreatePaymentRequest{
+		UserID:   userID.String(),
+		Amount:   decimal.NewFromInt(20000),
+		Type:     entity.TransactionTypeDonation,
+		Gateway:  entity.TransactionGatewayBankTransfer,
+		TargetID: &authorTarget,
+		PlanID:   &silverPlanID,
+	}
+
+	t.Run("donation_keeps_payer_amount", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, authorID).Return(&entity.User{ID: authorID}, nil)
+		mockTxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tx *entity.Transaction) error {
+			assert.Equal(t, "20000", tx.Amount.String())
+			assert.Equal(t, authorID, *tx.TargetID)
+			assert.Nil(t, tx.PlanID)
+			return nil
+		})
+		mockSePayAdapter.EXPECT().GetBankTransferInfo().Return(adapter.BankTransferInfo{})
+
+		resp, err := svc.InitPayment(ctx, donationReq)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "20000", resp.Amount.String())
+	})
+
+	t.Run("donation_requires_positive_amount", func(t *testing.T) {
+		req := donationReq
+		req.Amount = decimal.NewFromInt(-5)
+
+		_, err := svc.InitPayment(ctx, req)
+
+		assert.ErrorIs(t, err, service.ErrInvalidPaymentAmount)
+	})
+
+	t.Run("fractional_vnd_donation_is_rejected", func(t *testing.T) {
+		req := donationReq
+		req.Amount = decimal.RequireFromString("20000.5")
+		mockUserRepo.EXPECT().FindByID(ctx, authorID).Return(&entity.User{ID: authorID}, nil)
+
+		_, err := svc.InitPayment(ctx, req)
+
+		assert.ErrorIs(t, err, service.ErrPaymentAmountPrecision)
+	})
+
+	t.Run("donation_requires_target", func(t *testing.T) {
+		req := donationReq
+		req.TargetID = nil
+
+		_, err := svc.InitPayment(ctx, req)
+
+		assert.ErrorIs(t, err, service.ErrInvalidPaymentTarget)
+	})
+
+	t.Run("donation_to_unknown_user_is_rejected", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, authorID).Return(nil, nil)
+
+		_, err := svc.InitPayment(ctx, donationReq)
+
+		assert.ErrorIs(t, err, service.ErrInvalidPaymentTarget)
+	})
+
+	t.Run("donation_to_self_is_rejected", func(t *testing.T) {
+		self := userID.String()
+		req := donationReq
+		req.TargetID = &self
+
+		_, err := svc.InitPayment(ctx, req)
+
+		assert.ErrorIs(t, err, service.ErrCannotDonateToSelf)
+	})
 }
 
 func TestPaymentService_HandleSePayWebhook(t *testing.T) {
@@ -105,6 +373,10 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 	mockSubRepo := mocks.NewMockSubscriptionRepository(ctrl)
 	mockPurchaseRepo := mocks.NewMockUserSeriesPurchaseRepository(ctrl)
 	mockPlanRepo := mocks.NewMockSubscriptionPlanRepository(ctrl)
+	mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	mockSubEventRepo := mocks.NewMockSubscriptionEventRepository(ctrl)
+	mockReconRepo := mocks.NewMockPaymentReconciliationRepository(ctrl)
+	mockLedger := servicemocks.NewMockLedgerService(ctrl)
 	mockSePayAdapter := adapter_mocks.NewMockSePayAdapter(ctrl)
 
 	db, sqlMock, _ := sqlmock.New()
@@ -115,8 +387,16 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		mockTxRepo,
 		mockSubRepo,
 		mockPurchaseRepo,
+		nil,
+		nil,
 		mockPlanRepo,
-		mockSePayAdapter,
+		mockOutboxRepo,
+		mockSubEventRepo,
+		mockReconRepo,
+		nil,
+		nil,
+		mockLedger,
+		sepayProviders(mockSePayAdapter),
 	)
 
 	ctx := context.Background()
@@ -128,9 +408,20 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 	payload := service.SePayWebhookPayload{
 		ID:             1001,
 		Content:        "ORDER-SEPAY-123456",
+		TransferType:   "in",
 		TransferAmount: amount,
 	}
 
+	t.Run("outgoing_transfer_ignored", func(t *testing.T) {
+		outgoing := payload
+		outgoing.TransferType = "out"
+
+		tx, err := svc.HandleSePayWebhook(ctx, outgoing)
+
+		assert.NoError(t, err)
+		assert.Nil(t, tx)
+	})
+
 	t.Run("transaction_not_found", func(t *testing.T) {
 		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
 		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(nil, nil)
@@ -156,6 +447,67 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		assert.Equal(t, existingTx, tx)
 	})
 
+	pendingDonation := func() *entity.Transaction {
+		return &entity.Transaction{
+			ID:      uuid.New(),
+			UserID:  userID,
+			OrderID: orderID,
+			Amount:  amount,
+			Type:    entity.TransactionTypeDonation,
+			Status:  entity.TransactionStatusPending,
+		}
+	}
+	expectLostClaim := func() {
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), entity.TransactionStatusPending).Return(false, nil)
+		sqlMock.ExpectRollback()
+	}
+
+	t.Run("concurrent_delivery_is_applied_once", func(t *testing.T) {
+		tx := pendingDonation()
+		settled := *tx
+		settled.Status, settled.SePayID = entity.TransactionStatusSuccess, sePayID
+
+		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+		// The other delivery of this transfer claimed the order first: nothing is granted twice
+		expectLostClaim()
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(&settled, nil)
+
+		result, err := svc.HandleSePayWebhook(ctx, payload)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusSuccess, result.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("transfer_losing_the_claim_is_flagged", func(t *testing.T) {
+		tx := pendingDonation()
+		settled := *tx
+		settled.Status, settled.SePayID = entity.TransactionStatusSuccess, "999"
+
+		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+		expectLostClaim()
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(&settled, nil)
+		mockReconRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockReconRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, rec *entity.PaymentReconciliation) error {
+			assert.Equal(t, entity.ReconciliationReasonOrderClosed, rec.Reason)
+			return nil
+		})
+
+		_, err := svc.HandleSePayWebhook(ctx, payload)
+
+		assert.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
 	t.Run("success_subscription", func(t *testing.T) {
 		planID := uuid.New()
 		targetID := uuid.New()
@@ -188,12 +540,27 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
 		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
 		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
 
 		// Expect plan to be fetched by ID
 		mockPlanRepo.EXPECT().FindByID(ctx, planID).Return(plan, nil)
 
-		mockTxRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).
+			Return(&entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: targetID, Tier: string(entity.TierFree)}, nil)
 		mockSubRepo.EXPECT().UpdateExpiry(ctx, userID, targetID, gomock.Any(), entity.TierSilver.String()).Return(nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, entity.SubscriptionEventActivated, e.Type)
+			assert.Equal(t, tx.ID, *e.TransactionID)
+			return nil
+		})
+		var enqueued []string
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.OutboxEvent) error {
+			enqueued = append(enqueued, e.EventType)
+			return nil
+		}).Times(2)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
 		sqlMock.ExpectCommit()
 
 		result, err := svc.HandleSePayWebhook(ctx, payload)
@@ -201,6 +568,10 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, entity.TransactionStatusSuccess, result.Status)
 		assert.Equal(t, sePayID, result.SePayID)
+		assert.Equal(t, []string{
+			string(service.EventSubscriptionExpiryUpdated),
+			string(service.EventPaymentSucceeded),
+		}, enqueued)
 	})
 
 	t.Run("success_series_purchase", func(t *testing.T) {
@@ -224,9 +595,13 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
 		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
 		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
 
-		mockTxRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
 		mockPurchaseRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
 		sqlMock.ExpectCommit()
 
 		result, err := svc.HandleSePayWebhook(ctx, payload)
@@ -268,12 +643,19 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
 		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
 		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
 
 		// Expect plan to be fetched by ID
 		mockPlanRepo.EXPECT().FindByID(ctx, planID).Return(plan, nil)
 
-		mockTxRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).
+			Return(&entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: targetID, Tier: string(entity.TierFree)}, nil)
 		mockSubRepo.EXPECT().UpdateExpiry(ctx, userID, targetID, gomock.Any(), entity.TierGold.String()).Return(nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(2)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
 		sqlMock.ExpectCommit()
 
 		// Act
@@ -285,10 +667,17 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		assert.Equal(t, sePayID, result.SePayID)
 	})
 
-	t.Run("subscription_with_plan_not_found", func(t *testing.T) {
-		// Arrange
+	t.Run("renewal_stacks_on_remaining_time", func(t *testing.T) {
 		planID := uuid.New()
 		targetID := uuid.New()
+		plan := &entity.SubscriptionPlan{
+			ID:           planID,
+			AuthorID:     targetID,
+			Tier:         entity.TierSilver,
+			DurationDays: 30,
+			Price:        decimal.NewFromInt(100000),
+		}
+		currentExpiry := time.Now().Add(10 * 24 * time.Hour)
 
 		tx := &entity.Transaction{
 			ID:       uuid.New(),
@@ -310,96 +699,53 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
 		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
 		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
 
-		// Plan not found
-		mockTxRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
-		mockPlanRepo.EXPECT().FindByID(ctx, planID).Return(nil, gorm.ErrRecordNotFound)
-		sqlMock.ExpectRollback()
+		mockPlanRepo.EXPECT().FindByID(ctx, planID).Return(plan, nil)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).
+			Return(&entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: targetID, Tier: entity.TierSilver.String(), ExpiresAt: &currentExpiry}, nil)
+		mockSubRepo.EXPECT().UpdateExpiry(ctx, userID, targetID, currentExpiry.AddDate(0, 0, 30), entity.TierSilver.String()).Return(nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, entity.SubscriptionEventRenewed, e.Type)
+			assert.Equal(t, currentExpiry.AddDate(0, 0, 30), *e.ExpiresAt)
+			return nil
+		})
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(2)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
 
-		// Act
 		result, err := svc.HandleSePayWebhook(ctx, payload)
 
-		// Assert
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "plan not found")
-		assert.Nil(t, result)
-	})
-
-	t.Run("subscription_with_different_plan_tiers", func(t *testing.T) {
-		tests := []struct {
-			name         string
-			tier         entity.SubscriptionTier
-			durationDays int
-		}{
-			{"bronze_tier", entity.TierBronze, 30},
-			{"silver_tier", entity.TierSilver, 60},
-			{"gold_tier", entity.TierGold, 90},
-		}
-
-		for _, tt := range tests {
-			t.Run(tt.name, func(t *testing.T) {
-				// Arrange
-				planID := uuid.New()
-				targetID := uuid.New()
-				plan := &entity.SubscriptionPlan{
-					ID:           planID,
-					AuthorID:     targetID,
-					Tier:         tt.tier,
-					DurationDays: tt.durationDays,
-					Price:        decimal.NewFromInt(100000),
-				}
-
-				tx := &entity.Transaction{
-					ID:       uuid.New(),
-					UserID:   userID,
-					OrderID:  orderID,
-					Amount:   amount,
-					Type:     entity.TransactionTypeSubscription,
-					Status:   entity.TransactionStatusPending,
-					TargetID: &targetID,
-				}
-				planIDStr := planID.String()
-				tx.PlanID = &planIDStr
-
-				mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
-				mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
-
-				sqlMock.ExpectBegin()
-				mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
-				mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
-				mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
-				mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
-
-				mockPlanRepo.EXPECT().FindByID(ctx, planID).Return(plan, nil)
-				mockTxRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
-				mockSubRepo.EXPECT().UpdateExpiry(ctx, userID, targetID, gomock.Any(), tt.tier.String()).Return(nil)
-				sqlMock.ExpectCommit()
-
-				// Act
-				result, err := svc.HandleSePayWebhook(ctx, payload)
-
-				// Assert
-				assert.NoError(t, err)
-				assert.Equal(t, entity.TransactionStatusSuccess, result.Status)
-			})
-		}
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusSuccess, result.Status)
 	})
 
-	t.Run("subscription_with_invalid_plan_uuid", func(t *testing.T) {
-		// Arrange
+	t.Run("downgrade_is_scheduled_for_period_end", func(t *testing.T) {
+		planID := uuid.New()
 		targetID := uuid.New()
-		invalidPlanID := "not-a-uuid"
+		plan := &entity.SubscriptionPlan{
+			ID:           planID,
+			AuthorID:     targetID,
+			Tier:         entity.TierBronze,
+			DurationDays: 30,
+			Price:        decimal.NewFromInt(50000),
+		}
+		currentExpiry := time.Now().Add(10 * 24 * time.Hour)
+		subID := uuid.New()
 
 		tx := &entity.Transaction{
 			ID:       uuid.New(),
 			UserID:   userID,
 			OrderID:  orderID,
-			Amount:   amount,
+			Amount:   plan.Price,
 			Type:     entity.TransactionTypeSubscription,
 			Status:   entity.TransactionStatusPending,
 			TargetID: &targetID,
 		}
-		tx.PlanID = &invalidPlanID
+		planIDStr := planID.String()
+		tx.PlanID = &planIDStr
 
 		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
 		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
@@ -409,32 +755,311 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
 		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
 		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
 
-		mockTxRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
-		sqlMock.ExpectRollback()
+		mockPlanRepo.EXPECT().FindByID(ctx, planID).Return(plan, nil)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).
+			Return(&entity.Subscription{ID: subID, SubscriberID: userID, AuthorID: targetID, Tier: entity.TierGold.String(), ExpiresAt: &currentExpiry}, nil)
+		// The current tier is kept until it expires, no new period is granted yet
+		mockSubRepo.EXPECT().ScheduleTierChange(ctx, subID, gomock.Any(), entity.TierBronze.String(), currentExpiry.AddDate(0, 0, 30)).Return(true, nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, entity.SubscriptionEventDowngradeScheduled, e.Type)
+			assert.Equal(t, entity.TierBronze.String(), e.Tier)
+			return nil
+		})
+		var enqueued []string
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.OutboxEvent) error {
+			enqueued = append(enqueued, e.EventType)
+			return nil
+		})
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
 
-		// Act
-		result, err := svc.HandleSePayWebhook(ctx, payload)
+		downgradePayload := payload
+		downgradePayload.TransferAmount = plan.Price
+		result, err := svc.HandleSePayWebhook(ctx, downgradePayload)
 
-		// Assert
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "invalid plan ID")
-		assert.Nil(t, result)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusSuccess, result.Status)
+		assert.Equal(t, []string{string(service.EventPaymentSucceeded)}, enqueued)
 	})
 
-	t.Run("transaction_already_successful", func(t *testing.T) {
-		// Arrange
-		tx := &entity.Transaction{
-			ID:      uuid.New(),
-			UserID:  userID,
-			OrderID: orderID,
-			Amount:  amount,
+	quotedOrder := func(plan *entity.SubscriptionPlan, change service.SubscriptionChange, basis *time.Time, startsAt time.Time) *entity.Transaction {
+		targetID, planIDStr := plan.AuthorID, plan.ID.String()
+		changeStr, expiresAt := string(change), startsAt.AddDate(0, 0, plan.DurationDays)
+		return &entity.Transaction{
+			ID:                 uuid.New(),
+			UserID:             userID,
+			OrderID:            orderID,
+			Amount:             amount,
+			Type:               entity.TransactionTypeSubscription,
+			Status:             entity.TransactionStatusPending,
+			TargetID:           &targetID,
+			PlanID:             &planIDStr,
+			SubscriptionChange: &changeStr,
+			PeriodStartsAt:     &startsAt,
+			PeriodExpiresAt:    &expiresAt,
+			QuotedExpiresAt:    basis,
+		}
+	}
+
+	t.Run("quoted_upgrade_is_applied_as_priced", func(t *testing.T) {
+		targetID := uuid.New()
+		plan := &entity.SubscriptionPlan{ID: uuid.New(), AuthorID: targetID, Tier: entity.TierGold, DurationDays: 30, Price: amount}
+		currentExpiry := time.Now().Add(10 * 24 * time.Hour).Truncate(time.Microsecond)
+		quotedAt := time.Now().Add(-time.Hour)
+		tx := quotedOrder(plan, service.SubscriptionChangeUpgrade, &currentExpiry, quotedAt)
+
+		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).
+			Return(&entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: targetID, Tier: entity.TierSilver.String(), ExpiresAt: &currentExpiry}, nil)
+		// The period quoted at checkout is granted without pricing the change again
+		mockSubRepo.EXPECT().UpdateExpiry(ctx, userID, targetID, quotedAt.AddDate(0, 0, 30), entity.TierGold.String()).Return(nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, entity.SubscriptionEventUpgraded, e.Type)
+			return nil
+		})
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(2)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		result, err := svc.HandleSePayWebhook(ctx, payload)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusSuccess, result.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("subscription_changed_since_quote_is_held_for_review", func(t *testing.T) {
+		targetID := uuid.New()
+		plan := &entity.SubscriptionPlan{ID: uuid.New(), AuthorID: targetID, Tier: entity.TierSilver, DurationDays: 30, Price: amount}
+		quotedExpiry := time.Now().Add(10 * 24 * time.Hour)
+		renewedExpiry := quotedExpiry.AddDate(0, 0, 30)
+		tx := quotedOrder(plan, service.SubscriptionChangeRenewal, &quotedExpiry, quotedExpiry)
+
+		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), entity.TransactionStatusPending).Return(true, nil)
+		// Another order renewed the subscription after this one was quoted
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).
+			Return(&entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: targetID, Tier: entity.TierSilver.String(), ExpiresAt: &renewedExpiry}, nil)
+		sqlMock.ExpectRollback()
+
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), entity.TransactionStatusPending).DoAndReturn(func(_ context.Context, updated *entity.Transaction, _ entity.TransactionStatus) (bool, error) {
+			assert.Equal(t, entity.TransactionStatusReview, updated.Status)
+			return true, nil
+		})
+		mockReconRepo.EXPECT().WithTx(gomock.Any()).Return(mockReconRepo)
+		mockReconRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, rec *entity.PaymentReconciliation) error {
+			assert.Equal(t, entity.ReconciliationReasonPlanConflict, rec.Reason)
+			assert.True(t, amount.Equal(rec.ReceivedAmount))
+			return nil
+		})
+		sqlMock.ExpectCommit()
+
+		result, err := svc.HandleSePayWebhook(ctx, payload)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusReview, result.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("subscription_with_plan_not_found", func(t *testing.T) {
+		// Arrange
+		planID := uuid.New()
+		targetID := uuid.New()
+
+		tx := &entity.Transaction{
+			ID:       uuid.New(),
+			UserID:   userID,
+			OrderID:  orderID,
+			Amount:   amount,
+			Type:     entity.TransactionTypeSubscription,
+			Status:   entity.TransactionStatusPending,
+			TargetID: &targetID,
+		}
+		planIDStr := planID.String()
+		tx.PlanID = &planIDStr
+
+		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+
+		// Plan not found
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockPlanRepo.EXPECT().FindByID(ctx, planID).Return(nil, gorm.ErrRecordNotFound)
+		sqlMock.ExpectRollback()
+
+		// Act
+		result, err := svc.HandleSePayWebhook(ctx, payload)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "plan not found")
+		assert.Nil(t, result)
+	})
+
+	t.Run("subscription_with_different_plan_tiers", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			tier         entity.SubscriptionTier
+			durationDays int
+		}{
+			{"bronze_tier", entity.TierBronze, 30},
+			{"silver_tier", entity.TierSilver, 60},
+			{"gold_tier", entity.TierGold, 90},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Arrange
+				planID := uuid.New()
+				targetID := uuid.New()
+				plan := &entity.SubscriptionPlan{
+					ID:           planID,
+					AuthorID:     targetID,
+					Tier:         tt.tier,
+					DurationDays: tt.durationDays,
+					Price:        decimal.NewFromInt(100000),
+				}
+
+				tx := &entity.Transaction{
+					ID:       uuid.New(),
+					UserID:   userID,
+					OrderID:  orderID,
+					Amount:   amount,
+					Type:     entity.TransactionTypeSubscription,
+					Status:   entity.TransactionStatusPending,
+					TargetID: &targetID,
+				}
+				planIDStr := planID.String()
+				tx.PlanID = &planIDStr
+
+				mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+				mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+
+				sqlMock.ExpectBegin()
+				mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+				mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+				mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+				mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+				mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+				mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+
+				mockPlanRepo.EXPECT().FindByID(ctx, planID).Return(plan, nil)
+				mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+				mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).
+					Return(&entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: targetID, Tier: string(entity.TierFree)}, nil)
+				mockSubRepo.EXPECT().UpdateExpiry(ctx, userID, targetID, gomock.Any(), tt.tier.String()).Return(nil)
+				mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(2)
+				mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				sqlMock.ExpectCommit()
+
+				// Act
+				result, err := svc.HandleSePayWebhook(ctx, payload)
+
+				// Assert
+				assert.NoError(t, err)
+				assert.Equal(t, entity.TransactionStatusSuccess, result.Status)
+			})
+		}
+	})
+
+	t.Run("subscription_with_invalid_plan_uuid", func(t *testing.T) {
+		// Arrange
+		targetID := uuid.New()
+		invalidPlanID := "not-a-uuid"
+
+		tx := &entity.Transaction{
+			ID:       uuid.New(),
+			UserID:   userID,
+			OrderID:  orderID,
+			Amount:   amount,
+			Type:     entity.TransactionTypeSubscription,
+			Status:   entity.TransactionStatusPending,
+			TargetID: &targetID,
+		}
+		tx.PlanID = &invalidPlanID
+
+		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		sqlMock.ExpectRollback()
+
+		// Act
+		result, err := svc.HandleSePayWebhook(ctx, payload)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid plan ID")
+		assert.Nil(t, result)
+	})
+
+	t.Run("transaction_already_successful", func(t *testing.T) {
+		// Arrange
+		tx := &entity.Transaction{
+			ID:      uuid.New(),
+			UserID:  userID,
+			OrderID: orderID,
+			Amount:  amount,
 			Type:    entity.TransactionTypeSubscription,
 			Status:  entity.TransactionStatusSuccess,
+			SePayID: "999",
 		}
 
 		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
 		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+		// A second transfer for a paid order is queued, not applied
+		mockReconRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockReconRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, rec *entity.PaymentReconciliation) error {
+			assert.Equal(t, entity.ReconciliationReasonOrderClosed, rec.Reason)
+			assert.Equal(t, tx.ID, rec.TransactionID)
+			assert.Equal(t, sePayID, rec.SePayID)
+			return nil
+		})
 
 		// Act
 		result, err := svc.HandleSePayWebhook(ctx, payload)
@@ -442,6 +1067,104 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, entity.TransactionStatusSuccess, result.Status)
+		assert.Equal(t, "999", result.SePayID)
+	})
+
+	t.Run("closed_order_transfer_redelivered", func(t *testing.T) {
+		tx := &entity.Transaction{
+			ID:      uuid.New(),
+			UserID:  userID,
+			OrderID: orderID,
+			Amount:  amount,
+			Type:    entity.TransactionTypeSeries,
+			Status:  entity.TransactionStatusFailed,
+		}
+
+		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+		mockReconRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(&entity.PaymentReconciliation{SePayID: sePayID}, nil)
+
+		result, err := svc.HandleSePayWebhook(ctx, payload)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusFailed, result.Status)
+	})
+
+	t.Run("underpayment_is_held_for_review", func(t *testing.T) {
+		seriesID := uuid.New()
+		tx := &entity.Transaction{
+			ID:       uuid.New(),
+			UserID:   userID,
+			OrderID:  orderID,
+			Amount:   amount,
+			Currency: "VND",
+			Type:     entity.TransactionTypeSeries,
+			Status:   entity.TransactionStatusPending,
+			TargetID: &seriesID,
+		}
+		underpaid := payload
+		underpaid.TransferAmount = decimal.NewFromInt(1)
+
+		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), entity.TransactionStatusPending).DoAndReturn(func(_ context.Context, updated *entity.Transaction, _ entity.TransactionStatus) (bool, error) {
+			assert.Equal(t, entity.TransactionStatusReview, updated.Status)
+			assert.Equal(t, "1", updated.PaidAmount.String())
+			return true, nil
+		})
+		mockReconRepo.EXPECT().WithTx(gomock.Any()).Return(mockReconRepo)
+		mockReconRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, rec *entity.PaymentReconciliation) error {
+			assert.Equal(t, entity.ReconciliationReasonUnderpaid, rec.Reason)
+			assert.True(t, amount.Equal(rec.ExpectedAmount))
+			assert.Equal(t, "1", rec.ReceivedAmount.String())
+			assert.Equal(t, entity.ReconciliationStatusOpen, rec.Status)
+			return nil
+		})
+		sqlMock.ExpectCommit()
+		// No purchase is recorded and no payment event is enqueued
+
+		result, err := svc.HandleSePayWebhook(ctx, underpaid)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusReview, result.Status)
+		assert.Equal(t, sePayID, result.SePayID)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("overpayment_is_recorded_for_review", func(t *testing.T) {
+		tx := &entity.Transaction{
+			ID:      uuid.New(),
+			UserID:  userID,
+			OrderID: orderID,
+			Amount:  amount,
+			Type:    entity.TransactionTypeDonation,
+			Status:  entity.TransactionStatusPending,
+		}
+		overpaid := payload
+		overpaid.TransferAmount = decimal.NewFromInt(150000)
+
+		mockTxRepo.EXPECT().FindBySePayID(ctx, sePayID).Return(nil, nil)
+		mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockReconRepo.EXPECT().WithTx(gomock.Any()).Return(mockReconRepo)
+		mockReconRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, rec *entity.PaymentReconciliation) error {
+			assert.Equal(t, entity.ReconciliationReasonOverpaid, rec.Reason)
+			assert.Equal(t, "150000", rec.ReceivedAmount.String())
+			return nil
+		})
+		sqlMock.ExpectCommit()
+
+		result, err := svc.HandleSePayWebhook(ctx, overpaid)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusReview, result.Status)
+		assert.Equal(t, "150000", result.PaidAmount.String())
 	})
 
 	t.Run("subscription_without_target_or_plan", func(t *testing.T) {
@@ -465,8 +1188,12 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
 		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
 		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
 
-		mockTxRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
 		sqlMock.ExpectCommit()
 
 		// Act
@@ -478,19 +1205,250 @@ func TestPaymentService_HandleSePayWebhook(t *testing.T) {
 	})
 }
 
-func TestPaymentService_GetTransactionStatus(t *testing.T) {
+func TestPaymentService_ResolveReconciliation(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockTxRepo := mocks.NewMockTransactionRepository(ctrl)
-	db, _, _ := sqlmock.New()
+	mockSubRepo := mocks.NewMockSubscriptionRepository(ctrl)
+	mockPurchaseRepo := mocks.NewMockUserSeriesPurchaseRepository(ctrl)
+	mockPlanRepo := mocks.NewMockSubscriptionPlanRepository(ctrl)
+	mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	mockSubEventRepo := mocks.NewMockSubscriptionEventRepository(ctrl)
+	mockReconRepo := mocks.NewMockPaymentReconciliationRepository(ctrl)
+	mockPromoRepo := mocks.NewMockPromoCodeRepository(ctrl)
+	mockLedger := servicemocks.NewMockLedgerService(ctrl)
+
+	db, sqlMock, _ := sqlmock.New()
 	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
 
-	svc := service.NewPaymentService(gormDB, mockTxRepo, nil, nil, nil, nil)
+	svc := service.NewPaymentService(gormDB, mockTxRepo, mockSubRepo, mockPurchaseRepo, nil, nil, mockPlanRepo, mockOutboxRepo, mockSubEventRepo, mockReconRepo, mockPromoRepo, nil, mockLedger, sepayProviders(nil))
 
 	ctx := context.Background()
-	orderID := "ORDER-123"
-	expectedTx := &entity.Transaction{OrderID: orderID, Status: entity.TransactionStatusSuccess}
+	adminID := uuid.New()
+	seriesID := uuid.New()
+
+	newCase := func(txStatus entity.TransactionStatus, reason entity.ReconciliationReason) (*entity.PaymentReconciliation, *entity.Transaction) {
+		tx := &entity.Transaction{
+			ID:       uuid.New(),
+			UserID:   uuid.New(),
+			Amount:   decimal.NewFromInt(49000),
+			Type:     entity.TransactionTypeSeries,
+			Status:   txStatus,
+			TargetID: &seriesID,
+			SePayID:  "2002",
+		}
+		rec := &entity.PaymentReconciliation{
+			ID:             uuid.New(),
+			TransactionID:  tx.ID,
+			SePayID:        "2002",
+			Reason:         reason,
+			ExpectedAmount: tx.Amount,
+			ReceivedAmount: decimal.NewFromInt(40000),
+			Status:         entity.ReconciliationStatusOpen,
+		}
+		return rec, tx
+	}
+
+	t.Run("grant_completes_held_order", func(t *testing.T) {
+		rec, tx := newCase(entity.TransactionStatusReview, entity.ReconciliationReasonUnderpaid)
+		note := "customer paid the rest in cash"
+
+		mockReconRepo.EXPECT().FindByID(ctx, rec.ID).Return(rec, nil)
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockReconRepo.EXPECT().WithTx(gomock.Any()).Return(mockReconRepo)
+		mockReconRepo.EXPECT().Resolve(ctx, rec.ID, entity.ReconciliationStatusGranted, adminID, &note, gomock.Any()).Return(true, nil)
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockPurchaseRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		result, err := svc.ResolveReconciliation(ctx, rec.ID, adminID, true, &note)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ReconciliationStatusGranted, result.Status)
+		assert.Equal(t, adminID, *result.ResolvedBy)
+		assert.Equal(t, entity.TransactionStatusSuccess, tx.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("grant_of_plan_conflict_prices_subscription_again", func(t *testing.T) {
+		rec, tx := newCase(entity.TransactionStatusReview, entity.ReconciliationReasonPlanConflict)
+		authorID := uuid.New()
+		plan := &entity.SubscriptionPlan{ID: uuid.New(), AuthorID: authorID, Tier: entity.TierSilver, DurationDays: 30, Price: tx.Amount}
+		quotedExpiry := time.Now().Add(10 * 24 * time.Hour)
+		currentExpiry := quotedExpiry.AddDate(0, 0, 30)
+		change, planID := string(service.SubscriptionChangeRenewal), plan.ID.String()
+		tx.Type, tx.TargetID, tx.PlanID = entity.TransactionTypeSubscription, &authorID, &planID
+		tx.SubscriptionChange, tx.PeriodStartsAt, tx.QuotedExpiresAt = &change, &quotedExpiry, &quotedExpiry
+		tx.PeriodExpiresAt = &currentExpiry
+
+		mockReconRepo.EXPECT().FindByID(ctx, rec.ID).Return(rec, nil)
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockReconRepo.EXPECT().WithTx(gomock.Any()).Return(mockReconRepo)
+		mockReconRepo.EXPECT().Resolve(ctx, rec.ID, entity.ReconciliationStatusGranted, adminID, nil, gomock.Any()).Return(true, nil)
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), entity.TransactionStatusReview).DoAndReturn(func(_ context.Context, updated *entity.Transaction, _ entity.TransactionStatus) (bool, error) {
+			assert.Nil(t, updated.SubscriptionChange)
+			return true, nil
+		})
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, tx.UserID, authorID).
+			Return(&entity.Subscription{ID: uuid.New(), SubscriberID: tx.UserID, AuthorID: authorID, Tier: entity.TierSilver.String(), ExpiresAt: &currentExpiry}, nil)
+		// The renewal stacks on the subscription as it is now, not as it was quoted
+		mockSubRepo.EXPECT().UpdateExpiry(ctx, tx.UserID, authorID, currentExpiry.AddDate(0, 0, 30), entity.TierSilver.String()).Return(nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(2)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		_, err := svc.ResolveReconciliation(ctx, rec.ID, adminID, true, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusSuccess, tx.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("dismiss_fails_held_order", func(t *testing.T) {
+		rec, tx := newCase(entity.TransactionStatusReview, entity.ReconciliationReasonUnderpaid)
+
+		mockReconRepo.EXPECT().FindByID(ctx, rec.ID).Return(rec, nil)
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockReconRepo.EXPECT().WithTx(gomock.Any()).Return(mockReconRepo)
+		mockReconRepo.EXPECT().Resolve(ctx, rec.ID, entity.ReconciliationStatusDismissed, adminID, nil, gomock.Any()).Return(true, nil)
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), entity.TransactionStatusReview).DoAndReturn(func(_ context.Context, updated *entity.Transaction, _ entity.TransactionStatus) (bool, error) {
+			assert.Equal(t, entity.TransactionStatusFailed, updated.Status)
+			return true, nil
+		})
+		sqlMock.ExpectCommit()
+
+		result, err := svc.ResolveReconciliation(ctx, rec.ID, adminID, false, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ReconciliationStatusDismissed, result.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("dismiss_releases_promo_code", func(t *testing.T) {
+		rec, tx := newCase(entity.TransactionStatusReview, entity.ReconciliationReasonUnderpaid)
+		promoCodeID := uuid.New()
+		tx.PromoCodeID = &promoCodeID
+
+		mockReconRepo.EXPECT().FindByID(ctx, rec.ID).Return(rec, nil)
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockReconRepo.EXPECT().WithTx(gomock.Any()).Return(mockReconRepo)
+		mockReconRepo.EXPECT().Resolve(ctx, rec.ID, entity.ReconciliationStatusDismissed, adminID, nil, gomock.Any()).Return(true, nil)
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockPromoRepo.EXPECT().WithTx(gomock.Any()).Return(mockPromoRepo)
+		mockPromoRepo.EXPECT().ReleaseRedemption(ctx, promoCodeID).Return(nil)
+		sqlMock.ExpectCommit()
+
+		_, err := svc.ResolveReconciliation(ctx, rec.ID, adminID, false, nil)
+
+		assert.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("grant_of_expired_order_counts_promo_code_again", func(t *testing.T) {
+		rec, tx := newCase(entity.TransactionStatusExpired, entity.ReconciliationReasonOrderClosed)
+		promoCodeID := uuid.New()
+		tx.PromoCodeID = &promoCodeID
+
+		mockReconRepo.EXPECT().FindByID(ctx, rec.ID).Return(rec, nil)
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+
+		sqlMock.ExpectBegin()
+		mockReconRepo.EXPECT().WithTx(gomock.Any()).Return(mockReconRepo)
+		mockReconRepo.EXPECT().Resolve(ctx, rec.ID, entity.ReconciliationStatusGranted, adminID, nil, gomock.Any()).Return(true, nil)
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		// Expiry gave the redemption back, so the paid order takes it again even past the cap
+		mockPromoRepo.EXPECT().WithTx(gomock.Any()).Return(mockPromoRepo)
+		mockPromoRepo.EXPECT().IncrementRedemptions(ctx, promoCodeID).Return(nil)
+		mockPurchaseRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		_, err := svc.ResolveReconciliation(ctx, rec.ID, adminID, true, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusSuccess, tx.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("paid_order_cannot_be_granted", func(t *testing.T) {
+		rec, tx := newCase(entity.TransactionStatusSuccess, entity.ReconciliationReasonOrderClosed)
+
+		mockReconRepo.EXPECT().FindByID(ctx, rec.ID).Return(rec, nil)
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+
+		_, err := svc.ResolveReconciliation(ctx, rec.ID, adminID, true, nil)
+
+		assert.ErrorIs(t, err, service.ErrReconciliationNotGrantable)
+	})
+
+	t.Run("already_resolved", func(t *testing.T) {
+		rec, _ := newCase(entity.TransactionStatusFailed, entity.ReconciliationReasonUnderpaid)
+		rec.Status = entity.ReconciliationStatusDismissed
+
+		mockReconRepo.EXPECT().FindByID(ctx, rec.ID).Return(rec, nil)
+
+		_, err := svc.ResolveReconciliation(ctx, rec.ID, adminID, true, nil)
+
+		assert.ErrorIs(t, err, service.ErrReconciliationResolved)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		id := uuid.New()
+		mockReconRepo.EXPECT().FindByID(ctx, id).Return(nil, nil)
+
+		_, err := svc.ResolveReconciliation(ctx, id, adminID, false, nil)
+
+		assert.ErrorIs(t, err, service.ErrReconciliationNotFound)
+	})
+}
+
+func TestPaymentService_GetTransactionStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTxRepo := mocks.NewMockTransactionRepository(ctrl)
+	db, _, _ := sqlmock.New()
+	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+
+	svc := service.NewPaymentService(gormDB, mockTxRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, sepayProviders(nil))
+
+	ctx := context.Background()
+	orderID := "ORDER-123"
+	expectedTx := &entity.Transaction{OrderID: orderID, Status: entity.TransactionStatusSuccess}
 
 	mockTxRepo.EXPECT().FindByRefID(ctx, orderID).Return(expectedTx, nil)
 
@@ -500,22 +1458,606 @@ func TestPaymentService_GetTransactionStatus(t *testing.T) {
 	assert.Equal(t, expectedTx, tx)
 }
 
-func TestPaymentService_VerifySePayWebhookSignature(t *testing.T) {
+// sepayProviders routes VND orders to SePay and, when given, USD orders to a card provider
+func sepayProviders(sepay adapter.SePayAdapter, card ...adapter.PaymentProvider) *service.PaymentProviders {
+	providers := map[entity.TransactionProvider]adapter.PaymentProvider{
+		entity.TransactionProviderSEPAY: adapter.NewSePayProvider(sepay),
+	}
+	if len(card) > 0 {
+		providers[entity.TransactionProviderStripe] = card[0]
+	}
+	return service.NewPaymentProviders(providers, map[string]string{"vnd": "sepay", "usd": "stripe"})
+}
+
+func TestPaymentService_CardProvider(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockAdapter := adapter_mocks.NewMockSePayAdapter(ctrl)
-	db, _, _ := sqlmock.New()
+	mockTxRepo := mocks.NewMockTransactionRepository(ctrl)
+	mockSubRepo := mocks.NewMockSubscriptionRepository(ctrl)
+	mockPurchaseRepo := mocks.NewMockUserSeriesPurchaseRepository(ctrl)
+	mockPlanRepo := mocks.NewMockSubscriptionPlanRepository(ctrl)
+	mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	mockSubEventRepo := mocks.NewMockSubscriptionEventRepository(ctrl)
+	mockReconRepo := mocks.NewMockPaymentReconciliationRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockLedger := servicemocks.NewMockLedgerService(ctrl)
+	mockCard := adapter_mocks.NewMockPaymentProvider(ctrl)
+
+	db, sqlMock, _ := sqlmock.New()
+	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+
+	svc := service.NewPaymentService(gormDB, mockTxRepo, mockSubRepo, mockPurchaseRepo, nil, mockUserRepo, mockPlanRepo, mockOutboxRepo,
+		mockSubEventRepo, mockReconRepo, nil, nil, mockLedger, sepayProviders(nil, mockCard))
+
+	ctx := context.Background()
+	userID := uuid.New()
+	authorID := uuid.New()
+	authorTarget := authorID.String()
+	sessionID := "cs_test_1"
+
+	pendingCardOrder := func() *entity.Transaction {
+		gateway := entity.TransactionGatewayCard
+		ref := sessionID
+		return &entity.Transaction{
+			ID:          uuid.New(),
+			UserID:      userID,
+			Amount:      decimal.RequireFromString("12.50"),
+			Currency:    "USD",
+			Provider:    entity.TransactionProviderStripe,
+			Gateway:     &gateway,
+			Type:        entity.TransactionTypeDonation,
+			Status:      entity.TransactionStatusPending,
+			TargetID:    &authorID,
+			ProviderRef: &ref,
+			OrderID:     "ORDER-STRIPE-1",
+		}
+	}
+	paid := &adapter.ProviderPayment{
+		ProviderRef: sessionID,
+		OrderID:     "ORDER-STRIPE-1",
+		Status:      adapter.ProviderPaymentPaid,
+		Amount:      decimal.RequireFromString("12.50"),
+		Currency:    "USD",
+	}
+	expectCompletion := func() {
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+	}
+
+	t.Run("init_routes_currency_to_card_checkout", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, authorID).Return(&entity.User{ID: authorID}, nil)
+		mockCard.EXPECT().SupportsGateway("CARD").Return(true)
+		mockCard.EXPECT().CreatePayment(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, req adapter.PaymentSessionRequest) (*adapter.PaymentSession, error) {
+				assert.Equal(t, "USD", req.Currency)
+				assert.Equal(t, "12.5", req.Amount.String())
+				assert.Contains(t, req.OrderID, "ORDER-STRIPE-")
+				return &adapter.PaymentSession{ProviderRef: sessionID, CheckoutURL: "https://checkout.example/cs_test_1"}, nil
+			})
+		mockTxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tx *entity.Transaction) error {
+			assert.Equal(t, entity.TransactionProviderStripe, tx.Provider)
+			assert.Equal(t, "USD", tx.Currency)
+			assert.Equal(t, sessionID, *tx.ProviderRef)
+			return nil
+		})
+
+		resp, err := svc.InitPayment(ctx, service.CreatePaymentRequest{
+			UserID:   userID.String(),
+			Amount:   decimal.RequireFromString("12.50"),
+			Type:     entity.TransactionTypeDonation,
+			Gateway:  entity.TransactionGatewayCard,
+			Currency: "usd",
+			TargetID: &authorTarget,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", resp.Currency)
+		assert.Equal(t, "https://checkout.example/cs_test_1", resp.CheckoutURL)
+		assert.Empty(t, resp.QRData)
+	})
+
+	t.Run("bank_gateway_is_not_offered_for_card_currency", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, authorID).Return(&entity.User{ID: authorID}, nil)
+		mockCard.EXPECT().SupportsGateway("VIETQR").Return(false)
+
+		_, err := svc.InitPayment(ctx, service.CreatePaymentRequest{
+			UserID:   userID.String(),
+			Amount:   decimal.NewFromInt(10),
+			Type:     entity.TransactionTypeDonation,
+			Gateway:  entity.TransactionGatewayVietQR,
+			Currency: "USD",
+			TargetID: &authorTarget,
+		})
+
+		assert.ErrorIs(t, err, service.ErrUnsupportedPaymentType)
+	})
+
+	t.Run("usd_donation_below_one_cent_is_rejected", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, authorID).Return(&entity.User{ID: authorID}, nil)
+		_, err := svc.InitPayment(ctx, service.CreatePaymentRequest{
+			UserID:   userID.String(),
+			Amount:   decimal.RequireFromString("10.005"),
+			Type:     entity.TransactionTypeDonation,
+			Gateway:  entity.TransactionGatewayCard,
+			Currency: "USD",
+			TargetID: &authorTarget,
+		})
+
+		assert.ErrorIs(t, err, service.ErrPaymentAmountPrecision)
+	})
+
+	t.Run("currency_without_provider", func(t *testing.T) {
+		mockUserRepo.EXPECT().FindByID(ctx, authorID).Return(&entity.User{ID: authorID}, nil)
+		_, err := svc.InitPayment(ctx, service.CreatePaymentRequest{
+			UserID:   userID.String(),
+			Amount:   decimal.NewFromInt(10),
+			Type:     entity.TransactionTypeDonation,
+			Gateway:  entity.TransactionGatewayCard,
+			Currency: "EUR",
+			TargetID: &authorTarget,
+		})
+
+		assert.ErrorIs(t, err, service.ErrUnsupportedCurrency)
+	})
+
+	t.Run("webhook_completes_order", func(t *testing.T) {
+		tx := pendingCardOrder()
+		mockCard.EXPECT().ParseWebhook([]byte("body"), "sig").Return(paid, nil)
+		mockTxRepo.EXPECT().FindByProviderRef(ctx, entity.TransactionProviderStripe, sessionID).Return(tx, nil)
+		expectCompletion()
+
+		result, err := svc.HandleProviderWebhook(ctx, entity.TransactionProviderStripe, []byte("body"), "sig")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusSuccess, result.Status)
+		assert.Empty(t, result.SePayID)
+		assert.NotNil(t, result.PaidAt)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("redelivered_webhook_is_ignored", func(t *testing.T) {
+		tx := pendingCardOrder()
+		paidAt := time.Now()
+		tx.Status = entity.TransactionStatusSuccess
+		tx.PaidAt = &paidAt
+		mockCard.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).Return(paid, nil)
+		mockTxRepo.EXPECT().FindByProviderRef(ctx, entity.TransactionProviderStripe, sessionID).Return(tx, nil)
+
+		result, err := svc.HandleProviderWebhook(ctx, entity.TransactionProviderStripe, nil, "sig")
+
+		assert.NoError(t, err)
+		assert.Equal(t, tx, result)
+	})
+
+	t.Run("payment_for_expired_order_is_flagged", func(t *testing.T) {
+		tx := pendingCardOrder()
+		tx.Status = entity.TransactionStatusExpired
+		mockCard.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).Return(paid, nil)
+		mockTxRepo.EXPECT().FindByProviderRef(ctx, entity.TransactionProviderStripe, sessionID).Return(tx, nil)
+		mockReconRepo.EXPECT().FindBySePayID(ctx, sessionID).Return(nil, nil)
+		mockReconRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, rec *entity.PaymentReconciliation) error {
+			assert.Equal(t, entity.ReconciliationReasonOrderClosed, rec.Reason)
+			assert.Equal(t, "USD", rec.Currency)
+			return nil
+		})
+
+		result, err := svc.HandleProviderWebhook(ctx, entity.TransactionProviderStripe, nil, "sig")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusExpired, result.Status)
+	})
+
+	t.Run("unsigned_webhook_is_rejected", func(t *testing.T) {
+		mockCard.EXPECT().ParseWebhook(gomock.Any(), "forged").Return(nil, adapter.ErrInvalidWebhookSignature)
+
+		_, err := svc.HandleProviderWebhook(ctx, entity.TransactionProviderStripe, nil, "forged")
+
+		assert.ErrorIs(t, err, adapter.ErrInvalidWebhookSignature)
+	})
+
+	t.Run("other_events_are_ignored", func(t *testing.T) {
+		mockCard.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		result, err := svc.HandleProviderWebhook(ctx, entity.TransactionProviderStripe, nil, "sig")
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("sepay_transfers_only_arrive_on_their_own_route", func(t *testing.T) {
+		body := []byte(`{"id":43,"content":"ORDER-1","transferType":"out","transferAmount":50000}`)
+
+		result, err := svc.HandleProviderWebhook(ctx, entity.TransactionProviderSEPAY, body, "token")
+
+		assert.ErrorIs(t, err, adapter.ErrWebhookNotSupported)
+		assert.Nil(t, result)
+	})
+
+	t.Run("status_lookup_settles_paid_checkout", func(t *testing.T) {
+		tx := pendingCardOrder()
+		mockTxRepo.EXPECT().FindByRefID(ctx, tx.OrderID).Return(tx, nil)
+		mockCard.EXPECT().GetPayment(ctx, sessionID).Return(paid, nil)
+		mockTxRepo.EXPECT().FindByProviderRef(ctx, entity.TransactionProviderStripe, sessionID).Return(tx, nil)
+		expectCompletion()
+
+		result, err := svc.GetTransactionStatus(ctx, tx.OrderID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusSuccess, result.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("status_lookup_keeps_unpaid_checkout_pending", func(t *testing.T) {
+		tx := pendingCardOrder()
+		unpaid := *paid
+		unpaid.Status = adapter.ProviderPaymentPending
+		mockTxRepo.EXPECT().FindByRefID(ctx, tx.OrderID).Return(tx, nil)
+		mockCard.EXPECT().GetPayment(ctx, sessionID).Return(&unpaid, nil)
+
+		result, err := svc.GetTransactionStatus(ctx, tx.OrderID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusPending, result.Status)
+	})
+}
+
+func TestPaymentService_GiftsAndPromoCodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTxRepo := mocks.NewMockTransactionRepository(ctrl)
+	mockSubRepo := mocks.NewMockSubscriptionRepository(ctrl)
+	mockPurchaseRepo := mocks.NewMockUserSeriesPurchaseRepository(ctrl)
+	mockPlanRepo := mocks.NewMockSubscriptionPlanRepository(ctrl)
+	mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	mockSubEventRepo := mocks.NewMockSubscriptionEventRepository(ctrl)
+	mockPromoRepo := mocks.NewMockPromoCodeRepository(ctrl)
+	mockGiftRepo := mocks.NewMockGiftSubscriptionRepository(ctrl)
+	mockLedger := servicemocks.NewMockLedgerService(ctrl)
+	mockSePayAdapter := adapter_mocks.NewMockSePayAdapter(ctrl)
+
+	db, sqlMock, _ := sqlmock.New()
 	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
 
-	svc := service.NewPaymentService(gormDB, nil, nil, nil, nil, mockAdapter)
+	svc := service.NewPaymentService(
+		gormDB,
+		mockTxRepo,
+		mockSubRepo,
+		mockPurchaseRepo,
+		nil,
+		nil,
+		mockPlanRepo,
+		mockOutboxRepo,
+		mockSubEventRepo,
+		nil,
+		mockPromoRepo,
+		mockGiftRepo,
+		mockLedger,
+		sepayProviders(mockSePayAdapter),
+	)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	authorID := uuid.New()
+	authorTarget := authorID.String()
+	plan := &entity.SubscriptionPlan{ID: uuid.New(), AuthorID: authorID, Tier: entity.TierSilver, Price: decimal.NewFromInt(99999), DurationDays: 30, IsActive: true}
+	planID := plan.ID.String()
+	planReq := func(gift bool, promoCode *string) service.CreatePaymentRequest {
+		return service.CreatePaymentRequest{
+			UserID:    userID.String(),
+			Type:      entity.TransactionTypeSubscription,
+			Gateway:   entity.TransactionGatewayBankTransfer,
+			TargetID:  &authorTarget,
+			PlanID:    &planID,
+			Gift:      gift,
+			PromoCode: promoCode,
+		}
+	}
+	promo := func(discountType entity.PromoDiscountType, value int64) *entity.PromoCode {
+		return &entity.PromoCode{ID: uuid.New(), AuthorID: authorID, Code: "LAUNCH", DiscountType: discountType, DiscountValue: decimal.NewFromInt(value), IsActive: true}
+	}
+	code := "launch"
+
+	t.Run("percent_promo_discounts_plan", func(t *testing.T) {
+		percent := promo(entity.PromoDiscountPercent, 15)
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).Return(nil, nil)
+		mockPromoRepo.EXPECT().FindByAuthorAndCode(ctx, authorID, code).Return(percent, nil)
+		mockPromoRepo.EXPECT().ReserveRedemption(ctx, percent.ID).Return(true, nil)
+		mockSePayAdapter.EXPECT().GetBankTransferInfo().Return(adapter.BankTransferInfo{})
+		mockTxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tx *entity.Transaction) error {
+			assert.Equal(t, &percent.ID, tx.PromoCodeID)
+			assert.Equal(t, "14999", tx.Discount.String())
+			return nil
+		})
+
+		resp, err := svc.InitPayment(ctx, planReq(false, &code))
 
-	payload := map[string]interface{}{"foo": "bar"}
-	signature := "valid-sig"
+		assert.NoError(t, err)
+		// 15% of 99999 is rounded down to whole dong
+		assert.Equal(t, "85000", resp.Amount.String())
+		assert.Equal(t, "14999", resp.Discount.String())
+	})
+
+	t.Run("expired_promo_is_rejected", func(t *testing.T) {
+		expired := promo(entity.PromoDiscountFixed, 10000)
+		past := time.Now().Add(-time.Hour)
+		expired.ExpiresAt = &past
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).Return(nil, nil)
+		mockPromoRepo.EXPECT().FindByAuthorAndCode(ctx, authorID, code).Return(expired, nil)
 
-	mockAdapter.EXPECT().VerifyWebhookSignature(payload, signature).Return(true)
+		_, err := svc.InitPayment(ctx, planReq(false, &code))
+
+		assert.ErrorIs(t, err, service.ErrPromoCodeInvalid)
+	})
+
+	t.Run("used_up_promo_is_rejected", func(t *testing.T) {
+		capped := promo(entity.PromoDiscountFixed, 10000)
+		maxRedemptions := 5
+		capped.MaxRedemptions, capped.RedemptionCount = &maxRedemptions, 5
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).Return(nil, nil)
+		mockPromoRepo.EXPECT().FindByAuthorAndCode(ctx, authorID, code).Return(capped, nil)
+
+		_, err := svc.InitPayment(ctx, planReq(false, &code))
+
+		assert.ErrorIs(t, err, service.ErrPromoCodeInvalid)
+	})
 
-	result := svc.VerifySePayWebhookSignature(payload, signature)
+	t.Run("last_redemption_taken_by_concurrent_order", func(t *testing.T) {
+		capped := promo(entity.PromoDiscountFixed, 10000)
+		maxRedemptions := 1
+		capped.MaxRedemptions = &maxRedemptions
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).Return(nil, nil)
+		// The code looked redeemable when loaded but another order reserved it first
+		mockPromoRepo.EXPECT().FindByAuthorAndCode(ctx, authorID, code).Return(capped, nil)
+		mockPromoRepo.EXPECT().ReserveRedemption(ctx, capped.ID).Return(false, nil)
 
-	assert.True(t, result)
+		_, err := svc.InitPayment(ctx, planReq(false, &code))
+
+		assert.ErrorIs(t, err, service.ErrPromoCodeInvalid)
+	})
+
+	t.Run("concurrent_orders_share_capped_promo", func(t *testing.T) {
+		capped := promo(entity.PromoDiscountFixed, 10000)
+		maxRedemptions := 2
+		capped.MaxRedemptions = &maxRedemptions
+		const orders = 8
+
+		// The repository's conditional update is the only thing deciding who gets a redemption
+		var mu sync.Mutex
+		redeemed := 0
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil).Times(orders)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).Return(nil, nil).Times(orders)
+		mockPromoRepo.EXPECT().FindByAuthorAndCode(ctx, authorID, code).Return(capped, nil).Times(orders)
+		mockPromoRepo.EXPECT().ReserveRedemption(ctx, capped.ID).DoAndReturn(func(context.Context, uuid.UUID) (bool, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if redeemed == maxRedemptions {
+				return false, nil
+			}
+			redeemed++
+			return true, nil
+		}).Times(orders)
+		mockSePayAdapter.EXPECT().GetBankTransferInfo().Return(adapter.BankTransferInfo{}).Times(maxRedemptions)
+		mockTxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(maxRedemptions)
+
+		var wg sync.WaitGroup
+		errs := make([]error, orders)
+		for i := 0; i < orders; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = svc.InitPayment(ctx, planReq(false, &code))
+			}(i)
+		}
+		wg.Wait()
+
+		placed := 0
+		for _, err := range errs {
+			if err == nil {
+				placed++
+				continue
+			}
+			assert.ErrorIs(t, err, service.ErrPromoCodeInvalid)
+		}
+		assert.Equal(t, maxRedemptions, placed)
+	})
+
+	t.Run("failed_order_releases_promo", func(t *testing.T) {
+		fixed := promo(entity.PromoDiscountFixed, 10000)
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).Return(nil, nil)
+		mockPromoRepo.EXPECT().FindByAuthorAndCode(ctx, authorID, code).Return(fixed, nil)
+		mockPromoRepo.EXPECT().ReserveRedemption(ctx, fixed.ID).Return(true, nil)
+		mockSePayAdapter.EXPECT().GetBankTransferInfo().Return(adapter.BankTransferInfo{})
+		mockTxRepo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("db down"))
+		mockPromoRepo.EXPECT().ReleaseRedemption(ctx, fixed.ID).Return(nil)
+
+		_, err := svc.InitPayment(ctx, planReq(false, &code))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("promo_for_other_tier_is_rejected", func(t *testing.T) {
+		goldOnly := promo(entity.PromoDiscountPercent, 50)
+		gold := entity.TierGold.String()
+		goldOnly.Tier = &gold
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, authorID).Return(nil, nil)
+		mockPromoRepo.EXPECT().FindByAuthorAndCode(ctx, authorID, code).Return(goldOnly, nil)
+
+		_, err := svc.InitPayment(ctx, planReq(false, &code))
+
+		assert.ErrorIs(t, err, service.ErrPromoCodeNotApplicable)
+	})
+
+	t.Run("promo_on_donation_is_rejected", func(t *testing.T) {
+		req := planReq(false, &code)
+		req.Type, req.Amount = entity.TransactionTypeDonation, decimal.NewFromInt(10000)
+
+		_, err := svc.InitPayment(ctx, req)
+
+		assert.ErrorIs(t, err, service.ErrPromoCodeNotApplicable)
+	})
+
+	t.Run("gift_ignores_payer_subscription", func(t *testing.T) {
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockSePayAdapter.EXPECT().GetBankTransferInfo().Return(adapter.BankTransferInfo{})
+		mockTxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tx *entity.Transaction) error {
+			assert.True(t, tx.Gift)
+			assert.Equal(t, plan.Price.String(), tx.Amount.String())
+			return nil
+		})
+
+		resp, err := svc.InitPayment(ctx, planReq(true, nil))
+
+		assert.NoError(t, err)
+		assert.Equal(t, plan.Price.String(), resp.Amount.String())
+	})
+
+	t.Run("gift_of_own_plan_is_rejected", func(t *testing.T) {
+		req := planReq(true, nil)
+		req.UserID = authorID.String()
+
+		_, err := svc.InitPayment(ctx, req)
+
+		assert.ErrorIs(t, err, service.ErrCannotSubscribeToSelf)
+	})
+
+	t.Run("free_gift_issues_code_right_away", func(t *testing.T) {
+		free := promo(entity.PromoDiscountPercent, 100)
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil).Times(2)
+		mockPromoRepo.EXPECT().FindByAuthorAndCode(ctx, authorID, code).Return(free, nil)
+		mockPromoRepo.EXPECT().ReserveRedemption(ctx, free.ID).Return(true, nil)
+		mockTxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+		mockTxRepo.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mockGiftRepo.EXPECT().WithTx(gomock.Any()).Return(mockGiftRepo)
+		mockGiftRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, gift *entity.GiftSubscription) error {
+			assert.Regexp(t, `^[A-Z0-9]{4}-[A-Z0-9]{4}-[A-Z0-9]{4}$`, gift.Code)
+			assert.Equal(t, userID, gift.PurchaserID)
+			assert.Equal(t, authorID, gift.AuthorID)
+			assert.Equal(t, entity.GiftStatusIssued, gift.Status)
+			return nil
+		})
+		mockLedger.EXPECT().RecordPayment(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		resp, err := svc.InitPayment(ctx, planReq(true, &code))
+
+		assert.NoError(t, err)
+		assert.True(t, resp.Amount.IsZero())
+		assert.Equal(t, entity.TransactionStatusSuccess, resp.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	recipientID := uuid.New()
+	issuedGift := func() *entity.GiftSubscription {
+		return &entity.GiftSubscription{
+			ID:            uuid.New(),
+			Code:          "ABCD-EFGH-JKLM",
+			TransactionID: uuid.New(),
+			PurchaserID:   userID,
+			AuthorID:      authorID,
+			PlanID:        plan.ID,
+			Tier:          plan.Tier.String(),
+			Status:        entity.GiftStatusIssued,
+		}
+	}
+
+	t.Run("redeem_follows_author_and_grants_plan", func(t *testing.T) {
+		gift := issuedGift()
+		sub := &entity.Subscription{ID: uuid.New(), SubscriberID: recipientID, AuthorID: authorID}
+		mockGiftRepo.EXPECT().FindByCode(ctx, "ABCD-EFGH-JKLM").Return(gift, nil)
+
+		sqlMock.ExpectBegin()
+		mockGiftRepo.EXPECT().WithTx(gomock.Any()).Return(mockGiftRepo)
+		mockGiftRepo.EXPECT().Redeem(ctx, gift.ID, recipientID, gomock.Any()).Return(true, nil)
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, recipientID, authorID).Return(nil, nil)
+		mockSubRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockPlanRepo.EXPECT().WithTx(gomock.Any()).Return(mockPlanRepo)
+		mockOutboxRepo.EXPECT().WithTx(gomock.Any()).Return(mockOutboxRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+		mockPlanRepo.EXPECT().FindByID(ctx, plan.ID).Return(plan, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, recipientID, authorID).Return(sub, nil)
+		mockSubRepo.EXPECT().UpdateExpiry(ctx, recipientID, authorID, gomock.Any(), entity.TierSilver.String()).Return(nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			// Tied to the purchase so refunding the gift takes the period back
+			assert.Equal(t, &gift.TransactionID, e.TransactionID)
+			assert.Equal(t, entity.SubscriptionEventActivated, e.Type)
+			return nil
+		})
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		result, err := svc.RedeemGift(ctx, recipientID, "abcd efgh jklm")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.GiftStatusRedeemed, result.Status)
+		assert.Equal(t, &recipientID, result.RedeemedBy)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("redeem_race_is_lost", func(t *testing.T) {
+		gift := issuedGift()
+		mockGiftRepo.EXPECT().FindByCode(ctx, gift.Code).Return(gift, nil)
+		sqlMock.ExpectBegin()
+		mockGiftRepo.EXPECT().WithTx(gomock.Any()).Return(mockGiftRepo)
+		mockGiftRepo.EXPECT().Redeem(ctx, gift.ID, recipientID, gomock.Any()).Return(false, nil)
+		sqlMock.ExpectRollback()
+
+		_, err := svc.RedeemGift(ctx, recipientID, gift.Code)
+
+		assert.ErrorIs(t, err, service.ErrGiftNotRedeemable)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("redeemed_gift_is_rejected", func(t *testing.T) {
+		gift := issuedGift()
+		gift.Status = entity.GiftStatusRedeemed
+		mockGiftRepo.EXPECT().FindByCode(ctx, gift.Code).Return(gift, nil)
+
+		_, err := svc.RedeemGift(ctx, recipientID, gift.Code)
+
+		assert.ErrorIs(t, err, service.ErrGiftNotRedeemable)
+	})
+
+	t.Run("purchaser_cannot_redeem", func(t *testing.T) {
+		gift := issuedGift()
+		mockGiftRepo.EXPECT().FindByCode(ctx, gift.Code).Return(gift, nil)
+
+		_, err := svc.RedeemGift(ctx, userID, gift.Code)
+
+		assert.ErrorIs(t, err, service.ErrOwnGift)
+	})
+
+	t.Run("unknown_code", func(t *testing.T) {
+		mockGiftRepo.EXPECT().FindByCode(ctx, "NOPE").Return(nil, nil)
+
+		_, err := svc.RedeemGift(ctx, recipientID, "nope")
+
+		assert.ErrorIs(t, err, service.ErrGiftNotFound)
+	})
 }
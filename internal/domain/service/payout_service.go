@@ -0,0 +1,188 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPayoutNotFound      = errors.New("payout not found")
+	ErrPayoutPending       = errors.New("a payout request is already waiting for review")
+	ErrPayoutReviewed      = errors.New("payout is already reviewed")
+	ErrInvalidPayoutAmount = errors.New("payout amount is below the minimum")
+	ErrInsufficientBalance = errors.New("payout amount exceeds the available balance")
+)
+
+// payoutCurrency is the currency payouts are sent to Vietnamese bank accounts in
+const payoutCurrency = "VND"
+
+// PayoutRequest describes an author's withdrawal to a bank account
+type PayoutRequest struct {
+	Amount      decimal.Decimal
+	BankName    string
+	AccountNo   string
+	AccountName string
+}
+
+// PayoutService handles author payout requests and their review by admins
+type PayoutService interface {
+	// RequestPayout asks for part of the author's balance to be sent to a bank account
+	RequestPayout(ctx context.Context, authorID uuid.UUID, req PayoutRequest) (*entity.Payout, error)
+
+	ListPayouts(ctx context.Context, filter repository.PayoutFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.Payout], error)
+
+	// ReviewPayout approves or rejects a pending payout. Approving records that the money was
+	// sent and debits the author's balance.
+	ReviewPayout(ctx context.Context, id, adminID uuid.UUID, approve bool, note, reference *string) (*entity.Payout, error)
+}
+
+type payoutService struct {
+	db         *gorm.DB
+	payoutRepo repository.PayoutRepository
+	ledger     LedgerService
+	minPayout  decimal.Decimal
+}
+
+// NewPayoutService creates a new instance of PayoutService
+func NewPayoutService(
+	db *gorm.DB,
+	payoutRepo repository.PayoutRepository,
+	ledger LedgerService,
+	minPayout decimal.Decimal,
+) PayoutService {
+	return &payoutService{
+		db:         db,
+		payoutRepo: payoutRepo,
+		ledger:     ledger,
+		minPayout:  minPayout,
+	}
+}
+
+// RequestPayout records a payout request once the balance covers it
+func (s *payoutService) RequestPayout(ctx context.Context, authorID uuid.UUID, req PayoutRequest) (*entity.Payout, error) {
+	if !req.Amount.IsPositive() || req.Amount.LessThan(s.minPayout) {
+		return nil, ErrInvalidPayoutAmount
+	}
+
+	pending, err := s.payoutRepo.FindPendingByAuthor(ctx, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pending payout: %w", err)
+	}
+	if pending != nil {
+		return nil, ErrPayoutPending
+	}
+
+	// Only one request is pending at a time, so the balance is not reserved
+	if err := s.checkBalance(ctx, authorID, req.Amount); err != nil {
+		return nil, err
+	}
+
+	payout := &entity.Payout{
+		AuthorID:    authorID,
+		Amount:      req.Amount,
+		Currency:    payoutCurrency,
+		BankName:    req.BankName,
+		AccountNo:   req.AccountNo,
+		AccountName: req.AccountName,
+		Status:      entity.PayoutStatusPending,
+	}
+	// A concurrent request may have passed the check above; the pending index lets only one in
+	created, err := s.payoutRepo.CreatePending(ctx, payout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payout: %w", err)
+	}
+	if !created {
+		return nil, ErrPayoutPending
+	}
+
+	logger.Info("Payout requested", map[string]interface{}{
+		"payoutId": payout.ID,
+		"authorId": authorID,
+		"amount":   payout.Amount,
+	})
+	return payout, nil
+}
+
+// ListPayouts returns payouts, most recent first
+func (s *payoutService) ListPayouts(ctx context.Context, filter repository.PayoutFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.Payout], error) {
+	return s.payoutRepo.FindAll(ctx, filter, pagination)
+}
+
+// ReviewPayout closes a pending payout and posts approved ones to the ledger
+func (s *payoutService) ReviewPayout(ctx context.Context, id, adminID uuid.UUID, approve bool, note, reference *string) (*entity.Payout, error) {
+	payout, err := s.payoutRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load payout: %w", err)
+	}
+	if payout == nil {
+		return nil, ErrPayoutNotFound
+	}
+	if !payout.IsPending() {
+		return nil, ErrPayoutReviewed
+	}
+
+	status := entity.PayoutStatusRejected
+	if approve {
+		// Refunds since the request may have lowered the balance
+		if err := s.checkBalance(ctx, payout.AuthorID, payout.Amount); err != nil {
+			return nil, err
+		}
+		status = entity.PayoutStatusApproved
+	}
+
+	now := time.Now()
+	err = s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		reviewed, err := s.payoutRepo.WithTx(dbTx).Review(ctx, payout.ID, status, adminID, note, reference, now)
+		if err != nil {
+			return fmt.Errorf("failed to review payout: %w", err)
+		}
+		if !reviewed {
+			return ErrPayoutReviewed // Reviewed concurrently
+		}
+		if approve {
+			return s.ledger.RecordPayout(ctx, dbTx, payout)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Payout reviewed", map[string]interface{}{
+		"payoutId": payout.ID,
+		"authorId": payout.AuthorID,
+		"status":   status,
+		"adminId":  adminID,
+	})
+
+	payout.Status = status
+	payout.Note = note
+	payout.Reference = reference
+	payout.ReviewedBy = &adminID
+	payout.ReviewedAt = &now
+	payout.UpdatedAt = now
+	return payout, nil
+}
+
+// checkBalance returns ErrInsufficientBalance if the author's balance does not cover amount
+func (s *payoutService) checkBalance(ctx context.Context, authorID uuid.UUID, amount decimal.Decimal) error {
+	balance, err := s.ledger.AuthorBalance(ctx, authorID, payoutCurrency)
+	if err != nil {
+		return fmt.Errorf("failed to load balance: %w", err)
+	}
+	if amount.GreaterThan(balance) {
+		return ErrInsufficientBalance
+	}
+	return nil
+}
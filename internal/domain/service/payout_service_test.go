@@ -0,0 +1,190 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	servicemocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestPayoutService_RequestPayout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	payoutRepo := mocks.NewMockPayoutRepository(ctrl)
+	ledger := servicemocks.NewMockLedgerService(ctrl)
+	svc := service.NewPayoutService(nil, payoutRepo, ledger, decimal.NewFromInt(100000))
+
+	authorID := uuid.New()
+	request := func(amount int64) service.PayoutRequest {
+		return service.PayoutRequest{
+			Amount:      decimal.NewFromInt(amount),
+			BankName:    "MBBank",
+			AccountNo:   "0123456789",
+			AccountName: "NGUYEN VAN A",
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		payoutRepo.EXPECT().FindPendingByAuthor(ctx, authorID).Return(nil, nil)
+		ledger.EXPECT().AuthorBalance(ctx, authorID, "VND").Return(decimal.NewFromInt(250000), nil)
+		payoutRepo.EXPECT().CreatePending(ctx, gomock.Any()).Return(true, nil)
+
+		payout, err := svc.RequestPayout(ctx, authorID, request(250000))
+
+		require.NoError(t, err)
+		assert.Equal(t, authorID, payout.AuthorID)
+		assert.Equal(t, entity.PayoutStatusPending, payout.Status)
+		assert.Equal(t, "VND", payout.Currency)
+	})
+
+	t.Run("below_minimum", func(t *testing.T) {
+		_, err := svc.RequestPayout(ctx, authorID, request(99999))
+
+		assert.ErrorIs(t, err, service.ErrInvalidPayoutAmount)
+	})
+
+	t.Run("one_pending_at_a_time", func(t *testing.T) {
+		payoutRepo.EXPECT().FindPendingByAuthor(ctx, authorID).Return(&entity.Payout{Status: entity.PayoutStatusPending}, nil)
+
+		_, err := svc.RequestPayout(ctx, authorID, request(100000))
+
+		assert.ErrorIs(t, err, service.ErrPayoutPending)
+	})
+
+	t.Run("concurrent_request_already_pending", func(t *testing.T) {
+		// Both requests saw no pending payout; the unique index rejects the second insert
+		payoutRepo.EXPECT().FindPendingByAuthor(ctx, authorID).Return(nil, nil)
+		ledger.EXPECT().AuthorBalance(ctx, authorID, "VND").Return(decimal.NewFromInt(250000), nil)
+		payoutRepo.EXPECT().CreatePending(ctx, gomock.Any()).Return(false, nil)
+
+		_, err := svc.RequestPayout(ctx, authorID, request(250000))
+
+		assert.ErrorIs(t, err, service.ErrPayoutPending)
+	})
+
+	t.Run("above_balance", func(t *testing.T) {
+		payoutRepo.EXPECT().FindPendingByAuthor(ctx, authorID).Return(nil, nil)
+		ledger.EXPECT().AuthorBalance(ctx, authorID, "VND").Return(decimal.NewFromInt(100000), nil)
+
+		_, err := svc.RequestPayout(ctx, authorID, request(100001))
+
+		assert.ErrorIs(t, err, service.ErrInsufficientBalance)
+	})
+}
+
+func TestPayoutService_ReviewPayout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	payoutRepo := mocks.NewMockPayoutRepository(ctrl)
+	ledger := servicemocks.NewMockLedgerService(ctrl)
+
+	db, sqlMock, _ := sqlmock.New()
+	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+	svc := service.NewPayoutService(gormDB, payoutRepo, ledger, decimal.NewFromInt(100000))
+
+	adminID := uuid.New()
+	pending := func() *entity.Payout {
+		return &entity.Payout{
+			ID:       uuid.New(),
+			AuthorID: uuid.New(),
+			Amount:   decimal.NewFromInt(150000),
+			Currency: "VND",
+			Status:   entity.PayoutStatusPending,
+		}
+	}
+	reference := "FT26289123"
+
+	t.Run("approve_debits_the_author", func(t *testing.T) {
+		payout := pending()
+
+		payoutRepo.EXPECT().FindByID(ctx, payout.ID).Return(payout, nil)
+		ledger.EXPECT().AuthorBalance(ctx, payout.AuthorID, "VND").Return(decimal.NewFromInt(150000), nil)
+		sqlMock.ExpectBegin()
+		payoutRepo.EXPECT().WithTx(gomock.Any()).Return(payoutRepo)
+		payoutRepo.EXPECT().Review(ctx, payout.ID, entity.PayoutStatusApproved, adminID, nil, &reference, gomock.Any()).Return(true, nil)
+		ledger.EXPECT().RecordPayout(ctx, gomock.Any(), payout).Return(nil)
+		sqlMock.ExpectCommit()
+
+		reviewed, err := svc.ReviewPayout(ctx, payout.ID, adminID, true, nil, &reference)
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.PayoutStatusApproved, reviewed.Status)
+		assert.Equal(t, adminID, *reviewed.ReviewedBy)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("reject_leaves_the_balance", func(t *testing.T) {
+		payout := pending()
+		note := "account name does not match"
+
+		payoutRepo.EXPECT().FindByID(ctx, payout.ID).Return(payout, nil)
+		sqlMock.ExpectBegin()
+		payoutRepo.EXPECT().WithTx(gomock.Any()).Return(payoutRepo)
+		payoutRepo.EXPECT().Review(ctx, payout.ID, entity.PayoutStatusRejected, adminID, &note, nil, gomock.Any()).Return(true, nil)
+		sqlMock.ExpectCommit()
+
+		reviewed, err := svc.ReviewPayout(ctx, payout.ID, adminID, false, &note, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.PayoutStatusRejected, reviewed.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("balance_lowered_by_refunds", func(t *testing.T) {
+		payout := pending()
+
+		payoutRepo.EXPECT().FindByID(ctx, payout.ID).Return(payout, nil)
+		ledger.EXPECT().AuthorBalance(ctx, payout.AuthorID, "VND").Return(decimal.NewFromInt(120000), nil)
+
+		_, err := svc.ReviewPayout(ctx, payout.ID, adminID, true, nil, &reference)
+
+		assert.ErrorIs(t, err, service.ErrInsufficientBalance)
+	})
+
+	t.Run("reviewed_concurrently", func(t *testing.T) {
+		payout := pending()
+
+		payoutRepo.EXPECT().FindByID(ctx, payout.ID).Return(payout, nil)
+		sqlMock.ExpectBegin()
+		payoutRepo.EXPECT().WithTx(gomock.Any()).Return(payoutRepo)
+		payoutRepo.EXPECT().Review(ctx, payout.ID, entity.PayoutStatusRejected, adminID, nil, nil, gomock.Any()).Return(false, nil)
+		sqlMock.ExpectRollback()
+
+		_, err := svc.ReviewPayout(ctx, payout.ID, adminID, false, nil, nil)
+
+		assert.ErrorIs(t, err, service.ErrPayoutReviewed)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("already_reviewed", func(t *testing.T) {
+		payout := pending()
+		payout.Status = entity.PayoutStatusApproved
+		payoutRepo.EXPECT().FindByID(ctx, payout.ID).Return(payout, nil)
+
+		_, err := svc.ReviewPayout(ctx, payout.ID, adminID, true, nil, nil)
+
+		assert.ErrorIs(t, err, service.ErrPayoutReviewed)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		id := uuid.New()
+		payoutRepo.EXPECT().FindByID(ctx, id).Return(nil, nil)
+
+		_, err := svc.ReviewPayout(ctx, id, adminID, true, nil, nil)
+
+		assert.ErrorIs(t, err, service.ErrPayoutNotFound)
+	})
+}
@@ -0,0 +1,135 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrPromoCodeNotFound    = errors.New("promo code not found")
+	ErrPromoCodeExists      = errors.New("promo code already exists")
+	ErrInvalidPromoDiscount = errors.New("promo code discount, tier, usage cap or expiry is invalid")
+)
+
+// promoCodePattern is what an author may name a code; it is stored uppercase
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,50}$`)
+
+// CreatePromoCodeRequest describes a discount an author offers on their plans
+type CreatePromoCodeRequest struct {
+	Code           string
+	DiscountType   entity.PromoDiscountType
+	DiscountValue  decimal.Decimal
+	Tier           *entity.SubscriptionTier // Restricts the code to plans of this tier
+	MaxRedemptions *int
+	ExpiresAt      *time.Time
+}
+
+// PromoCodeService manages the promo codes authors offer on their subscription plans
+type PromoCodeService interface {
+	CreatePromoCode(ctx context.Context, authorID uuid.UUID, req CreatePromoCodeRequest) (*entity.PromoCode, error)
+	ListPromoCodes(ctx context.Context, authorID uuid.UUID) ([]entity.PromoCode, error)
+	// DeactivatePromoCode stops a code from being applied to new orders
+	DeactivatePromoCode(ctx context.Context, authorID, id uuid.UUID) error
+}
+
+type promoCodeService struct {
+	promoRepo repository.PromoCodeRepository
+}
+
+// NewPromoCodeService creates a new instance of PromoCodeService
+func NewPromoCodeService(promoRepo repository.PromoCodeRepository) PromoCodeService {
+	return &promoCodeService{promoRepo: promoRepo}
+}
+
+// CreatePromoCode validates and stores a new code of the author
+func (s *promoCodeService) CreatePromoCode(ctx context.Context, authorID uuid.UUID, req CreatePromoCodeRequest) (*entity.PromoCode, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if !promoCodePattern.MatchString(code) {
+		return nil, ErrInvalidPromoDiscount
+	}
+	if err := validatePromoDiscount(req, time.Now()); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.promoRepo.FindByAuthorAndCode(ctx, authorID, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load promo code: %w", err)
+	}
+	if existing != nil {
+		return nil, ErrPromoCodeExists
+	}
+
+	promo := &entity.PromoCode{
+		AuthorID:       authorID,
+		Code:           code,
+		DiscountType:   req.DiscountType,
+		DiscountValue:  req.DiscountValue,
+		MaxRedemptions: req.MaxRedemptions,
+		ExpiresAt:      req.ExpiresAt,
+		IsActive:       true,
+	}
+	if req.Tier != nil {
+		tier := req.Tier.String()
+		promo.Tier = &tier
+	}
+	if err := s.promoRepo.Create(ctx, promo); err != nil {
+		return nil, fmt.Errorf("failed to create promo code: %w", err)
+	}
+	return promo, nil
+}
+
+// validatePromoDiscount checks the discount is a percentage up to 100 or a positive amount,
+// restricted to a paid tier if any, with a positive cap and an expiry after now
+func validatePromoDiscount(req CreatePromoCodeRequest, now time.Time) error {
+	if !req.DiscountValue.IsPositive() {
+		return ErrInvalidPromoDiscount
+	}
+	switch req.DiscountType {
+	case entity.PromoDiscountPercent:
+		if req.DiscountValue.GreaterThan(decimal.NewFromInt(100)) {
+			return ErrInvalidPromoDiscount
+		}
+	case entity.PromoDiscountFixed:
+	default:
+		return ErrInvalidPromoDiscount
+	}
+
+	if req.Tier != nil && (!req.Tier.IsValid() || *req.Tier == entity.TierFree) {
+		return ErrInvalidPromoDiscount
+	}
+	if req.MaxRedemptions != nil && *req.MaxRedemptions <= 0 {
+		return ErrInvalidPromoDiscount
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return ErrInvalidPromoDiscount
+	}
+	return nil
+}
+
+// ListPromoCodes returns the codes of an author, most recent first
+func (s *promoCodeService) ListPromoCodes(ctx context.Context, authorID uuid.UUID) ([]entity.PromoCode, error) {
+	return s.promoRepo.FindByAuthor(ctx, authorID)
+}
+
+// DeactivatePromoCode deactivates one of the author's codes
+func (s *promoCodeService) DeactivatePromoCode(ctx context.Context, authorID, id uuid.UUID) error {
+	deactivated, err := s.promoRepo.Deactivate(ctx, id, authorID)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate promo code: %w", err)
+	}
+	if !deactivated {
+		return ErrPromoCodeNotFound
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPromoCodeService_CreatePromoCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	promoRepo := mocks.NewMockPromoCodeRepository(ctrl)
+	svc := service.NewPromoCodeService(promoRepo)
+
+	authorID := uuid.New()
+	gold := entity.TierGold
+	free := entity.TierFree
+	zero, cap := 0, 100
+	past := time.Now().Add(-time.Hour)
+	request := func(discountType entity.PromoDiscountType, value int64) service.CreatePromoCodeRequest {
+		return service.CreatePromoCodeRequest{
+			Code:          " launch-2026 ",
+			DiscountType:  discountType,
+			DiscountValue: decimal.NewFromInt(value),
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		req := request(entity.PromoDiscountPercent, 20)
+		req.Tier, req.MaxRedemptions = &gold, &cap
+		promoRepo.EXPECT().FindByAuthorAndCode(ctx, authorID, "LAUNCH-2026").Return(nil, nil)
+		promoRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		promo, err := svc.CreatePromoCode(ctx, authorID, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "LAUNCH-2026", promo.Code)
+		assert.Equal(t, "GOLD", *promo.Tier)
+		assert.True(t, promo.IsActive)
+	})
+
+	t.Run("duplicate_code", func(t *testing.T) {
+		promoRepo.EXPECT().FindByAuthorAndCode(ctx, authorID, "LAUNCH-2026").Return(&entity.PromoCode{ID: uuid.New()}, nil)
+
+		_, err := svc.CreatePromoCode(ctx, authorID, request(entity.PromoDiscountFixed, 10000))
+
+		assert.ErrorIs(t, err, service.ErrPromoCodeExists)
+	})
+
+	invalid := map[string]func(*service.CreatePromoCodeRequest){
+		"code_with_spaces":      func(r *service.CreatePromoCodeRequest) { r.Code = "TWO WORDS" },
+		"percent_above_100":     func(r *service.CreatePromoCodeRequest) { r.DiscountValue = decimal.NewFromInt(101) },
+		"zero_discount":         func(r *service.CreatePromoCodeRequest) { r.DiscountValue = decimal.Zero },
+		"unknown_discount_type": func(r *service.CreatePromoCodeRequest) { r.DiscountType = "bogo" },
+		"free_tier":             func(r *service.CreatePromoCodeRequest) { r.Tier = &free },
+		"zero_cap":              func(r *service.CreatePromoCodeRequest) { r.MaxRedemptions = &zero },
+		"already_expired":       func(r *service.CreatePromoCodeRequest) { r.ExpiresAt = &past },
+	}
+	for name, mutate := range invalid {
+		t.Run(name, func(t *testing.T) {
+			req := request(entity.PromoDiscountPercent, 20)
+			mutate(&req)
+
+			_, err := svc.CreatePromoCode(ctx, authorID, req)
+
+			assert.ErrorIs(t, err, service.ErrInvalidPromoDiscount)
+		})
+	}
+}
+
+func TestPromoCodeService_DeactivatePromoCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	promoRepo := mocks.NewMockPromoCodeRepository(ctrl)
+	svc := service.NewPromoCodeService(promoRepo)
+
+	authorID, id := uuid.New(), uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		promoRepo.EXPECT().Deactivate(ctx, id, authorID).Return(true, nil)
+
+		assert.NoError(t, svc.DeactivatePromoCode(ctx, authorID, id))
+	})
+
+	t.Run("code_of_other_author", func(t *testing.T) {
+		promoRepo.EXPECT().Deactivate(ctx, id, authorID).Return(false, nil)
+
+		assert.ErrorIs(t, svc.DeactivatePromoCode(ctx, authorID, id), service.ErrPromoCodeNotFound)
+	})
+}
@@ -5,6 +5,7 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
@@ -66,7 +67,14 @@ func (s *recommendationService) GetPersonalizedFeed(ctx context.Context, userID
 		return nil, err
 	}
 
-	filter := repository.BlogFilter{}
+	// Only live posts the user may read, never drafts or posts scheduled for later
+	status := entity.BlogStatusPublished
+	now := time.Now()
+	filter := repository.BlogFilter{
+		Status:          &status,
+		PublishedBefore: &now,
+		Access:          &repository.BlogAccess{ViewerID: &userID},
+	}
 
 	// 2. If user has interests, filter by them
 	if len(interests) > 0 {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
@@ -32,17 +33,15 @@ func TestGetPersonalizedFeed_WithInterests(t *testing.T) {
 		{ID: tagID, Name: "Go", Slug: "go"},
 	}, nil)
 
-	// Construct expected filter
-	expectedFilter := repository.BlogFilter{
-		TagIDs: []uuid.UUID{tagID},
-	}
-	// We might need to ensure other fields match what the service produces (zero values).
-	// The service creates `filter := repository.BlogFilter{}` and sets TagIDs.
-
-	mockBlogRepo.EXPECT().FindAll(gomock.Any(), expectedFilter, gomock.Any()).Return(&repository.PaginatedResult[entity.Blog]{
-		Data:  []entity.Blog{{Title: "Go Blog"}},
-		Total: 1,
-	}, nil)
+	mockBlogRepo.EXPECT().FindAll(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter repository.BlogFilter, _ repository.Pagination) (*repository.PaginatedResult[entity.Blog], error) {
+			assert.Equal(t, []uuid.UUID{tagID}, filter.TagIDs)
+			assertLiveFeedFilter(t, filter, userID)
+			return &repository.PaginatedResult[entity.Blog]{
+				Data:  []entity.Blog{{Title: "Go Blog"}},
+				Total: 1,
+			}, nil
+		})
 
 	// Act
 	result, err := svc.GetPersonalizedFeed(context.Background(), userID, repository.Pagination{Page: 1, PageSize: 10})
@@ -68,13 +67,15 @@ func TestGetPersonalizedFeed_NoInterests_Fallback(t *testing.T) {
 	// Expectations
 	mockUserRepo.EXPECT().GetInterests(gomock.Any(), userID).Return([]entity.Tag{}, nil)
 
-	// Expected filter is empty (zero value)
-	expectedFilter := repository.BlogFilter{}
-
-	mockBlogRepo.EXPECT().FindAll(gomock.Any(), expectedFilter, gomock.Any()).Return(&repository.PaginatedResult[entity.Blog]{
-		Data:  []entity.Blog{{Title: "Recent Blog"}},
-		Total: 1,
-	}, nil)
+	mockBlogRepo.EXPECT().FindAll(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter repository.BlogFilter, _ repository.Pagination) (*repository.PaginatedResult[entity.Blog], error) {
+			assert.Empty(t, filter.TagIDs)
+			assertLiveFeedFilter(t, filter, userID)
+			return &repository.PaginatedResult[entity.Blog]{
+				Data:  []entity.Blog{{Title: "Recent Blog"}},
+				Total: 1,
+			}, nil
+		})
 
 	// Act
 	result, err := svc.GetPersonalizedFeed(context.Background(), userID, repository.Pagination{Page: 1, PageSize: 10})
@@ -85,6 +86,14 @@ func TestGetPersonalizedFeed_NoInterests_Fallback(t *testing.T) {
 	assert.Equal(t, "Recent Blog", result.Data[0].Title)
 }
 
+// assertLiveFeedFilter checks the feed only asks for live posts the user may read
+func assertLiveFeedFilter(t *testing.T, filter repository.BlogFilter, userID uuid.UUID) {
+	t.Helper()
+	assert.Equal(t, entity.BlogStatusPublished, *filter.Status)
+	assert.WithinDuration(t, time.Now(), *filter.PublishedBefore, time.Minute)
+	assert.Equal(t, &userID, filter.Access.ViewerID)
+}
+
 func TestGetRelatedBlogs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,461 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/infrastructure/adapter"
+	"github.com/aiagent/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTransactionNotFound      = errors.New("transaction not found")
+	ErrTransactionNotRefundable = errors.New("transaction is not paid or already fully refunded")
+	ErrInvalidRefundAmount      = errors.New("refund amount must be positive and not exceed the refundable amount")
+	ErrRefundConflict           = errors.New("transaction was refunded concurrently, retry with the current amount")
+	ErrRefundProviderMissing    = errors.New("payment provider of the transaction is not configured")
+	ErrRefundDeclined           = errors.New("payment provider declined the refund")
+
+	// errRefundSettled stops completing a pending refund another run completed first
+	errRefundSettled = errors.New("refund already settled")
+)
+
+// pendingRefundRetryAfter keeps RetryPendingRefunds away from refunds a request is still sending
+const pendingRefundRetryAfter = time.Minute
+
+// RefundCommand describes money returned for a transaction
+type RefundCommand struct {
+	Kind        entity.RefundKind
+	Amount      *decimal.Decimal // Nil refunds everything not refunded yet
+	Reason      string
+	ProviderRef *string // Set for money already returned outside the provider, which is then not called
+}
+
+// RefundService records refunds and chargebacks and revokes what the refunded payment granted
+type RefundService interface {
+	// RefundTransaction returns money for a paid transaction. Refunds of card payments are sent
+	// through their provider; bank transfers are returned by hand. Once nothing is left to refund,
+	// or on a chargeback, the subscription period, gift code or series purchase it paid for is revoked.
+	// A card refund whose outcome is unknown, because the provider could not be reached, is
+	// returned pending and left to RetryPendingRefunds.
+	RefundTransaction(ctx context.Context, transactionID, adminID uuid.UUID, cmd RefundCommand) (*entity.PaymentRefund, error)
+
+	// RetryPendingRefunds sends up to limit pending refunds to their provider again, with the same
+	// idempotency key, and applies the ones it confirms. It returns how many were completed.
+	RetryPendingRefunds(ctx context.Context, limit int) (int, error)
+
+	// ListRefunds returns the refund history of a transaction, oldest first
+	ListRefunds(ctx context.Context, transactionID uuid.UUID) ([]entity.PaymentRefund, error)
+}
+
+type refundService struct {
+	db           *gorm.DB
+	txRepo       repository.TransactionRepository
+	refundRepo   repository.PaymentRefundRepository
+	subRepo      repository.SubscriptionRepository
+	subEventRepo repository.SubscriptionEventRepository
+	purchaseRepo repository.UserSeriesPurchaseRepository
+	giftRepo     repository.GiftSubscriptionRepository
+	ledger       LedgerService
+	providers    *PaymentProviders
+}
+
+// NewRefundService creates a new instance of RefundService
+func NewRefundService(
+	db *gorm.DB,
+	txRepo repository.TransactionRepository,
+	refundRepo repository.PaymentRefundRepository,
+	subRepo repository.SubscriptionRepository,
+	subEventRepo repository.SubscriptionEventRepository,
+	purchaseRepo repository.UserSeriesPurchaseRepository,
+	giftRepo repository.GiftSubscriptionRepository,
+	ledger LedgerService,
+	providers *PaymentProviders,
+) RefundService {
+	return &refundService{
+		db:           db,
+		txRepo:       txRepo,
+		refundRepo:   refundRepo,
+		subRepo:      subRepo,
+		subEventRepo: subEventRepo,
+		purchaseRepo: purchaseRepo,
+		giftRepo:     giftRepo,
+		ledger:       ledger,
+		providers:    providers,
+	}
+}
+
+// RefundTransaction records a refund or chargeback and revokes the benefit when required
+func (s *refundService) RefundTransaction(ctx context.Context, transactionID, adminID uuid.UUID, cmd RefundCommand) (*entity.PaymentRefund, error) {
+	tx, err := s.txRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transaction: %w", err)
+	}
+	if tx == nil {
+		return nil, ErrTransactionNotFound
+	}
+	if !tx.IsRefundable() {
+		return nil, ErrTransactionNotRefundable
+	}
+
+	remaining := tx.RefundableAmount()
+	amount := remaining
+	if cmd.Amount != nil {
+		amount = *cmd.Amount
+	}
+	if !amount.IsPositive() || amount.GreaterThan(remaining) {
+		return nil, ErrInvalidRefundAmount
+	}
+
+	refund := &entity.PaymentRefund{
+		TransactionID: tx.ID,
+		UserID:        tx.UserID,
+		Kind:          cmd.Kind,
+		Amount:        amount,
+		Currency:      tx.Currency,
+		Reason:        cmd.Reason,
+		ProviderRef:   cmd.ProviderRef,
+		Status:        entity.RefundStatusCompleted,
+		CreatedBy:     adminID,
+	}
+
+	var provider adapter.PaymentProvider
+	if cmd.Kind == entity.RefundKindRefund && cmd.ProviderRef == nil {
+		if provider, err = s.refundProvider(tx); err != nil {
+			return nil, err
+		}
+	}
+
+	if provider == nil {
+		// Chargebacks and money returned by hand are only recorded
+		if err := s.applyRefund(ctx, tx, refund, true); err != nil {
+			return nil, err
+		}
+	} else {
+		// The provider is never called inside a database transaction: the refund is committed as
+		// pending first, so money the provider returned is never lost to a rollback, and its ID
+		// keeps a retried call from refunding twice
+		created, err := s.refundRepo.CreatePending(ctx, refund)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record refund: %w", err)
+		}
+		if !created {
+			return nil, ErrRefundConflict
+		}
+		if err := s.sendRefund(ctx, provider, tx, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Info("Transaction refunded", map[string]interface{}{
+		"transactionId":  tx.ID,
+		"refundId":       refund.ID,
+		"kind":           cmd.Kind,
+		"amount":         amount,
+		"refundStatus":   refund.Status,
+		"benefitRevoked": refund.BenefitRevoked,
+		"adminId":        adminID,
+	})
+
+	return refund, nil
+}
+
+// RetryPendingRefunds sends pending refunds to their provider again and settles them
+func (s *refundService) RetryPendingRefunds(ctx context.Context, limit int) (int, error) {
+	refunds, err := s.refundRepo.FindPending(ctx, time.Now().Add(-pendingRefundRetryAfter), limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load pending refunds: %w", err)
+	}
+
+	completed := 0
+	for i := range refunds {
+		refund := &refunds[i]
+		tx, err := s.txRepo.FindByID(ctx, refund.TransactionID)
+		if err == nil && tx == nil {
+			err = ErrTransactionNotFound
+		}
+		var provider adapter.PaymentProvider
+		if err == nil {
+			provider, err = s.refundProvider(tx)
+		}
+		if err != nil {
+			logger.Error("failed to retry pending refund", err, map[string]interface{}{"refundId": refund.ID})
+			continue
+		}
+
+		if err := s.sendRefund(ctx, provider, tx, refund); err != nil {
+			logger.Error("pending refund was declined", err, map[string]interface{}{"refundId": refund.ID})
+			continue
+		}
+		if !refund.IsPending() {
+			completed++
+		}
+	}
+	return completed, nil
+}
+
+// refundProvider returns the provider that collected tx, or nil for a bank transfer matched by content
+func (s *refundService) refundProvider(tx *entity.Transaction) (adapter.PaymentProvider, error) {
+	if tx.ProviderRef == nil {
+		return nil, nil
+	}
+	provider, ok := s.providers.Get(tx.Provider)
+	if !ok {
+		return nil, ErrRefundProviderMissing
+	}
+	return provider, nil
+}
+
+// sendRefund sends a pending refund to the provider and completes it once the provider confirms.
+// It only returns an error when the provider declined the refund, which then fails; when the
+// outcome is unknown the refund stays pending for RetryPendingRefunds.
+func (s *refundService) sendRefund(ctx context.Context, provider adapter.PaymentProvider, tx *entity.Transaction, refund *entity.PaymentRefund) error {
+	var providerRef *string
+	result, err := provider.Refund(ctx, *tx.ProviderRef, refund.Amount, tx.Currency, refund.ID.String())
+	switch {
+	case errors.Is(err, adapter.ErrRefundNotSupported):
+		// Returned by hand, like a bank transfer
+	case errors.Is(err, adapter.ErrRefundDeclined), errors.Is(err, adapter.ErrProviderPaymentNotFound):
+		if _, failErr := s.refundRepo.Fail(ctx, refund.ID); failErr != nil {
+			return fmt.Errorf("failed to record declined refund: %w", failErr)
+		}
+		refund.Status = entity.RefundStatusFailed
+		return fmt.Errorf("%w: %v", ErrRefundDeclined, err)
+	case err != nil:
+		logger.Error("refund left pending for reconciliation", err, map[string]interface{}{
+			"refundId":      refund.ID,
+			"transactionId": tx.ID,
+			"provider":      tx.Provider,
+		})
+		return nil
+	default:
+		providerRef = &result.ProviderRef
+	}
+
+	// The money is back with the payer: from here on a failure leaves the refund pending and the
+	// retry gets the same provider refund back through the idempotency key
+	current, err := s.txRepo.FindByID(ctx, tx.ID)
+	if err == nil && current == nil {
+		err = ErrTransactionNotFound
+	}
+	if err == nil {
+		refund.ProviderRef = providerRef
+		err = s.applyRefund(ctx, current, refund, false)
+	}
+	if err != nil {
+		logger.Error("refund sent but not applied, left pending for reconciliation", err, map[string]interface{}{
+			"refundId":      refund.ID,
+			"transactionId": tx.ID,
+		})
+	}
+	return nil
+}
+
+// applyRefund takes the refund off tx, revokes the benefit when nothing is left or on a chargeback,
+// and posts the refund to the ledger, all in one database transaction. A new refund is recorded
+// along; a pending one is completed, and left as is when it was completed in the meantime.
+func (s *refundService) applyRefund(ctx context.Context, tx *entity.Transaction, refund *entity.PaymentRefund, create bool) error {
+	remaining := tx.RefundableAmount()
+	if refund.Amount.GreaterThan(remaining) {
+		return ErrRefundConflict
+	}
+	refunded := tx.RefundedAmount.Add(refund.Amount)
+	status := entity.TransactionStatusPartiallyRefunded
+	if refund.Amount.Equal(remaining) {
+		status = entity.TransactionStatusRefunded
+	}
+
+	history, err := s.refundRepo.FindByTransactionID(ctx, tx.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load refunds: %w", err)
+	}
+	alreadyRevoked := false
+	for _, r := range history {
+		alreadyRevoked = alreadyRevoked || r.BenefitRevoked
+	}
+	// A partial refund is a goodwill gesture; the benefit goes once the payment is gone
+	revoke := !alreadyRevoked && (status == entity.TransactionStatusRefunded || refund.Kind == entity.RefundKindChargeback)
+
+	err = s.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		if !create {
+			completed, err := s.refundRepo.WithTx(dbTx).Complete(ctx, refund.ID, refund.ProviderRef, revoke)
+			if err != nil {
+				return fmt.Errorf("failed to complete refund: %w", err)
+			}
+			if !completed {
+				return errRefundSettled
+			}
+		}
+
+		applied, err := s.txRepo.WithTx(dbTx).ApplyRefund(ctx, tx.ID, tx.RefundedAmount, refunded, status)
+		if err != nil {
+			return fmt.Errorf("failed to update transaction: %w", err)
+		}
+		if !applied {
+			return ErrRefundConflict
+		}
+
+		if revoke {
+			if err := s.revokeBenefit(ctx, dbTx, tx); err != nil {
+				return err
+			}
+		}
+
+		if create {
+			refund.BenefitRevoked = revoke
+			if err := s.refundRepo.WithTx(dbTx).Create(ctx, refund); err != nil {
+				return fmt.Errorf("failed to record refund: %w", err)
+			}
+		}
+		// Takes back the author's share and the platform fee in proportion
+		return s.ledger.RecordRefund(ctx, dbTx, tx, refund)
+	})
+	if errors.Is(err, errRefundSettled) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	refund.Status = entity.RefundStatusCompleted
+	refund.BenefitRevoked = revoke
+	return nil
+}
+
+// revokeBenefit takes back what the transaction granted within dbTx
+func (s *refundService) revokeBenefit(ctx context.Context, dbTx *gorm.DB, tx *entity.Transaction) error {
+	if tx.TargetID == nil {
+		return nil // Nothing was granted without a target
+	}
+
+	switch tx.Type {
+	case entity.TransactionTypeSeries:
+		if err := s.purchaseRepo.WithTx(dbTx).Delete(ctx, tx.UserID, *tx.TargetID); err != nil {
+			return fmt.Errorf("failed to revoke series purchase: %w", err)
+		}
+	case entity.TransactionTypeSubscription:
+		if tx.Gift {
+			// A gift code nobody redeemed yet is voided; a redeemed one is revoked from its recipient
+			revoked, err := s.giftRepo.WithTx(dbTx).Revoke(ctx, tx.ID)
+			if err != nil {
+				return fmt.Errorf("failed to revoke gift: %w", err)
+			}
+			if revoked {
+				return nil
+			}
+		}
+		return s.revokeSubscription(ctx, dbTx, tx)
+	}
+	return nil
+}
+
+// revokeSubscription rolls back the period paid by tx and records it in the subscription history
+func (s *refundService) revokeSubscription(ctx context.Context, dbTx *gorm.DB, tx *entity.Transaction) error {
+	subRepo := s.subRepo.WithTx(dbTx)
+	subEventRepo := s.subEventRepo.WithTx(dbTx)
+
+	paid, err := subEventRepo.FindPaidByTransactionID(ctx, tx.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load subscription event: %w", err)
+	}
+	if paid == nil {
+		return nil // The payment never reached the subscription
+	}
+
+	sub, err := subRepo.FindBySubscriberAndAuthor(ctx, paid.SubscriberID, paid.AuthorID)
+	if err != nil {
+		return fmt.Errorf("failed to load subscription: %w", err)
+	}
+	if sub == nil {
+		return nil // Unfollowed since, nothing left to revoke
+	}
+
+	event := &entity.SubscriptionEvent{
+		SubscriptionID:    sub.ID,
+		SubscriberID:      sub.SubscriberID,
+		AuthorID:          sub.AuthorID,
+		Type:              entity.SubscriptionEventRefunded,
+		TransactionID:     &tx.ID,
+		PreviousTier:      &sub.Tier,
+		PreviousExpiresAt: sub.ExpiresAt,
+	}
+
+	if isPendingDowngrade(sub, paid) {
+		if _, err := subRepo.CancelScheduledChange(ctx, sub.ID); err != nil {
+			return fmt.Errorf("failed to cancel tier change: %w", err)
+		}
+		event.Tier, event.ExpiresAt = sub.Tier, sub.ExpiresAt
+	} else {
+		tier, expiresAt, ok := rollbackPeriod(sub, paid, time.Now())
+		if !ok {
+			return nil // Already back to a free follow
+		}
+		if err := subRepo.RevokePeriod(ctx, sub.ID, tier, expiresAt); err != nil {
+			return fmt.Errorf("failed to revoke subscription period: %w", err)
+		}
+		event.Tier, event.ExpiresAt = tier, expiresAt
+	}
+
+	if err := subEventRepo.Create(ctx, event); err != nil {
+		return fmt.Errorf("failed to record subscription event: %w", err)
+	}
+	return nil
+}
+
+// isPendingDowngrade returns true if paid scheduled a downgrade that has not taken effect yet
+func isPendingDowngrade(sub *entity.Subscription, paid *entity.SubscriptionEvent) bool {
+	return paid.Type == entity.SubscriptionEventDowngradeScheduled &&
+		sub.ScheduledExpiresAt != nil && paid.ExpiresAt != nil &&
+		sub.ScheduledExpiresAt.Equal(*paid.ExpiresAt)
+}
+
+// rollbackPeriod returns the tier and expiry sub falls back to once the period recorded by paid
+// is revoked. While sub is still on that period it returns to the state before the payment;
+// if later payments stacked on top, only the paid length is taken off the current expiry.
+// A nil expiry means the subscriber is back to a free follow. ok is false when sub has no paid
+// period left to revoke.
+func rollbackPeriod(sub *entity.Subscription, paid *entity.SubscriptionEvent, now time.Time) (tier string, expiresAt *time.Time, ok bool) {
+	if sub.ExpiresAt == nil || paid.ExpiresAt == nil {
+		return "", nil, false
+	}
+
+	if sub.ExpiresAt.Equal(*paid.ExpiresAt) && sub.Tier == paid.Tier {
+		tier, expiresAt = string(entity.TierFree), paid.PreviousExpiresAt
+		if paid.PreviousTier != nil {
+			tier = *paid.PreviousTier
+		}
+	} else {
+		start := paid.CreatedAt
+		if paid.PreviousExpiresAt != nil && paid.PreviousExpiresAt.After(start) {
+			start = *paid.PreviousExpiresAt
+		}
+		shifted := sub.ExpiresAt.Add(-paid.ExpiresAt.Sub(start))
+		tier, expiresAt = sub.Tier, &shifted
+	}
+
+	if expiresAt == nil || !expiresAt.After(now) {
+		return string(entity.TierFree), nil, true
+	}
+	return tier, expiresAt, true
+}
+
+// ListRefunds returns the refund history of a transaction
+func (s *refundService) ListRefunds(ctx context.Context, transactionID uuid.UUID) ([]entity.PaymentRefund, error) {
+	tx, err := s.txRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transaction: %w", err)
+	}
+	if tx == nil {
+		return nil, ErrTransactionNotFound
+	}
+	return s.refundRepo.FindByTransactionID(ctx, transactionID)
+}
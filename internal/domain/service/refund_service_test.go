@@ -0,0 +1,493 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	servicemocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/aiagent/internal/infrastructure/adapter"
+	adapter_mocks "github.com/aiagent/internal/infrastructure/adapter/mocks"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestRefundService_RefundTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTxRepo := mocks.NewMockTransactionRepository(ctrl)
+	mockRefundRepo := mocks.NewMockPaymentRefundRepository(ctrl)
+	mockSubRepo := mocks.NewMockSubscriptionRepository(ctrl)
+	mockSubEventRepo := mocks.NewMockSubscriptionEventRepository(ctrl)
+	mockPurchaseRepo := mocks.NewMockUserSeriesPurchaseRepository(ctrl)
+	mockLedger := servicemocks.NewMockLedgerService(ctrl)
+	mockCard := adapter_mocks.NewMockPaymentProvider(ctrl)
+	mockGiftRepo := mocks.NewMockGiftSubscriptionRepository(ctrl)
+
+	db, sqlMock, _ := sqlmock.New()
+	gormDB, _ := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+
+	svc := service.NewRefundService(gormDB, mockTxRepo, mockRefundRepo, mockSubRepo, mockSubEventRepo, mockPurchaseRepo, mockGiftRepo, mockLedger, sepayProviders(nil, mockCard))
+
+	ctx := context.Background()
+	adminID := uuid.New()
+	userID := uuid.New()
+	targetID := uuid.New()
+
+	paidTx := func(txType entity.TransactionType) *entity.Transaction {
+		return &entity.Transaction{
+			ID:       uuid.New(),
+			UserID:   userID,
+			Amount:   decimal.NewFromInt(100000),
+			Currency: "VND",
+			Type:     txType,
+			Status:   entity.TransactionStatusSuccess,
+			TargetID: &targetID,
+		}
+	}
+	expectTxRepos := func() {
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockRefundRepo.EXPECT().WithTx(gomock.Any()).Return(mockRefundRepo)
+	}
+	expectSubRepos := func() {
+		mockSubRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubRepo)
+		mockSubEventRepo.EXPECT().WithTx(gomock.Any()).Return(mockSubEventRepo)
+	}
+
+	t.Run("full_refund_removes_series_purchase", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeSeries)
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return(nil, nil)
+		sqlMock.ExpectBegin()
+		expectTxRepos()
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(decimal.Zero), decimalEq(tx.Amount), entity.TransactionStatusRefunded).Return(true, nil)
+		mockPurchaseRepo.EXPECT().WithTx(gomock.Any()).Return(mockPurchaseRepo)
+		mockPurchaseRepo.EXPECT().Delete(ctx, userID, targetID).Return(nil)
+		mockRefundRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordRefund(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		refund, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{
+			Kind:   entity.RefundKindRefund,
+			Reason: "bought by mistake",
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, tx.Amount.Equal(refund.Amount))
+		assert.True(t, refund.BenefitRevoked)
+		assert.Equal(t, adminID, refund.CreatedBy)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("partial_refund_keeps_benefit", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeSeries)
+		amount := decimal.NewFromInt(30000)
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return(nil, nil)
+		sqlMock.ExpectBegin()
+		expectTxRepos()
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(decimal.Zero), decimalEq(amount), entity.TransactionStatusPartiallyRefunded).Return(true, nil)
+		mockRefundRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordRefund(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		refund, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{
+			Kind:   entity.RefundKindRefund,
+			Amount: &amount,
+			Reason: "chapter missing",
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, refund.BenefitRevoked)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	cardTx := func() *entity.Transaction {
+		tx := paidTx(entity.TransactionTypeDonation)
+		ref := "cs_test_1"
+		tx.Amount = decimal.RequireFromString("12.50")
+		tx.Currency = "USD"
+		tx.Provider = entity.TransactionProviderStripe
+		tx.ProviderRef = &ref
+		return tx
+	}
+
+	expectPending := func(tx *entity.Transaction) *uuid.UUID {
+		refundID := uuid.New()
+		mockRefundRepo.EXPECT().CreatePending(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, refund *entity.PaymentRefund) (bool, error) {
+			assert.Equal(t, tx.ID, refund.TransactionID)
+			refund.ID = refundID
+			refund.Status = entity.RefundStatusPending
+			return true, nil
+		})
+		return &refundID
+	}
+
+	t.Run("card_refund_is_sent_through_provider", func(t *testing.T) {
+		tx := cardTx()
+		amount := decimal.RequireFromString("2.50")
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		refundID := expectPending(tx)
+		mockCard.EXPECT().Refund(ctx, "cs_test_1", decimalEq(amount), "USD", refundID.String()).
+			Return(&adapter.ProviderRefund{ProviderRef: "re_1"}, nil)
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return(nil, nil)
+		sqlMock.ExpectBegin()
+		expectTxRepos()
+		mockRefundRepo.EXPECT().Complete(ctx, *refundID, gomock.Any(), false).Return(true, nil)
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(decimal.Zero), decimalEq(amount), entity.TransactionStatusPartiallyRefunded).Return(true, nil)
+		mockLedger.EXPECT().RecordRefund(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		refund, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{
+			Kind:   entity.RefundKindRefund,
+			Amount: &amount,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "re_1", *refund.ProviderRef)
+		assert.Equal(t, entity.RefundStatusCompleted, refund.Status)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("declined_card_refund_fails", func(t *testing.T) {
+		tx := cardTx()
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		refundID := expectPending(tx)
+		mockCard.EXPECT().Refund(ctx, "cs_test_1", gomock.Any(), "USD", refundID.String()).
+			Return(nil, fmt.Errorf("%w: charge already refunded", adapter.ErrRefundDeclined))
+		mockRefundRepo.EXPECT().Fail(ctx, *refundID).Return(true, nil)
+
+		_, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund})
+
+		assert.ErrorIs(t, err, service.ErrRefundDeclined)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("unreachable_provider_leaves_refund_pending", func(t *testing.T) {
+		tx := cardTx()
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		refundID := expectPending(tx)
+		mockCard.EXPECT().Refund(ctx, "cs_test_1", gomock.Any(), "USD", refundID.String()).
+			Return(nil, errors.New("context deadline exceeded"))
+
+		refund, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund})
+
+		// The money may have left: nothing is applied and the refund waits for a retry
+		assert.NoError(t, err)
+		assert.True(t, refund.IsPending())
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("card_refund_already_in_flight", func(t *testing.T) {
+		tx := cardTx()
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().CreatePending(ctx, gomock.Any()).Return(false, nil)
+
+		_, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund})
+
+		assert.ErrorIs(t, err, service.ErrRefundConflict)
+	})
+
+	t.Run("retry_completes_pending_refund_with_same_key", func(t *testing.T) {
+		tx := cardTx()
+		pending := entity.PaymentRefund{
+			ID:            uuid.New(),
+			TransactionID: tx.ID,
+			Kind:          entity.RefundKindRefund,
+			Amount:        tx.Amount,
+			Status:        entity.RefundStatusPending,
+		}
+
+		mockRefundRepo.EXPECT().FindPending(ctx, gomock.Any(), 20).Return([]entity.PaymentRefund{pending}, nil)
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil).Times(2)
+		mockCard.EXPECT().Refund(ctx, "cs_test_1", decimalEq(tx.Amount), "USD", pending.ID.String()).
+			Return(&adapter.ProviderRefund{ProviderRef: "re_1"}, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return([]entity.PaymentRefund{pending}, nil)
+		sqlMock.ExpectBegin()
+		expectTxRepos()
+		mockRefundRepo.EXPECT().Complete(ctx, pending.ID, gomock.Any(), true).Return(true, nil)
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(decimal.Zero), decimalEq(tx.Amount), entity.TransactionStatusRefunded).Return(true, nil)
+		mockLedger.EXPECT().RecordRefund(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		completed, err := svc.RetryPendingRefunds(ctx, 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, completed)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("chargeback_rolls_back_renewal", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeSubscription)
+		previousExpiry := time.Now().Add(10 * 24 * time.Hour)
+		paidExpiry := previousExpiry.AddDate(0, 0, 30)
+		silver := entity.TierSilver.String()
+		sub := &entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: targetID, Tier: silver, ExpiresAt: &paidExpiry}
+		paid := &entity.SubscriptionEvent{
+			Type:              entity.SubscriptionEventRenewed,
+			SubscriberID:      userID,
+			AuthorID:          targetID,
+			Tier:              silver,
+			ExpiresAt:         &paidExpiry,
+			PreviousTier:      &silver,
+			PreviousExpiresAt: &previousExpiry,
+			CreatedAt:         time.Now().Add(-time.Hour),
+		}
+		amount := decimal.NewFromInt(40000)
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return(nil, nil)
+		sqlMock.ExpectBegin()
+		expectTxRepos()
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(decimal.Zero), decimalEq(amount), entity.TransactionStatusPartiallyRefunded).Return(true, nil)
+		expectSubRepos()
+		mockSubEventRepo.EXPECT().FindPaidByTransactionID(ctx, tx.ID).Return(paid, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).Return(sub, nil)
+		mockSubRepo.EXPECT().RevokePeriod(ctx, sub.ID, silver, &previousExpiry).Return(nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, entity.SubscriptionEventRefunded, e.Type)
+			assert.Equal(t, tx.ID, *e.TransactionID)
+			assert.Equal(t, paidExpiry, *e.PreviousExpiresAt)
+			return nil
+		})
+		mockRefundRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordRefund(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		refund, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{
+			Kind:   entity.RefundKindChargeback,
+			Amount: &amount,
+			Reason: "disputed with the bank",
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, refund.BenefitRevoked)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("refund_with_later_renewal_shortens_period", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeSubscription)
+		paidAt := time.Now().Add(-24 * time.Hour)
+		paidExpiry := paidAt.AddDate(0, 0, 30)
+		currentExpiry := paidExpiry.AddDate(0, 0, 30) // Renewed again afterwards
+		expected := currentExpiry.Add(-paidExpiry.Sub(paidAt))
+		free := string(entity.TierFree)
+		sub := &entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: targetID, Tier: "GOLD", ExpiresAt: &currentExpiry}
+		paid := &entity.SubscriptionEvent{
+			Type:         entity.SubscriptionEventActivated,
+			SubscriberID: userID,
+			AuthorID:     targetID,
+			Tier:         "GOLD",
+			ExpiresAt:    &paidExpiry,
+			PreviousTier: &free,
+			CreatedAt:    paidAt,
+		}
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return(nil, nil)
+		sqlMock.ExpectBegin()
+		expectTxRepos()
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(decimal.Zero), decimalEq(tx.Amount), entity.TransactionStatusRefunded).Return(true, nil)
+		expectSubRepos()
+		mockSubEventRepo.EXPECT().FindPaidByTransactionID(ctx, tx.ID).Return(paid, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).Return(sub, nil)
+		mockSubRepo.EXPECT().RevokePeriod(ctx, sub.ID, "GOLD", &expected).Return(nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockRefundRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordRefund(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		_, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund, Reason: "goodwill"})
+
+		assert.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("refund_of_first_period_falls_back_to_free", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeSubscription)
+		paidExpiry := time.Now().AddDate(0, 0, 30)
+		free := string(entity.TierFree)
+		sub := &entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: targetID, Tier: "BRONZE", ExpiresAt: &paidExpiry}
+		paid := &entity.SubscriptionEvent{
+			Type:         entity.SubscriptionEventActivated,
+			SubscriberID: userID,
+			AuthorID:     targetID,
+			Tier:         "BRONZE",
+			ExpiresAt:    &paidExpiry,
+			PreviousTier: &free,
+			CreatedAt:    time.Now(),
+		}
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return(nil, nil)
+		sqlMock.ExpectBegin()
+		expectTxRepos()
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(decimal.Zero), decimalEq(tx.Amount), entity.TransactionStatusRefunded).Return(true, nil)
+		expectSubRepos()
+		mockSubEventRepo.EXPECT().FindPaidByTransactionID(ctx, tx.ID).Return(paid, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).Return(sub, nil)
+		mockSubRepo.EXPECT().RevokePeriod(ctx, sub.ID, free, (*time.Time)(nil)).Return(nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockRefundRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordRefund(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		_, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund, Reason: "cancelled"})
+
+		assert.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("refund_cancels_pending_downgrade", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeSubscription)
+		currentExpiry := time.Now().Add(5 * 24 * time.Hour)
+		scheduledExpiry := currentExpiry.AddDate(0, 0, 30)
+		bronze := "BRONZE"
+		sub := &entity.Subscription{ID: uuid.New(), SubscriberID: userID, AuthorID: targetID, Tier: "GOLD", ExpiresAt: &currentExpiry,
+			ScheduledTier: &bronze, ScheduledExpiresAt: &scheduledExpiry}
+		paid := &entity.SubscriptionEvent{
+			Type:         entity.SubscriptionEventDowngradeScheduled,
+			SubscriberID: userID,
+			AuthorID:     targetID,
+			Tier:         bronze,
+			ExpiresAt:    &scheduledExpiry,
+		}
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return(nil, nil)
+		sqlMock.ExpectBegin()
+		expectTxRepos()
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(decimal.Zero), decimalEq(tx.Amount), entity.TransactionStatusRefunded).Return(true, nil)
+		expectSubRepos()
+		mockSubEventRepo.EXPECT().FindPaidByTransactionID(ctx, tx.ID).Return(paid, nil)
+		mockSubRepo.EXPECT().FindBySubscriberAndAuthor(ctx, userID, targetID).Return(sub, nil)
+		mockSubRepo.EXPECT().CancelScheduledChange(ctx, sub.ID).Return(true, nil)
+		mockSubEventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, "GOLD", e.Tier)
+			return nil
+		})
+		mockRefundRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordRefund(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		_, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund, Reason: "changed mind"})
+
+		assert.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("refund_voids_unredeemed_gift", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeSubscription)
+		tx.Gift = true
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return(nil, nil)
+		sqlMock.ExpectBegin()
+		expectTxRepos()
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(decimal.Zero), decimalEq(tx.Amount), entity.TransactionStatusRefunded).Return(true, nil)
+		mockGiftRepo.EXPECT().WithTx(gomock.Any()).Return(mockGiftRepo)
+		mockGiftRepo.EXPECT().Revoke(ctx, tx.ID).Return(true, nil)
+		mockRefundRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordRefund(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		refund, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund, Reason: "gift not wanted"})
+
+		assert.NoError(t, err)
+		assert.True(t, refund.BenefitRevoked)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("benefit_revoked_only_once", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeSeries)
+		tx.Status = entity.TransactionStatusPartiallyRefunded
+		tx.RefundedAmount = decimal.NewFromInt(60000)
+		remaining := decimal.NewFromInt(40000)
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return([]entity.PaymentRefund{{Kind: entity.RefundKindChargeback, BenefitRevoked: true}}, nil)
+		sqlMock.ExpectBegin()
+		expectTxRepos()
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(tx.RefundedAmount), decimalEq(tx.Amount), entity.TransactionStatusRefunded).Return(true, nil)
+		mockRefundRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockLedger.EXPECT().RecordRefund(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		sqlMock.ExpectCommit()
+
+		refund, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund, Reason: "rest"})
+
+		assert.NoError(t, err)
+		assert.True(t, remaining.Equal(refund.Amount))
+		assert.False(t, refund.BenefitRevoked)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("concurrent_refund_conflicts", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeDonation)
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+		mockRefundRepo.EXPECT().FindByTransactionID(ctx, tx.ID).Return(nil, nil)
+		sqlMock.ExpectBegin()
+		mockTxRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockTxRepo.EXPECT().ApplyRefund(ctx, tx.ID, decimalEq(decimal.Zero), decimalEq(tx.Amount), entity.TransactionStatusRefunded).Return(false, nil)
+		sqlMock.ExpectRollback()
+
+		_, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund, Reason: "dup"})
+
+		assert.ErrorIs(t, err, service.ErrRefundConflict)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("amount_above_refundable", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeDonation)
+		amount := decimal.NewFromInt(100001)
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+
+		_, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund, Amount: &amount, Reason: "too much"})
+
+		assert.ErrorIs(t, err, service.ErrInvalidRefundAmount)
+	})
+
+	t.Run("unpaid_transaction", func(t *testing.T) {
+		tx := paidTx(entity.TransactionTypeDonation)
+		tx.Status = entity.TransactionStatusPending
+
+		mockTxRepo.EXPECT().FindByID(ctx, tx.ID).Return(tx, nil)
+
+		_, err := svc.RefundTransaction(ctx, tx.ID, adminID, service.RefundCommand{Kind: entity.RefundKindRefund, Reason: "x"})
+
+		assert.ErrorIs(t, err, service.ErrTransactionNotRefundable)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		id := uuid.New()
+		mockTxRepo.EXPECT().FindByID(ctx, id).Return(nil, nil)
+
+		_, err := svc.RefundTransaction(ctx, id, adminID, service.RefundCommand{Kind: entity.RefundKindRefund, Reason: "x"})
+
+		assert.ErrorIs(t, err, service.ErrTransactionNotFound)
+	})
+}
+
+// decimalEq matches decimals by value, ignoring their internal exponent
+func decimalEq(want decimal.Decimal) gomock.Matcher {
+	return gomock.Cond(func(got decimal.Decimal) bool { return got.Equal(want) })
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// ScheduledPublishConfig holds tuning for the scheduled publishing job
+type ScheduledPublishConfig struct {
+	Interval  time.Duration // How often due posts are looked for
+	BatchSize int           // Max blogs loaded per query
+}
+
+// ScheduledPublishJob announces scheduled blogs once their publish time arrives: followers
+// are notified through the BlogPublishedEvent and the published content is kept as a version.
+//
+// A blog is marked announced by a conditional update in the same transaction as its outbox
+// event, so several instances can run the job at once and every blog is announced exactly
+// once. A blog rescheduled or unpublished after it was loaded is left alone.
+type ScheduledPublishJob struct {
+	db             *gorm.DB
+	blogRepo       repository.BlogRepository
+	outboxRepo     repository.OutboxRepository
+	versionService VersionService
+	cfg            ScheduledPublishConfig
+	stopCh         chan struct{}
+	doneCh         chan struct{}
+}
+
+// NewScheduledPublishJob creates a new scheduled publishing job
+func NewScheduledPublishJob(
+	db *gorm.DB,
+	blogRepo repository.BlogRepository,
+	outboxRepo repository.OutboxRepository,
+	versionService VersionService,
+	cfg ScheduledPublishConfig,
+) *ScheduledPublishJob {
+	return &ScheduledPublishJob{
+		db:             db,
+		blogRepo:       blogRepo,
+		outboxRepo:     outboxRepo,
+		versionService: versionService,
+		cfg:            cfg,
+		stopCh:         make(chan struct{}),
+		doneCh:         make(chan struct{}),
+	}
+}
+
+// Start runs the job once and then on every interval
+func (j *ScheduledPublishJob) Start() {
+	go func() {
+		defer close(j.doneCh)
+		ticker := time.NewTicker(j.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := j.RunOnce(context.Background()); err != nil {
+				logger.Error("scheduled publishing run failed", err)
+			}
+
+			select {
+			case <-ticker.C:
+			case <-j.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the job and waits for the current run to finish
+func (j *ScheduledPublishJob) Stop() {
+	close(j.stopCh)
+	<-j.doneCh
+}
+
+// RunOnce announces every blog whose publish time has passed and returns how many it announced
+func (j *ScheduledPublishJob) RunOnce(ctx context.Context) (int, error) {
+	now := time.Now()
+	total := 0
+
+	// Drain batch by batch, stopping once a batch announces nothing so blogs
+	// that keep failing are left for the next run
+	for {
+		blogs, err := j.blogRepo.FindScheduledDue(ctx, now, j.cfg.BatchSize)
+		if err != nil {
+			return total, err
+		}
+
+		announced := 0
+		for i := range blogs {
+			if j.announce(ctx, &blogs[i], now) {
+				announced++
+			}
+		}
+		total += announced
+
+		if len(blogs) < j.cfg.BatchSize || announced == 0 {
+			return total, nil
+		}
+	}
+}
+
+// announce emits the published side effects of one blog. It returns false if another
+// instance announced it first, it was rescheduled or unpublished, or the announcement
+// failed; failures are logged and retried on the next run.
+func (j *ScheduledPublishJob) announce(ctx context.Context, blog *entity.Blog, now time.Time) bool {
+	announced := false
+	err := j.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		ok, err := announceBlog(ctx, j.blogRepo.WithTx(dbTx), j.outboxRepo.WithTx(dbTx), blog, now)
+		announced = ok
+		return err
+	})
+	if err != nil {
+		logger.Error("failed to announce scheduled blog", err, map[string]interface{}{"blog_id": blog.ID})
+		return false
+	}
+
+	if announced {
+		snapshotPublished(ctx, j.versionService, blog)
+	}
+	return announced
+}
@@ -0,0 +1,144 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	servicemocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type scheduledPublishFixture struct {
+	job            *service.ScheduledPublishJob
+	sqlMock        sqlmock.Sqlmock
+	blogRepo       *mocks.MockBlogRepository
+	outboxRepo     *mocks.MockOutboxRepository
+	versionService *servicemocks.MockVersionService
+}
+
+func newScheduledPublishFixture(t *testing.T, batchSize int) *scheduledPublishFixture {
+	ctrl := gomock.NewController(t)
+
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+	require.NoError(t, err)
+
+	f := &scheduledPublishFixture{
+		sqlMock:        sqlMock,
+		blogRepo:       mocks.NewMockBlogRepository(ctrl),
+		outboxRepo:     mocks.NewMockOutboxRepository(ctrl),
+		versionService: servicemocks.NewMockVersionService(ctrl),
+	}
+	f.blogRepo.EXPECT().WithTx(gomock.Any()).Return(f.blogRepo).AnyTimes()
+	f.outboxRepo.EXPECT().WithTx(gomock.Any()).Return(f.outboxRepo).AnyTimes()
+
+	f.job = service.NewScheduledPublishJob(gormDB, f.blogRepo, f.outboxRepo, f.versionService, service.ScheduledPublishConfig{
+		Interval:  time.Minute,
+		BatchSize: batchSize,
+	})
+	return f
+}
+
+func dueBlog() entity.Blog {
+	publishedAt := time.Now().Add(-time.Minute)
+	return entity.Blog{
+		ID: uuid.New(), AuthorID: uuid.New(), Title: "Launch day",
+		Status: entity.BlogStatusPublished, PublishedAt: &publishedAt,
+	}
+}
+
+func TestScheduledPublishJob_RunOnce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("announces_due_blog", func(t *testing.T) {
+		f := newScheduledPublishFixture(t, 10)
+		blog := dueBlog()
+
+		f.blogRepo.EXPECT().FindScheduledDue(ctx, gomock.Any(), 10).Return([]entity.Blog{blog}, nil)
+		f.sqlMock.ExpectBegin()
+		f.blogRepo.EXPECT().MarkAnnounced(ctx, blog.ID, *blog.PublishedAt, gomock.Any()).Return(true, nil)
+		f.outboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.OutboxEvent) error {
+			assert.Equal(t, string(service.EventBlogPublished), e.EventType)
+			assert.Equal(t, blog.ID, e.AggregateID)
+			assert.Contains(t, e.Payload, "Launch day")
+			return nil
+		})
+		f.sqlMock.ExpectCommit()
+		f.versionService.EXPECT().CreateVersion(ctx, gomock.Any(), blog.AuthorID, service.VersionPublished).Return(nil, nil)
+
+		n, err := f.job.RunOnce(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("announced_elsewhere_or_rescheduled", func(t *testing.T) {
+		f := newScheduledPublishFixture(t, 10)
+		blog := dueBlog()
+
+		// Another instance marked it first, so no event and no version
+		f.blogRepo.EXPECT().FindScheduledDue(ctx, gomock.Any(), 10).Return([]entity.Blog{blog}, nil)
+		f.sqlMock.ExpectBegin()
+		f.blogRepo.EXPECT().MarkAnnounced(ctx, blog.ID, *blog.PublishedAt, gomock.Any()).Return(false, nil)
+		f.sqlMock.ExpectCommit()
+
+		n, err := f.job.RunOnce(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+		assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("outbox_failure_rolls_back", func(t *testing.T) {
+		f := newScheduledPublishFixture(t, 10)
+		blog := dueBlog()
+
+		f.blogRepo.EXPECT().FindScheduledDue(ctx, gomock.Any(), 10).Return([]entity.Blog{blog}, nil)
+		f.sqlMock.ExpectBegin()
+		f.blogRepo.EXPECT().MarkAnnounced(ctx, blog.ID, *blog.PublishedAt, gomock.Any()).Return(true, nil)
+		f.outboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("db down"))
+		f.sqlMock.ExpectRollback()
+
+		n, err := f.job.RunOnce(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+		assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("drains_full_batches", func(t *testing.T) {
+		f := newScheduledPublishFixture(t, 2)
+		first, second, third := dueBlog(), dueBlog(), dueBlog()
+
+		gomock.InOrder(
+			f.blogRepo.EXPECT().FindScheduledDue(ctx, gomock.Any(), 2).Return([]entity.Blog{first, second}, nil),
+			f.blogRepo.EXPECT().FindScheduledDue(ctx, gomock.Any(), 2).Return([]entity.Blog{third}, nil),
+		)
+		for range 3 {
+			f.sqlMock.ExpectBegin()
+			f.sqlMock.ExpectCommit()
+		}
+		f.blogRepo.EXPECT().MarkAnnounced(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(3)
+		f.outboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(3)
+		f.versionService.EXPECT().CreateVersion(ctx, gomock.Any(), gomock.Any(), service.VersionPublished).Return(nil, nil).Times(3)
+
+		n, err := f.job.RunOnce(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+	})
+}
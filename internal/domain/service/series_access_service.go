@@ -0,0 +1,66 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSeriesNotFound         = errors.New("series not found")
+	ErrSeriesPurchaseRequired = errors.New("this blog is part of a paid series; buy the series to read it")
+)
+
+// SeriesAccessService decides whether chapters of paid series are readable
+type SeriesAccessService interface {
+	// LockingSeries returns the paid series the viewer must buy to read the blog. It is
+	// empty when the blog is in no paid series, is a free preview, is written by the
+	// viewer, or the viewer bought any paid series containing it.
+	LockingSeries(ctx context.Context, blog *entity.Blog, viewerID *uuid.UUID) ([]entity.Series, error)
+}
+
+type seriesAccessService struct {
+	seriesRepo   repository.SeriesRepository
+	purchaseRepo repository.UserSeriesPurchaseRepository
+}
+
+// NewSeriesAccessService creates a new SeriesAccessService instance
+func NewSeriesAccessService(
+	seriesRepo repository.SeriesRepository,
+	purchaseRepo repository.UserSeriesPurchaseRepository,
+) SeriesAccessService {
+	return &seriesAccessService{
+		seriesRepo:   seriesRepo,
+		purchaseRepo: purchaseRepo,
+	}
+}
+
+func (s *seriesAccessService) LockingSeries(ctx context.Context, blog *entity.Blog, viewerID *uuid.UUID) ([]entity.Series, error) {
+	if viewerID != nil && *viewerID == blog.AuthorID {
+		return nil, nil
+	}
+
+	paid, err := s.seriesRepo.FindPaidContaining(ctx, blog.ID)
+	if err != nil || len(paid) == 0 {
+		return nil, err
+	}
+
+	if viewerID != nil {
+		for _, series := range paid {
+			purchased, err := s.purchaseRepo.HasPurchased(ctx, *viewerID, series.ID)
+			if err != nil {
+				return nil, err
+			}
+			if purchased {
+				return nil, nil
+			}
+		}
+	}
+
+	return paid, nil
+}
@@ -0,0 +1,76 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aiagent/internal/domain/entity"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSeriesAccessService_LockingSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeriesRepo := repoMocks.NewMockSeriesRepository(ctrl)
+	mockPurchaseRepo := repoMocks.NewMockUserSeriesPurchaseRepository(ctrl)
+	svc := service.NewSeriesAccessService(mockSeriesRepo, mockPurchaseRepo)
+
+	ctx := context.Background()
+	blog := &entity.Blog{ID: uuid.New(), AuthorID: uuid.New()}
+	readerID := uuid.New()
+	first := entity.Series{ID: uuid.New(), Price: decimal.NewFromInt(49000)}
+	second := entity.Series{ID: uuid.New(), Price: decimal.NewFromInt(99000)}
+
+	t.Run("author_reads_own_chapters", func(t *testing.T) {
+		locking, err := svc.LockingSeries(ctx, blog, &blog.AuthorID)
+
+		require.NoError(t, err)
+		assert.Empty(t, locking)
+	})
+
+	t.Run("free_or_preview_chapter", func(t *testing.T) {
+		mockSeriesRepo.EXPECT().FindPaidContaining(ctx, blog.ID).Return(nil, nil)
+
+		locking, err := svc.LockingSeries(ctx, blog, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, locking)
+	})
+
+	t.Run("anonymous_reader", func(t *testing.T) {
+		mockSeriesRepo.EXPECT().FindPaidContaining(ctx, blog.ID).Return([]entity.Series{first}, nil)
+
+		locking, err := svc.LockingSeries(ctx, blog, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, []entity.Series{first}, locking)
+	})
+
+	t.Run("buying_any_containing_series_unlocks", func(t *testing.T) {
+		mockSeriesRepo.EXPECT().FindPaidContaining(ctx, blog.ID).Return([]entity.Series{first, second}, nil)
+		mockPurchaseRepo.EXPECT().HasPurchased(ctx, readerID, first.ID).Return(false, nil)
+		mockPurchaseRepo.EXPECT().HasPurchased(ctx, readerID, second.ID).Return(true, nil)
+
+		locking, err := svc.LockingSeries(ctx, blog, &readerID)
+
+		require.NoError(t, err)
+		assert.Empty(t, locking)
+	})
+
+	t.Run("not_bought", func(t *testing.T) {
+		mockSeriesRepo.EXPECT().FindPaidContaining(ctx, blog.ID).Return([]entity.Series{first}, nil)
+		mockPurchaseRepo.EXPECT().HasPurchased(ctx, readerID, first.ID).Return(false, nil)
+
+		locking, err := svc.LockingSeries(ctx, blog, &readerID)
+
+		require.NoError(t, err)
+		assert.Len(t, locking, 1)
+	})
+}
@@ -2,29 +2,114 @@ package service
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
 
-import "context"
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/infrastructure/adapter"
+)
+
+var (
+	ErrUnsupportedProvider = errors.New("unsupported social login provider")
+	ErrInvalidOAuthState   = errors.New("invalid or expired login state")
+	ErrSocialEmailMissing  = errors.New("social account has no email address")
+)
 
 type SocialUserInfo struct {
-	ProviderID string
-	Name       string
-	Email      string
+	ProviderID    string
+	Name          string
+	Email         string
+	EmailVerified bool
 }
 
 type SocialAuthService interface {
-	GetUserInfo(ctx context.Context, provider string, code string) (*SocialUserInfo, error)
-	GetAuthURL(ctx context.Context, provider string) (string, error)
+	// GetUserInfo completes the callback: it consumes the state, exchanges the code and loads the profile
+	GetUserInfo(ctx context.Context, provider string, code string, state string) (*SocialUserInfo, error)
+	// GetAuthURL starts a login: it stores a fresh state and PKCE verifier and returns the consent page
+	// URL with the state, which the caller binds to the browser starting the login
+	GetAuthURL(ctx context.Context, provider string) (authURL string, state string, err error)
 }
 
-type socialAuthService struct{}
+type socialAuthService struct {
+	providers map[string]adapter.OAuthProvider
+	stateRepo repository.OAuthStateRepository
+	stateTTL  time.Duration
+}
 
-func NewSocialAuthService() SocialAuthService {
-	return &socialAuthService{}
+func NewSocialAuthService(providers []adapter.OAuthProvider, stateRepo repository.OAuthStateRepository, stateTTL time.Duration) SocialAuthService {
+	registry := make(map[string]adapter.OAuthProvider, len(providers))
+	for _, p := range providers {
+		registry[p.Name()] = p
+	}
+	return &socialAuthService{
+		providers: registry,
+		stateRepo: stateRepo,
+		stateTTL:  stateTTL,
+	}
 }
 
-func (s *socialAuthService) GetUserInfo(ctx context.Context, provider string, code string) (*SocialUserInfo, error) {
-	return nil, nil
+func (s *socialAuthService) GetUserInfo(ctx context.Context, provider string, code string, state string) (*SocialUserInfo, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, ErrUnsupportedProvider
+	}
+
+	pending, err := s.stateRepo.Consume(ctx, state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load login state: %w", err)
+	}
+	if pending == nil || pending.Provider != provider {
+		return nil, ErrInvalidOAuthState
+	}
+
+	info, err := p.Exchange(ctx, code, pending.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	if info.Email == "" {
+		return nil, ErrSocialEmailMissing
+	}
+
+	return &SocialUserInfo{
+		ProviderID:    info.ID,
+		Name:          info.Name,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+	}, nil
+}
+
+func (s *socialAuthService) GetAuthURL(ctx context.Context, provider string) (string, string, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return "", "", ErrUnsupportedProvider
+	}
+
+	state, err := randomURLToken(32)
+	if err != nil {
+		return "", "", err
+	}
+	verifier, err := randomURLToken(32)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := s.stateRepo.Save(ctx, state, &repository.OAuthState{Provider: provider, CodeVerifier: verifier}, s.stateTTL); err != nil {
+		return "", "", fmt.Errorf("failed to store login state: %w", err)
+	}
+
+	return p.AuthCodeURL(state, verifier), state, nil
 }
 
-func (s *socialAuthService) GetAuthURL(ctx context.Context, provider string) (string, error) {
-	return "", nil
+// randomURLToken returns n random bytes encoded as unpadded base64url (RFC 7636 verifier alphabet)
+func randomURLToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/repository"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/infrastructure/adapter"
+	adapterMocks "github.com/aiagent/internal/infrastructure/adapter/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSocialAuthService_GetAuthURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := adapterMocks.NewMockOAuthProvider(ctrl)
+	mockProvider.EXPECT().Name().Return("github").AnyTimes()
+	mockStateRepo := repoMocks.NewMockOAuthStateRepository(ctrl)
+	svc := service.NewSocialAuthService([]adapter.OAuthProvider{mockProvider}, mockStateRepo, 10*time.Minute)
+
+	ctx := context.Background()
+
+	t.Run("stores_state_and_verifier", func(t *testing.T) {
+		var savedState string
+		var saved *repository.OAuthState
+		mockStateRepo.EXPECT().Save(ctx, gomock.Any(), gomock.Any(), 10*time.Minute).
+			DoAndReturn(func(_ context.Context, state string, data *repository.OAuthState, _ time.Duration) error {
+				savedState, saved = state, data
+				return nil
+			})
+		mockProvider.EXPECT().AuthCodeURL(gomock.Any(), gomock.Any()).DoAndReturn(func(state, verifier string) string {
+			return "https://idp.example.com/authorize?state=" + url.QueryEscape(state)
+		})
+
+		authURL, state, err := svc.GetAuthURL(ctx, "github")
+
+		require.NoError(t, err)
+		assert.Equal(t, savedState, state)
+		assert.Equal(t, "github", saved.Provider)
+		assert.GreaterOrEqual(t, len(saved.CodeVerifier), 43) // RFC 7636 minimum
+		assert.Contains(t, authURL, url.QueryEscape(savedState))
+	})
+
+	t.Run("unknown_provider", func(t *testing.T) {
+		_, _, err := svc.GetAuthURL(ctx, "myspace")
+
+		assert.ErrorIs(t, err, service.ErrUnsupportedProvider)
+	})
+}
+
+func TestSocialAuthService_GetUserInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := adapterMocks.NewMockOAuthProvider(ctrl)
+	mockProvider.EXPECT().Name().Return("google").AnyTimes()
+	mockStateRepo := repoMocks.NewMockOAuthStateRepository(ctrl)
+	svc := service.NewSocialAuthService([]adapter.OAuthProvider{mockProvider}, mockStateRepo, 10*time.Minute)
+
+	ctx := context.Background()
+
+	t.Run("valid_state", func(t *testing.T) {
+		mockStateRepo.EXPECT().Consume(ctx, "state-1").Return(&repository.OAuthState{Provider: "google", CodeVerifier: "verifier"}, nil)
+		mockProvider.EXPECT().Exchange(ctx, "code", "verifier").Return(&adapter.OAuthUserInfo{
+			ID: "g-1", Name: "Ada", Email: "ada@example.com", EmailVerified: true,
+		}, nil)
+
+		info, err := svc.GetUserInfo(ctx, "google", "code", "state-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, &service.SocialUserInfo{ProviderID: "g-1", Name: "Ada", Email: "ada@example.com", EmailVerified: true}, info)
+	})
+
+	t.Run("unknown_or_replayed_state", func(t *testing.T) {
+		mockStateRepo.EXPECT().Consume(ctx, "state-1").Return(nil, nil)
+
+		_, err := svc.GetUserInfo(ctx, "google", "code", "state-1")
+
+		assert.ErrorIs(t, err, service.ErrInvalidOAuthState)
+	})
+
+	t.Run("state_issued_for_other_provider", func(t *testing.T) {
+		mockStateRepo.EXPECT().Consume(ctx, "state-2").Return(&repository.OAuthState{Provider: "github", CodeVerifier: "verifier"}, nil)
+
+		_, err := svc.GetUserInfo(ctx, "google", "code", "state-2")
+
+		assert.ErrorIs(t, err, service.ErrInvalidOAuthState)
+	})
+
+	t.Run("profile_without_email", func(t *testing.T) {
+		mockStateRepo.EXPECT().Consume(ctx, "state-3").Return(&repository.OAuthState{Provider: "google", CodeVerifier: "verifier"}, nil)
+		mockProvider.EXPECT().Exchange(ctx, "code", "verifier").Return(&adapter.OAuthUserInfo{ID: "g-2"}, nil)
+
+		_, err := svc.GetUserInfo(ctx, "google", "code", "state-3")
+
+		assert.ErrorIs(t, err, service.ErrSocialEmailMissing)
+	})
+}
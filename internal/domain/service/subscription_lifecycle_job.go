@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/pkg/logger"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SubscriptionLifecycleConfig holds tuning for the subscription lifecycle job
+type SubscriptionLifecycleConfig struct {
+	Interval       time.Duration // How often the job runs
+	ReminderBefore time.Duration // How long before expiry the subscriber is reminded
+	GracePeriod    time.Duration // How long paid access continues after expiry
+	BatchSize      int           // Max subscriptions loaded per query
+}
+
+// SubscriptionLifecycleJob moves paid subscriptions through their lifecycle after payment:
+// a scheduled downgrade taking effect at the end of the period, an expiry reminder, a grace
+// period once the paid period lapsed and finally a downgrade to a free follow. Every
+// transition is recorded as a SubscriptionEvent.
+//
+// Transitions are conditional updates, so several instances can run the job at once and a
+// renewal paid in the meantime always wins.
+type SubscriptionLifecycleJob struct {
+	db         *gorm.DB
+	subRepo    repository.SubscriptionRepository
+	eventRepo  repository.SubscriptionEventRepository
+	userRepo   repository.UserRepository
+	dispatcher NotificationDispatcher
+	cfg        SubscriptionLifecycleConfig
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+}
+
+// NewSubscriptionLifecycleJob creates a new subscription lifecycle job
+func NewSubscriptionLifecycleJob(
+	db *gorm.DB,
+	subRepo repository.SubscriptionRepository,
+	eventRepo repository.SubscriptionEventRepository,
+	userRepo repository.UserRepository,
+	dispatcher NotificationDispatcher,
+	cfg SubscriptionLifecycleConfig,
+) *SubscriptionLifecycleJob {
+	return &SubscriptionLifecycleJob{
+		db:         db,
+		subRepo:    subRepo,
+		eventRepo:  eventRepo,
+		userRepo:   userRepo,
+		dispatcher: dispatcher,
+		cfg:        cfg,
+		stopCh:     make(chan struct{}),
+		doneCh:     make(chan struct{}),
+	}
+}
+
+// Start runs the job once and then on every interval
+func (j *SubscriptionLifecycleJob) Start() {
+	go func() {
+		defer close(j.doneCh)
+		ticker := time.NewTicker(j.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := j.RunOnce(context.Background()); err != nil {
+				logger.Error("subscription lifecycle run failed", err)
+			}
+
+			select {
+			case <-ticker.C:
+			case <-j.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the job and waits for the current run to finish
+func (j *SubscriptionLifecycleJob) Stop() {
+	close(j.stopCh)
+	<-j.doneCh
+}
+
+// RunOnce applies due scheduled downgrades, sends due expiry reminders, starts the grace period
+// of lapsed subscriptions and downgrades those whose grace period ended. It returns the number
+// of transitions applied.
+func (j *SubscriptionLifecycleJob) RunOnce(ctx context.Context) (int, error) {
+	now := time.Now()
+	total := 0
+
+	for _, step := range []func(context.Context, time.Time) (int, int, error){
+		j.applyScheduledChanges,
+		j.sendReminders,
+		j.startGracePeriods,
+		j.downgrade,
+	} {
+		// Drain the step batch by batch, stopping once a batch changes nothing so
+		// rows that keep failing are left for the next run
+		for {
+			loaded, applied, err := step(ctx, now)
+			total += applied
+			if err != nil {
+				return total, err
+			}
+			if loaded < j.cfg.BatchSize || applied == 0 {
+				break
+			}
+		}
+	}
+
+	return total, nil
+}
+
+// applyScheduledChanges switches subscriptions whose period ended to the lower tier paid for in advance
+func (j *SubscriptionLifecycleJob) applyScheduledChanges(ctx context.Context, now time.Time) (int, int, error) {
+	subs, err := j.subRepo.FindScheduledDue(ctx, now, j.cfg.BatchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	applied := 0
+	for _, sub := range subs {
+		ok := j.transition(ctx, sub, entity.SubscriptionEvent{
+			Type:      entity.SubscriptionEventTierChanged,
+			Tier:      *sub.ScheduledTier,
+			ExpiresAt: sub.ScheduledExpiresAt,
+		}, func(subRepo repository.SubscriptionRepository) (bool, error) {
+			return subRepo.ApplyScheduledChange(ctx, sub.ID, now)
+		})
+		if ok {
+			applied++
+		}
+	}
+	return len(subs), applied, nil
+}
+
+// sendReminders reminds subscribers whose paid period ends within ReminderBefore
+func (j *SubscriptionLifecycleJob) sendReminders(ctx context.Context, now time.Time) (int, int, error) {
+	before := now.Add(j.cfg.ReminderBefore)
+	subs, err := j.subRepo.FindExpiringSoon(ctx, now, before, j.cfg.BatchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	applied := 0
+	for _, sub := range subs {
+		ok := j.transition(ctx, sub, entity.SubscriptionEvent{
+			Type:      entity.SubscriptionEventReminderSent,
+			Tier:      sub.Tier,
+			ExpiresAt: sub.ExpiresAt,
+		}, func(subRepo repository.SubscriptionRepository) (bool, error) {
+			return subRepo.MarkReminderSent(ctx, sub.ID, now, before)
+		})
+		if !ok {
+			continue
+		}
+		applied++
+		j.notify(ctx, sub, entity.NotificationTypeSubscriptionExpiring, map[string]interface{}{
+			"tier":       sub.Tier,
+			"expires_at": sub.ExpiresAt.Format(time.DateOnly),
+		})
+	}
+	return len(subs), applied, nil
+}
+
+// startGracePeriods keeps paid access of lapsed subscriptions for GracePeriod after their expiry
+func (j *SubscriptionLifecycleJob) startGracePeriods(ctx context.Context, now time.Time) (int, int, error) {
+	subs, err := j.subRepo.FindLapsed(ctx, now, j.cfg.BatchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	applied := 0
+	for _, sub := range subs {
+		// Counted from the expiry, not from now, so a late run does not lengthen the grace period
+		graceEndsAt := sub.ExpiresAt.Add(j.cfg.GracePeriod)
+		ok := j.transition(ctx, sub, entity.SubscriptionEvent{
+			Type:        entity.SubscriptionEventGraceStarted,
+			Tier:        sub.Tier,
+			ExpiresAt:   sub.ExpiresAt,
+			GraceEndsAt: &graceEndsAt,
+		}, func(subRepo repository.SubscriptionRepository) (bool, error) {
+			return subRepo.StartGracePeriod(ctx, sub.ID, now, graceEndsAt)
+		})
+		if !ok {
+			continue
+		}
+		applied++
+		// A grace period that is already over is followed by the downgrade notification instead
+		if graceEndsAt.After(now) {
+			j.notify(ctx, sub, entity.NotificationTypeSubscriptionGrace, map[string]interface{}{
+				"tier":          sub.Tier,
+				"grace_ends_at": graceEndsAt.Format(time.DateOnly),
+			})
+		}
+	}
+	return len(subs), applied, nil
+}
+
+// downgrade turns subscriptions whose grace period ended back into free follows
+func (j *SubscriptionLifecycleJob) downgrade(ctx context.Context, now time.Time) (int, int, error) {
+	subs, err := j.subRepo.FindGraceEnded(ctx, now, j.cfg.BatchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	applied := 0
+	for _, sub := range subs {
+		ok := j.transition(ctx, sub, entity.SubscriptionEvent{
+			Type: entity.SubscriptionEventDowngraded,
+			Tier: string(entity.TierFree),
+		}, func(subRepo repository.SubscriptionRepository) (bool, error) {
+			return subRepo.Downgrade(ctx, sub.ID, now)
+		})
+		if !ok {
+			continue
+		}
+		applied++
+		j.notify(ctx, sub, entity.NotificationTypeSubscriptionEnded, map[string]interface{}{
+			"tier": sub.Tier,
+		})
+	}
+	return len(subs), applied, nil
+}
+
+// transition applies one lifecycle change and records its event in the same database
+// transaction. It returns false if the subscription was no longer in the expected state
+// or the change failed; failures are logged and retried on the next run.
+func (j *SubscriptionLifecycleJob) transition(
+	ctx context.Context,
+	sub entity.Subscription,
+	event entity.SubscriptionEvent,
+	apply func(subRepo repository.SubscriptionRepository) (bool, error),
+) bool {
+	event.SubscriptionID = sub.ID
+	event.SubscriberID = sub.SubscriberID
+	event.AuthorID = sub.AuthorID
+
+	applied := false
+	err := j.db.WithContext(ctx).Transaction(func(dbTx *gorm.DB) error {
+		ok, err := apply(j.subRepo.WithTx(dbTx))
+		if err != nil || !ok {
+			return err
+		}
+		if err := j.eventRepo.WithTx(dbTx).Create(ctx, &event); err != nil {
+			return err
+		}
+		applied = true
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed to apply subscription lifecycle transition", err, map[string]interface{}{
+			"subscription_id": sub.ID,
+			"event_type":      event.Type,
+		})
+		return false
+	}
+	return applied
+}
+
+// notify tells the subscriber about a transition. The state change is already committed,
+// so a failed notification is only logged.
+func (j *SubscriptionLifecycleJob) notify(ctx context.Context, sub entity.Subscription, notifType entity.NotificationType, data map[string]interface{}) {
+	data["target_id"] = sub.AuthorID.String()
+	data["target_type"] = "user"
+	data["actor_id"] = sub.AuthorID.String()
+	data["actor_name"] = j.authorName(ctx, sub.AuthorID)
+
+	if err := j.dispatcher.Notify(ctx, sub.SubscriberID, notifType, data); err != nil {
+		logger.Error("failed to send subscription lifecycle notification", err, map[string]interface{}{
+			"subscription_id":   sub.ID,
+			"notification_type": notifType,
+		})
+	}
+}
+
+func (j *SubscriptionLifecycleJob) authorName(ctx context.Context, authorID uuid.UUID) string {
+	user, err := j.userRepo.FindByID(ctx, authorID)
+	if err != nil || user == nil {
+		return "an author"
+	}
+	return user.GetDisplayName()
+}
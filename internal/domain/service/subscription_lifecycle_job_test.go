@@ -0,0 +1,212 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	servicemocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type lifecycleFixture struct {
+	job        *service.SubscriptionLifecycleJob
+	sqlMock    sqlmock.Sqlmock
+	subRepo    *mocks.MockSubscriptionRepository
+	eventRepo  *mocks.MockSubscriptionEventRepository
+	dispatcher *servicemocks.MockNotificationDispatcher
+}
+
+func newLifecycleFixture(t *testing.T) *lifecycleFixture {
+	ctrl := gomock.NewController(t)
+
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+	require.NoError(t, err)
+
+	f := &lifecycleFixture{
+		sqlMock:    sqlMock,
+		subRepo:    mocks.NewMockSubscriptionRepository(ctrl),
+		eventRepo:  mocks.NewMockSubscriptionEventRepository(ctrl),
+		dispatcher: servicemocks.NewMockNotificationDispatcher(ctrl),
+	}
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	userRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(&entity.User{Name: "Ada"}, nil).AnyTimes()
+
+	f.subRepo.EXPECT().WithTx(gomock.Any()).Return(f.subRepo).AnyTimes()
+	f.eventRepo.EXPECT().WithTx(gomock.Any()).Return(f.eventRepo).AnyTimes()
+
+	f.job = service.NewSubscriptionLifecycleJob(gormDB, f.subRepo, f.eventRepo, userRepo, f.dispatcher, service.SubscriptionLifecycleConfig{
+		Interval:       time.Minute,
+		ReminderBefore: 72 * time.Hour,
+		GracePeriod:    48 * time.Hour,
+		BatchSize:      10,
+	})
+	return f
+}
+
+// expectNothingElse makes the steps that are not under test find no subscriptions
+func (f *lifecycleFixture) expectNothingElse(scheduled, reminders, lapsed, graceEnded bool) {
+	if !scheduled {
+		f.subRepo.EXPECT().FindScheduledDue(gomock.Any(), gomock.Any(), 10).Return(nil, nil)
+	}
+	if !reminders {
+		f.subRepo.EXPECT().FindExpiringSoon(gomock.Any(), gomock.Any(), gomock.Any(), 10).Return(nil, nil)
+	}
+	if !lapsed {
+		f.subRepo.EXPECT().FindLapsed(gomock.Any(), gomock.Any(), 10).Return(nil, nil)
+	}
+	if !graceEnded {
+		f.subRepo.EXPECT().FindGraceEnded(gomock.Any(), gomock.Any(), 10).Return(nil, nil)
+	}
+}
+
+func TestSubscriptionLifecycleJob_RunOnce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("applies_scheduled_downgrade", func(t *testing.T) {
+		f := newLifecycleFixture(t)
+		expiresAt := time.Now().Add(-time.Minute)
+		scheduledExpiresAt := expiresAt.AddDate(0, 0, 30)
+		scheduledTier := "BRONZE"
+		sub := entity.Subscription{
+			ID: uuid.New(), SubscriberID: uuid.New(), AuthorID: uuid.New(), Tier: "GOLD", ExpiresAt: &expiresAt,
+			ScheduledTier: &scheduledTier, ScheduledExpiresAt: &scheduledExpiresAt,
+		}
+
+		f.subRepo.EXPECT().FindScheduledDue(ctx, gomock.Any(), 10).Return([]entity.Subscription{sub}, nil)
+		f.expectNothingElse(true, false, false, false)
+
+		f.sqlMock.ExpectBegin()
+		f.subRepo.EXPECT().ApplyScheduledChange(ctx, sub.ID, gomock.Any()).Return(true, nil)
+		f.eventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, entity.SubscriptionEventTierChanged, e.Type)
+			assert.Equal(t, "BRONZE", e.Tier)
+			assert.Equal(t, &scheduledExpiresAt, e.ExpiresAt)
+			return nil
+		})
+		f.sqlMock.ExpectCommit()
+
+		n, err := f.job.RunOnce(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("sends_expiry_reminder", func(t *testing.T) {
+		f := newLifecycleFixture(t)
+		expiresAt := time.Now().Add(24 * time.Hour)
+		sub := entity.Subscription{ID: uuid.New(), SubscriberID: uuid.New(), AuthorID: uuid.New(), Tier: "SILVER", ExpiresAt: &expiresAt}
+
+		f.subRepo.EXPECT().FindExpiringSoon(ctx, gomock.Any(), gomock.Any(), 10).
+			DoAndReturn(func(_ context.Context, now, before time.Time, _ int) ([]entity.Subscription, error) {
+				assert.Equal(t, 72*time.Hour, before.Sub(now))
+				return []entity.Subscription{sub}, nil
+			})
+		f.expectNothingElse(false, true, false, false)
+
+		f.sqlMock.ExpectBegin()
+		f.subRepo.EXPECT().MarkReminderSent(ctx, sub.ID, gomock.Any(), gomock.Any()).Return(true, nil)
+		f.eventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, entity.SubscriptionEventReminderSent, e.Type)
+			assert.Equal(t, sub.ID, e.SubscriptionID)
+			assert.Equal(t, &expiresAt, e.ExpiresAt)
+			return nil
+		})
+		f.sqlMock.ExpectCommit()
+		f.dispatcher.EXPECT().Notify(ctx, sub.SubscriberID, entity.NotificationTypeSubscriptionExpiring, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, _ entity.NotificationType, data map[string]interface{}) error {
+				assert.Equal(t, expiresAt.Format(time.DateOnly), data["expires_at"])
+				assert.Equal(t, sub.AuthorID.String(), data["target_id"])
+				assert.Equal(t, "Ada", data["actor_name"])
+				return nil
+			})
+
+		n, err := f.job.RunOnce(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.NoError(t, f.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("starts_grace_period_from_expiry", func(t *testing.T) {
+		f := newLifecycleFixture(t)
+		expiresAt := time.Now().Add(-time.Hour)
+		sub := entity.Subscription{ID: uuid.New(), SubscriberID: uuid.New(), AuthorID: uuid.New(), Tier: "GOLD", ExpiresAt: &expiresAt}
+		graceEndsAt := expiresAt.Add(48 * time.Hour)
+
+		f.expectNothingElse(false, false, true, false)
+		f.subRepo.EXPECT().FindLapsed(ctx, gomock.Any(), 10).Return([]entity.Subscription{sub}, nil)
+
+		f.sqlMock.ExpectBegin()
+		f.subRepo.EXPECT().StartGracePeriod(ctx, sub.ID, gomock.Any(), graceEndsAt).Return(true, nil)
+		f.eventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, entity.SubscriptionEventGraceStarted, e.Type)
+			assert.Equal(t, graceEndsAt, *e.GraceEndsAt)
+			return nil
+		})
+		f.sqlMock.ExpectCommit()
+		f.dispatcher.EXPECT().Notify(ctx, sub.SubscriberID, entity.NotificationTypeSubscriptionGrace, gomock.Any()).Return(nil)
+
+		n, err := f.job.RunOnce(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("downgrades_after_grace_period", func(t *testing.T) {
+		f := newLifecycleFixture(t)
+		expiresAt := time.Now().Add(-72 * time.Hour)
+		graceEndsAt := time.Now().Add(-24 * time.Hour)
+		sub := entity.Subscription{ID: uuid.New(), SubscriberID: uuid.New(), AuthorID: uuid.New(), Tier: "GOLD", ExpiresAt: &expiresAt, GraceEndsAt: &graceEndsAt}
+
+		f.expectNothingElse(false, false, false, true)
+		f.subRepo.EXPECT().FindGraceEnded(ctx, gomock.Any(), 10).Return([]entity.Subscription{sub}, nil)
+
+		f.sqlMock.ExpectBegin()
+		f.subRepo.EXPECT().Downgrade(ctx, sub.ID, gomock.Any()).Return(true, nil)
+		f.eventRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.SubscriptionEvent) error {
+			assert.Equal(t, entity.SubscriptionEventDowngraded, e.Type)
+			assert.Equal(t, string(entity.TierFree), e.Tier)
+			assert.Nil(t, e.ExpiresAt)
+			return nil
+		})
+		f.sqlMock.ExpectCommit()
+		f.dispatcher.EXPECT().Notify(ctx, sub.SubscriberID, entity.NotificationTypeSubscriptionEnded, gomock.Any()).Return(nil)
+
+		n, err := f.job.RunOnce(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("skips_subscription_renewed_meanwhile", func(t *testing.T) {
+		f := newLifecycleFixture(t)
+		expiresAt := time.Now().Add(-time.Hour)
+		sub := entity.Subscription{ID: uuid.New(), SubscriberID: uuid.New(), AuthorID: uuid.New(), Tier: "GOLD", ExpiresAt: &expiresAt}
+
+		f.expectNothingElse(false, false, true, false)
+		f.subRepo.EXPECT().FindLapsed(ctx, gomock.Any(), 10).Return([]entity.Subscription{sub}, nil)
+
+		// The conditional update matches nothing, so no event is recorded and nobody is notified
+		f.sqlMock.ExpectBegin()
+		f.subRepo.EXPECT().StartGracePeriod(ctx, sub.ID, gomock.Any(), gomock.Any()).Return(false, nil)
+		f.sqlMock.ExpectCommit()
+
+		n, err := f.job.RunOnce(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+}
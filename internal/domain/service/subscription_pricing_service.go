@@ -0,0 +1,186 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrPlanNotFound              = errors.New("subscription plan not found")
+	ErrSubscriptionChangePending = errors.New("a tier change is already scheduled for this subscription")
+	ErrSubscriptionQuoteOutdated = errors.New("subscription changed since the plan was quoted")
+)
+
+// SubscriptionChange classifies what paying for a plan does to the current subscription
+type SubscriptionChange string
+
+const (
+	SubscriptionChangeNew       SubscriptionChange = "new"       // No paid tier yet (or only a grace period left), the period starts now
+	SubscriptionChangeRenewal   SubscriptionChange = "renewal"   // Same tier, the period is stacked on the remaining time
+	SubscriptionChangeUpgrade   SubscriptionChange = "upgrade"   // Higher tier, starts now and the unused days are credited
+	SubscriptionChangeDowngrade SubscriptionChange = "downgrade" // Lower tier, starts when the current period ends
+)
+
+// SubscriptionQuote is the price of switching a subscriber to a plan
+type SubscriptionQuote struct {
+	Plan        *entity.SubscriptionPlan
+	Change      SubscriptionChange
+	CurrentTier entity.SubscriptionTier
+	Credit      decimal.Decimal // Value of the unused days of the current plan, only for upgrades
+	AmountDue   decimal.Decimal // Plan price minus credit, never negative
+	StartsAt    time.Time
+	ExpiresAt   time.Time
+	Basis       *time.Time // Expiry of the current subscription the change was priced from
+}
+
+// SubscriptionPricingService prices subscription plans for a specific subscriber
+type SubscriptionPricingService interface {
+	// Quote returns what the subscriber pays for the author's plan and when the new period starts and ends.
+	// Returns ErrPlanNotFound if the plan is missing, inactive or belongs to another author.
+	Quote(ctx context.Context, subscriberID, authorID, planID uuid.UUID) (*SubscriptionQuote, error)
+}
+
+type subscriptionPricingService struct {
+	subRepo  repository.SubscriptionRepository
+	planRepo repository.SubscriptionPlanRepository
+}
+
+// NewSubscriptionPricingService creates a new SubscriptionPricingService instance
+func NewSubscriptionPricingService(
+	subRepo repository.SubscriptionRepository,
+	planRepo repository.SubscriptionPlanRepository,
+) SubscriptionPricingService {
+	return &subscriptionPricingService{
+		subRepo:  subRepo,
+		planRepo: planRepo,
+	}
+}
+
+// Quote returns what the subscriber pays for the author's plan
+func (s *subscriptionPricingService) Quote(ctx context.Context, subscriberID, authorID, planID uuid.UUID) (*SubscriptionQuote, error) {
+	return quotePlan(ctx, s.subRepo, s.planRepo, subscriberID, authorID, planID, time.Now())
+}
+
+// quotePlan loads the plan and the current subscription and prices the change. A subscriber
+// with a downgrade already scheduled has to wait for it before changing tier again.
+func quotePlan(
+	ctx context.Context,
+	subRepo repository.SubscriptionRepository,
+	planRepo repository.SubscriptionPlanRepository,
+	subscriberID, authorID, planID uuid.UUID,
+	now time.Time,
+) (*SubscriptionQuote, error) {
+	if subscriberID == authorID {
+		return nil, ErrCannotSubscribeToSelf
+	}
+
+	plan, err := planRepo.FindByID(ctx, planID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plan: %w", err)
+	}
+	if plan == nil || !plan.IsActive || plan.AuthorID != authorID {
+		return nil, ErrPlanNotFound
+	}
+
+	sub, err := subRepo.FindBySubscriberAndAuthor(ctx, subscriberID, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load subscription: %w", err)
+	}
+	if sub != nil && sub.HasScheduledChange() {
+		return nil, ErrSubscriptionChangePending
+	}
+
+	return quoteSubscription(ctx, planRepo, sub, plan, now)
+}
+
+// quoteSubscription prices moving sub (nil if the subscriber does not follow the author yet)
+// to plan. Tiers are compared with SubscriptionTier.Level, so the order of the hierarchy
+// decides between upgrade and downgrade, not the plan prices.
+func quoteSubscription(
+	ctx context.Context,
+	planRepo repository.SubscriptionPlanRepository,
+	sub *entity.Subscription,
+	plan *entity.SubscriptionPlan,
+	now time.Time,
+) (*SubscriptionQuote, error) {
+	quote := &SubscriptionQuote{
+		Plan:        plan,
+		Change:      SubscriptionChangeNew,
+		CurrentTier: entity.TierFree,
+		Credit:      decimal.Zero,
+		AmountDue:   plan.Price,
+		StartsAt:    now,
+	}
+	if sub != nil {
+		quote.CurrentTier = sub.PaidTier()
+		quote.Basis = sub.ExpiresAt
+	}
+
+	// Only a period that has not run out yet can be stacked on, credited or waited for;
+	// a subscription in its grace period starts over
+	remaining := time.Duration(0)
+	if quote.CurrentTier != entity.TierFree && sub.ExpiresAt.After(now) {
+		remaining = sub.ExpiresAt.Sub(now)
+	}
+
+	switch current, target := quote.CurrentTier.Level(), plan.Tier.Level(); {
+	case quote.CurrentTier == entity.TierFree:
+	case target == current:
+		quote.Change = SubscriptionChangeRenewal
+		if remaining > 0 {
+			quote.StartsAt = *sub.ExpiresAt
+		}
+	case target > current:
+		quote.Change = SubscriptionChangeUpgrade
+		credit, err := unusedCredit(ctx, planRepo, sub.AuthorID, quote.CurrentTier, remaining)
+		if err != nil {
+			return nil, err
+		}
+		// Credit beyond the new plan's price is not carried over
+		quote.Credit = decimal.Min(credit, plan.Price)
+		quote.AmountDue = plan.Price.Sub(quote.Credit)
+	case remaining > 0:
+		quote.Change = SubscriptionChangeDowngrade
+		quote.StartsAt = *sub.ExpiresAt
+	}
+
+	quote.ExpiresAt = quote.StartsAt.AddDate(0, 0, plan.DurationDays)
+	return quote, nil
+}
+
+// unusedCredit values the remaining time of the current tier at the price of the author's
+// plan for it, rounded down to whole currency units. Without such a plan there is no credit.
+func unusedCredit(
+	ctx context.Context,
+	planRepo repository.SubscriptionPlanRepository,
+	authorID uuid.UUID,
+	tier entity.SubscriptionTier,
+	remaining time.Duration,
+) (decimal.Decimal, error) {
+	if remaining <= 0 {
+		return decimal.Zero, nil
+	}
+
+	plan, err := planRepo.FindByAuthorAndTier(ctx, authorID, tier)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to load current plan: %w", err)
+	}
+	if plan == nil || plan.DurationDays <= 0 {
+		return decimal.Zero, nil
+	}
+
+	period := time.Duration(plan.DurationDays) * 24 * time.Hour
+	return plan.Price.
+		Mul(decimal.NewFromInt(int64(remaining / time.Second))).
+		Div(decimal.NewFromInt(int64(period / time.Second))).
+		Floor(), nil
+}
@@ -0,0 +1,132 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSubscriptionPricingService_Quote(t *testing.T) {
+	ctx := context.Background()
+	subscriberID := uuid.New()
+	authorID := uuid.New()
+
+	bronze := &entity.SubscriptionPlan{ID: uuid.New(), AuthorID: authorID, Tier: entity.TierBronze, Price: decimal.NewFromInt(30000), DurationDays: 30, IsActive: true}
+	silver := &entity.SubscriptionPlan{ID: uuid.New(), AuthorID: authorID, Tier: entity.TierSilver, Price: decimal.NewFromInt(90000), DurationDays: 30, IsActive: true}
+
+	setup := func(t *testing.T) (service.SubscriptionPricingService, *mocks.MockSubscriptionRepository, *mocks.MockSubscriptionPlanRepository) {
+		ctrl := gomock.NewController(t)
+		subRepo := mocks.NewMockSubscriptionRepository(ctrl)
+		planRepo := mocks.NewMockSubscriptionPlanRepository(ctrl)
+		return service.NewSubscriptionPricingService(subRepo, planRepo), subRepo, planRepo
+	}
+
+	t.Run("new_subscriber_pays_full_price", func(t *testing.T) {
+		svc, subRepo, planRepo := setup(t)
+		planRepo.EXPECT().FindByID(ctx, silver.ID).Return(silver, nil)
+		subRepo.EXPECT().FindBySubscriberAndAuthor(ctx, subscriberID, authorID).
+			Return(&entity.Subscription{SubscriberID: subscriberID, AuthorID: authorID, Tier: "FREE"}, nil)
+
+		quote, err := svc.Quote(ctx, subscriberID, authorID, silver.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, service.SubscriptionChangeNew, quote.Change)
+		assert.Equal(t, entity.TierFree, quote.CurrentTier)
+		assert.True(t, silver.Price.Equal(quote.AmountDue))
+		assert.Equal(t, quote.StartsAt.AddDate(0, 0, 30), quote.ExpiresAt)
+	})
+
+	t.Run("upgrade_credits_unused_days", func(t *testing.T) {
+		svc, subRepo, planRepo := setup(t)
+		// 10 of 30 days left on Bronze are worth a third of its price
+		expiresAt := time.Now().Add(10*24*time.Hour + time.Second)
+		planRepo.EXPECT().FindByID(ctx, silver.ID).Return(silver, nil)
+		subRepo.EXPECT().FindBySubscriberAndAuthor(ctx, subscriberID, authorID).
+			Return(&entity.Subscription{SubscriberID: subscriberID, AuthorID: authorID, Tier: "BRONZE", ExpiresAt: &expiresAt}, nil)
+		planRepo.EXPECT().FindByAuthorAndTier(ctx, authorID, entity.TierBronze).Return(bronze, nil)
+
+		quote, err := svc.Quote(ctx, subscriberID, authorID, silver.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, service.SubscriptionChangeUpgrade, quote.Change)
+		assert.Equal(t, entity.TierBronze, quote.CurrentTier)
+		assert.Equal(t, "10000", quote.Credit.String())
+		assert.Equal(t, "80000", quote.AmountDue.String())
+		assert.True(t, quote.StartsAt.Before(expiresAt))
+	})
+
+	t.Run("upgrade_during_grace_period_has_no_credit", func(t *testing.T) {
+		svc, subRepo, planRepo := setup(t)
+		expiresAt := time.Now().Add(-time.Hour)
+		graceEndsAt := time.Now().Add(time.Hour)
+		planRepo.EXPECT().FindByID(ctx, silver.ID).Return(silver, nil)
+		subRepo.EXPECT().FindBySubscriberAndAuthor(ctx, subscriberID, authorID).
+			Return(&entity.Subscription{Tier: "BRONZE", ExpiresAt: &expiresAt, GraceEndsAt: &graceEndsAt}, nil)
+
+		quote, err := svc.Quote(ctx, subscriberID, authorID, silver.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, service.SubscriptionChangeUpgrade, quote.Change)
+		assert.True(t, quote.Credit.IsZero())
+		assert.True(t, silver.Price.Equal(quote.AmountDue))
+	})
+
+	t.Run("renewal_stacks_on_expiry", func(t *testing.T) {
+		svc, subRepo, planRepo := setup(t)
+		expiresAt := time.Now().Add(5 * 24 * time.Hour)
+		planRepo.EXPECT().FindByID(ctx, bronze.ID).Return(bronze, nil)
+		subRepo.EXPECT().FindBySubscriberAndAuthor(ctx, subscriberID, authorID).
+			Return(&entity.Subscription{Tier: "BRONZE", ExpiresAt: &expiresAt}, nil)
+
+		quote, err := svc.Quote(ctx, subscriberID, authorID, bronze.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, service.SubscriptionChangeRenewal, quote.Change)
+		assert.Equal(t, expiresAt, quote.StartsAt)
+		assert.True(t, bronze.Price.Equal(quote.AmountDue))
+	})
+
+	t.Run("downgrade_starts_at_period_end", func(t *testing.T) {
+		svc, subRepo, planRepo := setup(t)
+		expiresAt := time.Now().Add(5 * 24 * time.Hour)
+		planRepo.EXPECT().FindByID(ctx, bronze.ID).Return(bronze, nil)
+		subRepo.EXPECT().FindBySubscriberAndAuthor(ctx, subscriberID, authorID).
+			Return(&entity.Subscription{Tier: "SILVER", ExpiresAt: &expiresAt}, nil)
+
+		quote, err := svc.Quote(ctx, subscriberID, authorID, bronze.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, service.SubscriptionChangeDowngrade, quote.Change)
+		assert.Equal(t, expiresAt, quote.StartsAt)
+		assert.Equal(t, expiresAt.AddDate(0, 0, 30), quote.ExpiresAt)
+		assert.True(t, bronze.Price.Equal(quote.AmountDue))
+	})
+
+	t.Run("inactive_plan_not_found", func(t *testing.T) {
+		svc, _, planRepo := setup(t)
+		inactive := *silver
+		inactive.IsActive = false
+		planRepo.EXPECT().FindByID(ctx, silver.ID).Return(&inactive, nil)
+
+		_, err := svc.Quote(ctx, subscriberID, authorID, silver.ID)
+
+		assert.ErrorIs(t, err, service.ErrPlanNotFound)
+	})
+
+	t.Run("own_plan", func(t *testing.T) {
+		svc, _, _ := setup(t)
+
+		_, err := svc.Quote(ctx, authorID, authorID, silver.ID)
+
+		assert.ErrorIs(t, err, service.ErrCannotSubscribeToSelf)
+	})
+}
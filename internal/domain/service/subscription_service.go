@@ -5,6 +5,7 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
@@ -29,11 +30,13 @@ type SubscriptionService interface {
 
 type subscriptionService struct {
 	subscriptionRepo repository.SubscriptionRepository
+	events           EventBus
 }
 
-func NewSubscriptionService(subscriptionRepo repository.SubscriptionRepository) SubscriptionService {
+func NewSubscriptionService(subscriptionRepo repository.SubscriptionRepository, events EventBus) SubscriptionService {
 	return &subscriptionService{
 		subscriptionRepo: subscriptionRepo,
+		events:           events,
 	}
 }
 
@@ -56,6 +59,14 @@ func (s *subscriptionService) Subscribe(ctx context.Context, subscriberID, autho
 		return nil, err
 	}
 
+	if s.events != nil {
+		s.events.Publish(ctx, UserFollowedEvent{
+			FollowerID: subscriberID,
+			FolloweeID: authorID,
+			OccurredAt: time.Now(),
+		})
+	}
+
 	return subscription, nil
 }
 
@@ -1,10 +1,57 @@
 package service_test
 
 import (
+	"context"
 	"testing"
+
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 )
 
-// Tests were removed because the functionality they tested (notification on subscribe)
-// is not currently implemented in SubscriptionService.
-func TestSubscriptionService_Placeholder(t *testing.T) {
+func TestSubscriptionService_Subscribe_PublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubRepo := repoMocks.NewMockSubscriptionRepository(ctrl)
+	mockBus := serviceMocks.NewMockEventBus(ctrl)
+
+	ctx := context.Background()
+	followerID := uuid.New()
+	authorID := uuid.New()
+
+	mockSubRepo.EXPECT().Exists(ctx, followerID, authorID).Return(false, nil)
+	mockSubRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	mockBus.EXPECT().Publish(ctx, gomock.Any()).Do(func(_ context.Context, event service.DomainEvent) {
+		e, ok := event.(service.UserFollowedEvent)
+		assert.True(t, ok)
+		assert.Equal(t, followerID, e.FollowerID)
+		assert.Equal(t, authorID, e.FolloweeID)
+	})
+
+	s := service.NewSubscriptionService(mockSubRepo, mockBus)
+	sub, err := s.Subscribe(ctx, followerID, authorID)
+	assert.NoError(t, err)
+	assert.Equal(t, authorID, sub.AuthorID)
+}
+
+func TestSubscriptionService_Subscribe_AlreadySubscribed_NoEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubRepo := repoMocks.NewMockSubscriptionRepository(ctrl)
+	mockBus := serviceMocks.NewMockEventBus(ctrl)
+
+	ctx := context.Background()
+	followerID := uuid.New()
+	authorID := uuid.New()
+
+	mockSubRepo.EXPECT().Exists(ctx, followerID, authorID).Return(true, nil)
+
+	s := service.NewSubscriptionService(mockSubRepo, mockBus)
+	_, err := s.Subscribe(ctx, followerID, authorID)
+	assert.ErrorIs(t, err, service.ErrAlreadySubscribed)
 }
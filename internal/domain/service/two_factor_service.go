@@ -0,0 +1,376 @@
+package service
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/pkg/logger"
+	"github.com/aiagent/pkg/secretbox"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorNotSetUp       = errors.New("two-factor authentication has not been set up")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+	ErrTwoFactorUnavailable    = errors.New("two-factor authentication is not configured")
+)
+
+// TOTP parameters (RFC 6238 defaults, which every authenticator app supports)
+const (
+	totpPeriod     = 30 * time.Second
+	totpDigits     = 6
+	totpSkew       = 1 // Accept codes one step before or after the current one for clock drift
+	totpSecretSize = 20
+
+	recoveryCodeCount    = 10
+	recoveryCodeLength   = 10
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789" // No 0/o, 1/l/i look-alikes
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TwoFactorSetup is what an authenticator app needs to enroll
+type TwoFactorSetup struct {
+	Secret          string
+	ProvisioningURI string // otpauth:// URI, rendered as a QR code by the client
+}
+
+// TwoFactorStatus summarizes a user's two-factor settings
+type TwoFactorStatus struct {
+	Enabled                bool
+	EnabledAt              *time.Time
+	RecoveryCodesRemaining int64
+}
+
+// TwoFactorService manages TOTP enrollment and verification
+type TwoFactorService interface {
+	// Setup starts enrollment with a fresh secret; it replaces an unconfirmed earlier setup
+	Setup(ctx context.Context, userID uuid.UUID, accountName string) (*TwoFactorSetup, error)
+	// Enable confirms enrollment with a code from the app and returns the recovery codes
+	Enable(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+	// Disable turns two-factor authentication off; the caller must have re-authenticated the user
+	Disable(ctx context.Context, userID uuid.UUID) error
+	// Verify checks a TOTP or recovery code; each code is accepted only once
+	Verify(ctx context.Context, userID uuid.UUID, code string) error
+	// RegenerateRecoveryCodes replaces all recovery codes after checking a TOTP or recovery code
+	RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+	GetStatus(ctx context.Context, userID uuid.UUID) (*TwoFactorStatus, error)
+	IsEnabled(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
+type twoFactorService struct {
+	repo   repository.TwoFactorRepository
+	issuer string
+	box    *secretbox.Box
+}
+
+// NewTwoFactorService creates a two-factor service; issuer is the name shown in authenticator apps.
+// Secrets are stored encrypted with box; without one no user can set up two-factor authentication.
+func NewTwoFactorService(repo repository.TwoFactorRepository, issuer string, box *secretbox.Box) TwoFactorService {
+	return &twoFactorService{
+		repo:   repo,
+		issuer: issuer,
+		box:    box,
+	}
+}
+
+func (s *twoFactorService) Setup(ctx context.Context, userID uuid.UUID, accountName string) (*TwoFactorSetup, error) {
+	current, err := s.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current.IsEnabled() {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+	if s.box == nil {
+		return nil, ErrTwoFactorUnavailable
+	}
+
+	raw := make([]byte, totpSecretSize)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+	secret := totpEncoding.EncodeToString(raw)
+	sealed, err := s.box.Seal(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Save(ctx, &entity.UserTwoFactor{
+		UserID:    userID,
+		Secret:    sealed,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &TwoFactorSetup{
+		Secret:          secret,
+		ProvisioningURI: s.provisioningURI(secret, accountName),
+	}, nil
+}
+
+func (s *twoFactorService) Enable(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	current, err := s.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, ErrTwoFactorNotSetUp
+	}
+	if current.IsEnabled() {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := s.openSecret(current)
+	if err != nil {
+		return nil, err
+	}
+	step, ok := validateTOTP(secret, code, time.Now())
+	if !ok {
+		return nil, ErrInvalidTwoFactorCode
+	}
+
+	now := time.Now()
+	current.EnabledAt = &now
+	current.LastUsedStep = step
+	if err := s.repo.Save(ctx, current); err != nil {
+		return nil, err
+	}
+	return s.newRecoveryCodes(ctx, userID)
+}
+
+func (s *twoFactorService) Disable(ctx context.Context, userID uuid.UUID) error {
+	current, err := s.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !current.IsEnabled() {
+		return ErrTwoFactorNotEnabled
+	}
+	return s.repo.Delete(ctx, userID)
+}
+
+func (s *twoFactorService) Verify(ctx context.Context, userID uuid.UUID, code string) error {
+	current, err := s.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !current.IsEnabled() {
+		return ErrTwoFactorNotEnabled
+	}
+
+	code = normalizeCode(code)
+	if len(code) == totpDigits {
+		secret, err := s.openSecret(current)
+		if err != nil {
+			return err
+		}
+		step, ok := validateTOTP(secret, code, time.Now())
+		if !ok {
+			return ErrInvalidTwoFactorCode
+		}
+		advanced, err := s.repo.AdvanceStep(ctx, userID, step)
+		if err != nil {
+			return err
+		}
+		if !advanced {
+			return ErrInvalidTwoFactorCode
+		}
+		s.sealLegacySecret(ctx, current, secret)
+		return nil
+	}
+
+	used, err := s.repo.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func (s *twoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	if err := s.Verify(ctx, userID, code); err != nil {
+		return nil, err
+	}
+	return s.newRecoveryCodes(ctx, userID)
+}
+
+func (s *twoFactorService) GetStatus(ctx context.Context, userID uuid.UUID) (*TwoFactorStatus, error) {
+	current, err := s.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !current.IsEnabled() {
+		return &TwoFactorStatus{}, nil
+	}
+
+	remaining, err := s.repo.CountUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &TwoFactorStatus{
+		Enabled:                true,
+		EnabledAt:              current.EnabledAt,
+		RecoveryCodesRemaining: remaining,
+	}, nil
+}
+
+func (s *twoFactorService) IsEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	current, err := s.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return current.IsEnabled(), nil
+}
+
+// openSecret decrypts the stored secret. Secrets enrolled before encryption was
+// introduced are still plain Base32 and are returned as they are.
+func (s *twoFactorService) openSecret(current *entity.UserTwoFactor) (string, error) {
+	if !secretbox.IsSealed(current.Secret) {
+		return current.Secret, nil
+	}
+	if s.box == nil {
+		return "", ErrTwoFactorUnavailable
+	}
+	secret, err := s.box.Open(current.Secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt two-factor secret: %w", err)
+	}
+	return secret, nil
+}
+
+// sealLegacySecret encrypts a secret still stored in plain text once it has been used successfully
+func (s *twoFactorService) sealLegacySecret(ctx context.Context, current *entity.UserTwoFactor, secret string) {
+	if s.box == nil || secretbox.IsSealed(current.Secret) {
+		return
+	}
+	sealed, err := s.box.Seal(secret)
+	if err == nil {
+		err = s.repo.UpdateSecret(ctx, current.UserID, sealed)
+	}
+	if err != nil {
+		logger.Error("failed to encrypt two-factor secret", err, map[string]interface{}{"user_id": current.UserID})
+	}
+}
+
+// newRecoveryCodes replaces the stored hashes and returns the plain codes, which are shown only once
+func (s *twoFactorService) newRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := randomRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	if err := s.repo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (s *twoFactorService) provisioningURI(secret, accountName string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", s.issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+
+	label := url.PathEscape(s.issuer + ":" + accountName)
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// GenerateTOTP returns the code an authenticator app shows for the secret at the given time
+func GenerateTOTP(secret string, at time.Time) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	return hotp(key, at.Unix()/int64(totpPeriod.Seconds())), nil
+}
+
+// validateTOTP returns the time step the code belongs to, allowing for clock drift
+func validateTOTP(secret, code string, at time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+
+	code = normalizeCode(code)
+	current := at.Unix() / int64(totpPeriod.Seconds())
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if hmac.Equal([]byte(hotp(key, step)), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// hotp computes an RFC 4226 one-time password for the counter
+func hotp(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+func randomRecoveryCode() (string, error) {
+	b := make([]byte, recoveryCodeLength)
+	alphabetSize := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		b[i] = recoveryCodeAlphabet[n.Int64()]
+	}
+	// Grouped for readability; the dash is ignored when the code is entered
+	return string(b[:recoveryCodeLength/2]) + "-" + string(b[recoveryCodeLength/2:]), nil
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(normalizeCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeCode strips the separators users type or paste along with a code
+func normalizeCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
@@ -0,0 +1,213 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	repoMocks "github.com/aiagent/internal/domain/repository/mocks"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/pkg/secretbox"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// RFC 6238 appendix B secret ("12345678901234567890") in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func newTestSecretBox(t *testing.T) *secretbox.Box {
+	box, err := secretbox.New(bytes.Repeat([]byte{42}, secretbox.KeySize))
+	require.NoError(t, err)
+	return box
+}
+
+func TestGenerateTOTP_RFC6238Vectors(t *testing.T) {
+	// The RFC lists 8-digit values; authenticator apps show the last 6
+	for unix, want := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	} {
+		code, err := service.GenerateTOTP(rfcSecret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, want, code, "T=%d", unix)
+	}
+}
+
+func TestTwoFactorService_SetupAndEnable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTwoFactorRepository(ctrl)
+	svc := service.NewTwoFactorService(mockRepo, "AIAgent", newTestSecretBox(t))
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	var stored *entity.UserTwoFactor
+	mockRepo.EXPECT().FindByUserID(ctx, userID).Return(nil, nil)
+	mockRepo.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tf *entity.UserTwoFactor) error {
+		stored = tf
+		return nil
+	})
+
+	setup, err := svc.Setup(ctx, userID, "ada@example.com")
+	require.NoError(t, err)
+	assert.False(t, stored.IsEnabled())
+	assert.True(t, secretbox.IsSealed(stored.Secret))
+	assert.NotContains(t, stored.Secret, setup.Secret, "the secret is encrypted at rest")
+
+	uri, err := url.Parse(setup.ProvisioningURI)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "/AIAgent:ada@example.com", uri.Path)
+	assert.Equal(t, setup.Secret, uri.Query().Get("secret"))
+	assert.Equal(t, "AIAgent", uri.Query().Get("issuer"))
+
+	t.Run("wrong_code", func(t *testing.T) {
+		mockRepo.EXPECT().FindByUserID(ctx, userID).Return(stored, nil)
+
+		_, err := svc.Enable(ctx, userID, "000000")
+
+		assert.ErrorIs(t, err, service.ErrInvalidTwoFactorCode)
+	})
+
+	t.Run("confirmed", func(t *testing.T) {
+		code, err := service.GenerateTOTP(setup.Secret, time.Now())
+		require.NoError(t, err)
+
+		var hashes []string
+		mockRepo.EXPECT().FindByUserID(ctx, userID).Return(stored, nil)
+		mockRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().ReplaceRecoveryCodes(ctx, userID, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, h []string) error {
+			hashes = h
+			return nil
+		})
+
+		codes, err := svc.Enable(ctx, userID, code)
+
+		require.NoError(t, err)
+		assert.True(t, stored.IsEnabled())
+		assert.NotZero(t, stored.LastUsedStep)
+		assert.Len(t, codes, 10)
+		require.Len(t, hashes, 10)
+		for i, c := range codes {
+			assert.NotContains(t, hashes[i], strings.ReplaceAll(c, "-", ""), "only hashes are stored")
+		}
+	})
+}
+
+func TestTwoFactorService_Verify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	box := newTestSecretBox(t)
+	mockRepo := repoMocks.NewMockTwoFactorRepository(ctrl)
+	svc := service.NewTwoFactorService(mockRepo, "AIAgent", box)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	enabledAt := time.Now().Add(-time.Hour)
+	sealed, err := box.Seal(rfcSecret)
+	require.NoError(t, err)
+	enabled := &entity.UserTwoFactor{UserID: userID, Secret: sealed, EnabledAt: &enabledAt}
+	code, err := service.GenerateTOTP(rfcSecret, time.Now())
+	require.NoError(t, err)
+
+	t.Run("totp_code", func(t *testing.T) {
+		mockRepo.EXPECT().FindByUserID(ctx, userID).Return(enabled, nil)
+		mockRepo.EXPECT().AdvanceStep(ctx, userID, gomock.Any()).Return(true, nil)
+
+		assert.NoError(t, svc.Verify(ctx, userID, code))
+	})
+
+	t.Run("plain_secret_is_encrypted_once_used", func(t *testing.T) {
+		legacy := &entity.UserTwoFactor{UserID: userID, Secret: rfcSecret, EnabledAt: &enabledAt}
+		mockRepo.EXPECT().FindByUserID(ctx, userID).Return(legacy, nil)
+		mockRepo.EXPECT().AdvanceStep(ctx, userID, gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().UpdateSecret(ctx, userID, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, secret string) error {
+			opened, err := box.Open(secret)
+			require.NoError(t, err)
+			assert.Equal(t, rfcSecret, opened)
+			return nil
+		})
+
+		assert.NoError(t, svc.Verify(ctx, userID, code))
+	})
+
+	t.Run("replayed_totp_code", func(t *testing.T) {
+		mockRepo.EXPECT().FindByUserID(ctx, userID).Return(enabled, nil)
+		mockRepo.EXPECT().AdvanceStep(ctx, userID, gomock.Any()).Return(false, nil)
+
+		assert.ErrorIs(t, svc.Verify(ctx, userID, code), service.ErrInvalidTwoFactorCode)
+	})
+
+	t.Run("recovery_code_is_normalized", func(t *testing.T) {
+		mockRepo.EXPECT().FindByUserID(ctx, userID).Return(enabled, nil)
+		var firstHash string
+		mockRepo.EXPECT().UseRecoveryCode(ctx, userID, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, hash string) (bool, error) {
+			firstHash = hash
+			return true, nil
+		})
+		require.NoError(t, svc.Verify(ctx, userID, "abcde-fghjk"))
+
+		mockRepo.EXPECT().FindByUserID(ctx, userID).Return(enabled, nil)
+		mockRepo.EXPECT().UseRecoveryCode(ctx, userID, firstHash).Return(false, nil)
+		assert.ErrorIs(t, svc.Verify(ctx, userID, " ABCDEFGHJK "), service.ErrInvalidTwoFactorCode)
+	})
+
+	t.Run("not_enabled", func(t *testing.T) {
+		mockRepo.EXPECT().FindByUserID(ctx, userID).Return(&entity.UserTwoFactor{UserID: userID, Secret: rfcSecret}, nil)
+
+		assert.ErrorIs(t, svc.Verify(ctx, userID, code), service.ErrTwoFactorNotEnabled)
+	})
+}
+
+func TestTwoFactorService_WithoutEncryptionKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTwoFactorRepository(ctrl)
+	svc := service.NewTwoFactorService(mockRepo, "AIAgent", nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockRepo.EXPECT().FindByUserID(ctx, userID).Return(nil, nil)
+
+	// No secret may be stored in plain text, so enrollment is refused
+	_, err := svc.Setup(ctx, userID, "ada@example.com")
+	assert.ErrorIs(t, err, service.ErrTwoFactorUnavailable)
+}
+
+func TestTwoFactorService_Disable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repoMocks.NewMockTwoFactorRepository(ctrl)
+	svc := service.NewTwoFactorService(mockRepo, "AIAgent", newTestSecretBox(t))
+
+	ctx := context.Background()
+	userID := uuid.New()
+	enabledAt := time.Now()
+
+	t.Run("enabled", func(t *testing.T) {
+		mockRepo.EXPECT().FindByUserID(ctx, userID).Return(&entity.UserTwoFactor{UserID: userID, EnabledAt: &enabledAt}, nil)
+		mockRepo.EXPECT().Delete(ctx, userID).Return(nil)
+
+		assert.NoError(t, svc.Disable(ctx, userID))
+	})
+
+	t.Run("not_enabled", func(t *testing.T) {
+		mockRepo.EXPECT().FindByUserID(ctx, userID).Return(nil, nil)
+
+		assert.ErrorIs(t, svc.Disable(ctx, userID), service.ErrTwoFactorNotEnabled)
+	})
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/pkg/textdiff"
+	"github.com/google/uuid"
+)
+
+func (s *versionService) CompareVersions(ctx context.Context, blogID uuid.UUID, fromID uuid.UUID, toID uuid.UUID) (*VersionComparison, error) {
+	from, err := s.GetVersion(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.GetVersion(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+	if from.BlogID != blogID || to.BlogID != blogID {
+		return nil, ErrVersionMismatch
+	}
+
+	content := textdiff.Compare(from.Content, to.Content, textdiff.DefaultContext)
+	metadata := textdiff.Compare(versionMetadata(from), versionMetadata(to), textdiff.DefaultContext)
+
+	return &VersionComparison{
+		From:    from,
+		To:      to,
+		Fields:  compareVersionFields(from, to),
+		Content: content,
+		Unified: metadata.Unified(versionFileName(from, "metadata"), versionFileName(to, "metadata")) +
+			content.Unified(versionFileName(from, "content"), versionFileName(to, "content")),
+	}, nil
+}
+
+func compareVersionFields(from, to *entity.BlogVersion) []VersionFieldChange {
+	changes := []VersionFieldChange{}
+	if from.Title != to.Title {
+		changes = append(changes, VersionFieldChange{Field: "title", From: from.Title, To: to.Title})
+	}
+	if from.Slug != to.Slug {
+		changes = append(changes, VersionFieldChange{Field: "slug", From: from.Slug, To: to.Slug})
+	}
+	if from.Visibility != to.Visibility {
+		changes = append(changes, VersionFieldChange{Field: "visibility", From: from.Visibility, To: to.Visibility})
+	}
+	if !sameCategory(from.CategoryID, to.CategoryID) {
+		changes = append(changes, VersionFieldChange{Field: "category", From: categoryName(from), To: categoryName(to)})
+	}
+
+	fromTags, toTags := tagNames(from.Tags), tagNames(to.Tags)
+	added, removed := diffTags(from.Tags, to.Tags)
+	if len(added) > 0 || len(removed) > 0 {
+		changes = append(changes, VersionFieldChange{Field: "tags", From: fromTags, To: toTags, Added: added, Removed: removed})
+	}
+	return changes
+}
+
+func sameCategory(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// categoryName names the category of a version, by ID when it was not loaded, nil when it has none
+func categoryName(v *entity.BlogVersion) interface{} {
+	if v.CategoryID == nil {
+		return nil
+	}
+	if v.Category != nil {
+		return v.Category.Name
+	}
+	return v.CategoryID.String()
+}
+
+// diffTags returns the names of the tags only in to and only in from, sorted
+func diffTags(from, to []entity.Tag) (added, removed []string) {
+	inFrom := make(map[uuid.UUID]bool, len(from))
+	for _, t := range from {
+		inFrom[t.ID] = true
+	}
+	inTo := make(map[uuid.UUID]bool, len(to))
+	for _, t := range to {
+		inTo[t.ID] = true
+		if !inFrom[t.ID] {
+			added = append(added, t.Name)
+		}
+	}
+	for _, t := range from {
+		if !inTo[t.ID] {
+			removed = append(removed, t.Name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+func tagNames(tags []entity.Tag) []string {
+	names := make([]string, 0, len(tags))
+	for _, t := range tags {
+		names = append(names, t.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// versionMetadata lists the compared fields of a version one per line, for the unified diff
+func versionMetadata(v *entity.BlogVersion) string {
+	category := ""
+	if name := categoryName(v); name != nil {
+		category = name.(string)
+	}
+	return fmt.Sprintf("title: %s\nslug: %s\nvisibility: %s\ncategory: %s\ntags: %s\n",
+		v.Title, v.Slug, v.Visibility, category, strings.Join(tagNames(v.Tags), ", "))
+}
+
+func versionFileName(v *entity.BlogVersion, part string) string {
+	return fmt.Sprintf("v%d/%s", v.VersionNumber, part)
+}
@@ -8,14 +8,42 @@ import (
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/pkg/textdiff"
 	"github.com/google/uuid"
 )
 
 var (
-	ErrVersionNotFound = errors.New("blog version not found")
-	ErrVersionMismatch = errors.New("version does not belong to blog")
+	ErrVersionNotFound         = errors.New("blog version not found")
+	ErrVersionMismatch         = errors.New("version does not belong to blog")
+	ErrInvalidVersionRetention = errors.New("invalid version retention policy")
 )
 
+const (
+	// DefaultMaxVersions is how many versions are kept of a blog without a retention policy
+	DefaultMaxVersions = 50
+	// MaxRetainedVersions is the most versions a retention policy may keep
+	MaxRetainedVersions = 200
+)
+
+// VersionFieldChange is a field that differs between two versions. Tags are compared by name;
+// Added and Removed are only set for them.
+type VersionFieldChange struct {
+	Field   string
+	From    interface{}
+	To      interface{}
+	Added   []string
+	Removed []string
+}
+
+// VersionComparison is what changed from one version of a blog to another
+type VersionComparison struct {
+	From    *entity.BlogVersion
+	To      *entity.BlogVersion
+	Fields  []VersionFieldChange
+	Content *textdiff.Diff
+	Unified string // Metadata and content changes as a unified diff
+}
+
 type VersionService interface {
 	// CreateVersion creates a new version from a blog
 	CreateVersion(ctx context.Context, blog *entity.Blog, editorID uuid.UUID, changeSummary string) (*entity.BlogVersion, error)
@@ -31,4 +59,16 @@ type VersionService interface {
 
 	// DeleteVersion deletes a version
 	DeleteVersion(ctx context.Context, versionID uuid.UUID, requesterID uuid.UUID) error
+
+	// CompareVersions diffs two versions of a blog, from fromID to toID
+	CompareVersions(ctx context.Context, blogID uuid.UUID, fromID uuid.UUID, toID uuid.UUID) (*VersionComparison, error)
+
+	// GetRetention gets the retention policy of a blog, the default one when it has none
+	GetRetention(ctx context.Context, blogID uuid.UUID) (*entity.BlogVersionRetention, error)
+
+	// SetRetention saves the retention policy of a blog and prunes its versions right away
+	SetRetention(ctx context.Context, retention *entity.BlogVersionRetention) error
+
+	// PruneExpiredVersions deletes the versions past the age limit of every blog that has one
+	PruneExpiredVersions(ctx context.Context) (int64, error)
 }
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/pkg/logger"
 	"github.com/google/uuid"
 )
 
@@ -56,19 +58,9 @@ func (s *versionService) CreateVersion(ctx context.Context, blog *entity.Blog, e
 		return nil, err
 	}
 
-	// Auto-cleanup: keep 50 versions
-	// Fire and forget or blocking? Spec doesn't specify, but safer to block or log error.
-	// Spec says "Call Repository.DeleteOldest(blogID, 50) (keep 50)"
-	// We'll treat it as part of the flow.
-	if err := s.versionRepo.DeleteOldest(ctx, blog.ID, 50); err != nil {
-		// Just log error in real world, but here we might return it or ignore.
-		// Since spec lists it as a step, I'll return error if it fails strictly,
-		// or maybe it's better to just proceed.
-		// Given the test expectation, I should probably respect the return.
-		// However, cleanup failure shouldn't necessarily fail the creation.
-		// But in my test I mocked it to return nil.
-		// I'll return error to be safe.
-		// In production usually we do this async.
+	// Pruning failures only leave extra versions behind until the next one is saved
+	if err := s.prune(ctx, blog.ID); err != nil {
+		logger.Error("failed to prune blog versions", err, map[string]interface{}{"blog_id": blog.ID})
 	}
 
 	return version, nil
@@ -169,3 +161,51 @@ func (s *versionService) DeleteVersion(ctx context.Context, versionID uuid.UUID,
 
 	return s.versionRepo.Delete(ctx, versionID)
 }
+
+func (s *versionService) GetRetention(ctx context.Context, blogID uuid.UUID) (*entity.BlogVersionRetention, error) {
+	retention, err := s.versionRepo.FindRetention(ctx, blogID)
+	if err != nil {
+		return nil, err
+	}
+	if retention == nil {
+		return &entity.BlogVersionRetention{BlogID: blogID, MaxVersions: DefaultMaxVersions}, nil
+	}
+	return retention, nil
+}
+
+func (s *versionService) SetRetention(ctx context.Context, retention *entity.BlogVersionRetention) error {
+	if retention.MaxVersions < 1 || retention.MaxVersions > MaxRetainedVersions {
+		return ErrInvalidVersionRetention
+	}
+	if retention.MaxAgeDays != nil && *retention.MaxAgeDays < 1 {
+		return ErrInvalidVersionRetention
+	}
+
+	if err := s.versionRepo.SaveRetention(ctx, retention); err != nil {
+		return err
+	}
+	return s.prune(ctx, retention.BlogID)
+}
+
+func (s *versionService) PruneExpiredVersions(ctx context.Context) (int64, error) {
+	return s.versionRepo.DeleteExpired(ctx)
+}
+
+// prune applies the retention policy of a blog to its versions
+func (s *versionService) prune(ctx context.Context, blogID uuid.UUID) error {
+	retention, err := s.GetRetention(ctx, blogID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.versionRepo.DeleteOldest(ctx, blogID, retention.MaxVersions); err != nil {
+		return err
+	}
+	if retention.MaxAgeDays != nil {
+		before := time.Now().AddDate(0, 0, -*retention.MaxAgeDays)
+		if _, err := s.versionRepo.DeleteCreatedBefore(ctx, blogID, before); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
@@ -43,7 +44,8 @@ func TestVersionService_CreateVersion(t *testing.T) {
 			assert.Equal(t, "Test Blog", v.Title)
 			return nil
 		})
-		mockVersionRepo.EXPECT().DeleteOldest(ctx, blogID, 50).Return(nil)
+		mockVersionRepo.EXPECT().FindRetention(ctx, blogID).Return(nil, nil)
+		mockVersionRepo.EXPECT().DeleteOldest(ctx, blogID, service.DefaultMaxVersions).Return(nil)
 
 		v, err := svc.CreateVersion(ctx, blog, editorID, "update")
 		assert.NoError(t, err)
@@ -51,6 +53,32 @@ func TestVersionService_CreateVersion(t *testing.T) {
 		assert.Equal(t, nextVersion, v.VersionNumber)
 	})
 
+	t.Run("applies_retention_policy", func(t *testing.T) {
+		maxAgeDays := 30
+		mockVersionRepo.EXPECT().GetNextVersionNumber(ctx, blogID).Return(2, nil)
+		mockVersionRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockVersionRepo.EXPECT().FindRetention(ctx, blogID).Return(&entity.BlogVersionRetention{BlogID: blogID, MaxVersions: 5, MaxAgeDays: &maxAgeDays}, nil)
+		mockVersionRepo.EXPECT().DeleteOldest(ctx, blogID, 5).Return(nil)
+		mockVersionRepo.EXPECT().DeleteCreatedBefore(ctx, blogID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, before time.Time) (int64, error) {
+				assert.WithinDuration(t, time.Now().AddDate(0, 0, -30), before, time.Minute)
+				return 3, nil
+			})
+
+		_, err := svc.CreateVersion(ctx, blog, editorID, "update")
+		assert.NoError(t, err)
+	})
+
+	t.Run("pruning_failure_does_not_fail", func(t *testing.T) {
+		mockVersionRepo.EXPECT().GetNextVersionNumber(ctx, blogID).Return(3, nil)
+		mockVersionRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockVersionRepo.EXPECT().FindRetention(ctx, blogID).Return(nil, errors.New("db error"))
+
+		v, err := svc.CreateVersion(ctx, blog, editorID, "update")
+		assert.NoError(t, err)
+		assert.NotNil(t, v)
+	})
+
 	t.Run("error_get_next_version", func(t *testing.T) {
 		mockVersionRepo.EXPECT().GetNextVersionNumber(ctx, blogID).Return(0, errors.New("db error"))
 
@@ -163,7 +191,8 @@ func TestVersionService_RestoreVersion(t *testing.T) {
 		// Create new version
 		mockVersionRepo.EXPECT().GetNextVersionNumber(ctx, blogID).Return(10, nil)
 		mockVersionRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
-		mockVersionRepo.EXPECT().DeleteOldest(ctx, blogID, 50).Return(nil)
+		mockVersionRepo.EXPECT().FindRetention(ctx, blogID).Return(nil, nil)
+		mockVersionRepo.EXPECT().DeleteOldest(ctx, blogID, service.DefaultMaxVersions).Return(nil)
 
 		res, err := svc.RestoreVersion(ctx, blogID, versionID, editorID)
 		assert.NoError(t, err)
@@ -254,3 +283,108 @@ func TestVersionService_DeleteVersion(t *testing.T) {
 		// Assuming generic access denied error or specific
 	})
 }
+
+func TestVersionService_CompareVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionRepo := mocks.NewMockBlogVersionRepository(ctrl)
+	svc := service.NewVersionService(mockVersionRepo, mocks.NewMockBlogRepository(ctrl))
+
+	ctx := context.Background()
+	blogID := uuid.New()
+	goTag := entity.Tag{ID: uuid.New(), Name: "go"}
+	dbTag := entity.Tag{ID: uuid.New(), Name: "databases"}
+	apiTag := entity.Tag{ID: uuid.New(), Name: "api"}
+	category := &entity.Category{ID: uuid.New(), Name: "Backend"}
+
+	from := &entity.BlogVersion{
+		ID: uuid.New(), BlogID: blogID, VersionNumber: 2,
+		Title: "Intro", Slug: "intro", Visibility: entity.BlogVisibilityPublic,
+		Content: "# Intro\n\nHello world.\n",
+		Tags:    []entity.Tag{goTag, dbTag},
+	}
+	to := &entity.BlogVersion{
+		ID: uuid.New(), BlogID: blogID, VersionNumber: 5,
+		Title: "Introduction", Slug: "intro", Visibility: entity.BlogVisibilitySubscribersOnly,
+		CategoryID: &category.ID, Category: category,
+		Content: "# Intro\n\nHello there.\n",
+		Tags:    []entity.Tag{apiTag, goTag},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockVersionRepo.EXPECT().FindByID(ctx, from.ID).Return(from, nil)
+		mockVersionRepo.EXPECT().FindByID(ctx, to.ID).Return(to, nil)
+
+		cmp, err := svc.CompareVersions(ctx, blogID, from.ID, to.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []service.VersionFieldChange{
+			{Field: "title", From: "Intro", To: "Introduction"},
+			{Field: "visibility", From: entity.BlogVisibilityPublic, To: entity.BlogVisibilitySubscribersOnly},
+			{Field: "category", From: nil, To: "Backend"},
+			{Field: "tags", From: []string{"databases", "go"}, To: []string{"api", "go"}, Added: []string{"api"}, Removed: []string{"databases"}},
+		}, cmp.Fields)
+		assert.Equal(t, 1, cmp.Content.Additions)
+		assert.Equal(t, 1, cmp.Content.Deletions)
+		assert.Equal(t, "--- v2/metadata\n+++ v5/metadata\n@@ -1,5 +1,5 @@\n"+
+			"-title: Intro\n+title: Introduction\n slug: intro\n-visibility: public\n-category: \n-tags: databases, go\n"+
+			"+visibility: subscribers_only\n+category: Backend\n+tags: api, go\n"+
+			"--- v2/content\n+++ v5/content\n@@ -1,3 +1,3 @@\n # Intro\n \n-Hello world.\n+Hello there.\n", cmp.Unified)
+	})
+
+	t.Run("version_of_other_blog", func(t *testing.T) {
+		other := &entity.BlogVersion{ID: uuid.New(), BlogID: uuid.New()}
+		mockVersionRepo.EXPECT().FindByID(ctx, from.ID).Return(from, nil)
+		mockVersionRepo.EXPECT().FindByID(ctx, other.ID).Return(other, nil)
+
+		_, err := svc.CompareVersions(ctx, blogID, from.ID, other.ID)
+		assert.ErrorIs(t, err, service.ErrVersionMismatch)
+	})
+
+	t.Run("version_not_found", func(t *testing.T) {
+		mockVersionRepo.EXPECT().FindByID(ctx, from.ID).Return(nil, nil)
+
+		_, err := svc.CompareVersions(ctx, blogID, from.ID, to.ID)
+		assert.ErrorIs(t, err, service.ErrVersionNotFound)
+	})
+}
+
+func TestVersionService_SetRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVersionRepo := mocks.NewMockBlogVersionRepository(ctrl)
+	svc := service.NewVersionService(mockVersionRepo, mocks.NewMockBlogRepository(ctrl))
+
+	ctx := context.Background()
+	blogID := uuid.New()
+
+	t.Run("saves_and_prunes", func(t *testing.T) {
+		retention := &entity.BlogVersionRetention{BlogID: blogID, MaxVersions: 10}
+		mockVersionRepo.EXPECT().SaveRetention(ctx, retention).Return(nil)
+		mockVersionRepo.EXPECT().FindRetention(ctx, blogID).Return(retention, nil)
+		mockVersionRepo.EXPECT().DeleteOldest(ctx, blogID, 10).Return(nil)
+
+		assert.NoError(t, svc.SetRetention(ctx, retention))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		zero := 0
+		for _, retention := range []*entity.BlogVersionRetention{
+			{BlogID: blogID, MaxVersions: 0},
+			{BlogID: blogID, MaxVersions: service.MaxRetainedVersions + 1},
+			{BlogID: blogID, MaxVersions: 10, MaxAgeDays: &zero},
+		} {
+			assert.ErrorIs(t, svc.SetRetention(ctx, retention), service.ErrInvalidVersionRetention)
+		}
+	})
+
+	t.Run("default_policy", func(t *testing.T) {
+		mockVersionRepo.EXPECT().FindRetention(ctx, blogID).Return(nil, nil)
+
+		retention, err := svc.GetRetention(ctx, blogID)
+		assert.NoError(t, err)
+		assert.Equal(t, &entity.BlogVersionRetention{BlogID: blogID, MaxVersions: service.DefaultMaxVersions}, retention)
+	})
+}
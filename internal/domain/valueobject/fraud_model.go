@@ -131,15 +131,34 @@ type BatchAnalyzeCommand struct {
 // BatchAnalyzeResult represents the result of batch analysis
 type BatchAnalyzeResult struct {
 	JobID              uuid.UUID
-	Status             string // "started", "completed", "failed"
+	Status             string // "started", "running", "completed", "failed", "cancelled"
+	DateFrom           time.Time
+	DateTo             time.Time
 	StartedAt          time.Time
 	CompletedAt        *time.Time
 	ProcessedFollowers int
 	NewSignalsDetected int
 	UsersScored        int
+	Errors             []string
 	Message            string
 }
 
+// BatchJobFilter represents filters for listing batch jobs
+type BatchJobFilter struct {
+	Status   string // running, completed, failed, cancelled
+	Page     int
+	PageSize int
+}
+
+// BatchJobListResult represents a page of batch jobs
+type BatchJobListResult struct {
+	Jobs       []BatchAnalyzeResult
+	TotalCount int
+	Page       int
+	PageSize   int
+	TotalPages int
+}
+
 // UserBadgeResult represents a user's badge status
 type UserBadgeResult struct {
 	UserID        uuid.UUID
@@ -0,0 +1,58 @@
+package adapter
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/aiagent/internal/infrastructure/config"
+)
+
+const (
+	BlobStoreLocal = "local"
+	BlobStoreS3    = "s3"
+)
+
+// ErrInvalidBlobKey is returned for keys that are empty, absolute or escape the store
+var ErrInvalidBlobKey = errors.New("invalid blob key")
+
+// BlobStore stores uploaded objects under slash-separated keys and tells where they are served from
+type BlobStore interface {
+	// Put stores data under key, replacing any existing object
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+
+	// Delete removes the object under key; deleting a missing object is not an error
+	Delete(ctx context.Context, key string) error
+
+	// URL returns the public URL of the object under key
+	URL(key string) string
+}
+
+// NewBlobStore creates the BlobStore selected by the storage driver
+func NewBlobStore(cfg *config.StorageConfig) (BlobStore, error) {
+	switch cfg.Driver {
+	case BlobStoreLocal:
+		return NewLocalBlobStore(cfg.LocalDir, cfg.PublicURL), nil
+	case BlobStoreS3:
+		return NewS3BlobStore(&cfg.S3, cfg.PublicURL)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
+
+// validBlobKey returns true for relative keys without empty, . or .. segments
+func validBlobKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+	return path.Clean(key) == key && !strings.HasPrefix(key, "../") && key != ".." && key != "."
+}
+
+// joinURL appends key to a base URL
+func joinURL(base, key string) string {
+	return strings.TrimSuffix(base, "/") + "/" + key
+}
@@ -0,0 +1,196 @@
+package adapter
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aiagent/internal/infrastructure/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type s3Object struct {
+	data        []byte
+	contentType string
+}
+
+// s3Fake is a MinIO-style S3 server keeping path-style objects in memory. It rejects
+// requests whose Signature Version 4 authorization does not match.
+type s3Fake struct {
+	server  *httptest.Server
+	mu      sync.Mutex
+	objects map[string]s3Object
+}
+
+var s3AuthPattern = regexp.MustCompile(`^AWS4-HMAC-SHA256 Credential=([^/]+)/(\d{8})/([^/]+)/s3/aws4_request, SignedHeaders=([^,]+), Signature=([0-9a-f]{64})$`)
+
+func newS3Fake(t *testing.T) *s3Fake {
+	f := &s3Fake{objects: map[string]s3Object{}}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if !f.authorized(r, body) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "<Error><Code>SignatureDoesNotMatch</Code></Error>")
+			return
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			f.objects[r.URL.Path] = s3Object{data: body, contentType: r.Header.Get("Content-Type")}
+			w.WriteHeader(http.StatusOK)
+		case http.MethodDelete:
+			delete(f.objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// authorized recomputes the signature the way S3 does, from the request as received
+func (f *s3Fake) authorized(r *http.Request, body []byte) bool {
+	m := s3AuthPattern.FindStringSubmatch(r.Header.Get("Authorization"))
+	if m == nil || m[1] != "minio-access" || m[4] != s3SignedHeaders {
+		return false
+	}
+	if r.Header.Get("X-Amz-Content-Sha256") != sha256Hex(body) {
+		return false
+	}
+	amzDate := r.Header.Get("X-Amz-Date")
+	if !strings.HasPrefix(amzDate, m[2]) {
+		return false
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method, r.URL.EscapedPath(), r.URL.RawQuery,
+		"host:" + r.Host,
+		"x-amz-content-sha256:" + r.Header.Get("X-Amz-Content-Sha256"),
+		"x-amz-date:" + amzDate,
+		"", m[4], sha256Hex(body),
+	}, "\n")
+	scope := m[2] + "/" + m[3] + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+	expected := hmacSHA256(sigV4SigningKey("minio-secret", m[2], m[3], "s3"), []byte(stringToSign))
+	return hex.EncodeToString(expected) == m[5]
+}
+
+func (f *s3Fake) store(t *testing.T, secret string) BlobStore {
+	store, err := NewBlobStore(&config.StorageConfig{
+		Driver: BlobStoreS3,
+		S3: config.S3Config{
+			Endpoint:     f.server.URL,
+			Region:       "us-east-1",
+			Bucket:       "media",
+			AccessKey:    "minio-access",
+			SecretKey:    secret,
+			UsePathStyle: true,
+		},
+	})
+	require.NoError(t, err)
+	return store
+}
+
+func TestSigV4SigningKey(t *testing.T) {
+	// Example from the AWS Signature Version 4 documentation
+	key := sigV4SigningKey("wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "20150830", "us-east-1", "iam")
+
+	assert.Equal(t, "c4afb1cc5771d871763a393e44b703571b55cc28424d1a5e86da6ed3c154a4b9", hex.EncodeToString(key))
+}
+
+func TestS3BlobStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("put_and_delete", func(t *testing.T) {
+		f := newS3Fake(t)
+		store := f.store(t, "minio-secret")
+
+		require.NoError(t, store.Put(ctx, "media/u1/a b+c.png", []byte("png-bytes"), "image/png"))
+
+		obj, ok := f.objects["/media/media/u1/a b+c.png"]
+		require.True(t, ok)
+		assert.Equal(t, []byte("png-bytes"), obj.data)
+		assert.Equal(t, "image/png", obj.contentType)
+		assert.Equal(t, f.server.URL+"/media/media/u1/x.png", store.URL("media/u1/x.png"))
+
+		require.NoError(t, store.Delete(ctx, "media/u1/a b+c.png"))
+		assert.Empty(t, f.objects)
+		// Deleting again is not an error
+		assert.NoError(t, store.Delete(ctx, "media/u1/a b+c.png"))
+	})
+
+	t.Run("wrong_secret_is_rejected", func(t *testing.T) {
+		f := newS3Fake(t)
+		store := f.store(t, "not-the-secret")
+
+		err := store.Put(ctx, "media/u1/x.png", []byte("png-bytes"), "image/png")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "403")
+		assert.Empty(t, f.objects)
+	})
+
+	t.Run("invalid_key", func(t *testing.T) {
+		store := newS3Fake(t).store(t, "minio-secret")
+
+		assert.ErrorIs(t, store.Put(ctx, "../other-bucket/x", []byte("x"), "image/png"), ErrInvalidBlobKey)
+	})
+
+	t.Run("virtual_hosted_and_public_url", func(t *testing.T) {
+		store, err := NewS3BlobStore(&config.S3Config{Endpoint: "https://s3.eu-west-1.amazonaws.com", Region: "eu-west-1", Bucket: "media"}, "")
+		require.NoError(t, err)
+		assert.Equal(t, "https://media.s3.eu-west-1.amazonaws.com/a/b.jpg", store.URL("a/b.jpg"))
+
+		store, err = NewS3BlobStore(&config.S3Config{Endpoint: "https://s3.eu-west-1.amazonaws.com", Region: "eu-west-1", Bucket: "media"}, "https://cdn.example/")
+		require.NoError(t, err)
+		assert.Equal(t, "https://cdn.example/a/b.jpg", store.URL("a/b.jpg"))
+	})
+}
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := NewBlobStore(&config.StorageConfig{Driver: BlobStoreLocal, LocalDir: root, PublicURL: "/uploads"})
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "media/u1/a.png", []byte("png-bytes"), "image/png"))
+
+	data, err := os.ReadFile(filepath.Join(root, "media", "u1", "a.png"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("png-bytes"), data)
+	assert.Equal(t, "/uploads/media/u1/a.png", store.URL("media/u1/a.png"))
+
+	// No temporary files are left next to the object
+	entries, err := os.ReadDir(filepath.Join(root, "media", "u1"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, store.Delete(ctx, "media/u1/a.png"))
+	_, err = os.Stat(filepath.Join(root, "media", "u1", "a.png"))
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, store.Delete(ctx, "media/u1/a.png"))
+
+	for _, key := range []string{"", "/etc/passwd", "../escape.png", "media/../../escape.png", "media//a.png", `media\..\a.png`} {
+		assert.ErrorIs(t, store.Put(ctx, key, []byte("x"), "image/png"), ErrInvalidBlobKey, key)
+	}
+}
+
+func TestNewBlobStore_UnknownDriver(t *testing.T) {
+	_, err := NewBlobStore(&config.StorageConfig{Driver: "ftp"})
+
+	assert.Error(t, err)
+}
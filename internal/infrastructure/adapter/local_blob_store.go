@@ -0,0 +1,86 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type localBlobStore struct {
+	root      string
+	publicURL string
+}
+
+// NewLocalBlobStore creates a BlobStore writing under root on the local disk, served from
+// publicURL. It suits a single instance; replicas need a shared store such as S3.
+func NewLocalBlobStore(root, publicURL string) BlobStore {
+	return &localBlobStore{
+		root:      root,
+		publicURL: publicURL,
+	}
+}
+
+// Put writes to a temporary file renamed into place, so readers never see a partial object
+func (s *localBlobStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create blob: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return fmt.Errorf("failed to store blob: %w", err)
+	}
+	return nil
+}
+
+func (s *localBlobStore) Delete(ctx context.Context, key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+func (s *localBlobStore) URL(key string) string {
+	return joinURL(s.publicURL, key)
+}
+
+// path returns the file of key, making sure it stays under the root directory
+func (s *localBlobStore) path(key string) (string, error) {
+	if !validBlobKey(key) {
+		return "", ErrInvalidBlobKey
+	}
+	root, err := filepath.Abs(s.root)
+	if err != nil {
+		return "", err
+	}
+	filePath := filepath.Join(root, filepath.FromSlash(key))
+	if !strings.HasPrefix(filePath, root+string(filepath.Separator)) {
+		return "", ErrInvalidBlobKey
+	}
+	return filePath, nil
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: internal/infrastructure/adapter/sepay_adapter.go
+// Source: sepay_adapter.go
 //
 // Generated by this command:
 //
-//	mockgen -source=internal/infrastructure/adapter/sepay_adapter.go -destination=internal/infrastructure/adapter/mocks/mock_sepay_adapter.go -package=mocks
+//	mockgen -source=sepay_adapter.go -destination=mocks/mock_sepay_adapter.go -package=mocks
 //
 
 // Package mocks is a generated GoMock package.
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	adapter "github.com/aiagent/internal/infrastructure/adapter"
 	gomock "go.uber.org/mock/gomock"
@@ -85,6 +86,21 @@ func (mr *MockSePayAdapterMockRecorder) GetTransactionBySePayID(ctx, sepayID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionBySePayID", reflect.TypeOf((*MockSePayAdapter)(nil).GetTransactionBySePayID), ctx, sepayID)
 }
 
+// ListTransactions mocks base method.
+func (m *MockSePayAdapter) ListTransactions(ctx context.Context, since, until time.Time, limit int) ([]adapter.SePayTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx, since, until, limit)
+	ret0, _ := ret[0].([]adapter.SePayTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MockSePayAdapterMockRecorder) ListTransactions(ctx, since, until, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockSePayAdapter)(nil).ListTransactions), ctx, since, until, limit)
+}
+
 // VerifyWebhookSignature mocks base method.
 func (m *MockSePayAdapter) VerifyWebhookSignature(payload map[string]any, signature string) bool {
 	m.ctrl.T.Helper()
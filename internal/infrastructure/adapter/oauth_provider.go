@@ -0,0 +1,256 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aiagent/internal/infrastructure/config"
+	"golang.org/x/oauth2"
+)
+
+// Supported social login providers
+const (
+	OAuthProviderGoogle   = "google"
+	OAuthProviderGitHub   = "github"
+	OAuthProviderFacebook = "facebook"
+)
+
+// OAuthUserInfo is the provider profile needed to sign a user in
+type OAuthUserInfo struct {
+	ID            string
+	Name          string
+	Email         string
+	EmailVerified bool
+}
+
+// OAuthProvider runs the authorization-code flow (with PKCE) against one identity provider
+type OAuthProvider interface {
+	Name() string
+	// AuthCodeURL builds the consent page URL carrying the state and the S256 challenge of the verifier
+	AuthCodeURL(state, codeVerifier string) string
+	// Exchange trades the authorization code for a token and loads the user's profile
+	Exchange(ctx context.Context, code, codeVerifier string) (*OAuthUserInfo, error)
+}
+
+// profileFetcher loads the profile with an HTTP client that already carries the access token
+type profileFetcher func(ctx context.Context, client *http.Client, userInfoURL string) (*OAuthUserInfo, error)
+
+type oauthProvider struct {
+	name         string
+	config       *oauth2.Config
+	userInfoURL  string
+	fetchProfile profileFetcher
+	httpClient   *http.Client
+}
+
+func newOAuthProvider(name string, cfg config.OAuthProviderConfig, endpoint oauth2.Endpoint, defaultScopes []string, userInfoURL string, fetch profileFetcher) OAuthProvider {
+	if cfg.AuthURL != "" {
+		endpoint.AuthURL = cfg.AuthURL
+	}
+	if cfg.TokenURL != "" {
+		endpoint.TokenURL = cfg.TokenURL
+	}
+	if cfg.UserInfoURL != "" {
+		userInfoURL = cfg.UserInfoURL
+	}
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	return &oauthProvider{
+		name: name,
+		config: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       scopes,
+			Endpoint:     endpoint,
+		},
+		userInfoURL:  userInfoURL,
+		fetchProfile: fetch,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// NewGoogleProvider creates the Google OpenID Connect provider
+func NewGoogleProvider(cfg config.OAuthProviderConfig) OAuthProvider {
+	endpoint := oauth2.Endpoint{
+		AuthURL:  "https://accounts.google.com/o/oauth2/v2/auth",
+		TokenURL: "https://oauth2.googleapis.com/token",
+	}
+	return newOAuthProvider(OAuthProviderGoogle, cfg, endpoint,
+		[]string{"openid", "email", "profile"},
+		"https://openidconnect.googleapis.com/v1/userinfo",
+		fetchGoogleProfile)
+}
+
+// NewGitHubProvider creates the GitHub provider
+func NewGitHubProvider(cfg config.OAuthProviderConfig) OAuthProvider {
+	endpoint := oauth2.Endpoint{
+		AuthURL:  "https://github.com/login/oauth/authorize",
+		TokenURL: "https://github.com/login/oauth/access_token",
+	}
+	return newOAuthProvider(OAuthProviderGitHub, cfg, endpoint,
+		[]string{"read:user", "user:email"},
+		"https://api.github.com/user",
+		fetchGitHubProfile)
+}
+
+// NewFacebookProvider creates the Facebook provider
+func NewFacebookProvider(cfg config.OAuthProviderConfig) OAuthProvider {
+	endpoint := oauth2.Endpoint{
+		AuthURL:  "https://www.facebook.com/v19.0/dialog/oauth",
+		TokenURL: "https://graph.facebook.com/v19.0/oauth/access_token",
+	}
+	return newOAuthProvider(OAuthProviderFacebook, cfg, endpoint,
+		[]string{"email", "public_profile"},
+		"https://graph.facebook.com/me?fields=id,name,email",
+		fetchFacebookProfile)
+}
+
+// NewOAuthProviders creates every provider that has a client ID configured
+func NewOAuthProviders(cfg *config.OAuthConfig) []OAuthProvider {
+	var providers []OAuthProvider
+	if cfg.Google.ClientID != "" {
+		providers = append(providers, NewGoogleProvider(cfg.Google))
+	}
+	if cfg.GitHub.ClientID != "" {
+		providers = append(providers, NewGitHubProvider(cfg.GitHub))
+	}
+	if cfg.Facebook.ClientID != "" {
+		providers = append(providers, NewFacebookProvider(cfg.Facebook))
+	}
+	return providers
+}
+
+// Name returns the provider key used in routes and social accounts
+func (p *oauthProvider) Name() string {
+	return p.name
+}
+
+// AuthCodeURL builds the provider's consent page URL
+func (p *oauthProvider) AuthCodeURL(state, codeVerifier string) string {
+	return p.config.AuthCodeURL(state, oauth2.S256ChallengeOption(codeVerifier))
+}
+
+// Exchange trades the authorization code for a token and loads the user's profile
+func (p *oauthProvider) Exchange(ctx context.Context, code, codeVerifier string) (*OAuthUserInfo, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+
+	token, err := p.config.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("%s token exchange failed: %w", p.name, err)
+	}
+
+	info, err := p.fetchProfile(ctx, p.config.Client(ctx, token), p.userInfoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s profile: %w", p.name, err)
+	}
+	if info.ID == "" {
+		return nil, fmt.Errorf("%s profile has no user id", p.name)
+	}
+	return info, nil
+}
+
+func fetchGoogleProfile(ctx context.Context, client *http.Client, userInfoURL string) (*OAuthUserInfo, error) {
+	var profile struct {
+		Sub           string `json:"sub"`
+		Name          string `json:"name"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+	}
+	if err := getJSON(ctx, client, userInfoURL, &profile); err != nil {
+		return nil, err
+	}
+	return &OAuthUserInfo{
+		ID:            profile.Sub,
+		Name:          profile.Name,
+		Email:         profile.Email,
+		EmailVerified: profile.EmailVerified,
+	}, nil
+}
+
+func fetchGitHubProfile(ctx context.Context, client *http.Client, userInfoURL string) (*OAuthUserInfo, error) {
+	var profile struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Name  string `json:"name"`
+	}
+	if err := getJSON(ctx, client, userInfoURL, &profile); err != nil {
+		return nil, err
+	}
+
+	info := &OAuthUserInfo{Name: profile.Name}
+	if profile.ID != 0 {
+		info.ID = strconv.FormatInt(profile.ID, 10)
+	}
+	if info.Name == "" {
+		info.Name = profile.Login
+	}
+
+	// The public profile email may be hidden or unverified; the emails endpoint is authoritative
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(ctx, client, userInfoURL+"/emails", &emails); err != nil {
+		return nil, err
+	}
+	for _, e := range emails {
+		if e.Primary {
+			info.Email = e.Email
+			info.EmailVerified = e.Verified
+			break
+		}
+	}
+	return info, nil
+}
+
+func fetchFacebookProfile(ctx context.Context, client *http.Client, userInfoURL string) (*OAuthUserInfo, error) {
+	var profile struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+	if err := getJSON(ctx, client, userInfoURL, &profile); err != nil {
+		return nil, err
+	}
+	// Facebook only returns confirmed email addresses
+	return &OAuthUserInfo{
+		ID:            profile.ID,
+		Name:          profile.Name,
+		Email:         profile.Email,
+		EmailVerified: profile.Email != "",
+	}, nil
+}
+
+// getJSON performs an authenticated GET and decodes the JSON response
+func getJSON(ctx context.Context, client *http.Client, url string, dest interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("profile api returned status: %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package adapter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/aiagent/internal/infrastructure/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeIdP is a minimal authorization server: it issues a code bound to the
+// PKCE challenge and only exchanges it when the matching verifier is sent
+type fakeIdP struct {
+	server    *httptest.Server
+	challenge string
+	profiles  map[string]interface{} // path -> JSON body
+}
+
+func newFakeIdP(t *testing.T, profiles map[string]interface{}) *fakeIdP {
+	idp := &fakeIdP{profiles: profiles}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "good-code" || base64.RawURLEncoding.EncodeToString(sum[:]) != idp.challenge {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token-abc","token_type":"Bearer","expires_in":3600}`))
+	})
+	for path, body := range profiles {
+		body := body
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token-abc" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(body)
+		})
+	}
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *fakeIdP) config() config.OAuthProviderConfig {
+	return config.OAuthProviderConfig{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "http://localhost/callback",
+		AuthURL:      idp.server.URL + "/authorize",
+		TokenURL:     idp.server.URL + "/token",
+		UserInfoURL:  idp.server.URL + "/user",
+	}
+}
+
+// authorize plays the browser leg: it reads the challenge from the consent URL
+func (idp *fakeIdP) authorize(t *testing.T, provider OAuthProvider, state, verifier string) {
+	u, err := url.Parse(provider.AuthCodeURL(state, verifier))
+	require.NoError(t, err)
+	assert.Equal(t, state, u.Query().Get("state"))
+	assert.Equal(t, "S256", u.Query().Get("code_challenge_method"))
+	idp.challenge = u.Query().Get("code_challenge")
+}
+
+func TestOAuthProvider_GoogleExchange(t *testing.T) {
+	idp := newFakeIdP(t, map[string]interface{}{
+		"/user": map[string]interface{}{"sub": "g-1", "name": "Ada", "email": "ada@example.com", "email_verified": true},
+	})
+	provider := NewGoogleProvider(idp.config())
+
+	idp.authorize(t, provider, "state-1", "verifier-with-enough-entropy-0123456789abc")
+	info, err := provider.Exchange(context.Background(), "good-code", "verifier-with-enough-entropy-0123456789abc")
+
+	require.NoError(t, err)
+	assert.Equal(t, &OAuthUserInfo{ID: "g-1", Name: "Ada", Email: "ada@example.com", EmailVerified: true}, info)
+}
+
+func TestOAuthProvider_RejectsWrongVerifier(t *testing.T) {
+	idp := newFakeIdP(t, map[string]interface{}{
+		"/user": map[string]interface{}{"sub": "g-1"},
+	})
+	provider := NewGoogleProvider(idp.config())
+
+	idp.authorize(t, provider, "state-1", "verifier-with-enough-entropy-0123456789abc")
+	_, err := provider.Exchange(context.Background(), "good-code", "someone-elses-verifier-0123456789abcdefghij")
+
+	assert.Error(t, err)
+}
+
+func TestOAuthProvider_GitHubUsesPrimaryEmail(t *testing.T) {
+	idp := newFakeIdP(t, map[string]interface{}{
+		"/user": map[string]interface{}{"id": 42, "login": "octocat", "name": ""},
+		"/user/emails": []map[string]interface{}{
+			{"email": "old@example.com", "primary": false, "verified": true},
+			{"email": "octo@example.com", "primary": true, "verified": false},
+		},
+	})
+	provider := NewGitHubProvider(idp.config())
+
+	idp.authorize(t, provider, "state-2", "verifier-with-enough-entropy-0123456789abc")
+	info, err := provider.Exchange(context.Background(), "good-code", "verifier-with-enough-entropy-0123456789abc")
+
+	require.NoError(t, err)
+	assert.Equal(t, "42", info.ID)
+	assert.Equal(t, "octocat", info.Name)
+	assert.Equal(t, "octo@example.com", info.Email)
+	assert.False(t, info.EmailVerified)
+}
+
+func TestNewOAuthProviders_OnlyConfigured(t *testing.T) {
+	providers := NewOAuthProviders(&config.OAuthConfig{
+		GitHub:   config.OAuthProviderConfig{ClientID: "gh"},
+		Facebook: config.OAuthProviderConfig{ClientID: "fb"},
+	})
+
+	require.Len(t, providers, 2)
+	assert.Equal(t, OAuthProviderGitHub, providers[0].Name())
+	assert.Equal(t, OAuthProviderFacebook, providers[1].Name())
+}
@@ -0,0 +1,94 @@
+package adapter
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrInvalidWebhookSignature is returned when a webhook was not signed by the provider
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	// ErrWebhookNotSupported is returned by providers whose notifications arrive on their own route
+	ErrWebhookNotSupported = errors.New("provider webhooks are not received here")
+	// ErrRefundNotSupported is returned by providers whose refunds are sent by hand
+	ErrRefundNotSupported = errors.New("provider does not support refunds")
+	// ErrRefundDeclined is returned when the provider refused a refund, so no money was sent
+	ErrRefundDeclined = errors.New("provider declined the refund")
+	// ErrProviderPaymentNotFound is returned when the provider has no record of a payment
+	ErrProviderPaymentNotFound = errors.New("provider payment not found")
+)
+
+// ProviderPaymentStatus is the state of a payment at its provider
+type ProviderPaymentStatus string
+
+// ProviderPaymentStatus values
+const (
+	ProviderPaymentPending ProviderPaymentStatus = "pending"
+	ProviderPaymentPaid    ProviderPaymentStatus = "paid"
+	ProviderPaymentFailed  ProviderPaymentStatus = "failed"
+)
+
+// PaymentSessionRequest describes an order to collect money for
+type PaymentSessionRequest struct {
+	OrderID     string
+	Amount      decimal.Decimal
+	Currency    string
+	Gateway     string
+	Description string
+}
+
+// PaymentSession tells the payer how to pay an order
+type PaymentSession struct {
+	// ProviderRef identifies the payment at the provider; empty when it is only known once the
+	// money arrives, as with bank transfers
+	ProviderRef string
+	CheckoutURL string // Hosted checkout page the payer is redirected to
+	QRData      string
+	QRDataURL   string
+	BankName    string
+	AccountNo   string
+	AccountName string
+}
+
+// ProviderPayment is a payment as reported by its provider
+type ProviderPayment struct {
+	ProviderRef string
+	OrderID     string
+	Status      ProviderPaymentStatus
+	Amount      decimal.Decimal
+	Currency    string
+}
+
+// IsPaid returns true once the provider has collected the money
+func (p *ProviderPayment) IsPaid() bool {
+	return p.Status == ProviderPaymentPaid
+}
+
+// ProviderRefund is money sent back to the payer by the provider
+type ProviderRefund struct {
+	ProviderRef string
+}
+
+// PaymentProvider collects payments for orders through an external payment provider
+type PaymentProvider interface {
+	// SupportsGateway returns true if the provider offers the payment method
+	SupportsGateway(gateway string) bool
+
+	// CreatePayment starts collecting the payment of an order
+	CreatePayment(ctx context.Context, req PaymentSessionRequest) (*PaymentSession, error)
+
+	// ParseWebhook verifies that a webhook was sent by the provider and returns the payment it
+	// reports, or nil for events that do not settle a payment
+	ParseWebhook(body []byte, signature string) (*ProviderPayment, error)
+
+	// GetPayment looks up the current state of a payment
+	GetPayment(ctx context.Context, providerRef string) (*ProviderPayment, error)
+
+	// Refund returns part or all of a payment to the payer. Calls repeated with the same
+	// idempotency key return the first refund instead of sending the money again.
+	Refund(ctx context.Context, providerRef string, amount decimal.Decimal, currency string, idempotencyKey string) (*ProviderRefund, error)
+}
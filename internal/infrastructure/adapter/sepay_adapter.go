@@ -4,13 +4,22 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/aiagent/internal/infrastructure/config"
 )
 
+// ErrSePayTransactionNotFound is returned when SePay has no record of a transaction
+var ErrSePayTransactionNotFound = errors.New("sepay transaction not found")
+
+// sepayDefaultBaseURL is used when no base URL is configured
+const sepayDefaultBaseURL = "https://api.sepay.vn/v1"
+
 // CreateVietQRRequest represents the request to create a VietQR code
 type CreateVietQRRequest struct {
 	AccountNo   string `json:"accountNo"`
@@ -51,6 +60,9 @@ type SePayTransaction struct {
 	ReferenceCode string  `json:"referenceCode"`
 }
 
+// SePayDateLayout is how SePay formats transfer dates and date filters
+const SePayDateLayout = "2006-01-02 15:04:05"
+
 // SePayTransactionResponse represents the response from SePay for transaction details
 type SePayTransactionResponse struct {
 	Status int              `json:"status"`
@@ -58,12 +70,22 @@ type SePayTransactionResponse struct {
 	Data   SePayTransaction `json:"data"`
 }
 
+// SePayTransactionListResponse represents the response from SePay for a transaction listing
+type SePayTransactionListResponse struct {
+	Status int                `json:"status"`
+	Error  interface{}        `json:"error"`
+	Data   []SePayTransaction `json:"data"`
+}
+
 // SePayAdapter defines the interface for SePay payment gateway operations
 type SePayAdapter interface {
 	CreateVietQR(ctx context.Context, req CreateVietQRRequest) (*VietQRResponse, error)
 	GetBankTransferInfo() BankTransferInfo
 	VerifyWebhookSignature(payload map[string]interface{}, signature string) bool
 	GetTransactionBySePayID(ctx context.Context, sepayID string) (*SePayTransaction, error)
+	// ListTransactions returns up to limit transfers received between since and until, newest
+	// first. A zero until leaves the range open-ended.
+	ListTransactions(ctx context.Context, since, until time.Time, limit int) ([]SePayTransaction, error)
 }
 
 type sePayAdapter struct {
@@ -74,12 +96,16 @@ type sePayAdapter struct {
 
 // NewSePayAdapter creates a new instance of SePayAdapter
 func NewSePayAdapter(cfg *config.SePayConfig) SePayAdapter {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = sepayDefaultBaseURL
+	}
 	return &sePayAdapter{
 		config: cfg,
 		client: &http.Client{
 			Timeout: 10 * time.Second,
 		},
-		baseURL: "https://api.sepay.vn/v1",
+		baseURL: baseURL,
 	}
 }
 
@@ -153,6 +179,9 @@ func (a *sePayAdapter) GetTransactionBySePayID(ctx context.Context, sepayID stri
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrSePayTransactionNotFound
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("sepay api returned status: %d", resp.StatusCode)
 	}
@@ -164,3 +193,41 @@ func (a *sePayAdapter) GetTransactionBySePayID(ctx context.Context, sepayID stri
 
 	return &result.Data, nil
 }
+
+// ListTransactions retrieves incoming transfers to the configured bank account from SePay API
+func (a *sePayAdapter) ListTransactions(ctx context.Context, since, until time.Time, limit int) ([]SePayTransaction, error) {
+	query := url.Values{}
+	query.Set("transaction_date_min", since.Format(SePayDateLayout))
+	if !until.IsZero() {
+		query.Set("transaction_date_max", until.Format(SePayDateLayout))
+	}
+	query.Set("limit", strconv.Itoa(limit))
+	if a.config.BankAccount != "" {
+		query.Set("account_number", a.config.BankAccount)
+	}
+	endpoint := fmt.Sprintf("%s/transactions/list?%s", a.baseURL, query.Encode())
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Authorization", "Bearer "+a.config.APIKey)
+
+	resp, err := a.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("sepay api returned status: %d", resp.StatusCode)
+	}
+
+	var result SePayTransactionListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.Data, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
+	"time"
 )
 
 func TestSePayAdapter_CreateVietQR(t *testing.T) {
@@ -112,3 +113,47 @@ func TestSePayAdapter_VerifyWebhookSignature(t *testing.T) {
 		assert.False(t, adapter.VerifyWebhookSignature(payload, signature))
 	})
 }
+
+func TestSePayAdapter_GetTransactionBySePayID_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	adapter := NewSePayAdapter(&config.SePayConfig{BaseURL: server.URL, APIKey: "test_key"})
+
+	res, err := adapter.GetTransactionBySePayID(context.Background(), "404")
+
+	assert.ErrorIs(t, err, ErrSePayTransactionNotFound)
+	assert.Nil(t, res)
+}
+
+func TestSePayAdapter_ListTransactions(t *testing.T) {
+	since := time.Date(2026, 10, 15, 8, 30, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/transactions/list", r.URL.Path)
+		assert.Equal(t, "Bearer test_key", r.Header.Get("Authorization"))
+		assert.Equal(t, "2026-10-15 08:30:00", r.URL.Query().Get("transaction_date_min"))
+		assert.Equal(t, "2026-10-15 09:00:00", r.URL.Query().Get("transaction_date_max"))
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+		assert.Equal(t, "123456789", r.URL.Query().Get("account_number"))
+
+		json.NewEncoder(w).Encode(SePayTransactionListResponse{
+			Status: 200,
+			Data: []SePayTransaction{
+				{ID: "1", Amount: 10000, Content: "ORDER-SEPAY-1"},
+				{ID: "2", Amount: 20000, Content: "ORDER-SEPAY-2"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	adapter := NewSePayAdapter(&config.SePayConfig{BaseURL: server.URL, APIKey: "test_key", BankAccount: "123456789"})
+
+	res, err := adapter.ListTransactions(context.Background(), since, since.Add(30*time.Minute), 50)
+
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "ORDER-SEPAY-2", res[1].Content)
+}
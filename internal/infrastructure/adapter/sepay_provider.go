@@ -0,0 +1,90 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// SePay payment methods and currency
+const (
+	sepayGatewayVietQR       = "VIETQR"
+	sepayGatewayBankTransfer = "BANK_TRANSFER"
+	sepayCurrency            = "VND"
+)
+
+type sePayProvider struct {
+	sepay SePayAdapter
+}
+
+// NewSePayProvider exposes SePay bank transfers as a PaymentProvider. The transfer ID that
+// identifies a payment is only known once the money arrives.
+func NewSePayProvider(sepay SePayAdapter) PaymentProvider {
+	return &sePayProvider{sepay: sepay}
+}
+
+// SupportsGateway returns true for VietQR and manual bank transfers
+func (p *sePayProvider) SupportsGateway(gateway string) bool {
+	return gateway == sepayGatewayVietQR || gateway == sepayGatewayBankTransfer
+}
+
+// CreatePayment returns the QR code or bank account the payer transfers to
+func (p *sePayProvider) CreatePayment(ctx context.Context, req PaymentSessionRequest) (*PaymentSession, error) {
+	if req.Currency != sepayCurrency {
+		return nil, fmt.Errorf("sepay cannot collect %s", req.Currency)
+	}
+
+	session := &PaymentSession{}
+	switch req.Gateway {
+	case sepayGatewayVietQR:
+		qrResp, err := p.sepay.CreateVietQR(ctx, CreateVietQRRequest{
+			Amount:  int(req.Amount.IntPart()),
+			AddInfo: req.OrderID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create VietQR: %w", err)
+		}
+		session.QRData = qrResp.Data.QrCode
+		session.QRDataURL = qrResp.Data.QrDataURL
+	case sepayGatewayBankTransfer:
+		bankInfo := p.sepay.GetBankTransferInfo()
+		session.BankName = bankInfo.BankName
+		session.AccountNo = bankInfo.AccountNo
+		session.AccountName = bankInfo.AccountName
+	default:
+		return nil, fmt.Errorf("sepay does not support gateway %s", req.Gateway)
+	}
+	return session, nil
+}
+
+// ParseWebhook is not used for SePay. Transfers are only received on the SePay webhook route,
+// which ignores outgoing transfers, so every notification is settled by one path.
+func (p *sePayProvider) ParseWebhook(body []byte, signature string) (*ProviderPayment, error) {
+	return nil, ErrWebhookNotSupported
+}
+
+// GetPayment looks up a received transfer by its SePay ID
+func (p *sePayProvider) GetPayment(ctx context.Context, providerRef string) (*ProviderPayment, error) {
+	transfer, err := p.sepay.GetTransactionBySePayID(ctx, providerRef)
+	if errors.Is(err, ErrSePayTransactionNotFound) {
+		return nil, ErrProviderPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProviderPayment{
+		ProviderRef: transfer.ID,
+		OrderID:     transfer.Content,
+		Status:      ProviderPaymentPaid,
+		Amount:      decimal.NewFromFloat(transfer.Amount),
+		Currency:    sepayCurrency,
+	}, nil
+}
+
+// Refund is not available; bank transfers are returned by hand
+func (p *sePayProvider) Refund(ctx context.Context, providerRef string, amount decimal.Decimal, currency string, idempotencyKey string) (*ProviderRefund, error) {
+	return nil, ErrRefundNotSupported
+}
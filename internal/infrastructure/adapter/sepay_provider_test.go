@@ -0,0 +1,45 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aiagent/internal/infrastructure/config"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSePayProvider_CreatePayment(t *testing.T) {
+	provider := NewSePayProvider(NewSePayAdapter(&config.SePayConfig{
+		BankName:    "MBBank",
+		BankAccount: "0123",
+		BankOwner:   "AI AGENT",
+	}))
+
+	assert.True(t, provider.SupportsGateway("VIETQR"))
+	assert.False(t, provider.SupportsGateway("CARD"))
+
+	session, err := provider.CreatePayment(context.Background(), PaymentSessionRequest{
+		OrderID:  "ORDER-1",
+		Amount:   decimal.NewFromInt(50000),
+		Currency: "VND",
+		Gateway:  "BANK_TRANSFER",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &PaymentSession{BankName: "MBBank", AccountNo: "0123", AccountName: "AI AGENT"}, session)
+
+	_, err = provider.CreatePayment(context.Background(), PaymentSessionRequest{Currency: "USD", Gateway: "BANK_TRANSFER"})
+	assert.Error(t, err)
+
+	_, err = provider.Refund(context.Background(), "1", decimal.NewFromInt(1), "VND", "key")
+	assert.ErrorIs(t, err, ErrRefundNotSupported)
+}
+
+func TestSePayProvider_ParseWebhook(t *testing.T) {
+	provider := NewSePayProvider(NewSePayAdapter(&config.SePayConfig{WebhookToken: "token"}))
+
+	payment, err := provider.ParseWebhook([]byte(`{"id":42,"content":"ORDER-1","transferType":"in","transferAmount":50000}`), "token")
+	assert.ErrorIs(t, err, ErrWebhookNotSupported)
+	assert.Nil(t, payment)
+}
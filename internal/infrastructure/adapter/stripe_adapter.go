@@ -0,0 +1,327 @@
+package adapter
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aiagent/internal/infrastructure/config"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// stripeDefaultBaseURL is used when no base URL is configured
+	stripeDefaultBaseURL = "https://api.stripe.com"
+	// stripeSignatureTolerance is how old a signed webhook may be, limiting replays
+	stripeSignatureTolerance = 5 * time.Minute
+	stripeGatewayCard        = "CARD"
+)
+
+// stripeZeroDecimal lists the currencies Stripe amounts are not expressed in cents for
+var stripeZeroDecimal = map[string]bool{"VND": true, "JPY": true, "KRW": true}
+
+// StripeCheckoutSession is a hosted checkout page as returned by Stripe
+type StripeCheckoutSession struct {
+	ID                string `json:"id"`
+	URL               string `json:"url"`
+	ClientReferenceID string `json:"client_reference_id"`
+	AmountTotal       int64  `json:"amount_total"`
+	Currency          string `json:"currency"`
+	Status            string `json:"status"`         // open, complete or expired
+	PaymentStatus     string `json:"payment_status"` // paid, unpaid or no_payment_required
+	PaymentIntent     string `json:"payment_intent"`
+}
+
+// StripeRefund is a refund as returned by Stripe
+type StripeRefund struct {
+	ID     string `json:"id"`
+	Amount int64  `json:"amount"`
+	Status string `json:"status"`
+}
+
+// stripeEvent is a webhook notification; only checkout session events are handled
+type stripeEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object StripeCheckoutSession `json:"object"`
+	} `json:"data"`
+}
+
+// stripeError is the error body returned by the Stripe API
+type stripeError struct {
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// stripeAPIError is a request Stripe answered with an error status
+type stripeAPIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *stripeAPIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("stripe api returned status: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("stripe api returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// rejected returns true if Stripe refused the request itself; conflicts, rate limits and
+// server errors may succeed when retried
+func (e *stripeAPIError) rejected() bool {
+	return e.StatusCode >= 400 && e.StatusCode < 500 &&
+		e.StatusCode != http.StatusConflict && e.StatusCode != http.StatusTooManyRequests
+}
+
+type stripeAdapter struct {
+	config  *config.StripeConfig
+	client  *http.Client
+	baseURL string
+	now     func() time.Time
+}
+
+// NewStripeAdapter creates a PaymentProvider taking card payments through Stripe hosted checkout
+func NewStripeAdapter(cfg *config.StripeConfig) PaymentProvider {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = stripeDefaultBaseURL
+	}
+	return &stripeAdapter{
+		config: cfg,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		now:     time.Now,
+	}
+}
+
+// SupportsGateway returns true for card payments
+func (a *stripeAdapter) SupportsGateway(gateway string) bool {
+	return gateway == stripeGatewayCard
+}
+
+// CreatePayment opens a checkout session the payer is redirected to
+func (a *stripeAdapter) CreatePayment(ctx context.Context, req PaymentSessionRequest) (*PaymentSession, error) {
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("client_reference_id", req.OrderID)
+	form.Set("metadata[order_id]", req.OrderID)
+	form.Set("success_url", a.config.SuccessURL)
+	form.Set("cancel_url", a.config.CancelURL)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", strings.ToLower(req.Currency))
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(toStripeAmount(req.Amount, req.Currency), 10))
+	form.Set("line_items[0][price_data][product_data][name]", req.Description)
+
+	var session StripeCheckoutSession
+	if err := a.do(ctx, http.MethodPost, "/v1/checkout/sessions", form, "", &session); err != nil {
+		return nil, fmt.Errorf("failed to create checkout session: %w", err)
+	}
+
+	return &PaymentSession{
+		ProviderRef: session.ID,
+		CheckoutURL: session.URL,
+	}, nil
+}
+
+// ParseWebhook verifies the Stripe-Signature header and returns the checkout session it reports
+func (a *stripeAdapter) ParseWebhook(body []byte, signature string) (*ProviderPayment, error) {
+	if err := a.verifySignature(body, signature); err != nil {
+		return nil, err
+	}
+
+	var event stripeEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook: %w", err)
+	}
+
+	switch event.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded":
+		return sessionPayment(&event.Data.Object), nil
+	}
+	return nil, nil
+}
+
+// verifySignature checks the HMAC-SHA256 of "timestamp.body" under the webhook secret
+func (a *stripeAdapter) verifySignature(body []byte, header string) error {
+	if a.config.WebhookSecret == "" {
+		return ErrInvalidWebhookSignature
+	}
+
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidWebhookSignature
+	}
+	if age := a.now().Sub(time.Unix(seconds, 0)); age > stripeSignatureTolerance || age < -stripeSignatureTolerance {
+		return ErrInvalidWebhookSignature
+	}
+
+	expected := signStripePayload(a.config.WebhookSecret, timestamp, body)
+	for _, sig := range signatures {
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			return nil
+		}
+	}
+	return ErrInvalidWebhookSignature
+}
+
+// signStripePayload returns the hex signature Stripe sends for a webhook body
+func signStripePayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// GetPayment looks up a checkout session
+func (a *stripeAdapter) GetPayment(ctx context.Context, providerRef string) (*ProviderPayment, error) {
+	session, err := a.getSession(ctx, providerRef)
+	if err != nil {
+		return nil, err
+	}
+	return sessionPayment(session), nil
+}
+
+// Refund returns money through the payment intent of a paid checkout session. The idempotency
+// key is passed on to Stripe, which answers a retry with the refund it already created.
+func (a *stripeAdapter) Refund(ctx context.Context, providerRef string, amount decimal.Decimal, currency string, idempotencyKey string) (*ProviderRefund, error) {
+	session, err := a.getSession(ctx, providerRef)
+	if err != nil {
+		return nil, err
+	}
+	if session.PaymentIntent == "" {
+		return nil, fmt.Errorf("checkout session %s has no payment to refund", providerRef)
+	}
+
+	form := url.Values{}
+	form.Set("payment_intent", session.PaymentIntent)
+	form.Set("amount", strconv.FormatInt(toStripeAmount(amount, currency), 10))
+
+	var refund StripeRefund
+	if err := a.do(ctx, http.MethodPost, "/v1/refunds", form, idempotencyKey, &refund); err != nil {
+		var apiErr *stripeAPIError
+		if errors.As(err, &apiErr) && apiErr.rejected() {
+			return nil, fmt.Errorf("%w: %s", ErrRefundDeclined, apiErr.Message)
+		}
+		return nil, fmt.Errorf("failed to create refund: %w", err)
+	}
+	return &ProviderRefund{ProviderRef: refund.ID}, nil
+}
+
+func (a *stripeAdapter) getSession(ctx context.Context, id string) (*StripeCheckoutSession, error) {
+	var session StripeCheckoutSession
+	if err := a.do(ctx, http.MethodGet, "/v1/checkout/sessions/"+url.PathEscape(id), nil, "", &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// do calls the Stripe API with a form-encoded body and decodes the response into out. A non-empty
+// idempotency key is sent as the Idempotency-Key header.
+func (a *stripeAdapter) do(ctx context.Context, method, path string, form url.Values, idempotencyKey string, out interface{}) error {
+	var body io.Reader = http.NoBody
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, a.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+a.config.SecretKey)
+	if form != nil {
+		httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if idempotencyKey != "" {
+		httpReq.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := a.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrProviderPaymentNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &stripeAPIError{StatusCode: resp.StatusCode}
+		var body stripeError
+		if json.NewDecoder(resp.Body).Decode(&body) == nil {
+			apiErr.Message = body.Error.Message
+		}
+		return apiErr
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// sessionPayment converts a checkout session into the payment it collects
+func sessionPayment(session *StripeCheckoutSession) *ProviderPayment {
+	currency := strings.ToUpper(session.Currency)
+	status := ProviderPaymentPending
+	switch {
+	case session.PaymentStatus == "paid":
+		status = ProviderPaymentPaid
+	case session.Status == "expired":
+		status = ProviderPaymentFailed
+	}
+
+	return &ProviderPayment{
+		ProviderRef: session.ID,
+		OrderID:     session.ClientReferenceID,
+		Status:      status,
+		Amount:      fromStripeAmount(session.AmountTotal, currency),
+		Currency:    currency,
+	}
+}
+
+// toStripeAmount converts an amount to the smallest currency unit Stripe expects
+func toStripeAmount(amount decimal.Decimal, currency string) int64 {
+	if stripeZeroDecimal[strings.ToUpper(currency)] {
+		return amount.Round(0).IntPart()
+	}
+	return amount.Shift(2).Round(0).IntPart()
+}
+
+// fromStripeAmount converts an amount in the smallest currency unit back to a decimal
+func fromStripeAmount(amount int64, currency string) decimal.Decimal {
+	if stripeZeroDecimal[strings.ToUpper(currency)] {
+		return decimal.NewFromInt(amount)
+	}
+	return decimal.New(amount, -2)
+}
@@ -0,0 +1,241 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aiagent/internal/infrastructure/config"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stripeFake serves the checkout session and refund endpoints from memory
+type stripeFake struct {
+	server   *httptest.Server
+	sessions map[string]StripeCheckoutSession
+	refunds  []map[string]string
+
+	refundStatus int // Status refunds are answered with instead of succeeding, when set
+}
+
+func newStripeFake(t *testing.T) *stripeFake {
+	f := &stripeFake{sessions: map[string]StripeCheckoutSession{}}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer sk_test", r.Header.Get("Authorization"))
+		require.NoError(t, r.ParseForm())
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/checkout/sessions":
+			amount, _ := decimal.NewFromString(r.PostForm.Get("line_items[0][price_data][unit_amount]"))
+			session := StripeCheckoutSession{
+				ID:                fmt.Sprintf("cs_test_%d", len(f.sessions)+1),
+				ClientReferenceID: r.PostForm.Get("client_reference_id"),
+				AmountTotal:       amount.IntPart(),
+				Currency:          r.PostForm.Get("line_items[0][price_data][currency]"),
+				Status:            "open",
+				PaymentStatus:     "unpaid",
+			}
+			session.URL = "https://checkout.example/" + session.ID
+			f.sessions[session.ID] = session
+			json.NewEncoder(w).Encode(session)
+		case r.Method == http.MethodGet && len(r.URL.Path) > len("/v1/checkout/sessions/"):
+			session, ok := f.sessions[r.URL.Path[len("/v1/checkout/sessions/"):]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]string{"message": "No such checkout.session"}})
+				return
+			}
+			json.NewEncoder(w).Encode(session)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/refunds" && f.refundStatus != 0:
+			w.WriteHeader(f.refundStatus)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]string{"message": "Charge has already been refunded."}})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/refunds":
+			f.refunds = append(f.refunds, map[string]string{
+				"payment_intent":  r.PostForm.Get("payment_intent"),
+				"amount":          r.PostForm.Get("amount"),
+				"idempotency_key": r.Header.Get("Idempotency-Key"),
+			})
+			json.NewEncoder(w).Encode(StripeRefund{ID: fmt.Sprintf("re_%d", len(f.refunds)), Status: "succeeded"})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]string{"message": "unexpected request"}})
+		}
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *stripeFake) adapter() *stripeAdapter {
+	return NewStripeAdapter(&config.StripeConfig{
+		BaseURL:       f.server.URL,
+		SecretKey:     "sk_test",
+		WebhookSecret: "whsec_test",
+		SuccessURL:    "https://blog.example/paid",
+		CancelURL:     "https://blog.example/cancelled",
+	}).(*stripeAdapter)
+}
+
+// pay completes a checkout session as if the payer paid it
+func (f *stripeFake) pay(id string) StripeCheckoutSession {
+	session := f.sessions[id]
+	session.Status = "complete"
+	session.PaymentStatus = "paid"
+	session.PaymentIntent = "pi_" + id
+	f.sessions[id] = session
+	return session
+}
+
+func signedStripeEvent(t *testing.T, eventType string, session StripeCheckoutSession, at time.Time) ([]byte, string) {
+	event := map[string]interface{}{
+		"id":   "evt_1",
+		"type": eventType,
+		"data": map[string]interface{}{"object": session},
+	}
+	body, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	timestamp := fmt.Sprint(at.Unix())
+	return body, fmt.Sprintf("t=%s,v1=%s", timestamp, signStripePayload("whsec_test", timestamp, body))
+}
+
+func TestStripeAdapter_CheckoutLifecycle(t *testing.T) {
+	fake := newStripeFake(t)
+	stripe := fake.adapter()
+	ctx := context.Background()
+
+	session, err := stripe.CreatePayment(ctx, PaymentSessionRequest{
+		OrderID:     "ORDER-1",
+		Amount:      decimal.RequireFromString("12.50"),
+		Currency:    "USD",
+		Gateway:     "CARD",
+		Description: "Series purchase",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cs_test_1", session.ProviderRef)
+	assert.Equal(t, "https://checkout.example/cs_test_1", session.CheckoutURL)
+	assert.Equal(t, int64(1250), fake.sessions["cs_test_1"].AmountTotal)
+
+	payment, err := stripe.GetPayment(ctx, "cs_test_1")
+	require.NoError(t, err)
+	assert.Equal(t, ProviderPaymentPending, payment.Status)
+
+	fake.pay("cs_test_1")
+	payment, err = stripe.GetPayment(ctx, "cs_test_1")
+	require.NoError(t, err)
+	assert.True(t, payment.IsPaid())
+	assert.Equal(t, "ORDER-1", payment.OrderID)
+	assert.Equal(t, "USD", payment.Currency)
+	assert.True(t, decimal.RequireFromString("12.50").Equal(payment.Amount))
+
+	refund, err := stripe.Refund(ctx, "cs_test_1", decimal.RequireFromString("2.25"), "USD", "refund-1")
+	require.NoError(t, err)
+	assert.Equal(t, "re_1", refund.ProviderRef)
+	assert.Equal(t, map[string]string{"payment_intent": "pi_cs_test_1", "amount": "225", "idempotency_key": "refund-1"}, fake.refunds[0])
+
+	_, err = stripe.GetPayment(ctx, "cs_missing")
+	assert.ErrorIs(t, err, ErrProviderPaymentNotFound)
+}
+
+func TestStripeAdapter_RefundErrors(t *testing.T) {
+	fake := newStripeFake(t)
+	stripe := fake.adapter()
+	ctx := context.Background()
+
+	session, err := stripe.CreatePayment(ctx, PaymentSessionRequest{OrderID: "ORDER-1", Amount: decimal.NewFromInt(10), Currency: "USD"})
+	require.NoError(t, err)
+	fake.pay(session.ProviderRef)
+
+	// A refused refund sent no money; a failing server may have, so the refund is retried
+	fake.refundStatus = http.StatusBadRequest
+	_, err = stripe.Refund(ctx, session.ProviderRef, decimal.NewFromInt(10), "USD", "refund-1")
+	assert.ErrorIs(t, err, ErrRefundDeclined)
+
+	fake.refundStatus = http.StatusInternalServerError
+	_, err = stripe.Refund(ctx, session.ProviderRef, decimal.NewFromInt(10), "USD", "refund-1")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrRefundDeclined)
+}
+
+func TestStripeAdapter_ZeroDecimalCurrency(t *testing.T) {
+	fake := newStripeFake(t)
+
+	_, err := fake.adapter().CreatePayment(context.Background(), PaymentSessionRequest{
+		OrderID:  "ORDER-1",
+		Amount:   decimal.NewFromInt(50000),
+		Currency: "VND",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(50000), fake.sessions["cs_test_1"].AmountTotal)
+}
+
+func TestStripeAdapter_ParseWebhook(t *testing.T) {
+	fake := newStripeFake(t)
+	stripe := fake.adapter()
+	now := time.Now()
+	stripe.now = func() time.Time { return now }
+
+	paid := StripeCheckoutSession{
+		ID:                "cs_test_9",
+		ClientReferenceID: "ORDER-9",
+		AmountTotal:       999,
+		Currency:          "usd",
+		Status:            "complete",
+		PaymentStatus:     "paid",
+	}
+
+	t.Run("completed_checkout", func(t *testing.T) {
+		body, signature := signedStripeEvent(t, "checkout.session.completed", paid, now)
+
+		payment, err := stripe.ParseWebhook(body, signature)
+
+		require.NoError(t, err)
+		assert.Equal(t, &ProviderPayment{
+			ProviderRef: "cs_test_9",
+			OrderID:     "ORDER-9",
+			Status:      ProviderPaymentPaid,
+			Amount:      decimal.New(999, -2),
+			Currency:    "USD",
+		}, payment)
+	})
+
+	t.Run("ignored_event", func(t *testing.T) {
+		body, signature := signedStripeEvent(t, "customer.created", paid, now)
+
+		payment, err := stripe.ParseWebhook(body, signature)
+
+		require.NoError(t, err)
+		assert.Nil(t, payment)
+	})
+
+	t.Run("tampered_body", func(t *testing.T) {
+		body, signature := signedStripeEvent(t, "checkout.session.completed", paid, now)
+		body[len(body)-2] = ' '
+
+		_, err := stripe.ParseWebhook(body, signature)
+
+		assert.ErrorIs(t, err, ErrInvalidWebhookSignature)
+	})
+
+	t.Run("replayed_event", func(t *testing.T) {
+		body, signature := signedStripeEvent(t, "checkout.session.completed", paid, now.Add(-10*time.Minute))
+
+		_, err := stripe.ParseWebhook(body, signature)
+
+		assert.ErrorIs(t, err, ErrInvalidWebhookSignature)
+	})
+
+	t.Run("missing_signature", func(t *testing.T) {
+		body, _ := signedStripeEvent(t, "checkout.session.completed", paid, now)
+
+		_, err := stripe.ParseWebhook(body, "")
+
+		assert.ErrorIs(t, err, ErrInvalidWebhookSignature)
+	})
+}
@@ -9,15 +9,127 @@ import (
 
 // Config holds all application configuration
 type Config struct {
-	Server    ServerConfig
-	Database  DatabaseConfig
-	Redis     RedisConfig
-	Logger    LoggerConfig
-	Telemetry TelemetryConfig
-	Scheduler SchedulerConfig
-	Firebase  FirebaseConfig
-	SePay     SePayConfig
-	Email     EmailConfig
+	Server       ServerConfig
+	Database     DatabaseConfig
+	Redis        RedisConfig
+	Logger       LoggerConfig
+	Telemetry    TelemetryConfig
+	Scheduler    SchedulerConfig
+	Firebase     FirebaseConfig
+	SePay        SePayConfig
+	Stripe       StripeConfig
+	Email        EmailConfig
+	Outbox       OutboxConfig
+	OAuth        OAuthConfig
+	Session      SessionConfig
+	TwoFactor    TwoFactorConfig
+	Subscription SubscriptionConfig
+	Payment      PaymentConfig
+	Earnings     EarningsConfig
+	Publishing   PublishingConfig
+	Storage      StorageConfig
+}
+
+// StorageConfig holds uploaded media storage and processing configuration
+type StorageConfig struct {
+	Driver        string   `mapstructure:"driver"`          // local or s3
+	LocalDir      string   `mapstructure:"local_dir"`       // Directory the local driver writes to
+	PublicURL     string   `mapstructure:"public_url"`      // Base URL objects are served from, e.g. a CDN in front of the bucket
+	MaxUploadSize int64    `mapstructure:"max_upload_size"` // Largest accepted upload, in bytes
+	UserQuota     int64    `mapstructure:"user_quota"`      // Bytes of media a user may store, variants included
+	MaxPixels     int      `mapstructure:"max_pixels"`      // Images with more pixels are rejected before being decoded
+	S3            S3Config `mapstructure:"s3"`
+}
+
+// S3Config holds the bucket used by the s3 storage driver, on AWS or any S3-compatible service
+type S3Config struct {
+	Endpoint     string `mapstructure:"endpoint"` // e.g. https://s3.us-east-1.amazonaws.com or a MinIO URL
+	Region       string `mapstructure:"region"`
+	Bucket       string `mapstructure:"bucket"`
+	AccessKey    string `mapstructure:"access_key"`
+	SecretKey    string `mapstructure:"secret_key"`
+	UsePathStyle bool   `mapstructure:"use_path_style"` // Address the bucket in the path instead of the host name, as MinIO expects
+}
+
+// PublishingConfig holds scheduled blog publishing configuration
+type PublishingConfig struct {
+	Interval  time.Duration `mapstructure:"interval"`   // How often scheduled posts whose time arrived are announced
+	BatchSize int           `mapstructure:"batch_size"` // Max due posts loaded per query
+}
+
+// EarningsConfig holds the author earnings ledger and payout configuration
+type EarningsConfig struct {
+	PlatformFee PlatformFeeConfig `mapstructure:"platform_fee"`
+	MinPayout   float64           `mapstructure:"min_payout"` // Smallest amount an author can withdraw
+}
+
+// PlatformFeeConfig holds the percentage of each payment kept by the platform, by transaction type
+type PlatformFeeConfig struct {
+	Subscription float64 `mapstructure:"subscription"`
+	Series       float64 `mapstructure:"series"`
+	Donation     float64 `mapstructure:"donation"`
+}
+
+// PaymentConfig holds provider routing, pending order expiry and SePay reconciliation configuration
+type PaymentConfig struct {
+	Providers     map[string]string `mapstructure:"providers"`      // Payment provider collecting each currency, e.g. VND: SEPAY
+	SweepInterval time.Duration     `mapstructure:"sweep_interval"` // How often missed transfers are polled and stale orders expired
+	OrderTTL      time.Duration     `mapstructure:"order_ttl"`      // How long an unpaid order stays payable
+	BatchSize     int               `mapstructure:"batch_size"`     // Max pending orders and SePay transfers loaded per sweep
+	ReportHour    int               `mapstructure:"report_hour"`    // Hour (scheduler timezone) after which yesterday's report is produced
+}
+
+// SubscriptionConfig holds paid subscription lifecycle configuration
+type SubscriptionConfig struct {
+	LifecycleInterval time.Duration `mapstructure:"lifecycle_interval"` // How often reminders, grace periods and downgrades are processed
+	ReminderBefore    time.Duration `mapstructure:"reminder_before"`    // How long before expiry the subscriber is reminded
+	GracePeriod       time.Duration `mapstructure:"grace_period"`       // How long paid access continues after expiry
+	BatchSize         int           `mapstructure:"batch_size"`         // Max subscriptions loaded per query
+}
+
+// TwoFactorConfig holds TOTP two-factor authentication configuration
+type TwoFactorConfig struct {
+	Issuer           string `mapstructure:"issuer"`             // Account label prefix shown in authenticator apps
+	EnforceForAdmins bool   `mapstructure:"enforce_for_admins"` // Admin-only routes reject users without 2FA enabled
+	EncryptionKey    string `mapstructure:"encryption_key"`     // Base64 32-byte key encrypting stored TOTP secrets
+}
+
+// SessionConfig holds login session configuration
+type SessionConfig struct {
+	IdleTimeout   time.Duration `mapstructure:"idle_timeout"`   // A session expires after this long without requests
+	MaxLifetime   time.Duration `mapstructure:"max_lifetime"`   // Absolute limit, activity never extends a session past it
+	TouchInterval time.Duration `mapstructure:"touch_interval"` // Minimum time between last-seen updates of one session
+}
+
+// OutboxConfig holds transactional outbox relay configuration
+type OutboxConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	Lease        time.Duration `mapstructure:"lease"`
+	BaseBackoff  time.Duration `mapstructure:"base_backoff"`
+	MaxBackoff   time.Duration `mapstructure:"max_backoff"`
+}
+
+// OAuthConfig holds social login configuration
+type OAuthConfig struct {
+	StateTTL time.Duration       `mapstructure:"state_ttl"` // How long a login attempt may take before its state expires
+	Google   OAuthProviderConfig `mapstructure:"google"`
+	GitHub   OAuthProviderConfig `mapstructure:"github"`
+	Facebook OAuthProviderConfig `mapstructure:"facebook"`
+}
+
+// OAuthProviderConfig holds the client registration for one OAuth2 provider.
+// A provider is enabled when its client ID is set.
+type OAuthProviderConfig struct {
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+	// Endpoint overrides, empty values use the provider's public endpoints
+	AuthURL     string `mapstructure:"auth_url"`
+	TokenURL    string `mapstructure:"token_url"`
+	UserInfoURL string `mapstructure:"user_info_url"`
 }
 
 // EmailConfig holds SMTP-related configuration
@@ -27,10 +139,13 @@ type EmailConfig struct {
 	User     string `mapstructure:"user"`
 	Password string `mapstructure:"password"`
 	From     string `mapstructure:"from"`
+
+	FrontendURL string `mapstructure:"frontend_url"` // Base URL of the web app that links in emails open
 }
 
 // SePayConfig holds SePay-related configuration
 type SePayConfig struct {
+	BaseURL      string `mapstructure:"base_url"` // SePay API endpoint, empty uses the public one
 	APIKey       string `mapstructure:"api_key"`
 	WebhookToken string `mapstructure:"webhook_token"`
 	BankName     string `mapstructure:"bank_name"`
@@ -39,6 +154,16 @@ type SePayConfig struct {
 	BankBranch   string `mapstructure:"bank_branch"`
 }
 
+// StripeConfig holds the card checkout provider configuration. Card payments are disabled
+// while no secret key is set.
+type StripeConfig struct {
+	BaseURL       string `mapstructure:"base_url"` // API endpoint, empty uses the public one
+	SecretKey     string `mapstructure:"secret_key"`
+	WebhookSecret string `mapstructure:"webhook_secret"` // Signs the checkout webhooks
+	SuccessURL    string `mapstructure:"success_url"`    // Where the payer lands after paying
+	CancelURL     string `mapstructure:"cancel_url"`     // Where the payer lands after leaving the checkout
+}
+
 // ServerConfig holds server-related configuration
 type ServerConfig struct {
 	Port         string        `mapstructure:"port"`
@@ -164,6 +289,7 @@ func setDefaults() {
 	viper.SetDefault("firebase.service_account_path", "")
 
 	// SePay defaults
+	viper.SetDefault("sepay.base_url", "https://api.sepay.vn/v1")
 	viper.SetDefault("sepay.api_key", "")
 	viper.SetDefault("sepay.webhook_token", "")
 	viper.SetDefault("sepay.bank_name", "")
@@ -171,10 +297,84 @@ func setDefaults() {
 	viper.SetDefault("sepay.bank_owner", "")
 	viper.SetDefault("sepay.bank_branch", "")
 
+	// Stripe defaults
+	viper.SetDefault("stripe.base_url", "https://api.stripe.com")
+	viper.SetDefault("stripe.secret_key", "")
+	viper.SetDefault("stripe.webhook_secret", "")
+	viper.SetDefault("stripe.success_url", "http://localhost:3000/payments/success")
+	viper.SetDefault("stripe.cancel_url", "http://localhost:3000/payments/cancel")
+
 	// Email defaults
 	viper.SetDefault("email.host", "localhost")
 	viper.SetDefault("email.port", 1025) // Default for MailHog
 	viper.SetDefault("email.user", "")
 	viper.SetDefault("email.password", "")
 	viper.SetDefault("email.from", "noreply@aiagent.com")
+	viper.SetDefault("email.frontend_url", "http://localhost:3000")
+
+	// Outbox defaults
+	viper.SetDefault("outbox.enabled", true)
+	viper.SetDefault("outbox.poll_interval", "2s")
+	viper.SetDefault("outbox.batch_size", 50)
+	viper.SetDefault("outbox.lease", "1m")
+	viper.SetDefault("outbox.base_backoff", "5s")
+	viper.SetDefault("outbox.max_backoff", "1h")
+
+	// OAuth defaults
+	viper.SetDefault("oauth.state_ttl", "10m")
+	viper.SetDefault("oauth.google.client_id", "")
+	viper.SetDefault("oauth.google.client_secret", "")
+	viper.SetDefault("oauth.google.redirect_url", "http://localhost:8081/api/v1/auth/google/callback")
+	viper.SetDefault("oauth.github.client_id", "")
+	viper.SetDefault("oauth.github.client_secret", "")
+	viper.SetDefault("oauth.github.redirect_url", "http://localhost:8081/api/v1/auth/github/callback")
+	viper.SetDefault("oauth.facebook.client_id", "")
+	viper.SetDefault("oauth.facebook.client_secret", "")
+	viper.SetDefault("oauth.facebook.redirect_url", "http://localhost:8081/api/v1/auth/facebook/callback")
+
+	// Session defaults
+	viper.SetDefault("session.idle_timeout", "24h")
+	viper.SetDefault("session.max_lifetime", "720h")
+	viper.SetDefault("session.touch_interval", "1m")
+
+	// Two-factor defaults
+	viper.SetDefault("two_factor.issuer", "AIAgent")
+	viper.SetDefault("two_factor.enforce_for_admins", false)
+
+	// Subscription lifecycle defaults
+	viper.SetDefault("subscription.lifecycle_interval", "15m")
+	viper.SetDefault("subscription.reminder_before", "72h")
+	viper.SetDefault("subscription.grace_period", "72h")
+	viper.SetDefault("subscription.batch_size", 100)
+
+	// Payment reconciliation defaults
+	viper.SetDefault("payment.providers", map[string]string{"VND": "SEPAY"})
+	viper.SetDefault("payment.sweep_interval", "5m")
+	viper.SetDefault("payment.order_ttl", "24h")
+	viper.SetDefault("payment.batch_size", 200)
+	viper.SetDefault("payment.report_hour", 1)
+
+	// Earnings defaults
+	viper.SetDefault("earnings.platform_fee.subscription", 20)
+	viper.SetDefault("earnings.platform_fee.series", 30)
+	viper.SetDefault("earnings.platform_fee.donation", 5)
+	viper.SetDefault("earnings.min_payout", 100000)
+
+	// Scheduled publishing defaults
+	viper.SetDefault("publishing.interval", "1m")
+	viper.SetDefault("publishing.batch_size", 100)
+
+	// Media storage defaults
+	viper.SetDefault("storage.driver", "local")
+	viper.SetDefault("storage.local_dir", "uploads")
+	viper.SetDefault("storage.public_url", "/uploads")
+	viper.SetDefault("storage.max_upload_size", 10*1024*1024)
+	viper.SetDefault("storage.user_quota", 200*1024*1024)
+	viper.SetDefault("storage.max_pixels", 40000000)
+	viper.SetDefault("storage.s3.endpoint", "")
+	viper.SetDefault("storage.s3.region", "us-east-1")
+	viper.SetDefault("storage.s3.bucket", "")
+	viper.SetDefault("storage.s3.access_key", "")
+	viper.SetDefault("storage.s3.secret_key", "")
+	viper.SetDefault("storage.s3.use_path_style", false)
 }
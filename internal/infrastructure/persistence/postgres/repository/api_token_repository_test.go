@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPITokenRepository_Revoke(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewAPITokenRepository(db)
+
+	ctx := context.Background()
+	userID, tokenID := uuid.New(), uuid.New()
+	query := regexp.QuoteMeta(`UPDATE "api_tokens" SET "revoked_at"=$1,"updated_at"=$2 WHERE id = $3 AND user_id = $4 AND revoked_at IS NULL`)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), tokenID, userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	ok, err := repo.Revoke(ctx, userID, tokenID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// Another user's token, or one already revoked, matches no row
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), tokenID, userID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	ok, err = repo.Revoke(ctx, userID, tokenID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAPITokenRepository_FindByHash_NotFound(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewAPITokenRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "api_tokens" WHERE token_hash = $1 ORDER BY "api_tokens"."id" LIMIT $2`)).
+		WithArgs("hash", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	token, err := repo.FindByHash(context.Background(), "hash")
+
+	assert.NoError(t, err)
+	assert.Nil(t, token)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
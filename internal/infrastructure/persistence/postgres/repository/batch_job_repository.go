@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type batchJobRepository struct {
+	db *gorm.DB
+}
+
+// NewBatchJobRepository creates a new batch job repository
+func NewBatchJobRepository(db *gorm.DB) repository.BatchJobRepository {
+	return &batchJobRepository{db: db}
+}
+
+func (r *batchJobRepository) Create(ctx context.Context, job *entity.BatchJob) error {
+	return r.db.WithContext(ctx).Create(job).Error
+}
+
+func (r *batchJobRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.BatchJob, error) {
+	var job entity.BatchJob
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&job).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &job, err
+}
+
+func (r *batchJobRepository) FindAll(ctx context.Context, filter repository.BatchJobFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.BatchJob], error) {
+	var jobs []entity.BatchJob
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entity.BatchJob{})
+	if filter.JobType != nil {
+		query = query.Where("job_type = ?", *filter.JobType)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	if err := query.Order("created_at DESC").Offset(offset).Limit(pagination.PageSize).Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pagination.PageSize)))
+
+	return &repository.PaginatedResult[entity.BatchJob]{
+		Data:       jobs,
+		Total:      total,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+func (r *batchJobRepository) FindStale(ctx context.Context, jobType string, now time.Time) ([]entity.BatchJob, error) {
+	var jobs []entity.BatchJob
+	err := r.db.WithContext(ctx).
+		Where("job_type = ? AND status = ?", jobType, entity.BatchJobStatusRunning).
+		Where("locked_until IS NULL OR locked_until < ?", now).
+		Order("created_at ASC").
+		Find(&jobs).Error
+	return jobs, err
+}
+
+func (r *batchJobRepository) Claim(ctx context.Context, id uuid.UUID, workerID string, leaseUntil time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.BatchJob{}).
+		Where("id = ? AND status = ?", id, entity.BatchJobStatusRunning).
+		Where("locked_until IS NULL OR locked_until < ? OR locked_by = ?", time.Now(), workerID).
+		Updates(map[string]interface{}{
+			"locked_by":    workerID,
+			"locked_until": leaseUntil,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *batchJobRepository) SaveProgress(ctx context.Context, job *entity.BatchJob, workerID string) (bool, error) {
+	errorsJSON, err := json.Marshal(job.Errors)
+	if err != nil {
+		return false, err
+	}
+	if job.Errors == nil {
+		errorsJSON = []byte("[]")
+	}
+
+	updates := map[string]interface{}{
+		"status":               job.Status,
+		"processed_followers":  job.ProcessedFollowers,
+		"new_signals_detected": job.NewSignalsDetected,
+		"users_scored":         job.UsersScored,
+		"checkpoint_id":        job.CheckpointID,
+		"checkpoint_at":        job.CheckpointAt,
+		"errors":               string(errorsJSON),
+		"message":              job.Message,
+		"locked_until":         job.LockedUntil,
+		"completed_at":         job.CompletedAt,
+	}
+	if job.IsFinished() {
+		updates["locked_by"] = nil
+		updates["locked_until"] = nil
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&entity.BatchJob{}).
+		Where("id = ? AND status = ? AND locked_by = ?", job.ID, entity.BatchJobStatusRunning, workerID).
+		Updates(updates)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *batchJobRepository) Cancel(ctx context.Context, id uuid.UUID, message string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.BatchJob{}).
+		Where("id = ? AND status = ?", id, entity.BatchJobStatusRunning).
+		Updates(map[string]interface{}{
+			"status":       entity.BatchJobStatusCancelled,
+			"message":      message,
+			"completed_at": time.Now(),
+			"locked_by":    nil,
+			"locked_until": nil,
+		})
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// SQL fragments deciding what a viewer may read of the blogs aliased b
+const (
+	activeSubscriberOf = "SELECT %s FROM subscriptions s WHERE s.subscriber_id = ? AND s.author_id = b.author_id AND (s.expires_at IS NULL OR s.expires_at > NOW() OR s.grace_ends_at > NOW() OR s.scheduled_expires_at > NOW())"
+	paidChapterOf      = "SELECT 1 FROM series_blogs sb JOIN series ps ON ps.id = sb.series_id " +
+		"WHERE sb.blog_id = b.id AND NOT sb.is_preview AND ps.price > 0 AND ps.deleted_at IS NULL"
+)
+
+// blogVisibleTo returns a SQL condition on b following blogService.CheckAccess: drafts and
+// scheduled posts only for their author, subscriber-only posts only for the author and
+// active subscribers
+func blogVisibleTo(viewerID *uuid.UUID) (string, []interface{}) {
+	if viewerID == nil {
+		return "b.status = ? AND b.published_at <= NOW() AND b.visibility = ?",
+			[]interface{}{entity.BlogStatusPublished, entity.BlogVisibilityPublic}
+	}
+	return "(b.author_id = ? OR (b.status = ? AND b.published_at <= NOW() AND (b.visibility = ? OR EXISTS (" +
+			fmt.Sprintf(activeSubscriberOf, "1") + "))))",
+		[]interface{}{*viewerID, entity.BlogStatusPublished, entity.BlogVisibilityPublic, *viewerID}
+}
+
+// blogLockedExpr returns a SQL boolean that is true when the blog's tags require a higher
+// tier than the viewer's active subscription to its author grants, or when the blog is
+// a non-preview chapter of a paid series the viewer has not bought
+func blogLockedExpr(viewerID *uuid.UUID) (string, []interface{}) {
+	requiredLevel := "COALESCE((SELECT MAX(" + tierLevelSQL("m.required_tier") + ") FROM blog_tags bt " +
+		"JOIN tag_tier_mappings m ON m.tag_id = bt.tag_id AND m.author_id = b.author_id WHERE bt.blog_id = b.id), 0)"
+	if viewerID == nil {
+		return "(" + requiredLevel + " > 0 OR EXISTS (" + paidChapterOf + "))", nil
+	}
+
+	userLevel := "COALESCE((" + fmt.Sprintf(activeSubscriberOf, "MAX("+tierLevelSQL("s.tier")+")") + "), 0)"
+	seriesLocked := "EXISTS (" + paidChapterOf + ") AND NOT EXISTS (" + paidChapterOf +
+		" AND EXISTS (SELECT 1 FROM user_series_purchases p WHERE p.series_id = ps.id AND p.user_id = ?))"
+	return "(b.author_id <> ? AND (" + requiredLevel + " > " + userLevel + " OR " + seriesLocked + "))",
+		[]interface{}{*viewerID, *viewerID, *viewerID}
+}
+
+// tierLevelSQL maps a tier column to entity.SubscriptionTier.Level in SQL
+func tierLevelSQL(column string) string {
+	var b strings.Builder
+	b.WriteString("CASE " + column)
+	for _, tier := range []entity.SubscriptionTier{entity.TierBronze, entity.TierSilver, entity.TierGold} {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", tier, tier.Level())
+	}
+	b.WriteString(" ELSE 0 END")
+	return b.String()
+}
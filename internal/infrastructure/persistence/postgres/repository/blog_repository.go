@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"math"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
@@ -55,45 +56,19 @@ func (r *blogRepository) FindAll(ctx context.Context, filter repository.BlogFilt
 	var blogs []entity.Blog
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.Blog{}).Where("deleted_at IS NULL")
-
-	// Apply filters
-	if filter.AuthorID != nil {
-		query = query.Where("author_id = ?", *filter.AuthorID)
-	}
-	if filter.CategoryID != nil {
-		query = query.Where("category_id = ?", *filter.CategoryID)
-	}
-	if filter.Status != nil {
-		query = query.Where("status = ?", *filter.Status)
-	}
-	if filter.Visibility != nil {
-		query = query.Where("visibility = ?", *filter.Visibility)
-	}
-	if filter.Search != nil && *filter.Search != "" {
-		searchPattern := "%" + *filter.Search + "%"
-		query = query.Where("title ILIKE ? OR content ILIKE ?", searchPattern, searchPattern)
-	}
-	if len(filter.TagIDs) > 0 {
-		query = query.Joins("JOIN blog_tags ON blog_tags.blog_id = blogs.id").
-			Where("blog_tags.tag_id IN ?", filter.TagIDs)
-	}
-	if filter.PublishedBefore != nil {
-		query = query.Where("published_at <= ?", filter.PublishedBefore)
-	}
-
-	// Count total
+	// Access rules are part of the query so the total only counts blogs the viewer may see
+	query := r.filtered(ctx, filter)
 	if err := query.Count(&total).Error; err != nil {
 		return nil, err
 	}
 
 	// Apply pagination and fetch
 	offset := (pagination.Page - 1) * pagination.PageSize
-	err := query.
+	err := r.selectListed(query, filter).
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
-		Order("created_at DESC").
+		Order("b.created_at DESC").
 		Offset(offset).
 		Limit(pagination.PageSize).
 		Find(&blogs).Error
@@ -112,6 +87,68 @@ func (r *blogRepository) FindAll(ctx context.Context, filter repository.BlogFilt
 	}, nil
 }
 
+func (r *blogRepository) FindPublishedAfter(ctx context.Context, filter repository.BlogFilter, after *repository.BlogCursor, limit int) ([]entity.Blog, error) {
+	query := r.filtered(ctx, filter).
+		Where("b.status = ? AND b.published_at <= NOW()", entity.BlogStatusPublished)
+	if after != nil {
+		// Row comparison keeps blogs published at the same instant in a stable order
+		query = query.Where("(b.published_at, b.id) < (?, ?)", after.PublishedAt, after.ID)
+	}
+
+	var blogs []entity.Blog
+	err := r.selectListed(query, filter).
+		Preload("Author").
+		Preload("Category").
+		Preload("Tags").
+		Order("b.published_at DESC, b.id DESC").
+		Limit(limit).
+		Find(&blogs).Error
+	return blogs, err
+}
+
+// filtered selects the blogs (aliased b) matching the filter
+func (r *blogRepository) filtered(ctx context.Context, filter repository.BlogFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).Table("blogs AS b").Where("b.deleted_at IS NULL")
+
+	if filter.AuthorID != nil {
+		query = query.Where("b.author_id = ?", *filter.AuthorID)
+	}
+	if filter.CategoryID != nil {
+		query = query.Where("b.category_id = ?", *filter.CategoryID)
+	}
+	if filter.Status != nil {
+		query = query.Where("b.status = ?", *filter.Status)
+	}
+	if filter.Visibility != nil {
+		query = query.Where("b.visibility = ?", *filter.Visibility)
+	}
+	if filter.Search != nil && *filter.Search != "" {
+		query = query.Where("b.search_vector @@ "+tsQuery, *filter.Search)
+	}
+	if len(filter.TagIDs) > 0 {
+		// A subquery rather than a join, so blogs with several of the tags are counted once
+		query = query.Where("EXISTS (SELECT 1 FROM blog_tags ft WHERE ft.blog_id = b.id AND ft.tag_id IN ?)", filter.TagIDs)
+	}
+	if filter.PublishedBefore != nil {
+		query = query.Where("b.published_at <= ?", filter.PublishedBefore)
+	}
+	if filter.Access != nil {
+		visible, args := blogVisibleTo(filter.Access.ViewerID)
+		query = query.Where(visible, args...)
+	}
+	return query
+}
+
+// selectListed selects the blog columns, and whether the viewer may read each blog when
+// the filter has one
+func (r *blogRepository) selectListed(query *gorm.DB, filter repository.BlogFilter) *gorm.DB {
+	if filter.Access == nil {
+		return query.Select("b.*")
+	}
+	locked, args := blogLockedExpr(filter.Access.ViewerID)
+	return query.Select("b.*, "+locked+" AS locked", args...)
+}
+
 func (r *blogRepository) Update(ctx context.Context, blog *entity.Blog) error {
 	return r.db.WithContext(ctx).Save(blog).Error
 }
@@ -123,6 +160,75 @@ func (r *blogRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		Update("deleted_at", gorm.Expr("NOW()")).Error
 }
 
+func (r *blogRepository) FindScheduledDue(ctx context.Context, now time.Time, limit int) ([]entity.Blog, error) {
+	var blogs []entity.Blog
+	err := r.db.WithContext(ctx).
+		Preload("Tags").
+		Where("status = ? AND published_at <= ? AND announced_at IS NULL AND deleted_at IS NULL", entity.BlogStatusPublished, now).
+		Order("published_at ASC").
+		Limit(limit).
+		Find(&blogs).Error
+	return blogs, err
+}
+
+func (r *blogRepository) FindScheduledByAuthor(ctx context.Context, authorID uuid.UUID) ([]entity.Blog, error) {
+	var blogs []entity.Blog
+	err := r.db.WithContext(ctx).
+		Preload("Category").
+		Preload("Tags").
+		Where("author_id = ? AND status = ? AND announced_at IS NULL AND deleted_at IS NULL", authorID, entity.BlogStatusPublished).
+		Order("published_at ASC").
+		Find(&blogs).Error
+	return blogs, err
+}
+
+func (r *blogRepository) MarkAnnounced(ctx context.Context, id uuid.UUID, publishedAt, now time.Time) (bool, error) {
+	// announced_at is read-only on entity.Blog so Save never overwrites it, hence the table
+	result := r.db.WithContext(ctx).
+		Table("blogs").
+		Where("id = ? AND status = ? AND published_at = ? AND published_at <= ? AND announced_at IS NULL AND deleted_at IS NULL",
+			id, entity.BlogStatusPublished, publishedAt, now).
+		UpdateColumn("announced_at", now)
+	return result.RowsAffected > 0, result.Error
+}
+
+// pendingSchedule matches a blog that is published for a time still ahead and not announced,
+// so changing it cannot race with MarkAnnounced
+const pendingSchedule = "id = ? AND status = ? AND published_at > ? AND announced_at IS NULL AND deleted_at IS NULL"
+
+func (r *blogRepository) Reschedule(ctx context.Context, id uuid.UUID, publishAt time.Time, timezone *string, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Table("blogs").
+		Where(pendingSchedule, id, entity.BlogStatusPublished, now).
+		Updates(map[string]interface{}{
+			"published_at":     publishAt,
+			"publish_timezone": timezone,
+			"updated_at":       now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *blogRepository) CancelSchedule(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Table("blogs").
+		Where(pendingSchedule, id, entity.BlogStatusPublished, now).
+		Updates(map[string]interface{}{
+			"status":           entity.BlogStatusDraft,
+			"published_at":     nil,
+			"publish_timezone": nil,
+			"announced_at":     nil,
+			"updated_at":       now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *blogRepository) ResetAnnouncement(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Table("blogs").
+		Where("id = ?", id).
+		UpdateColumn("announced_at", nil).Error
+}
+
 func (r *blogRepository) AddTags(ctx context.Context, blogID uuid.UUID, tagIDs []uuid.UUID) error {
 	for _, tagID := range tagIDs {
 		err := r.db.WithContext(ctx).Exec(
@@ -248,13 +354,14 @@ func (r *blogRepository) FindRelated(ctx context.Context, blogID uuid.UUID, limi
 
 	err := r.db.WithContext(ctx).
 		Model(&entity.Blog{}).
+		Select("blogs.*").
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
 		Joins("JOIN blog_tags ON blog_tags.blog_id = blogs.id").
 		Where("blog_tags.tag_id IN (?)", subQuery).
 		Where("blogs.id != ?", blogID).
-		Where("blogs.status = ?", entity.BlogStatusPublished).
+		Where("blogs.status = ? AND blogs.published_at <= NOW()", entity.BlogStatusPublished).
 		Group("blogs.id").
 		Order("COUNT(blog_tags.tag_id) DESC, blogs.published_at DESC").
 		Limit(limit).
@@ -285,3 +392,11 @@ func (r *blogRepository) ExistsByAuthorAndTag(ctx context.Context, authorID, tag
 		Count(&count).Error
 	return count > 0, err
 }
+
+// WithTx returns a new repository with the given transaction
+func (r *blogRepository) WithTx(tx interface{}) repository.BlogRepository {
+	if gormDB, ok := tx.(*gorm.DB); ok {
+		return &blogRepository{db: gormDB}
+	}
+	return r
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlogRepository_MarkAnnounced(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewBlogRepository(db)
+
+	id := uuid.New()
+	now := time.Now()
+	publishedAt := now.Add(-time.Minute)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "blogs" SET "announced_at"=$1 WHERE id = $2 AND status = $3 AND published_at = $4 AND published_at <= $5 AND announced_at IS NULL AND deleted_at IS NULL`)).
+		WithArgs(now, id, entity.BlogStatusPublished, publishedAt, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	marked, err := repo.MarkAnnounced(context.Background(), id, publishedAt, now)
+
+	assert.NoError(t, err)
+	assert.True(t, marked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlogRepository_Reschedule(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewBlogRepository(db)
+
+	id := uuid.New()
+	now := time.Now()
+	publishAt := now.Add(24 * time.Hour)
+	hanoi := "Asia/Ho_Chi_Minh"
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "blogs" SET "publish_timezone"=$1,"published_at"=$2,"updated_at"=$3 WHERE id = $4 AND status = $5 AND published_at > $6 AND announced_at IS NULL AND deleted_at IS NULL`)).
+		WithArgs(&hanoi, publishAt, now, id, entity.BlogStatusPublished, now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	rescheduled, err := repo.Reschedule(context.Background(), id, publishAt, &hanoi, now)
+
+	assert.NoError(t, err)
+	assert.False(t, rescheduled)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlogRepository_CancelSchedule(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewBlogRepository(db)
+
+	id := uuid.New()
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "blogs" SET "announced_at"=$1,"publish_timezone"=$2,"published_at"=$3,"status"=$4,"updated_at"=$5 WHERE id = $6 AND status = $7 AND published_at > $8 AND announced_at IS NULL AND deleted_at IS NULL`)).
+		WithArgs(nil, nil, nil, entity.BlogStatusDraft, now, id, entity.BlogStatusPublished, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	cancelled, err := repo.CancelSchedule(context.Background(), id, now)
+
+	assert.NoError(t, err)
+	assert.True(t, cancelled)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlogRepository_ResetAnnouncement(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewBlogRepository(db)
+
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "blogs" SET "announced_at"=$1 WHERE id = $2`)).
+		WithArgs(nil, id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.ResetAnnouncement(context.Background(), id))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
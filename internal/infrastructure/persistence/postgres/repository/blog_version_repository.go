@@ -3,11 +3,13 @@ package repository
 import (
 	"context"
 	"math"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type blogVersionRepository struct {
@@ -118,3 +120,42 @@ func (r *blogVersionRepository) DeleteOldest(ctx context.Context, blogID uuid.UU
 		).Error
 	})
 }
+
+func (r *blogVersionRepository) DeleteCreatedBefore(ctx context.Context, blogID uuid.UUID, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(
+		`DELETE FROM "blog_versions" WHERE blog_id = ? AND created_at < ? `+
+			`AND version_number < (SELECT MAX(version_number) FROM "blog_versions" WHERE blog_id = ?)`,
+		blogID, before, blogID,
+	)
+	return result.RowsAffected, result.Error
+}
+
+func (r *blogVersionRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(
+		`DELETE FROM "blog_versions" v USING "blog_version_retention" p ` +
+			`WHERE v.blog_id = p.blog_id AND p.max_age_days IS NOT NULL ` +
+			`AND v.created_at < NOW() - make_interval(days => p.max_age_days) ` +
+			`AND v.version_number < (SELECT MAX(latest.version_number) FROM "blog_versions" latest WHERE latest.blog_id = v.blog_id)`,
+	)
+	return result.RowsAffected, result.Error
+}
+
+func (r *blogVersionRepository) FindRetention(ctx context.Context, blogID uuid.UUID) (*entity.BlogVersionRetention, error) {
+	var retention entity.BlogVersionRetention
+	err := r.db.WithContext(ctx).Where("blog_id = ?", blogID).First(&retention).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &retention, nil
+}
+
+func (r *blogVersionRepository) SaveRetention(ctx context.Context, retention *entity.BlogVersionRetention) error {
+	retention.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "blog_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"max_versions", "max_age_days", "updated_at"}),
+	}).Create(retention).Error
+}
@@ -324,3 +324,73 @@ func TestBlogVersionRepository_DeleteOldest_NoAction(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestBlogVersionRepository_DeleteCreatedBefore_KeepsLatest(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewBlogVersionRepository(db)
+
+	ctx := context.Background()
+	blogID := uuid.New()
+	before := time.Now().AddDate(0, 0, -30)
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "blog_versions" WHERE blog_id = $1 AND created_at < $2 AND version_number < (SELECT MAX(version_number) FROM "blog_versions" WHERE blog_id = $3)`)).
+		WithArgs(blogID, before, blogID).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+
+	deleted, err := repo.DeleteCreatedBefore(ctx, blogID, before)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlogVersionRepository_DeleteExpired(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewBlogVersionRepository(db)
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		`DELETE FROM "blog_versions" v USING "blog_version_retention" p WHERE v.blog_id = p.blog_id AND p.max_age_days IS NOT NULL`)).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+
+	deleted, err := repo.DeleteExpired(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlogVersionRepository_SaveRetention_Upserts(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewBlogVersionRepository(db)
+
+	maxAgeDays := 90
+	retention := &entity.BlogVersionRetention{BlogID: uuid.New(), MaxVersions: 20, MaxAgeDays: &maxAgeDays}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`INSERT INTO "blog_version_retention" ("blog_id","max_versions","max_age_days","updated_at") VALUES ($1,$2,$3,$4) `+
+			`ON CONFLICT ("blog_id") DO UPDATE SET "max_versions"="excluded"."max_versions","max_age_days"="excluded"."max_age_days","updated_at"="excluded"."updated_at"`)).
+		WithArgs(retention.BlogID, 20, 90, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(time.Now()))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.SaveRetention(context.Background(), retention))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlogVersionRepository_FindRetention_NotFound(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewBlogVersionRepository(db)
+
+	blogID := uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "blog_version_retention" WHERE blog_id = $1`)).
+		WithArgs(blogID, 1).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	retention, err := repo.FindRetention(context.Background(), blogID)
+
+	assert.NoError(t, err)
+	assert.Nil(t, retention)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
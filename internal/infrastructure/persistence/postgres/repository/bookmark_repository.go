@@ -74,6 +74,7 @@ func (r *bookmarkRepository) FindByUser(ctx context.Context, userID uuid.UUID, p
 	offset := (pagination.Page - 1) * pagination.PageSize
 
 	err := query.
+		Select("blogs.*").
 		Preload("Author").
 		Preload("Category").
 		Preload("Tags").
@@ -9,6 +9,7 @@ import (
 	"github.com/aiagent/internal/domain/valueobject"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // fraudDetectionRepository implements the FraudDetectionRepository interface using GORM
@@ -86,6 +87,24 @@ func (r *fraudDetectionRepository) GetUnprocessedBotSignals(ctx context.Context,
 	return signals, err
 }
 
+// GetUnprocessedBotSignalsAfter retrieves unprocessed bot signals in a date range using keyset pagination
+func (r *fraudDetectionRepository) GetUnprocessedBotSignalsAfter(ctx context.Context, from, to time.Time, afterDetectedAt *time.Time, afterID *uuid.UUID, limit int) ([]entity.BotDetectionSignal, error) {
+	var signals []entity.BotDetectionSignal
+	query := r.db.WithContext(ctx).
+		Where("processed = ?", false).
+		Where("detected_at >= ? AND detected_at <= ?", from, to)
+
+	if afterDetectedAt != nil && afterID != nil {
+		query = query.Where("(detected_at, id) > (?, ?)", *afterDetectedAt, *afterID)
+	}
+
+	err := query.
+		Order("detected_at asc, id asc").
+		Limit(limit).
+		Find(&signals).Error
+	return signals, err
+}
+
 // MarkBotSignalAsProcessed marks a bot signal as processed
 func (r *fraudDetectionRepository) MarkBotSignalAsProcessed(ctx context.Context, signalID uuid.UUID) error {
 	return r.db.WithContext(ctx).
@@ -176,9 +195,12 @@ func (r *fraudDetectionRepository) GetLastReviewByUser(ctx context.Context, user
 	return &review, err
 }
 
-// CreateBotNotification creates a bot follower notification
-func (r *fraudDetectionRepository) CreateBotNotification(ctx context.Context, notification *entity.BotFollowerNotification) error {
-	return r.db.WithContext(ctx).Create(notification).Error
+// CreateBotNotification creates a bot follower notification, skipping one that was already stored
+func (r *fraudDetectionRepository) CreateBotNotification(ctx context.Context, notification *entity.BotFollowerNotification) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(notification)
+	return result.RowsAffected > 0, result.Error
 }
 
 // GetBotNotificationsByUser retrieves bot notifications for a user
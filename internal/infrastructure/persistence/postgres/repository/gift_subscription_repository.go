@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type giftSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewGiftSubscriptionRepository creates a new gift subscription repository
+func NewGiftSubscriptionRepository(db *gorm.DB) repository.GiftSubscriptionRepository {
+	return &giftSubscriptionRepository{db: db}
+}
+
+func (r *giftSubscriptionRepository) Create(ctx context.Context, gift *entity.GiftSubscription) error {
+	return r.db.WithContext(ctx).Create(gift).Error
+}
+
+func (r *giftSubscriptionRepository) FindByCode(ctx context.Context, code string) (*entity.GiftSubscription, error) {
+	var gift entity.GiftSubscription
+	err := r.db.WithContext(ctx).Where("code = ?", strings.ToUpper(code)).First(&gift).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &gift, err
+}
+
+func (r *giftSubscriptionRepository) FindByTransactionID(ctx context.Context, transactionID uuid.UUID) (*entity.GiftSubscription, error) {
+	var gift entity.GiftSubscription
+	err := r.db.WithContext(ctx).Where("transaction_id = ?", transactionID).First(&gift).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &gift, err
+}
+
+func (r *giftSubscriptionRepository) FindByPurchaser(ctx context.Context, purchaserID uuid.UUID, pagination repository.Pagination) (*repository.PaginatedResult[entity.GiftSubscription], error) {
+	var gifts []entity.GiftSubscription
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entity.GiftSubscription{}).Where("purchaser_id = ?", purchaserID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	if err := query.Order("created_at DESC").Offset(offset).Limit(pagination.PageSize).Find(&gifts).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pagination.PageSize)))
+
+	return &repository.PaginatedResult[entity.GiftSubscription]{
+		Data:       gifts,
+		Total:      total,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+func (r *giftSubscriptionRepository) Redeem(ctx context.Context, id, recipientID uuid.UUID, redeemedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.GiftSubscription{}).
+		Where("id = ? AND status = ?", id, entity.GiftStatusIssued).
+		Updates(map[string]interface{}{
+			"status":      entity.GiftStatusRedeemed,
+			"redeemed_by": recipientID,
+			"redeemed_at": redeemedAt,
+			"updated_at":  redeemedAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *giftSubscriptionRepository) Revoke(ctx context.Context, transactionID uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.GiftSubscription{}).
+		Where("transaction_id = ? AND status = ?", transactionID, entity.GiftStatusIssued).
+		Updates(map[string]interface{}{
+			"status":     entity.GiftStatusRevoked,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// WithTx returns a new repository with the given transaction
+func (r *giftSubscriptionRepository) WithTx(tx interface{}) repository.GiftSubscriptionRepository {
+	if gormDB, ok := tx.(*gorm.DB); ok {
+		return &giftSubscriptionRepository{db: gormDB}
+	}
+	return r
+}
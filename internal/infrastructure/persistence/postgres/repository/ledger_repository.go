@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type ledgerRepository struct {
+	db *gorm.DB
+}
+
+// NewLedgerRepository creates a new ledger repository
+func NewLedgerRepository(db *gorm.DB) repository.LedgerRepository {
+	return &ledgerRepository{db: db}
+}
+
+func (r *ledgerRepository) CreateJournal(ctx context.Context, entries []entity.LedgerEntry) error {
+	return r.db.WithContext(ctx).Create(&entries).Error
+}
+
+func (r *ledgerRepository) FindBySource(ctx context.Context, kind entity.LedgerEntryKind, sourceID uuid.UUID) ([]entity.LedgerEntry, error) {
+	var entries []entity.LedgerEntry
+	err := r.db.WithContext(ctx).
+		Where("kind = ? AND source_id = ?", kind, sourceID).
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *ledgerRepository) AuthorBalance(ctx context.Context, authorID uuid.UUID, currency string) (decimal.Decimal, error) {
+	var balance decimal.Decimal
+	// The payable account is credited (negative) when the author earns
+	err := r.db.WithContext(ctx).
+		Model(&entity.LedgerEntry{}).
+		Select("COALESCE(-SUM(amount), 0)").
+		Where("author_id = ? AND account = ? AND currency = ?", authorID, entity.LedgerAccountAuthorPayable, currency).
+		Scan(&balance).Error
+	return balance, err
+}
+
+func (r *ledgerRepository) AuthorEarnings(ctx context.Context, authorID uuid.UUID, filter repository.LedgerEarningsFilter) ([]repository.LedgerEarningsRow, error) {
+	var rows []repository.LedgerEarningsRow
+
+	query := r.db.WithContext(ctx).
+		Model(&entity.LedgerEntry{}).
+		Select(`transaction_type, tier, series_id,
+			COALESCE(SUM(CASE WHEN account = ? THEN amount ELSE 0 END), 0) AS gross,
+			COALESCE(-SUM(CASE WHEN account = ? THEN amount ELSE 0 END), 0) AS fee,
+			COALESCE(-SUM(CASE WHEN account = ? THEN amount ELSE 0 END), 0) AS net,
+			COUNT(DISTINCT CASE WHEN kind = ? THEN source_id END) AS payments`,
+			entity.LedgerAccountGateway, entity.LedgerAccountPlatformRevenue, entity.LedgerAccountAuthorPayable, entity.LedgerEntryPayment).
+		Where("author_id = ? AND kind IN ? AND currency = ?", authorID, []entity.LedgerEntryKind{entity.LedgerEntryPayment, entity.LedgerEntryRefund}, filter.Currency)
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	err := query.
+		Group("transaction_type, tier, series_id").
+		Order("transaction_type, tier, series_id").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// WithTx returns a new repository with the given transaction
+func (r *ledgerRepository) WithTx(tx interface{}) repository.LedgerRepository {
+	if gormDB, ok := tx.(*gorm.DB); ok {
+		return &ledgerRepository{db: gormDB}
+	}
+	return r
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"math"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type mediaAssetRepository struct {
+	db *gorm.DB
+}
+
+// NewMediaAssetRepository creates a new media asset repository
+func NewMediaAssetRepository(db *gorm.DB) repository.MediaAssetRepository {
+	return &mediaAssetRepository{db: db}
+}
+
+func (r *mediaAssetRepository) Create(ctx context.Context, asset *entity.MediaAsset) error {
+	return r.db.WithContext(ctx).Create(asset).Error
+}
+
+func (r *mediaAssetRepository) FindByOwner(ctx context.Context, ownerID uuid.UUID, purpose *entity.MediaPurpose, pagination repository.Pagination) (*repository.PaginatedResult[entity.MediaAsset], error) {
+	var assets []entity.MediaAsset
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entity.MediaAsset{}).Where("owner_id = ?", ownerID)
+	if purpose != nil {
+		query = query.Where("purpose = ?", *purpose)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	if err := query.Order("created_at DESC").Offset(offset).Limit(pagination.PageSize).Find(&assets).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pagination.PageSize)))
+
+	return &repository.PaginatedResult[entity.MediaAsset]{
+		Data:       assets,
+		Total:      total,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+func (r *mediaAssetRepository) Delete(ctx context.Context, id, ownerID uuid.UUID) (*entity.MediaAsset, error) {
+	var asset entity.MediaAsset
+	result := r.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		Delete(&asset)
+	if result.Error != nil || result.RowsAffected == 0 {
+		return nil, result.Error
+	}
+	return &asset, nil
+}
+
+func (r *mediaAssetRepository) ReserveQuota(ctx context.Context, ownerID uuid.UUID, bytes, limit int64) (bool, error) {
+	if bytes > limit {
+		return false, nil
+	}
+	// The conflict update only applies while the new total stays within the limit, so
+	// concurrent uploads cannot overshoot it
+	result := r.db.WithContext(ctx).Exec(`
+		INSERT INTO user_media_usage (user_id, bytes_used, updated_at) VALUES (?, ?, NOW())
+		ON CONFLICT (user_id) DO UPDATE
+		SET bytes_used = user_media_usage.bytes_used + EXCLUDED.bytes_used, updated_at = NOW()
+		WHERE user_media_usage.bytes_used + EXCLUDED.bytes_used <= ?`,
+		ownerID, bytes, limit)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *mediaAssetRepository) ReleaseQuota(ctx context.Context, ownerID uuid.UUID, bytes int64) error {
+	return r.db.WithContext(ctx).Exec(
+		"UPDATE user_media_usage SET bytes_used = GREATEST(bytes_used - ?, 0), updated_at = NOW() WHERE user_id = ?",
+		bytes, ownerID).Error
+}
+
+func (r *mediaAssetRepository) GetUsage(ctx context.Context, ownerID uuid.UUID) (int64, error) {
+	var usage entity.MediaUsage
+	err := r.db.WithContext(ctx).Where("user_id = ?", ownerID).First(&usage).Error
+	if err == gorm.ErrRecordNotFound {
+		return 0, nil
+	}
+	return usage.BytesUsed, err
+}
+
+// WithTx returns a new repository with the given transaction
+func (r *mediaAssetRepository) WithTx(tx interface{}) repository.MediaAssetRepository {
+	if gormDB, ok := tx.(*gorm.DB); ok {
+		return &mediaAssetRepository{db: gormDB}
+	}
+	return r
+}
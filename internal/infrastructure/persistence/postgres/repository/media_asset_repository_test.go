@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMediaAssetRepository_ReserveQuota(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewMediaAssetRepository(db)
+	ctx := context.Background()
+	ownerID := uuid.New()
+
+	upsert := regexp.QuoteMeta("INSERT INTO user_media_usage (user_id, bytes_used, updated_at) VALUES ($1, $2, NOW())") +
+		`.*WHERE user_media_usage\.bytes_used \+ EXCLUDED\.bytes_used <= \$3`
+
+	t.Run("within_quota", func(t *testing.T) {
+		mock.ExpectExec(upsert).WithArgs(ownerID, int64(300), int64(1000)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ok, err := repo.ReserveQuota(ctx, ownerID, 300, 1000)
+
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("total_over_quota", func(t *testing.T) {
+		mock.ExpectExec(upsert).WithArgs(ownerID, int64(800), int64(1000)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ok, err := repo.ReserveQuota(ctx, ownerID, 800, 1000)
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("single_upload_over_quota", func(t *testing.T) {
+		ok, err := repo.ReserveQuota(ctx, ownerID, 1001, 1000)
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMediaAssetRepository_Delete(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewMediaAssetRepository(db)
+	ctx := context.Background()
+	id, ownerID := uuid.New(), uuid.New()
+
+	query := regexp.QuoteMeta(`DELETE FROM "media_assets" WHERE id = $1 AND owner_id = $2 RETURNING *`)
+
+	t.Run("own_asset", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).WithArgs(id, ownerID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "owner_id", "size", "key", "variants"}).
+				AddRow(id, ownerID, 2048, "media/a/original.png", `[{"width":320,"key":"media/a/w320.png"}]`))
+		mock.ExpectCommit()
+
+		asset, err := repo.Delete(ctx, id, ownerID)
+
+		require.NoError(t, err)
+		require.NotNil(t, asset)
+		assert.Equal(t, int64(2048), asset.Size)
+		assert.Equal(t, []string{"media/a/original.png", "media/a/w320.png"}, asset.Keys())
+	})
+
+	t.Run("asset_of_other_user", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(query).WithArgs(id, ownerID).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+
+		asset, err := repo.Delete(ctx, id, ownerID)
+
+		require.NoError(t, err)
+		assert.Nil(t, asset)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
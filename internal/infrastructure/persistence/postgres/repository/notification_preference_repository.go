@@ -53,3 +53,16 @@ func (r *notificationPreferenceRepository) IsEnabled(ctx context.Context, userID
 	}
 	return pref.Enabled, nil
 }
+
+func (r *notificationPreferenceRepository) FindDisabledUserIDs(ctx context.Context, userIDs []uuid.UUID, notifType entity.NotificationType, channel string) ([]uuid.UUID, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&entity.NotificationPreference{}).
+		Where("user_id IN ? AND notification_type = ? AND channel = ? AND enabled = ?", userIDs, notifType, channel, false).
+		Pluck("user_id", &ids).Error
+	return ids, err
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aiagent/internal/domain/repository"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type notificationRepository struct {
@@ -32,6 +33,16 @@ func (r *notificationRepository) Save(ctx context.Context, notification *entity.
 	return r.db.WithContext(ctx).Create(notification).Error
 }
 
+func (r *notificationRepository) CreateBatch(ctx context.Context, notifications []*entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&notifications).Error
+}
+
 func (r *notificationRepository) updateGroupedNotification(ctx context.Context, existing *entity.Notification, new *entity.Notification) error {
 	existing.GroupedCount++
 	existing.UpdatedAt = time.Now()
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository creates a new outbox repository
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+// Create records an outbox event
+func (r *outboxRepository) Create(ctx context.Context, event *entity.OutboxEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+// CreateBatch records events in a single insert, skipping IDs that are already stored
+func (r *outboxRepository) CreateBatch(ctx context.Context, events []*entity.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&events).Error
+}
+
+// ClaimBatch leases due events using SKIP LOCKED so concurrent relays never share a row
+func (r *outboxRepository) ClaimBatch(ctx context.Context, workerID string, lease time.Duration, limit int) ([]*entity.OutboxEvent, error) {
+	var events []*entity.OutboxEvent
+	now := time.Now()
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE outbox_events
+		SET status = ?, locked_by = ?, locked_until = ?, attempts = attempts + 1, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE (status = ? AND next_attempt_at <= ?)
+			   OR (status = ? AND locked_until < ?)
+			ORDER BY created_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		entity.OutboxStatusProcessing, workerID, now.Add(lease), now,
+		entity.OutboxStatusPending, now,
+		entity.OutboxStatusProcessing, now,
+		limit,
+	).Scan(&events).Error
+	return events, err
+}
+
+// ExtendLease renews a lease that workerID still holds and has not let expire
+func (r *outboxRepository) ExtendLease(ctx context.Context, id uuid.UUID, workerID string, lease time.Duration) (bool, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&entity.OutboxEvent{}).
+		Where("id = ? AND locked_by = ? AND status = ? AND locked_until > ?", id, workerID, entity.OutboxStatusProcessing, now).
+		Updates(map[string]interface{}{
+			"locked_until": now.Add(lease),
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// MarkProcessed marks a leased event as delivered
+func (r *outboxRepository) MarkProcessed(ctx context.Context, id uuid.UUID, workerID string) error {
+	now := time.Now()
+	return r.release(ctx, id, workerID, map[string]interface{}{
+		"status":       entity.OutboxStatusProcessed,
+		"processed_at": now,
+		"last_error":   nil,
+	})
+}
+
+// MarkFailed releases a leased event for another attempt
+func (r *outboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, workerID string, lastError string, nextAttemptAt time.Time) error {
+	return r.release(ctx, id, workerID, map[string]interface{}{
+		"status":          entity.OutboxStatusPending,
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      lastError,
+	})
+}
+
+// MarkDead moves a leased event to the dead-letter state
+func (r *outboxRepository) MarkDead(ctx context.Context, id uuid.UUID, workerID string, lastError string) error {
+	return r.release(ctx, id, workerID, map[string]interface{}{
+		"status":     entity.OutboxStatusDead,
+		"last_error": lastError,
+	})
+}
+
+// release clears the lease and applies updates, but only while workerID still owns the lease
+func (r *outboxRepository) release(ctx context.Context, id uuid.UUID, workerID string, updates map[string]interface{}) error {
+	updates["locked_by"] = nil
+	updates["locked_until"] = nil
+	updates["updated_at"] = time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&entity.OutboxEvent{}).
+		Where("id = ? AND locked_by = ?", id, workerID).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// FindDeliveredHandlers returns the handlers that already received an event
+func (r *outboxRepository) FindDeliveredHandlers(ctx context.Context, eventID uuid.UUID) ([]string, error) {
+	var handlers []string
+	err := r.db.WithContext(ctx).
+		Model(&entity.OutboxDelivery{}).
+		Where("event_id = ?", eventID).
+		Pluck("handler", &handlers).Error
+	return handlers, err
+}
+
+// RecordDelivery records that a handler received an event
+func (r *outboxRepository) RecordDelivery(ctx context.Context, eventID uuid.UUID, handler string) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entity.OutboxDelivery{EventID: eventID, Handler: handler, DeliveredAt: time.Now()}).Error
+}
+
+// WithTx returns a new repository with the given transaction
+func (r *outboxRepository) WithTx(tx interface{}) repository.OutboxRepository {
+	if gormDB, ok := tx.(*gorm.DB); ok {
+		return &outboxRepository{db: gormDB}
+	}
+	return r
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type paymentReconciliationRepository struct {
+	db *gorm.DB
+}
+
+// NewPaymentReconciliationRepository creates a new payment reconciliation repository
+func NewPaymentReconciliationRepository(db *gorm.DB) repository.PaymentReconciliationRepository {
+	return &paymentReconciliationRepository{db: db}
+}
+
+func (r *paymentReconciliationRepository) Create(ctx context.Context, rec *entity.PaymentReconciliation) error {
+	return r.db.WithContext(ctx).Create(rec).Error
+}
+
+func (r *paymentReconciliationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.PaymentReconciliation, error) {
+	var rec entity.PaymentReconciliation
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&rec).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &rec, err
+}
+
+func (r *paymentReconciliationRepository) FindBySePayID(ctx context.Context, sePayID string) (*entity.PaymentReconciliation, error) {
+	var rec entity.PaymentReconciliation
+	err := r.db.WithContext(ctx).Where("sepay_id = ?", sePayID).First(&rec).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &rec, err
+}
+
+func (r *paymentReconciliationRepository) FindAll(ctx context.Context, filter repository.PaymentReconciliationFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.PaymentReconciliation], error) {
+	var recs []entity.PaymentReconciliation
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entity.PaymentReconciliation{})
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	if err := query.Order("created_at DESC").Offset(offset).Limit(pagination.PageSize).Find(&recs).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pagination.PageSize)))
+
+	return &repository.PaginatedResult[entity.PaymentReconciliation]{
+		Data:       recs,
+		Total:      total,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+func (r *paymentReconciliationRepository) FindCreatedBetween(ctx context.Context, from, to time.Time) ([]entity.PaymentReconciliation, error) {
+	var recs []entity.PaymentReconciliation
+	err := r.db.WithContext(ctx).
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Order("created_at ASC").
+		Find(&recs).Error
+	return recs, err
+}
+
+func (r *paymentReconciliationRepository) Resolve(ctx context.Context, id uuid.UUID, status entity.ReconciliationStatus, resolvedBy uuid.UUID, note *string, resolvedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.PaymentReconciliation{}).
+		Where("id = ? AND status = ?", id, entity.ReconciliationStatusOpen).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"note":        note,
+			"resolved_by": resolvedBy,
+			"resolved_at": resolvedAt,
+			"updated_at":  resolvedAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// WithTx returns a new repository with the given transaction
+func (r *paymentReconciliationRepository) WithTx(tx interface{}) repository.PaymentReconciliationRepository {
+	if gormDB, ok := tx.(*gorm.DB); ok {
+		return &paymentReconciliationRepository{db: gormDB}
+	}
+	return r
+}
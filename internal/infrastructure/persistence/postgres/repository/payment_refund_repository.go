@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type paymentRefundRepository struct {
+	db *gorm.DB
+}
+
+// NewPaymentRefundRepository creates a new payment refund repository
+func NewPaymentRefundRepository(db *gorm.DB) repository.PaymentRefundRepository {
+	return &paymentRefundRepository{db: db}
+}
+
+func (r *paymentRefundRepository) Create(ctx context.Context, refund *entity.PaymentRefund) error {
+	return r.db.WithContext(ctx).Create(refund).Error
+}
+
+// CreatePending relies on the unique index over the pending refunds of a transaction
+func (r *paymentRefundRepository) CreatePending(ctx context.Context, refund *entity.PaymentRefund) (bool, error) {
+	refund.Status = entity.RefundStatusPending
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(refund)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *paymentRefundRepository) Complete(ctx context.Context, id uuid.UUID, providerRef *string, benefitRevoked bool) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.PaymentRefund{}).
+		Where("id = ? AND status = ?", id, entity.RefundStatusPending).
+		Updates(map[string]interface{}{
+			"status":          entity.RefundStatusCompleted,
+			"provider_ref":    providerRef,
+			"benefit_revoked": benefitRevoked,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *paymentRefundRepository) Fail(ctx context.Context, id uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.PaymentRefund{}).
+		Where("id = ? AND status = ?", id, entity.RefundStatusPending).
+		Update("status", entity.RefundStatusFailed)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *paymentRefundRepository) FindPending(ctx context.Context, createdBefore time.Time, limit int) ([]entity.PaymentRefund, error) {
+	var refunds []entity.PaymentRefund
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND created_at < ?", entity.RefundStatusPending, createdBefore).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&refunds).Error
+	return refunds, err
+}
+
+func (r *paymentRefundRepository) FindByTransactionID(ctx context.Context, transactionID uuid.UUID) ([]entity.PaymentRefund, error) {
+	var refunds []entity.PaymentRefund
+	err := r.db.WithContext(ctx).
+		Where("transaction_id = ?", transactionID).
+		Order("created_at ASC").
+		Find(&refunds).Error
+	return refunds, err
+}
+
+// WithTx returns a new repository with the given transaction
+func (r *paymentRefundRepository) WithTx(tx interface{}) repository.PaymentRefundRepository {
+	if gormDB, ok := tx.(*gorm.DB); ok {
+		return &paymentRefundRepository{db: gormDB}
+	}
+	return r
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"math"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type paymentReportRepository struct {
+	db *gorm.DB
+}
+
+// NewPaymentReportRepository creates a new payment report repository
+func NewPaymentReportRepository(db *gorm.DB) repository.PaymentReportRepository {
+	return &paymentReportRepository{db: db}
+}
+
+// Create stores a report unless another instance already produced the same day
+func (r *paymentReportRepository) Create(ctx context.Context, report *entity.PaymentReport) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "day"}}, DoNothing: true}).
+		Create(report)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *paymentReportRepository) FindByDay(ctx context.Context, day string) (*entity.PaymentReport, error) {
+	var report entity.PaymentReport
+	err := r.db.WithContext(ctx).Where("day = ?", day).First(&report).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &report, err
+}
+
+func (r *paymentReportRepository) FindAll(ctx context.Context, pagination repository.Pagination) (*repository.PaginatedResult[entity.PaymentReport], error) {
+	var reports []entity.PaymentReport
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entity.PaymentReport{})
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	if err := query.Order("day DESC").Offset(offset).Limit(pagination.PageSize).Find(&reports).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pagination.PageSize)))
+
+	return &repository.PaginatedResult[entity.PaymentReport]{
+		Data:       reports,
+		Total:      total,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
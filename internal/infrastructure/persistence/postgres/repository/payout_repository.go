@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type payoutRepository struct {
+	db *gorm.DB
+}
+
+// NewPayoutRepository creates a new payout repository
+func NewPayoutRepository(db *gorm.DB) repository.PayoutRepository {
+	return &payoutRepository{db: db}
+}
+
+// CreatePending relies on the unique index over the pending payouts of an author
+func (r *payoutRepository) CreatePending(ctx context.Context, payout *entity.Payout) (bool, error) {
+	payout.Status = entity.PayoutStatusPending
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(payout)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *payoutRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Payout, error) {
+	var payout entity.Payout
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&payout).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &payout, err
+}
+
+func (r *payoutRepository) FindPendingByAuthor(ctx context.Context, authorID uuid.UUID) (*entity.Payout, error) {
+	var payout entity.Payout
+	err := r.db.WithContext(ctx).
+		Where("author_id = ? AND status = ?", authorID, entity.PayoutStatusPending).
+		First(&payout).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &payout, err
+}
+
+func (r *payoutRepository) FindAll(ctx context.Context, filter repository.PayoutFilter, pagination repository.Pagination) (*repository.PaginatedResult[entity.Payout], error) {
+	var payouts []entity.Payout
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entity.Payout{})
+	if filter.AuthorID != nil {
+		query = query.Where("author_id = ?", *filter.AuthorID)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (pagination.Page - 1) * pagination.PageSize
+	if err := query.Order("created_at DESC").Offset(offset).Limit(pagination.PageSize).Find(&payouts).Error; err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pagination.PageSize)))
+
+	return &repository.PaginatedResult[entity.Payout]{
+		Data:       payouts,
+		Total:      total,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+func (r *payoutRepository) Review(ctx context.Context, id uuid.UUID, status entity.PayoutStatus, reviewedBy uuid.UUID, note, reference *string, reviewedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.Payout{}).
+		Where("id = ? AND status = ?", id, entity.PayoutStatusPending).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"note":        note,
+			"reference":   reference,
+			"reviewed_by": reviewedBy,
+			"reviewed_at": reviewedAt,
+			"updated_at":  reviewedAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// WithTx returns a new repository with the given transaction
+func (r *payoutRepository) WithTx(tx interface{}) repository.PayoutRepository {
+	if gormDB, ok := tx.(*gorm.DB); ok {
+		return &payoutRepository{db: gormDB}
+	}
+	return r
+}
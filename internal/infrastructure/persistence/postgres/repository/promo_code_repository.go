@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type promoCodeRepository struct {
+	db *gorm.DB
+}
+
+// NewPromoCodeRepository creates a new promo code repository
+func NewPromoCodeRepository(db *gorm.DB) repository.PromoCodeRepository {
+	return &promoCodeRepository{db: db}
+}
+
+func (r *promoCodeRepository) Create(ctx context.Context, promo *entity.PromoCode) error {
+	return r.db.WithContext(ctx).Create(promo).Error
+}
+
+func (r *promoCodeRepository) FindByAuthorAndCode(ctx context.Context, authorID uuid.UUID, code string) (*entity.PromoCode, error) {
+	var promo entity.PromoCode
+	err := r.db.WithContext(ctx).
+		Where("author_id = ? AND code = ?", authorID, strings.ToUpper(code)).
+		First(&promo).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &promo, err
+}
+
+func (r *promoCodeRepository) FindByAuthor(ctx context.Context, authorID uuid.UUID) ([]entity.PromoCode, error) {
+	var promos []entity.PromoCode
+	err := r.db.WithContext(ctx).
+		Where("author_id = ?", authorID).
+		Order("created_at DESC").
+		Find(&promos).Error
+	return promos, err
+}
+
+func (r *promoCodeRepository) Deactivate(ctx context.Context, id, authorID uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.PromoCode{}).
+		Where("id = ? AND author_id = ?", id, authorID).
+		Updates(map[string]interface{}{
+			"is_active":  false,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *promoCodeRepository) ReserveRedemption(ctx context.Context, id uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.PromoCode{}).
+		Where("id = ? AND (max_redemptions IS NULL OR redemption_count < max_redemptions)", id).
+		Updates(map[string]interface{}{
+			"redemption_count": gorm.Expr("redemption_count + 1"),
+			"updated_at":       time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *promoCodeRepository) ReleaseRedemption(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.PromoCode{}).
+		Where("id = ? AND redemption_count > 0", id).
+		Updates(map[string]interface{}{
+			"redemption_count": gorm.Expr("redemption_count - 1"),
+			"updated_at":       time.Now(),
+		}).Error
+}
+
+func (r *promoCodeRepository) IncrementRedemptions(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&entity.PromoCode{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"redemption_count": gorm.Expr("redemption_count + 1"),
+			"updated_at":       time.Now(),
+		}).Error
+}
+
+// WithTx returns a new repository with the given transaction
+func (r *promoCodeRepository) WithTx(tx interface{}) repository.PromoCodeRepository {
+	if gormDB, ok := tx.(*gorm.DB); ok {
+		return &promoCodeRepository{db: gormDB}
+	}
+	return r
+}
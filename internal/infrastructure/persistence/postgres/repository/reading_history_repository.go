@@ -38,3 +38,14 @@ func (r *readingHistoryRepository) GetRecentByUserID(ctx context.Context, userID
 		Find(&histories).Error
 	return histories, err
 }
+
+func (r *readingHistoryRepository) GetByBlogIDs(ctx context.Context, userID uuid.UUID, blogIDs []uuid.UUID) ([]*entity.UserReadingHistory, error) {
+	var histories []*entity.UserReadingHistory
+	if len(blogIDs) == 0 {
+		return histories, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND blog_id IN ?", userID, blogIDs).
+		Find(&histories).Error
+	return histories, err
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"html"
+	"math"
+	"strings"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// The text search configuration must match the one the search migration indexes with,
+// otherwise the GIN indexes are not used
+const (
+	tsQuery          = "websearch_to_tsquery('simple', ?)"
+	seriesVector     = "to_tsvector('simple', s.title || ' ' || COALESCE(s.description, ''))"
+	tagVector        = "to_tsvector('simple', t.name)"
+	authorVector     = "to_tsvector('simple', u.name || ' ' || COALESCE(u.display_name, ''))"
+	snippetOptions   = "StartSel=" + markStart + ", StopSel=" + markStop + ", MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" … \""
+	highlightOptions = "HighlightAll=true, StartSel=" + markStart + ", StopSel=" + markStop
+)
+
+// ts_headline copies the stored text as is, so matches are delimited with control characters
+// that survive HTML escaping and only become <mark> tags afterwards
+const (
+	markStart = "\x02"
+	markStop  = "\x03"
+)
+
+var highlightMarks = strings.NewReplacer(markStart, "<mark>", markStop, "</mark>")
+
+// toHighlightHTML escapes a ts_headline result and turns its delimiters into <mark> tags
+func toHighlightHTML(headline string) string {
+	return highlightMarks.Replace(html.EscapeString(headline))
+}
+
+func toHighlightHTMLHits(hits []repository.SearchHit) []repository.SearchHit {
+	for i := range hits {
+		hits[i].Snippet = toHighlightHTML(hits[i].Snippet)
+	}
+	return hits
+}
+
+type searchRepository struct {
+	db *gorm.DB
+}
+
+// NewSearchRepository creates a new full-text search repository
+func NewSearchRepository(db *gorm.DB) repository.SearchRepository {
+	return &searchRepository{db: db}
+}
+
+type blogSearchRow struct {
+	repository.BlogSearchHit
+	Excerpt *string
+	Content string
+}
+
+func (r *searchRepository) SearchBlogs(ctx context.Context, filter repository.BlogSearchFilter, pagination repository.Pagination) (*repository.PaginatedResult[repository.BlogSearchHit], error) {
+	var total int64
+	if err := r.blogMatches(ctx, filter).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	lockedExpr, lockedArgs := blogLockedExpr(filter.ViewerID)
+	args := append([]interface{}{filter.Query}, lockedArgs...)
+	offset := (pagination.Page - 1) * pagination.PageSize
+	page := r.blogMatches(ctx, filter).
+		Select("b.id, b.author_id, b.category_id, b.title, b.slug, b.excerpt, b.content, b.visibility, b.published_at, "+
+			"ts_rank_cd(b.search_vector, "+tsQuery+") AS rank, "+lockedExpr+" AS locked", args...).
+		Order("rank DESC, b.published_at DESC NULLS LAST").
+		Offset(offset).
+		Limit(pagination.PageSize)
+
+	// Headlines are expensive, so they are only built for the rows on this page
+	var rows []blogSearchRow
+	err := r.db.WithContext(ctx).
+		Table("(?) AS hits", page).
+		Select("hits.*, COALESCE(u.display_name, u.name) AS author_name, "+
+			"ts_headline('simple', hits.title, "+tsQuery+", '"+highlightOptions+"') AS title_highlight, "+
+			"ts_headline('simple', CASE WHEN hits.locked THEN COALESCE(hits.excerpt, '') "+
+			"ELSE COALESCE(hits.excerpt, '') || ' ' || regexp_replace(hits.content, '<[^>]+>', ' ', 'g') END, "+
+			tsQuery+", '"+snippetOptions+"') AS snippet",
+			filter.Query, filter.Query).
+		Joins("JOIN users u ON u.id = hits.author_id").
+		Order("hits.rank DESC, hits.published_at DESC NULLS LAST").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]repository.BlogSearchHit, len(rows))
+	for i, row := range rows {
+		hits[i] = row.BlogSearchHit
+		hits[i].TitleHighlight = toHighlightHTML(row.TitleHighlight)
+		hits[i].Snippet = toHighlightHTML(row.Snippet)
+	}
+
+	return &repository.PaginatedResult[repository.BlogSearchHit]{
+		Data:       hits,
+		Total:      total,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalPages: int(math.Ceil(float64(total) / float64(pagination.PageSize))),
+	}, nil
+}
+
+func (r *searchRepository) BlogFacets(ctx context.Context, filter repository.BlogSearchFilter, limit int) (*repository.BlogSearchFacets, error) {
+	var facets repository.BlogSearchFacets
+
+	err := r.blogMatches(ctx, filter).
+		Select("c.id, c.name, COUNT(*) AS count").
+		Joins("JOIN categories c ON c.id = b.category_id").
+		Group("c.id, c.name").
+		Order("count DESC, c.name").
+		Limit(limit).
+		Scan(&facets.Categories).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.blogMatches(ctx, filter).
+		Select("t.id, t.name, COUNT(*) AS count").
+		Joins("JOIN blog_tags bt ON bt.blog_id = b.id").
+		Joins("JOIN tags t ON t.id = bt.tag_id").
+		Group("t.id, t.name").
+		Order("count DESC, t.name").
+		Limit(limit).
+		Scan(&facets.Tags).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.blogMatches(ctx, filter).
+		Select("u.id, COALESCE(u.display_name, u.name) AS name, COUNT(*) AS count").
+		Joins("JOIN users u ON u.id = b.author_id").
+		Group("u.id, u.display_name, u.name").
+		Order("count DESC, name").
+		Limit(limit).
+		Scan(&facets.Authors).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &facets, nil
+}
+
+func (r *searchRepository) SearchSeries(ctx context.Context, query string, limit int) ([]repository.SearchHit, error) {
+	var hits []repository.SearchHit
+	err := r.db.WithContext(ctx).
+		Table("series AS s").
+		Select("s.id, s.title, s.slug, "+
+			"ts_headline('simple', COALESCE(s.description, ''), "+tsQuery+", '"+snippetOptions+"') AS snippet, "+
+			"ts_rank("+seriesVector+", "+tsQuery+") AS rank", query, query).
+		Where("s.deleted_at IS NULL").
+		Where(seriesVector+" @@ "+tsQuery, query).
+		Order("rank DESC").
+		Limit(limit).
+		Scan(&hits).Error
+	return toHighlightHTMLHits(hits), err
+}
+
+func (r *searchRepository) SearchTags(ctx context.Context, query string, limit int) ([]repository.SearchHit, error) {
+	var hits []repository.SearchHit
+	err := r.db.WithContext(ctx).
+		Table("tags AS t").
+		Select("t.id, t.name AS title, t.slug, "+
+			"ts_headline('simple', t.name, "+tsQuery+", '"+highlightOptions+"') AS snippet, "+
+			"ts_rank("+tagVector+", "+tsQuery+") AS rank", query, query).
+		Where(tagVector+" @@ "+tsQuery, query).
+		Order("rank DESC").
+		Limit(limit).
+		Scan(&hits).Error
+	return toHighlightHTMLHits(hits), err
+}
+
+func (r *searchRepository) SearchAuthors(ctx context.Context, query string, limit int) ([]repository.SearchHit, error) {
+	var hits []repository.SearchHit
+	err := r.db.WithContext(ctx).
+		Table("users AS u").
+		Select("u.id, COALESCE(u.display_name, u.name) AS title, "+
+			"ts_headline('simple', u.name || ' ' || COALESCE(u.display_name, ''), "+tsQuery+", '"+highlightOptions+"') AS snippet, "+
+			"ts_rank("+authorVector+", "+tsQuery+") AS rank", query, query).
+		Where("u.deleted_at IS NULL AND u.is_active").
+		Where(authorVector+" @@ "+tsQuery, query).
+		Where("EXISTS (SELECT 1 FROM blogs b WHERE b.author_id = u.id AND b.status = ? AND b.deleted_at IS NULL)", entity.BlogStatusPublished).
+		Order("rank DESC").
+		Limit(limit).
+		Scan(&hits).Error
+	return toHighlightHTMLHits(hits), err
+}
+
+// blogMatches selects the blogs (aliased b) matching the filter that the viewer may see
+func (r *searchRepository) blogMatches(ctx context.Context, filter repository.BlogSearchFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).
+		Table("blogs AS b").
+		Where("b.deleted_at IS NULL").
+		Where("b.search_vector @@ "+tsQuery, filter.Query)
+
+	visible, visibleArgs := blogVisibleTo(filter.ViewerID)
+	query = query.Where(visible, visibleArgs...)
+
+	if filter.AuthorID != nil {
+		query = query.Where("b.author_id = ?", *filter.AuthorID)
+	}
+	if filter.CategoryID != nil {
+		query = query.Where("b.category_id = ?", *filter.CategoryID)
+	}
+	if len(filter.TagIDs) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM blog_tags ft WHERE ft.blog_id = b.id AND ft.tag_id IN ?)", filter.TagIDs)
+	}
+	return query
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchRepository_SearchBlogs_Anonymous(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewSearchRepository(db)
+
+	hitID, authorID := uuid.New(), uuid.New()
+	visible := func(first int) string {
+		return regexp.QuoteMeta(fmt.Sprintf(`WHERE b.deleted_at IS NULL AND b.search_vector @@ websearch_to_tsquery('simple', $%d) `+
+			`AND (b.status = $%d AND b.published_at <= NOW() AND b.visibility = $%d)`, first, first+1, first+2))
+	}
+
+	mock.ExpectQuery(`SELECT count\(\*\) FROM blogs AS b `+visible(1)).
+		WithArgs("go generics", "published", "public").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	// Anonymous viewers hold no subscription or purchase, so any tier requirement or paid chapter locks the post
+	mock.ExpectQuery(`AS rank, \(COALESCE\(\(SELECT MAX\(CASE m.required_tier .*\), 0\) > 0 OR EXISTS \(SELECT 1 FROM series_blogs sb .*ps.price > 0.*\)\) AS locked FROM blogs AS b `+visible(4)).
+		WithArgs("go generics", "go generics", "go generics", "go generics", "published", "public", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "author_id", "author_name", "title", "title_highlight", "snippet", "visibility", "rank", "locked"}).
+			AddRow(hitID, authorID, "Ada", "Go generics", "\x02Go\x03 \x02generics\x03", "excerpt", "public", 0.6, true))
+
+	result, err := repo.SearchBlogs(context.Background(), repository.BlogSearchFilter{Query: "go generics"}, repository.Pagination{Page: 1, PageSize: 10})
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, hitID, result.Data[0].ID)
+	assert.Equal(t, "Ada", result.Data[0].AuthorName)
+	assert.Equal(t, "<mark>Go</mark> <mark>generics</mark>", result.Data[0].TitleHighlight)
+	assert.True(t, result.Data[0].Locked)
+	assert.Equal(t, 1, result.TotalPages)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchRepository_SearchBlogs_EscapesHighlights(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewSearchRepository(db)
+
+	title := `Go <script>alert(1)</script><img src=x onerror="steal()">`
+	mock.ExpectQuery(`SELECT count\(\*\) FROM blogs AS b`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	// Matches come back delimited by the control characters the query asks ts_headline for
+	mock.ExpectQuery(`ts_headline\('simple', hits.title, websearch_to_tsquery\('simple', \$\d+\), 'HighlightAll=true, StartSel=\x02, StopSel=\x03'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "author_id", "author_name", "title", "title_highlight", "snippet", "visibility", "rank", "locked"}).
+			AddRow(uuid.New(), uuid.New(), "Mallory", title,
+				"\x02Go\x03 <script>alert(1)</script><img src=x onerror=\"steal()\">",
+				"<b>\x02Go\x03</b> & more", "public", 0.6, false))
+
+	result, err := repo.SearchBlogs(context.Background(), repository.BlogSearchFilter{Query: "go"}, repository.Pagination{Page: 1, PageSize: 10})
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, title, result.Data[0].Title)
+	assert.Equal(t, "<mark>Go</mark> &lt;script&gt;alert(1)&lt;/script&gt;&lt;img src=x onerror=&#34;steal()&#34;&gt;", result.Data[0].TitleHighlight)
+	assert.Equal(t, "&lt;b&gt;<mark>Go</mark>&lt;/b&gt; &amp; more", result.Data[0].Snippet)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchRepository_SearchTags_EscapesHighlights(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewSearchRepository(db)
+
+	mock.ExpectQuery(`FROM tags AS t`).
+		WithArgs("go", "go", "go", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "slug", "snippet", "rank"}).
+			AddRow(uuid.New(), "go<svg onload=x>", "go-svg", "\x02go\x03<svg onload=x>", 0.1))
+
+	hits, err := repo.SearchTags(context.Background(), "go", 5)
+
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "<mark>go</mark>&lt;svg onload=x&gt;", hits[0].Snippet)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/aiagent/internal/domain/entity"
@@ -43,9 +44,21 @@ func (r *seriesRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.S
 	if err != nil {
 		return nil, err
 	}
+	if err := r.sortChapters(ctx, &series); err != nil {
+		return nil, err
+	}
 	return &series, nil
 }
 
+func (r *seriesRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entity.Series{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Count(&count).Error
+	return count > 0, err
+}
+
 func (r *seriesRepository) GetBySlug(ctx context.Context, slug string) (*entity.Series, error) {
 	var series entity.Series
 	err := r.db.WithContext(ctx).
@@ -59,9 +72,34 @@ func (r *seriesRepository) GetBySlug(ctx context.Context, slug string) (*entity.
 	if err != nil {
 		return nil, err
 	}
+	if err := r.sortChapters(ctx, &series); err != nil {
+		return nil, err
+	}
 	return &series, nil
 }
 
+// sortChapters puts preloaded blogs in chapter order; many2many preloads ignore the join table's sort_order
+func (r *seriesRepository) sortChapters(ctx context.Context, series *entity.Series) error {
+	var order []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Table("series_blogs").
+		Where("series_id = ?", series.ID).
+		Order("sort_order, created_at").
+		Pluck("blog_id", &order).Error
+	if err != nil {
+		return err
+	}
+
+	position := make(map[uuid.UUID]int, len(order))
+	for i, id := range order {
+		position[id] = i
+	}
+	sort.SliceStable(series.Blogs, func(i, j int) bool {
+		return position[series.Blogs[i].ID] < position[series.Blogs[j].ID]
+	})
+	return nil
+}
+
 func (r *seriesRepository) List(ctx context.Context, params map[string]interface{}) ([]entity.Series, int64, error) {
 	var series []entity.Series
 	var total int64
@@ -100,8 +138,19 @@ func (r *seriesRepository) List(ctx context.Context, params map[string]interface
 	return series, total, nil
 }
 
+// AddBlog locks the series row first so chapters added at the same time are numbered
+// one after the other instead of both taking MAX(sort_order) + 1
 func (r *seriesRepository) AddBlog(ctx context.Context, seriesID, blogID uuid.UUID) error {
-	return r.db.WithContext(ctx).Model(&entity.Series{ID: seriesID}).Association("Blogs").Append(&entity.Blog{ID: blogID})
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`SELECT id FROM series WHERE id = ? FOR UPDATE`, seriesID).Error; err != nil {
+			return err
+		}
+		return tx.Exec(
+			`INSERT INTO series_blogs (series_id, blog_id, sort_order)
+			SELECT ?, ?, COALESCE(MAX(sort_order), 0) + 1 FROM series_blogs WHERE series_id = ?
+			ON CONFLICT (series_id, blog_id) DO NOTHING`,
+			seriesID, blogID, seriesID).Error
+	})
 }
 
 func (r *seriesRepository) RemoveBlog(ctx context.Context, seriesID, blogID uuid.UUID) error {
@@ -174,3 +223,67 @@ func (r *seriesRepository) GetHighlighted(ctx context.Context, limit int) ([]rep
 
 	return results, nil
 }
+
+func (r *seriesRepository) SetBlogPreview(ctx context.Context, seriesID, blogID uuid.UUID, preview bool) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Table("series_blogs").
+		Where("series_id = ? AND blog_id = ?", seriesID, blogID).
+		Update("is_preview", preview)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *seriesRepository) GetPreviewBlogIDs(ctx context.Context, seriesID uuid.UUID) ([]uuid.UUID, error) {
+	var blogIDs []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Table("series_blogs").
+		Where("series_id = ? AND is_preview", seriesID).
+		Pluck("blog_id", &blogIDs).Error
+	return blogIDs, err
+}
+
+func (r *seriesRepository) FindPaidContaining(ctx context.Context, blogID uuid.UUID) ([]entity.Series, error) {
+	var series []entity.Series
+	err := r.db.WithContext(ctx).
+		Joins("JOIN series_blogs sb ON sb.series_id = series.id").
+		Where("sb.blog_id = ? AND NOT sb.is_preview AND series.price > 0 AND series.deleted_at IS NULL", blogID).
+		Find(&series).Error
+	return series, err
+}
+
+func (r *seriesRepository) ReorderBlogs(ctx context.Context, seriesID uuid.UUID, blogIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, blogID := range blogIDs {
+			err := tx.Table("series_blogs").
+				Where("series_id = ? AND blog_id = ?", seriesID, blogID).
+				Update("sort_order", i+1).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *seriesRepository) GetChapters(ctx context.Context, seriesID uuid.UUID) ([]entity.Blog, error) {
+	var blogs []entity.Blog
+	err := r.db.WithContext(ctx).
+		Select("blogs.*").
+		Joins("JOIN series_blogs sb ON sb.blog_id = blogs.id").
+		Where("sb.series_id = ?", seriesID).
+		Order("sb.sort_order, sb.created_at").
+		Find(&blogs).Error
+	return blogs, err
+}
+
+func (r *seriesRepository) FindContaining(ctx context.Context, blogID uuid.UUID) ([]entity.Series, error) {
+	var series []entity.Series
+	err := r.db.WithContext(ctx).
+		Joins("JOIN series_blogs sb ON sb.series_id = series.id").
+		Where("sb.blog_id = ? AND series.deleted_at IS NULL", blogID).
+		Order("series.created_at").
+		Find(&series).Error
+	return series, err
+}
@@ -142,3 +142,28 @@ func TestSeriesRepository_GetHighlighted_Error(t *testing.T) {
 	assert.Nil(t, result)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestSeriesRepository_AddBlog_AppendsChapter(t *testing.T) {
+	// Arrange
+	db, mock := setupSeriesTestDB(t)
+	repo := NewSeriesRepository(db)
+
+	seriesID, blogID := uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	// Concurrent additions wait for each other on the series row
+	mock.ExpectExec(`SELECT id FROM series WHERE id = \$1 FOR UPDATE`).
+		WithArgs(seriesID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO series_blogs \(series_id, blog_id, sort_order\)\s+SELECT \$1, \$2, COALESCE\(MAX\(sort_order\), 0\) \+ 1 FROM series_blogs WHERE series_id = \$3`).
+		WithArgs(seriesID, blogID, seriesID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Act
+	err := repo.AddBlog(context.Background(), seriesID, blogID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
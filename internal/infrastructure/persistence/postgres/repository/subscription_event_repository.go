@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type subscriptionEventRepository struct {
+	db *gorm.DB
+}
+
+// NewSubscriptionEventRepository creates a new subscription event repository
+func NewSubscriptionEventRepository(db *gorm.DB) repository.SubscriptionEventRepository {
+	return &subscriptionEventRepository{db: db}
+}
+
+func (r *subscriptionEventRepository) Create(ctx context.Context, event *entity.SubscriptionEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+func (r *subscriptionEventRepository) FindPaidByTransactionID(ctx context.Context, transactionID uuid.UUID) (*entity.SubscriptionEvent, error) {
+	var event entity.SubscriptionEvent
+	err := r.db.WithContext(ctx).
+		Where("transaction_id = ? AND type IN ?", transactionID, []entity.SubscriptionEventType{
+			entity.SubscriptionEventActivated,
+			entity.SubscriptionEventRenewed,
+			entity.SubscriptionEventUpgraded,
+			entity.SubscriptionEventDowngradeScheduled,
+		}).
+		Order("created_at ASC").
+		First(&event).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &event, err
+}
+
+// WithTx returns a new repository with the given transaction
+func (r *subscriptionEventRepository) WithTx(tx interface{}) repository.SubscriptionEventRepository {
+	if gormDB, ok := tx.(*gorm.DB); ok {
+		return &subscriptionEventRepository{db: gormDB}
+	}
+	return r
+}
@@ -125,14 +125,34 @@ func (r *subscriptionRepository) CountBySubscriber(ctx context.Context, subscrib
 	return count, err
 }
 
+func (r *subscriptionRepository) FindBySubscriberAndAuthor(ctx context.Context, subscriberID, authorID uuid.UUID) (*entity.Subscription, error) {
+	var subscription entity.Subscription
+	err := r.db.WithContext(ctx).
+		Where("subscriber_id = ? AND author_id = ?", subscriberID, authorID).
+		First(&subscription).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
 func (r *subscriptionRepository) UpdateExpiry(ctx context.Context, userID, authorID uuid.UUID, expiresAt time.Time, tier string) error {
 	result := r.db.WithContext(ctx).
 		Model(&entity.Subscription{}).
 		Where("subscriber_id = ? AND author_id = ?", userID, authorID).
 		Updates(map[string]interface{}{
-			"expires_at": expiresAt,
-			"tier":       tier,
-			"updated_at": time.Now(),
+			"expires_at":           expiresAt,
+			"tier":                 tier,
+			"grace_ends_at":        nil,
+			"reminder_sent_at":     nil,
+			"scheduled_tier":       nil,
+			"scheduled_expires_at": nil,
+			"updated_at":           time.Now(),
 		})
 
 	if result.Error != nil {
@@ -148,9 +168,10 @@ func (r *subscriptionRepository) UpdateExpiry(ctx context.Context, userID, autho
 
 func (r *subscriptionRepository) FindActiveSubscription(ctx context.Context, userID, authorID uuid.UUID) (*entity.Subscription, error) {
 	var subscription entity.Subscription
+	now := time.Now()
 	err := r.db.WithContext(ctx).
 		Where("subscriber_id = ? AND author_id = ?", userID, authorID).
-		Where("expires_at > ? OR expires_at IS NULL", time.Now()).
+		Where("expires_at > ? OR expires_at IS NULL OR grace_ends_at > ? OR scheduled_expires_at > ?", now, now, now).
 		First(&subscription).Error
 
 	if err != nil {
@@ -163,6 +184,163 @@ func (r *subscriptionRepository) FindActiveSubscription(ctx context.Context, use
 	return &subscription, nil
 }
 
+func (r *subscriptionRepository) FindSubscriberIDs(ctx context.Context, authorID, afterID uuid.UUID, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	query := r.db.WithContext(ctx).
+		Model(&entity.Subscription{}).
+		Where("author_id = ?", authorID)
+
+	if afterID != uuid.Nil {
+		query = query.Where("subscriber_id > ?", afterID)
+	}
+
+	err := query.
+		Order("subscriber_id ASC").
+		Limit(limit).
+		Pluck("subscriber_id", &ids).Error
+	return ids, err
+}
+
+func (r *subscriptionRepository) FindExpiringSoon(ctx context.Context, now, before time.Time, limit int) ([]entity.Subscription, error) {
+	var subscriptions []entity.Subscription
+	err := r.db.WithContext(ctx).
+		Where("expires_at > ? AND expires_at <= ? AND reminder_sent_at IS NULL AND scheduled_tier IS NULL", now, before).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+func (r *subscriptionRepository) FindLapsed(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error) {
+	var subscriptions []entity.Subscription
+	err := r.db.WithContext(ctx).
+		Where("expires_at <= ? AND grace_ends_at IS NULL AND scheduled_tier IS NULL", now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+func (r *subscriptionRepository) FindScheduledDue(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error) {
+	var subscriptions []entity.Subscription
+	err := r.db.WithContext(ctx).
+		Where("scheduled_tier IS NOT NULL AND expires_at <= ?", now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+func (r *subscriptionRepository) FindGraceEnded(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error) {
+	var subscriptions []entity.Subscription
+	err := r.db.WithContext(ctx).
+		Where("expires_at <= ? AND grace_ends_at <= ?", now, now).
+		Order("grace_ends_at ASC").
+		Limit(limit).
+		Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+func (r *subscriptionRepository) MarkReminderSent(ctx context.Context, id uuid.UUID, now, before time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.Subscription{}).
+		Where("id = ? AND expires_at > ? AND expires_at <= ? AND reminder_sent_at IS NULL", id, now, before).
+		Updates(map[string]interface{}{
+			"reminder_sent_at": now,
+			"updated_at":       now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *subscriptionRepository) StartGracePeriod(ctx context.Context, id uuid.UUID, now, graceEndsAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.Subscription{}).
+		Where("id = ? AND expires_at <= ? AND grace_ends_at IS NULL AND scheduled_tier IS NULL", id, now).
+		Updates(map[string]interface{}{
+			"grace_ends_at": graceEndsAt,
+			"updated_at":    now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *subscriptionRepository) Downgrade(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.Subscription{}).
+		Where("id = ? AND expires_at <= ? AND grace_ends_at <= ?", id, now, now).
+		Updates(map[string]interface{}{
+			"tier":             string(entity.TierFree),
+			"expires_at":       nil,
+			"grace_ends_at":    nil,
+			"reminder_sent_at": nil,
+			"updated_at":       now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *subscriptionRepository) ScheduleTierChange(ctx context.Context, id uuid.UUID, now time.Time, tier string, scheduledExpiresAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.Subscription{}).
+		Where("id = ? AND expires_at > ? AND scheduled_tier IS NULL", id, now).
+		Updates(map[string]interface{}{
+			"scheduled_tier":       tier,
+			"scheduled_expires_at": scheduledExpiresAt,
+			"updated_at":           now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *subscriptionRepository) ApplyScheduledChange(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	// The right-hand sides read the row as it was before the update, so the scheduled
+	// columns can be copied and cleared in one statement
+	result := r.db.WithContext(ctx).
+		Model(&entity.Subscription{}).
+		Where("id = ? AND scheduled_tier IS NOT NULL AND expires_at <= ?", id, now).
+		Updates(map[string]interface{}{
+			"tier":                 gorm.Expr("scheduled_tier"),
+			"expires_at":           gorm.Expr("scheduled_expires_at"),
+			"scheduled_tier":       nil,
+			"scheduled_expires_at": nil,
+			"grace_ends_at":        nil,
+			"reminder_sent_at":     nil,
+			"updated_at":           now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *subscriptionRepository) CancelScheduledChange(ctx context.Context, id uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.Subscription{}).
+		Where("id = ? AND scheduled_tier IS NOT NULL", id).
+		Updates(map[string]interface{}{
+			"scheduled_tier":       nil,
+			"scheduled_expires_at": nil,
+			"updated_at":           time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *subscriptionRepository) RevokePeriod(ctx context.Context, id uuid.UUID, tier string, expiresAt *time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&entity.Subscription{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"tier":                 tier,
+			"expires_at":           expiresAt,
+			"grace_ends_at":        nil,
+			"reminder_sent_at":     nil,
+			"scheduled_tier":       nil,
+			"scheduled_expires_at": nil,
+			"updated_at":           time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 // WithTx returns a new repository with the given transaction
 func (r *subscriptionRepository) WithTx(tx interface{}) repository.SubscriptionRepository {
 	if gormDB, ok := tx.(*gorm.DB); ok {
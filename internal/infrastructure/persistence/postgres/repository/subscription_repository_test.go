@@ -24,8 +24,8 @@ func TestSubscriptionRepository_UpdateExpiry_Success(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`UPDATE "subscriptions" SET "expires_at"=$1,"tier"=$2,"updated_at"=$3 WHERE subscriber_id = $4 AND author_id = $5`)).
-		WithArgs(expiresAt, tier, sqlmock.AnyArg(), userID, authorID).
+		`UPDATE "subscriptions" SET "expires_at"=$1,"grace_ends_at"=$2,"reminder_sent_at"=$3,"scheduled_expires_at"=$4,"scheduled_tier"=$5,"tier"=$6,"updated_at"=$7 WHERE subscriber_id = $8 AND author_id = $9`)).
+		WithArgs(expiresAt, nil, nil, nil, nil, tier, sqlmock.AnyArg(), userID, authorID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -47,8 +47,8 @@ func TestSubscriptionRepository_UpdateExpiry_NotFound(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`UPDATE "subscriptions" SET "expires_at"=$1,"tier"=$2,"updated_at"=$3 WHERE subscriber_id = $4 AND author_id = $5`)).
-		WithArgs(expiresAt, tier, sqlmock.AnyArg(), userID, authorID).
+		`UPDATE "subscriptions" SET "expires_at"=$1,"grace_ends_at"=$2,"reminder_sent_at"=$3,"scheduled_expires_at"=$4,"scheduled_tier"=$5,"tier"=$6,"updated_at"=$7 WHERE subscriber_id = $8 AND author_id = $9`)).
+		WithArgs(expiresAt, nil, nil, nil, nil, tier, sqlmock.AnyArg(), userID, authorID).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectCommit()
 
@@ -70,8 +70,8 @@ func TestSubscriptionRepository_UpdateExpiry_Error(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectExec(regexp.QuoteMeta(
-		`UPDATE "subscriptions" SET "expires_at"=$1,"tier"=$2,"updated_at"=$3 WHERE subscriber_id = $4 AND author_id = $5`)).
-		WithArgs(expiresAt, tier, sqlmock.AnyArg(), userID, authorID).
+		`UPDATE "subscriptions" SET "expires_at"=$1,"grace_ends_at"=$2,"reminder_sent_at"=$3,"scheduled_expires_at"=$4,"scheduled_tier"=$5,"tier"=$6,"updated_at"=$7 WHERE subscriber_id = $8 AND author_id = $9`)).
+		WithArgs(expiresAt, nil, nil, nil, nil, tier, sqlmock.AnyArg(), userID, authorID).
 		WillReturnError(assert.AnError)
 	mock.ExpectRollback()
 
@@ -94,8 +94,8 @@ func TestSubscriptionRepository_FindActiveSubscription_Paid(t *testing.T) {
 		AddRow(uuid.New(), userID, authorID, expiresAt, "PREMIUM", time.Now(), time.Now())
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "subscriptions" WHERE (subscriber_id = $1 AND author_id = $2) AND (expires_at > $3 OR expires_at IS NULL) ORDER BY "subscriptions"."id" LIMIT $4`)).
-		WithArgs(userID, authorID, sqlmock.AnyArg(), 1).
+		`SELECT * FROM "subscriptions" WHERE (subscriber_id = $1 AND author_id = $2) AND (expires_at > $3 OR expires_at IS NULL OR grace_ends_at > $4 OR scheduled_expires_at > $5) ORDER BY "subscriptions"."id" LIMIT $6`)).
+		WithArgs(userID, authorID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
 		WillReturnRows(rows)
 
 	result, err := repo.FindActiveSubscription(ctx, userID, authorID)
@@ -121,8 +121,8 @@ func TestSubscriptionRepository_FindActiveSubscription_SilverTier(t *testing.T)
 		AddRow(uuid.New(), userID, authorID, expiresAt, "SILVER", time.Now(), time.Now())
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "subscriptions" WHERE (subscriber_id = $1 AND author_id = $2) AND (expires_at > $3 OR expires_at IS NULL) ORDER BY "subscriptions"."id" LIMIT $4`)).
-		WithArgs(userID, authorID, sqlmock.AnyArg(), 1).
+		`SELECT * FROM "subscriptions" WHERE (subscriber_id = $1 AND author_id = $2) AND (expires_at > $3 OR expires_at IS NULL OR grace_ends_at > $4 OR scheduled_expires_at > $5) ORDER BY "subscriptions"."id" LIMIT $6`)).
+		WithArgs(userID, authorID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
 		WillReturnRows(rows)
 
 	result, err := repo.FindActiveSubscription(ctx, userID, authorID)
@@ -151,8 +151,8 @@ func TestSubscriptionRepository_Create_Success(t *testing.T) {
 
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`INSERT INTO "subscriptions" ("subscriber_id","author_id","expires_at","tier") VALUES ($1,$2,$3,$4) RETURNING "id","created_at","updated_at"`)).
-		WithArgs(userID, authorID, &expiresAt, "SILVER").
+		`INSERT INTO "subscriptions" ("subscriber_id","author_id","expires_at","tier","grace_ends_at","reminder_sent_at","scheduled_tier","scheduled_expires_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id","created_at","updated_at"`)).
+		WithArgs(userID, authorID, &expiresAt, "SILVER", nil, nil, nil, nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(uuid.New(), time.Now(), time.Now()))
 	mock.ExpectCommit()
 
@@ -174,8 +174,8 @@ func TestSubscriptionRepository_FindActiveSubscription_Free(t *testing.T) {
 		AddRow(uuid.New(), userID, authorID, nil, "FREE", time.Now(), time.Now())
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "subscriptions" WHERE (subscriber_id = $1 AND author_id = $2) AND (expires_at > $3 OR expires_at IS NULL) ORDER BY "subscriptions"."id" LIMIT $4`)).
-		WithArgs(userID, authorID, sqlmock.AnyArg(), 1).
+		`SELECT * FROM "subscriptions" WHERE (subscriber_id = $1 AND author_id = $2) AND (expires_at > $3 OR expires_at IS NULL OR grace_ends_at > $4 OR scheduled_expires_at > $5) ORDER BY "subscriptions"."id" LIMIT $6`)).
+		WithArgs(userID, authorID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
 		WillReturnRows(rows)
 
 	result, err := repo.FindActiveSubscription(ctx, userID, authorID)
@@ -195,8 +195,8 @@ func TestSubscriptionRepository_FindActiveSubscription_NotFound(t *testing.T) {
 	authorID := uuid.New()
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT * FROM "subscriptions" WHERE (subscriber_id = $1 AND author_id = $2) AND (expires_at > $3 OR expires_at IS NULL) ORDER BY "subscriptions"."id" LIMIT $4`)).
-		WithArgs(userID, authorID, sqlmock.AnyArg(), 1).
+		`SELECT * FROM "subscriptions" WHERE (subscriber_id = $1 AND author_id = $2) AND (expires_at > $3 OR expires_at IS NULL OR grace_ends_at > $4 OR scheduled_expires_at > $5) ORDER BY "subscriptions"."id" LIMIT $6`)).
+		WithArgs(userID, authorID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}))
 
 	result, err := repo.FindActiveSubscription(ctx, userID, authorID)
@@ -205,3 +205,111 @@ func TestSubscriptionRepository_FindActiveSubscription_NotFound(t *testing.T) {
 	assert.Nil(t, result)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestSubscriptionRepository_FindExpiringSoon(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewSubscriptionRepository(db)
+
+	now := time.Now()
+	before := now.Add(72 * time.Hour)
+	expiresAt := now.Add(24 * time.Hour)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		`SELECT * FROM "subscriptions" WHERE expires_at > $1 AND expires_at <= $2 AND reminder_sent_at IS NULL AND scheduled_tier IS NULL ORDER BY expires_at ASC LIMIT $3`)).
+		WithArgs(now, before, 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "subscriber_id", "author_id", "expires_at", "tier"}).
+			AddRow(uuid.New(), uuid.New(), uuid.New(), expiresAt, "SILVER"))
+
+	result, err := repo.FindExpiringSoon(context.Background(), now, before, 50)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubscriptionRepository_StartGracePeriod_AlreadyRenewed(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewSubscriptionRepository(db)
+
+	id := uuid.New()
+	now := time.Now()
+	graceEndsAt := now.Add(72 * time.Hour)
+
+	// A renewal moved expires_at into the future, so the lapsed condition no longer matches
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "subscriptions" SET "grace_ends_at"=$1,"updated_at"=$2 WHERE id = $3 AND expires_at <= $4 AND grace_ends_at IS NULL AND scheduled_tier IS NULL`)).
+		WithArgs(graceEndsAt, now, id, now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	updated, err := repo.StartGracePeriod(context.Background(), id, now, graceEndsAt)
+
+	assert.NoError(t, err)
+	assert.False(t, updated)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubscriptionRepository_Downgrade(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewSubscriptionRepository(db)
+
+	id := uuid.New()
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "subscriptions" SET "expires_at"=$1,"grace_ends_at"=$2,"reminder_sent_at"=$3,"tier"=$4,"updated_at"=$5 WHERE id = $6 AND expires_at <= $7 AND grace_ends_at <= $8`)).
+		WithArgs(nil, nil, nil, "FREE", now, id, now, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	updated, err := repo.Downgrade(context.Background(), id, now)
+
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubscriptionRepository_ScheduleTierChange(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewSubscriptionRepository(db)
+
+	id := uuid.New()
+	now := time.Now()
+	scheduledExpiresAt := now.Add(45 * 24 * time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "subscriptions" SET "scheduled_expires_at"=$1,"scheduled_tier"=$2,"updated_at"=$3 WHERE id = $4 AND expires_at > $5 AND scheduled_tier IS NULL`)).
+		WithArgs(scheduledExpiresAt, "BRONZE", now, id, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	updated, err := repo.ScheduleTierChange(context.Background(), id, now, "BRONZE", scheduledExpiresAt)
+
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubscriptionRepository_ApplyScheduledChange(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewSubscriptionRepository(db)
+
+	id := uuid.New()
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "subscriptions" SET "expires_at"=scheduled_expires_at,"grace_ends_at"=$1,"reminder_sent_at"=$2,"scheduled_expires_at"=$3,"scheduled_tier"=$4,"tier"=scheduled_tier,"updated_at"=$5 WHERE id = $6 AND scheduled_tier IS NOT NULL AND expires_at <= $7`)).
+		WithArgs(nil, nil, nil, nil, now, id, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	updated, err := repo.ApplyScheduledChange(context.Background(), id, now)
+
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,10 +2,12 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -23,6 +25,18 @@ func (r *transactionRepository) Create(ctx context.Context, tx *entity.Transacti
 	return r.db.WithContext(ctx).Create(tx).Error
 }
 
+// FindByID finds a transaction by ID
+func (r *transactionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	var tx entity.Transaction
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&tx).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &tx, err
+}
+
 // FindByRefID finds a transaction by reference code
 func (r *transactionRepository) FindByRefID(ctx context.Context, refID string) (*entity.Transaction, error) {
 	var tx entity.Transaction
@@ -47,6 +61,18 @@ func (r *transactionRepository) FindBySePayID(ctx context.Context, sePayID strin
 	return &tx, err
 }
 
+// FindByProviderRef finds a transaction by the ID of its payment at a provider
+func (r *transactionRepository) FindByProviderRef(ctx context.Context, provider entity.TransactionProvider, providerRef string) (*entity.Transaction, error) {
+	var tx entity.Transaction
+	err := r.db.WithContext(ctx).
+		Where("provider = ? AND provider_ref = ?", provider, providerRef).
+		First(&tx).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &tx, err
+}
+
 // UpdateStatus updates the status of a transaction
 func (r *transactionRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entity.TransactionStatus) error {
 	return r.db.WithContext(ctx).
@@ -60,6 +86,103 @@ func (r *transactionRepository) Update(ctx context.Context, tx *entity.Transacti
 	return r.db.WithContext(ctx).Save(tx).Error
 }
 
+// Claim saves tx unless its status moved away from from since it was loaded
+func (r *transactionRepository) Claim(ctx context.Context, tx *entity.Transaction, from entity.TransactionStatus) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(tx).
+		Where("status = ?", from).
+		Select("*").
+		Updates(tx)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ApplyRefund records a refund on a paid transaction unless another one landed first
+func (r *transactionRepository) ApplyRefund(ctx context.Context, id uuid.UUID, expected, refunded decimal.Decimal, status entity.TransactionStatus) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entity.Transaction{}).
+		Where("id = ? AND refunded_amount = ? AND status IN ?", id, expected, []entity.TransactionStatus{
+			entity.TransactionStatusSuccess,
+			entity.TransactionStatusPartiallyRefunded,
+		}).
+		Updates(map[string]interface{}{
+			"refunded_amount": refunded,
+			"status":          status,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// FindPending returns up to limit unpaid orders, oldest first
+func (r *transactionRepository) FindPending(ctx context.Context, limit int) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+	err := r.db.WithContext(ctx).
+		Where("status = ?", entity.TransactionStatusPending).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&transactions).Error
+	return transactions, err
+}
+
+// ExpirePending marks the given unpaid orders created before the given time as expired and
+// releases the promo code redemptions they reserved in the same statement
+func (r *transactionRepository) ExpirePending(ctx context.Context, ids []uuid.UUID, createdBefore time.Time) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var expired int64
+	err := r.db.WithContext(ctx).Raw(`
+		WITH expired AS (
+			UPDATE transactions SET status = ?, updated_at = ?
+			WHERE id IN ? AND status = ? AND created_at < ?
+			RETURNING promo_code_id
+		), released AS (
+			UPDATE promo_codes p
+			SET redemption_count = GREATEST(p.redemption_count - e.orders, 0), updated_at = ?
+			FROM (
+				SELECT promo_code_id, COUNT(*) AS orders FROM expired
+				WHERE promo_code_id IS NOT NULL GROUP BY promo_code_id
+			) e
+			WHERE p.id = e.promo_code_id
+		)
+		SELECT COUNT(*) FROM expired`,
+		entity.TransactionStatusExpired, time.Now(), ids, entity.TransactionStatusPending, createdBefore, time.Now(),
+	).Scan(&expired).Error
+	return expired, err
+}
+
+// FindPaidBetween returns the transactions whose transfer was applied within [from, to)
+func (r *transactionRepository) FindPaidBetween(ctx context.Context, from, to time.Time) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+	err := r.db.WithContext(ctx).
+		Where("paid_at >= ? AND paid_at < ?", from, to).
+		Order("paid_at ASC").
+		Find(&transactions).Error
+	return transactions, err
+}
+
+// CountCreatedByStatus counts the orders created within [from, to) per current status
+func (r *transactionRepository) CountCreatedByStatus(ctx context.Context, from, to time.Time) (map[entity.TransactionStatus]int64, error) {
+	var rows []struct {
+		Status entity.TransactionStatus
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entity.Transaction{}).
+		Select("status, COUNT(*) AS count").
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entity.TransactionStatus]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
 // FindByUserID finds all transactions for a user
 func (r *transactionRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Transaction, error) {
 	var transactions []*entity.Transaction
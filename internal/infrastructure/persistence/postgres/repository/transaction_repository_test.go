@@ -80,22 +80,35 @@ func TestTransactionRepository_Create_Success(t *testing.T) {
 	}
 
 	// Expect GORM to insert the transaction
-	// Column order: user_id, amount, currency, provider, gateway, type, status, target_id, plan_id, content, se_pay_id, reference_code, order_id
+	// Column order: user_id, amount, paid_amount, paid_at, refunded_amount, currency, provider, gateway, type, status,
+	// target_id, plan_id, gift, promo_code_id, discount, subscription_change, period_starts_at, period_expires_at,
+	// quoted_expires_at, content, sepay_id, provider_ref, reference_code, order_id
 	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(
 		`INSERT INTO "transactions"`)).
 		WithArgs(
 			userID,
 			amount,
+			nil,          // paid_amount
+			nil,          // paid_at
+			decimal.Zero, // refunded_amount
 			"VND",
 			entity.TransactionProviderSEPAY,
 			nil, // gateway
 			entity.TransactionTypeSubscription,
 			entity.TransactionStatusPending,
-			nil, // target_id
-			nil, // plan_id
-			"",  // content
+			nil,          // target_id
+			nil,          // plan_id
+			false,        // gift
+			nil,          // promo_code_id
+			decimal.Zero, // discount
+			nil,          // subscription_change
+			nil,          // period_starts_at
+			nil,          // period_expires_at
+			nil,          // quoted_expires_at
+			"",           // content
 			"SEPAY123456",
+			nil, // provider_ref
 			"REF789",
 			"ORD123456789",
 		).
@@ -133,17 +146,28 @@ func TestTransactionRepository_Create_Error(t *testing.T) {
 		WithArgs(
 			userID,
 			amount,
-			"VND",     // default currency
-			"",        // default provider
-			nil,       // gateway
-			"",        // type
-			"PENDING", // default status
-			nil,       // target_id
-			nil,       // plan_id
-			"",        // content
-			"",        // se_pay_id
-			"",        // reference_code
-			"",        // order_id
+			nil,          // paid_amount
+			nil,          // paid_at
+			decimal.Zero, // refunded_amount
+			"VND",        // default currency
+			"",           // default provider
+			nil,          // gateway
+			"",           // type
+			"PENDING",    // default status
+			nil,          // target_id
+			nil,          // plan_id
+			false,        // gift
+			nil,          // promo_code_id
+			decimal.Zero, // discount
+			nil,          // subscription_change
+			nil,          // period_starts_at
+			nil,          // period_expires_at
+			nil,          // quoted_expires_at
+			"",           // content
+			"",           // sepay_id
+			nil,          // provider_ref
+			"",           // reference_code
+			"",           // order_id
 		).
 		WillReturnError(assert.AnError)
 	mock.ExpectRollback()
@@ -358,6 +382,40 @@ func TestTransactionRepository_UpdateStatus_Error(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+// TestTransactionRepository_Claim tests that a transaction is only saved from the expected status
+func TestTransactionRepository_Claim(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		affected int64
+		claimed  bool
+	}{
+		{name: "claimed", affected: 1, claimed: true},
+		{name: "changed_concurrently", affected: 0, claimed: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			db, mock := setupTestDB(t)
+			repo := NewTransactionRepository(db)
+
+			ctx := context.Background()
+			tx := &entity.Transaction{ID: uuid.New(), Status: entity.TransactionStatusSuccess}
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "transactions" SET .* WHERE status = \$\d+ AND "id" = \$\d+`).
+				WillReturnResult(sqlmock.NewResult(0, tc.affected))
+			mock.ExpectCommit()
+
+			// Act
+			claimed, err := repo.Claim(ctx, tx, entity.TransactionStatusPending)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.claimed, claimed)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 // TestTransactionRepository_FindByUserID_Success tests finding transactions by user ID
 func TestTransactionRepository_FindByUserID_Success(t *testing.T) {
 	// Arrange
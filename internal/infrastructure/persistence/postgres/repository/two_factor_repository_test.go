@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwoFactorRepository_AdvanceStep(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewTwoFactorRepository(db)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	query := regexp.QuoteMeta(`UPDATE "user_two_factor" SET "last_used_step"=$1,"updated_at"=$2 WHERE user_id = $3 AND last_used_step < $4`)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs(int64(100), sqlmock.AnyArg(), userID, int64(100)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	ok, err := repo.AdvanceStep(ctx, userID, 100)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// The same step again matches no row: the code was already used
+	mock.ExpectBegin()
+	mock.ExpectExec(query).
+		WithArgs(int64(100), sqlmock.AnyArg(), userID, int64(100)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	ok, err = repo.AdvanceStep(ctx, userID, 100)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTwoFactorRepository_UpdateSecret(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewTwoFactorRepository(db)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	// Only the secret changes; the enrollment and the last used step are left alone
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "user_two_factor" SET "secret"=$1,"updated_at"=$2 WHERE user_id = $3`)).
+		WithArgs("v1:sealed", sqlmock.AnyArg(), userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.UpdateSecret(ctx, userID, "v1:sealed"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTwoFactorRepository_UseRecoveryCode(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewTwoFactorRepository(db)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(
+		`UPDATE "two_factor_recovery_codes" SET "used_at"=$1 WHERE user_id = $2 AND code_hash = $3 AND used_at IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), userID, "hash").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	ok, err := repo.UseRecoveryCode(ctx, userID, "hash")
+
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTwoFactorRepository_FindByUserID_NotFound(t *testing.T) {
+	db, mock := setupTestDB(t)
+	repo := NewTwoFactorRepository(db)
+
+	userID := uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_two_factor" WHERE user_id = $1`)).
+		WithArgs(userID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+
+	twoFactor, err := repo.FindByUserID(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Nil(t, twoFactor)
+}
@@ -35,12 +35,13 @@ func (r *userSeriesPurchaseRepository) HasPurchased(ctx context.Context, userID,
 	return count > 0, nil
 }
 
-// GetUserPurchases returns all series purchased by a user, including preloaded Series entity
+// GetUserPurchases returns all series purchased by a user, newest first, including preloaded Series entity
 func (r *userSeriesPurchaseRepository) GetUserPurchases(ctx context.Context, userID uuid.UUID) ([]*entity.UserSeriesPurchase, error) {
 	var purchases []*entity.UserSeriesPurchase
 	err := r.db.WithContext(ctx).
 		Preload("Series").
 		Where("user_id = ?", userID).
+		Order("created_at DESC").
 		Find(&purchases).Error
 	if err != nil {
 		return nil, err
@@ -48,6 +49,13 @@ func (r *userSeriesPurchaseRepository) GetUserPurchases(ctx context.Context, use
 	return purchases, nil
 }
 
+// Delete removes a user series purchase record
+func (r *userSeriesPurchaseRepository) Delete(ctx context.Context, userID, seriesID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND series_id = ?", userID, seriesID).
+		Delete(&entity.UserSeriesPurchase{}).Error
+}
+
 // WithTx returns a new repository with the given transaction
 func (r *userSeriesPurchaseRepository) WithTx(tx interface{}) repository.UserSeriesPurchaseRepository {
 	if gormDB, ok := tx.(*gorm.DB); ok {
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	domainRepo "github.com/aiagent/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+type authTokenRepository struct {
+	client *redis.Client
+}
+
+// NewAuthTokenRepository creates a new instance of AuthTokenRepository.
+// Only a hash of each token is stored, so a leaked keyspace cannot be replayed.
+func NewAuthTokenRepository(client *redis.Client) domainRepo.AuthTokenRepository {
+	return &authTokenRepository{
+		client: client,
+	}
+}
+
+func (r *authTokenRepository) Create(ctx context.Context, purpose domainRepo.AuthTokenPurpose, token string, userID uuid.UUID, ttl time.Duration) error {
+	hash := hashToken(token)
+	userKey := r.getUserKey(purpose, userID)
+
+	// Drop the token issued before this one so only the latest email works
+	previous, err := r.client.Get(ctx, userKey).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if previous != "" {
+			pipe.Del(ctx, r.getKey(purpose, previous))
+		}
+		pipe.Set(ctx, r.getKey(purpose, hash), userID.String(), ttl)
+		pipe.Set(ctx, userKey, hash, ttl)
+		return nil
+	})
+	return err
+}
+
+func (r *authTokenRepository) Consume(ctx context.Context, purpose domainRepo.AuthTokenPurpose, token string) (uuid.UUID, error) {
+	userIDStr, err := r.client.GetDel(ctx, r.getKey(purpose, hashToken(token))).Result()
+	if errors.Is(err, redis.Nil) {
+		return uuid.Nil, nil
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid user id in token: %w", err)
+	}
+	_ = r.client.Del(ctx, r.getUserKey(purpose, userID)).Err()
+	return userID, nil
+}
+
+func (r *authTokenRepository) getKey(purpose domainRepo.AuthTokenPurpose, hash string) string {
+	return fmt.Sprintf("auth_token:%s:%s", purpose, hash)
+}
+
+func (r *authTokenRepository) getUserKey(purpose domainRepo.AuthTokenPurpose, userID uuid.UUID) string {
+	return fmt.Sprintf("auth_token_user:%s:%s", purpose, userID)
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainRepo "github.com/aiagent/internal/domain/repository"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthTokenRepository_SingleUseAndLatestOnly(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	repo := NewAuthTokenRepository(client)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	require.NoError(t, repo.Create(ctx, domainRepo.AuthTokenPasswordReset, "first", userID, time.Hour))
+	require.NoError(t, repo.Create(ctx, domainRepo.AuthTokenPasswordReset, "second", userID, time.Hour))
+
+	// Issuing a new token invalidates the previous one
+	got, err := repo.Consume(ctx, domainRepo.AuthTokenPasswordReset, "first")
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, got)
+
+	// Tokens are scoped to their purpose
+	got, err = repo.Consume(ctx, domainRepo.AuthTokenEmailVerification, "second")
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, got)
+
+	got, err = repo.Consume(ctx, domainRepo.AuthTokenPasswordReset, "second")
+	require.NoError(t, err)
+	assert.Equal(t, userID, got)
+
+	got, err = repo.Consume(ctx, domainRepo.AuthTokenPasswordReset, "second")
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, got)
+
+	// Only hashes are stored
+	assert.False(t, mr.Exists("auth_token:password_reset:second"))
+}
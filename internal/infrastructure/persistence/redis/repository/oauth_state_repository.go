@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	domainRepo "github.com/aiagent/internal/domain/repository"
+	"github.com/redis/go-redis/v9"
+)
+
+type oauthStateRepository struct {
+	client *redis.Client
+}
+
+// NewOAuthStateRepository creates a new instance of OAuthStateRepository
+func NewOAuthStateRepository(client *redis.Client) domainRepo.OAuthStateRepository {
+	return &oauthStateRepository{
+		client: client,
+	}
+}
+
+func (r *oauthStateRepository) Save(ctx context.Context, state string, data *domainRepo.OAuthState, ttl time.Duration) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal oauth state: %w", err)
+	}
+	return r.client.Set(ctx, r.getKey(state), payload, ttl).Err()
+}
+
+func (r *oauthStateRepository) Consume(ctx context.Context, state string) (*domainRepo.OAuthState, error) {
+	// GETDEL makes a replayed callback find nothing
+	payload, err := r.client.GetDel(ctx, r.getKey(state)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var data domainRepo.OAuthState
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal oauth state: %w", err)
+	}
+	return &data, nil
+}
+
+func (r *oauthStateRepository) getKey(state string) string {
+	return fmt.Sprintf("oauth_state:%s", state)
+}
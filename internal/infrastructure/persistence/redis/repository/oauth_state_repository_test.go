@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainRepo "github.com/aiagent/internal/domain/repository"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthStateRepository_ConsumeIsSingleUse(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	repo := NewOAuthStateRepository(client)
+	ctx := context.Background()
+
+	err := repo.Save(ctx, "state-1", &domainRepo.OAuthState{Provider: "github", CodeVerifier: "verifier"}, time.Minute)
+	require.NoError(t, err)
+
+	got, err := repo.Consume(ctx, "state-1")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "github", got.Provider)
+	assert.Equal(t, "verifier", got.CodeVerifier)
+
+	// A replayed callback must not find the state again
+	got, err = repo.Consume(ctx, "state-1")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestOAuthStateRepository_Expires(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	repo := NewOAuthStateRepository(client)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Save(ctx, "state-2", &domainRepo.OAuthState{Provider: "google"}, time.Minute))
+	mr.FastForward(2 * time.Minute)
+
+	got, err := repo.Consume(ctx, "state-2")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -2,43 +2,245 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/aiagent/internal/domain/entity"
 	domainRepo "github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/infrastructure/config"
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 )
 
+// touchSessionScript updates last-seen and the expiry only while the session
+// still exists, so a touch racing a revocation cannot resurrect it. A legacy
+// session stored as a plain string is deleted instead.
+var touchSessionScript = redis.NewScript(`
+	local kind = redis.call('TYPE', KEYS[1])['ok']
+	if kind ~= 'hash' then
+		if kind ~= 'none' then
+			redis.call('DEL', KEYS[1])
+		end
+		return 0
+	end
+	redis.call('HSET', KEYS[1], 'last_seen_at', ARGV[1])
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	redis.call('PEXPIRE', KEYS[2], ARGV[2], 'GT')
+	return 1
+`)
+
 type sessionRepository struct {
 	client *redis.Client
+	config *config.SessionConfig
 }
 
 // NewSessionRepository creates a new instance of SessionRepository
-func NewSessionRepository(client *redis.Client) domainRepo.SessionRepository {
+func NewSessionRepository(client *redis.Client, cfg *config.SessionConfig) domainRepo.SessionRepository {
 	return &sessionRepository{
 		client: client,
+		config: cfg,
 	}
 }
 
-func (r *sessionRepository) CreateSession(ctx context.Context, sessionID string, userID string, duration time.Duration) error {
-	key := r.getKey(sessionID)
-	return r.client.Set(ctx, key, userID, duration).Err()
+func (r *sessionRepository) CreateSession(ctx context.Context, session *entity.Session) error {
+	ttl := r.ttl(session, session.LastSeenAt)
+	session.ExpiresAt = session.LastSeenAt.Add(ttl)
+	userKey := r.getUserKey(session.UserID.String())
+
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, r.getKey(session.ID),
+			"user_id", session.UserID.String(),
+			"device", session.Device,
+			"ip_address", session.IPAddress,
+			"user_agent", session.UserAgent,
+			"created_at", session.CreatedAt.UTC().Format(time.RFC3339Nano),
+			"last_seen_at", session.LastSeenAt.UTC().Format(time.RFC3339Nano),
+		)
+		pipe.Expire(ctx, r.getKey(session.ID), ttl)
+		// The index lives as long as the longest-lived session; a shorter TTL never cuts it down
+		pipe.SAdd(ctx, userKey, session.ID)
+		pipe.ExpireNX(ctx, userKey, ttl)
+		pipe.ExpireGT(ctx, userKey, ttl)
+		return nil
+	})
+	return err
 }
 
 func (r *sessionRepository) GetUserID(ctx context.Context, sessionID string) (string, error) {
-	key := r.getKey(sessionID)
-	userID, err := r.client.Get(ctx, key).Result()
+	userID, err := r.client.HGet(ctx, r.getKey(sessionID), "user_id").Result()
+	if isLegacySession(err) {
+		return "", r.dropLegacySession(ctx, sessionID, redis.Nil)
+	}
 	if err != nil {
 		return "", err
 	}
 	return userID, nil
 }
 
+func (r *sessionRepository) GetSession(ctx context.Context, sessionID string) (*entity.Session, error) {
+	fields, err := r.client.HGetAll(ctx, r.getKey(sessionID)).Result()
+	if isLegacySession(err) {
+		return nil, r.dropLegacySession(ctx, sessionID, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return r.toEntity(sessionID, fields)
+}
+
+func (r *sessionRepository) TouchSession(ctx context.Context, session *entity.Session, seenAt time.Time) error {
+	ttl := r.ttl(session, seenAt)
+	if ttl <= 0 {
+		return r.DeleteSession(ctx, session.ID)
+	}
+	session.LastSeenAt = seenAt
+	session.ExpiresAt = seenAt.Add(ttl)
+
+	keys := []string{r.getKey(session.ID), r.getUserKey(session.UserID.String())}
+	return touchSessionScript.Run(ctx, r.client, keys, seenAt.UTC().Format(time.RFC3339Nano), ttl.Milliseconds()).Err()
+}
+
+func (r *sessionRepository) ListUserSessions(ctx context.Context, userID string) ([]entity.Session, error) {
+	userKey := r.getUserKey(userID)
+	sessionIDs, err := r.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	cmds := make([]*redis.MapStringStringCmd, len(sessionIDs))
+	_, err = r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, sessionID := range sessionIDs {
+			cmds[i] = pipe.HGetAll(ctx, r.getKey(sessionID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]entity.Session, 0, len(sessionIDs))
+	var expired []interface{}
+	for i, cmd := range cmds {
+		fields := cmd.Val()
+		if len(fields) == 0 {
+			expired = append(expired, sessionIDs[i])
+			continue
+		}
+		session, err := r.toEntity(sessionIDs[i], fields)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+
+	// Sessions that expired on their own are still listed in the index
+	if len(expired) > 0 {
+		if err := r.client.SRem(ctx, userKey, expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+	return sessions, nil
+}
+
 func (r *sessionRepository) DeleteSession(ctx context.Context, sessionID string) error {
 	key := r.getKey(sessionID)
-	return r.client.Del(ctx, key).Err()
+	var userID *redis.StringCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		userID = pipe.HGet(ctx, key, "user_id")
+		pipe.Del(ctx, key)
+		return nil
+	})
+	// A legacy session is not in any index and was deleted by the same transaction
+	if errors.Is(err, redis.Nil) || isLegacySession(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return r.client.SRem(ctx, r.getUserKey(userID.Val()), sessionID).Err()
+}
+
+func (r *sessionRepository) DeleteUserSessions(ctx context.Context, userID string, exceptSessionID string) error {
+	userKey := r.getUserKey(userID)
+	sessionIDs, err := r.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, sessionID := range sessionIDs {
+			if sessionID == exceptSessionID {
+				continue
+			}
+			pipe.Del(ctx, r.getKey(sessionID))
+			pipe.SRem(ctx, userKey, sessionID)
+		}
+		return nil
+	})
+	return err
+}
+
+// isLegacySession reports whether a session key still holds the plain user ID that sessions
+// were stored as before they became hashes
+func isLegacySession(err error) bool {
+	return redis.HasErrorPrefix(err, "WRONGTYPE")
+}
+
+// dropLegacySession deletes a session stored in the old format, which is treated as not found
+// so its user signs in again, and returns notFound
+func (r *sessionRepository) dropLegacySession(ctx context.Context, sessionID string, notFound error) error {
+	if err := r.client.Del(ctx, r.getKey(sessionID)).Err(); err != nil {
+		return err
+	}
+	return notFound
+}
+
+// ttl returns how long the session may live after activity at the given time:
+// the idle timeout, capped by what is left of its maximum lifetime
+func (r *sessionRepository) ttl(session *entity.Session, at time.Time) time.Duration {
+	ttl := r.config.IdleTimeout
+	if r.config.MaxLifetime > 0 {
+		if remaining := session.CreatedAt.Add(r.config.MaxLifetime).Sub(at); remaining < ttl {
+			ttl = remaining
+		}
+	}
+	return ttl
+}
+
+func (r *sessionRepository) toEntity(sessionID string, fields map[string]string) (*entity.Session, error) {
+	userID, err := uuid.Parse(fields["user_id"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id in session %s: %w", sessionID, err)
+	}
+	createdAt, _ := time.Parse(time.RFC3339Nano, fields["created_at"])
+	lastSeenAt, _ := time.Parse(time.RFC3339Nano, fields["last_seen_at"])
+
+	session := &entity.Session{
+		ID:         sessionID,
+		UserID:     userID,
+		Device:     fields["device"],
+		IPAddress:  fields["ip_address"],
+		UserAgent:  fields["user_agent"],
+		CreatedAt:  createdAt,
+		LastSeenAt: lastSeenAt,
+	}
+	session.ExpiresAt = lastSeenAt.Add(r.ttl(session, lastSeenAt))
+	return session, nil
 }
 
 func (r *sessionRepository) getKey(sessionID string) string {
 	return fmt.Sprintf("session:%s", sessionID)
 }
+
+func (r *sessionRepository) getUserKey(userID string) string {
+	return fmt.Sprintf("user_sessions:%s", userID)
+}
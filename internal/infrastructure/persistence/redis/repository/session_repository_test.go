@@ -5,10 +5,33 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/infrastructure/config"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+var testSessionConfig = &config.SessionConfig{
+	IdleTimeout:   time.Hour,
+	MaxLifetime:   24 * time.Hour,
+	TouchInterval: time.Minute,
+}
+
+func newTestSession(id string, userID uuid.UUID, createdAt time.Time) *entity.Session {
+	return &entity.Session{
+		ID:         id,
+		UserID:     userID,
+		Device:     "Firefox on Linux",
+		IPAddress:  "203.0.113.7",
+		UserAgent:  "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0",
+		CreatedAt:  createdAt,
+		LastSeenAt: createdAt,
+	}
+}
+
 func TestSessionRepository_Integration(t *testing.T) {
 	// This requires a running Redis instance.
 	// We'll skip if connection fails.
@@ -21,23 +44,22 @@ func TestSessionRepository_Integration(t *testing.T) {
 		t.Skip("Redis is not available")
 	}
 
-	repo := NewSessionRepository(client)
+	repo := NewSessionRepository(client, testSessionConfig)
 	ctx := context.Background()
 	sessionID := "test-session-id"
-	userID := "test-user-id"
-	duration := time.Minute
+	userID := uuid.New()
 
 	// cleanup
-	defer client.Del(ctx, "session:"+sessionID)
+	defer client.Del(ctx, "session:"+sessionID, "user_sessions:"+userID.String())
 
 	// Test CreateSession
-	err := repo.CreateSession(ctx, sessionID, userID, duration)
+	err := repo.CreateSession(ctx, newTestSession(sessionID, userID, time.Now()))
 	assert.NoError(t, err)
 
 	// Test GetUserID
 	gotUserID, err := repo.GetUserID(ctx, sessionID)
 	assert.NoError(t, err)
-	assert.Equal(t, userID, gotUserID)
+	assert.Equal(t, userID.String(), gotUserID)
 
 	// Test DeleteSession
 	err = repo.DeleteSession(ctx, sessionID)
@@ -47,3 +69,141 @@ func TestSessionRepository_Integration(t *testing.T) {
 	_, err = repo.GetUserID(ctx, sessionID)
 	assert.Error(t, err)
 }
+
+func TestSessionRepository_GetAndListSessions(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	repo := NewSessionRepository(client, testSessionConfig)
+	ctx := context.Background()
+	userID := uuid.New()
+	now := time.Now().Truncate(time.Second)
+
+	older := newTestSession("s1", userID, now.Add(-2*time.Hour))
+	older.LastSeenAt = now.Add(-time.Minute)
+	newer := newTestSession("s2", userID, now)
+	require.NoError(t, repo.CreateSession(ctx, older))
+	require.NoError(t, repo.CreateSession(ctx, newer))
+	require.NoError(t, repo.CreateSession(ctx, newTestSession("gone", userID, now)))
+	mr.Del("session:gone") // expired without being revoked
+
+	got, err := repo.GetSession(ctx, "s1")
+	require.NoError(t, err)
+	assert.Equal(t, older.Device, got.Device)
+	assert.Equal(t, older.IPAddress, got.IPAddress)
+	assert.True(t, older.CreatedAt.Equal(got.CreatedAt))
+
+	missing, err := repo.GetSession(ctx, "unknown")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	sessions, err := repo.ListUserSessions(ctx, userID.String())
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "s2", sessions[0].ID)
+	assert.Equal(t, "s1", sessions[1].ID)
+	assert.False(t, isMember(t, mr, "user_sessions:"+userID.String(), "gone"), "expired session is pruned from the index")
+}
+
+func TestSessionRepository_TouchSession(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	repo := NewSessionRepository(client, testSessionConfig)
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("slides_idle_timeout", func(t *testing.T) {
+		session := newTestSession("fresh", uuid.New(), now)
+		require.NoError(t, repo.CreateSession(ctx, session))
+		mr.FastForward(30 * time.Minute)
+
+		require.NoError(t, repo.TouchSession(ctx, session, now.Add(30*time.Minute)))
+
+		assert.Equal(t, time.Hour, mr.TTL("session:fresh"))
+		got, err := repo.GetSession(ctx, "fresh")
+		require.NoError(t, err)
+		assert.True(t, now.Add(30*time.Minute).Equal(got.LastSeenAt))
+	})
+
+	t.Run("capped_by_max_lifetime", func(t *testing.T) {
+		session := newTestSession("old", uuid.New(), now.Add(-23*time.Hour-30*time.Minute))
+		require.NoError(t, repo.CreateSession(ctx, session))
+
+		require.NoError(t, repo.TouchSession(ctx, session, now))
+
+		assert.InDelta(t, float64(30*time.Minute), float64(mr.TTL("session:old")), float64(time.Second))
+	})
+
+	t.Run("does_not_resurrect_revoked_session", func(t *testing.T) {
+		session := newTestSession("revoked", uuid.New(), now)
+		require.NoError(t, repo.CreateSession(ctx, session))
+		require.NoError(t, repo.DeleteSession(ctx, "revoked"))
+
+		require.NoError(t, repo.TouchSession(ctx, session, now))
+
+		assert.False(t, mr.Exists("session:revoked"))
+	})
+}
+
+func TestSessionRepository_DeleteUserSessions(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	repo := NewSessionRepository(client, testSessionConfig)
+	ctx := context.Background()
+	user1, user2 := uuid.New(), uuid.New()
+
+	for _, id := range []string{"s1", "s2", "s3"} {
+		assert.NoError(t, repo.CreateSession(ctx, newTestSession(id, user1, time.Now())))
+	}
+	assert.NoError(t, repo.CreateSession(ctx, newTestSession("other", user2, time.Now())))
+
+	assert.NoError(t, repo.DeleteUserSessions(ctx, user1.String(), "s2"))
+
+	_, err := repo.GetUserID(ctx, "s1")
+	assert.Error(t, err)
+	_, err = repo.GetUserID(ctx, "s3")
+	assert.Error(t, err)
+	userID, err := repo.GetUserID(ctx, "s2")
+	assert.NoError(t, err)
+	assert.Equal(t, user1.String(), userID)
+	userID, err = repo.GetUserID(ctx, "other")
+	assert.NoError(t, err)
+	assert.Equal(t, user2.String(), userID)
+}
+
+func isMember(t *testing.T, mr *miniredis.Miniredis, key, member string) bool {
+	ok, err := mr.IsMember(key, member)
+	require.NoError(t, err)
+	return ok
+}
+
+func TestSessionRepository_LegacySessionsAreNotFound(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	repo := NewSessionRepository(client, testSessionConfig)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	// Sessions created before they became hashes hold only the user ID
+	legacy := func(id string) {
+		require.NoError(t, mr.Set("session:"+id, userID.String()))
+	}
+
+	legacy("old-get")
+	session, err := repo.GetSession(ctx, "old-get")
+	require.NoError(t, err)
+	assert.Nil(t, session)
+	assert.False(t, mr.Exists("session:old-get"))
+
+	legacy("old-user")
+	_, err = repo.GetUserID(ctx, "old-user")
+	assert.ErrorIs(t, err, redis.Nil)
+	assert.False(t, mr.Exists("session:old-user"))
+
+	legacy("old-touch")
+	require.NoError(t, repo.TouchSession(ctx, newTestSession("old-touch", userID, time.Now()), time.Now()))
+	assert.False(t, mr.Exists("session:old-touch"))
+
+	legacy("old-delete")
+	require.NoError(t, repo.DeleteSession(ctx, "old-delete"))
+	assert.False(t, mr.Exists("session:old-delete"))
+}
@@ -1,12 +1,20 @@
 package auth
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/aiagent/internal/application/dto"
 	"github.com/aiagent/internal/application/usecase/auth"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/domain/valueobject"
 	"github.com/aiagent/pkg/response"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type AuthHandler interface {
@@ -15,6 +23,23 @@ type AuthHandler interface {
 	Logout(c *gin.Context)
 	SocialLogin(c *gin.Context)
 	SocialCallback(c *gin.Context)
+	VerifyEmail(c *gin.Context)
+	ResendVerification(c *gin.Context)
+	ForgotPassword(c *gin.Context)
+	ResetPassword(c *gin.Context)
+	ChangePassword(c *gin.Context)
+	ListSessions(c *gin.Context)
+	RevokeSession(c *gin.Context)
+	LogoutEverywhere(c *gin.Context)
+	VerifyTwoFactorLogin(c *gin.Context)
+	GetTwoFactorStatus(c *gin.Context)
+	SetupTwoFactor(c *gin.Context)
+	EnableTwoFactor(c *gin.Context)
+	DisableTwoFactor(c *gin.Context)
+	RegenerateRecoveryCodes(c *gin.Context)
+	CreateAPIToken(c *gin.Context)
+	ListAPITokens(c *gin.Context)
+	RevokeAPIToken(c *gin.Context)
 }
 
 type authHandler struct {
@@ -51,16 +76,16 @@ func (h *authHandler) Login(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.authUseCase.Login(c.Request.Context(), req)
+	resp, err := h.authUseCase.Login(c.Request.Context(), req, clientInfo(c))
 	if err != nil {
 		response.Unauthorized(c, err.Error())
 		return
 	}
 
-	// Set session cookie
-	secure := gin.Mode() == gin.ReleaseMode
-	c.SetSameSite(http.SameSiteLaxMode)
-	c.SetCookie("session_id", resp.SessionID, 3600*24, "/", "", secure, true)
+	// With two-factor authentication the session only exists after the second step
+	if !resp.TwoFactorRequired {
+		setSessionCookie(c, resp)
+	}
 
 	response.Success(c, http.StatusOK, resp)
 }
@@ -77,9 +102,7 @@ func (h *authHandler) Logout(c *gin.Context) {
 		return
 	}
 
-	// Clear cookie
-	secure := gin.Mode() == gin.ReleaseMode
-	c.SetCookie("session_id", "", -1, "/", "", secure, true)
+	clearSessionCookie(c)
 
 	response.Success(c, http.StatusOK, nil)
 }
@@ -91,38 +114,494 @@ func (h *authHandler) SocialLogin(c *gin.Context) {
 		return
 	}
 
-	authURL, err := h.authUseCase.GetSocialAuthURL(c.Request.Context(), provider)
+	authURL, state, err := h.authUseCase.GetSocialAuthURL(c.Request.Context(), provider)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		if errors.Is(err, service.ErrUnsupportedProvider) {
+			response.NotFound(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
 		return
 	}
 
+	// Binds the login to this browser, so a callback link started by someone else is refused
+	setOAuthStateCookie(c, state)
+
 	c.Redirect(http.StatusTemporaryRedirect, authURL)
 }
 
 func (h *authHandler) SocialCallback(c *gin.Context) {
 	provider := c.Param("provider")
+	// The provider redirects back with an error when the user declines consent
+	if providerErr := c.Query("error"); providerErr != "" {
+		response.BadRequest(c, "social login failed: "+providerErr)
+		return
+	}
+
 	code := c.Query("code")
 	if code == "" {
 		response.BadRequest(c, "code is required")
 		return
 	}
+	state := c.Query("state")
+	if state == "" {
+		response.BadRequest(c, "state is required")
+		return
+	}
+	if !oauthStateMatches(c, state) {
+		response.BadRequest(c, service.ErrInvalidOAuthState.Error())
+		return
+	}
+	clearOAuthStateCookie(c)
 
 	req := dto.LoginWithSocialRequest{
 		Provider: provider,
 		Code:     code,
+		State:    state,
 	}
 
-	resp, err := h.authUseCase.LoginWithSocial(c.Request.Context(), req)
+	resp, err := h.authUseCase.LoginWithSocial(c.Request.Context(), req, clientInfo(c))
 	if err != nil {
-		response.Unauthorized(c, err.Error())
+		switch {
+		case errors.Is(err, service.ErrInvalidOAuthState),
+			errors.Is(err, service.ErrUnsupportedProvider),
+			errors.Is(err, service.ErrSocialEmailMissing):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, auth.ErrSocialEmailNotVerified):
+			response.Unauthorized(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	if !resp.TwoFactorRequired {
+		setSessionCookie(c, resp)
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+func (h *authHandler) VerifyEmail(c *gin.Context) {
+	var req dto.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.authUseCase.VerifyEmail(c.Request.Context(), req.Token); err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+func (h *authHandler) ResendVerification(c *gin.Context) {
+	var req dto.EmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.authUseCase.ResendVerification(c.Request.Context(), req.Email); err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	// Same answer whether or not the address has an account
+	response.Success(c, http.StatusAccepted, gin.H{"message": "If the account exists and is unverified, a new link has been sent"})
+}
+
+func (h *authHandler) ForgotPassword(c *gin.Context) {
+	var req dto.EmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.authUseCase.ForgotPassword(c.Request.Context(), req.Email); err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	// Same answer whether or not the address has an account
+	response.Success(c, http.StatusAccepted, gin.H{"message": "If the account exists, a password reset link has been sent"})
+}
+
+func (h *authHandler) ResetPassword(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.authUseCase.ResetPassword(c.Request.Context(), req); err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+func (h *authHandler) ChangePassword(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+
+	var req dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.authUseCase.ChangePassword(c.Request.Context(), userID.(uuid.UUID), req, clientInfo(c))
+	if err != nil {
+		if errors.Is(err, auth.ErrIncorrectPassword) {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	// The old session was revoked along with the others; continue on the new one
+	setSessionCookie(c, resp)
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+func (h *authHandler) ListSessions(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+	sessionID, _ := c.Get("sessionID")
+	currentSession, _ := sessionID.(string)
+
+	sessions, err := h.authUseCase.ListSessions(c.Request.Context(), userID.(uuid.UUID), currentSession)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, sessions)
+}
+
+func (h *authHandler) RevokeSession(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+
+	target := c.Param("id")
+	if err := h.authUseCase.RevokeSession(c.Request.Context(), userID.(uuid.UUID), target); err != nil {
+		if errors.Is(err, auth.ErrSessionNotFound) {
+			response.NotFound(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	// Revoking the session in use is the same as logging out
+	if sessionID, _ := c.Get("sessionID"); sessionID == target {
+		clearSessionCookie(c)
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+func (h *authHandler) LogoutEverywhere(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+
+	if err := h.authUseCase.LogoutEverywhere(c.Request.Context(), userID.(uuid.UUID)); err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	clearSessionCookie(c)
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+func (h *authHandler) VerifyTwoFactorLogin(c *gin.Context) {
+	var req dto.TwoFactorLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.authUseCase.VerifyTwoFactorLogin(c.Request.Context(), req, clientInfo(c))
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, service.ErrInvalidTwoFactorCode) {
+			response.Unauthorized(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	setSessionCookie(c, resp)
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+func (h *authHandler) GetTwoFactorStatus(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+
+	status, err := h.authUseCase.GetTwoFactorStatus(c.Request.Context(), userID.(uuid.UUID))
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, status)
+}
+
+func (h *authHandler) SetupTwoFactor(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+
+	setup, err := h.authUseCase.SetupTwoFactor(c.Request.Context(), userID.(uuid.UUID))
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, setup)
+}
+
+func (h *authHandler) EnableTwoFactor(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+	sessionID, _ := c.Get("sessionID")
+	currentSession, _ := sessionID.(string)
+
+	var req dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
 		return
 	}
 
-	// Set session cookie
+	codes, err := h.authUseCase.EnableTwoFactor(c.Request.Context(), userID.(uuid.UUID), currentSession, req.Code)
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, codes)
+}
+
+func (h *authHandler) DisableTwoFactor(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+
+	var req dto.DisableTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.authUseCase.DisableTwoFactor(c.Request.Context(), userID.(uuid.UUID), req); err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+func (h *authHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+
+	var req dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	codes, err := h.authUseCase.RegenerateRecoveryCodes(c.Request.Context(), userID.(uuid.UUID), req.Code)
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, codes)
+}
+
+func (h *authHandler) CreateAPIToken(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+
+	var req dto.CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	token, err := h.authUseCase.CreateAPIToken(c.Request.Context(), userID.(uuid.UUID), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidAPITokenScope), errors.Is(err, service.ErrInvalidAPITokenExpiry):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, service.ErrAPITokenLimitReached):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, token)
+}
+
+func (h *authHandler) ListAPITokens(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+
+	tokens, err := h.authUseCase.ListAPITokens(c.Request.Context(), userID.(uuid.UUID))
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, tokens)
+}
+
+func (h *authHandler) RevokeAPIToken(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "not logged in")
+		return
+	}
+
+	tokenID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid token id")
+		return
+	}
+
+	if err := h.authUseCase.RevokeAPIToken(c.Request.Context(), userID.(uuid.UUID), tokenID); err != nil {
+		if errors.Is(err, service.ErrAPITokenNotFound) {
+			response.NotFound(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+// respondTwoFactorError maps two-factor enrollment errors to HTTP statuses
+func respondTwoFactorError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidTwoFactorCode),
+		errors.Is(err, auth.ErrIncorrectPassword):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, service.ErrTwoFactorUnavailable):
+		response.Error(c, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", err.Error())
+	case errors.Is(err, service.ErrTwoFactorAlreadyEnabled),
+		errors.Is(err, service.ErrTwoFactorNotEnabled),
+		errors.Is(err, service.ErrTwoFactorNotSetUp):
+		response.Conflict(c, err.Error())
+	default:
+		response.InternalServerError(c, err.Error())
+	}
+}
+
+func clientInfo(c *gin.Context) valueobject.ClientInfo {
+	return valueobject.ClientInfo{
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
+
+// setSessionCookie stores the session ID in a cookie that lives as long as the session
+func setSessionCookie(c *gin.Context, resp *dto.AuthResponse) {
+	maxAge := 3600 * 24
+	if resp.SessionExpiresAt != nil {
+		maxAge = int(time.Until(*resp.SessionExpiresAt).Seconds())
+	}
+
 	secure := gin.Mode() == gin.ReleaseMode
 	c.SetSameSite(http.SameSiteLaxMode)
-	c.SetCookie("session_id", resp.SessionID, 3600*24, "/", "", secure, true)
+	c.SetCookie("session_id", resp.SessionID, maxAge, "/", "", secure, true)
+}
 
-	response.Success(c, http.StatusOK, resp)
+func clearSessionCookie(c *gin.Context) {
+	secure := gin.Mode() == gin.ReleaseMode
+	c.SetCookie("session_id", "", -1, "/", "", secure, true)
+}
+
+const (
+	oauthStateCookie = "oauth_state"
+	// oauthStateCookieMaxAge matches the default lifetime of the state stored server-side
+	oauthStateCookieMaxAge = 10 * 60
+)
+
+// setOAuthStateCookie stores a hash of the login state in the browser. Lax lets the cookie
+// come back on the provider's top-level redirect to the callback.
+func setOAuthStateCookie(c *gin.Context, state string) {
+	secure := gin.Mode() == gin.ReleaseMode
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oauthStateCookie, hashOAuthState(state), oauthStateCookieMaxAge, "/", "", secure, true)
+}
+
+func clearOAuthStateCookie(c *gin.Context) {
+	secure := gin.Mode() == gin.ReleaseMode
+	c.SetCookie(oauthStateCookie, "", -1, "/", "", secure, true)
+}
+
+// oauthStateMatches reports whether the callback state is the one issued to this browser
+func oauthStateMatches(c *gin.Context, state string) bool {
+	cookie, err := c.Cookie(oauthStateCookie)
+	if err != nil || cookie == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookie), []byte(hashOAuthState(state))) == 1
+}
+
+func hashOAuthState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
 }
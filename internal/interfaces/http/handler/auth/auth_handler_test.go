@@ -2,18 +2,26 @@ package auth_test
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/aiagent/internal/application/dto"
+	authUseCase "github.com/aiagent/internal/application/usecase/auth"
 	"github.com/aiagent/internal/application/usecase/auth/mocks"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/domain/valueobject"
 	"github.com/aiagent/internal/interfaces/http/handler/auth"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -133,11 +141,15 @@ func TestAuthHandler_Login(t *testing.T) {
 		}
 
 		mockUseCase.EXPECT().
-			Login(gomock.Any(), reqBody).
-			Return(expectedResp, nil)
+			Login(gomock.Any(), reqBody, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ dto.LoginRequest, client valueobject.ClientInfo) (*dto.AuthResponse, error) {
+				assert.Equal(t, "test-agent/1.0", client.UserAgent)
+				return expectedResp, nil
+			})
 
 		req, _ := http.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(jsonBody))
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "test-agent/1.0")
 		r.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
@@ -167,7 +179,7 @@ func TestAuthHandler_Login(t *testing.T) {
 		jsonBody, _ := json.Marshal(reqBody)
 
 		mockUseCase.EXPECT().
-			Login(gomock.Any(), reqBody).
+			Login(gomock.Any(), reqBody, gomock.Any()).
 			Return(nil, errors.New("invalid credentials"))
 
 		req, _ := http.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(jsonBody))
@@ -235,13 +247,21 @@ func TestAuthHandler_SocialLogin(t *testing.T) {
 
 		mockUseCase.EXPECT().
 			GetSocialAuthURL(gomock.Any(), provider).
-			Return(authURL, nil)
+			Return(authURL, "state-123", nil)
 
 		req, _ := http.NewRequest(http.MethodGet, "/auth/"+provider, nil)
 		r.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusTemporaryRedirect, w.Code)
 		assert.Equal(t, authURL, w.Header().Get("Location"))
+
+		// The browser gets a hash of the state, never the state itself
+		cookies := w.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, "oauth_state", cookies[0].Name)
+		assert.Equal(t, stateHash("state-123"), cookies[0].Value)
+		assert.True(t, cookies[0].HttpOnly)
+		assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
 	})
 }
 
@@ -269,22 +289,334 @@ func TestAuthHandler_SocialCallback(t *testing.T) {
 			LoginWithSocial(gomock.Any(), dto.LoginWithSocialRequest{
 				Provider: provider,
 				Code:     code,
-			}).
+				State:    "state-123",
+			}, gomock.Any()).
 			Return(expectedResp, nil)
 
-		req, _ := http.NewRequest(http.MethodGet, "/auth/"+provider+"/callback?code="+code, nil)
+		req, _ := http.NewRequest(http.MethodGet, "/auth/"+provider+"/callback?code="+code+"&state=state-123", nil)
+		req.AddCookie(&http.Cookie{Name: "oauth_state", Value: stateHash("state-123")})
 		r.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
 
-		// Check for cookie
+		// Check for cookies: the session is set and the used state cleared
 		cookies := w.Header().Values("Set-Cookie")
-		assert.NotEmpty(t, cookies)
-		assert.Contains(t, cookies[0], "session_id=session-123")
-		assert.Contains(t, cookies[0], "HttpOnly")
+		require.Len(t, cookies, 2)
+		assert.Contains(t, cookies[0], "oauth_state=;")
+		assert.Contains(t, cookies[1], "session_id=session-123")
+		assert.Contains(t, cookies[1], "HttpOnly")
 
 		var response map[string]interface{}
 		json.Unmarshal(w.Body.Bytes(), &response)
 		assert.Equal(t, true, response["success"])
 	})
+
+	t.Run("missing_state", func(t *testing.T) {
+		r, w := setupRouter()
+		r.GET("/auth/:provider/callback", handler.SocialCallback)
+
+		req, _ := http.NewRequest(http.MethodGet, "/auth/google/callback?code=auth-code", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("state_from_another_browser", func(t *testing.T) {
+		r, w := setupRouter()
+		r.GET("/auth/:provider/callback", handler.SocialCallback)
+
+		// The victim's browser carries the cookie of its own login attempt, or none at all
+		req, _ := http.NewRequest(http.MethodGet, "/auth/google/callback?code=attacker-code&state=attacker-state", nil)
+		req.AddCookie(&http.Cookie{Name: "oauth_state", Value: stateHash("victim-state")})
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		r, w = setupRouter()
+		r.GET("/auth/:provider/callback", handler.SocialCallback)
+
+		req, _ = http.NewRequest(http.MethodGet, "/auth/google/callback?code=attacker-code&state=attacker-state", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("error_status", func(t *testing.T) {
+		cases := []struct {
+			err    error
+			status int
+		}{
+			{service.ErrInvalidOAuthState, http.StatusBadRequest},
+			{service.ErrUnsupportedProvider, http.StatusBadRequest},
+			{authUseCase.ErrSocialEmailNotVerified, http.StatusUnauthorized},
+			{errors.New("redis: connection refused"), http.StatusInternalServerError},
+		}
+		for _, tc := range cases {
+			r, w := setupRouter()
+			r.GET("/auth/:provider/callback", handler.SocialCallback)
+
+			mockUseCase.EXPECT().LoginWithSocial(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, tc.err)
+
+			req, _ := http.NewRequest(http.MethodGet, "/auth/google/callback?code=auth-code&state=state-123", nil)
+			req.AddCookie(&http.Cookie{Name: "oauth_state", Value: stateHash("state-123")})
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.status, w.Code, tc.err.Error())
+		}
+	})
+
+	t.Run("consent_denied", func(t *testing.T) {
+		r, w := setupRouter()
+		r.GET("/auth/:provider/callback", handler.SocialCallback)
+
+		req, _ := http.NewRequest(http.MethodGet, "/auth/google/callback?error=access_denied&state=state-123", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAuthHandler_ResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockAuthUseCase(ctrl)
+	handler := auth.NewAuthHandler(mockUseCase)
+
+	t.Run("expired_token", func(t *testing.T) {
+		r, w := setupRouter()
+		r.POST("/auth/reset-password", handler.ResetPassword)
+
+		reqBody := dto.ResetPasswordRequest{Token: "expired", NewPassword: "new-password-123"}
+		mockUseCase.EXPECT().ResetPassword(gomock.Any(), reqBody).Return(authUseCase.ErrInvalidToken)
+
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest(http.MethodPost, "/auth/reset-password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("short_password", func(t *testing.T) {
+		r, w := setupRouter()
+		r.POST("/auth/reset-password", handler.ResetPassword)
+
+		body, _ := json.Marshal(dto.ResetPasswordRequest{Token: "token", NewPassword: "short"})
+		req, _ := http.NewRequest(http.MethodPost, "/auth/reset-password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAuthHandler_ChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockAuthUseCase(ctrl)
+	handler := auth.NewAuthHandler(mockUseCase)
+	userID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		r, w := setupRouter()
+		r.POST("/auth/change-password", func(c *gin.Context) {
+			c.Set("userID", userID)
+			c.Set("sessionID", "session-123")
+			handler.ChangePassword(c)
+		})
+
+		reqBody := dto.ChangePasswordRequest{CurrentPassword: "old-password", NewPassword: "new-password-123"}
+		expiresAt := time.Now().Add(24 * time.Hour)
+		mockUseCase.EXPECT().ChangePassword(gomock.Any(), userID, reqBody, gomock.Any()).
+			Return(&dto.AuthResponse{SessionID: "session-456", SessionExpiresAt: &expiresAt, UserID: userID}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest(http.MethodPost, "/auth/change-password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		// The client moves to the rotated session
+		assert.Contains(t, w.Header().Get("Set-Cookie"), "session_id=session-456")
+	})
+
+	t.Run("not_logged_in", func(t *testing.T) {
+		r, w := setupRouter()
+		r.POST("/auth/change-password", handler.ChangePassword)
+
+		req, _ := http.NewRequest(http.MethodPost, "/auth/change-password", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestAuthHandler_ListSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockAuthUseCase(ctrl)
+	handler := auth.NewAuthHandler(mockUseCase)
+	userID := uuid.New()
+
+	r, w := setupRouter()
+	r.GET("/auth/sessions", func(c *gin.Context) {
+		c.Set("userID", userID)
+		c.Set("sessionID", "session-123")
+		handler.ListSessions(c)
+	})
+
+	mockUseCase.EXPECT().ListSessions(gomock.Any(), userID, "session-123").Return([]dto.SessionResponse{
+		{ID: "session-123", Device: "Chrome on Windows", Current: true},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/auth/sessions", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	data := response["data"].([]interface{})
+	assert.Len(t, data, 1)
+	assert.Equal(t, true, data[0].(map[string]interface{})["current"])
+}
+
+func TestAuthHandler_RevokeSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockAuthUseCase(ctrl)
+	handler := auth.NewAuthHandler(mockUseCase)
+	userID := uuid.New()
+
+	newRouter := func() (*gin.Engine, *httptest.ResponseRecorder) {
+		r, w := setupRouter()
+		r.DELETE("/auth/sessions/:id", func(c *gin.Context) {
+			c.Set("userID", userID)
+			c.Set("sessionID", "session-123")
+			handler.RevokeSession(c)
+		})
+		return r, w
+	}
+
+	t.Run("other_device", func(t *testing.T) {
+		r, w := newRouter()
+		mockUseCase.EXPECT().RevokeSession(gomock.Any(), userID, "session-456").Return(nil)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/auth/sessions/session-456", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Set-Cookie"))
+	})
+
+	t.Run("current_device_clears_cookie", func(t *testing.T) {
+		r, w := newRouter()
+		mockUseCase.EXPECT().RevokeSession(gomock.Any(), userID, "session-123").Return(nil)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/auth/sessions/session-123", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Set-Cookie"), "Max-Age=0")
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		r, w := newRouter()
+		mockUseCase.EXPECT().RevokeSession(gomock.Any(), userID, "unknown").Return(authUseCase.ErrSessionNotFound)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/auth/sessions/unknown", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAuthHandler_LogoutEverywhere(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockAuthUseCase(ctrl)
+	handler := auth.NewAuthHandler(mockUseCase)
+	userID := uuid.New()
+
+	r, w := setupRouter()
+	r.DELETE("/auth/sessions", func(c *gin.Context) {
+		c.Set("userID", userID)
+		handler.LogoutEverywhere(c)
+	})
+
+	mockUseCase.EXPECT().LogoutEverywhere(gomock.Any(), userID).Return(nil)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/auth/sessions", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Set-Cookie"), "Max-Age=0")
+}
+
+func TestAuthHandler_TwoFactorLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := mocks.NewMockAuthUseCase(ctrl)
+	handler := auth.NewAuthHandler(mockUseCase)
+
+	t.Run("password_step_sets_no_cookie", func(t *testing.T) {
+		r, w := setupRouter()
+		r.POST("/login", handler.Login)
+
+		reqBody := dto.LoginRequest{Email: "test@example.com", Password: "password123"}
+		mockUseCase.EXPECT().Login(gomock.Any(), reqBody, gomock.Any()).
+			Return(&dto.AuthResponse{TwoFactorRequired: true, ChallengeToken: "challenge-1"}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Set-Cookie"))
+		assert.Contains(t, w.Body.String(), `"challengeToken":"challenge-1"`)
+	})
+
+	t.Run("second_step_sets_cookie", func(t *testing.T) {
+		r, w := setupRouter()
+		r.POST("/login/2fa", handler.VerifyTwoFactorLogin)
+
+		reqBody := dto.TwoFactorLoginRequest{ChallengeToken: "challenge-1", Code: "123456"}
+		mockUseCase.EXPECT().VerifyTwoFactorLogin(gomock.Any(), reqBody, gomock.Any()).
+			Return(&dto.AuthResponse{SessionID: "session-123"}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest(http.MethodPost, "/login/2fa", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Set-Cookie"), "session_id=session-123")
+	})
+
+	t.Run("wrong_code", func(t *testing.T) {
+		r, w := setupRouter()
+		r.POST("/login/2fa", handler.VerifyTwoFactorLogin)
+
+		reqBody := dto.TwoFactorLoginRequest{ChallengeToken: "challenge-1", Code: "000000"}
+		mockUseCase.EXPECT().VerifyTwoFactorLogin(gomock.Any(), reqBody, gomock.Any()).
+			Return(nil, service.ErrInvalidTwoFactorCode)
+
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest(http.MethodPost, "/login/2fa", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func stateHash(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
 }
@@ -67,6 +67,7 @@ func (h *blogHandler) Create(c *gin.Context) {
 // @Produce json
 // @Param id path string true "Blog ID"
 // @Success 200 {object} dto.BlogResponse
+// @Failure 403 {object} response.Response
 // @Failure 404 {object} response.Response
 // @Router /api/v1/blogs/{id} [get]
 func (h *blogHandler) GetByID(c *gin.Context) {
@@ -89,7 +90,7 @@ func (h *blogHandler) GetByID(c *gin.Context) {
 			response.NotFound(c, err.Error())
 			return
 		}
-		if err == blogUsecase.ErrBlogAccessDenied {
+		if err == blogUsecase.ErrBlogAccessDenied || err == blogUsecase.ErrSeriesPurchaseRequired {
 			response.Forbidden(c, err.Error())
 			return
 		}
@@ -110,7 +111,8 @@ func (h *blogHandler) GetByID(c *gin.Context) {
 // @Param categoryId query string false "Filter by category ID"
 // @Param status query string false "Filter by status (draft, published)"
 // @Param visibility query string false "Filter by visibility (public, subscribers_only)"
-// @Param search query string false "Search in title or content"
+// @Param tagIds query []string false "Filter by tag IDs (any of them)"
+// @Param search query string false "Full-text search in title, excerpt and content"
 // @Param page query int false "Page number" default(1)
 // @Param pageSize query int false "Page size" default(10)
 // @Success 200 {object} response.Response
@@ -151,6 +153,50 @@ func (h *blogHandler) List(c *gin.Context) {
 	})
 }
 
+// Timeline godoc
+// @Summary List published blogs by cursor
+// @Description Pages through the published blogs the viewer may see, newest first. Pass the nextCursor of a page to get the next one; it is omitted on the last page.
+// @Tags Blogs
+// @Produce json
+// @Param authorId query string false "Filter by author ID"
+// @Param categoryId query string false "Filter by category ID"
+// @Param tagIds query []string false "Filter by tag IDs (any of them)"
+// @Param search query string false "Full-text search in title, excerpt and content"
+// @Param cursor query string false "nextCursor of the previous page"
+// @Param limit query int false "Page size" default(10)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Router /api/v1/blogs/timeline [get]
+func (h *blogHandler) Timeline(c *gin.Context) {
+	var params dto.BlogTimelineParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	// Get viewer ID if authenticated
+	var viewerID *uuid.UUID
+	if userID, exists := c.Get("userID"); exists {
+		uid := userID.(uuid.UUID)
+		viewerID = &uid
+	}
+
+	page, err := h.blogUseCase.Timeline(c.Request.Context(), &params, viewerID)
+	if err != nil {
+		if err == blogUsecase.ErrInvalidBlogCursor {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.SuccessWithMeta(c, page.Data, &response.Meta{
+		PageSize:   params.Limit,
+		NextCursor: page.NextCursor,
+	})
+}
+
 // Update godoc
 // @Summary Update a blog
 // @Description Update an existing blog (author only)
@@ -251,6 +297,7 @@ func (h *blogHandler) Delete(c *gin.Context) {
 // @Param id path string true "Blog ID"
 // @Param request body dto.PublishBlogRequest true "Visibility setting"
 // @Success 200 {object} dto.BlogResponse
+// @Failure 400 {object} response.Response
 // @Failure 403 {object} response.Response
 // @Failure 404 {object} response.Response
 // @Security Bearer
@@ -281,6 +328,8 @@ func (h *blogHandler) Publish(c *gin.Context) {
 			response.NotFound(c, err.Error())
 		case blogUsecase.ErrBlogAccessDenied:
 			response.Forbidden(c, err.Error())
+		case blogUsecase.ErrInvalidPublishTime, blogUsecase.ErrInvalidTimezone:
+			response.BadRequest(c, err.Error())
 		default:
 			response.InternalServerError(c, err.Error())
 		}
@@ -331,6 +380,123 @@ func (h *blogHandler) Unpublish(c *gin.Context) {
 	response.Success(c, http.StatusOK, blog)
 }
 
+// ListScheduled godoc
+// @Summary List scheduled blogs
+// @Description List the current author's blogs waiting for their publish time, soonest first
+// @Tags Blogs
+// @Produce json
+// @Success 200 {array} dto.ScheduledBlogResponse
+// @Failure 401 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/blogs/scheduled [get]
+func (h *blogHandler) ListScheduled(c *gin.Context) {
+	authorID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return
+	}
+
+	blogs, err := h.blogUseCase.ListScheduled(c.Request.Context(), authorID.(uuid.UUID))
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, blogs)
+}
+
+// Reschedule godoc
+// @Summary Reschedule a blog
+// @Description Move a scheduled blog to another future publish time. The time is RFC 3339, or a wall-clock time read in the given IANA timezone.
+// @Tags Blogs
+// @Accept json
+// @Produce json
+// @Param id path string true "Blog ID"
+// @Param request body dto.ScheduleBlogRequest true "New publish time"
+// @Success 200 {object} dto.BlogResponse
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/blogs/{id}/schedule [put]
+func (h *blogHandler) Reschedule(c *gin.Context) {
+	authorID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid blog ID")
+		return
+	}
+
+	var req dto.ScheduleBlogRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	blog, err := h.blogUseCase.Reschedule(c.Request.Context(), id, authorID.(uuid.UUID), &req)
+	if err != nil {
+		h.respondScheduleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, blog)
+}
+
+// CancelSchedule godoc
+// @Summary Cancel a scheduled blog
+// @Description Turn a scheduled blog back into a draft before its publish time
+// @Tags Blogs
+// @Produce json
+// @Param id path string true "Blog ID"
+// @Success 200 {object} dto.BlogResponse
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/blogs/{id}/schedule [delete]
+func (h *blogHandler) CancelSchedule(c *gin.Context) {
+	authorID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid blog ID")
+		return
+	}
+
+	blog, err := h.blogUseCase.CancelSchedule(c.Request.Context(), id, authorID.(uuid.UUID))
+	if err != nil {
+		h.respondScheduleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, blog)
+}
+
+func (h *blogHandler) respondScheduleError(c *gin.Context, err error) {
+	switch err {
+	case blogUsecase.ErrBlogNotFound:
+		response.NotFound(c, err.Error())
+	case blogUsecase.ErrBlogAccessDenied:
+		response.Forbidden(c, err.Error())
+	case blogUsecase.ErrBlogNotScheduled:
+		response.Conflict(c, err.Error())
+	case blogUsecase.ErrInvalidPublishTime, blogUsecase.ErrInvalidTimezone:
+		response.BadRequest(c, err.Error())
+	default:
+		response.InternalServerError(c, err.Error())
+	}
+}
+
 // React godoc
 // @Summary React to a blog
 // @Description Upvote, downvote, or remove reaction from a blog
@@ -368,7 +534,7 @@ func (h *blogHandler) React(c *gin.Context) {
 		switch err {
 		case blogUsecase.ErrBlogNotFound:
 			response.NotFound(c, err.Error())
-		case blogUsecase.ErrBlogAccessDenied:
+		case blogUsecase.ErrBlogAccessDenied, blogUsecase.ErrSeriesPurchaseRequired:
 			response.Forbidden(c, err.Error())
 		default:
 			response.InternalServerError(c, err.Error())
@@ -8,9 +8,13 @@ type BlogHandler interface {
 	Create(c *gin.Context)
 	GetByID(c *gin.Context)
 	List(c *gin.Context)
+	Timeline(c *gin.Context)
 	Update(c *gin.Context)
 	Delete(c *gin.Context)
 	Publish(c *gin.Context)
 	Unpublish(c *gin.Context)
+	ListScheduled(c *gin.Context)
+	Reschedule(c *gin.Context)
+	CancelSchedule(c *gin.Context)
 	React(c *gin.Context)
 }
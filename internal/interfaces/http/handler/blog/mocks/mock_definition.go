@@ -40,6 +40,18 @@ func (m *MockBlogHandler) EXPECT() *MockBlogHandlerMockRecorder {
 	return m.recorder
 }
 
+// CancelSchedule mocks base method.
+func (m *MockBlogHandler) CancelSchedule(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CancelSchedule", c)
+}
+
+// CancelSchedule indicates an expected call of CancelSchedule.
+func (mr *MockBlogHandlerMockRecorder) CancelSchedule(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSchedule", reflect.TypeOf((*MockBlogHandler)(nil).CancelSchedule), c)
+}
+
 // Create mocks base method.
 func (m *MockBlogHandler) Create(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -88,6 +100,18 @@ func (mr *MockBlogHandlerMockRecorder) List(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBlogHandler)(nil).List), c)
 }
 
+// ListScheduled mocks base method.
+func (m *MockBlogHandler) ListScheduled(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListScheduled", c)
+}
+
+// ListScheduled indicates an expected call of ListScheduled.
+func (mr *MockBlogHandlerMockRecorder) ListScheduled(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduled", reflect.TypeOf((*MockBlogHandler)(nil).ListScheduled), c)
+}
+
 // Publish mocks base method.
 func (m *MockBlogHandler) Publish(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -112,6 +136,30 @@ func (mr *MockBlogHandlerMockRecorder) React(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "React", reflect.TypeOf((*MockBlogHandler)(nil).React), c)
 }
 
+// Reschedule mocks base method.
+func (m *MockBlogHandler) Reschedule(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Reschedule", c)
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockBlogHandlerMockRecorder) Reschedule(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockBlogHandler)(nil).Reschedule), c)
+}
+
+// Timeline mocks base method.
+func (m *MockBlogHandler) Timeline(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Timeline", c)
+}
+
+// Timeline indicates an expected call of Timeline.
+func (mr *MockBlogHandlerMockRecorder) Timeline(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeline", reflect.TypeOf((*MockBlogHandler)(nil).Timeline), c)
+}
+
 // Unpublish mocks base method.
 func (m *MockBlogHandler) Unpublish(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -11,6 +11,8 @@ type FraudHandler interface {
 	BanUser(c *gin.Context)
 	GetFraudTrends(c *gin.Context)
 	TriggerBatchAnalysis(c *gin.Context)
+	ListBatchJobs(c *gin.Context)
+	CancelBatchJob(c *gin.Context)
 	GetUserBadgeStatus(c *gin.Context)
 	GetUserBotNotifications(c *gin.Context)
 	MarkNotificationAsRead(c *gin.Context)
@@ -1,6 +1,7 @@
 package fraud
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/aiagent/internal/application/dto"
@@ -293,6 +294,71 @@ func (h *fraudHandler) TriggerBatchAnalysis(c *gin.Context) {
 		return
 	}
 
+	response.Success(c, http.StatusOK, toBatchAnalyzeResponse(result))
+}
+
+// ListBatchJobs handles GET /api/admin/batch-jobs
+func (h *fraudHandler) ListBatchJobs(c *gin.Context) {
+	var req dto.BatchJobListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	filter := valueobject.BatchJobFilter{
+		Status:   req.Status,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	result, err := h.service.ListBatchJobs(c.Request.Context(), filter)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	jobs := make([]dto.BatchAnalyzeResponse, 0, len(result.Jobs))
+	for i := range result.Jobs {
+		jobs = append(jobs, *toBatchAnalyzeResponse(&result.Jobs[i]))
+	}
+
+	resp := &dto.BatchJobListResponse{
+		Jobs:       jobs,
+		TotalCount: result.TotalCount,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages,
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// CancelBatchJob handles POST /api/admin/batch-jobs/:id/cancel
+func (h *fraudHandler) CancelBatchJob(c *gin.Context) {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid job ID")
+		return
+	}
+
+	result, err := h.service.CancelBatchJob(c.Request.Context(), jobID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBatchJobNotFound):
+			response.NotFound(c, err.Error())
+		case errors.Is(err, service.ErrBatchJobNotCancellable):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, toBatchAnalyzeResponse(result))
+}
+
+// toBatchAnalyzeResponse maps a batch job result to its response, omitting unset dates
+func toBatchAnalyzeResponse(result *valueobject.BatchAnalyzeResult) *dto.BatchAnalyzeResponse {
 	resp := &dto.BatchAnalyzeResponse{
 		JobID:              result.JobID,
 		Status:             result.Status,
@@ -301,10 +367,16 @@ func (h *fraudHandler) TriggerBatchAnalysis(c *gin.Context) {
 		ProcessedFollowers: result.ProcessedFollowers,
 		NewSignalsDetected: result.NewSignalsDetected,
 		UsersScored:        result.UsersScored,
+		Errors:             result.Errors,
 		Message:            result.Message,
 	}
-
-	response.Success(c, http.StatusOK, resp)
+	if !result.DateFrom.IsZero() {
+		resp.DateFrom = &result.DateFrom
+	}
+	if !result.DateTo.IsZero() {
+		resp.DateTo = &result.DateTo
+	}
+	return resp
 }
 
 // GetUserBadgeStatus handles GET /api/users/:id/badge
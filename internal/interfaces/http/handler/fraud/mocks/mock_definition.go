@@ -52,6 +52,18 @@ func (mr *MockFraudHandlerMockRecorder) BanUser(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockFraudHandler)(nil).BanUser), c)
 }
 
+// CancelBatchJob mocks base method.
+func (m *MockFraudHandler) CancelBatchJob(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CancelBatchJob", c)
+}
+
+// CancelBatchJob indicates an expected call of CancelBatchJob.
+func (mr *MockFraudHandlerMockRecorder) CancelBatchJob(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBatchJob", reflect.TypeOf((*MockFraudHandler)(nil).CancelBatchJob), c)
+}
+
 // GetFraudDashboard mocks base method.
 func (m *MockFraudHandler) GetFraudDashboard(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -112,6 +124,18 @@ func (mr *MockFraudHandlerMockRecorder) GetUserRiskScore(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRiskScore", reflect.TypeOf((*MockFraudHandler)(nil).GetUserRiskScore), c)
 }
 
+// ListBatchJobs mocks base method.
+func (m *MockFraudHandler) ListBatchJobs(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListBatchJobs", c)
+}
+
+// ListBatchJobs indicates an expected call of ListBatchJobs.
+func (mr *MockFraudHandlerMockRecorder) ListBatchJobs(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchJobs", reflect.TypeOf((*MockFraudHandler)(nil).ListBatchJobs), c)
+}
+
 // MarkNotificationAsRead mocks base method.
 func (m *MockFraudHandler) MarkNotificationAsRead(c *gin.Context) {
 	m.ctrl.T.Helper()
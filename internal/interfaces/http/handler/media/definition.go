@@ -0,0 +1,17 @@
+package media
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import "github.com/gin-gonic/gin"
+
+// MediaHandler defines the interface for media upload HTTP handlers
+type MediaHandler interface {
+	// Upload handles POST /api/v1/media
+	Upload(c *gin.Context)
+
+	// List handles GET /api/v1/media
+	List(c *gin.Context)
+
+	// Delete handles DELETE /api/v1/media/:id
+	Delete(c *gin.Context)
+}
@@ -0,0 +1,155 @@
+package media
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/application/usecase/media"
+	"github.com/aiagent/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type mediaHandler struct {
+	mediaUseCase media.MediaUseCase
+}
+
+// NewMediaHandler creates a new MediaHandler instance
+func NewMediaHandler(mediaUseCase media.MediaUseCase) MediaHandler {
+	return &mediaHandler{
+		mediaUseCase: mediaUseCase,
+	}
+}
+
+// Upload godoc
+// @Summary Upload an image
+// @Description Uploads a blog thumbnail or an image embedded in blog content. The image type is checked from its content, metadata is stripped and resized copies are generated; the stored bytes count against the user's quota.
+// @Tags Media
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param file formData file true "Image file (jpg/png/gif/webp)"
+// @Param purpose formData string true "blog_thumbnail or blog_inline"
+// @Success 201 {object} response.Response{data=dto.MediaResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/v1/media [post]
+func (h *mediaHandler) Upload(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UploadMediaRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		response.BadRequest(c, "File is required")
+		return
+	}
+
+	resp, err := h.mediaUseCase.Upload(c.Request.Context(), userID, req, file)
+	if err != nil {
+		switch {
+		case errors.Is(err, media.ErrUnsupportedMedia), errors.Is(err, media.ErrMediaTooLarge):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, media.ErrMediaQuotaExceeded):
+			response.Forbidden(c, err.Error())
+		default:
+			response.InternalServerError(c, "Failed to upload media")
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, resp)
+}
+
+// List godoc
+// @Summary List my media
+// @Description Lists the images uploaded by the signed-in user, most recent first, with their storage usage
+// @Tags Media
+// @Produce json
+// @Security Bearer
+// @Param purpose query string false "avatar, blog_thumbnail or blog_inline"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.Response{data=dto.MediaListResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/v1/media [get]
+func (h *mediaHandler) List(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.MediaListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.mediaUseCase.List(c.Request.Context(), userID, req)
+	if err != nil {
+		response.InternalServerError(c, "Failed to list media")
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// Delete godoc
+// @Summary Delete an image
+// @Description Deletes an image of the signed-in user with its resized copies and frees its storage
+// @Tags Media
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Media ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/v1/media/{id} [delete]
+func (h *mediaHandler) Delete(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid media ID")
+		return
+	}
+
+	if err := h.mediaUseCase.Delete(c.Request.Context(), userID, id); err != nil {
+		if errors.Is(err, media.ErrMediaNotFound) {
+			response.NotFound(c, "Media not found")
+			return
+		}
+		response.InternalServerError(c, "Failed to delete media")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func currentUserID(c *gin.Context) (uuid.UUID, bool) {
+	userIDVal, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "Authentication required")
+		return uuid.Nil, false
+	}
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok {
+		response.Unauthorized(c, "Invalid user ID")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
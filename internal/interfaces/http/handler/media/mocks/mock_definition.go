@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: definition.go
+//
+// Generated by this command:
+//
+//	mockgen -source=definition.go -destination=mocks/mock_definition.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMediaHandler is a mock of MediaHandler interface.
+type MockMediaHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockMediaHandlerMockRecorder is the mock recorder for MockMediaHandler.
+type MockMediaHandlerMockRecorder struct {
+	mock *MockMediaHandler
+}
+
+// NewMockMediaHandler creates a new mock instance.
+func NewMockMediaHandler(ctrl *gomock.Controller) *MockMediaHandler {
+	mock := &MockMediaHandler{ctrl: ctrl}
+	mock.recorder = &MockMediaHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaHandler) EXPECT() *MockMediaHandlerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockMediaHandler) Delete(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", c)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMediaHandlerMockRecorder) Delete(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMediaHandler)(nil).Delete), c)
+}
+
+// List mocks base method.
+func (m *MockMediaHandler) List(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "List", c)
+}
+
+// List indicates an expected call of List.
+func (mr *MockMediaHandlerMockRecorder) List(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMediaHandler)(nil).List), c)
+}
+
+// Upload mocks base method.
+func (m *MockMediaHandler) Upload(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Upload", c)
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockMediaHandlerMockRecorder) Upload(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockMediaHandler)(nil).Upload), c)
+}
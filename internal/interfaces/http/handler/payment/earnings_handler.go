@@ -0,0 +1,192 @@
+package payment
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/application/usecase/payment"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// EarningsHandler handles the signed-in author's balance, earnings and payout requests
+type EarningsHandler interface {
+	GetBalance(c *gin.Context)
+	GetEarnings(c *gin.Context)
+	RequestPayout(c *gin.Context)
+	ListPayouts(c *gin.Context)
+}
+
+type earningsHandler struct {
+	earningsUseCase payment.EarningsUseCase
+}
+
+// NewEarningsHandler creates a new EarningsHandler instance
+func NewEarningsHandler(earningsUseCase payment.EarningsUseCase) EarningsHandler {
+	return &earningsHandler{
+		earningsUseCase: earningsUseCase,
+	}
+}
+
+// GetBalance handles GET /api/v1/authors/me/balance
+// @Summary Get author balance
+// @Description Returns what the platform owes the signed-in author and the payout waiting for review
+// @Tags Earnings
+// @Produce json
+// @Param currency query string false "Currency code (default VND)"
+// @Success 200 {object} dto.BalanceResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/authors/me/balance [get]
+func (h *earningsHandler) GetBalance(c *gin.Context) {
+	var req dto.BalanceRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	authorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.earningsUseCase.Balance(c.Request.Context(), authorID, req)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// GetEarnings handles GET /api/v1/authors/me/earnings
+// @Summary Get author earnings
+// @Description Returns the signed-in author's earnings less refunds, broken down by subscription tier, series and donations
+// @Tags Earnings
+// @Produce json
+// @Param currency query string false "Currency code (default VND)"
+// @Param from query string false "First day (YYYY-MM-DD, UTC)"
+// @Param to query string false "Last day (YYYY-MM-DD, UTC)"
+// @Success 200 {object} dto.EarningsResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/authors/me/earnings [get]
+func (h *earningsHandler) GetEarnings(c *gin.Context) {
+	var req dto.EarningsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+	if req.From != "" && req.To != "" && req.To < req.From {
+		response.BadRequest(c, "to must not be before from")
+		return
+	}
+
+	authorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.earningsUseCase.Earnings(c.Request.Context(), authorID, req)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// RequestPayout handles POST /api/v1/authors/me/payouts
+// @Summary Request a payout
+// @Description Asks for part of the balance to be sent to a bank account; one request can wait for review at a time
+// @Tags Earnings
+// @Accept json
+// @Produce json
+// @Param body body dto.CreatePayoutRequest true "Payout"
+// @Success 201 {object} dto.PayoutResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/authors/me/payouts [post]
+func (h *earningsHandler) RequestPayout(c *gin.Context) {
+	var req dto.CreatePayoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	authorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.earningsUseCase.RequestPayout(c.Request.Context(), authorID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidPayoutAmount), errors.Is(err, service.ErrInsufficientBalance):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, service.ErrPayoutPending):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, resp)
+}
+
+// ListPayouts handles GET /api/v1/authors/me/payouts
+// @Summary List my payouts
+// @Description Lists the signed-in author's payout requests, most recent first
+// @Tags Earnings
+// @Produce json
+// @Param status query string false "Status (pending, approved, rejected)"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} dto.PayoutListResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/authors/me/payouts [get]
+func (h *earningsHandler) ListPayouts(c *gin.Context) {
+	var req dto.PayoutListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	authorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.earningsUseCase.ListPayouts(c.Request.Context(), &authorID, req)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// currentUserID returns the signed-in user, answering 401 when there is none
+func currentUserID(c *gin.Context) (uuid.UUID, bool) {
+	userIDVal, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return uuid.Nil, false
+	}
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok {
+		response.Unauthorized(c, "invalid user ID")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
@@ -0,0 +1,142 @@
+package payment_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/interfaces/http/handler/payment"
+	"github.com/aiagent/internal/interfaces/http/handler/payment/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupEarningsTest(t *testing.T) (*gomock.Controller, *mocks.MockEarningsUseCase, *gin.Engine, uuid.UUID) {
+	ctrl := gomock.NewController(t)
+	mockUC := mocks.NewMockEarningsUseCase(ctrl)
+	h := payment.NewEarningsHandler(mockUC)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	authorID := uuid.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("userID", authorID)
+		c.Next()
+	})
+	r.GET("/api/v1/authors/me/balance", h.GetBalance)
+	r.GET("/api/v1/authors/me/earnings", h.GetEarnings)
+	r.GET("/api/v1/authors/me/payouts", h.ListPayouts)
+	r.POST("/api/v1/authors/me/payouts", h.RequestPayout)
+
+	return ctrl, mockUC, r, authorID
+}
+
+func TestEarningsHandler_GetBalance(t *testing.T) {
+	ctrl, mockUC, r, authorID := setupEarningsTest(t)
+	defer ctrl.Finish()
+
+	mockUC.EXPECT().Balance(gomock.Any(), authorID, dto.BalanceRequest{}).Return(&dto.BalanceResponse{Balance: decimal.NewFromInt(80000), Currency: "VND"}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/authors/me/balance", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestEarningsHandler_GetEarnings(t *testing.T) {
+	ctrl, mockUC, r, authorID := setupEarningsTest(t)
+	defer ctrl.Finish()
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/authors/me/earnings"+query, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("period", func(t *testing.T) {
+		mockUC.EXPECT().Earnings(gomock.Any(), authorID, dto.EarningsRequest{From: "2026-10-01", To: "2026-10-31"}).
+			Return(&dto.EarningsResponse{Breakdown: []dto.EarningsLineResponse{}}, nil)
+
+		assert.Equal(t, http.StatusOK, get("?from=2026-10-01&to=2026-10-31").Code)
+	})
+
+	t.Run("invalid_day", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, get("?from=01/10/2026").Code)
+	})
+
+	t.Run("reversed_period", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, get("?from=2026-10-31&to=2026-10-01").Code)
+	})
+}
+
+func TestEarningsHandler_RequestPayout(t *testing.T) {
+	ctrl, mockUC, r, authorID := setupEarningsTest(t)
+	defer ctrl.Finish()
+
+	post := func(body interface{}) *httptest.ResponseRecorder {
+		raw, _ := json.Marshal(body)
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/authors/me/payouts", bytes.NewBuffer(raw))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	valid := dto.CreatePayoutRequest{
+		Amount:      decimal.NewFromInt(150000),
+		BankName:    "MBBank",
+		AccountNo:   "0123456789",
+		AccountName: "NGUYEN VAN A",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockUC.EXPECT().RequestPayout(gomock.Any(), authorID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, req dto.CreatePayoutRequest) (*dto.PayoutResponse, error) {
+				assert.True(t, valid.Amount.Equal(req.Amount))
+				return &dto.PayoutResponse{AuthorID: authorID, Amount: req.Amount, Status: entity.PayoutStatusPending}, nil
+			})
+
+		assert.Equal(t, http.StatusCreated, post(valid).Code)
+	})
+
+	t.Run("missing_bank_account", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, post(dto.CreatePayoutRequest{Amount: valid.Amount, BankName: "MBBank"}).Code)
+	})
+
+	t.Run("insufficient_balance", func(t *testing.T) {
+		mockUC.EXPECT().RequestPayout(gomock.Any(), authorID, gomock.Any()).Return(nil, service.ErrInsufficientBalance)
+
+		assert.Equal(t, http.StatusBadRequest, post(valid).Code)
+	})
+
+	t.Run("pending_request", func(t *testing.T) {
+		mockUC.EXPECT().RequestPayout(gomock.Any(), authorID, gomock.Any()).Return(nil, service.ErrPayoutPending)
+
+		assert.Equal(t, http.StatusConflict, post(valid).Code)
+	})
+}
+
+func TestEarningsHandler_ListPayouts(t *testing.T) {
+	ctrl, mockUC, r, authorID := setupEarningsTest(t)
+	defer ctrl.Finish()
+
+	mockUC.EXPECT().ListPayouts(gomock.Any(), &authorID, dto.PayoutListRequest{Status: "approved", Page: 1, PageSize: 20}).
+		Return(&dto.PayoutListResponse{Payouts: []dto.PayoutResponse{}, Page: 1, PageSize: 20}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/authors/me/payouts?status=approved", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,106 @@
+package payment
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/application/usecase/payment"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// GiftHandler handles gift subscription codes of the signed-in user
+type GiftHandler interface {
+	ListGifts(c *gin.Context)
+	RedeemGift(c *gin.Context)
+}
+
+type giftHandler struct {
+	giftUseCase payment.GiftUseCase
+}
+
+// NewGiftHandler creates a new GiftHandler instance
+func NewGiftHandler(giftUseCase payment.GiftUseCase) GiftHandler {
+	return &giftHandler{
+		giftUseCase: giftUseCase,
+	}
+}
+
+// ListGifts handles GET /api/v1/gifts
+// @Summary List my gifts
+// @Description Lists the gift codes the signed-in user paid for, most recent first; a code appears once its order is paid
+// @Tags Gifts
+// @Produce json
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} dto.GiftListResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/gifts [get]
+func (h *giftHandler) ListGifts(c *gin.Context) {
+	var req dto.GiftListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.giftUseCase.ListGifts(c.Request.Context(), userID, req)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// RedeemGift handles POST /api/v1/gifts/redeem
+// @Summary Redeem a gift code
+// @Description Starts the gifted subscription period for the signed-in user, following the author if needed
+// @Tags Gifts
+// @Accept json
+// @Produce json
+// @Param body body dto.RedeemGiftRequest true "Gift code"
+// @Success 200 {object} dto.GiftResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/gifts/redeem [post]
+func (h *giftHandler) RedeemGift(c *gin.Context) {
+	var req dto.RedeemGiftRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.giftUseCase.Redeem(c.Request.Context(), userID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrGiftNotFound):
+			response.NotFound(c, err.Error())
+		case errors.Is(err, service.ErrOwnGift), errors.Is(err, service.ErrCannotSubscribeToSelf):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, service.ErrGiftNotRedeemable), errors.Is(err, service.ErrSubscriptionChangePending):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
@@ -0,0 +1,95 @@
+package payment_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/interfaces/http/handler/payment"
+	"github.com/aiagent/internal/interfaces/http/handler/payment/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupGiftTest(t *testing.T) (*gomock.Controller, *mocks.MockGiftUseCase, *gin.Engine, uuid.UUID) {
+	ctrl := gomock.NewController(t)
+	mockUC := mocks.NewMockGiftUseCase(ctrl)
+	h := payment.NewGiftHandler(mockUC)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	userID := uuid.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("userID", userID)
+		c.Next()
+	})
+	r.GET("/api/v1/gifts", h.ListGifts)
+	r.POST("/api/v1/gifts/redeem", h.RedeemGift)
+
+	return ctrl, mockUC, r, userID
+}
+
+func TestGiftHandler_ListGifts(t *testing.T) {
+	ctrl, mockUC, r, userID := setupGiftTest(t)
+	defer ctrl.Finish()
+
+	mockUC.EXPECT().ListGifts(gomock.Any(), userID, dto.GiftListRequest{Page: 2, PageSize: 20}).
+		Return(&dto.GiftListResponse{Gifts: []dto.GiftResponse{}}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/gifts?page=2", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGiftHandler_RedeemGift(t *testing.T) {
+	ctrl, mockUC, r, userID := setupGiftTest(t)
+	defer ctrl.Finish()
+
+	redeem := func(code string) *httptest.ResponseRecorder {
+		raw, _ := json.Marshal(dto.RedeemGiftRequest{Code: code})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/gifts/redeem", bytes.NewBuffer(raw))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockUC.EXPECT().Redeem(gomock.Any(), userID, dto.RedeemGiftRequest{Code: "ABCD-EFGH-JKLM"}).
+			Return(&dto.GiftResponse{Code: "ABCD-EFGH-JKLM", Status: entity.GiftStatusRedeemed}, nil)
+
+		assert.Equal(t, http.StatusOK, redeem("ABCD-EFGH-JKLM").Code)
+	})
+
+	t.Run("missing_code", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, redeem("").Code)
+	})
+
+	errorCases := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"unknown_code", service.ErrGiftNotFound, http.StatusNotFound},
+		{"own_gift", service.ErrOwnGift, http.StatusBadRequest},
+		{"already_redeemed", service.ErrGiftNotRedeemable, http.StatusConflict},
+		{"change_pending", service.ErrSubscriptionChangePending, http.StatusConflict},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockUC.EXPECT().Redeem(gomock.Any(), userID, gomock.Any()).Return(nil, tc.err)
+
+			assert.Equal(t, tc.want, redeem("ABCD-EFGH-JKLM").Code)
+		})
+	}
+}
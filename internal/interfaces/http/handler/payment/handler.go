@@ -2,9 +2,11 @@ package payment
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/service"
 	"github.com/aiagent/pkg/response"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -15,19 +17,27 @@ type CreatePaymentUseCase interface {
 	Execute(ctx context.Context, req dto.CreatePaymentRequest) (*dto.CreatePaymentResponse, error)
 }
 
+// PaymentStatusUseCase defines the interface for the order status use case
+type PaymentStatusUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, orderID string) (*dto.PaymentStatusResponse, error)
+}
+
 // PaymentHandler handles payment-related HTTP requests
 type PaymentHandler interface {
 	CreatePayment(c *gin.Context)
+	GetPaymentStatus(c *gin.Context)
 }
 
 type paymentHandler struct {
 	createPaymentUseCase CreatePaymentUseCase
+	paymentStatusUseCase PaymentStatusUseCase
 }
 
 // NewPaymentHandler creates a new PaymentHandler instance
-func NewPaymentHandler(createPaymentUseCase CreatePaymentUseCase) PaymentHandler {
+func NewPaymentHandler(createPaymentUseCase CreatePaymentUseCase, paymentStatusUseCase PaymentStatusUseCase) PaymentHandler {
 	return &paymentHandler{
 		createPaymentUseCase: createPaymentUseCase,
+		paymentStatusUseCase: paymentStatusUseCase,
 	}
 }
 
@@ -41,6 +51,8 @@ func NewPaymentHandler(createPaymentUseCase CreatePaymentUseCase) PaymentHandler
 // @Success 201 {object} dto.CreatePaymentResponse
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
 // @Failure 500 {object} response.Response
 // @Security Bearer
 // @Router /api/v1/payments [post]
@@ -67,9 +79,53 @@ func (h *paymentHandler) CreatePayment(c *gin.Context) {
 
 	resp, err := h.createPaymentUseCase.Execute(c.Request.Context(), req)
 	if err != nil {
-		response.InternalServerError(c, err.Error())
+		switch {
+		case errors.Is(err, service.ErrSeriesNotFound), errors.Is(err, service.ErrPlanNotFound):
+			response.NotFound(c, err.Error())
+		case errors.Is(err, service.ErrSeriesNotForSale), errors.Is(err, service.ErrUnsupportedCurrency),
+			errors.Is(err, service.ErrCannotSubscribeToSelf), errors.Is(err, service.ErrCannotDonateToSelf),
+			errors.Is(err, service.ErrInvalidPaymentAmount), errors.Is(err, service.ErrPaymentAmountPrecision),
+			errors.Is(err, service.ErrInvalidPaymentTarget), errors.Is(err, service.ErrUnsupportedPaymentType),
+			errors.Is(err, service.ErrPromoCodeInvalid), errors.Is(err, service.ErrPromoCodeNotApplicable):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, service.ErrSeriesAlreadyPurchased), errors.Is(err, service.ErrSubscriptionChangePending):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
 		return
 	}
 
 	response.Success(c, http.StatusCreated, resp)
 }
+
+// GetPaymentStatus handles GET /api/v1/payments/:orderId
+// @Summary Get payment status
+// @Description Returns an order of the signed-in user; card orders are checked with their provider while pending
+// @Tags Payments
+// @Produce json
+// @Param orderId path string true "Order ID"
+// @Success 200 {object} dto.PaymentStatusResponse
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/payments/{orderId} [get]
+func (h *paymentHandler) GetPaymentStatus(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.paymentStatusUseCase.Execute(c.Request.Context(), userID, c.Param("orderId"))
+	if err != nil {
+		if errors.Is(err, service.ErrTransactionNotFound) {
+			response.NotFound(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aiagent/internal/application/dto"
 	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
 	"github.com/aiagent/internal/interfaces/http/handler/payment"
 	"github.com/aiagent/internal/interfaces/http/handler/payment/mocks"
 	"github.com/gin-gonic/gin"
@@ -22,7 +23,7 @@ import (
 func setupTest(t *testing.T) (*gomock.Controller, *mocks.MockCreatePaymentUseCase, *gin.Engine, payment.PaymentHandler) {
 	ctrl := gomock.NewController(t)
 	mockUC := mocks.NewMockCreatePaymentUseCase(ctrl)
-	h := payment.NewPaymentHandler(mockUC)
+	h := payment.NewPaymentHandler(mockUC, mocks.NewMockPaymentStatusUseCase(ctrl))
 
 	gin.SetMode(gin.TestMode)
 	r := gin.Default()
@@ -88,7 +89,7 @@ func TestPaymentHandler_CreatePayment(t *testing.T) {
 	t.Run("missing user in context", func(t *testing.T) {
 		gin.SetMode(gin.TestMode)
 		rNoUser := gin.New()
-		hNoUser := payment.NewPaymentHandler(mockUC)
+		hNoUser := payment.NewPaymentHandler(mockUC, nil)
 		rNoUser.POST("/api/v1/payments", hNoUser.CreatePayment)
 
 		req := dto.CreatePaymentRequest{
@@ -111,7 +112,7 @@ func TestPaymentHandler_CreatePayment(t *testing.T) {
 		rInvalid := gin.New()
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		h2 := payment.NewPaymentHandler(mocks.NewMockCreatePaymentUseCase(ctrl))
+		h2 := payment.NewPaymentHandler(mocks.NewMockCreatePaymentUseCase(ctrl), nil)
 		// Add middleware to set user ID
 		rInvalid.Use(func(c *gin.Context) {
 			c.Set("userID", uuid.New())
@@ -146,6 +147,49 @@ func TestPaymentHandler_CreatePayment(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
+
+	t.Run("series already purchased", func(t *testing.T) {
+		targetID := uuid.New().String()
+		req := dto.CreatePaymentRequest{
+			Type:     entity.TransactionTypeSeries,
+			Gateway:  entity.TransactionGatewayVietQR,
+			TargetID: &targetID,
+		}
+		body, _ := json.Marshal(req)
+
+		mockUC.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, service.ErrSeriesAlreadyPurchased)
+
+		httpReq, _ := http.NewRequest("POST", "/api/v1/payments", bytes.NewBuffer(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("donation to self", func(t *testing.T) {
+		targetID := uuid.New().String()
+		req := dto.CreatePaymentRequest{
+			Amount:   decimal.NewFromInt(50000),
+			Type:     entity.TransactionTypeDonation,
+			Gateway:  entity.TransactionGatewayVietQR,
+			TargetID: &targetID,
+		}
+		body, _ := json.Marshal(req)
+
+		mockUC.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, service.ErrCannotDonateToSelf)
+
+		httpReq, _ := http.NewRequest("POST", "/api/v1/payments", bytes.NewBuffer(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
 
 func TestPaymentHandler_CreatePayment_Donation(t *testing.T) {
@@ -188,3 +232,49 @@ func TestPaymentHandler_CreatePayment_Donation(t *testing.T) {
 	json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.Equal(t, true, resp["success"])
 }
+
+func TestPaymentHandler_GetPaymentStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStatusUC := mocks.NewMockPaymentStatusUseCase(ctrl)
+	h := payment.NewPaymentHandler(mocks.NewMockCreatePaymentUseCase(ctrl), mockStatusUC)
+
+	userID := uuid.New()
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("userID", userID)
+		c.Next()
+	})
+	r.GET("/api/v1/payments/:orderId", h.GetPaymentStatus)
+
+	t.Run("success", func(t *testing.T) {
+		mockStatusUC.EXPECT().
+			Execute(gomock.Any(), userID, "ORDER-STRIPE-1").
+			Return(&dto.PaymentStatusResponse{
+				OrderID:  "ORDER-STRIPE-1",
+				Status:   entity.TransactionStatusSuccess,
+				Amount:   decimal.RequireFromString("12.50"),
+				Currency: "USD",
+			}, nil)
+
+		httpReq, _ := http.NewRequest("GET", "/api/v1/payments/ORDER-STRIPE-1", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockStatusUC.EXPECT().
+			Execute(gomock.Any(), userID, "ORDER-MISSING").
+			Return(nil, service.ErrTransactionNotFound)
+
+		httpReq, _ := http.NewRequest("GET", "/api/v1/payments/ORDER-MISSING", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
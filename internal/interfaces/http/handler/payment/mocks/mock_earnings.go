@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/usecase/payment/earnings.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/application/usecase/payment/earnings.go -destination=internal/interfaces/http/handler/payment/mocks/mock_earnings.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/aiagent/internal/application/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEarningsUseCase is a mock of EarningsUseCase interface.
+type MockEarningsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEarningsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockEarningsUseCaseMockRecorder is the mock recorder for MockEarningsUseCase.
+type MockEarningsUseCaseMockRecorder struct {
+	mock *MockEarningsUseCase
+}
+
+// NewMockEarningsUseCase creates a new mock instance.
+func NewMockEarningsUseCase(ctrl *gomock.Controller) *MockEarningsUseCase {
+	mock := &MockEarningsUseCase{ctrl: ctrl}
+	mock.recorder = &MockEarningsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEarningsUseCase) EXPECT() *MockEarningsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Balance mocks base method.
+func (m *MockEarningsUseCase) Balance(ctx context.Context, authorID uuid.UUID, req dto.BalanceRequest) (*dto.BalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Balance", ctx, authorID, req)
+	ret0, _ := ret[0].(*dto.BalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Balance indicates an expected call of Balance.
+func (mr *MockEarningsUseCaseMockRecorder) Balance(ctx, authorID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockEarningsUseCase)(nil).Balance), ctx, authorID, req)
+}
+
+// Earnings mocks base method.
+func (m *MockEarningsUseCase) Earnings(ctx context.Context, authorID uuid.UUID, req dto.EarningsRequest) (*dto.EarningsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Earnings", ctx, authorID, req)
+	ret0, _ := ret[0].(*dto.EarningsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Earnings indicates an expected call of Earnings.
+func (mr *MockEarningsUseCaseMockRecorder) Earnings(ctx, authorID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Earnings", reflect.TypeOf((*MockEarningsUseCase)(nil).Earnings), ctx, authorID, req)
+}
+
+// ListPayouts mocks base method.
+func (m *MockEarningsUseCase) ListPayouts(ctx context.Context, authorID *uuid.UUID, req dto.PayoutListRequest) (*dto.PayoutListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPayouts", ctx, authorID, req)
+	ret0, _ := ret[0].(*dto.PayoutListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPayouts indicates an expected call of ListPayouts.
+func (mr *MockEarningsUseCaseMockRecorder) ListPayouts(ctx, authorID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayouts", reflect.TypeOf((*MockEarningsUseCase)(nil).ListPayouts), ctx, authorID, req)
+}
+
+// RequestPayout mocks base method.
+func (m *MockEarningsUseCase) RequestPayout(ctx context.Context, authorID uuid.UUID, req dto.CreatePayoutRequest) (*dto.PayoutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPayout", ctx, authorID, req)
+	ret0, _ := ret[0].(*dto.PayoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPayout indicates an expected call of RequestPayout.
+func (mr *MockEarningsUseCaseMockRecorder) RequestPayout(ctx, authorID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPayout", reflect.TypeOf((*MockEarningsUseCase)(nil).RequestPayout), ctx, authorID, req)
+}
+
+// ReviewPayout mocks base method.
+func (m *MockEarningsUseCase) ReviewPayout(ctx context.Context, id, adminID uuid.UUID, req dto.ReviewPayoutRequest) (*dto.PayoutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewPayout", ctx, id, adminID, req)
+	ret0, _ := ret[0].(*dto.PayoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewPayout indicates an expected call of ReviewPayout.
+func (mr *MockEarningsUseCaseMockRecorder) ReviewPayout(ctx, id, adminID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewPayout", reflect.TypeOf((*MockEarningsUseCase)(nil).ReviewPayout), ctx, id, adminID, req)
+}
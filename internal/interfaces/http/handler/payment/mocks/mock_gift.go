@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/usecase/payment/gift.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/application/usecase/payment/gift.go -destination=internal/interfaces/http/handler/payment/mocks/mock_gift.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/aiagent/internal/application/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGiftUseCase is a mock of GiftUseCase interface.
+type MockGiftUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGiftUseCaseMockRecorder is the mock recorder for MockGiftUseCase.
+type MockGiftUseCaseMockRecorder struct {
+	mock *MockGiftUseCase
+}
+
+// NewMockGiftUseCase creates a new mock instance.
+func NewMockGiftUseCase(ctrl *gomock.Controller) *MockGiftUseCase {
+	mock := &MockGiftUseCase{ctrl: ctrl}
+	mock.recorder = &MockGiftUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftUseCase) EXPECT() *MockGiftUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListGifts mocks base method.
+func (m *MockGiftUseCase) ListGifts(ctx context.Context, userID uuid.UUID, req dto.GiftListRequest) (*dto.GiftListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGifts", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.GiftListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGifts indicates an expected call of ListGifts.
+func (mr *MockGiftUseCaseMockRecorder) ListGifts(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGifts", reflect.TypeOf((*MockGiftUseCase)(nil).ListGifts), ctx, userID, req)
+}
+
+// Redeem mocks base method.
+func (m *MockGiftUseCase) Redeem(ctx context.Context, userID uuid.UUID, req dto.RedeemGiftRequest) (*dto.GiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.GiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockGiftUseCaseMockRecorder) Redeem(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockGiftUseCase)(nil).Redeem), ctx, userID, req)
+}
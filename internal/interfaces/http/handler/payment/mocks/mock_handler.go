@@ -1,11 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: internal/interfaces/http/handler/payment/handler.go
-// Source: internal/application/usecase/payment/process_webhook.go
 //
 // Generated by this command:
 //
 //	mockgen -source=internal/interfaces/http/handler/payment/handler.go -destination=internal/interfaces/http/handler/payment/mocks/mock_handler.go -package=mocks
-//	mockgen -source=internal/application/usecase/payment/process_webhook.go -destination=internal/interfaces/http/handler/payment/mocks/mock_process_webhook.go -package=mocks
 //
 
 // Package mocks is a generated GoMock package.
@@ -16,30 +14,32 @@ import (
 	reflect "reflect"
 
 	dto "github.com/aiagent/internal/application/dto"
-	entity "github.com/aiagent/internal/domain/entity"
+	gin "github.com/gin-gonic/gin"
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
 // MockCreatePaymentUseCase is a mock of CreatePaymentUseCase interface.
 type MockCreatePaymentUseCase struct {
 	ctrl     *gomock.Controller
-	recorder *MockCreatePaymentUseCaseRecorder
+	recorder *MockCreatePaymentUseCaseMockRecorder
+	isgomock struct{}
 }
 
-// MockCreatePaymentUseCaseRecorder is the mock recorder for MockCreatePaymentUseCase.
-type MockCreatePaymentUseCaseRecorder struct {
+// MockCreatePaymentUseCaseMockRecorder is the mock recorder for MockCreatePaymentUseCase.
+type MockCreatePaymentUseCaseMockRecorder struct {
 	mock *MockCreatePaymentUseCase
 }
 
 // NewMockCreatePaymentUseCase creates a new mock instance.
 func NewMockCreatePaymentUseCase(ctrl *gomock.Controller) *MockCreatePaymentUseCase {
 	mock := &MockCreatePaymentUseCase{ctrl: ctrl}
-	mock.recorder = &MockCreatePaymentUseCaseRecorder{mock}
+	mock.recorder = &MockCreatePaymentUseCaseMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCreatePaymentUseCase) EXPECT() *MockCreatePaymentUseCaseRecorder {
+func (m *MockCreatePaymentUseCase) EXPECT() *MockCreatePaymentUseCaseMockRecorder {
 	return m.recorder
 }
 
@@ -53,43 +53,94 @@ func (m *MockCreatePaymentUseCase) Execute(ctx context.Context, req dto.CreatePa
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockCreatePaymentUseCaseRecorder) Execute(ctx, req any) *gomock.Call {
+func (mr *MockCreatePaymentUseCaseMockRecorder) Execute(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreatePaymentUseCase)(nil).Execute), ctx, req)
 }
 
-// MockProcessWebhookUseCase is a mock of ProcessWebhookUseCase interface.
-type MockProcessWebhookUseCase struct {
+// MockPaymentStatusUseCase is a mock of PaymentStatusUseCase interface.
+type MockPaymentStatusUseCase struct {
 	ctrl     *gomock.Controller
-	recorder *MockProcessWebhookUseCaseRecorder
+	recorder *MockPaymentStatusUseCaseMockRecorder
+	isgomock struct{}
 }
 
-// MockProcessWebhookUseCaseRecorder is the mock recorder for MockProcessWebhookUseCase.
-type MockProcessWebhookUseCaseRecorder struct {
-	mock *MockProcessWebhookUseCase
+// MockPaymentStatusUseCaseMockRecorder is the mock recorder for MockPaymentStatusUseCase.
+type MockPaymentStatusUseCaseMockRecorder struct {
+	mock *MockPaymentStatusUseCase
 }
 
-// NewMockProcessWebhookUseCase creates a new mock instance.
-func NewMockProcessWebhookUseCase(ctrl *gomock.Controller) *MockProcessWebhookUseCase {
-	mock := &MockProcessWebhookUseCase{ctrl: ctrl}
-	mock.recorder = &MockProcessWebhookUseCaseRecorder{mock}
+// NewMockPaymentStatusUseCase creates a new mock instance.
+func NewMockPaymentStatusUseCase(ctrl *gomock.Controller) *MockPaymentStatusUseCase {
+	mock := &MockPaymentStatusUseCase{ctrl: ctrl}
+	mock.recorder = &MockPaymentStatusUseCaseMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockProcessWebhookUseCase) EXPECT() *MockProcessWebhookUseCaseRecorder {
+func (m *MockPaymentStatusUseCase) EXPECT() *MockPaymentStatusUseCaseMockRecorder {
 	return m.recorder
 }
 
 // Execute mocks base method.
-func (m *MockProcessWebhookUseCase) Execute(ctx context.Context, req dto.ProcessWebhookRequest) (*entity.Transaction, error) {
+func (m *MockPaymentStatusUseCase) Execute(ctx context.Context, userID uuid.UUID, orderID string) (*dto.PaymentStatusResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, req)
-	ret0, _ := ret[0].(*entity.Transaction)
+	ret := m.ctrl.Call(m, "Execute", ctx, userID, orderID)
+	ret0, _ := ret[0].(*dto.PaymentStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockProcessWebhookUseCaseRecorder) Execute(ctx, req any) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockProcessWebhookUseCase)(nil).Execute), ctx, req)
+func (mr *MockPaymentStatusUseCaseMockRecorder) Execute(ctx, userID, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPaymentStatusUseCase)(nil).Execute), ctx, userID, orderID)
+}
+
+// MockPaymentHandler is a mock of PaymentHandler interface.
+type MockPaymentHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentHandlerMockRecorder is the mock recorder for MockPaymentHandler.
+type MockPaymentHandlerMockRecorder struct {
+	mock *MockPaymentHandler
+}
+
+// NewMockPaymentHandler creates a new mock instance.
+func NewMockPaymentHandler(ctrl *gomock.Controller) *MockPaymentHandler {
+	mock := &MockPaymentHandler{ctrl: ctrl}
+	mock.recorder = &MockPaymentHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentHandler) EXPECT() *MockPaymentHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreatePayment mocks base method.
+func (m *MockPaymentHandler) CreatePayment(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreatePayment", c)
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockPaymentHandlerMockRecorder) CreatePayment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockPaymentHandler)(nil).CreatePayment), c)
+}
+
+// GetPaymentStatus mocks base method.
+func (m *MockPaymentHandler) GetPaymentStatus(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetPaymentStatus", c)
+}
+
+// GetPaymentStatus indicates an expected call of GetPaymentStatus.
+func (mr *MockPaymentHandlerMockRecorder) GetPaymentStatus(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentStatus", reflect.TypeOf((*MockPaymentHandler)(nil).GetPaymentStatus), c)
 }
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/usecase/payment/promo_code.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/application/usecase/payment/promo_code.go -destination=internal/interfaces/http/handler/payment/mocks/mock_promo_code.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/aiagent/internal/application/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPromoCodeUseCase is a mock of PromoCodeUseCase interface.
+type MockPromoCodeUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoCodeUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPromoCodeUseCaseMockRecorder is the mock recorder for MockPromoCodeUseCase.
+type MockPromoCodeUseCaseMockRecorder struct {
+	mock *MockPromoCodeUseCase
+}
+
+// NewMockPromoCodeUseCase creates a new mock instance.
+func NewMockPromoCodeUseCase(ctrl *gomock.Controller) *MockPromoCodeUseCase {
+	mock := &MockPromoCodeUseCase{ctrl: ctrl}
+	mock.recorder = &MockPromoCodeUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoCodeUseCase) EXPECT() *MockPromoCodeUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPromoCodeUseCase) Create(ctx context.Context, authorID uuid.UUID, req dto.CreatePromoCodeRequest) (*dto.PromoCodeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, authorID, req)
+	ret0, _ := ret[0].(*dto.PromoCodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromoCodeUseCaseMockRecorder) Create(ctx, authorID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromoCodeUseCase)(nil).Create), ctx, authorID, req)
+}
+
+// Deactivate mocks base method.
+func (m *MockPromoCodeUseCase) Deactivate(ctx context.Context, authorID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, authorID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockPromoCodeUseCaseMockRecorder) Deactivate(ctx, authorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockPromoCodeUseCase)(nil).Deactivate), ctx, authorID, id)
+}
+
+// List mocks base method.
+func (m *MockPromoCodeUseCase) List(ctx context.Context, authorID uuid.UUID) (*dto.PromoCodeListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, authorID)
+	ret0, _ := ret[0].(*dto.PromoCodeListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPromoCodeUseCaseMockRecorder) List(ctx, authorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPromoCodeUseCase)(nil).List), ctx, authorID)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/usecase/payment/reconciliation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/application/usecase/payment/reconciliation.go -destination=internal/interfaces/http/handler/payment/mocks/mock_reconciliation.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/aiagent/internal/application/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReconciliationUseCase is a mock of ReconciliationUseCase interface.
+type MockReconciliationUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockReconciliationUseCaseMockRecorder is the mock recorder for MockReconciliationUseCase.
+type MockReconciliationUseCaseMockRecorder struct {
+	mock *MockReconciliationUseCase
+}
+
+// NewMockReconciliationUseCase creates a new mock instance.
+func NewMockReconciliationUseCase(ctrl *gomock.Controller) *MockReconciliationUseCase {
+	mock := &MockReconciliationUseCase{ctrl: ctrl}
+	mock.recorder = &MockReconciliationUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationUseCase) EXPECT() *MockReconciliationUseCaseMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockReconciliationUseCase) List(ctx context.Context, req dto.ReconciliationListRequest) (*dto.ReconciliationListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, req)
+	ret0, _ := ret[0].(*dto.ReconciliationListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReconciliationUseCaseMockRecorder) List(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReconciliationUseCase)(nil).List), ctx, req)
+}
+
+// Resolve mocks base method.
+func (m *MockReconciliationUseCase) Resolve(ctx context.Context, id, adminID uuid.UUID, req dto.ResolveReconciliationRequest) (*dto.ReconciliationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, id, adminID, req)
+	ret0, _ := ret[0].(*dto.ReconciliationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockReconciliationUseCaseMockRecorder) Resolve(ctx, id, adminID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockReconciliationUseCase)(nil).Resolve), ctx, id, adminID, req)
+}
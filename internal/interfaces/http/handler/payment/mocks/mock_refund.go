@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/usecase/payment/refund.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/application/usecase/payment/refund.go -destination=internal/interfaces/http/handler/payment/mocks/mock_refund.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/aiagent/internal/application/dto"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefundUseCase is a mock of RefundUseCase interface.
+type MockRefundUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefundUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRefundUseCaseMockRecorder is the mock recorder for MockRefundUseCase.
+type MockRefundUseCaseMockRecorder struct {
+	mock *MockRefundUseCase
+}
+
+// NewMockRefundUseCase creates a new mock instance.
+func NewMockRefundUseCase(ctrl *gomock.Controller) *MockRefundUseCase {
+	mock := &MockRefundUseCase{ctrl: ctrl}
+	mock.recorder = &MockRefundUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundUseCase) EXPECT() *MockRefundUseCaseMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockRefundUseCase) List(ctx context.Context, transactionID uuid.UUID) ([]dto.RefundResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, transactionID)
+	ret0, _ := ret[0].([]dto.RefundResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRefundUseCaseMockRecorder) List(ctx, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRefundUseCase)(nil).List), ctx, transactionID)
+}
+
+// Refund mocks base method.
+func (m *MockRefundUseCase) Refund(ctx context.Context, transactionID, adminID uuid.UUID, req dto.CreateRefundRequest) (*dto.RefundResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, transactionID, adminID, req)
+	ret0, _ := ret[0].(*dto.RefundResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockRefundUseCaseMockRecorder) Refund(ctx, transactionID, adminID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockRefundUseCase)(nil).Refund), ctx, transactionID, adminID, req)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/usecase/payment/report.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/application/usecase/payment/report.go -destination=internal/interfaces/http/handler/payment/mocks/mock_report.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/aiagent/internal/application/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportUseCase is a mock of ReportUseCase interface.
+type MockReportUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockReportUseCaseMockRecorder is the mock recorder for MockReportUseCase.
+type MockReportUseCaseMockRecorder struct {
+	mock *MockReportUseCase
+}
+
+// NewMockReportUseCase creates a new mock instance.
+func NewMockReportUseCase(ctrl *gomock.Controller) *MockReportUseCase {
+	mock := &MockReportUseCase{ctrl: ctrl}
+	mock.recorder = &MockReportUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportUseCase) EXPECT() *MockReportUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockReportUseCase) Get(ctx context.Context, day string) (*dto.PaymentReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, day)
+	ret0, _ := ret[0].(*dto.PaymentReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReportUseCaseMockRecorder) Get(ctx, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReportUseCase)(nil).Get), ctx, day)
+}
+
+// List mocks base method.
+func (m *MockReportUseCase) List(ctx context.Context, req dto.PaymentReportListRequest) (*dto.PaymentReportListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, req)
+	ret0, _ := ret[0].(*dto.PaymentReportListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReportUseCaseMockRecorder) List(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReportUseCase)(nil).List), ctx, req)
+}
@@ -0,0 +1,109 @@
+package payment
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/application/usecase/payment"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PayoutHandler handles the admin review of author payout requests
+type PayoutHandler interface {
+	ListPayouts(c *gin.Context)
+	ReviewPayout(c *gin.Context)
+}
+
+type payoutHandler struct {
+	earningsUseCase payment.EarningsUseCase
+}
+
+// NewPayoutHandler creates a new PayoutHandler instance
+func NewPayoutHandler(earningsUseCase payment.EarningsUseCase) PayoutHandler {
+	return &payoutHandler{
+		earningsUseCase: earningsUseCase,
+	}
+}
+
+// ListPayouts handles GET /api/v1/admin/payments/payouts
+// @Summary List payout requests
+// @Description Lists payout requests of every author, most recent first
+// @Tags Payments
+// @Produce json
+// @Param status query string false "Status (pending, approved, rejected)"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} dto.PayoutListResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/admin/payments/payouts [get]
+func (h *payoutHandler) ListPayouts(c *gin.Context) {
+	var req dto.PayoutListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.earningsUseCase.ListPayouts(c.Request.Context(), nil, req)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// ReviewPayout handles POST /api/v1/admin/payments/payouts/:id/review
+// @Summary Review a payout request
+// @Description Approves a payout once the money was sent, debiting the author's balance, or rejects it
+// @Tags Payments
+// @Accept json
+// @Produce json
+// @Param id path string true "Payout ID"
+// @Param body body dto.ReviewPayoutRequest true "Decision"
+// @Success 200 {object} dto.PayoutResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/admin/payments/payouts/{id}/review [post]
+func (h *payoutHandler) ReviewPayout(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid payout ID")
+		return
+	}
+
+	var req dto.ReviewPayoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	adminID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.earningsUseCase.ReviewPayout(c.Request.Context(), id, adminID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrPayoutNotFound):
+			response.NotFound(c, err.Error())
+		case errors.Is(err, service.ErrPayoutReviewed), errors.Is(err, service.ErrInsufficientBalance):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
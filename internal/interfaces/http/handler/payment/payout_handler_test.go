@@ -0,0 +1,106 @@
+package payment_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/interfaces/http/handler/payment"
+	"github.com/aiagent/internal/interfaces/http/handler/payment/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupPayoutTest(t *testing.T) (*gomock.Controller, *mocks.MockEarningsUseCase, *gin.Engine, uuid.UUID) {
+	ctrl := gomock.NewController(t)
+	mockUC := mocks.NewMockEarningsUseCase(ctrl)
+	h := payment.NewPayoutHandler(mockUC)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	adminID := uuid.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("userID", adminID)
+		c.Next()
+	})
+	r.GET("/api/v1/admin/payments/payouts", h.ListPayouts)
+	r.POST("/api/v1/admin/payments/payouts/:id/review", h.ReviewPayout)
+
+	return ctrl, mockUC, r, adminID
+}
+
+func TestPayoutHandler_ListPayouts(t *testing.T) {
+	ctrl, mockUC, r, _ := setupPayoutTest(t)
+	defer ctrl.Finish()
+
+	t.Run("all_authors", func(t *testing.T) {
+		mockUC.EXPECT().ListPayouts(gomock.Any(), nil, dto.PayoutListRequest{Status: "pending", Page: 1, PageSize: 20}).
+			Return(&dto.PayoutListResponse{Payouts: []dto.PayoutResponse{}}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/payments/payouts?status=pending", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("invalid_status", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/payments/payouts?status=paid", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPayoutHandler_ReviewPayout(t *testing.T) {
+	ctrl, mockUC, r, adminID := setupPayoutTest(t)
+	defer ctrl.Finish()
+
+	payoutID := uuid.New()
+	post := func(id string, body interface{}) *httptest.ResponseRecorder {
+		raw, _ := json.Marshal(body)
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/payments/payouts/"+id+"/review", bytes.NewBuffer(raw))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("approve", func(t *testing.T) {
+		reference := "FT26289123"
+		req := dto.ReviewPayoutRequest{Action: "approve", Reference: &reference}
+		mockUC.EXPECT().ReviewPayout(gomock.Any(), payoutID, adminID, req).
+			Return(&dto.PayoutResponse{ID: payoutID, Status: entity.PayoutStatusApproved}, nil)
+
+		assert.Equal(t, http.StatusOK, post(payoutID.String(), req).Code)
+	})
+
+	t.Run("invalid_action", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, post(payoutID.String(), dto.ReviewPayoutRequest{Action: "pay"}).Code)
+	})
+
+	t.Run("invalid_id", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, post("not-a-uuid", dto.ReviewPayoutRequest{Action: "reject"}).Code)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mockUC.EXPECT().ReviewPayout(gomock.Any(), payoutID, adminID, gomock.Any()).Return(nil, service.ErrPayoutNotFound)
+
+		assert.Equal(t, http.StatusNotFound, post(payoutID.String(), dto.ReviewPayoutRequest{Action: "reject"}).Code)
+	})
+
+	t.Run("already_reviewed", func(t *testing.T) {
+		mockUC.EXPECT().ReviewPayout(gomock.Any(), payoutID, adminID, gomock.Any()).Return(nil, service.ErrPayoutReviewed)
+
+		assert.Equal(t, http.StatusConflict, post(payoutID.String(), dto.ReviewPayoutRequest{Action: "approve"}).Code)
+	})
+}
@@ -0,0 +1,131 @@
+package payment
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/application/usecase/payment"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PromoCodeHandler handles the promo codes the signed-in author offers on their plans
+type PromoCodeHandler interface {
+	CreatePromoCode(c *gin.Context)
+	ListPromoCodes(c *gin.Context)
+	DeactivatePromoCode(c *gin.Context)
+}
+
+type promoCodeHandler struct {
+	promoCodeUseCase payment.PromoCodeUseCase
+}
+
+// NewPromoCodeHandler creates a new PromoCodeHandler instance
+func NewPromoCodeHandler(promoCodeUseCase payment.PromoCodeUseCase) PromoCodeHandler {
+	return &promoCodeHandler{
+		promoCodeUseCase: promoCodeUseCase,
+	}
+}
+
+// CreatePromoCode handles POST /api/v1/authors/me/promo-codes
+// @Summary Create a promo code
+// @Description Creates a percentage or fixed discount on the signed-in author's plans, optionally limited to a tier, a number of paid orders and an expiry
+// @Tags Promo Codes
+// @Accept json
+// @Produce json
+// @Param body body dto.CreatePromoCodeRequest true "Promo code"
+// @Success 201 {object} dto.PromoCodeResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/authors/me/promo-codes [post]
+func (h *promoCodeHandler) CreatePromoCode(c *gin.Context) {
+	var req dto.CreatePromoCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	authorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.promoCodeUseCase.Create(c.Request.Context(), authorID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidPromoDiscount):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, service.ErrPromoCodeExists):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusCreated, resp)
+}
+
+// ListPromoCodes handles GET /api/v1/authors/me/promo-codes
+// @Summary List my promo codes
+// @Description Lists the signed-in author's promo codes with their usage, most recent first
+// @Tags Promo Codes
+// @Produce json
+// @Success 200 {object} dto.PromoCodeListResponse
+// @Failure 401 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/authors/me/promo-codes [get]
+func (h *promoCodeHandler) ListPromoCodes(c *gin.Context) {
+	authorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.promoCodeUseCase.List(c.Request.Context(), authorID)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// DeactivatePromoCode handles DELETE /api/v1/authors/me/promo-codes/:id
+// @Summary Deactivate a promo code
+// @Description Stops a promo code from being applied to new orders; orders already placed keep their discount
+// @Tags Promo Codes
+// @Param id path string true "Promo code ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/authors/me/promo-codes/{id} [delete]
+func (h *promoCodeHandler) DeactivatePromoCode(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid promo code ID")
+		return
+	}
+
+	authorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.promoCodeUseCase.Deactivate(c.Request.Context(), authorID, id); err != nil {
+		if errors.Is(err, service.ErrPromoCodeNotFound) {
+			response.NotFound(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,109 @@
+package payment_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/interfaces/http/handler/payment"
+	"github.com/aiagent/internal/interfaces/http/handler/payment/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupPromoCodeTest(t *testing.T) (*gomock.Controller, *mocks.MockPromoCodeUseCase, *gin.Engine, uuid.UUID) {
+	ctrl := gomock.NewController(t)
+	mockUC := mocks.NewMockPromoCodeUseCase(ctrl)
+	h := payment.NewPromoCodeHandler(mockUC)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	authorID := uuid.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("userID", authorID)
+		c.Next()
+	})
+	r.POST("/api/v1/authors/me/promo-codes", h.CreatePromoCode)
+	r.GET("/api/v1/authors/me/promo-codes", h.ListPromoCodes)
+	r.DELETE("/api/v1/authors/me/promo-codes/:id", h.DeactivatePromoCode)
+
+	return ctrl, mockUC, r, authorID
+}
+
+func TestPromoCodeHandler_CreatePromoCode(t *testing.T) {
+	ctrl, mockUC, r, authorID := setupPromoCodeTest(t)
+	defer ctrl.Finish()
+
+	create := func(body interface{}) *httptest.ResponseRecorder {
+		raw, _ := json.Marshal(body)
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/authors/me/promo-codes", bytes.NewBuffer(raw))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	valid := dto.CreatePromoCodeRequest{Code: "LAUNCH", DiscountType: "percent", DiscountValue: decimal.NewFromInt(20)}
+
+	t.Run("success", func(t *testing.T) {
+		mockUC.EXPECT().Create(gomock.Any(), authorID, gomock.Any()).
+			Return(&dto.PromoCodeResponse{ID: uuid.New(), Code: "LAUNCH"}, nil)
+
+		assert.Equal(t, http.StatusCreated, create(valid).Code)
+	})
+
+	t.Run("unknown_discount_type", func(t *testing.T) {
+		req := valid
+		req.DiscountType = "bogo"
+
+		assert.Equal(t, http.StatusBadRequest, create(req).Code)
+	})
+
+	t.Run("invalid_discount", func(t *testing.T) {
+		mockUC.EXPECT().Create(gomock.Any(), authorID, gomock.Any()).Return(nil, service.ErrInvalidPromoDiscount)
+
+		assert.Equal(t, http.StatusBadRequest, create(valid).Code)
+	})
+
+	t.Run("duplicate_code", func(t *testing.T) {
+		mockUC.EXPECT().Create(gomock.Any(), authorID, gomock.Any()).Return(nil, service.ErrPromoCodeExists)
+
+		assert.Equal(t, http.StatusConflict, create(valid).Code)
+	})
+}
+
+func TestPromoCodeHandler_DeactivatePromoCode(t *testing.T) {
+	ctrl, mockUC, r, authorID := setupPromoCodeTest(t)
+	defer ctrl.Finish()
+
+	deactivate := func(id string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/authors/me/promo-codes/"+id, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockUC.EXPECT().Deactivate(gomock.Any(), authorID, id).Return(nil)
+
+		assert.Equal(t, http.StatusNoContent, deactivate(id.String()).Code)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mockUC.EXPECT().Deactivate(gomock.Any(), authorID, id).Return(service.ErrPromoCodeNotFound)
+
+		assert.Equal(t, http.StatusNotFound, deactivate(id.String()).Code)
+	})
+
+	t.Run("invalid_id", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, deactivate("abc").Code)
+	})
+}
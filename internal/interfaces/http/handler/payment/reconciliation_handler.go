@@ -0,0 +1,116 @@
+package payment
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/application/usecase/payment"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ReconciliationHandler handles admin review of transfers that did not match their order
+type ReconciliationHandler interface {
+	ListReconciliations(c *gin.Context)
+	ResolveReconciliation(c *gin.Context)
+}
+
+type reconciliationHandler struct {
+	reconciliationUseCase payment.ReconciliationUseCase
+}
+
+// NewReconciliationHandler creates a new ReconciliationHandler instance
+func NewReconciliationHandler(reconciliationUseCase payment.ReconciliationUseCase) ReconciliationHandler {
+	return &reconciliationHandler{
+		reconciliationUseCase: reconciliationUseCase,
+	}
+}
+
+// ListReconciliations handles GET /api/v1/admin/payments/reconciliations
+// @Summary List flagged payments
+// @Description Lists underpaid, overpaid and late transfers waiting for review
+// @Tags Payments
+// @Produce json
+// @Param status query string false "open, granted or dismissed"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} dto.ReconciliationListResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/admin/payments/reconciliations [get]
+func (h *reconciliationHandler) ListReconciliations(c *gin.Context) {
+	var req dto.ReconciliationListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.reconciliationUseCase.List(c.Request.Context(), req)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// ResolveReconciliation handles POST /api/v1/admin/payments/reconciliations/:id/resolve
+// @Summary Resolve a flagged payment
+// @Description Grants the order despite the mismatch or dismisses the transfer
+// @Tags Payments
+// @Accept json
+// @Produce json
+// @Param id path string true "Reconciliation ID"
+// @Param body body dto.ResolveReconciliationRequest true "Decision"
+// @Success 200 {object} dto.ReconciliationResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/admin/payments/reconciliations/{id}/resolve [post]
+func (h *reconciliationHandler) ResolveReconciliation(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid reconciliation ID")
+		return
+	}
+
+	var req dto.ResolveReconciliationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	adminIDVal, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return
+	}
+	adminID, ok := adminIDVal.(uuid.UUID)
+	if !ok {
+		response.Unauthorized(c, "invalid user ID")
+		return
+	}
+
+	resp, err := h.reconciliationUseCase.Resolve(c.Request.Context(), id, adminID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrReconciliationNotFound):
+			response.NotFound(c, err.Error())
+		case errors.Is(err, service.ErrReconciliationResolved), errors.Is(err, service.ErrReconciliationNotGrantable),
+			errors.Is(err, service.ErrSeriesAlreadyPurchased), errors.Is(err, service.ErrSubscriptionChangePending):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
@@ -0,0 +1,120 @@
+package payment_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/interfaces/http/handler/payment"
+	"github.com/aiagent/internal/interfaces/http/handler/payment/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupReconciliationTest(t *testing.T) (*gomock.Controller, *mocks.MockReconciliationUseCase, *gin.Engine, uuid.UUID) {
+	ctrl := gomock.NewController(t)
+	mockUC := mocks.NewMockReconciliationUseCase(ctrl)
+	h := payment.NewReconciliationHandler(mockUC)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	adminID := uuid.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("userID", adminID)
+		c.Next()
+	})
+	r.GET("/api/v1/admin/payments/reconciliations", h.ListReconciliations)
+	r.POST("/api/v1/admin/payments/reconciliations/:id/resolve", h.ResolveReconciliation)
+
+	return ctrl, mockUC, r, adminID
+}
+
+func TestReconciliationHandler_ListReconciliations(t *testing.T) {
+	ctrl, mockUC, r, _ := setupReconciliationTest(t)
+	defer ctrl.Finish()
+
+	t.Run("success", func(t *testing.T) {
+		mockUC.EXPECT().List(gomock.Any(), dto.ReconciliationListRequest{Status: "open", Page: 1, PageSize: 20}).
+			Return(&dto.ReconciliationListResponse{
+				Reconciliations: []dto.ReconciliationResponse{{ID: uuid.New(), Reason: entity.ReconciliationReasonUnderpaid}},
+				TotalCount:      1,
+				Page:            1,
+				PageSize:        20,
+				TotalPages:      1,
+			}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/payments/reconciliations?status=open", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("invalid_status", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/payments/reconciliations?status=paid", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestReconciliationHandler_ResolveReconciliation(t *testing.T) {
+	ctrl, mockUC, r, adminID := setupReconciliationTest(t)
+	defer ctrl.Finish()
+
+	recID := uuid.New()
+	url := "/api/v1/admin/payments/reconciliations/" + recID.String() + "/resolve"
+
+	post := func(body interface{}) *httptest.ResponseRecorder {
+		raw, _ := json.Marshal(body)
+		req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(raw))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("grant", func(t *testing.T) {
+		mockUC.EXPECT().Resolve(gomock.Any(), recID, adminID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ uuid.UUID, req dto.ResolveReconciliationRequest) (*dto.ReconciliationResponse, error) {
+				assert.Equal(t, "grant", req.Action)
+				return &dto.ReconciliationResponse{ID: recID, Status: entity.ReconciliationStatusGranted}, nil
+			})
+
+		w := post(dto.ResolveReconciliationRequest{Action: "grant"})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("invalid_action", func(t *testing.T) {
+		w := post(dto.ResolveReconciliationRequest{Action: "refund"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mockUC.EXPECT().Resolve(gomock.Any(), recID, adminID, gomock.Any()).Return(nil, service.ErrReconciliationNotFound)
+
+		w := post(dto.ResolveReconciliationRequest{Action: "dismiss"})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("already_resolved", func(t *testing.T) {
+		mockUC.EXPECT().Resolve(gomock.Any(), recID, adminID, gomock.Any()).Return(nil, service.ErrReconciliationResolved)
+
+		w := post(dto.ResolveReconciliationRequest{Action: "dismiss"})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -0,0 +1,127 @@
+package payment
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/application/usecase/payment"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RefundHandler handles admin refunds and chargebacks of transactions
+type RefundHandler interface {
+	CreateRefund(c *gin.Context)
+	ListRefunds(c *gin.Context)
+}
+
+type refundHandler struct {
+	refundUseCase payment.RefundUseCase
+}
+
+// NewRefundHandler creates a new RefundHandler instance
+func NewRefundHandler(refundUseCase payment.RefundUseCase) RefundHandler {
+	return &refundHandler{
+		refundUseCase: refundUseCase,
+	}
+}
+
+// CreateRefund handles POST /api/v1/admin/payments/transactions/:id/refunds
+// @Summary Refund a transaction
+// @Description Records a refund or chargeback; a full refund or a chargeback revokes the subscription period or series purchase.
+// @Description A card refund the provider has not confirmed yet is returned as pending with 202 and settled by reconciliation
+// @Tags Payments
+// @Accept json
+// @Produce json
+// @Param id path string true "Transaction ID"
+// @Param body body dto.CreateRefundRequest true "Refund"
+// @Success 201 {object} dto.RefundResponse
+// @Success 202 {object} dto.RefundResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/admin/payments/transactions/{id}/refunds [post]
+func (h *refundHandler) CreateRefund(c *gin.Context) {
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid transaction ID")
+		return
+	}
+
+	var req dto.CreateRefundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	adminIDVal, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return
+	}
+	adminID, ok := adminIDVal.(uuid.UUID)
+	if !ok {
+		response.Unauthorized(c, "invalid user ID")
+		return
+	}
+
+	resp, err := h.refundUseCase.Refund(c.Request.Context(), transactionID, adminID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrTransactionNotFound):
+			response.NotFound(c, err.Error())
+		case errors.Is(err, service.ErrInvalidRefundAmount):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, service.ErrTransactionNotRefundable), errors.Is(err, service.ErrRefundConflict),
+			errors.Is(err, service.ErrRefundProviderMissing), errors.Is(err, service.ErrRefundDeclined):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	if resp.Status == entity.RefundStatusPending {
+		response.Success(c, http.StatusAccepted, resp)
+		return
+	}
+	response.Success(c, http.StatusCreated, resp)
+}
+
+// ListRefunds handles GET /api/v1/admin/payments/transactions/:id/refunds
+// @Summary List refunds of a transaction
+// @Description Returns the refund and chargeback history of a transaction, oldest first
+// @Tags Payments
+// @Produce json
+// @Param id path string true "Transaction ID"
+// @Success 200 {array} dto.RefundResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/admin/payments/transactions/{id}/refunds [get]
+func (h *refundHandler) ListRefunds(c *gin.Context) {
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid transaction ID")
+		return
+	}
+
+	resp, err := h.refundUseCase.List(c.Request.Context(), transactionID)
+	if err != nil {
+		if errors.Is(err, service.ErrTransactionNotFound) {
+			response.NotFound(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
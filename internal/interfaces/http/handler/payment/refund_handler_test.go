@@ -0,0 +1,148 @@
+package payment_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/interfaces/http/handler/payment"
+	"github.com/aiagent/internal/interfaces/http/handler/payment/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupRefundTest(t *testing.T) (*gomock.Controller, *mocks.MockRefundUseCase, *gin.Engine, uuid.UUID) {
+	ctrl := gomock.NewController(t)
+	mockUC := mocks.NewMockRefundUseCase(ctrl)
+	h := payment.NewRefundHandler(mockUC)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	adminID := uuid.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("userID", adminID)
+		c.Next()
+	})
+	r.GET("/api/v1/admin/payments/transactions/:id/refunds", h.ListRefunds)
+	r.POST("/api/v1/admin/payments/transactions/:id/refunds", h.CreateRefund)
+
+	return ctrl, mockUC, r, adminID
+}
+
+func TestRefundHandler_CreateRefund(t *testing.T) {
+	ctrl, mockUC, r, adminID := setupRefundTest(t)
+	defer ctrl.Finish()
+
+	txID := uuid.New()
+	url := "/api/v1/admin/payments/transactions/" + txID.String() + "/refunds"
+
+	post := func(body interface{}) *httptest.ResponseRecorder {
+		raw, _ := json.Marshal(body)
+		req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(raw))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("partial_refund", func(t *testing.T) {
+		amount := decimal.NewFromInt(20000)
+		mockUC.EXPECT().Refund(gomock.Any(), txID, adminID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ uuid.UUID, req dto.CreateRefundRequest) (*dto.RefundResponse, error) {
+				assert.Equal(t, "refund", req.Kind)
+				assert.True(t, amount.Equal(*req.Amount))
+				return &dto.RefundResponse{TransactionID: txID, Kind: entity.RefundKindRefund, Amount: amount}, nil
+			})
+
+		w := post(dto.CreateRefundRequest{Kind: "refund", Amount: &amount, Reason: "missing chapter"})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("invalid_kind", func(t *testing.T) {
+		w := post(dto.CreateRefundRequest{Kind: "void", Reason: "x"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("missing_reason", func(t *testing.T) {
+		w := post(dto.CreateRefundRequest{Kind: "chargeback"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("amount_too_large", func(t *testing.T) {
+		mockUC.EXPECT().Refund(gomock.Any(), txID, adminID, gomock.Any()).Return(nil, service.ErrInvalidRefundAmount)
+
+		w := post(dto.CreateRefundRequest{Kind: "refund", Reason: "x"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mockUC.EXPECT().Refund(gomock.Any(), txID, adminID, gomock.Any()).Return(nil, service.ErrTransactionNotFound)
+
+		w := post(dto.CreateRefundRequest{Kind: "refund", Reason: "x"})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("already_refunded", func(t *testing.T) {
+		mockUC.EXPECT().Refund(gomock.Any(), txID, adminID, gomock.Any()).Return(nil, service.ErrTransactionNotRefundable)
+
+		w := post(dto.CreateRefundRequest{Kind: "chargeback", Reason: "x"})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("pending_card_refund", func(t *testing.T) {
+		mockUC.EXPECT().Refund(gomock.Any(), txID, adminID, gomock.Any()).
+			Return(&dto.RefundResponse{TransactionID: txID, Kind: entity.RefundKindRefund, Status: entity.RefundStatusPending}, nil)
+
+		w := post(dto.CreateRefundRequest{Kind: "refund", Reason: "x"})
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+
+	t.Run("declined_by_provider", func(t *testing.T) {
+		mockUC.EXPECT().Refund(gomock.Any(), txID, adminID, gomock.Any()).Return(nil, service.ErrRefundDeclined)
+
+		w := post(dto.CreateRefundRequest{Kind: "refund", Reason: "x"})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestRefundHandler_ListRefunds(t *testing.T) {
+	ctrl, mockUC, r, _ := setupRefundTest(t)
+	defer ctrl.Finish()
+
+	t.Run("success", func(t *testing.T) {
+		txID := uuid.New()
+		mockUC.EXPECT().List(gomock.Any(), txID).Return([]dto.RefundResponse{{TransactionID: txID}}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/payments/transactions/"+txID.String()+"/refunds", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("invalid_id", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/payments/transactions/abc/refunds", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,91 @@
+package payment
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/application/usecase/payment"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/pkg/response"
+	"github.com/gin-gonic/gin"
+)
+
+// ReportHandler handles admin access to the daily payment reconciliation reports
+type ReportHandler interface {
+	ListReports(c *gin.Context)
+	GetReport(c *gin.Context)
+}
+
+type reportHandler struct {
+	reportUseCase payment.ReportUseCase
+}
+
+// NewReportHandler creates a new ReportHandler instance
+func NewReportHandler(reportUseCase payment.ReportUseCase) ReportHandler {
+	return &reportHandler{
+		reportUseCase: reportUseCase,
+	}
+}
+
+// ListReports handles GET /api/v1/admin/payments/reports
+// @Summary List payment reconciliation reports
+// @Description Lists the daily reconciliations of orders against SePay, most recent day first
+// @Tags Payments
+// @Produce json
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} dto.PaymentReportListResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/admin/payments/reports [get]
+func (h *reportHandler) ListReports(c *gin.Context) {
+	var req dto.PaymentReportListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.reportUseCase.List(c.Request.Context(), req)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// GetReport handles GET /api/v1/admin/payments/reports/:day
+// @Summary Get a payment reconciliation report
+// @Description Returns the reconciliation of one day with every mismatched payment
+// @Tags Payments
+// @Produce json
+// @Param day path string true "Day (YYYY-MM-DD)"
+// @Success 200 {object} dto.PaymentReportResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/admin/payments/reports/{day} [get]
+func (h *reportHandler) GetReport(c *gin.Context) {
+	day := c.Param("day")
+	if _, err := time.Parse("2006-01-02", day); err != nil {
+		response.BadRequest(c, "day must be formatted as YYYY-MM-DD")
+		return
+	}
+
+	resp, err := h.reportUseCase.Get(c.Request.Context(), day)
+	if err != nil {
+		if errors.Is(err, service.ErrPaymentReportNotFound) {
+			response.NotFound(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
@@ -0,0 +1,70 @@
+package payment_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aiagent/internal/application/dto"
+	"github.com/aiagent/internal/domain/service"
+	"github.com/aiagent/internal/interfaces/http/handler/payment"
+	"github.com/aiagent/internal/interfaces/http/handler/payment/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupReportTest(t *testing.T) (*gomock.Controller, *mocks.MockReportUseCase, *gin.Engine) {
+	ctrl := gomock.NewController(t)
+	mockUC := mocks.NewMockReportUseCase(ctrl)
+	h := payment.NewReportHandler(mockUC)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/api/v1/admin/payments/reports", h.ListReports)
+	r.GET("/api/v1/admin/payments/reports/:day", h.GetReport)
+
+	return ctrl, mockUC, r
+}
+
+func TestReportHandler_ListReports(t *testing.T) {
+	ctrl, mockUC, r := setupReportTest(t)
+	defer ctrl.Finish()
+
+	mockUC.EXPECT().List(gomock.Any(), dto.PaymentReportListRequest{Page: 2, PageSize: 20}).
+		Return(&dto.PaymentReportListResponse{Reports: []dto.PaymentReportResponse{{Day: "2026-10-15"}}, Page: 2, PageSize: 20}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/payments/reports?page=2", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestReportHandler_GetReport(t *testing.T) {
+	ctrl, mockUC, r := setupReportTest(t)
+	defer ctrl.Finish()
+
+	get := func(day string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/payments/reports/"+day, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockUC.EXPECT().Get(gomock.Any(), "2026-10-15").Return(&dto.PaymentReportResponse{Day: "2026-10-15"}, nil)
+
+		assert.Equal(t, http.StatusOK, get("2026-10-15").Code)
+	})
+
+	t.Run("invalid_day", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, get("yesterday").Code)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mockUC.EXPECT().Get(gomock.Any(), "2020-01-01").Return(nil, service.ErrPaymentReportNotFound)
+
+		assert.Equal(t, http.StatusNotFound, get("2020-01-01").Code)
+	})
+}
@@ -1,18 +1,26 @@
 package payment
 
 import (
+	"errors"
+	"io"
 	"net/http"
 	"strings"
 
 	"github.com/aiagent/internal/application/dto"
 	"github.com/aiagent/internal/application/usecase/payment"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/infrastructure/adapter"
 	"github.com/aiagent/pkg/response"
 	"github.com/gin-gonic/gin"
 )
 
+// maxWebhookBody bounds the size of a provider webhook read into memory
+const maxWebhookBody = 1 << 20
+
 // WebhookHandler defines the interface for webhook handlers
 type WebhookHandler interface {
 	HandleSePayWebhook(c *gin.Context)
+	HandleStripeWebhook(c *gin.Context)
 }
 
 type webhookHandler struct {
@@ -55,7 +63,8 @@ func (h *webhookHandler) HandleSePayWebhook(c *gin.Context) {
 		}
 	}
 
-	if apiKey != h.sepayAPIKey {
+	// Without a configured key no request can be authenticated
+	if h.sepayAPIKey == "" || apiKey != h.sepayAPIKey {
 		response.Unauthorized(c, "invalid or missing API key")
 		return
 	}
@@ -73,6 +82,54 @@ func (h *webhookHandler) HandleSePayWebhook(c *gin.Context) {
 		response.InternalServerError(c, err.Error())
 		return
 	}
+	if tx == nil {
+		response.Success(c, http.StatusOK, map[string]interface{}{"ignored": true})
+		return
+	}
+
+	response.Success(c, http.StatusOK, map[string]interface{}{
+		"transactionId": tx.ID,
+		"status":        tx.Status,
+	})
+}
+
+// HandleStripeWebhook handles POST /api/v1/webhooks/stripe
+// @Summary Handle Stripe webhook callback
+// @Description Settles card orders from signed checkout events; other events are acknowledged and ignored
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param Stripe-Signature header string true "t=<timestamp>,v1=<HMAC-SHA256 signature>"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/webhooks/stripe [post]
+func (h *webhookHandler) HandleStripeWebhook(c *gin.Context) {
+	h.handleProviderWebhook(c, entity.TransactionProviderStripe, c.GetHeader("Stripe-Signature"))
+}
+
+// handleProviderWebhook passes the raw body to the provider, which signs it byte for byte
+func (h *webhookHandler) handleProviderWebhook(c *gin.Context, provider entity.TransactionProvider, signature string) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxWebhookBody))
+	if err != nil {
+		response.BadRequest(c, "failed to read webhook body")
+		return
+	}
+
+	tx, err := h.processWebhookUseCase.ExecuteProvider(c.Request.Context(), provider, body, signature)
+	if err != nil {
+		if errors.Is(err, adapter.ErrInvalidWebhookSignature) {
+			response.Unauthorized(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+	if tx == nil {
+		response.Success(c, http.StatusOK, map[string]interface{}{"ignored": true})
+		return
+	}
 
 	response.Success(c, http.StatusOK, map[string]interface{}{
 		"transactionId": tx.ID,
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aiagent/internal/application/dto"
 	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/infrastructure/adapter"
 	"github.com/aiagent/internal/interfaces/http/handler/payment"
 	"github.com/aiagent/internal/interfaces/http/handler/payment/mocks"
 	"github.com/gin-gonic/gin"
@@ -132,6 +133,41 @@ func TestWebhookHandler_HandleSePayWebhook(t *testing.T) {
 		assert.Equal(t, false, resp["success"])
 	})
 
+	t.Run("no API key configured", func(t *testing.T) {
+		unkeyed := payment.NewWebhookHandler(mockUC, "")
+		unkeyedRouter := gin.New()
+		unkeyedRouter.POST("/api/v1/webhooks/sepay", unkeyed.HandleSePayWebhook)
+
+		body, _ := json.Marshal(dto.ProcessWebhookRequest{ID: 12345, TransferType: "in"})
+		httpReq, _ := http.NewRequest("POST", "/api/v1/webhooks/sepay", bytes.NewBuffer(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		httpReq.Header.Set("Authorization", "Bearer ")
+		w := httptest.NewRecorder()
+		unkeyedRouter.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("outgoing transfer ignored", func(t *testing.T) {
+		body, _ := json.Marshal(dto.ProcessWebhookRequest{ID: 12346, TransferType: "out", TransferAmount: decimal.NewFromInt(100000)})
+
+		mockUC.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+
+		httpReq, _ := http.NewRequest("POST", "/api/v1/webhooks/sepay", bytes.NewBuffer(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		httpReq.Header.Set("Authorization", "Bearer "+validAPIKey)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, map[string]interface{}{"ignored": true}, resp["data"])
+	})
+
 	t.Run("invalid JSON body", func(t *testing.T) {
 		httpReq, _ := http.NewRequest("POST", "/api/v1/webhooks/sepay", bytes.NewBuffer([]byte("invalid json")))
 		httpReq.Header.Set("Content-Type", "application/json")
@@ -172,3 +208,68 @@ func TestWebhookHandler_HandleSePayWebhook(t *testing.T) {
 		assert.Equal(t, false, resp["success"])
 	})
 }
+
+func TestWebhookHandler_HandleStripeWebhook(t *testing.T) {
+	ctrl, mockUC, r, h := setupWebhookTest(t)
+	defer ctrl.Finish()
+
+	r.POST("/api/v1/webhooks/stripe", h.HandleStripeWebhook)
+
+	body := []byte(`{"id":"evt_1","type":"checkout.session.completed"}`)
+	signature := "t=1700000000,v1=abc"
+
+	t.Run("success", func(t *testing.T) {
+		expectedTx := &entity.Transaction{
+			ID:       uuid.New(),
+			Currency: "USD",
+			Provider: entity.TransactionProviderStripe,
+			Status:   entity.TransactionStatusSuccess,
+		}
+
+		mockUC.EXPECT().
+			ExecuteProvider(gomock.Any(), entity.TransactionProviderStripe, body, signature).
+			Return(expectedTx, nil)
+
+		httpReq, _ := http.NewRequest("POST", "/api/v1/webhooks/stripe", bytes.NewBuffer(body))
+		httpReq.Header.Set("Stripe-Signature", signature)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		data := resp["data"].(map[string]interface{})
+		assert.Equal(t, expectedTx.ID.String(), data["transactionId"])
+	})
+
+	t.Run("ignored event", func(t *testing.T) {
+		mockUC.EXPECT().
+			ExecuteProvider(gomock.Any(), entity.TransactionProviderStripe, body, signature).
+			Return(nil, nil)
+
+		httpReq, _ := http.NewRequest("POST", "/api/v1/webhooks/stripe", bytes.NewBuffer(body))
+		httpReq.Header.Set("Stripe-Signature", signature)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		data := resp["data"].(map[string]interface{})
+		assert.Equal(t, true, data["ignored"])
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		mockUC.EXPECT().
+			ExecuteProvider(gomock.Any(), entity.TransactionProviderStripe, body, "").
+			Return(nil, adapter.ErrInvalidWebhookSignature)
+
+		httpReq, _ := http.NewRequest("POST", "/api/v1/webhooks/stripe", bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httpReq)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
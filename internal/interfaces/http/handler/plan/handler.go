@@ -11,6 +11,9 @@ type PlanHandler interface {
 	// GetAuthorPlans retrieves all plans for a specific author
 	GetAuthorPlans(c *gin.Context)
 
+	// QuoteSubscription prices one of an author's plans for the current user
+	QuoteSubscription(c *gin.Context)
+
 	// AssignTagToTier assigns a tag to a subscription tier for the current author
 	AssignTagToTier(c *gin.Context)
 
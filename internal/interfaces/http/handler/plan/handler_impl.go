@@ -1,7 +1,9 @@
 package plan
 
 import (
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/aiagent/internal/application/dto"
 	"github.com/aiagent/internal/domain/entity"
@@ -13,9 +15,10 @@ import (
 )
 
 type planHandler struct {
-	planService   service.PlanManagementService
-	tagService    service.TagTierService
-	accessService service.ContentAccessService
+	planService    service.PlanManagementService
+	tagService     service.TagTierService
+	accessService  service.ContentAccessService
+	pricingService service.SubscriptionPricingService
 }
 
 // NewPlanHandler creates a new PlanHandler instance
@@ -23,11 +26,13 @@ func NewPlanHandler(
 	planService service.PlanManagementService,
 	tagService service.TagTierService,
 	accessService service.ContentAccessService,
+	pricingService service.SubscriptionPricingService,
 ) PlanHandler {
 	return &planHandler{
-		planService:   planService,
-		tagService:    tagService,
-		accessService: accessService,
+		planService:    planService,
+		tagService:     tagService,
+		accessService:  accessService,
+		pricingService: pricingService,
 	}
 }
 
@@ -146,6 +151,7 @@ func (h *planHandler) GetAuthorPlans(c *gin.Context) {
 	planResponses := make([]dto.PlanWithTagsResponse, len(plansWithTags))
 	for i, p := range plansWithTags {
 		planResponses[i] = dto.PlanWithTagsResponse{
+			ID:           p.Plan.ID,
 			Tier:         string(p.Plan.Tier),
 			Price:        p.Plan.Price,
 			DurationDays: p.Plan.DurationDays,
@@ -164,6 +170,78 @@ func (h *planHandler) GetAuthorPlans(c *gin.Context) {
 	response.Success(c, http.StatusOK, resp)
 }
 
+// QuoteSubscription godoc
+// @Summary Quote a subscription plan
+// @Description Price one of an author's plans for the current user. Upgrades are credited with the
+// @Description unused days of the current tier; downgrades start when the current period ends.
+// @Tags Plans
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param authorId path string true "Author ID"
+// @Param planId path string true "Plan ID"
+// @Success 200 {object} dto.SubscriptionQuoteResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/authors/{authorId}/plans/{planId}/quote [get]
+func (h *planHandler) QuoteSubscription(c *gin.Context) {
+	userIDVal, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return
+	}
+
+	userID, ok := userIDVal.(uuid.UUID)
+	if !ok {
+		response.Unauthorized(c, "invalid user ID")
+		return
+	}
+
+	authorID, err := uuid.Parse(c.Param("authorId"))
+	if err != nil {
+		response.BadRequest(c, "invalid author ID")
+		return
+	}
+
+	planID, err := uuid.Parse(c.Param("planId"))
+	if err != nil {
+		response.BadRequest(c, "invalid plan ID")
+		return
+	}
+
+	quote, err := h.pricingService.Quote(c.Request.Context(), userID, authorID, planID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrPlanNotFound):
+			response.NotFound(c, err.Error())
+		case errors.Is(err, service.ErrCannotSubscribeToSelf):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, service.ErrSubscriptionChangePending):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	resp := dto.SubscriptionQuoteResponse{
+		PlanID:      quote.Plan.ID,
+		Tier:        string(quote.Plan.Tier),
+		CurrentTier: string(quote.CurrentTier),
+		Change:      string(quote.Change),
+		Price:       quote.Plan.Price,
+		Credit:      quote.Credit,
+		AmountDue:   quote.AmountDue,
+		StartsAt:    quote.StartsAt.Format(time.RFC3339),
+		ExpiresAt:   quote.ExpiresAt.Format(time.RFC3339),
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
 // AssignTagToTier godoc
 // @Summary Assign a tag to a subscription tier
 // @Description Set which subscription tier is required to access content with this tag
@@ -365,20 +443,37 @@ func (h *planHandler) CheckBlogAccess(c *gin.Context) {
 	upgradeOptions := make([]dto.UpgradeOption, len(result.UpgradeOptions))
 	for i, uo := range result.UpgradeOptions {
 		price, _ := decimal.NewFromString(uo.Price)
+		amountDue, err := decimal.NewFromString(uo.AmountDue)
+		if err != nil {
+			amountDue = price
+		}
 		upgradeOptions[i] = dto.UpgradeOption{
 			Tier:         string(uo.Tier),
 			Price:        price,
+			AmountDue:    amountDue,
 			DurationDays: uo.DurationDays,
 			PlanID:       uo.PlanID,
 		}
 	}
 
+	seriesOptions := make([]dto.SeriesPurchaseOption, len(result.SeriesOptions))
+	for i, so := range result.SeriesOptions {
+		price, _ := decimal.NewFromString(so.Price)
+		seriesOptions[i] = dto.SeriesPurchaseOption{
+			SeriesID: so.SeriesID,
+			Title:    so.Title,
+			Price:    price,
+			Currency: so.Currency,
+		}
+	}
+
 	resp := dto.CheckBlogAccessResponse{
 		Accessible:     result.Accessible,
 		UserTier:       string(result.UserTier),
 		RequiredTier:   string(result.RequiredTier),
 		Reason:         result.Reason,
 		UpgradeOptions: upgradeOptions,
+		SeriesOptions:  seriesOptions,
 	}
 
 	response.Success(c, http.StatusOK, resp)
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/aiagent/internal/application/dto"
 	"github.com/aiagent/internal/domain/entity"
@@ -35,7 +36,8 @@ func TestPlanHandler_UpsertPlans(t *testing.T) {
 	mockPlanService := mocks.NewMockPlanManagementService(ctrl)
 	mockTagService := mocks.NewMockTagTierService(ctrl)
 	mockAccessService := mocks.NewMockContentAccessService(ctrl)
-	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService)
+	mockPricingService := mocks.NewMockSubscriptionPricingService(ctrl)
+	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService, mockPricingService)
 
 	t.Run("success", func(t *testing.T) {
 		r, w := setupRouter()
@@ -181,7 +183,8 @@ func TestPlanHandler_GetAuthorPlans(t *testing.T) {
 	mockPlanService := mocks.NewMockPlanManagementService(ctrl)
 	mockTagService := mocks.NewMockTagTierService(ctrl)
 	mockAccessService := mocks.NewMockContentAccessService(ctrl)
-	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService)
+	mockPricingService := mocks.NewMockSubscriptionPricingService(ctrl)
+	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService, mockPricingService)
 
 	t.Run("success", func(t *testing.T) {
 		r, w := setupRouter()
@@ -248,6 +251,87 @@ func TestPlanHandler_GetAuthorPlans(t *testing.T) {
 	})
 }
 
+func TestPlanHandler_QuoteSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPlanService := mocks.NewMockPlanManagementService(ctrl)
+	mockTagService := mocks.NewMockTagTierService(ctrl)
+	mockAccessService := mocks.NewMockContentAccessService(ctrl)
+	mockPricingService := mocks.NewMockSubscriptionPricingService(ctrl)
+	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService, mockPricingService)
+
+	userID := uuid.New()
+	authorID := uuid.New()
+	planID := uuid.New()
+	path := "/authors/" + authorID.String() + "/plans/" + planID.String() + "/quote"
+
+	newRouter := func() (*gin.Engine, *httptest.ResponseRecorder) {
+		r, w := setupRouter()
+		r.GET("/authors/:authorId/plans/:planId/quote", func(c *gin.Context) {
+			c.Set("userID", userID)
+			handler.QuoteSubscription(c)
+		})
+		return r, w
+	}
+
+	t.Run("success", func(t *testing.T) {
+		r, w := newRouter()
+		now := time.Now()
+		mockPricingService.EXPECT().Quote(gomock.Any(), userID, authorID, planID).Return(&service.SubscriptionQuote{
+			Plan:        &entity.SubscriptionPlan{ID: planID, Tier: entity.TierSilver, Price: decimal.NewFromInt(90000)},
+			Change:      service.SubscriptionChangeUpgrade,
+			CurrentTier: entity.TierBronze,
+			Credit:      decimal.NewFromInt(10000),
+			AmountDue:   decimal.NewFromInt(80000),
+			StartsAt:    now,
+			ExpiresAt:   now.AddDate(0, 0, 30),
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp struct {
+			Data dto.SubscriptionQuoteResponse `json:"data"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, "upgrade", resp.Data.Change)
+		assert.Equal(t, "BRONZE", resp.Data.CurrentTier)
+		assert.Equal(t, "80000", resp.Data.AmountDue.String())
+	})
+
+	t.Run("plan_not_found", func(t *testing.T) {
+		r, w := newRouter()
+		mockPricingService.EXPECT().Quote(gomock.Any(), userID, authorID, planID).Return(nil, service.ErrPlanNotFound)
+
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("change_pending", func(t *testing.T) {
+		r, w := newRouter()
+		mockPricingService.EXPECT().Quote(gomock.Any(), userID, authorID, planID).Return(nil, service.ErrSubscriptionChangePending)
+
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("invalid_plan_id", func(t *testing.T) {
+		r, w := newRouter()
+
+		req, _ := http.NewRequest(http.MethodGet, "/authors/"+authorID.String()+"/plans/invalid-id/quote", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
 func TestPlanHandler_AssignTagToTier(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -255,7 +339,8 @@ func TestPlanHandler_AssignTagToTier(t *testing.T) {
 	mockPlanService := mocks.NewMockPlanManagementService(ctrl)
 	mockTagService := mocks.NewMockTagTierService(ctrl)
 	mockAccessService := mocks.NewMockContentAccessService(ctrl)
-	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService)
+	mockPricingService := mocks.NewMockSubscriptionPricingService(ctrl)
+	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService, mockPricingService)
 
 	t.Run("success", func(t *testing.T) {
 		r, w := setupRouter()
@@ -380,7 +465,8 @@ func TestPlanHandler_UnassignTagFromTier(t *testing.T) {
 	mockPlanService := mocks.NewMockPlanManagementService(ctrl)
 	mockTagService := mocks.NewMockTagTierService(ctrl)
 	mockAccessService := mocks.NewMockContentAccessService(ctrl)
-	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService)
+	mockPricingService := mocks.NewMockSubscriptionPricingService(ctrl)
+	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService, mockPricingService)
 
 	t.Run("success", func(t *testing.T) {
 		r, w := setupRouter()
@@ -460,7 +546,8 @@ func TestPlanHandler_GetAuthorTagTiers(t *testing.T) {
 	mockPlanService := mocks.NewMockPlanManagementService(ctrl)
 	mockTagService := mocks.NewMockTagTierService(ctrl)
 	mockAccessService := mocks.NewMockContentAccessService(ctrl)
-	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService)
+	mockPricingService := mocks.NewMockSubscriptionPricingService(ctrl)
+	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService, mockPricingService)
 
 	t.Run("success", func(t *testing.T) {
 		r, w := setupRouter()
@@ -533,7 +620,8 @@ func TestPlanHandler_CheckBlogAccess(t *testing.T) {
 	mockPlanService := mocks.NewMockPlanManagementService(ctrl)
 	mockTagService := mocks.NewMockTagTierService(ctrl)
 	mockAccessService := mocks.NewMockContentAccessService(ctrl)
-	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService)
+	mockPricingService := mocks.NewMockSubscriptionPricingService(ctrl)
+	handler := NewPlanHandler(mockPlanService, mockTagService, mockAccessService, mockPricingService)
 
 	t.Run("success_authenticated_user_with_access", func(t *testing.T) {
 		r, w := setupRouter()
@@ -88,6 +88,18 @@ func (mr *MockPlanHandlerMockRecorder) GetAuthorTagTiers(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorTagTiers", reflect.TypeOf((*MockPlanHandler)(nil).GetAuthorTagTiers), c)
 }
 
+// QuoteSubscription mocks base method.
+func (m *MockPlanHandler) QuoteSubscription(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "QuoteSubscription", c)
+}
+
+// QuoteSubscription indicates an expected call of QuoteSubscription.
+func (mr *MockPlanHandlerMockRecorder) QuoteSubscription(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteSubscription", reflect.TypeOf((*MockPlanHandler)(nil).QuoteSubscription), c)
+}
+
 // UnassignTagFromTier mocks base method.
 func (m *MockPlanHandler) UnassignTagFromTier(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -118,15 +118,16 @@ func (h *profileHandler) UpdateMyProfile(c *gin.Context) {
 
 // UploadAvatar godoc
 // @Summary Upload avatar
-// @Description Upload a new avatar image for the current user
+// @Description Upload a new avatar image for the current user. The image type is checked from its content, metadata is stripped and resized copies are generated.
 // @Tags Profile
 // @Accept multipart/form-data
 // @Produce json
 // @Security Bearer
-// @Param avatar formData file true "Avatar image file (max 5MB, jpg/png/gif/webp)"
+// @Param avatar formData file true "Avatar image file (jpg/png/gif/webp)"
 // @Success 200 {object} response.Response{data=dto.AvatarUploadResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
 // @Router /api/v1/profile/avatar [post]
 func (h *profileHandler) UploadAvatar(c *gin.Context) {
 	userID, exists := c.Get("userID")
@@ -151,9 +152,11 @@ func (h *profileHandler) UploadAvatar(c *gin.Context) {
 	if err != nil {
 		switch {
 		case errors.Is(err, profile.ErrFileTooLarge):
-			response.BadRequest(c, "File too large, max 5MB allowed")
+			response.BadRequest(c, "File or image dimensions too large")
 		case errors.Is(err, profile.ErrInvalidFileType):
 			response.BadRequest(c, "Invalid file type, allowed: jpg, jpeg, png, gif, webp")
+		case errors.Is(err, profile.ErrMediaQuotaExceeded):
+			response.Forbidden(c, "Media storage quota exceeded")
 		case errors.Is(err, profile.ErrUserNotFound):
 			response.NotFound(c, "User not found")
 		default:
@@ -0,0 +1,9 @@
+package search
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_$GOFILE -package=mocks
+
+import "github.com/gin-gonic/gin"
+
+type SearchHandler interface {
+	Search(c *gin.Context)
+}
@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: definition.go
+//
+// Generated by this command:
+//
+//	mockgen -source=definition.go -destination=mocks/mock_definition.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSearchHandler is a mock of SearchHandler interface.
+type MockSearchHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchHandlerMockRecorder is the mock recorder for MockSearchHandler.
+type MockSearchHandlerMockRecorder struct {
+	mock *MockSearchHandler
+}
+
+// NewMockSearchHandler creates a new mock instance.
+func NewMockSearchHandler(ctrl *gomock.Controller) *MockSearchHandler {
+	mock := &MockSearchHandler{ctrl: ctrl}
+	mock.recorder = &MockSearchHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchHandler) EXPECT() *MockSearchHandlerMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearchHandler) Search(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Search", c)
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchHandlerMockRecorder) Search(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchHandler)(nil).Search), c)
+}
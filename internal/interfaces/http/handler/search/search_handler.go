@@ -0,0 +1,69 @@
+package search
+
+import (
+	"net/http"
+
+	"github.com/aiagent/internal/application/dto"
+	searchUsecase "github.com/aiagent/internal/application/usecase/search"
+	"github.com/aiagent/pkg/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type searchHandler struct {
+	searchUseCase searchUsecase.SearchUseCase
+}
+
+func NewSearchHandler(searchUseCase searchUsecase.SearchUseCase) SearchHandler {
+	return &searchHandler{
+		searchUseCase: searchUseCase,
+	}
+}
+
+// Search godoc
+// @Summary Search
+// @Description Full-text search over blogs, series, tags and authors. Blog results are ranked,
+// @Description highlighted with <mark> and faceted by category, tag and author. Signed-in
+// @Description viewers also find their own drafts and posts of authors they subscribe to.
+// @Tags Search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query (supports quoted phrases, OR and -exclusions)"
+// @Param type query string false "Result type (all, blogs, series, tags, authors)" default(all)
+// @Param authorId query string false "Filter blogs by author ID"
+// @Param categoryId query string false "Filter blogs by category ID"
+// @Param tagIds query []string false "Filter blogs by tag IDs"
+// @Param page query int false "Page number of blog results" default(1)
+// @Param pageSize query int false "Page size" default(10)
+// @Success 200 {object} dto.SearchResponse
+// @Failure 400 {object} response.Response
+// @Router /api/v1/search [get]
+func (h *searchHandler) Search(c *gin.Context) {
+	var params dto.SearchParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	// Set defaults
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.PageSize < 1 || params.PageSize > 50 {
+		params.PageSize = 10
+	}
+
+	var viewerID *uuid.UUID
+	if userID, exists := c.Get("userID"); exists {
+		uid := userID.(uuid.UUID)
+		viewerID = &uid
+	}
+
+	result, err := h.searchUseCase.Search(c.Request.Context(), &params, viewerID)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, result)
+}
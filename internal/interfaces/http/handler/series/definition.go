@@ -14,5 +14,9 @@ type SeriesHandler interface {
 	List(c *gin.Context)
 	AddBlog(c *gin.Context)
 	RemoveBlog(c *gin.Context)
+	ReorderBlogs(c *gin.Context)
+	SetBlogPreview(c *gin.Context)
+	GetProgress(c *gin.Context)
+	GetLibrary(c *gin.Context)
 	GetHighlightedSeries(c *gin.Context)
 }
@@ -112,6 +112,30 @@ func (mr *MockSeriesHandlerMockRecorder) GetHighlightedSeries(c any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighlightedSeries", reflect.TypeOf((*MockSeriesHandler)(nil).GetHighlightedSeries), c)
 }
 
+// GetLibrary mocks base method.
+func (m *MockSeriesHandler) GetLibrary(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetLibrary", c)
+}
+
+// GetLibrary indicates an expected call of GetLibrary.
+func (mr *MockSeriesHandlerMockRecorder) GetLibrary(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLibrary", reflect.TypeOf((*MockSeriesHandler)(nil).GetLibrary), c)
+}
+
+// GetProgress mocks base method.
+func (m *MockSeriesHandler) GetProgress(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetProgress", c)
+}
+
+// GetProgress indicates an expected call of GetProgress.
+func (mr *MockSeriesHandlerMockRecorder) GetProgress(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProgress", reflect.TypeOf((*MockSeriesHandler)(nil).GetProgress), c)
+}
+
 // List mocks base method.
 func (m *MockSeriesHandler) List(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -136,6 +160,30 @@ func (mr *MockSeriesHandlerMockRecorder) RemoveBlog(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlog", reflect.TypeOf((*MockSeriesHandler)(nil).RemoveBlog), c)
 }
 
+// ReorderBlogs mocks base method.
+func (m *MockSeriesHandler) ReorderBlogs(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReorderBlogs", c)
+}
+
+// ReorderBlogs indicates an expected call of ReorderBlogs.
+func (mr *MockSeriesHandlerMockRecorder) ReorderBlogs(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderBlogs", reflect.TypeOf((*MockSeriesHandler)(nil).ReorderBlogs), c)
+}
+
+// SetBlogPreview mocks base method.
+func (m *MockSeriesHandler) SetBlogPreview(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBlogPreview", c)
+}
+
+// SetBlogPreview indicates an expected call of SetBlogPreview.
+func (mr *MockSeriesHandlerMockRecorder) SetBlogPreview(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlogPreview", reflect.TypeOf((*MockSeriesHandler)(nil).SetBlogPreview), c)
+}
+
 // Update mocks base method.
 func (m *MockSeriesHandler) Update(c *gin.Context) {
 	m.ctrl.T.Helper()
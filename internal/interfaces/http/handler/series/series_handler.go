@@ -49,6 +49,10 @@ func (h *seriesHandler) Create(c *gin.Context) {
 
 	series, err := h.seriesUseCase.CreateSeries(c.Request.Context(), authorID.(uuid.UUID), &req)
 	if err != nil {
+		if err == seriesUsecase.ErrInvalidSeriesPrice {
+			response.BadRequest(c, err.Error())
+			return
+		}
 		response.InternalServerError(c, err.Error())
 		return
 	}
@@ -91,12 +95,14 @@ func (h *seriesHandler) Update(c *gin.Context) {
 
 	series, err := h.seriesUseCase.UpdateSeries(c.Request.Context(), authorID.(uuid.UUID), id, &req)
 	if err != nil {
-		// Basic error matching, could be refined
-		if err.Error() == "unauthorized: you are not the author of this series" {
+		switch err {
+		case seriesUsecase.ErrNotSeriesAuthor:
 			response.Forbidden(c, err.Error())
-			return
+		case seriesUsecase.ErrInvalidSeriesPrice:
+			response.BadRequest(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
 		}
-		response.InternalServerError(c, err.Error())
 		return
 	}
 
@@ -129,7 +135,7 @@ func (h *seriesHandler) Delete(c *gin.Context) {
 	}
 
 	if err := h.seriesUseCase.DeleteSeries(c.Request.Context(), authorID.(uuid.UUID), id); err != nil {
-		if err.Error() == "unauthorized: you are not the author of this series" {
+		if err == seriesUsecase.ErrNotSeriesAuthor {
 			response.Forbidden(c, err.Error())
 			return
 		}
@@ -268,7 +274,7 @@ func (h *seriesHandler) AddBlog(c *gin.Context) {
 	}
 
 	if err := h.seriesUseCase.AddBlogToSeries(c.Request.Context(), authorID.(uuid.UUID), seriesID, req.BlogID); err != nil {
-		if err.Error() == "unauthorized: you are not the author of this series" {
+		if err == seriesUsecase.ErrNotSeriesAuthor {
 			response.Forbidden(c, err.Error())
 			return
 		}
@@ -312,7 +318,7 @@ func (h *seriesHandler) RemoveBlog(c *gin.Context) {
 	}
 
 	if err := h.seriesUseCase.RemoveBlogFromSeries(c.Request.Context(), authorID.(uuid.UUID), seriesID, blogID); err != nil {
-		if err.Error() == "unauthorized: you are not the author of this series" {
+		if err == seriesUsecase.ErrNotSeriesAuthor {
 			response.Forbidden(c, err.Error())
 			return
 		}
@@ -323,6 +329,174 @@ func (h *seriesHandler) RemoveBlog(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// ReorderBlogs godoc
+// @Summary Reorder chapters
+// @Description Set the chapter order of a series; blogIds must list every chapter exactly once (author only)
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param id path string true "Series ID"
+// @Param request body dto.ReorderSeriesBlogsRequest true "Blog IDs in chapter order"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/series/{id}/blogs/order [put]
+func (h *seriesHandler) ReorderBlogs(c *gin.Context) {
+	authorID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return
+	}
+
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid series ID")
+		return
+	}
+
+	var req dto.ReorderSeriesBlogsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.seriesUseCase.ReorderBlogs(c.Request.Context(), authorID.(uuid.UUID), seriesID, req.BlogIDs); err != nil {
+		switch err {
+		case seriesUsecase.ErrNotSeriesAuthor:
+			response.Forbidden(c, err.Error())
+		case seriesUsecase.ErrInvalidChapterOrder:
+			response.BadRequest(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "chapters reordered"})
+}
+
+// GetProgress godoc
+// @Summary Get reading progress
+// @Description Get how many published chapters of a series the current user has read
+// @Tags Series
+// @Produce json
+// @Param id path string true "Series ID"
+// @Success 200 {object} dto.SeriesProgressResponse
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/series/{id}/progress [get]
+func (h *seriesHandler) GetProgress(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return
+	}
+
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid series ID")
+		return
+	}
+
+	progress, err := h.seriesUseCase.GetProgress(c.Request.Context(), userID.(uuid.UUID), seriesID)
+	if err != nil {
+		switch err {
+		case seriesUsecase.ErrSeriesNotFound:
+			response.NotFound(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, progress)
+}
+
+// SetBlogPreview godoc
+// @Summary Set chapter preview
+// @Description Mark a chapter of a paid series as free to read, or lock it again (author only)
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param id path string true "Series ID"
+// @Param blogId path string true "Blog ID"
+// @Param request body dto.SetSeriesBlogPreviewRequest true "Preview flag"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/series/{id}/blogs/{blogId}/preview [put]
+func (h *seriesHandler) SetBlogPreview(c *gin.Context) {
+	authorID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return
+	}
+
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid series ID")
+		return
+	}
+
+	blogID, err := uuid.Parse(c.Param("blogId"))
+	if err != nil {
+		response.BadRequest(c, "invalid blog ID")
+		return
+	}
+
+	var req dto.SetSeriesBlogPreviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.seriesUseCase.SetBlogPreview(c.Request.Context(), authorID.(uuid.UUID), seriesID, blogID, *req.Preview); err != nil {
+		switch err {
+		case seriesUsecase.ErrNotSeriesAuthor:
+			response.Forbidden(c, err.Error())
+		case seriesUsecase.ErrBlogNotInSeries:
+			response.NotFound(c, err.Error())
+		default:
+			response.InternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"preview": *req.Preview})
+}
+
+// GetLibrary godoc
+// @Summary Get purchased series
+// @Description List the series the current user bought, newest purchase first
+// @Tags Series
+// @Produce json
+// @Success 200 {array} dto.LibraryItemResponse
+// @Failure 401 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Security Bearer
+// @Router /api/v1/me/library [get]
+func (h *seriesHandler) GetLibrary(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return
+	}
+
+	library, err := h.seriesUseCase.GetLibrary(c.Request.Context(), userID.(uuid.UUID))
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, library)
+}
+
 // GetHighlightedSeries godoc
 // @Summary Get highlighted series
 // @Description Get a list of highlighted series
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aiagent/internal/application/dto"
+	seriesUsecase "github.com/aiagent/internal/application/usecase/series"
 	"github.com/aiagent/internal/application/usecase/series/mocks"
 	"github.com/aiagent/internal/interfaces/http/handler/series"
 	"github.com/gin-gonic/gin"
@@ -86,3 +88,102 @@ func TestGetHighlightedSeries(t *testing.T) {
 		assert.False(t, response["success"].(bool))
 	})
 }
+
+func TestSetBlogPreview(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	seriesID, blogID, userID := uuid.New(), uuid.New(), uuid.New()
+	newContext := func(w *httptest.ResponseRecorder, body string) *gin.Context {
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/api/v1/series/"+seriesID.String()+"/blogs/"+blogID.String()+"/preview", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: seriesID.String()}, {Key: "blogId", Value: blogID.String()}}
+		c.Set("userID", userID)
+		return c
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := mocks.NewMockSeriesUseCase(ctrl)
+		handler := series.NewSeriesHandler(mockUseCase)
+
+		w := httptest.NewRecorder()
+		mockUseCase.EXPECT().SetBlogPreview(gomock.Any(), userID, seriesID, blogID, true).Return(nil)
+
+		handler.SetBlogPreview(newContext(w, `{"preview":true}`))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("missing flag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler := series.NewSeriesHandler(mocks.NewMockSeriesUseCase(ctrl))
+
+		w := httptest.NewRecorder()
+		handler.SetBlogPreview(newContext(w, `{}`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("blog not in series", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := mocks.NewMockSeriesUseCase(ctrl)
+		handler := series.NewSeriesHandler(mockUseCase)
+
+		w := httptest.NewRecorder()
+		mockUseCase.EXPECT().SetBlogPreview(gomock.Any(), userID, seriesID, blogID, false).Return(seriesUsecase.ErrBlogNotInSeries)
+
+		handler.SetBlogPreview(newContext(w, `{"preview":false}`))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetProgress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	seriesID, userID := uuid.New(), uuid.New()
+	newContext := func(w *httptest.ResponseRecorder) *gin.Context {
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/api/v1/series/"+seriesID.String()+"/progress", nil)
+		c.Params = gin.Params{{Key: "id", Value: seriesID.String()}}
+		c.Set("userID", userID)
+		return c
+	}
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusOK},
+		{name: "series not found", err: seriesUsecase.ErrSeriesNotFound, status: http.StatusNotFound},
+		{name: "database error", err: errors.New("connection refused"), status: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := mocks.NewMockSeriesUseCase(ctrl)
+			handler := series.NewSeriesHandler(mockUseCase)
+
+			var progress *dto.SeriesProgressResponse
+			if tt.err == nil {
+				progress = &dto.SeriesProgressResponse{SeriesID: seriesID}
+			}
+			mockUseCase.EXPECT().GetProgress(gomock.Any(), userID, seriesID).Return(progress, tt.err)
+
+			w := httptest.NewRecorder()
+			handler.GetProgress(newContext(w))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/aiagent/internal/application/usecase/subscription"
+	"github.com/aiagent/internal/domain/valueobject"
 	"github.com/aiagent/pkg/response"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -39,13 +40,19 @@ func (h *subscriptionHandler) Subscribe(c *gin.Context) {
 		return
 	}
 
-	authorID, err := uuid.Parse(c.Param("authorId"))
+	authorID, err := uuid.Parse(authorParam(c))
 	if err != nil {
 		response.BadRequest(c, "invalid author ID")
 		return
 	}
 
-	sub, err := h.subscriptionUseCase.Subscribe(c.Request.Context(), subscriberID.(uuid.UUID), authorID)
+	client := valueobject.ClientInfo{
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Referrer:  c.Request.Referer(),
+	}
+
+	sub, err := h.subscriptionUseCase.Subscribe(c.Request.Context(), subscriberID.(uuid.UUID), authorID, client)
 	if err != nil {
 		switch err {
 		case subscription.ErrCannotSubscribeToSelf:
@@ -79,7 +86,7 @@ func (h *subscriptionHandler) Unsubscribe(c *gin.Context) {
 		return
 	}
 
-	authorID, err := uuid.Parse(c.Param("authorId"))
+	authorID, err := uuid.Parse(authorParam(c))
 	if err != nil {
 		response.BadRequest(c, "invalid author ID")
 		return
@@ -155,7 +162,7 @@ func (h *subscriptionHandler) GetMySubscriptions(c *gin.Context) {
 // @Success 200 {object} response.Response
 // @Router /api/v1/authors/{authorId}/subscribers [get]
 func (h *subscriptionHandler) GetSubscribers(c *gin.Context) {
-	authorID, err := uuid.Parse(c.Param("authorId"))
+	authorID, err := uuid.Parse(authorParam(c))
 	if err != nil {
 		response.BadRequest(c, "invalid author ID")
 		return
@@ -320,3 +327,12 @@ func (h *subscriptionHandler) CheckSubscriptionStatus(c *gin.Context) {
 		"isSubscribed": isSubscribed,
 	})
 }
+
+// authorParam returns the followed user's ID from either the /authors/:authorId
+// or the /users/:userId route, which share the same handlers
+func authorParam(c *gin.Context) string {
+	if id := c.Param("authorId"); id != "" {
+		return id
+	}
+	return c.Param("userId")
+}
@@ -396,3 +396,194 @@ func (h *VersionHandler) Delete(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+func (h *VersionHandler) Diff(c *gin.Context) {
+	blogID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid blog ID")
+		return
+	}
+
+	fromID, err := uuid.Parse(c.Param("versionId"))
+	if err != nil {
+		response.BadRequest(c, "invalid version ID")
+		return
+	}
+
+	toID, err := uuid.Parse(c.Param("otherVersionId"))
+	if err != nil {
+		response.BadRequest(c, "invalid version ID")
+		return
+	}
+
+	if _, ok := h.requireAuthor(c, blogID); !ok {
+		return
+	}
+
+	comparison, err := h.versionService.CompareVersions(c.Request.Context(), blogID, fromID, toID)
+	if err != nil {
+		if err == service.ErrVersionNotFound {
+			response.NotFound(c, err.Error())
+			return
+		}
+		if err == service.ErrVersionMismatch {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	// Plain patch text for tools that read diffs
+	if c.Query("format") == "unified" {
+		c.Data(http.StatusOK, "text/x-diff; charset=utf-8", []byte(comparison.Unified))
+		return
+	}
+
+	response.Success(c, http.StatusOK, h.toVersionDiffResponse(comparison))
+}
+
+func (h *VersionHandler) toVersionDiffResponse(cmp *service.VersionComparison) dto.VersionDiffResponse {
+	fields := make([]dto.VersionFieldChange, 0, len(cmp.Fields))
+	for _, f := range cmp.Fields {
+		fields = append(fields, dto.VersionFieldChange{
+			Field:   f.Field,
+			From:    f.From,
+			To:      f.To,
+			Added:   f.Added,
+			Removed: f.Removed,
+		})
+	}
+
+	hunks := make([]dto.DiffHunk, 0, len(cmp.Content.Hunks))
+	for _, hunk := range cmp.Content.Hunks {
+		lines := make([]dto.DiffLine, 0, len(hunk.Lines))
+		for _, line := range hunk.Lines {
+			var words []dto.DiffSegment
+			for _, w := range line.Words {
+				words = append(words, dto.DiffSegment{Op: string(w.Op), Text: w.Text})
+			}
+			lines = append(lines, dto.DiffLine{
+				Op:        string(line.Op),
+				Text:      line.Text,
+				OldNumber: line.OldNumber,
+				NewNumber: line.NewNumber,
+				Words:     words,
+			})
+		}
+		hunks = append(hunks, dto.DiffHunk{
+			OldStart: hunk.OldStart,
+			OldLines: hunk.OldLines,
+			NewStart: hunk.NewStart,
+			NewLines: hunk.NewLines,
+			Lines:    lines,
+		})
+	}
+
+	return dto.VersionDiffResponse{
+		From:   dto.VersionRef{ID: cmp.From.ID, VersionNumber: cmp.From.VersionNumber, CreatedAt: cmp.From.CreatedAt},
+		To:     dto.VersionRef{ID: cmp.To.ID, VersionNumber: cmp.To.VersionNumber, CreatedAt: cmp.To.CreatedAt},
+		Fields: fields,
+		Content: dto.ContentDiffResponse{
+			Additions: cmp.Content.Additions,
+			Deletions: cmp.Content.Deletions,
+			Hunks:     hunks,
+		},
+		Unified: cmp.Unified,
+	}
+}
+
+func (h *VersionHandler) GetRetention(c *gin.Context) {
+	blogID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid blog ID")
+		return
+	}
+
+	if _, ok := h.requireAuthor(c, blogID); !ok {
+		return
+	}
+
+	retention, err := h.versionService.GetRetention(c.Request.Context(), blogID)
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, toRetentionResponse(retention))
+}
+
+func (h *VersionHandler) SetRetention(c *gin.Context) {
+	blogID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "invalid blog ID")
+		return
+	}
+
+	var req dto.VersionRetentionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	if _, ok := h.requireAuthor(c, blogID); !ok {
+		return
+	}
+
+	retention := &entity.BlogVersionRetention{
+		BlogID:      blogID,
+		MaxVersions: req.MaxVersions,
+		MaxAgeDays:  req.MaxAgeDays,
+	}
+	if err := h.versionService.SetRetention(c.Request.Context(), retention); err != nil {
+		if err == service.ErrInvalidVersionRetention {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, http.StatusOK, toRetentionResponse(retention))
+}
+
+func toRetentionResponse(r *entity.BlogVersionRetention) dto.VersionRetentionResponse {
+	resp := dto.VersionRetentionResponse{
+		MaxVersions: r.MaxVersions,
+		MaxAgeDays:  r.MaxAgeDays,
+	}
+	if !r.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &r.UpdatedAt
+	}
+	return resp
+}
+
+// requireAuthor loads the blog and writes the error response unless the current user is its author
+func (h *VersionHandler) requireAuthor(c *gin.Context, blogID uuid.UUID) (*entity.Blog, bool) {
+	userIDVal, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "authentication required")
+		return nil, false
+	}
+	userID := userIDVal.(uuid.UUID)
+
+	blog, err := h.blogService.GetByID(c.Request.Context(), blogID, &userID)
+	if err != nil {
+		if err == service.ErrBlogNotFound {
+			response.NotFound(c, err.Error())
+			return nil, false
+		}
+		if err == service.ErrBlogAccessDenied {
+			response.Forbidden(c, err.Error())
+			return nil, false
+		}
+		response.InternalServerError(c, err.Error())
+		return nil, false
+	}
+
+	if blog.AuthorID != userID {
+		response.Forbidden(c, "Access denied")
+		return nil, false
+	}
+	return blog, true
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/aiagent/internal/domain/service"
 	"github.com/aiagent/internal/domain/service/mocks"
 	"github.com/aiagent/internal/interfaces/http/handler/version"
+	"github.com/aiagent/pkg/textdiff"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -317,3 +318,224 @@ func TestVersionHandler_Delete(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, w.Code)
 	})
 }
+
+func TestVersionHandler_Diff(t *testing.T) {
+	r, mockVersionService, mockBlogService, handler, userID := setupRouter()
+
+	blogID := uuid.New()
+	fromID := uuid.New()
+	toID := uuid.New()
+	url := "/blogs/" + blogID.String() + "/versions/" + fromID.String() + "/diff/" + toID.String()
+	r.GET("/blogs/:id/versions/:versionId/diff/:otherVersionId", handler.Diff)
+
+	blog := &entity.Blog{
+		ID:       blogID,
+		AuthorID: userID,
+	}
+	from := &entity.BlogVersion{ID: fromID, BlogID: blogID, VersionNumber: 1, Title: "Old", Content: "a\nb\n"}
+	to := &entity.BlogVersion{ID: toID, BlogID: blogID, VersionNumber: 2, Title: "New", Content: "a\nc\n"}
+	content := textdiff.Compare(from.Content, to.Content, textdiff.DefaultContext)
+	comparison := &service.VersionComparison{
+		From:    from,
+		To:      to,
+		Fields:  []service.VersionFieldChange{{Field: "title", From: "Old", To: "New"}},
+		Content: content,
+		Unified: content.Unified("v1/content", "v2/content"),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockBlogService.EXPECT().
+			GetByID(gomock.Any(), blogID, gomock.Any()).
+			Return(blog, nil)
+
+		mockVersionService.EXPECT().
+			CompareVersions(gomock.Any(), blogID, fromID, toID).
+			Return(comparison, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp struct {
+			Data dto.VersionDiffResponse `json:"data"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, resp.Data.From.VersionNumber)
+		assert.Equal(t, 2, resp.Data.To.VersionNumber)
+		assert.Equal(t, "title", resp.Data.Fields[0].Field)
+		assert.Equal(t, 1, resp.Data.Content.Additions)
+		assert.Equal(t, 1, resp.Data.Content.Deletions)
+		assert.Len(t, resp.Data.Content.Hunks, 1)
+		assert.Equal(t, "delete", resp.Data.Content.Hunks[0].Lines[1].Op)
+		assert.Equal(t, comparison.Unified, resp.Data.Unified)
+	})
+
+	t.Run("UnifiedFormat", func(t *testing.T) {
+		mockBlogService.EXPECT().
+			GetByID(gomock.Any(), blogID, gomock.Any()).
+			Return(blog, nil)
+
+		mockVersionService.EXPECT().
+			CompareVersions(gomock.Any(), blogID, fromID, toID).
+			Return(comparison, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, url+"?format=unified", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/x-diff; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "--- v1/content\n+++ v2/content\n@@ -1,2 +1,2 @@\n a\n-b\n+c\n", w.Body.String())
+	})
+
+	t.Run("VersionOfAnotherBlog", func(t *testing.T) {
+		mockBlogService.EXPECT().
+			GetByID(gomock.Any(), blogID, gomock.Any()).
+			Return(blog, nil)
+
+		mockVersionService.EXPECT().
+			CompareVersions(gomock.Any(), blogID, fromID, toID).
+			Return(nil, service.ErrVersionMismatch)
+
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockBlogService.EXPECT().
+			GetByID(gomock.Any(), blogID, gomock.Any()).
+			Return(blog, nil)
+
+		mockVersionService.EXPECT().
+			CompareVersions(gomock.Any(), blogID, fromID, toID).
+			Return(nil, service.ErrVersionNotFound)
+
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockBlogService.EXPECT().
+			GetByID(gomock.Any(), blogID, gomock.Any()).
+			Return(&entity.Blog{ID: blogID, AuthorID: uuid.New()}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("InvalidUUID", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/blogs/"+blogID.String()+"/versions/"+fromID.String()+"/diff/invalid-uuid", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestVersionHandler_Retention(t *testing.T) {
+	r, mockVersionService, mockBlogService, handler, userID := setupRouter()
+
+	blogID := uuid.New()
+	url := "/blogs/" + blogID.String() + "/versions/retention"
+	r.GET("/blogs/:id/versions/retention", handler.GetRetention)
+	r.PUT("/blogs/:id/versions/retention", handler.SetRetention)
+
+	blog := &entity.Blog{
+		ID:       blogID,
+		AuthorID: userID,
+	}
+
+	t.Run("GetDefault", func(t *testing.T) {
+		mockBlogService.EXPECT().
+			GetByID(gomock.Any(), blogID, gomock.Any()).
+			Return(blog, nil)
+
+		mockVersionService.EXPECT().
+			GetRetention(gomock.Any(), blogID).
+			Return(&entity.BlogVersionRetention{BlogID: blogID, MaxVersions: service.DefaultMaxVersions}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp struct {
+			Data dto.VersionRetentionResponse `json:"data"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.NoError(t, err)
+		assert.Equal(t, service.DefaultMaxVersions, resp.Data.MaxVersions)
+		assert.Nil(t, resp.Data.MaxAgeDays)
+		assert.Nil(t, resp.Data.UpdatedAt)
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		maxAgeDays := 90
+		body, _ := json.Marshal(dto.VersionRetentionRequest{MaxVersions: 20, MaxAgeDays: &maxAgeDays})
+
+		mockBlogService.EXPECT().
+			GetByID(gomock.Any(), blogID, gomock.Any()).
+			Return(blog, nil)
+
+		mockVersionService.EXPECT().
+			SetRetention(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, retention *entity.BlogVersionRetention) error {
+				assert.Equal(t, blogID, retention.BlogID)
+				assert.Equal(t, 20, retention.MaxVersions)
+				assert.Equal(t, 90, *retention.MaxAgeDays)
+				retention.UpdatedAt = time.Now()
+				return nil
+			})
+
+		req, _ := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp struct {
+			Data dto.VersionRetentionResponse `json:"data"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.NoError(t, err)
+		assert.Equal(t, 20, resp.Data.MaxVersions)
+		assert.NotNil(t, resp.Data.UpdatedAt)
+	})
+
+	t.Run("SetOutOfRange", func(t *testing.T) {
+		body, _ := json.Marshal(dto.VersionRetentionRequest{MaxVersions: 500})
+
+		req, _ := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("SetForbidden", func(t *testing.T) {
+		body, _ := json.Marshal(dto.VersionRetentionRequest{MaxVersions: 10})
+
+		mockBlogService.EXPECT().
+			GetByID(gomock.Any(), blogID, gomock.Any()).
+			Return(&entity.Blog{ID: blogID, AuthorID: uuid.New()}, nil)
+
+		req, _ := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
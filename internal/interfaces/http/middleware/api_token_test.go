@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	roleMocks "github.com/aiagent/internal/application/usecase/role/mocks"
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
+	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAPITokenAuth_ScopesIntersectRoles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTokens := serviceMocks.NewMockAPITokenService(ctrl)
+	mockRoles := roleMocks.NewMockRoleUseCase(ctrl)
+	authz := NewAuthorization(mockRoles, nil, false)
+	userID := uuid.New()
+
+	sessionAuth := func(c *gin.Context) {
+		c.Set("userID", userID)
+		c.Next()
+	}
+
+	r := gin.New()
+	authn := APITokenAuth(mockTokens, sessionAuth)
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	r.GET("/blogs", authn, authz.RequireRead(entity.ResourceBlogs), ok)
+	r.POST("/blogs", authn, authz.RequireCreate(entity.ResourceBlogs), ok)
+	r.GET("/plans", authn, RequireScope(entity.ResourcePlans, entity.PermissionRead), ok)
+
+	serve := func(method, path, authorization string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, path, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	readOnly := &entity.APIToken{
+		ID:     uuid.New(),
+		UserID: userID,
+		Scopes: entity.APITokenScopes{entity.ResourceBlogs: entity.PermissionRead},
+	}
+
+	t.Run("scope_and_role_allow", func(t *testing.T) {
+		mockTokens.EXPECT().Authenticate(gomock.Any(), "pat_read").Return(readOnly, nil)
+		mockRoles.EXPECT().CheckPermission(gomock.Any(), userID, entity.ResourceBlogs, entity.PermissionRead).Return(true, nil)
+
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/blogs", "Bearer pat_read"))
+	})
+
+	t.Run("scope_allows_role_denies", func(t *testing.T) {
+		mockTokens.EXPECT().Authenticate(gomock.Any(), "pat_read").Return(readOnly, nil)
+		mockRoles.EXPECT().CheckPermission(gomock.Any(), userID, entity.ResourceBlogs, entity.PermissionRead).Return(false, nil)
+
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "/blogs", "Bearer pat_read"))
+	})
+
+	t.Run("role_allows_scope_denies", func(t *testing.T) {
+		mockTokens.EXPECT().Authenticate(gomock.Any(), "pat_read").Return(readOnly, nil)
+
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "/blogs", "Bearer pat_read"))
+	})
+
+	t.Run("scope_only_resource", func(t *testing.T) {
+		mockTokens.EXPECT().Authenticate(gomock.Any(), "pat_read").Return(readOnly, nil)
+
+		assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "/plans", "Bearer pat_read"))
+	})
+
+	t.Run("session_is_not_scoped", func(t *testing.T) {
+		mockRoles.EXPECT().CheckPermission(gomock.Any(), userID, entity.ResourceBlogs, entity.PermissionCreate).Return(true, nil)
+
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/blogs", ""))
+		assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/plans", ""))
+	})
+
+	t.Run("invalid_token", func(t *testing.T) {
+		mockTokens.EXPECT().Authenticate(gomock.Any(), "pat_revoked").Return(nil, service.ErrInvalidAPIToken)
+
+		assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/blogs", "Bearer pat_revoked"))
+	})
+
+	t.Run("not_a_bearer_token", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/blogs", "Basic dXNlcjpwYXNz"))
+	})
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"github.com/aiagent/internal/application/usecase/role"
 	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/domain/service"
 	"github.com/aiagent/pkg/response"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -10,48 +11,69 @@ import (
 
 // Authorization middleware for role-based access control
 type Authorization struct {
-	roleUseCase role.RoleUseCase
+	roleUseCase           role.RoleUseCase
+	twoFactorService      service.TwoFactorService
+	requireAdminTwoFactor bool
 }
 
-// NewAuthorization creates a new authorization middleware
-func NewAuthorization(roleUseCase role.RoleUseCase) *Authorization {
+// NewAuthorization creates a new authorization middleware. With requireAdminTwoFactor
+// set, RequireAdmin also rejects users who have not enabled two-factor authentication.
+func NewAuthorization(roleUseCase role.RoleUseCase, twoFactorService service.TwoFactorService, requireAdminTwoFactor bool) *Authorization {
 	return &Authorization{
-		roleUseCase: roleUseCase,
+		roleUseCase:           roleUseCase,
+		twoFactorService:      twoFactorService,
+		requireAdminTwoFactor: requireAdminTwoFactor,
 	}
 }
 
-// RequirePermission returns a middleware that checks if the user has the required permission
+// RequirePermission returns a middleware that checks if the user has the required permission.
+// Requests made with an API token also need the permission in the token's scopes.
 func (a *Authorization) RequirePermission(resource string, permission entity.Permission) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		userID, exists := c.Get("userID")
-		if !exists {
-			response.Unauthorized(c, "Authentication required")
-			c.Abort()
+		if _, ok := a.checkPermission(c, resource, permission); !ok {
 			return
 		}
+		c.Next()
+	}
+}
 
-		uid, ok := userID.(uuid.UUID)
-		if !ok {
-			response.Unauthorized(c, "Invalid user ID")
-			c.Abort()
-			return
-		}
+// checkPermission aborts the request with an error response unless the user holds the permission
+func (a *Authorization) checkPermission(c *gin.Context, resource string, permission entity.Permission) (uuid.UUID, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		response.Unauthorized(c, "Authentication required")
+		c.Abort()
+		return uuid.Nil, false
+	}
 
-		hasPermission, err := a.roleUseCase.CheckPermission(c.Request.Context(), uid, resource, permission)
-		if err != nil {
-			response.InternalServerError(c, "Failed to check permissions")
-			c.Abort()
-			return
-		}
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		response.Unauthorized(c, "Invalid user ID")
+		c.Abort()
+		return uuid.Nil, false
+	}
 
-		if !hasPermission {
-			response.Forbidden(c, "Insufficient permissions")
-			c.Abort()
-			return
-		}
+	// An API token only gets what both its scopes and the user's roles allow
+	if !tokenScopeAllows(c, resource, permission) {
+		response.Forbidden(c, "API token scope does not allow this action")
+		c.Abort()
+		return uuid.Nil, false
+	}
 
-		c.Next()
+	hasPermission, err := a.roleUseCase.CheckPermission(c.Request.Context(), uid, resource, permission)
+	if err != nil {
+		response.InternalServerError(c, "Failed to check permissions")
+		c.Abort()
+		return uuid.Nil, false
+	}
+
+	if !hasPermission {
+		response.Forbidden(c, "Insufficient permissions")
+		c.Abort()
+		return uuid.Nil, false
 	}
+
+	return uid, true
 }
 
 // RequireRead returns a middleware that checks if the user has READ permission
@@ -75,6 +97,31 @@ func (a *Authorization) RequireDelete(resource string) gin.HandlerFunc {
 }
 
 // RequireAdmin returns a middleware that checks if the user has full permissions on the resource
+// and, when enforced, has two-factor authentication enabled
 func (a *Authorization) RequireAdmin(resource string) gin.HandlerFunc {
-	return a.RequirePermission(resource, entity.PermissionAll)
+	if !a.requireAdminTwoFactor {
+		return a.RequirePermission(resource, entity.PermissionAll)
+	}
+
+	return func(c *gin.Context) {
+		uid, ok := a.checkPermission(c, resource, entity.PermissionAll)
+		if !ok {
+			return
+		}
+
+		enabled, err := a.twoFactorService.IsEnabled(c.Request.Context(), uid)
+		if err != nil {
+			response.InternalServerError(c, "Failed to check two-factor authentication")
+			c.Abort()
+			return
+		}
+
+		if !enabled {
+			response.Forbidden(c, "Two-factor authentication must be enabled for this action")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
 }
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	roleMocks "github.com/aiagent/internal/application/usecase/role/mocks"
+	"github.com/aiagent/internal/domain/entity"
+	serviceMocks "github.com/aiagent/internal/domain/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthorization_RequireAdminTwoFactor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRoles := roleMocks.NewMockRoleUseCase(ctrl)
+	mockTwoFactor := serviceMocks.NewMockTwoFactorService(ctrl)
+	userID := uuid.New()
+
+	serve := func(auth *Authorization) int {
+		r := gin.New()
+		r.GET("/admin", func(c *gin.Context) {
+			c.Set("userID", userID)
+		}, auth.RequireAdmin("fraud"), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/admin", nil)
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("not_enforced", func(t *testing.T) {
+		mockRoles.EXPECT().CheckPermission(gomock.Any(), userID, "fraud", entity.PermissionAll).Return(true, nil)
+
+		assert.Equal(t, http.StatusOK, serve(NewAuthorization(mockRoles, mockTwoFactor, false)))
+	})
+
+	t.Run("enforced_without_2fa", func(t *testing.T) {
+		mockRoles.EXPECT().CheckPermission(gomock.Any(), userID, "fraud", entity.PermissionAll).Return(true, nil)
+		mockTwoFactor.EXPECT().IsEnabled(gomock.Any(), userID).Return(false, nil)
+
+		assert.Equal(t, http.StatusForbidden, serve(NewAuthorization(mockRoles, mockTwoFactor, true)))
+	})
+
+	t.Run("enforced_with_2fa", func(t *testing.T) {
+		mockRoles.EXPECT().CheckPermission(gomock.Any(), userID, "fraud", entity.PermissionAll).Return(true, nil)
+		mockTwoFactor.EXPECT().IsEnabled(gomock.Any(), userID).Return(true, nil)
+
+		assert.Equal(t, http.StatusOK, serve(NewAuthorization(mockRoles, mockTwoFactor, true)))
+	})
+
+	t.Run("enforced_non_admin", func(t *testing.T) {
+		mockRoles.EXPECT().CheckPermission(gomock.Any(), userID, "fraud", entity.PermissionAll).Return(false, nil)
+
+		assert.Equal(t, http.StatusForbidden, serve(NewAuthorization(mockRoles, mockTwoFactor, true)))
+	})
+}
@@ -2,14 +2,17 @@ package middleware
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/pkg/logger"
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 )
 
-// SessionAuth creates a middleware that checks for a valid session using Redis
-func SessionAuth(repo repository.SessionRepository) gin.HandlerFunc {
+// SessionAuth creates a middleware that checks for a valid session using Redis.
+// Active sessions are kept alive: at most once per touchInterval the last-seen
+// time is recorded and the expiry of both the session and its cookie slides forward.
+func SessionAuth(repo repository.SessionRepository, touchInterval time.Duration) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		sessionID, err := c.Cookie("session_id")
 		if err != nil {
@@ -17,20 +20,46 @@ func SessionAuth(repo repository.SessionRepository) gin.HandlerFunc {
 			return
 		}
 
-		userIDStr, err := repo.GetUserID(c.Request.Context(), sessionID)
-		if err != nil || userIDStr == "" {
+		session, err := repo.GetSession(c.Request.Context(), sessionID)
+		if err != nil || session == nil {
 			c.AbortWithStatus(http.StatusUnauthorized)
 			return
 		}
 
-		userID, err := uuid.Parse(userIDStr)
+		now := time.Now()
+		if now.Sub(session.LastSeenAt) >= touchInterval {
+			// A failed touch only costs activity tracking; the session itself is valid
+			if err := repo.TouchSession(c.Request.Context(), session, now); err != nil {
+				logger.Error("failed to touch session", err, map[string]interface{}{"user_id": session.UserID})
+			} else {
+				secure := gin.Mode() == gin.ReleaseMode
+				c.SetSameSite(http.SameSiteLaxMode)
+				c.SetCookie("session_id", sessionID, int(time.Until(session.ExpiresAt).Seconds()), "/", "", secure, true)
+			}
+		}
+
+		c.Set("userID", session.UserID)
+		c.Set("sessionID", sessionID)
+		c.Next()
+	}
+}
+
+// OptionalSessionAuth identifies the viewer on public endpoints whose results depend on
+// who is asking. A missing or invalid session is not an error; the request stays anonymous.
+func OptionalSessionAuth(repo repository.SessionRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sessionID, err := c.Cookie("session_id")
 		if err != nil {
-			c.AbortWithStatus(http.StatusUnauthorized)
+			c.Next()
 			return
 		}
 
-		c.Set("userID", userID)
-		c.Set("sessionID", sessionID)
+		session, err := repo.GetSession(c.Request.Context(), sessionID)
+		if err != nil {
+			logger.Error("failed to load session", err, nil)
+		} else if session != nil {
+			c.Set("userID", session.UserID)
+		}
 		c.Next()
 	}
 }
@@ -1,11 +1,14 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository/mocks"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -21,6 +24,9 @@ func TestSessionAuth(t *testing.T) {
 
 	mockRepo := mocks.NewMockSessionRepository(ctrl)
 	validUUID := uuid.New()
+	now := time.Now()
+	activeSession := &entity.Session{ID: "valid_session", UserID: validUUID, CreatedAt: now.Add(-time.Hour), LastSeenAt: now.Add(-10 * time.Second)}
+	idleSession := &entity.Session{ID: "idle_session", UserID: validUUID, CreatedAt: now.Add(-time.Hour), LastSeenAt: now.Add(-10 * time.Minute)}
 
 	tests := []struct {
 		name           string
@@ -28,11 +34,13 @@ func TestSessionAuth(t *testing.T) {
 		setupRequest   func(req *http.Request)
 		expectedStatus int
 		expectedUserID string
+		expectCookie   bool
 	}{
 		{
 			name: "Success",
 			setupMock: func() {
-				mockRepo.EXPECT().GetUserID(gomock.Any(), "valid_session").Return(validUUID.String(), nil)
+				// Seen within the touch interval: no write
+				mockRepo.EXPECT().GetSession(gomock.Any(), "valid_session").Return(activeSession, nil)
 			},
 			setupRequest: func(req *http.Request) {
 				req.AddCookie(&http.Cookie{Name: "session_id", Value: "valid_session"})
@@ -40,6 +48,24 @@ func TestSessionAuth(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedUserID: validUUID.String(),
 		},
+		{
+			name: "SlidesExpiry",
+			setupMock: func() {
+				mockRepo.EXPECT().GetSession(gomock.Any(), "idle_session").Return(idleSession, nil)
+				mockRepo.EXPECT().TouchSession(gomock.Any(), idleSession, gomock.Any()).
+					DoAndReturn(func(_ context.Context, s *entity.Session, seenAt time.Time) error {
+						s.LastSeenAt = seenAt
+						s.ExpiresAt = seenAt.Add(24 * time.Hour)
+						return nil
+					})
+			},
+			setupRequest: func(req *http.Request) {
+				req.AddCookie(&http.Cookie{Name: "session_id", Value: "idle_session"})
+			},
+			expectedStatus: http.StatusOK,
+			expectedUserID: validUUID.String(),
+			expectCookie:   true,
+		},
 		{
 			name: "NoCookie",
 			setupMock: func() {
@@ -53,7 +79,7 @@ func TestSessionAuth(t *testing.T) {
 		{
 			name: "InvalidSession",
 			setupMock: func() {
-				mockRepo.EXPECT().GetUserID(gomock.Any(), "invalid_session").Return("", errors.New("not found"))
+				mockRepo.EXPECT().GetSession(gomock.Any(), "invalid_session").Return(nil, errors.New("connection refused"))
 			},
 			setupRequest: func(req *http.Request) {
 				req.AddCookie(&http.Cookie{Name: "session_id", Value: "invalid_session"})
@@ -61,12 +87,12 @@ func TestSessionAuth(t *testing.T) {
 			expectedStatus: http.StatusUnauthorized,
 		},
 		{
-			name: "MalformedUUID",
+			name: "ExpiredSession",
 			setupMock: func() {
-				mockRepo.EXPECT().GetUserID(gomock.Any(), "malformed_session").Return("not-a-uuid", nil)
+				mockRepo.EXPECT().GetSession(gomock.Any(), "expired_session").Return(nil, nil)
 			},
 			setupRequest: func(req *http.Request) {
-				req.AddCookie(&http.Cookie{Name: "session_id", Value: "malformed_session"})
+				req.AddCookie(&http.Cookie{Name: "session_id", Value: "expired_session"})
 			},
 			expectedStatus: http.StatusUnauthorized,
 		},
@@ -84,7 +110,7 @@ func TestSessionAuth(t *testing.T) {
 			c.Request = req
 
 			// Initialize middleware
-			middleware := SessionAuth(mockRepo)
+			middleware := SessionAuth(mockRepo, time.Minute)
 			middleware(c)
 
 			if tt.expectedStatus == http.StatusOK {
@@ -96,6 +122,13 @@ func TestSessionAuth(t *testing.T) {
 				sessionID, exists := c.Get("sessionID")
 				assert.True(t, exists, "sessionID should be set in context")
 				assert.NotEmpty(t, sessionID)
+
+				cookie := w.Header().Get("Set-Cookie")
+				if tt.expectCookie {
+					assert.Contains(t, cookie, "Max-Age=86")
+				} else {
+					assert.Empty(t, cookie)
+				}
 			} else {
 				assert.Equal(t, tt.expectedStatus, w.Code)
 				assert.True(t, c.IsAborted())
@@ -103,3 +136,57 @@ func TestSessionAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionalSessionAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockSessionRepository(ctrl)
+	userID := uuid.New()
+
+	r := gin.New()
+	r.GET("/search", OptionalSessionAuth(mockRepo), func(c *gin.Context) {
+		viewer, _ := c.Get("userID")
+		c.JSON(http.StatusOK, gin.H{"viewer": viewer})
+	})
+
+	serve := func(cookie string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/search", nil)
+		if cookie != "" {
+			req.AddCookie(&http.Cookie{Name: "session_id", Value: cookie})
+		}
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("valid_session", func(t *testing.T) {
+		mockRepo.EXPECT().GetSession(gomock.Any(), "valid_session").Return(&entity.Session{UserID: userID}, nil)
+
+		w := serve("valid_session")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), userID.String())
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		w := serve("")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"viewer":null}`, w.Body.String())
+	})
+
+	t.Run("unknown_session", func(t *testing.T) {
+		mockRepo.EXPECT().GetSession(gomock.Any(), "expired_session").Return(nil, nil)
+
+		assert.JSONEq(t, `{"viewer":null}`, serve("expired_session").Body.String())
+	})
+
+	t.Run("store_error", func(t *testing.T) {
+		mockRepo.EXPECT().GetSession(gomock.Any(), "valid_session").Return(nil, errors.New("redis down"))
+
+		assert.Equal(t, http.StatusOK, serve("valid_session").Code)
+	})
+}
@@ -9,7 +9,24 @@ func RegisterAuthRoutes(v1 *gin.RouterGroup, p Params, rateLimit, sessionAuth gi
 	{
 		authGroup.POST("/register", rateLimit, p.AuthHandler.Register)
 		authGroup.POST("/login", rateLimit, p.AuthHandler.Login)
+		authGroup.POST("/login/2fa", rateLimit, p.AuthHandler.VerifyTwoFactorLogin)
 		authGroup.POST("/logout", sessionAuth, p.AuthHandler.Logout)
+		authGroup.POST("/verify-email", rateLimit, p.AuthHandler.VerifyEmail)
+		authGroup.POST("/resend-verification", rateLimit, p.AuthHandler.ResendVerification)
+		authGroup.POST("/forgot-password", rateLimit, p.AuthHandler.ForgotPassword)
+		authGroup.POST("/reset-password", rateLimit, p.AuthHandler.ResetPassword)
+		authGroup.POST("/change-password", sessionAuth, rateLimit, p.AuthHandler.ChangePassword)
+		authGroup.GET("/sessions", sessionAuth, p.AuthHandler.ListSessions)
+		authGroup.DELETE("/sessions", sessionAuth, p.AuthHandler.LogoutEverywhere)
+		authGroup.DELETE("/sessions/:id", sessionAuth, p.AuthHandler.RevokeSession)
+		authGroup.GET("/2fa", sessionAuth, p.AuthHandler.GetTwoFactorStatus)
+		authGroup.POST("/2fa/setup", sessionAuth, p.AuthHandler.SetupTwoFactor)
+		authGroup.POST("/2fa/enable", sessionAuth, rateLimit, p.AuthHandler.EnableTwoFactor)
+		authGroup.POST("/2fa/disable", sessionAuth, rateLimit, p.AuthHandler.DisableTwoFactor)
+		authGroup.POST("/2fa/recovery-codes", sessionAuth, rateLimit, p.AuthHandler.RegenerateRecoveryCodes)
+		authGroup.GET("/tokens", sessionAuth, p.AuthHandler.ListAPITokens)
+		authGroup.POST("/tokens", sessionAuth, rateLimit, p.AuthHandler.CreateAPIToken)
+		authGroup.DELETE("/tokens/:id", sessionAuth, p.AuthHandler.RevokeAPIToken)
 		authGroup.GET("/:provider", p.AuthHandler.SocialLogin)
 		authGroup.GET("/:provider/callback", p.AuthHandler.SocialCallback)
 	}
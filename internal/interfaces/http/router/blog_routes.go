@@ -1,39 +1,49 @@
 package router
 
 import (
+	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/interfaces/http/middleware"
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterBlogRoutes(v1 *gin.RouterGroup, p Params, auth *middleware.Authorization, sessionAuth gin.HandlerFunc) {
+func RegisterBlogRoutes(v1 *gin.RouterGroup, p Params, auth *middleware.Authorization, sessionAuth, tokenAuth, optionalAuth gin.HandlerFunc) {
 	blogs := v1.Group("/blogs")
 	{
-		blogs.GET("", p.BlogHandler.List)
+		blogs.GET("", optionalAuth, p.BlogHandler.List)
 		blogs.GET("/feed", sessionAuth, p.RecommendationHandler.GetPersonalizedFeed) // Personalized feed
-		blogs.GET("/:id", p.BlogHandler.GetByID)
-		blogs.GET("/:id/related", p.RecommendationHandler.GetRelatedBlogs)                              // Related blogs
-		blogs.POST("", sessionAuth, auth.RequireCreate("blogs"), p.BlogHandler.Create)                  // Requires CREATE permission
-		blogs.PUT("/:id", sessionAuth, auth.RequireUpdate("blogs"), p.BlogHandler.Update)               // Requires UPDATE permission
-		blogs.DELETE("/:id", sessionAuth, auth.RequireDelete("blogs"), p.BlogHandler.Delete)            // Requires DELETE permission
-		blogs.POST("/:id/publish", sessionAuth, auth.RequireUpdate("blogs"), p.BlogHandler.Publish)     // Requires UPDATE permission
-		blogs.POST("/:id/unpublish", sessionAuth, auth.RequireUpdate("blogs"), p.BlogHandler.Unpublish) // Requires UPDATE permission
-		blogs.POST("/:id/reaction", sessionAuth, p.BlogHandler.React)                                   // Authenticated users
-		blogs.POST("/:id/read", sessionAuth, p.ReadingHistoryHandler.MarkAsRead)                        // Authenticated users
+		blogs.GET("/timeline", optionalAuth, p.BlogHandler.Timeline)                 // Published blogs by cursor
+		blogs.GET("/:id", optionalAuth, p.BlogHandler.GetByID)
+		blogs.GET("/:id/related", p.RecommendationHandler.GetRelatedBlogs)                            // Related blogs
+		blogs.POST("", tokenAuth, auth.RequireCreate("blogs"), p.BlogHandler.Create)                  // Requires CREATE permission
+		blogs.PUT("/:id", tokenAuth, auth.RequireUpdate("blogs"), p.BlogHandler.Update)               // Requires UPDATE permission
+		blogs.DELETE("/:id", tokenAuth, auth.RequireDelete("blogs"), p.BlogHandler.Delete)            // Requires DELETE permission
+		blogs.POST("/:id/publish", tokenAuth, auth.RequireUpdate("blogs"), p.BlogHandler.Publish)     // Requires UPDATE permission
+		blogs.POST("/:id/unpublish", tokenAuth, auth.RequireUpdate("blogs"), p.BlogHandler.Unpublish) // Requires UPDATE permission
+		blogs.POST("/:id/reaction", sessionAuth, p.BlogHandler.React)                                 // Authenticated users
+		blogs.POST("/:id/read", sessionAuth, p.ReadingHistoryHandler.MarkAsRead)                      // Authenticated users
 		blogs.POST("/:id/bookmark", sessionAuth, p.BookmarkHandler.Bookmark)
 		blogs.DELETE("/:id/bookmark", sessionAuth, p.BookmarkHandler.Unbookmark)
 
+		// Scheduled publishing (author only)
+		blogs.GET("/scheduled", tokenAuth, middleware.RequireScope(entity.ResourceBlogs, entity.PermissionRead), p.BlogHandler.ListScheduled)
+		blogs.PUT("/:id/schedule", tokenAuth, auth.RequireUpdate("blogs"), p.BlogHandler.Reschedule)
+		blogs.DELETE("/:id/schedule", tokenAuth, auth.RequireUpdate("blogs"), p.BlogHandler.CancelSchedule)
+
 		// Blog comments
 		blogs.GET("/:id/comments", p.CommentHandler.GetByBlogID)
-		blogs.POST("/:id/comments", sessionAuth, auth.RequireCreate("comments"), p.CommentHandler.Create)
+		blogs.POST("/:id/comments", tokenAuth, auth.RequireCreate("comments"), p.CommentHandler.Create)
 	}
 }
 
-func RegisterVersionRoutes(v1 *gin.RouterGroup, p Params, auth *middleware.Authorization, sessionAuth gin.HandlerFunc) {
+func RegisterVersionRoutes(v1 *gin.RouterGroup, p Params, auth *middleware.Authorization, tokenAuth gin.HandlerFunc) {
 	versions := v1.Group("/blogs/:id/versions")
-	versions.Use(sessionAuth) // All version endpoints require authentication
+	versions.Use(tokenAuth) // All version endpoints require authentication
 	{
-		versions.GET("", p.VersionHandler.List)
-		versions.GET("/:versionId", p.VersionHandler.Get)
+		versions.GET("", middleware.RequireScope(entity.ResourceBlogs, entity.PermissionRead), p.VersionHandler.List)
+		versions.GET("/retention", middleware.RequireScope(entity.ResourceBlogs, entity.PermissionRead), p.VersionHandler.GetRetention)
+		versions.PUT("/retention", auth.RequireUpdate("blogs"), p.VersionHandler.SetRetention)
+		versions.GET("/:versionId", middleware.RequireScope(entity.ResourceBlogs, entity.PermissionRead), p.VersionHandler.Get)
+		versions.GET("/:versionId/diff/:otherVersionId", middleware.RequireScope(entity.ResourceBlogs, entity.PermissionRead), p.VersionHandler.Diff)
 		versions.POST("", auth.RequireUpdate("blogs"), p.VersionHandler.Create)
 		versions.POST("/:versionId/restore", auth.RequireUpdate("blogs"), p.VersionHandler.Restore)
 		versions.DELETE("/:versionId", auth.RequireUpdate("blogs"), p.VersionHandler.Delete)
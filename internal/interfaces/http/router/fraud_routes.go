@@ -17,6 +17,8 @@ func RegisterFraudRoutes(v1 *gin.RouterGroup, p Params, auth *middleware.Authori
 		admin.GET("/fraud-dashboard", p.FraudHandler.GetFraudDashboard)
 		admin.POST("/users/:id/review", p.FraudHandler.ReviewUser)
 		admin.POST("/users/:id/ban", p.FraudHandler.BanUser)
+		admin.GET("/batch-jobs", p.FraudHandler.ListBatchJobs)
+		admin.POST("/batch-jobs/:id/cancel", p.FraudHandler.CancelBatchJob)
 	}
 
 	// Analytics
@@ -0,0 +1,14 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterMediaRoutes(v1 *gin.RouterGroup, p Params, sessionAuth gin.HandlerFunc) {
+	media := v1.Group("/media", sessionAuth)
+	{
+		media.POST("", p.MediaHandler.Upload)
+		media.GET("", p.MediaHandler.List)
+		media.DELETE("/:id", p.MediaHandler.Delete)
+	}
+}
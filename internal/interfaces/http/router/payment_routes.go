@@ -2,6 +2,7 @@ package router
 
 import (
 	"github.com/aiagent/internal/interfaces/http/handler/payment"
+	"github.com/aiagent/internal/interfaces/http/middleware"
 	"github.com/gin-gonic/gin"
 )
 
@@ -11,11 +12,51 @@ func RegisterPaymentRoutes(v1 *gin.RouterGroup, paymentH payment.PaymentHandler,
 	payments := v1.Group("/payments", sessionAuth)
 	{
 		payments.POST("", paymentH.CreatePayment)
+		payments.GET("/:orderId", paymentH.GetPaymentStatus)
 	}
 
 	// Webhook routes (public)
 	webhooks := v1.Group("/webhooks")
 	{
 		webhooks.POST("/sepay", webhookH.HandleSePayWebhook)
+		webhooks.POST("/stripe", webhookH.HandleStripeWebhook)
+	}
+}
+
+// RegisterPaymentAdminRoutes registers the review queue for mismatched transfers, refunds, reports and payouts
+func RegisterPaymentAdminRoutes(v1 *gin.RouterGroup, p Params, auth *middleware.Authorization, sessionAuth gin.HandlerFunc) {
+	admin := v1.Group("/admin/payments", sessionAuth, auth.RequireAdmin("payments"))
+	{
+		admin.GET("/reconciliations", p.ReconciliationHandler.ListReconciliations)
+		admin.POST("/reconciliations/:id/resolve", p.ReconciliationHandler.ResolveReconciliation)
+		admin.GET("/transactions/:id/refunds", p.RefundHandler.ListRefunds)
+		admin.POST("/transactions/:id/refunds", p.RefundHandler.CreateRefund)
+		admin.GET("/reports", p.ReportHandler.ListReports)
+		admin.GET("/reports/:day", p.ReportHandler.GetReport)
+		admin.GET("/payouts", p.PayoutHandler.ListPayouts)
+		admin.POST("/payouts/:id/review", p.PayoutHandler.ReviewPayout)
+	}
+}
+
+// RegisterEarningsRoutes registers the signed-in author's balance, earnings, payout requests and promo codes
+func RegisterEarningsRoutes(v1 *gin.RouterGroup, p Params, sessionAuth gin.HandlerFunc) {
+	authorsMe := v1.Group("/authors/me", sessionAuth)
+	{
+		authorsMe.GET("/balance", p.EarningsHandler.GetBalance)
+		authorsMe.GET("/earnings", p.EarningsHandler.GetEarnings)
+		authorsMe.GET("/payouts", p.EarningsHandler.ListPayouts)
+		authorsMe.POST("/payouts", p.EarningsHandler.RequestPayout)
+		authorsMe.GET("/promo-codes", p.PromoCodeHandler.ListPromoCodes)
+		authorsMe.POST("/promo-codes", p.PromoCodeHandler.CreatePromoCode)
+		authorsMe.DELETE("/promo-codes/:id", p.PromoCodeHandler.DeactivatePromoCode)
+	}
+}
+
+// RegisterGiftRoutes registers the gift codes a user paid for and their redemption
+func RegisterGiftRoutes(v1 *gin.RouterGroup, p Params, sessionAuth gin.HandlerFunc) {
+	gifts := v1.Group("/gifts", sessionAuth)
+	{
+		gifts.GET("", p.GiftHandler.ListGifts)
+		gifts.POST("/redeem", p.GiftHandler.RedeemGift)
 	}
 }
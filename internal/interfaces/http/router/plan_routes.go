@@ -1,34 +1,40 @@
 package router
 
 import (
+	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/interfaces/http/handler/plan"
+	"github.com/aiagent/internal/interfaces/http/middleware"
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterPlanRoutes registers plan-related routes
-// Public routes: GET /authors/:authorId/plans, GET /blogs/:blogId/access
-// Protected routes: All /authors/me/* endpoints require session authentication
-func RegisterPlanRoutes(v1 *gin.RouterGroup, planH plan.PlanHandler, sessionAuth gin.HandlerFunc) {
+// Public routes: GET /authors/:authorId/plans, GET /blogs/:blogId/access (answered for the signed-in viewer if any)
+// Session routes: GET /authors/:authorId/plans/:planId/quote
+// Protected routes: All /authors/me/* endpoints require a session or an API token scoped to plans
+func RegisterPlanRoutes(v1 *gin.RouterGroup, planH plan.PlanHandler, sessionAuth, tokenAuth, optionalAuth gin.HandlerFunc) {
 	// Authors group
 	authors := v1.Group("/authors")
 	{
 		// Public endpoint: Get author's subscription plans
 		authors.GET("/:authorId/plans", planH.GetAuthorPlans)
 
+		// Price of a plan for the signed-in reader, credited for the current tier
+		authors.GET("/:authorId/plans/:planId/quote", sessionAuth, planH.QuoteSubscription)
+
 		// Protected endpoints: Current author's plan management
 		authorsMe := authors.Group("/me")
-		authorsMe.Use(sessionAuth)
+		authorsMe.Use(tokenAuth)
 		{
-			authorsMe.POST("/plans", planH.UpsertPlans)
-			authorsMe.POST("/tags/:tagId/tier", planH.AssignTagToTier)
-			authorsMe.DELETE("/tags/:tagId/tier", planH.UnassignTagFromTier)
-			authorsMe.GET("/tag-tiers", planH.GetAuthorTagTiers)
+			authorsMe.POST("/plans", middleware.RequireScope(entity.ResourcePlans, entity.PermissionUpdate), planH.UpsertPlans)
+			authorsMe.POST("/tags/:tagId/tier", middleware.RequireScope(entity.ResourcePlans, entity.PermissionUpdate), planH.AssignTagToTier)
+			authorsMe.DELETE("/tags/:tagId/tier", middleware.RequireScope(entity.ResourcePlans, entity.PermissionUpdate), planH.UnassignTagFromTier)
+			authorsMe.GET("/tag-tiers", middleware.RequireScope(entity.ResourcePlans, entity.PermissionRead), planH.GetAuthorTagTiers)
 		}
 	}
 
 	// Blogs group - public endpoint for access checking
 	blogs := v1.Group("/blogs")
 	{
-		blogs.GET("/:blogId/access", planH.CheckBlogAccess)
+		blogs.GET("/:blogId/access", optionalAuth, planH.CheckBlogAccess)
 	}
 }
@@ -1,10 +1,12 @@
 package router
 
 import (
+	"strings"
 	"time"
 
 	roleUseCase "github.com/aiagent/internal/application/usecase/role"
 	"github.com/aiagent/internal/domain/repository"
+	"github.com/aiagent/internal/domain/service"
 	"github.com/aiagent/internal/infrastructure/config"
 	"github.com/aiagent/internal/interfaces/http/handler/admin"
 	"github.com/aiagent/internal/interfaces/http/handler/auth"
@@ -14,6 +16,7 @@ import (
 	"github.com/aiagent/internal/interfaces/http/handler/comment"
 	"github.com/aiagent/internal/interfaces/http/handler/fraud"
 	"github.com/aiagent/internal/interfaces/http/handler/health"
+	"github.com/aiagent/internal/interfaces/http/handler/media"
 	"github.com/aiagent/internal/interfaces/http/handler/notification"
 	"github.com/aiagent/internal/interfaces/http/handler/payment"
 	"github.com/aiagent/internal/interfaces/http/handler/plan"
@@ -22,6 +25,7 @@ import (
 	"github.com/aiagent/internal/interfaces/http/handler/reading_history"
 	"github.com/aiagent/internal/interfaces/http/handler/recommendation"
 	"github.com/aiagent/internal/interfaces/http/handler/role"
+	"github.com/aiagent/internal/interfaces/http/handler/search"
 	"github.com/aiagent/internal/interfaces/http/handler/series"
 	"github.com/aiagent/internal/interfaces/http/handler/subscription"
 	"github.com/aiagent/internal/interfaces/http/handler/tag"
@@ -49,9 +53,11 @@ type Params struct {
 	BookmarkHandler       bookmark.BookmarkHandler
 	CategoryHandler       category.CategoryHandler
 	TagHandler            tag.TagHandler
+	SearchHandler         search.SearchHandler
 	CommentHandler        comment.CommentHandler
 	SubscriptionHandler   subscription.SubscriptionHandler
 	ProfileHandler        profile.ProfileHandler
+	MediaHandler          media.MediaHandler
 	RoleHandler           role.RoleHandler
 	SeriesHandler         series.SeriesHandler
 	RankingHandler        ranking.RankingHandler
@@ -60,12 +66,21 @@ type Params struct {
 	FraudHandler          fraud.FraudHandler
 	PaymentHandler        payment.PaymentHandler
 	WebhookHandler        payment.WebhookHandler
+	ReconciliationHandler payment.ReconciliationHandler
+	RefundHandler         payment.RefundHandler
+	ReportHandler         payment.ReportHandler
+	EarningsHandler       payment.EarningsHandler
+	PayoutHandler         payment.PayoutHandler
+	GiftHandler           payment.GiftHandler
+	PromoCodeHandler      payment.PromoCodeHandler
 	PlanHandler           plan.PlanHandler
 	AuthHandler           auth.AuthHandler
 	NotificationHandler   notification.NotificationHandler
 	SessionRepository     repository.SessionRepository
 	RedisClient           *redis.Client
 	RoleUseCase           roleUseCase.RoleUseCase // For authorization middleware
+	TwoFactorService      service.TwoFactorService
+	APITokenService       service.APITokenService
 	Config                *config.Config
 }
 
@@ -86,12 +101,18 @@ func New(p Params) *gin.Engine {
 	engine.Use(middleware.CORS())
 
 	// Authorization middleware (for protected routes)
-	auth := middleware.NewAuthorization(p.RoleUseCase)
-	sessionAuth := middleware.SessionAuth(p.SessionRepository)
+	auth := middleware.NewAuthorization(p.RoleUseCase, p.TwoFactorService, p.Config.TwoFactor.EnforceForAdmins)
+	sessionAuth := middleware.SessionAuth(p.SessionRepository, p.Config.Session.TouchInterval)
+	// Content management endpoints also accept personal access tokens from scripted clients
+	tokenAuth := middleware.APITokenAuth(p.APITokenService, sessionAuth)
+	// Public reads still resolve a signed-in viewer so subscribers and buyers see gated content
+	optionalAuth := middleware.OptionalSessionAuth(p.SessionRepository)
 	rateLimit := middleware.RateLimit(p.RedisClient, 100, time.Minute)
 
-	// Serve static files for avatar uploads
-	engine.Static("/uploads", "./uploads")
+	// Serve uploaded media from disk when stored locally; the s3 driver serves it from the bucket or CDN
+	if storage := p.Config.Storage; storage.Driver == "local" && strings.HasPrefix(storage.PublicURL, "/") {
+		engine.Static(storage.PublicURL, storage.LocalDir)
+	}
 
 	// Swagger documentation
 	engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -102,14 +123,16 @@ func New(p Params) *gin.Engine {
 		RegisterHealthRoutes(engine, v1, p)
 		RegisterAuthRoutes(v1, p, rateLimit, sessionAuth)
 		RegisterProfileRoutes(v1, p, sessionAuth)
+		RegisterMediaRoutes(v1, p, sessionAuth)
 		RegisterUserRoutes(v1, p, auth, sessionAuth)
 		RegisterRoleRoutes(v1, p, auth, sessionAuth)
-		RegisterBlogRoutes(v1, p, auth, sessionAuth)
-		RegisterVersionRoutes(v1, p, auth, sessionAuth)
-		RegisterSeriesRoutes(v1, p, auth, sessionAuth)
+		RegisterBlogRoutes(v1, p, auth, sessionAuth, tokenAuth, optionalAuth)
+		RegisterVersionRoutes(v1, p, auth, tokenAuth)
+		RegisterSeriesRoutes(v1, p, auth, sessionAuth, tokenAuth)
 		RegisterCommentRoutes(v1, p, auth, sessionAuth)
-		RegisterCategoryRoutes(v1, p, auth, sessionAuth)
-		RegisterTagRoutes(v1, p, auth, sessionAuth)
+		RegisterCategoryRoutes(v1, p, auth, tokenAuth)
+		RegisterTagRoutes(v1, p, auth, tokenAuth)
+		RegisterSearchRoutes(v1, p, optionalAuth)
 		RegisterSubscriptionRoutes(v1, p, sessionAuth)
 		RegisterBookmarkRoutes(v1, p, sessionAuth)
 		RegisterReadingHistoryRoutes(v1, p, sessionAuth)
@@ -120,9 +143,12 @@ func New(p Params) *gin.Engine {
 
 		// Payment & Webhooks
 		RegisterPaymentRoutes(v1, p.PaymentHandler, p.WebhookHandler, sessionAuth)
+		RegisterPaymentAdminRoutes(v1, p, auth, sessionAuth)
+		RegisterEarningsRoutes(v1, p, sessionAuth)
+		RegisterGiftRoutes(v1, p, sessionAuth)
 
 		// Plan routes (multi-tier subscription)
-		RegisterPlanRoutes(v1, p.PlanHandler, sessionAuth)
+		RegisterPlanRoutes(v1, p.PlanHandler, sessionAuth, tokenAuth, optionalAuth)
 	}
 
 	return engine
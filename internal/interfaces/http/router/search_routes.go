@@ -0,0 +1,10 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterSearchRoutes(v1 *gin.RouterGroup, p Params, optionalAuth gin.HandlerFunc) {
+	// Public, but signed-in viewers also see what their session grants access to
+	v1.GET("/search", optionalAuth, p.SearchHandler.Search)
+}
@@ -5,17 +5,22 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterSeriesRoutes(v1 *gin.RouterGroup, p Params, auth *middleware.Authorization, sessionAuth gin.HandlerFunc) {
+func RegisterSeriesRoutes(v1 *gin.RouterGroup, p Params, auth *middleware.Authorization, sessionAuth, tokenAuth gin.HandlerFunc) {
 	seriesGroup := v1.Group("/series")
 	{
 		seriesGroup.GET("", p.SeriesHandler.List)
 		seriesGroup.GET("/highlighted", p.SeriesHandler.GetHighlightedSeries)
 		seriesGroup.GET("/:id", p.SeriesHandler.GetByID)
 		seriesGroup.GET("/slug/:slug", p.SeriesHandler.GetBySlug)
-		seriesGroup.POST("", sessionAuth, auth.RequireCreate("series"), p.SeriesHandler.Create)
-		seriesGroup.PUT("/:id", sessionAuth, auth.RequireUpdate("series"), p.SeriesHandler.Update)
-		seriesGroup.DELETE("/:id", sessionAuth, auth.RequireDelete("series"), p.SeriesHandler.Delete)
-		seriesGroup.POST("/:id/blogs", sessionAuth, auth.RequireUpdate("series"), p.SeriesHandler.AddBlog)
-		seriesGroup.DELETE("/:id/blogs/:blogId", sessionAuth, auth.RequireUpdate("series"), p.SeriesHandler.RemoveBlog)
+		seriesGroup.GET("/:id/progress", sessionAuth, p.SeriesHandler.GetProgress)
+		seriesGroup.POST("", tokenAuth, auth.RequireCreate("series"), p.SeriesHandler.Create)
+		seriesGroup.PUT("/:id", tokenAuth, auth.RequireUpdate("series"), p.SeriesHandler.Update)
+		seriesGroup.DELETE("/:id", tokenAuth, auth.RequireDelete("series"), p.SeriesHandler.Delete)
+		seriesGroup.POST("/:id/blogs", tokenAuth, auth.RequireUpdate("series"), p.SeriesHandler.AddBlog)
+		seriesGroup.DELETE("/:id/blogs/:blogId", tokenAuth, auth.RequireUpdate("series"), p.SeriesHandler.RemoveBlog)
+		seriesGroup.PUT("/:id/blogs/order", tokenAuth, auth.RequireUpdate("series"), p.SeriesHandler.ReorderBlogs)
+		seriesGroup.PUT("/:id/blogs/:blogId/preview", tokenAuth, auth.RequireUpdate("series"), p.SeriesHandler.SetBlogPreview)
 	}
+
+	v1.GET("/me/library", sessionAuth, p.SeriesHandler.GetLibrary)
 }
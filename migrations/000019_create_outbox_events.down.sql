@@ -0,0 +1,9 @@
+-- Rollback: Drop outbox_events table
+
+DROP TRIGGER IF EXISTS update_outbox_events_updated_at ON outbox_events;
+
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+DROP INDEX IF EXISTS idx_outbox_events_processing;
+DROP INDEX IF EXISTS idx_outbox_events_aggregate;
+
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,9 @@
+-- Rollback: Drop batch_jobs table
+
+DROP TRIGGER IF EXISTS update_batch_jobs_updated_at ON batch_jobs;
+
+DROP INDEX IF EXISTS idx_batch_jobs_type;
+DROP INDEX IF EXISTS idx_batch_jobs_status;
+DROP INDEX IF EXISTS idx_batch_jobs_created_at;
+
+DROP TABLE IF EXISTS batch_jobs;
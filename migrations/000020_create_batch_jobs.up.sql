@@ -0,0 +1,32 @@
+-- Migration: Create batch_jobs table
+-- Description: Persistent, resumable background jobs (fraud batch analysis)
+
+CREATE TABLE IF NOT EXISTS batch_jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_type VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('running', 'completed', 'failed', 'cancelled')),
+    date_from TIMESTAMP NOT NULL,
+    date_to TIMESTAMP NOT NULL,
+    processed_followers INT NOT NULL DEFAULT 0,
+    new_signals_detected INT NOT NULL DEFAULT 0,
+    users_scored INT NOT NULL DEFAULT 0,
+    checkpoint_id UUID,
+    checkpoint_at TIMESTAMP,
+    errors JSONB NOT NULL DEFAULT '[]',
+    message VARCHAR(255),
+    locked_by VARCHAR(100),
+    locked_until TIMESTAMP,
+    started_at TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_batch_jobs_type ON batch_jobs(job_type);
+CREATE INDEX IF NOT EXISTS idx_batch_jobs_status ON batch_jobs(status);
+CREATE INDEX IF NOT EXISTS idx_batch_jobs_created_at ON batch_jobs(created_at DESC);
+
+CREATE TRIGGER update_batch_jobs_updated_at
+    BEFORE UPDATE ON batch_jobs
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
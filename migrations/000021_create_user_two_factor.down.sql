@@ -0,0 +1,8 @@
+-- Rollback: Drop two-factor authentication tables
+
+DROP TRIGGER IF EXISTS update_user_two_factor_updated_at ON user_two_factor;
+
+DROP INDEX IF EXISTS idx_two_factor_recovery_codes_user_hash;
+
+DROP TABLE IF EXISTS two_factor_recovery_codes;
+DROP TABLE IF EXISTS user_two_factor;
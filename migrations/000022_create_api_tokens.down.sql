@@ -0,0 +1,8 @@
+-- Rollback: Drop personal access tokens
+
+DROP TRIGGER IF EXISTS update_api_tokens_updated_at ON api_tokens;
+
+DROP INDEX IF EXISTS idx_api_tokens_user_id;
+DROP INDEX IF EXISTS idx_api_tokens_token_hash;
+
+DROP TABLE IF EXISTS api_tokens;
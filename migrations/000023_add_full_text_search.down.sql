@@ -0,0 +1,11 @@
+-- Rollback: Full-text search
+
+DROP INDEX IF EXISTS idx_users_search;
+DROP INDEX IF EXISTS idx_tags_search;
+DROP INDEX IF EXISTS idx_series_search;
+DROP INDEX IF EXISTS idx_blogs_search_vector;
+
+DROP TRIGGER IF EXISTS update_blogs_search_vector ON blogs;
+DROP FUNCTION IF EXISTS blogs_search_vector_update();
+
+ALTER TABLE blogs DROP COLUMN IF EXISTS search_vector;
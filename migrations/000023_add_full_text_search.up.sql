@@ -0,0 +1,37 @@
+-- Migration: Full-text search
+-- Description: Weighted tsvector on blogs kept current by a trigger, plus GIN indexes for
+-- series, tags and authors. The 'simple' configuration does no stemming, so it works the
+-- same for every language authors write in.
+
+ALTER TABLE blogs ADD COLUMN IF NOT EXISTS search_vector TSVECTOR;
+
+CREATE OR REPLACE FUNCTION blogs_search_vector_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('simple', COALESCE(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('simple', COALESCE(NEW.excerpt, '')), 'B') ||
+        setweight(to_tsvector('simple', COALESCE(NEW.content, '')), 'C');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER update_blogs_search_vector
+    BEFORE INSERT OR UPDATE OF title, excerpt, content ON blogs
+    FOR EACH ROW
+    EXECUTE FUNCTION blogs_search_vector_update();
+
+UPDATE blogs SET search_vector =
+    setweight(to_tsvector('simple', COALESCE(title, '')), 'A') ||
+    setweight(to_tsvector('simple', COALESCE(excerpt, '')), 'B') ||
+    setweight(to_tsvector('simple', COALESCE(content, '')), 'C');
+
+CREATE INDEX IF NOT EXISTS idx_blogs_search_vector ON blogs USING GIN (search_vector);
+
+-- Expression indexes; queries must use the identical expression to hit them
+CREATE INDEX IF NOT EXISTS idx_series_search ON series
+    USING GIN (to_tsvector('simple', title || ' ' || COALESCE(description, '')));
+CREATE INDEX IF NOT EXISTS idx_tags_search ON tags
+    USING GIN (to_tsvector('simple', name));
+CREATE INDEX IF NOT EXISTS idx_users_search ON users
+    USING GIN (to_tsvector('simple', name || ' ' || COALESCE(display_name, '')));
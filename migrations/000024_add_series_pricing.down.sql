@@ -0,0 +1,7 @@
+-- Rollback: Paid series
+
+ALTER TABLE series_blogs DROP COLUMN IF EXISTS is_preview;
+
+ALTER TABLE series
+DROP COLUMN IF EXISTS currency,
+DROP COLUMN IF EXISTS price;
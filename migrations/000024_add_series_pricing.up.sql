@@ -0,0 +1,10 @@
+-- Migration: Paid series
+-- Description: Adds a price and currency to series and lets authors mark chapters of a
+-- paid series as free previews. A price of 0 keeps a series free.
+
+ALTER TABLE series
+ADD COLUMN IF NOT EXISTS price DECIMAL(20,2) NOT NULL DEFAULT 0 CHECK (price >= 0),
+ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'VND';
+
+ALTER TABLE series_blogs
+ADD COLUMN IF NOT EXISTS is_preview BOOLEAN NOT NULL DEFAULT false;
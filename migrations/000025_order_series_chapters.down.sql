@@ -0,0 +1,4 @@
+-- Rollback: Ordered series chapters
+-- Chapter numbers are kept; only the index is dropped.
+
+DROP INDEX IF EXISTS idx_series_blogs_series_order;
@@ -0,0 +1,14 @@
+-- Migration: Ordered series chapters
+-- Description: Chapters were added without a sort order, so every row holds 0. Number
+-- existing chapters in the order they were added and index the order for navigation.
+
+UPDATE series_blogs sb
+SET sort_order = ordered.position
+FROM (
+    SELECT series_id, blog_id,
+           ROW_NUMBER() OVER (PARTITION BY series_id ORDER BY sort_order, created_at) AS position
+    FROM series_blogs
+) ordered
+WHERE sb.series_id = ordered.series_id AND sb.blog_id = ordered.blog_id;
+
+CREATE INDEX IF NOT EXISTS idx_series_blogs_series_order ON series_blogs(series_id, sort_order);
@@ -0,0 +1,9 @@
+-- Rollback: Subscription lifecycle
+
+DROP TABLE IF EXISTS subscription_events;
+
+DROP INDEX IF EXISTS idx_subscriptions_grace_ends_at;
+
+ALTER TABLE subscriptions
+DROP COLUMN IF EXISTS reminder_sent_at,
+DROP COLUMN IF EXISTS grace_ends_at;
@@ -0,0 +1,24 @@
+-- Migration: Subscription lifecycle
+-- Description: Tracks the grace period and expiry reminder of paid subscriptions and
+-- records every lifecycle transition (activation, renewal, reminder, grace, downgrade).
+
+ALTER TABLE subscriptions
+ADD COLUMN IF NOT EXISTS grace_ends_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS reminder_sent_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_subscriptions_grace_ends_at ON subscriptions(grace_ends_at) WHERE grace_ends_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS subscription_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    subscription_id UUID NOT NULL REFERENCES subscriptions(id) ON DELETE CASCADE,
+    subscriber_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('activated', 'renewed', 'reminder_sent', 'grace_started', 'downgraded')),
+    tier VARCHAR(20) NOT NULL,
+    expires_at TIMESTAMP,
+    grace_ends_at TIMESTAMP,
+    transaction_id UUID REFERENCES transactions(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscription_events_subscription ON subscription_events(subscription_id, created_at DESC);
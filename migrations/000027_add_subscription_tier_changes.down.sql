@@ -0,0 +1,11 @@
+-- Rollback: Subscription tier changes
+
+DELETE FROM subscription_events WHERE type IN ('upgraded', 'downgrade_scheduled', 'tier_changed');
+
+ALTER TABLE subscription_events DROP CONSTRAINT IF EXISTS subscription_events_type_check;
+ALTER TABLE subscription_events ADD CONSTRAINT subscription_events_type_check
+    CHECK (type IN ('activated', 'renewed', 'reminder_sent', 'grace_started', 'downgraded'));
+
+ALTER TABLE subscriptions
+DROP COLUMN IF EXISTS scheduled_expires_at,
+DROP COLUMN IF EXISTS scheduled_tier;
@@ -0,0 +1,11 @@
+-- Migration: Subscription tier changes
+-- Description: Lets a paid subscription carry a downgrade that takes effect when the current
+-- period ends, and records upgrades and scheduled downgrades in the lifecycle history.
+
+ALTER TABLE subscriptions
+ADD COLUMN IF NOT EXISTS scheduled_tier VARCHAR(20),
+ADD COLUMN IF NOT EXISTS scheduled_expires_at TIMESTAMP;
+
+ALTER TABLE subscription_events DROP CONSTRAINT IF EXISTS subscription_events_type_check;
+ALTER TABLE subscription_events ADD CONSTRAINT subscription_events_type_check
+    CHECK (type IN ('activated', 'renewed', 'upgraded', 'downgrade_scheduled', 'tier_changed', 'reminder_sent', 'grace_started', 'downgraded'));
@@ -0,0 +1,6 @@
+-- Rollback: Payment reconciliations
+
+DROP TABLE IF EXISTS payment_reconciliations;
+
+ALTER TABLE transactions
+DROP COLUMN IF EXISTS paid_amount;
@@ -0,0 +1,26 @@
+-- Migration: Payment reconciliations
+-- Description: Records the amount actually received for a transaction and queues transfers that
+-- do not match their order (underpaid, overpaid or for an order that is no longer payable) for review.
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS paid_amount DECIMAL(20,2);
+
+CREATE TABLE IF NOT EXISTS payment_reconciliations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaction_id UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    sepay_id VARCHAR(100) NOT NULL UNIQUE,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('underpaid', 'overpaid', 'order_closed')),
+    expected_amount DECIMAL(19,4) NOT NULL,
+    received_amount DECIMAL(19,4) NOT NULL,
+    currency VARCHAR(10) NOT NULL DEFAULT 'VND',
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'granted', 'dismissed')),
+    note TEXT,
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_reconciliations_status ON payment_reconciliations(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_payment_reconciliations_transaction ON payment_reconciliations(transaction_id);
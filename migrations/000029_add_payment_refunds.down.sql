@@ -0,0 +1,18 @@
+-- Rollback: Payment refunds
+
+DROP INDEX IF EXISTS idx_subscription_events_transaction;
+
+DELETE FROM subscription_events WHERE type = 'refunded';
+
+ALTER TABLE subscription_events DROP CONSTRAINT IF EXISTS subscription_events_type_check;
+ALTER TABLE subscription_events ADD CONSTRAINT subscription_events_type_check
+    CHECK (type IN ('activated', 'renewed', 'upgraded', 'downgrade_scheduled', 'tier_changed', 'reminder_sent', 'grace_started', 'downgraded'));
+
+ALTER TABLE subscription_events
+DROP COLUMN IF EXISTS previous_expires_at,
+DROP COLUMN IF EXISTS previous_tier;
+
+DROP TABLE IF EXISTS payment_refunds;
+
+ALTER TABLE transactions
+DROP COLUMN IF EXISTS refunded_amount;
@@ -0,0 +1,32 @@
+-- Migration: Payment refunds
+-- Description: Records refunds and chargebacks against transactions and keeps the subscription
+-- state before each paid transition so a refunded period can be rolled back.
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS refunded_amount DECIMAL(20,2) NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS payment_refunds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaction_id UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('refund', 'chargeback')),
+    amount DECIMAL(19,4) NOT NULL CHECK (amount > 0),
+    currency VARCHAR(10) NOT NULL DEFAULT 'VND',
+    reason TEXT NOT NULL,
+    provider_ref VARCHAR(255),
+    benefit_revoked BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_refunds_transaction ON payment_refunds(transaction_id, created_at);
+
+ALTER TABLE subscription_events
+ADD COLUMN IF NOT EXISTS previous_tier VARCHAR(20),
+ADD COLUMN IF NOT EXISTS previous_expires_at TIMESTAMP;
+
+ALTER TABLE subscription_events DROP CONSTRAINT IF EXISTS subscription_events_type_check;
+ALTER TABLE subscription_events ADD CONSTRAINT subscription_events_type_check
+    CHECK (type IN ('activated', 'renewed', 'upgraded', 'downgrade_scheduled', 'tier_changed', 'reminder_sent', 'grace_started', 'downgraded', 'refunded'));
+
+CREATE INDEX IF NOT EXISTS idx_subscription_events_transaction ON subscription_events(transaction_id);
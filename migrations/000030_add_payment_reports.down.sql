@@ -0,0 +1,9 @@
+-- Rollback: Payment reports
+
+DROP TABLE IF EXISTS payment_reports;
+
+DROP INDEX IF EXISTS idx_transactions_paid_at;
+DROP INDEX IF EXISTS idx_transactions_status_created;
+
+ALTER TABLE transactions
+DROP COLUMN IF EXISTS paid_at;
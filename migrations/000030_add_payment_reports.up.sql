@@ -0,0 +1,23 @@
+-- Migration: Payment reports
+-- Description: Records when a transfer was applied to an order, lets unpaid orders expire and
+-- stores the daily reconciliation of orders against the transfers recorded by SePay.
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS paid_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_status_created ON transactions(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_transactions_paid_at ON transactions(paid_at) WHERE paid_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS payment_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    day VARCHAR(10) NOT NULL UNIQUE,
+    period_start TIMESTAMP NOT NULL,
+    period_end TIMESTAMP NOT NULL,
+    orders_created BIGINT NOT NULL DEFAULT 0,
+    orders_paid BIGINT NOT NULL DEFAULT 0,
+    orders_expired BIGINT NOT NULL DEFAULT 0,
+    orders_pending BIGINT NOT NULL DEFAULT 0,
+    paid_amount DECIMAL(19,4) NOT NULL DEFAULT 0,
+    mismatches JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,4 @@
+-- Rollback: Earnings ledger
+
+DROP TABLE IF EXISTS payouts;
+DROP TABLE IF EXISTS ledger_entries;
@@ -0,0 +1,46 @@
+-- Migration: Earnings ledger
+-- Description: Double-entry ledger splitting every payment between the author it is attributed to
+-- and the platform fee, and payout requests through which authors withdraw their balance.
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    journal_id UUID NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('payment', 'refund', 'payout')),
+    source_id UUID NOT NULL,
+    account VARCHAR(30) NOT NULL CHECK (account IN ('gateway', 'platform_revenue', 'author_payable')),
+    author_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    amount DECIMAL(19,4) NOT NULL,
+    currency VARCHAR(10) NOT NULL DEFAULT 'VND',
+    transaction_id UUID REFERENCES transactions(id) ON DELETE SET NULL,
+    transaction_type VARCHAR(20),
+    tier VARCHAR(20),
+    series_id UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- A source is posted once; the same account never appears twice in its journal
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ledger_entries_source ON ledger_entries(kind, source_id, account);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_journal ON ledger_entries(journal_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_author ON ledger_entries(author_id, account, created_at);
+
+CREATE TABLE IF NOT EXISTS payouts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount DECIMAL(19,4) NOT NULL CHECK (amount > 0),
+    currency VARCHAR(10) NOT NULL DEFAULT 'VND',
+    bank_name VARCHAR(100) NOT NULL,
+    account_no VARCHAR(50) NOT NULL,
+    account_name VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    note TEXT,
+    reference VARCHAR(255),
+    reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payouts_author ON payouts(author_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_payouts_status ON payouts(status, created_at);
+-- At most one request per author waits for review
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payouts_one_pending ON payouts(author_id) WHERE status = 'pending';
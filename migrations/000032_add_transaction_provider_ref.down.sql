@@ -0,0 +1,4 @@
+-- Rollback: Payment provider references
+
+DROP INDEX IF EXISTS idx_transactions_provider_ref;
+ALTER TABLE transactions DROP COLUMN IF EXISTS provider_ref;
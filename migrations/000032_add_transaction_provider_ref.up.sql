@@ -0,0 +1,9 @@
+-- Migration: Payment provider references
+-- Description: Card providers identify a payment by the checkout session opened for it; the
+-- reference is stored so webhooks and status lookups find their order.
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS provider_ref VARCHAR(255);
+
+-- A checkout session pays exactly one order
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_provider_ref
+    ON transactions(provider, provider_ref) WHERE provider_ref IS NOT NULL;
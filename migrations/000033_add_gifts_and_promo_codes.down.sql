@@ -0,0 +1,10 @@
+-- Rollback: Gift subscriptions and promo codes
+
+DROP TABLE IF EXISTS gift_subscriptions;
+
+ALTER TABLE transactions
+DROP COLUMN IF EXISTS discount,
+DROP COLUMN IF EXISTS promo_code_id,
+DROP COLUMN IF EXISTS gift;
+
+DROP TABLE IF EXISTS promo_codes;
@@ -0,0 +1,43 @@
+-- Migration: Gift subscriptions and promo codes
+-- Description: Subscriptions paid for another user issue a code the recipient redeems, and authors
+-- offer promo codes discounting their plans.
+
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code VARCHAR(50) NOT NULL,
+    discount_type VARCHAR(20) NOT NULL CHECK (discount_type IN ('percent', 'fixed')),
+    discount_value DECIMAL(20,2) NOT NULL CHECK (discount_value > 0),
+    tier VARCHAR(20),
+    max_redemptions INTEGER CHECK (max_redemptions > 0),
+    redemption_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (discount_type <> 'percent' OR discount_value <= 100)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_promo_codes_author_code ON promo_codes(author_id, code);
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS gift BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN IF NOT EXISTS promo_code_id UUID REFERENCES promo_codes(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS discount DECIMAL(20,2) NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS gift_subscriptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(20) NOT NULL UNIQUE,
+    transaction_id UUID NOT NULL UNIQUE REFERENCES transactions(id) ON DELETE CASCADE,
+    purchaser_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    plan_id UUID NOT NULL REFERENCES subscription_plans(id),
+    tier VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'issued' CHECK (status IN ('issued', 'redeemed', 'revoked')),
+    redeemed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    redeemed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gift_subscriptions_purchaser ON gift_subscriptions(purchaser_id, created_at);
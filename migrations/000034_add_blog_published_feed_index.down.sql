@@ -0,0 +1,3 @@
+-- Rollback: Published blog feed index
+
+DROP INDEX IF EXISTS idx_blogs_published_feed;
@@ -0,0 +1,6 @@
+-- Migration: Published blog feed index
+-- Description: Feeds page through published blogs by (published_at, id), newest first,
+-- starting after the last blog of the previous page.
+
+CREATE INDEX IF NOT EXISTS idx_blogs_published_feed
+    ON blogs(published_at DESC, id DESC) WHERE status = 'published' AND deleted_at IS NULL;
@@ -0,0 +1,5 @@
+-- Rollback: Scheduled blog publishing
+
+DROP INDEX IF EXISTS idx_blogs_scheduled_due;
+ALTER TABLE blogs DROP COLUMN IF EXISTS publish_timezone;
+ALTER TABLE blogs DROP COLUMN IF EXISTS announced_at;
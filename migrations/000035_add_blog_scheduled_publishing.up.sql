@@ -0,0 +1,14 @@
+-- Migration: Scheduled blog publishing
+-- Description: announced_at records when followers were told about a post, so the scheduled
+-- publishing job announces every post exactly once when its publish time arrives.
+-- publish_timezone keeps the IANA timezone an author scheduled a post in.
+
+ALTER TABLE blogs ADD COLUMN IF NOT EXISTS announced_at TIMESTAMP;
+ALTER TABLE blogs ADD COLUMN IF NOT EXISTS publish_timezone VARCHAR(64);
+
+-- Posts that are already live were announced when they were published
+UPDATE blogs SET announced_at = published_at
+WHERE status = 'published' AND published_at <= NOW() AND announced_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_blogs_scheduled_due
+    ON blogs(published_at) WHERE status = 'published' AND announced_at IS NULL AND deleted_at IS NULL;
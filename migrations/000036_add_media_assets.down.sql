@@ -0,0 +1,4 @@
+-- Rollback: Media assets
+
+DROP TABLE IF EXISTS user_media_usage;
+DROP TABLE IF EXISTS media_assets;
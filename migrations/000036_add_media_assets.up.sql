@@ -0,0 +1,25 @@
+-- Migration: Media assets
+-- Description: Images uploaded through the media pipeline, with their resized variants, and the
+-- bytes each user stores, checked against the per-user quota.
+
+CREATE TABLE IF NOT EXISTS media_assets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose VARCHAR(20) NOT NULL CHECK (purpose IN ('avatar', 'blog_thumbnail', 'blog_inline')),
+    content_type VARCHAR(50) NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    size BIGINT NOT NULL CHECK (size >= 0),
+    key VARCHAR(255) NOT NULL UNIQUE,
+    url VARCHAR(500) NOT NULL,
+    variants JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_media_assets_owner ON media_assets(owner_id, created_at);
+
+CREATE TABLE IF NOT EXISTS user_media_usage (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    bytes_used BIGINT NOT NULL DEFAULT 0 CHECK (bytes_used >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
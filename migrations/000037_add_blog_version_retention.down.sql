@@ -0,0 +1,3 @@
+-- Rollback: Blog version retention
+
+DROP TABLE IF EXISTS blog_version_retention;
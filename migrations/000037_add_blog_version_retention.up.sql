@@ -0,0 +1,11 @@
+-- Migration: Blog version retention
+-- Description: Per-blog policy for pruning old versions. Blogs without a row keep the default
+-- number of versions and no age limit.
+
+CREATE TABLE IF NOT EXISTS blog_version_retention (
+    blog_id UUID PRIMARY KEY REFERENCES blogs(id) ON DELETE CASCADE,
+    max_versions INTEGER NOT NULL CHECK (max_versions >= 1),
+    max_age_days INTEGER CHECK (max_age_days >= 1),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
@@ -0,0 +1,4 @@
+-- Rollback: Payment refund status
+
+DROP INDEX IF EXISTS idx_payment_refunds_pending;
+ALTER TABLE payment_refunds DROP COLUMN IF EXISTS status;
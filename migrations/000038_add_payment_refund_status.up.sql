@@ -0,0 +1,13 @@
+-- Migration: Payment refund status
+-- Description: Refunds sent through a provider are recorded as pending before the provider is
+-- called, then completed once it confirms or failed when it declines, so money is never
+-- returned without a record of it.
+
+ALTER TABLE payment_refunds
+ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'completed'
+    CHECK (status IN ('pending', 'completed', 'failed'));
+
+-- One refund at a time may be in flight at the provider for a transaction; this also lets the
+-- reconciliation job find the refunds to retry
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_refunds_pending
+    ON payment_refunds(transaction_id) WHERE status = 'pending';
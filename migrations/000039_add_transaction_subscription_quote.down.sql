@@ -0,0 +1,12 @@
+-- Rollback: Transaction subscription quote
+
+DELETE FROM payment_reconciliations WHERE reason = 'plan_conflict';
+ALTER TABLE payment_reconciliations DROP CONSTRAINT IF EXISTS payment_reconciliations_reason_check;
+ALTER TABLE payment_reconciliations ADD CONSTRAINT payment_reconciliations_reason_check
+    CHECK (reason IN ('underpaid', 'overpaid', 'order_closed'));
+
+ALTER TABLE transactions
+DROP COLUMN IF EXISTS quoted_expires_at,
+DROP COLUMN IF EXISTS period_expires_at,
+DROP COLUMN IF EXISTS period_starts_at,
+DROP COLUMN IF EXISTS subscription_change;
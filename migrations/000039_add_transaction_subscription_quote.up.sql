@@ -0,0 +1,15 @@
+-- Migration: Transaction subscription quote
+-- Description: Subscription orders keep the change, period and basis they were priced with at
+-- checkout so the webhook grants exactly what was paid for. Payments whose subscription changed
+-- in the meantime are queued for reconciliation with the plan_conflict reason.
+
+ALTER TABLE transactions
+ADD COLUMN IF NOT EXISTS subscription_change VARCHAR(20)
+    CHECK (subscription_change IN ('new', 'renewal', 'upgrade', 'downgrade')),
+ADD COLUMN IF NOT EXISTS period_starts_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS period_expires_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS quoted_expires_at TIMESTAMP;
+
+ALTER TABLE payment_reconciliations DROP CONSTRAINT IF EXISTS payment_reconciliations_reason_check;
+ALTER TABLE payment_reconciliations ADD CONSTRAINT payment_reconciliations_reason_check
+    CHECK (reason IN ('underpaid', 'overpaid', 'order_closed', 'plan_conflict'));
@@ -0,0 +1,3 @@
+-- Rollback: Create outbox_deliveries table
+
+DROP TABLE IF EXISTS outbox_deliveries;
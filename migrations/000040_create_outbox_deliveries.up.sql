@@ -0,0 +1,10 @@
+-- Migration: Create outbox_deliveries table
+-- Description: Records which event bus handlers received each outbox event, so a failed
+-- delivery is retried only for the handlers that failed
+
+CREATE TABLE IF NOT EXISTS outbox_deliveries (
+    event_id UUID NOT NULL REFERENCES outbox_events(id) ON DELETE CASCADE,
+    handler VARCHAR(100) NOT NULL,
+    delivered_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, handler)
+);
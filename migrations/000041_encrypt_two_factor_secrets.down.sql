@@ -0,0 +1,9 @@
+-- Rollback: Widen two-factor secret column
+-- Encrypted secrets are unreadable without the application key, so those enrollments are reset
+
+DELETE FROM two_factor_recovery_codes
+WHERE user_id IN (SELECT user_id FROM user_two_factor WHERE secret LIKE 'v1:%');
+
+DELETE FROM user_two_factor WHERE secret LIKE 'v1:%';
+
+ALTER TABLE user_two_factor ALTER COLUMN secret TYPE VARCHAR(64);
@@ -0,0 +1,5 @@
+-- Migration: Widen two-factor secret column
+-- Description: TOTP secrets are stored encrypted with the application key, which no longer fits 64 characters.
+-- Secrets enrolled earlier stay readable and are encrypted the next time their code is accepted.
+
+ALTER TABLE user_two_factor ALTER COLUMN secret TYPE VARCHAR(255);
@@ -0,0 +1,9 @@
+-- Rollback: Align transaction and promo code money precision
+
+ALTER TABLE promo_codes ALTER COLUMN discount_value TYPE DECIMAL(20,2);
+
+ALTER TABLE transactions
+    ALTER COLUMN amount TYPE DECIMAL(20,2),
+    ALTER COLUMN paid_amount TYPE DECIMAL(20,2),
+    ALTER COLUMN refunded_amount TYPE DECIMAL(20,2),
+    ALTER COLUMN discount TYPE DECIMAL(20,2);
@@ -0,0 +1,11 @@
+-- Migration: Align transaction and promo code money precision
+-- Description: Transaction amounts and promo discounts were created as DECIMAL(20,2) while the entities map them
+-- as decimal(19,4), so fractional card amounts and percentage discounts were rounded by the database.
+
+ALTER TABLE transactions
+    ALTER COLUMN amount TYPE DECIMAL(19,4),
+    ALTER COLUMN paid_amount TYPE DECIMAL(19,4),
+    ALTER COLUMN refunded_amount TYPE DECIMAL(19,4),
+    ALTER COLUMN discount TYPE DECIMAL(19,4);
+
+ALTER TABLE promo_codes ALTER COLUMN discount_value TYPE DECIMAL(19,4);
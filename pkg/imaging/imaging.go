@@ -0,0 +1,148 @@
+// Package imaging validates uploaded images and re-encodes them without metadata.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const jpegQuality = 85
+
+var (
+	// ErrUnsupportedFormat is returned when the data is not a JPEG, PNG, GIF or WebP image
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	// ErrImageTooLarge is returned when the image has more pixels than allowed
+	ErrImageTooLarge = errors.New("image dimensions too large")
+)
+
+// formats maps the content types accepted, as sniffed from their magic bytes, to the name
+// their decoder is registered under
+var formats = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+	"image/webp": "webp",
+}
+
+// Options controls how an image is processed
+type Options struct {
+	MaxPixels int   // Images with more pixels are rejected before being decoded, 0 means no limit
+	Widths    []int // Widths of the downscaled variants; widths not smaller than the image are skipped
+}
+
+// Image is an encoded image
+type Image struct {
+	ContentType string
+	Ext         string // File extension including the dot
+	Width       int
+	Height      int
+	Data        []byte
+}
+
+// Result is a processed upload: the re-encoded original and its downscaled variants, in the
+// order of Options.Widths
+type Result struct {
+	Original Image
+	Variants []Image
+}
+
+// DetectContentType returns the content type of an accepted image format, identified by its
+// magic bytes rather than a file name
+func DetectContentType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if _, ok := formats[contentType]; !ok {
+		return "", ErrUnsupportedFormat
+	}
+	return contentType, nil
+}
+
+// Process decodes an uploaded image and re-encodes it and its variants. Re-encoding drops
+// EXIF and other metadata; the EXIF orientation of a JPEG is applied to the pixels first so
+// the image still displays upright. JPEGs stay JPEG and other formats become PNG, so
+// animated GIFs keep only their first frame.
+func Process(data []byte, opts Options) (*Result, error) {
+	contentType, err := DetectContentType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the dimensions from the header before allocating the pixels
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || format != formats[contentType] {
+		return nil, ErrUnsupportedFormat
+	}
+	if opts.MaxPixels > 0 && cfg.Width*cfg.Height > opts.MaxPixels {
+		return nil, ErrImageTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if contentType == "image/jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+
+	encode := encodePNG
+	if contentType == "image/jpeg" {
+		encode = encodeJPEG
+	}
+
+	original, err := encode(img)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Original: *original}
+
+	bounds := img.Bounds()
+	for _, width := range opts.Widths {
+		if width <= 0 || width >= bounds.Dx() {
+			continue
+		}
+		height := max(1, bounds.Dy()*width/bounds.Dx())
+		dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+		draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+
+		variant, err := encode(dst)
+		if err != nil {
+			return nil, err
+		}
+		result.Variants = append(result.Variants, *variant)
+	}
+
+	return result, nil
+}
+
+func encodeJPEG(img image.Image) (*Image, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+	return newImage("image/jpeg", ".jpg", img, buf.Bytes()), nil
+}
+
+func encodePNG(img image.Image) (*Image, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return newImage("image/png", ".png", img, buf.Bytes()), nil
+}
+
+func newImage(contentType, ext string, img image.Image, data []byte) *Image {
+	return &Image{
+		ContentType: contentType,
+		Ext:         ext,
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+		Data:        data,
+	}
+}
@@ -0,0 +1,139 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testImage returns a w x h image whose top-left pixel is red and the rest white
+func testImage(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.White)
+		}
+	}
+	img.Set(0, 0, color.NRGBA{R: 255, A: 255})
+	return img
+}
+
+func encodeTestPNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// encodeTestJPEG encodes img with an APP1 Exif segment holding orientation and a camera model
+func encodeTestJPEG(t *testing.T, img image.Image, orientation uint16) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+	data := buf.Bytes()
+
+	tiff := []byte("MM\x00\x2A\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, exifOrientationTag)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3) // SHORT
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+	tiff = append(tiff, "Secret Camera"...)
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(payload)+2))
+	app1 = append(app1, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, app1...)
+	return append(out, data[2:]...)
+}
+
+func TestDetectContentType(t *testing.T) {
+	var gifBuf bytes.Buffer
+	require.NoError(t, gif.Encode(&gifBuf, testImage(4, 4), nil))
+
+	contentType, err := DetectContentType(encodeTestPNG(t, testImage(4, 4)))
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+
+	contentType, err = DetectContentType(gifBuf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "image/gif", contentType)
+
+	_, err = DetectContentType([]byte("<html><script>alert(1)</script></html>"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestProcess(t *testing.T) {
+	t.Run("jpeg_strips_exif_and_applies_orientation", func(t *testing.T) {
+		data := encodeTestJPEG(t, testImage(40, 20), 6)
+		require.Equal(t, 6, jpegOrientation(data))
+
+		result, err := Process(data, Options{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "image/jpeg", result.Original.ContentType)
+		assert.Equal(t, ".jpg", result.Original.Ext)
+		// Rotated a quarter turn clockwise, so the red corner moves to the top right
+		assert.Equal(t, 20, result.Original.Width)
+		assert.Equal(t, 40, result.Original.Height)
+		assert.NotContains(t, string(result.Original.Data), "Exif")
+		assert.NotContains(t, string(result.Original.Data), "Secret Camera")
+		assert.Equal(t, 1, jpegOrientation(result.Original.Data))
+
+		decoded, err := jpeg.Decode(bytes.NewReader(result.Original.Data))
+		require.NoError(t, err)
+		r, g, _, _ := decoded.At(19, 0).RGBA()
+		assert.Greater(t, r, g)
+	})
+
+	t.Run("generates_smaller_variants_only", func(t *testing.T) {
+		result, err := Process(encodeTestPNG(t, testImage(800, 400)), Options{Widths: []int{200, 400, 800, 1600}})
+
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", result.Original.ContentType)
+		require.Len(t, result.Variants, 2)
+		assert.Equal(t, 200, result.Variants[0].Width)
+		assert.Equal(t, 100, result.Variants[0].Height)
+		assert.Equal(t, 400, result.Variants[1].Width)
+
+		cfg, err := png.DecodeConfig(bytes.NewReader(result.Variants[1].Data))
+		require.NoError(t, err)
+		assert.Equal(t, 400, cfg.Width)
+		assert.Equal(t, 200, cfg.Height)
+	})
+
+	t.Run("gif_becomes_png", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, gif.Encode(&buf, testImage(10, 10), nil))
+
+		result, err := Process(buf.Bytes(), Options{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", result.Original.ContentType)
+		assert.Equal(t, ".png", result.Original.Ext)
+	})
+
+	t.Run("too_many_pixels", func(t *testing.T) {
+		_, err := Process(encodeTestPNG(t, testImage(100, 100)), Options{MaxPixels: 5000})
+
+		assert.ErrorIs(t, err, ErrImageTooLarge)
+	})
+
+	t.Run("truncated_image", func(t *testing.T) {
+		data := encodeTestPNG(t, testImage(100, 100))
+
+		_, err := Process(data[:len(data)/2], Options{})
+
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+}
@@ -0,0 +1,52 @@
+package markdown
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// ids generates heading anchors. Unlike the goldmark default it keeps non-ASCII letters, so
+// headings such as "Giới thiệu" get a readable anchor instead of losing their accented letters.
+type ids struct {
+	used map[string]bool
+}
+
+func newIDs() *ids {
+	return &ids{used: map[string]bool{}}
+}
+
+// Generate returns a unique anchor made of the lower-cased letters and digits of value, with
+// spaces, hyphens and underscores turned into hyphens
+func (s *ids) Generate(value []byte, kind ast.NodeKind) []byte {
+	var b strings.Builder
+	for _, r := range strings.TrimSpace(string(value)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		case unicode.IsSpace(r) || r == '-' || r == '_':
+			b.WriteByte('-')
+		}
+	}
+
+	id := b.String()
+	if id == "" {
+		id = "heading"
+		if kind != ast.KindHeading {
+			id = "id"
+		}
+	}
+	unique := id
+	for i := 1; s.used[unique]; i++ {
+		unique = id + "-" + strconv.Itoa(i)
+	}
+	s.used[unique] = true
+	return []byte(unique)
+}
+
+// Put marks an anchor given explicitly in the source as used
+func (s *ids) Put(value []byte) {
+	s.used[string(value)] = true
+}
@@ -0,0 +1,173 @@
+// Package markdown renders user written Markdown to sanitized HTML with a table of contents.
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// WordsPerMinute is the reading speed reading times are estimated with
+const WordsPerMinute = 200
+
+// Heading is an entry of the table of contents
+type Heading struct {
+	Level int    `json:"level"`
+	ID    string `json:"id"` // Anchor of the heading in the rendered HTML
+	Text  string `json:"text"`
+}
+
+// Document is Markdown rendered for display
+type Document struct {
+	HTML               string    `json:"html"`
+	TOC                []Heading `json:"toc"` // Headings in document order
+	WordCount          int       `json:"wordCount"`
+	ReadingTimeMinutes int       `json:"readingTimeMinutes"`
+}
+
+// Raw HTML in the source is dropped by the Markdown renderer; the policy is a second line of
+// defence for what the renderer itself produces, such as links and images.
+var (
+	md = goldmark.New(
+		goldmark.WithExtensions(
+			extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+			extension.Strikethrough,
+			extension.Linkify,
+			extension.TaskList,
+		),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+	policy = newPolicy()
+)
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#.-]+$`)).OnElements("code")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	return p
+}
+
+// Render converts Markdown to sanitized HTML and collects its headings and word count
+func Render(source string) (*Document, error) {
+	src := []byte(source)
+	doc := md.Parser().Parse(text.NewReader(src), parser.WithContext(parser.NewContext(parser.WithIDs(newIDs()))))
+
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, src, doc); err != nil {
+		return nil, err
+	}
+
+	result := &Document{
+		HTML: policy.Sanitize(buf.String()),
+		TOC:  []Heading{},
+	}
+	var plain strings.Builder
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		if heading, ok := n.(*ast.Heading); ok {
+			id, _ := heading.AttributeString("id")
+			idBytes, _ := id.([]byte)
+			result.TOC = append(result.TOC, Heading{
+				Level: heading.Level,
+				ID:    string(idBytes),
+				Text:  inlineText(heading, src),
+			})
+		}
+		switch {
+		case n.Kind() == ast.KindHTMLBlock:
+			return ast.WalkSkipChildren, nil
+		case n.Kind() == ast.KindCodeBlock || n.Kind() == ast.KindFencedCodeBlock:
+			writeLines(&plain, n, src)
+			return ast.WalkSkipChildren, nil
+		case n.FirstChild() != nil && n.FirstChild().Type() == ast.TypeInline:
+			plain.WriteString(inlineText(n, src))
+			plain.WriteByte(' ')
+			return ast.WalkSkipChildren, nil
+		}
+		return ast.WalkContinue, nil
+	})
+
+	result.WordCount = len(strings.Fields(plain.String()))
+	result.ReadingTimeMinutes = (result.WordCount + WordsPerMinute - 1) / WordsPerMinute
+	return result, nil
+}
+
+// Excerpt returns the text of the leading paragraphs, cut at a word boundary so that it is at
+// most maxLen characters including the ellipsis. Headings, code and tables are left out.
+func Excerpt(source string, maxLen int) string {
+	src := []byte(source)
+	doc := md.Parser().Parse(text.NewReader(src))
+
+	var words []string
+	length := 0
+	truncated := false
+	for n := doc.FirstChild(); n != nil && !truncated; n = n.NextSibling() {
+		if n.Kind() != ast.KindParagraph {
+			continue
+		}
+		for _, word := range strings.Fields(inlineText(n, src)) {
+			wordLen := utf8.RuneCountInString(word)
+			if len(words) > 0 {
+				wordLen++ // Separating space
+			}
+			if length+wordLen > maxLen-1 {
+				truncated = true
+				break
+			}
+			words = append(words, word)
+			length += wordLen
+		}
+	}
+
+	excerpt := strings.Join(words, " ")
+	if truncated {
+		excerpt = strings.TrimRight(excerpt, ".,;:!?-") + "…"
+	}
+	return excerpt
+}
+
+// inlineText returns the text of the inline content of n, without markup
+func inlineText(n ast.Node, source []byte) string {
+	var buf strings.Builder
+	_ = ast.Walk(n, func(c ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node := c.(type) {
+		case *ast.Text:
+			buf.Write(node.Segment.Value(source))
+			if node.SoftLineBreak() || node.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(node.Value)
+		case *ast.AutoLink:
+			buf.Write(node.Label(source))
+		case *ast.RawHTML:
+			return ast.WalkSkipChildren, nil
+		}
+		return ast.WalkContinue, nil
+	})
+	return strings.TrimSpace(buf.String())
+}
+
+func writeLines(buf *strings.Builder, n ast.Node, source []byte) {
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		buf.Write(segment.Value(source))
+	}
+	buf.WriteByte(' ')
+}
@@ -0,0 +1,69 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_SanitizesHTML(t *testing.T) {
+	doc, err := Render("Hello <script>alert(1)</script> **world**\n\n" +
+		"<img src=x onerror=alert(1)>\n\n" +
+		"[click](javascript:alert(1)) ![pic](https://cdn.example/a.png \"A\")\n")
+	require.NoError(t, err)
+
+	assert.Contains(t, doc.HTML, "<strong>world</strong>")
+	assert.NotContains(t, doc.HTML, "<script")
+	assert.NotContains(t, doc.HTML, "onerror")
+	assert.NotContains(t, doc.HTML, "javascript:")
+	assert.Contains(t, doc.HTML, `<img src="https://cdn.example/a.png" alt="pic" title="A">`)
+}
+
+func TestRender_GFM(t *testing.T) {
+	doc, err := Render("| a | b |\n|:--|--:|\n| 1 | 2 |\n\n- [x] done\n- [ ] todo\n\n~~old~~ https://example.com\n\n```go\nfmt.Println()\n```\n")
+	require.NoError(t, err)
+
+	assert.Contains(t, doc.HTML, `<th align="left">a</th>`)
+	assert.Contains(t, doc.HTML, `<input checked="" disabled="" type="checkbox">`)
+	assert.Contains(t, doc.HTML, "<del>old</del>")
+	assert.Contains(t, doc.HTML, `<a href="https://example.com" rel="nofollow">https://example.com</a>`)
+	assert.Contains(t, doc.HTML, `<code class="language-go">`)
+}
+
+func TestRender_TableOfContents(t *testing.T) {
+	doc, err := Render("# Giới thiệu\n\nText\n\n## Setup `go`\n\n## Setup go\n\n### Notes {#custom}\n")
+	require.NoError(t, err)
+
+	assert.Equal(t, []Heading{
+		{Level: 1, ID: "giới-thiệu", Text: "Giới thiệu"},
+		{Level: 2, ID: "setup-go", Text: "Setup go"},
+		{Level: 2, ID: "setup-go-1", Text: "Setup go"},
+		{Level: 3, ID: "notes-custom", Text: "Notes {#custom}"},
+	}, doc.TOC)
+	assert.Contains(t, doc.HTML, `<h1 id="giới-thiệu">Giới thiệu</h1>`)
+	assert.Contains(t, doc.HTML, `<h2 id="setup-go-1">`)
+}
+
+func TestRender_ReadingTime(t *testing.T) {
+	empty, err := Render("")
+	require.NoError(t, err)
+	assert.Equal(t, 0, empty.WordCount)
+	assert.Equal(t, 0, empty.ReadingTimeMinutes)
+	assert.Empty(t, empty.TOC)
+
+	doc, err := Render("# Title here\n\n" + strings.Repeat("word ", 400) + "\n\n```\ncode block\n```\n")
+	require.NoError(t, err)
+	assert.Equal(t, 404, doc.WordCount)
+	assert.Equal(t, 3, doc.ReadingTimeMinutes)
+}
+
+func TestExcerpt(t *testing.T) {
+	source := "# Heading\n\nFirst *paragraph* with [a link](https://example.com).\n\n```\ncode\n```\n\nSecond paragraph goes on."
+
+	assert.Equal(t, "First paragraph with a link. Second paragraph goes on.", Excerpt(source, 200))
+	assert.Equal(t, "First paragraph with a…", Excerpt(source, 25))
+	assert.Equal(t, "First paragraph with a link…", Excerpt(source, 29))
+	assert.Equal(t, "", Excerpt("# Only a heading", 200))
+}
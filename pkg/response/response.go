@@ -27,6 +27,8 @@ type Meta struct {
 	PageSize   int   `json:"pageSize,omitempty"`
 	Total      int64 `json:"total,omitempty"`
 	TotalPages int   `json:"totalPages,omitempty"`
+	// NextCursor fetches the next page of a cursor-paginated list; empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
 }
 
 // Success sends a successful response
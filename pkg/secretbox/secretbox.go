@@ -0,0 +1,87 @@
+// Package secretbox encrypts short secrets, such as TOTP keys, before they are stored.
+// Sealed values are AES-256-GCM ciphertexts with a random nonce, base64 encoded behind
+// a version prefix so that values written before encryption was introduced can be told apart.
+package secretbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// KeySize is the length of the application key in bytes
+const KeySize = 32
+
+const prefix = "v1:"
+
+var (
+	ErrInvalidKey    = fmt.Errorf("secretbox: key must be %d bytes", KeySize)
+	ErrMalformed     = errors.New("secretbox: malformed sealed value")
+	ErrDecryptFailed = errors.New("secretbox: value cannot be decrypted with this key")
+)
+
+// Box seals and opens secrets with one application key
+type Box struct {
+	aead cipher.AEAD
+}
+
+// New creates a Box from a raw 32-byte key
+func New(key []byte) (*Box, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Box{aead: aead}, nil
+}
+
+// NewFromBase64 creates a Box from a base64 encoded key, as kept in configuration
+func NewFromBase64(key string) (*Box, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, fmt.Errorf("secretbox: key is not base64: %w", err)
+	}
+	return New(raw)
+}
+
+// Seal encrypts the plaintext
+func (b *Box) Seal(plaintext string) (string, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("secretbox: failed to generate nonce: %w", err)
+	}
+	sealed := b.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value produced by Seal
+func (b *Box) Open(sealed string) (string, error) {
+	if !IsSealed(sealed) {
+		return "", ErrMalformed
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(sealed, prefix))
+	if err != nil || len(raw) < b.aead.NonceSize() {
+		return "", ErrMalformed
+	}
+	nonce, ciphertext := raw[:b.aead.NonceSize()], raw[b.aead.NonceSize():]
+	plaintext, err := b.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrDecryptFailed
+	}
+	return string(plaintext), nil
+}
+
+// IsSealed reports whether the value was produced by Seal rather than stored in plain text
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
@@ -0,0 +1,61 @@
+package secretbox_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/aiagent/pkg/secretbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBox_SealOpen(t *testing.T) {
+	box, err := secretbox.New(bytes.Repeat([]byte{7}, secretbox.KeySize))
+	require.NoError(t, err)
+
+	sealed, err := box.Seal("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	assert.True(t, secretbox.IsSealed(sealed))
+	assert.NotContains(t, sealed, "JBSWY3DPEHPK3PXP")
+
+	again, err := box.Seal("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again, "every seal uses a fresh nonce")
+
+	opened, err := box.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", opened)
+}
+
+func TestBox_Open_Rejects(t *testing.T) {
+	box, err := secretbox.New(bytes.Repeat([]byte{7}, secretbox.KeySize))
+	require.NoError(t, err)
+	other, err := secretbox.New(bytes.Repeat([]byte{8}, secretbox.KeySize))
+	require.NoError(t, err)
+
+	sealed, err := box.Seal("secret")
+	require.NoError(t, err)
+
+	_, err = other.Open(sealed)
+	assert.ErrorIs(t, err, secretbox.ErrDecryptFailed)
+
+	_, err = box.Open(sealed[:len(sealed)-4] + "AAAA")
+	assert.ErrorIs(t, err, secretbox.ErrDecryptFailed)
+
+	_, err = box.Open("JBSWY3DPEHPK3PXP")
+	assert.ErrorIs(t, err, secretbox.ErrMalformed)
+}
+
+func TestNewFromBase64(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, secretbox.KeySize))
+	_, err := secretbox.NewFromBase64(key + "\n")
+	assert.NoError(t, err)
+
+	_, err = secretbox.NewFromBase64(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.ErrorIs(t, err, secretbox.ErrInvalidKey)
+
+	_, err = secretbox.NewFromBase64(strings.Repeat("!", 44))
+	assert.Error(t, err)
+}
@@ -0,0 +1,265 @@
+// Package textdiff compares two texts line by line, with word level changes within changed
+// lines, and formats the result as hunks or as a unified diff.
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultContext is the number of unchanged lines shown around changes
+const DefaultContext = 3
+
+// Op is what happened to a line or a piece of a line
+type Op string
+
+const (
+	Equal  Op = "equal"
+	Insert Op = "insert"
+	Delete Op = "delete"
+)
+
+// Segment is a run of words with the same Op
+type Segment struct {
+	Op   Op     `json:"op"`
+	Text string `json:"text"`
+}
+
+// Line is a line of a hunk. OldNumber and NewNumber are 1-based and zero on the side the line
+// is not on. A deleted line paired with the inserted line replacing it has Words set: its
+// unchanged and deleted words for the deleted line, its unchanged and inserted words for the other.
+type Line struct {
+	Op        Op        `json:"op"`
+	Text      string    `json:"text"` // Without line terminator
+	OldNumber int       `json:"oldNumber,omitempty"`
+	NewNumber int       `json:"newNumber,omitempty"`
+	Words     []Segment `json:"words,omitempty"`
+
+	noNewline bool // Last line of a text without a trailing newline
+}
+
+// Hunk is a group of changes with the unchanged lines around them. Starts follow the unified
+// diff convention: 1-based, or the line before an empty range.
+type Hunk struct {
+	OldStart int    `json:"oldStart"`
+	OldLines int    `json:"oldLines"`
+	NewStart int    `json:"newStart"`
+	NewLines int    `json:"newLines"`
+	Lines    []Line `json:"lines"`
+}
+
+// Diff is the difference between two texts
+type Diff struct {
+	Hunks     []Hunk `json:"hunks"`
+	Additions int    `json:"additions"` // Inserted lines
+	Deletions int    `json:"deletions"` // Deleted lines
+}
+
+// Compare diffs two texts line by line, keeping context unchanged lines around each change.
+// CRLF line endings are compared as LF.
+func Compare(oldText, newText string, context int) *Diff {
+	oldLines, newLines := splitLines(oldText), splitLines(newText)
+	script := editScript(oldLines, newLines)
+
+	diff := &Diff{Hunks: []Hunk{}}
+	for _, e := range script {
+		switch e.op {
+		case Insert:
+			diff.Additions++
+		case Delete:
+			diff.Deletions++
+		}
+	}
+
+	for start := 0; start < len(script); {
+		if script[start].op == Equal {
+			start++
+			continue
+		}
+
+		// Extend the hunk while the next change is close enough for the contexts to touch
+		end := start
+		for i := start; i < len(script); i++ {
+			if script[i].op != Equal {
+				end = i + 1
+			} else if i-end >= 2*context {
+				break
+			}
+		}
+
+		from := max(start-context, 0)
+		to := min(end+context, len(script))
+		diff.Hunks = append(diff.Hunks, newHunk(script[from:to], oldLines, newLines))
+		start = to
+	}
+	return diff
+}
+
+func newHunk(script []edit, oldLines, newLines []string) Hunk {
+	hunk := Hunk{OldStart: script[0].a, NewStart: script[0].b}
+	for _, e := range script {
+		var line Line
+		switch e.op {
+		case Equal:
+			line = Line{Op: Equal, OldNumber: e.a + 1, NewNumber: e.b + 1}
+			line.Text, line.noNewline = trimNewline(newLines[e.b])
+			hunk.OldLines++
+			hunk.NewLines++
+		case Delete:
+			line = Line{Op: Delete, OldNumber: e.a + 1}
+			line.Text, line.noNewline = trimNewline(oldLines[e.a])
+			hunk.OldLines++
+		case Insert:
+			line = Line{Op: Insert, NewNumber: e.b + 1}
+			line.Text, line.noNewline = trimNewline(newLines[e.b])
+			hunk.NewLines++
+		}
+		hunk.Lines = append(hunk.Lines, line)
+	}
+	if hunk.OldLines > 0 {
+		hunk.OldStart++
+	}
+	if hunk.NewLines > 0 {
+		hunk.NewStart++
+	}
+
+	pairChangedLines(hunk.Lines)
+	return hunk
+}
+
+// pairChangedLines diffs the words of each deleted line with the inserted line at the same
+// position in the run of insertions that follows
+func pairChangedLines(lines []Line) {
+	for i := 0; i < len(lines); {
+		if lines[i].Op != Delete {
+			i++
+			continue
+		}
+		delStart := i
+		for i < len(lines) && lines[i].Op == Delete {
+			i++
+		}
+		insStart := i
+		for i < len(lines) && lines[i].Op == Insert {
+			i++
+		}
+
+		pairs := min(insStart-delStart, i-insStart)
+		for p := 0; p < pairs; p++ {
+			oldLine, newLine := &lines[delStart+p], &lines[insStart+p]
+			oldLine.Words, newLine.Words = Words(oldLine.Text, newLine.Text)
+		}
+	}
+}
+
+// Words diffs two lines word by word. It returns the unchanged and deleted runs of oldText and
+// the unchanged and inserted runs of newText.
+func Words(oldText, newText string) (oldSegments, newSegments []Segment) {
+	oldTokens, newTokens := tokenize(oldText), tokenize(newText)
+	for _, e := range editScript(oldTokens, newTokens) {
+		switch e.op {
+		case Equal:
+			oldSegments = appendSegment(oldSegments, Equal, oldTokens[e.a])
+			newSegments = appendSegment(newSegments, Equal, newTokens[e.b])
+		case Delete:
+			oldSegments = appendSegment(oldSegments, Delete, oldTokens[e.a])
+		case Insert:
+			newSegments = appendSegment(newSegments, Insert, newTokens[e.b])
+		}
+	}
+	return oldSegments, newSegments
+}
+
+func appendSegment(segments []Segment, op Op, text string) []Segment {
+	if n := len(segments); n > 0 && segments[n-1].Op == op {
+		segments[n-1].Text += text
+		return segments
+	}
+	return append(segments, Segment{Op: op, Text: text})
+}
+
+// tokenize splits a line into words, runs of spaces and single punctuation characters
+func tokenize(s string) []string {
+	var tokens []string
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		end := size
+		switch {
+		case isWordRune(r):
+			end = runLength(s, isWordRune)
+		case unicode.IsSpace(r):
+			end = runLength(s, unicode.IsSpace)
+		}
+		tokens = append(tokens, s[:end])
+		s = s[end:]
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_'
+}
+
+func runLength(s string, in func(rune) bool) int {
+	for i, r := range s {
+		if !in(r) {
+			return i
+		}
+	}
+	return len(s)
+}
+
+// Unified formats the diff as a unified diff between files named oldName and newName
+func (d *Diff) Unified(oldName, newName string) string {
+	if len(d.Hunks) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+	for _, hunk := range d.Hunks {
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", unifiedRange(hunk.OldStart, hunk.OldLines), unifiedRange(hunk.NewStart, hunk.NewLines))
+		for _, line := range hunk.Lines {
+			switch line.Op {
+			case Equal:
+				b.WriteByte(' ')
+			case Delete:
+				b.WriteByte('-')
+			case Insert:
+				b.WriteByte('+')
+			}
+			b.WriteString(line.Text)
+			b.WriteByte('\n')
+			if line.noNewline {
+				b.WriteString("\\ No newline at end of file\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+func unifiedRange(start, lines int) string {
+	if lines == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, lines)
+}
+
+// splitLines splits text into lines that keep their "\n", so that a missing final newline shows
+// up as a change of the last line
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func trimNewline(line string) (string, bool) {
+	if text, ok := strings.CutSuffix(line, "\n"); ok {
+		return text, false
+	}
+	return line, true
+}
@@ -0,0 +1,125 @@
+package textdiff
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare_Unified(t *testing.T) {
+	oldText := "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\neleven\ntwelve\n"
+	newText := "one\nTWO\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\neleven\ntwelve\nthirteen"
+
+	diff := Compare(oldText, newText, DefaultContext)
+
+	// Same output as `diff -u`
+	assert.Equal(t, "--- a\n+++ b\n"+
+		"@@ -1,5 +1,5 @@\n one\n-two\n+TWO\n three\n four\n five\n"+
+		"@@ -10,3 +10,4 @@\n ten\n eleven\n twelve\n+thirteen\n\\ No newline at end of file\n",
+		diff.Unified("a", "b"))
+	assert.Equal(t, 2, diff.Additions)
+	assert.Equal(t, 1, diff.Deletions)
+}
+
+func TestCompare_MergesCloseChanges(t *testing.T) {
+	diff := Compare("a\nb\nc\nd\ne\nf\ng\nh\n", "A\nb\nc\nd\ne\nf\ng\nH\n", DefaultContext)
+
+	require.Len(t, diff.Hunks, 1)
+	hunk := diff.Hunks[0]
+	assert.Equal(t, Hunk{OldStart: 1, OldLines: 8, NewStart: 1, NewLines: 8}, Hunk{
+		OldStart: hunk.OldStart, OldLines: hunk.OldLines, NewStart: hunk.NewStart, NewLines: hunk.NewLines,
+	})
+	assert.Equal(t, Line{Op: Delete, Text: "a", OldNumber: 1, Words: []Segment{{Op: Delete, Text: "a"}}}, hunk.Lines[0])
+	assert.Equal(t, Line{Op: Equal, Text: "b", OldNumber: 2, NewNumber: 2}, hunk.Lines[2])
+}
+
+func TestCompare_EmptyTexts(t *testing.T) {
+	diff := Compare("", "added\n", DefaultContext)
+	assert.Equal(t, "--- a\n+++ b\n@@ -0,0 +1 @@\n+added\n", diff.Unified("a", "b"))
+
+	diff = Compare("same\r\n", "same\n", DefaultContext)
+	assert.Empty(t, diff.Hunks)
+	assert.Equal(t, "", diff.Unified("a", "b"))
+}
+
+func TestCompare_WordsOfChangedLines(t *testing.T) {
+	diff := Compare("intro\nThe quick brown fox.\n", "intro\nThe slow brown fox!\nnew line\n", DefaultContext)
+
+	require.Len(t, diff.Hunks, 1)
+	lines := diff.Hunks[0].Lines
+	require.Len(t, lines, 4)
+	assert.Equal(t, []Segment{
+		{Op: Equal, Text: "The "}, {Op: Delete, Text: "quick"}, {Op: Equal, Text: " brown fox"}, {Op: Delete, Text: "."},
+	}, lines[1].Words)
+	assert.Equal(t, []Segment{
+		{Op: Equal, Text: "The "}, {Op: Insert, Text: "slow"}, {Op: Equal, Text: " brown fox"}, {Op: Insert, Text: "!"},
+	}, lines[2].Words)
+	// An inserted line with no deleted counterpart has no word diff
+	assert.Equal(t, Line{Op: Insert, Text: "new line", NewNumber: 3}, lines[3])
+}
+
+func TestWords_Unicode(t *testing.T) {
+	oldSegments, newSegments := Words("Xin chào thế giới", "Xin chào cả thế giới")
+
+	assert.Equal(t, []Segment{{Op: Equal, Text: "Xin chào thế giới"}}, oldSegments)
+	assert.Equal(t, []Segment{{Op: Equal, Text: "Xin chào "}, {Op: Insert, Text: "cả "}, {Op: Equal, Text: "thế giới"}}, newSegments)
+}
+
+// TestEditScript_Shortest checks scripts of random inputs against the LCS length, and that
+// they turn one sequence into the other
+func TestEditScript_Shortest(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for n := 0; n < 500; n++ {
+		a := randomTokens(rng, rng.Intn(30))
+		b := randomTokens(rng, rng.Intn(30))
+
+		script := editScript(a, b)
+
+		var gotA, gotB []string
+		equal := 0
+		for _, e := range script {
+			switch e.op {
+			case Equal:
+				require.Equal(t, a[e.a], b[e.b])
+				gotA = append(gotA, a[e.a])
+				gotB = append(gotB, b[e.b])
+				equal++
+			case Delete:
+				gotA = append(gotA, a[e.a])
+			case Insert:
+				gotB = append(gotB, b[e.b])
+			}
+		}
+		require.Equal(t, strings.Join(a, ""), strings.Join(gotA, ""))
+		require.Equal(t, strings.Join(b, ""), strings.Join(gotB, ""))
+		require.Equal(t, lcsLength(a, b), equal, "a=%v b=%v", a, b)
+	}
+}
+
+func randomTokens(rng *rand.Rand, n int) []string {
+	tokens := make([]string, n)
+	for i := range tokens {
+		tokens[i] = string(rune('a' + rng.Intn(4)))
+	}
+	return tokens
+}
+
+func lcsLength(a, b []string) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+	return table[0][0]
+}
@@ -35,7 +35,7 @@ func setupSeriesTestServer(t *testing.T) *SeriesTestContext {
 	seriesRepo := repository.NewSeriesRepository(db)
 
 	// Setup usecases
-	seriesUC := series.NewSeriesUseCase(seriesRepo)
+	seriesUC := series.NewSeriesUseCase(seriesRepo, repository.NewUserSeriesPurchaseRepository(db), repository.NewReadingHistoryRepository(db))
 
 	// Setup handler
 	handler := seriesHandler.NewSeriesHandler(seriesUC)
@@ -130,13 +130,18 @@ func TestPaymentIntegration(t *testing.T) {
 		BankBranch:   "Test Branch",
 		WebhookToken: "test-webhook-token",
 	}
-	sepayAdapter := adapter.NewSePayAdapter(cfg)
+	providers := service.NewPaymentProviders(map[entity.TransactionProvider]adapter.PaymentProvider{
+		entity.TransactionProviderSEPAY: adapter.NewSePayProvider(adapter.NewSePayAdapter(cfg)),
+	}, map[string]string{"VND": "SEPAY"})
 
-	paymentSvc := service.NewPaymentService(db, txRepo, subRepo, purchaseRepo, planRepo, sepayAdapter)
+	ledgerSvc := service.NewLedgerService(repository.NewLedgerRepository(db), planRepo, repository.NewSeriesRepository(db), service.PlatformFees{
+		entity.TransactionTypeSubscription: decimal.NewFromInt(20),
+	})
+	paymentSvc := service.NewPaymentService(db, txRepo, subRepo, purchaseRepo, repository.NewSeriesRepository(db), userRepo, planRepo, repository.NewOutboxRepository(db), repository.NewSubscriptionEventRepository(db), repository.NewPaymentReconciliationRepository(db), repository.NewPromoCodeRepository(db), repository.NewGiftSubscriptionRepository(db), ledgerSvc, providers)
 	createPaymentUC := payment.NewCreatePaymentUseCase(paymentSvc)
 	processWebhookUC := payment.NewProcessWebhookUseCase(paymentSvc)
 
-	paymentH := paymentHandler.NewPaymentHandler(createPaymentUC)
+	paymentH := paymentHandler.NewPaymentHandler(createPaymentUC, payment.NewPaymentStatusUseCase(paymentSvc))
 	webhookH := paymentHandler.NewWebhookHandler(processWebhookUC, cfg.WebhookToken)
 
 	// Setup Router
@@ -274,6 +279,11 @@ func TestPaymentIntegration(t *testing.T) {
 		assert.True(t, subUpdated.ExpiresAt.After(time.Now()))
 		// Should be roughly 30 days from now
 		assert.True(t, subUpdated.ExpiresAt.After(time.Now().AddDate(0, 0, 29)))
+
+		// 6. Verify the author is credited the payment less the platform fee
+		balance, err := ledgerSvc.AuthorBalance(context.Background(), authorID, "VND")
+		require.NoError(t, err)
+		assert.Equal(t, "40000", balance.String())
 	})
 
 	t.Run("Scenario 2: Idempotency", func(t *testing.T) {
@@ -68,10 +68,11 @@ func setupPlanTestServer(t *testing.T) *PlanTestContext {
 	// Setup services
 	planService := service.NewPlanManagementService(planRepo, tagTierRepo)
 	tagService := service.NewTagTierService(tagTierRepo, tagRepo, blogRepo)
-	accessService := service.NewContentAccessService(tagService, subRepo, planRepo)
+	accessService := service.NewContentAccessService(tagService, subRepo, planRepo,
+		service.NewSeriesAccessService(repository.NewSeriesRepository(db), repository.NewUserSeriesPurchaseRepository(db)))
 
 	// Setup handler
-	handler := planHandler.NewPlanHandler(planService, tagService, accessService)
+	handler := planHandler.NewPlanHandler(planService, tagService, accessService, service.NewSubscriptionPricingService(subRepo, planRepo))
 
 	// Setup router
 	gin.SetMode(gin.TestMode)
@@ -83,7 +84,7 @@ func setupPlanTestServer(t *testing.T) *PlanTestContext {
 	sessionAuth := conditionalAuthMiddleware(authorID)
 
 	// Register routes
-	router.RegisterPlanRoutes(v1, handler, sessionAuth)
+	router.RegisterPlanRoutes(v1, handler, sessionAuth, sessionAuth, sessionAuth)
 
 	server := httptest.NewServer(r)
 
@@ -754,16 +755,17 @@ func TestPlanAPI_GetAuthorTagTiers(t *testing.T) {
 		// Setup services
 		planService := service.NewPlanManagementService(planRepo, tagTierRepo)
 		tagService := service.NewTagTierService(tagTierRepo, tagRepo, blogRepo)
-		accessService := service.NewContentAccessService(tagService, subRepo, planRepo)
+		accessService := service.NewContentAccessService(tagService, subRepo, planRepo,
+			service.NewSeriesAccessService(repository.NewSeriesRepository(db2), repository.NewUserSeriesPurchaseRepository(db2)))
 
-		handler := planHandler.NewPlanHandler(planService, tagService, accessService)
+		handler := planHandler.NewPlanHandler(planService, tagService, accessService, service.NewSubscriptionPricingService(subRepo, planRepo))
 
 		gin.SetMode(gin.TestMode)
 		r := gin.New()
 		v1 := r.Group("/api/v1")
 
 		sessionAuth := conditionalAuthMiddleware(newAuthorID)
-		router.RegisterPlanRoutes(v1, handler, sessionAuth)
+		router.RegisterPlanRoutes(v1, handler, sessionAuth, sessionAuth, sessionAuth)
 
 		req, _ := http.NewRequest("GET", "/api/v1/authors/me/tag-tiers", nil)
 		req.Header.Set("Authorization", "Bearer mock-token")
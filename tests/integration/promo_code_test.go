@@ -0,0 +1,84 @@
+package integration
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/aiagent/internal/domain/entity"
+	"github.com/aiagent/internal/infrastructure/persistence/postgres/repository"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPromoCodeReservation tests that orders placed at the same time never take more
+// redemptions than a capped promo code allows
+func TestPromoCodeReservation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	repo := repository.NewPromoCodeRepository(db)
+
+	authorID := uuid.New()
+	createUser(t, db, authorID)
+
+	maxRedemptions := 3
+	promo := &entity.PromoCode{
+		AuthorID:       authorID,
+		Code:           "LAUNCH",
+		DiscountType:   entity.PromoDiscountPercent,
+		DiscountValue:  decimal.NewFromInt(20),
+		MaxRedemptions: &maxRedemptions,
+		IsActive:       true,
+	}
+	require.NoError(t, repo.Create(ctx, promo))
+
+	t.Run("concurrent reservations stop at the cap", func(t *testing.T) {
+		const orders = 20
+
+		var wg sync.WaitGroup
+		results := make(chan bool, orders)
+		for i := 0; i < orders; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				reserved, err := repo.ReserveRedemption(ctx, promo.ID)
+				assert.NoError(t, err)
+				results <- reserved
+			}()
+		}
+		wg.Wait()
+		close(results)
+
+		reserved := 0
+		for ok := range results {
+			if ok {
+				reserved++
+			}
+		}
+		assert.Equal(t, maxRedemptions, reserved)
+
+		stored, err := repo.FindByAuthorAndCode(ctx, authorID, "LAUNCH")
+		require.NoError(t, err)
+		assert.Equal(t, maxRedemptions, stored.RedemptionCount)
+	})
+
+	t.Run("released redemption can be reserved again", func(t *testing.T) {
+		require.NoError(t, repo.ReleaseRedemption(ctx, promo.ID))
+
+		reserved, err := repo.ReserveRedemption(ctx, promo.ID)
+		require.NoError(t, err)
+		assert.True(t, reserved)
+
+		reserved, err = repo.ReserveRedemption(ctx, promo.ID)
+		require.NoError(t, err)
+		assert.False(t, reserved)
+	})
+}
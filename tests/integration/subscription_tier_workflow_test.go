@@ -43,8 +43,9 @@ func TestSubscriptionTierWorkflow(t *testing.T) {
 	// Setup services
 	planMgmtSvc := service.NewPlanManagementService(planRepo, tagTierRepo)
 	tagTierSvc := service.NewTagTierService(tagTierRepo, tagRepo, blogRepo)
-	contentAccessSvc := service.NewContentAccessService(tagTierSvc, subRepo, planRepo)
-	subscriptionSvc := service.NewSubscriptionService(subRepo)
+	contentAccessSvc := service.NewContentAccessService(tagTierSvc, subRepo, planRepo,
+		service.NewSeriesAccessService(pgRepo.NewSeriesRepository(db), pgRepo.NewUserSeriesPurchaseRepository(db)))
+	subscriptionSvc := service.NewSubscriptionService(subRepo, nil)
 
 	// Setup payment service (for simulating webhooks)
 	txRepo := pgRepo.NewTransactionRepository(db)
@@ -56,8 +57,11 @@ func TestSubscriptionTierWorkflow(t *testing.T) {
 		BankBranch:   "Test Branch",
 		WebhookToken: "test-webhook-token",
 	}
-	sepayAdapter := adapter.NewSePayAdapter(cfg)
-	paymentSvc := service.NewPaymentService(db, txRepo, subRepo, pgRepo.NewUserSeriesPurchaseRepository(db), planRepo, sepayAdapter)
+	providers := service.NewPaymentProviders(map[entity.TransactionProvider]adapter.PaymentProvider{
+		entity.TransactionProviderSEPAY: adapter.NewSePayProvider(adapter.NewSePayAdapter(cfg)),
+	}, map[string]string{"VND": "SEPAY"})
+	ledgerSvc := service.NewLedgerService(pgRepo.NewLedgerRepository(db), planRepo, pgRepo.NewSeriesRepository(db), service.PlatformFees{})
+	paymentSvc := service.NewPaymentService(db, txRepo, subRepo, pgRepo.NewUserSeriesPurchaseRepository(db), pgRepo.NewSeriesRepository(db), userRepo, planRepo, pgRepo.NewOutboxRepository(db), pgRepo.NewSubscriptionEventRepository(db), pgRepo.NewPaymentReconciliationRepository(db), pgRepo.NewPromoCodeRepository(db), pgRepo.NewGiftSubscriptionRepository(db), ledgerSvc, providers)
 	createPaymentUC := payment.NewCreatePaymentUseCase(paymentSvc)
 	processWebhookUC := payment.NewProcessWebhookUseCase(paymentSvc)
 
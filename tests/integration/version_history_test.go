@@ -14,13 +14,16 @@ import (
 	"github.com/aiagent/internal/domain/entity"
 	"github.com/aiagent/internal/domain/repository"
 	"github.com/aiagent/internal/domain/service"
+	cacheMocks "github.com/aiagent/internal/infrastructure/cache/mocks"
 	postgresRepository "github.com/aiagent/internal/infrastructure/persistence/postgres/repository"
 	blogHandler "github.com/aiagent/internal/interfaces/http/handler/blog"
 	versionHandler "github.com/aiagent/internal/interfaces/http/handler/version"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 type VersionTestContext struct {
@@ -57,10 +60,15 @@ func setupVersionTestServer(t *testing.T) *VersionTestContext {
 	// Wait, blogService constructor requires Redis.
 	// I'll use nil for Redis if it allows it, or I'll see how other tests handle it.
 	// Actually, I'll use a nil redis for now and see if it crashes.
-	blogSvc := service.NewBlogService(blogRepo, subRepo, tagRepo, nil, versionSvc)
+	blogSvc := service.NewBlogService(db, blogRepo, subRepo, tagRepo, nil, versionSvc, postgresRepository.NewOutboxRepository(db),
+		service.NewSeriesAccessService(postgresRepository.NewSeriesRepository(db), postgresRepository.NewUserSeriesPurchaseRepository(db)))
 
 	// UseCases
-	blogUC := blog.NewBlogUseCase(blogSvc)
+	// Rendered content is not cached: every lookup misses
+	contentCache := cacheMocks.NewMockCache(gomock.NewController(t))
+	contentCache.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(redis.Nil).AnyTimes()
+	contentCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	blogUC := blog.NewBlogUseCase(blogSvc, postgresRepository.NewSeriesRepository(db), service.NewContentRenderer(contentCache))
 
 	// Handlers
 	bHandler := blogHandler.NewBlogHandler(blogUC)